@@ -24,6 +24,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
 	"github.com/m3db/m3/src/metrics/aggregation"
 )
 
@@ -38,14 +39,16 @@ type Counter struct {
 	count      int64
 	max        int64
 	min        int64
+	distinct   *hll.Sketch // Sketch of the distinct values counted.
 }
 
 // NewCounter creates a new counter.
 func NewCounter(opts Options) Counter {
 	return Counter{
-		Options: opts,
-		max:     math.MinInt64,
-		min:     math.MaxInt64,
+		Options:  opts,
+		max:      math.MinInt64,
+		min:      math.MaxInt64,
+		distinct: newDistinctSketch(opts),
 	}
 }
 
@@ -74,6 +77,7 @@ func (c *Counter) Update(timestamp time.Time, value int64, annotation []byte) {
 		c.sumSq += value * value
 	}
 
+	updateDistinctSketch(c.distinct, c.annotation, annotation, c.Metrics.Distinct)
 	c.annotation = MaybeReplaceAnnotation(c.annotation, annotation)
 }
 
@@ -125,14 +129,41 @@ func (c *Counter) ValueOf(aggType aggregation.Type) float64 {
 		return float64(c.SumSq())
 	case aggregation.Stdev:
 		return c.Stdev()
+	case aggregation.CountDistinct:
+		return distinctEstimate(c.distinct)
 	default:
 		return 0
 	}
 }
 
-// Annotation returns the annotation associated with the counter.
+// Annotation returns the annotation associated with the counter, along with
+// the sketch of the distinct values counted if any.
 func (c *Counter) Annotation() []byte {
-	return c.annotation
+	return annotationWithDistinctSketch(c.annotation, c.distinct)
+}
+
+// EncodeState encodes the state of the counter.
+func (c *Counter) EncodeState(enc *StateEncoder) {
+	enc.EncodeTime(c.lastAt)
+	enc.EncodeBytes(c.annotation)
+	enc.EncodeVarint(c.sum)
+	enc.EncodeVarint(c.sumSq)
+	enc.EncodeVarint(c.count)
+	enc.EncodeVarint(c.max)
+	enc.EncodeVarint(c.min)
+	encodeDistinctSketch(enc, c.distinct)
+}
+
+// DecodeState restores the state of the counter encoded by EncodeState.
+func (c *Counter) DecodeState(dec *StateDecoder) error {
+	c.lastAt = dec.DecodeTime()
+	c.annotation = dec.DecodeBytes()
+	c.sum = dec.DecodeVarint()
+	c.sumSq = dec.DecodeVarint()
+	c.count = dec.DecodeVarint()
+	c.max = dec.DecodeVarint()
+	c.min = dec.DecodeVarint()
+	return decodeDistinctSketch(dec, c.distinct)
 }
 
 // Close closes the counter.
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"bytes"
+
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+)
+
+// newDistinctSketch returns the sketch counting the distinct values of an
+// aggregation, or nil if the count distinct aggregation type is not enabled.
+func newDistinctSketch(opts Options) *hll.Sketch {
+	if !opts.HasCountDistinct {
+		return nil
+	}
+	return hll.NewSketch()
+}
+
+// updateDistinctSketch merges the distinct sketch carried by the annotation of
+// a value into the sketch of the aggregation. The sketch is not merged again if
+// the annotation is the previous annotation of the aggregation, which is the
+// case of most values of a series.
+func updateDistinctSketch(
+	sketch *hll.Sketch,
+	prevAnnotation []byte,
+	annotationBytes []byte,
+	metrics DistinctMetrics,
+) {
+	if sketch == nil || len(annotationBytes) == 0 || bytes.Equal(prevAnnotation, annotationBytes) {
+		return
+	}
+	var payload annotation.Payload
+	if err := payload.Unmarshal(annotationBytes); err != nil || len(payload.DistinctSketch) == 0 {
+		return
+	}
+	other, err := hll.Unmarshal(payload.DistinctSketch)
+	if err != nil {
+		metrics.IncInvalidSketches()
+		return
+	}
+	sketch.Merge(other)
+}
+
+// distinctEstimate returns the estimated number of distinct values of an
+// aggregation.
+func distinctEstimate(sketch *hll.Sketch) float64 {
+	if sketch == nil {
+		return 0
+	}
+	return float64(sketch.Estimate())
+}
+
+// annotationWithDistinctSketch returns the annotation along with the distinct
+// sketch of the aggregation, if any, so that it can be merged by the next stage
+// of a pipeline.
+func annotationWithDistinctSketch(annotationBytes []byte, sketch *hll.Sketch) []byte {
+	if sketch == nil {
+		return annotationBytes
+	}
+	var payload annotation.Payload
+	if err := payload.Unmarshal(annotationBytes); err != nil {
+		return annotationBytes
+	}
+	payload.DistinctSketch = sketch.Marshal(payload.DistinctSketch[:0])
+	result, err := payload.Marshal()
+	if err != nil {
+		return annotationBytes
+	}
+	return result
+}
+
+func encodeDistinctSketch(enc *StateEncoder, sketch *hll.Sketch) {
+	var data []byte
+	if sketch != nil {
+		data = sketch.Marshal(nil)
+	}
+	enc.EncodeBytes(data)
+}
+
+func decodeDistinctSketch(dec *StateDecoder, sketch *hll.Sketch) error {
+	data := dec.DecodeBytes()
+	if err := dec.Err(); err != nil || sketch == nil || len(data) == 0 {
+		return err
+	}
+	return sketch.Unmarshal(data)
+}
+
+// StripDistinctSketch returns the annotation without the distinct sketch it
+// may carry, distinct sketches are not stored along with aggregated values.
+func StripDistinctSketch(annotationBytes []byte) []byte {
+	var payload annotation.Payload
+	if len(annotationBytes) == 0 || payload.Unmarshal(annotationBytes) != nil ||
+		len(payload.DistinctSketch) == 0 {
+		return annotationBytes
+	}
+	payload.DistinctSketch = nil
+	result, err := payload.Marshal()
+	if err != nil {
+		return annotationBytes
+	}
+	return result
+}
+
+// CountDistinctAnnotation returns the annotation of the values of the count
+// distinct aggregation type, which are gauges regardless of the type of the
+// aggregated metric.
+func CountDistinctAnnotation(annotationBytes []byte) []byte {
+	var payload annotation.Payload
+	if err := payload.Unmarshal(annotationBytes); err != nil {
+		payload = annotation.Payload{}
+	}
+	payload.DistinctSketch = nil
+	payload.OpenMetricsHandleValueResets = false
+	if payload.SourceFormat == annotation.SourceFormat_GRAPHITE {
+		payload.GraphiteType = annotation.GraphiteType_GRAPHITE_GAUGE
+	} else {
+		payload.OpenMetricsFamilyType = annotation.OpenMetricsFamilyType_GAUGE
+	}
+	result, err := payload.Marshal()
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+// MergeAnnotations merges the timer and distinct sketches carried in the new
+// annotation into the ones carried in the current annotation, returning the
+// updated ref for the current annotation. The current annotation is replaced,
+// as with MaybeReplaceAnnotation, with the sketches of both annotations merged
+// where they can be.
+func MergeAnnotations(currentAnnotation, newAnnotation []byte) []byte {
+	if len(currentAnnotation) == 0 || len(newAnnotation) == 0 ||
+		bytes.Equal(currentAnnotation, newAnnotation) {
+		return MaybeReplaceAnnotation(currentAnnotation, newAnnotation)
+	}
+
+	var curr, next annotation.Payload
+	if curr.Unmarshal(currentAnnotation) != nil || next.Unmarshal(newAnnotation) != nil {
+		return MaybeReplaceAnnotation(currentAnnotation, newAnnotation)
+	}
+	var merged bool
+	if timerSketch, ok := mergeTimerSketches(curr.TimerSketch, next.TimerSketch); ok {
+		next.TimerSketch = timerSketch
+		merged = true
+	}
+	if distinctSketch, ok := mergeDistinctSketches(curr.DistinctSketch, next.DistinctSketch); ok {
+		next.DistinctSketch = distinctSketch
+		merged = true
+	}
+	if !merged {
+		return MaybeReplaceAnnotation(currentAnnotation, newAnnotation)
+	}
+
+	result, err := next.Marshal()
+	if err != nil {
+		return MaybeReplaceAnnotation(currentAnnotation, newAnnotation)
+	}
+	return MaybeReplaceAnnotation(currentAnnotation, result)
+}
+
+func mergeTimerSketches(current, next []byte) ([]byte, bool) {
+	if len(current) == 0 || len(next) == 0 {
+		return nil, false
+	}
+	sketch, err := ddsketch.Unmarshal(current)
+	if err != nil {
+		return nil, false
+	}
+	other, err := ddsketch.Unmarshal(next)
+	if err != nil || sketch.Merge(other) != nil {
+		return nil, false
+	}
+	return sketch.Marshal(nil), true
+}
+
+func mergeDistinctSketches(current, next []byte) ([]byte, bool) {
+	if len(current) == 0 || len(next) == 0 {
+		return nil, false
+	}
+	sketch, err := hll.Unmarshal(current)
+	if err != nil {
+		return nil, false
+	}
+	other, err := hll.Unmarshal(next)
+	if err != nil {
+		return nil, false
+	}
+	sketch.Merge(other)
+	return sketch.Marshal(nil), true
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func testDistinctOptions() Options {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(aggregation.Types{aggregation.Sum, aggregation.CountDistinct})
+	return opts
+}
+
+func testDistinctAnnotation(t *testing.T, values ...string) []byte {
+	sketch := hll.NewSketch()
+	for _, v := range values {
+		sketch.Add([]byte(v))
+	}
+	payload := annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+		DistinctSketch:        sketch.Marshal(nil),
+	}
+	data, err := payload.Marshal()
+	require.NoError(t, err)
+	return data
+}
+
+func requireDistinctSketchEstimate(t *testing.T, expected uint64, annotationBytes []byte) {
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(annotationBytes))
+	sketch, err := hll.Unmarshal(payload.DistinctSketch)
+	require.NoError(t, err)
+	require.Equal(t, expected, sketch.Estimate())
+}
+
+func TestCounterCountDistinct(t *testing.T) {
+	c := NewCounter(testDistinctOptions())
+	for i := 0; i < 100; i++ {
+		c.Update(time.Now(), 1, testDistinctAnnotation(t, fmt.Sprintf("value%d", i%10)))
+	}
+	c.Update(time.Now(), 1, nil)
+	require.Equal(t, 101.0, c.ValueOf(aggregation.Sum))
+	require.Equal(t, 10.0, c.ValueOf(aggregation.CountDistinct))
+	requireDistinctSketchEstimate(t, 10, c.Annotation())
+}
+
+func TestGaugeCountDistinct(t *testing.T) {
+	g := NewGauge(testDistinctOptions())
+	g.Update(time.Now(), 1, testDistinctAnnotation(t, "a", "b"))
+	g.Update(time.Now(), 1, testDistinctAnnotation(t, "b", "c"))
+	require.Equal(t, 3.0, g.ValueOf(aggregation.CountDistinct))
+	requireDistinctSketchEstimate(t, 3, g.Annotation())
+}
+
+func TestTimerCountDistinct(t *testing.T) {
+	timer := NewTimer(testQuantiles, cm.NewOptions(), testDistinctOptions())
+	timer.AddBatch(time.Now(), []float64{1, 2}, testDistinctAnnotation(t, "a"))
+	timer.AddBatch(time.Now(), []float64{3}, testDistinctAnnotation(t, "b"))
+	require.Equal(t, 2.0, timer.ValueOf(aggregation.CountDistinct))
+	requireDistinctSketchEstimate(t, 2, timer.Annotation())
+}
+
+func TestCountDistinctNotEnabled(t *testing.T) {
+	c := NewCounter(NewOptions(instrument.NewOptions()))
+	data := testDistinctAnnotation(t, "a")
+	c.Update(time.Now(), 1, data)
+	require.Equal(t, 0.0, c.ValueOf(aggregation.CountDistinct))
+	require.Equal(t, data, c.Annotation())
+}
+
+func TestCountDistinctInvalidSketch(t *testing.T) {
+	opts := testDistinctOptions()
+	c := NewCounter(opts)
+	payload := annotation.Payload{DistinctSketch: []byte{0xff}}
+	data, err := payload.Marshal()
+	require.NoError(t, err)
+	c.Update(time.Now(), 1, data)
+	require.Equal(t, 0.0, c.ValueOf(aggregation.CountDistinct))
+}
+
+func TestStripDistinctSketch(t *testing.T) {
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(StripDistinctSketch(testDistinctAnnotation(t, "a"))))
+	require.Equal(t, annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+	}, payload)
+
+	require.Nil(t, StripDistinctSketch(nil))
+	require.Equal(t, []byte("foo"), StripDistinctSketch([]byte("foo")))
+}
+
+func TestCountDistinctAnnotation(t *testing.T) {
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(CountDistinctAnnotation(testDistinctAnnotation(t, "a"))))
+	require.Equal(t, annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_GAUGE,
+	}, payload)
+
+	graphite, err := (&annotation.Payload{
+		SourceFormat: annotation.SourceFormat_GRAPHITE,
+		GraphiteType: annotation.GraphiteType_GRAPHITE_COUNTER,
+	}).Marshal()
+	require.NoError(t, err)
+	payload = annotation.Payload{}
+	require.NoError(t, payload.Unmarshal(CountDistinctAnnotation(graphite)))
+	require.Equal(t, annotation.Payload{
+		SourceFormat: annotation.SourceFormat_GRAPHITE,
+		GraphiteType: annotation.GraphiteType_GRAPHITE_GAUGE,
+	}, payload)
+}
+
+func TestMergeAnnotations(t *testing.T) {
+	merged := MergeAnnotations(nil, testDistinctAnnotation(t, "a", "b"))
+	merged = MergeAnnotations(merged, testDistinctAnnotation(t, "b", "c"))
+	requireDistinctSketchEstimate(t, 3, merged)
+
+	// Annotations without sketches are replaced.
+	require.Equal(t, []byte("foo"), MergeAnnotations(merged, []byte("foo")))
+	require.Equal(t, []byte("foo"), MergeAnnotations([]byte("bar"), []byte("foo")))
+}
@@ -24,6 +24,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
 	"github.com/m3db/m3/src/metrics/aggregation"
 )
 
@@ -39,19 +40,22 @@ type Gauge struct {
 	max        float64
 	min        float64
 	last       float64
+	distinct   *hll.Sketch // Sketch of the distinct values counted.
 }
 
 // NewGauge creates a new gauge.
 func NewGauge(opts Options) Gauge {
 	return Gauge{
-		Options: opts,
-		max:     math.NaN(),
-		min:     math.NaN(),
+		Options:  opts,
+		max:      math.NaN(),
+		min:      math.NaN(),
+		distinct: newDistinctSketch(opts),
 	}
 }
 
 // Update updates the gauge value.
 func (g *Gauge) Update(timestamp time.Time, value float64, annotation []byte) {
+	updateDistinctSketch(g.distinct, g.annotation, annotation, g.Metrics.Distinct)
 	g.annotation = MaybeReplaceAnnotation(g.annotation, annotation)
 	g.updateTotals(timestamp, value)
 }
@@ -159,14 +163,43 @@ func (g *Gauge) ValueOf(aggType aggregation.Type) float64 {
 		return g.SumSq()
 	case aggregation.Stdev:
 		return g.Stdev()
+	case aggregation.CountDistinct:
+		return distinctEstimate(g.distinct)
 	default:
 		return 0
 	}
 }
 
-// Annotation returns the annotation associated with the gauge.
+// Annotation returns the annotation associated with the gauge, along with the
+// sketch of the distinct values counted if any.
 func (g *Gauge) Annotation() []byte {
-	return g.annotation
+	return annotationWithDistinctSketch(g.annotation, g.distinct)
+}
+
+// EncodeState encodes the state of the gauge.
+func (g *Gauge) EncodeState(enc *StateEncoder) {
+	enc.EncodeTime(g.lastAt)
+	enc.EncodeBytes(g.annotation)
+	enc.EncodeFloat64(g.sum)
+	enc.EncodeFloat64(g.sumSq)
+	enc.EncodeVarint(g.count)
+	enc.EncodeFloat64(g.max)
+	enc.EncodeFloat64(g.min)
+	enc.EncodeFloat64(g.last)
+	encodeDistinctSketch(enc, g.distinct)
+}
+
+// DecodeState restores the state of the gauge encoded by EncodeState.
+func (g *Gauge) DecodeState(dec *StateDecoder) error {
+	g.lastAt = dec.DecodeTime()
+	g.annotation = dec.DecodeBytes()
+	g.sum = dec.DecodeFloat64()
+	g.sumSq = dec.DecodeFloat64()
+	g.count = dec.DecodeVarint()
+	g.max = dec.DecodeFloat64()
+	g.min = dec.DecodeFloat64()
+	g.last = dec.DecodeFloat64()
+	return decodeDistinctSketch(dec, g.distinct)
 }
 
 // Close closes the gauge.
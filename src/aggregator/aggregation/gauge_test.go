@@ -136,6 +136,8 @@ func TestGaugeCustomAggregationType(t *testing.T) {
 			require.Equal(t, float64(338350), v)
 		case aggregation.Stdev:
 			require.InDelta(t, 29.01149, v, 0.001)
+		case aggregation.CountDistinct:
+			require.Equal(t, float64(0), v)
 		default:
 			require.Equal(t, float64(0), v)
 			require.False(t, aggType.IsValidForGauge())
@@ -163,6 +165,8 @@ func TestGaugeCustomAggregationType(t *testing.T) {
 			require.Equal(t, 0.0, v)
 		case aggregation.Stdev:
 			require.InDelta(t, 0.0, v, 0.0)
+		case aggregation.CountDistinct:
+			require.Equal(t, 0.0, v)
 		default:
 			require.Equal(t, 0.0, v)
 			require.False(t, aggType.IsValidForGauge())
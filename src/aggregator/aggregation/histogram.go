@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/x/histogram"
+)
+
+// Histogram aggregates native histogram values. Histograms are carried in the
+// annotation of each value, the aggregated histogram is returned as part of
+// the annotation of the aggregation.
+type Histogram struct {
+	Options
+
+	lastAt     time.Time
+	annotation []byte
+	last       *histogram.Histogram
+	sum        *histogram.Histogram
+	count      int64
+	merge      bool
+}
+
+// NewHistogram creates a new histogram. If merge is set the buckets of all
+// values are merged and the merged histogram is returned as the annotation,
+// otherwise the last histogram received is returned.
+func NewHistogram(opts Options, merge bool) Histogram {
+	return Histogram{
+		Options: opts,
+		merge:   merge,
+	}
+}
+
+// Update updates the histogram with the histogram carried in the annotation.
+func (h *Histogram) Update(timestamp time.Time, annotationBytes []byte) {
+	var payload annotation.Payload
+	if err := payload.Unmarshal(annotationBytes); err != nil || len(payload.NativeHistogram) == 0 {
+		h.Options.Metrics.Histogram.IncInvalidValues()
+		return
+	}
+	value, err := histogram.Unmarshal(payload.NativeHistogram)
+	if err != nil {
+		h.Options.Metrics.Histogram.IncInvalidValues()
+		return
+	}
+
+	h.annotation = MaybeReplaceAnnotation(h.annotation, annotationBytes)
+	if h.lastAt.IsZero() || timestamp.After(h.lastAt) {
+		// NB: Only keep the last histogram based on the timestamp of the
+		// value rather than the order received, same as gauges.
+		h.lastAt = timestamp
+		h.last = value
+	} else {
+		h.Options.Metrics.Histogram.IncValuesOutOfOrder()
+	}
+
+	h.count++
+
+	if !h.merge {
+		return
+	}
+	if h.sum == nil {
+		h.sum = value.Copy()
+		return
+	}
+	h.sum.Add(value)
+}
+
+// LastAt returns the time of the last value received.
+func (h *Histogram) LastAt() time.Time { return h.lastAt }
+
+// Last returns the last histogram received.
+func (h *Histogram) Last() *histogram.Histogram { return h.last }
+
+// Sum returns the histogram with the buckets of all values merged, it is
+// only available if the histogram was created with merging enabled.
+func (h *Histogram) Sum() *histogram.Histogram { return h.sum }
+
+// Count returns the number of values received.
+func (h *Histogram) Count() int64 { return h.count }
+
+// ValueOf returns the value for the aggregation type, the value of a histogram
+// is the count of observations.
+func (h *Histogram) ValueOf(aggType aggregation.Type) float64 {
+	switch aggType {
+	case aggregation.Last:
+		if h.last == nil {
+			return 0
+		}
+		return h.last.Count
+	case aggregation.Sum:
+		if h.sum == nil {
+			return 0
+		}
+		return h.sum.Count
+	default:
+		return 0
+	}
+}
+
+// Annotation returns the last annotation received with the aggregated
+// histogram in place of the original one.
+func (h *Histogram) Annotation() []byte {
+	value := h.last
+	if h.merge {
+		value = h.sum
+	}
+	if value == nil {
+		return h.annotation
+	}
+
+	var payload annotation.Payload
+	if err := payload.Unmarshal(h.annotation); err != nil {
+		return h.annotation
+	}
+	payload.NativeHistogram = value.Marshal(payload.NativeHistogram[:0])
+	result, err := payload.Marshal()
+	if err != nil {
+		return h.annotation
+	}
+	return result
+}
+
+// EncodeState encodes the state of the histogram.
+func (h *Histogram) EncodeState(enc *StateEncoder) {
+	enc.EncodeTime(h.lastAt)
+	enc.EncodeBytes(h.annotation)
+	enc.EncodeVarint(h.count)
+	encodeHistogramState(enc, h.last)
+	encodeHistogramState(enc, h.sum)
+}
+
+// DecodeState restores the state of the histogram encoded by EncodeState.
+func (h *Histogram) DecodeState(dec *StateDecoder) error {
+	h.lastAt = dec.DecodeTime()
+	h.annotation = dec.DecodeBytes()
+	h.count = dec.DecodeVarint()
+	var err error
+	if h.last, err = decodeHistogramState(dec); err != nil {
+		return err
+	}
+	if h.sum, err = decodeHistogramState(dec); err != nil {
+		return err
+	}
+	return dec.Err()
+}
+
+func encodeHistogramState(enc *StateEncoder, value *histogram.Histogram) {
+	if value == nil {
+		enc.EncodeBytes(nil)
+		return
+	}
+	enc.EncodeBytes(value.Marshal(nil))
+}
+
+func decodeHistogramState(dec *StateDecoder) (*histogram.Histogram, error) {
+	data := dec.DecodeBytes()
+	if len(data) == 0 {
+		return nil, dec.Err()
+	}
+	return histogram.Unmarshal(data)
+}
+
+// Close closes the histogram.
+func (h *Histogram) Close() {}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/x/histogram"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func testHistogramAnnotation(t *testing.T, h *histogram.Histogram) []byte {
+	payload := annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_HISTOGRAM,
+		NativeHistogram:       h.Marshal(nil),
+	}
+	data, err := payload.Marshal()
+	require.NoError(t, err)
+	return data
+}
+
+func annotationHistogram(t *testing.T, data []byte) *histogram.Histogram {
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(data))
+	require.Equal(t, annotation.OpenMetricsFamilyType_HISTOGRAM, payload.OpenMetricsFamilyType)
+	h, err := histogram.Unmarshal(payload.NativeHistogram)
+	require.NoError(t, err)
+	return h
+}
+
+func TestHistogramLast(t *testing.T) {
+	var (
+		now    = time.Now()
+		first  = &histogram.Histogram{Count: 2, Sum: 3, ZeroCount: 2}
+		second = &histogram.Histogram{Count: 1, Sum: 1,
+			PositiveSpans: []histogram.Span{{Offset: 1, Length: 1}}, PositiveBuckets: []float64{1}}
+		h = NewHistogram(NewOptions(instrument.NewOptions()), false)
+	)
+
+	h.Update(now.Add(time.Second), testHistogramAnnotation(t, second))
+	h.Update(now, testHistogramAnnotation(t, first))
+	h.Update(now, []byte("invalid"))
+
+	require.Equal(t, int64(2), h.Count())
+	require.Equal(t, now.Add(time.Second), h.LastAt())
+	require.Equal(t, 1.0, h.ValueOf(aggregation.Last))
+	require.Nil(t, h.Sum())
+	require.True(t, second.Equal(annotationHistogram(t, h.Annotation())))
+}
+
+func TestHistogramMerge(t *testing.T) {
+	var (
+		now   = time.Now()
+		first = &histogram.Histogram{Count: 3, Sum: 5, ZeroCount: 1,
+			PositiveSpans: []histogram.Span{{Offset: 1, Length: 1}}, PositiveBuckets: []float64{2}}
+		second = &histogram.Histogram{Count: 2, Sum: 4,
+			PositiveSpans: []histogram.Span{{Offset: 1, Length: 2}}, PositiveBuckets: []float64{1, 1}}
+		h = NewHistogram(NewOptions(instrument.NewOptions()), true)
+	)
+
+	h.Update(now, testHistogramAnnotation(t, first))
+	h.Update(now.Add(time.Second), testHistogramAnnotation(t, second))
+
+	expected := &histogram.Histogram{Count: 5, Sum: 9, ZeroCount: 1,
+		PositiveSpans: []histogram.Span{{Offset: 1, Length: 2}}, PositiveBuckets: []float64{3, 1}}
+	require.Equal(t, 5.0, h.ValueOf(aggregation.Sum))
+	require.Equal(t, 2.0, h.ValueOf(aggregation.Last))
+	require.True(t, expected.Equal(h.Sum()))
+	require.True(t, expected.Equal(annotationHistogram(t, h.Annotation())))
+
+	// The merged histogram must not alias the first value added.
+	require.Equal(t, 3.0, first.Count)
+}
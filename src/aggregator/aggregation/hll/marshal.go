@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+const (
+	marshalVersion = 1
+
+	sparseEncoding = 0
+	denseEncoding  = 1
+)
+
+var (
+	errUnmarshalTruncated = errors.New("hll: truncated data")
+	errUnmarshalVersion   = errors.New("hll: unknown version")
+	errUnmarshalPrecision = errors.New("hll: unsupported precision")
+	errUnmarshalInvalid   = errors.New("hll: invalid sketch")
+)
+
+// Marshal appends the binary representation of the sketch to the buffer and
+// returns the extended buffer. Sparse registers are encoded as the delta of
+// their index with the previous one along with their value, in index order.
+func (s *Sketch) Marshal(buf []byte) []byte {
+	buf = append(buf, marshalVersion, Precision)
+	if s.registers != nil {
+		buf = append(buf, denseEncoding)
+		return append(buf, s.registers...)
+	}
+
+	indexes := make([]uint32, 0, len(s.sparse))
+	for idx := range s.sparse {
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	buf = append(buf, sparseEncoding)
+	buf = binary.AppendUvarint(buf, uint64(len(indexes)))
+	var prev uint32
+	for _, idx := range indexes {
+		buf = binary.AppendUvarint(buf, uint64(idx-prev)<<6|uint64(s.sparse[idx]))
+		prev = idx
+	}
+	return buf
+}
+
+// Unmarshal decodes a sketch from its binary representation.
+func Unmarshal(data []byte) (*Sketch, error) {
+	var s Sketch
+	if err := s.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Unmarshal decodes the binary representation into the sketch, reusing the
+// existing registers where possible.
+func (s *Sketch) Unmarshal(data []byte) error {
+	if len(data) < 3 {
+		return errUnmarshalTruncated
+	}
+	if data[0] != marshalVersion {
+		return errUnmarshalVersion
+	}
+	if data[1] != Precision {
+		return errUnmarshalPrecision
+	}
+	encoding, data := data[2], data[3:]
+
+	switch encoding {
+	case denseEncoding:
+		if len(data) != numRegisters {
+			return errUnmarshalTruncated
+		}
+		for _, rho := range data {
+			if rho > maxRegisterValue {
+				return errUnmarshalInvalid
+			}
+		}
+		if s.registers == nil {
+			s.registers = make([]uint8, numRegisters)
+		}
+		copy(s.registers, data)
+		s.sparse = nil
+		return nil
+	case sparseEncoding:
+	default:
+		return errUnmarshalInvalid
+	}
+
+	numEntries, n := binary.Uvarint(data)
+	if n <= 0 {
+		return errUnmarshalTruncated
+	}
+	data = data[n:]
+	if numEntries > maxSparseLen || numEntries > uint64(len(data)) {
+		return errUnmarshalInvalid
+	}
+	s.Reset()
+	var idx uint64
+	for i := uint64(0); i < numEntries; i++ {
+		entry, n := binary.Uvarint(data)
+		if n <= 0 {
+			s.Reset()
+			return errUnmarshalTruncated
+		}
+		data = data[n:]
+		idx += entry >> 6
+		rho := uint8(entry & 0x3f)
+		if idx >= numSparseRegisters || rho == 0 || rho > 64-sparsePrecision+1 {
+			s.Reset()
+			return errUnmarshalInvalid
+		}
+		s.sparse[uint32(idx)] = rho
+	}
+	return nil
+}
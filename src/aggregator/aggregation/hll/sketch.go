@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hll
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	// Precision is the number of bits of the hash of a value used to select
+	// the register of the value, a sketch has 2^Precision registers and a
+	// standard error of about 1.04/sqrt(2^Precision), i.e. 0.81%.
+	Precision = 14
+
+	// sparsePrecision is the precision of the sparse representation.
+	sparsePrecision = 25
+
+	numRegisters       = 1 << Precision
+	numSparseRegisters = 1 << sparsePrecision
+
+	// maxRegisterValue is the largest position of the leftmost one bit in the
+	// bits of a hash that follow the register index.
+	maxRegisterValue = 64 - Precision + 1
+
+	// maxSparseLen is the number of sparse entries past which the sketch is
+	// converted to the dense representation, which is then about as large.
+	maxSparseLen = numRegisters / 4
+)
+
+// Sketch is a HyperLogLog++ sketch. Values are hashed to 64 bits, the first
+// Precision bits of the hash select a register and the register keeps the
+// largest position of the leftmost one bit among the remaining bits of the
+// hashes it is selected for. Until the sketch is converted to the dense
+// representation, registers are kept with a precision of 25 bits for the
+// (few) indexes that were selected. Sketch APIs are not thread-safe.
+type Sketch struct {
+	sparse    map[uint32]uint8
+	registers []uint8
+}
+
+// NewSketch creates a new sketch.
+func NewSketch() *Sketch {
+	return &Sketch{sparse: make(map[uint32]uint8)}
+}
+
+// Add adds a value to the sketch.
+func (s *Sketch) Add(value []byte) {
+	s.AddHash(xxhash.Sum64(value))
+}
+
+// AddHash adds the 64 bit hash of a value to the sketch.
+func (s *Sketch) AddHash(hash uint64) {
+	if s.registers != nil {
+		idx, rho := registerFor(hash)
+		s.setRegister(idx, rho)
+		return
+	}
+	idx := uint32(hash >> (64 - sparsePrecision))
+	rho := uint8(bits.LeadingZeros64(hash<<sparsePrecision|1<<(sparsePrecision-1)) + 1)
+	s.setSparse(idx, rho)
+}
+
+func registerFor(hash uint64) (uint32, uint8) {
+	idx := uint32(hash >> (64 - Precision))
+	rho := uint8(bits.LeadingZeros64(hash<<Precision|1<<(Precision-1)) + 1)
+	return idx, rho
+}
+
+// sparseToRegister converts a sparse register to the register of the dense
+// representation it falls into.
+func sparseToRegister(sparseIdx uint32, sparseRho uint8) (uint32, uint8) {
+	const extraBits = sparsePrecision - Precision
+	idx := sparseIdx >> extraBits
+	if rest := sparseIdx & (1<<extraBits - 1); rest != 0 {
+		return idx, uint8(bits.LeadingZeros32(rest<<(32-extraBits)) + 1)
+	}
+	return idx, extraBits + sparseRho
+}
+
+func (s *Sketch) setRegister(idx uint32, rho uint8) {
+	if s.registers[idx] < rho {
+		s.registers[idx] = rho
+	}
+}
+
+func (s *Sketch) setSparse(idx uint32, rho uint8) {
+	if s.sparse[idx] >= rho {
+		return
+	}
+	s.sparse[idx] = rho
+	if len(s.sparse) > maxSparseLen {
+		s.toDense()
+	}
+}
+
+func (s *Sketch) toDense() {
+	s.registers = make([]uint8, numRegisters)
+	for sparseIdx, sparseRho := range s.sparse {
+		s.setRegister(sparseToRegister(sparseIdx, sparseRho))
+	}
+	s.sparse = nil
+}
+
+// IsSparse returns whether the sketch uses the sparse representation.
+func (s *Sketch) IsSparse() bool { return s.registers == nil }
+
+// Merge merges another sketch into the sketch, the sketch then estimates the
+// cardinality of the union of the values added to both sketches.
+func (s *Sketch) Merge(other *Sketch) {
+	if other.registers == nil {
+		for idx, rho := range other.sparse {
+			if s.registers == nil {
+				s.setSparse(idx, rho)
+			} else {
+				s.setRegister(sparseToRegister(idx, rho))
+			}
+		}
+		return
+	}
+	if s.registers == nil {
+		s.toDense()
+	}
+	for idx, rho := range other.registers {
+		s.setRegister(uint32(idx), rho)
+	}
+}
+
+// Estimate returns the estimated number of distinct values added to the
+// sketch.
+func (s *Sketch) Estimate() uint64 {
+	if s.registers == nil {
+		return uint64(math.Round(linearCounting(numSparseRegisters, numSparseRegisters-len(s.sparse))))
+	}
+
+	var counts [maxRegisterValue + 1]int
+	for _, rho := range s.registers {
+		counts[rho]++
+	}
+	const m = float64(numRegisters)
+	z := m * tau(1-float64(counts[maxRegisterValue])/m)
+	for k := maxRegisterValue - 1; k >= 1; k-- {
+		z = 0.5 * (z + float64(counts[k]))
+	}
+	z += m * sigma(float64(counts[0])/m)
+	return uint64(math.Round(m * m / (2 * math.Ln2 * z)))
+}
+
+func linearCounting(m, numZeros int) float64 {
+	return float64(m) * math.Log(float64(m)/float64(numZeros))
+}
+
+func sigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y, z := 1.0, x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if z == prev {
+			return z
+		}
+	}
+}
+
+func tau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == prev {
+			return z / 3
+		}
+	}
+}
+
+// Clone returns a copy of the sketch.
+func (s *Sketch) Clone() *Sketch {
+	clone := &Sketch{}
+	if s.registers != nil {
+		clone.registers = append([]uint8(nil), s.registers...)
+		return clone
+	}
+	clone.sparse = make(map[uint32]uint8, len(s.sparse))
+	for idx, rho := range s.sparse {
+		clone.sparse[idx] = rho
+	}
+	return clone
+}
+
+// Reset resets the sketch to an empty sparse sketch.
+func (s *Sketch) Reset() {
+	s.registers = nil
+	if s.sparse == nil {
+		s.sparse = make(map[uint32]uint8)
+		return
+	}
+	for idx := range s.sparse {
+		delete(s.sparse, idx)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hll
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// maxRelativeError is four times the standard error of the estimates.
+const maxRelativeError = 4 * 1.04 / 128
+
+func addValues(s *Sketch, prefix string, from, to int) {
+	for i := from; i < to; i++ {
+		s.Add([]byte(fmt.Sprintf("%s-%d", prefix, i)))
+	}
+}
+
+func requireWithinError(t *testing.T, expected int, actual uint64) {
+	require.InDelta(t, float64(expected), float64(actual), float64(expected)*maxRelativeError+1,
+		"expected %d, actual %d", expected, actual)
+}
+
+func TestSketchEmpty(t *testing.T) {
+	s := NewSketch()
+	require.Equal(t, uint64(0), s.Estimate())
+	require.True(t, s.IsSparse())
+
+	s.toDense()
+	require.Equal(t, uint64(0), s.Estimate())
+}
+
+func TestSketchEstimate(t *testing.T) {
+	for _, cardinality := range []int{1, 10, 100, 1000, 10000, 100000, 1000000} {
+		t.Run(fmt.Sprintf("cardinality %d", cardinality), func(t *testing.T) {
+			s := NewSketch()
+			addValues(s, "value", 0, cardinality)
+			// Adding the values again does not change the estimate.
+			addValues(s, "value", 0, cardinality/10)
+			requireWithinError(t, cardinality, s.Estimate())
+			require.Equal(t, cardinality <= maxSparseLen, s.IsSparse())
+		})
+	}
+}
+
+func TestSketchSparseExact(t *testing.T) {
+	s := NewSketch()
+	addValues(s, "value", 0, 100)
+	require.Equal(t, uint64(100), s.Estimate())
+}
+
+func TestSketchDenseConversion(t *testing.T) {
+	sparse := NewSketch()
+	addValues(sparse, "value", 0, maxSparseLen)
+	require.True(t, sparse.IsSparse())
+
+	dense := sparse.Clone()
+	dense.toDense()
+	requireWithinError(t, maxSparseLen, dense.Estimate())
+
+	// The registers of the converted sketch are the ones of a sketch that was
+	// dense from the start.
+	expected := NewSketch()
+	expected.toDense()
+	addValues(expected, "value", 0, maxSparseLen)
+	require.Equal(t, expected.registers, dense.registers)
+}
+
+func TestSketchMerge(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right int
+	}{
+		{name: "sparse with sparse", left: 100, right: 200},
+		{name: "sparse with dense", left: 100, right: 20000},
+		{name: "dense with sparse", left: 20000, right: 100},
+		{name: "dense with dense", left: 20000, right: 50000},
+		{name: "sparse with sparse to dense", left: 3000, right: 3000},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			left, right, all := NewSketch(), NewSketch(), NewSketch()
+			// The right values start from the middle of the left ones.
+			addValues(left, "value", 0, test.left)
+			addValues(right, "value", test.left/2, test.left/2+test.right)
+			addValues(all, "value", 0, test.left)
+			addValues(all, "value", test.left/2, test.left/2+test.right)
+
+			right.Merge(left)
+			left.Merge(right)
+			union := test.left
+			if test.left/2+test.right > union {
+				union = test.left/2 + test.right
+			}
+			requireWithinError(t, union, left.Estimate())
+			require.Equal(t, all.Estimate(), left.Estimate())
+			require.Equal(t, all.Estimate(), right.Estimate())
+		})
+	}
+}
+
+func TestSketchMarshalRoundTrip(t *testing.T) {
+	for _, cardinality := range []int{0, 1, 1000, 100000} {
+		t.Run(fmt.Sprintf("cardinality %d", cardinality), func(t *testing.T) {
+			s := NewSketch()
+			addValues(s, "value", 0, cardinality)
+
+			decoded, err := Unmarshal(s.Marshal(nil))
+			require.NoError(t, err)
+			require.Equal(t, s.IsSparse(), decoded.IsSparse())
+			require.Equal(t, s.Estimate(), decoded.Estimate())
+			require.Equal(t, s.registers, decoded.registers)
+
+			// Decoding into a sketch replaces its registers.
+			reused := NewSketch()
+			addValues(reused, "other", 0, 50000)
+			require.NoError(t, reused.Unmarshal(s.Marshal(nil)))
+			require.Equal(t, s.Estimate(), reused.Estimate())
+		})
+	}
+}
+
+func TestSketchMarshalSparseSize(t *testing.T) {
+	s := NewSketch()
+	s.Add([]byte("value"))
+	require.True(t, len(s.Marshal(nil)) <= 10)
+}
+
+func TestSketchUnmarshalErrors(t *testing.T) {
+	s := NewSketch()
+	addValues(s, "value", 0, 100)
+	sparse := s.Marshal(nil)
+	addValues(s, "value", 0, 10000)
+	dense := s.Marshal(nil)
+
+	corrupt := func(data []byte, idx int, value byte) []byte {
+		data = append([]byte(nil), data...)
+		data[idx] = value
+		return data
+	}
+	tests := []struct {
+		name     string
+		data     []byte
+		expected error
+	}{
+		{name: "empty", data: nil, expected: errUnmarshalTruncated},
+		{name: "version", data: corrupt(sparse, 0, 2), expected: errUnmarshalVersion},
+		{name: "precision", data: corrupt(sparse, 1, 12), expected: errUnmarshalPrecision},
+		{name: "encoding", data: corrupt(sparse, 2, 2), expected: errUnmarshalInvalid},
+		{name: "truncated sparse", data: sparse[:len(sparse)-1], expected: errUnmarshalTruncated},
+		{name: "truncated dense", data: dense[:len(dense)-1], expected: errUnmarshalTruncated},
+		{name: "dense register", data: corrupt(dense, 3, math.MaxUint8), expected: errUnmarshalInvalid},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Unmarshal(test.data)
+			require.Equal(t, test.expected, err)
+		})
+	}
+}
+
+func TestSketchReset(t *testing.T) {
+	s := NewSketch()
+	addValues(s, "value", 0, 10000)
+	s.Reset()
+	require.True(t, s.IsSparse())
+	require.Equal(t, uint64(0), s.Estimate())
+	addValues(s, "value", 0, 10)
+	require.Equal(t, uint64(10), s.Estimate())
+}
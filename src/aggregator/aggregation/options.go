@@ -23,6 +23,7 @@ package aggregation
 import (
 	"github.com/uber-go/tally"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/x/instrument"
 )
@@ -38,12 +39,20 @@ type Options struct {
 	// HasExpensiveAggregations means expensive (multiplication／division)
 	// aggregation types are enabled.
 	HasExpensiveAggregations bool
+	// TimerSketch is the sketch options of timers whose quantiles are computed
+	// with a DDSketch, if nil timer quantiles are computed with the CM stream.
+	TimerSketch ddsketch.Options
+	// HasCountDistinct means the count distinct aggregation type is enabled.
+	HasCountDistinct bool
 }
 
 // Metrics is a set of metrics that can be used by elements.
 type Metrics struct {
-	Counter CounterMetrics
-	Gauge   GaugeMetrics
+	Counter   CounterMetrics
+	Gauge     GaugeMetrics
+	Histogram HistogramMetrics
+	Timer     TimerMetrics
+	Distinct  DistinctMetrics
 }
 
 // CounterMetrics is a set of counter metrics can be used by all counters.
@@ -60,8 +69,11 @@ type GaugeMetrics struct {
 func NewMetrics(scope tally.Scope) Metrics {
 	scope = scope.SubScope("aggregation")
 	return Metrics{
-		Counter: newCounterMetrics(scope.SubScope("counters")),
-		Gauge:   newGaugeMetrics(scope.SubScope("gauges")),
+		Counter:   newCounterMetrics(scope.SubScope("counters")),
+		Gauge:     newGaugeMetrics(scope.SubScope("gauges")),
+		Histogram: newHistogramMetrics(scope.SubScope("histograms")),
+		Timer:     newTimerMetrics(scope.SubScope("timers")),
+		Distinct:  newDistinctMetrics(scope.SubScope("distinct")),
 	}
 }
 
@@ -91,6 +103,70 @@ func (m GaugeMetrics) IncValuesOutOfOrder() {
 	}
 }
 
+// HistogramMetrics is a set of histogram metrics can be used by all histograms.
+type HistogramMetrics struct {
+	valuesOutOfOrder tally.Counter
+	invalidValues    tally.Counter
+}
+
+func newHistogramMetrics(scope tally.Scope) HistogramMetrics {
+	return HistogramMetrics{
+		valuesOutOfOrder: scope.Counter("values-out-of-order"),
+		invalidValues:    scope.Counter("invalid-values"),
+	}
+}
+
+// IncValuesOutOfOrder increments value or if not initialized is a no-op.
+func (m HistogramMetrics) IncValuesOutOfOrder() {
+	if m.valuesOutOfOrder != nil {
+		m.valuesOutOfOrder.Inc(1)
+	}
+}
+
+// IncInvalidValues increments value or if not initialized is a no-op.
+func (m HistogramMetrics) IncInvalidValues() {
+	if m.invalidValues != nil {
+		m.invalidValues.Inc(1)
+	}
+}
+
+// TimerMetrics is a set of timer metrics can be used by all timers.
+type TimerMetrics struct {
+	invalidSketches tally.Counter
+}
+
+func newTimerMetrics(scope tally.Scope) TimerMetrics {
+	return TimerMetrics{
+		invalidSketches: scope.Counter("invalid-sketches"),
+	}
+}
+
+// IncInvalidSketches increments value or if not initialized is a no-op.
+func (m TimerMetrics) IncInvalidSketches() {
+	if m.invalidSketches != nil {
+		m.invalidSketches.Inc(1)
+	}
+}
+
+// DistinctMetrics is a set of metrics can be used by all aggregations counting
+// distinct values.
+type DistinctMetrics struct {
+	invalidSketches tally.Counter
+}
+
+func newDistinctMetrics(scope tally.Scope) DistinctMetrics {
+	return DistinctMetrics{
+		invalidSketches: scope.Counter("invalid-sketches"),
+	}
+}
+
+// IncInvalidSketches increments value or if not initialized is a no-op.
+func (m DistinctMetrics) IncInvalidSketches() {
+	if m.invalidSketches != nil {
+		m.invalidSketches.Inc(1)
+	}
+}
+
 // NewOptions creates a new aggregation options.
 func NewOptions(instrumentOpts instrument.Options) Options {
 	return Options{
@@ -102,4 +178,5 @@ func NewOptions(instrumentOpts instrument.Options) Options {
 // ResetSetData resets the aggregation options.
 func (o *Options) ResetSetData(aggTypes aggregation.Types) {
 	o.HasExpensiveAggregations = isExpensive(aggTypes)
+	o.HasCountDistinct = aggTypes.Contains(aggregation.CountDistinct)
 }
@@ -20,6 +20,14 @@
 
 package cm
 
+// SampleState is the state of a sample, used to checkpoint and restore
+// the samples of a stream.
+type SampleState struct {
+	Value    float64
+	NumRanks int64
+	Delta    int64
+}
+
 // Sample represents a sampled value.
 type Sample struct {
 	prev     *Sample // previous sample
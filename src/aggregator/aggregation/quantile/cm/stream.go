@@ -172,6 +172,42 @@ func (s *Stream) Quantile(q float64) float64 {
 	return math.NaN()
 }
 
+// Samples flushes the stream and appends the state of its samples to dst in
+// ascending order of their values.
+func (s *Stream) Samples(dst []SampleState) []SampleState {
+	s.Flush()
+	for curr := s.samples.Front(); curr != nil; curr = curr.next {
+		dst = append(dst, SampleState{
+			Value:    curr.value,
+			NumRanks: curr.numRanks,
+			Delta:    curr.delta,
+		})
+	}
+	return dst
+}
+
+// SetSamples replaces the samples of the stream with the samples returned
+// by Samples.
+func (s *Stream) SetSamples(samples []SampleState) {
+	s.bufMore.Reset()
+	s.bufLess.Reset()
+	s.samples.Reset()
+	s.compressCursor = nil
+	s.compressMinRank = 0
+	s.insertAndCompressCounter = 0
+	s.numValues = 0
+	for _, state := range samples {
+		sample := s.samples.Acquire()
+		sample.value = state.Value
+		sample.numRanks = state.NumRanks
+		sample.delta = state.Delta
+		s.samples.PushBack(sample)
+		s.numValues += state.NumRanks
+	}
+	s.insertCursor = s.samples.Front()
+	s.flushed = false
+}
+
 // ResetSetData resets the stream and sets data.
 func (s *Stream) ResetSetData(quantiles []float64) {
 	s.quantiles = quantiles
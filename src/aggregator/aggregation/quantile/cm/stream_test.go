@@ -153,6 +153,35 @@ func TestStreamClose(t *testing.T) {
 	require.True(t, s.closed)
 }
 
+func TestStreamSetSamples(t *testing.T) {
+	opts := testStreamOptions().SetInsertAndCompressEvery(testInsertAndCompressEvery)
+	numSamples := 100000
+	s := NewStream(opts)
+	s.ResetSetData(testQuantiles)
+	for i := 0; i < numSamples/2; i++ {
+		s.Add(float64(i))
+	}
+
+	restored := NewStream(opts)
+	restored.ResetSetData(testQuantiles)
+	restored.SetSamples(s.Samples(nil))
+	require.Equal(t, s.numValues, restored.numValues)
+
+	// Values added after the samples are restored are accounted for.
+	for i := numSamples / 2; i < numSamples; i++ {
+		restored.Add(float64(i))
+	}
+	restored.Flush()
+
+	require.Equal(t, 0.0, restored.Min())
+	require.Equal(t, float64(numSamples-1), restored.Max())
+	margin := float64(numSamples) * opts.Eps()
+	for _, q := range testQuantiles {
+		val := restored.Quantile(q)
+		require.True(t, val >= float64(numSamples)*q-margin && val <= float64(numSamples)*q+margin)
+	}
+}
+
 func testStreamWithIncreasingSamples(t *testing.T, opts Options) {
 	numSamples := 100000
 	s := NewStream(opts)
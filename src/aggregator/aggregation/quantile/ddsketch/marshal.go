@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const marshalVersion = 1
+
+var (
+	errUnmarshalTruncated = errors.New("ddsketch: truncated data")
+	errUnmarshalVersion   = errors.New("ddsketch: unknown version")
+	errUnmarshalInvalid   = errors.New("ddsketch: invalid sketch")
+)
+
+// Marshal appends the binary representation of the sketch to the buffer and
+// returns the extended buffer.
+func (s *Sketch) Marshal(buf []byte) []byte {
+	buf = append(buf, marshalVersion)
+	buf = appendFloat(buf, s.relativeAccuracy)
+	buf = binary.AppendUvarint(buf, uint64(s.maxNumBuckets))
+	buf = appendFloat(buf, s.zeroCount)
+	buf = appendFloat(buf, s.count)
+	buf = appendFloat(buf, s.sum)
+	buf = appendFloat(buf, s.min)
+	buf = appendFloat(buf, s.max)
+	buf = appendStore(buf, &s.positive)
+	buf = appendStore(buf, &s.negative)
+	return buf
+}
+
+func appendFloat(buf []byte, v float64) []byte {
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+}
+
+func appendStore(buf []byte, s *store) []byte {
+	buf = binary.AppendVarint(buf, int64(s.offset))
+	buf = binary.AppendUvarint(buf, uint64(len(s.counts)))
+	for _, count := range s.counts {
+		buf = appendFloat(buf, count)
+	}
+	return buf
+}
+
+// Unmarshal decodes a sketch from its binary representation.
+func Unmarshal(data []byte) (*Sketch, error) {
+	var s Sketch
+	if err := s.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Unmarshal decodes the binary representation into the sketch, reusing the
+// existing buckets where possible. The sketch takes the accuracy of the
+// decoded sketch.
+func (s *Sketch) Unmarshal(data []byte) error {
+	d := decoder{data: data}
+	if version := d.byte(); d.err == nil && version != marshalVersion {
+		return errUnmarshalVersion
+	}
+	relativeAccuracy := d.float()
+	maxNumBuckets := d.uvarint()
+	s.zeroCount = d.float()
+	s.count = d.float()
+	s.sum = d.float()
+	s.min = d.float()
+	s.max = d.float()
+	s.positive = d.store(s.positive)
+	s.negative = d.store(s.negative)
+	if d.err != nil {
+		return d.err
+	}
+	opts := NewOptions().
+		SetRelativeAccuracy(relativeAccuracy).
+		SetMaxNumBuckets(int(maxNumBuckets))
+	if maxNumBuckets > math.MaxInt32 || opts.Validate() != nil ||
+		len(s.positive.counts) > int(maxNumBuckets) ||
+		len(s.negative.counts) > int(maxNumBuckets) {
+		return errUnmarshalInvalid
+	}
+	s.setAccuracy(relativeAccuracy, int(maxNumBuckets))
+	return nil
+}
+
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 1 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) float() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 8 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[8:]
+	return v
+}
+
+func (d *decoder) store(s store) store {
+	offset := d.varint()
+	numBuckets := d.uvarint()
+	if d.err != nil {
+		return s
+	}
+	if offset < math.MinInt32 || offset > math.MaxInt32 {
+		d.err = errUnmarshalInvalid
+		return s
+	}
+	if numBuckets*8 > uint64(len(d.data)) {
+		// Guard against allocating based on a corrupt length.
+		d.err = errUnmarshalTruncated
+		return s
+	}
+	s.offset = int32(offset)
+	s.counts = s.counts[:0]
+	for i := uint64(0); i < numBuckets; i++ {
+		s.counts = append(s.counts, d.float())
+	}
+	return s
+}
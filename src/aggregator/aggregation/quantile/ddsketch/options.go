@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"fmt"
+)
+
+const (
+	minRelativeAccuracy     = 0.0
+	maxRelativeAccuracy     = 1.0
+	defaultRelativeAccuracy = 0.01
+	defaultMaxNumBuckets    = 2048
+)
+
+var (
+	errInvalidRelativeAccuracy = fmt.Errorf("relative accuracy must be between %f and %f",
+		minRelativeAccuracy, maxRelativeAccuracy)
+	errInvalidMaxNumBuckets = fmt.Errorf("max number of buckets must be positive")
+)
+
+// Options represent various options for computing quantiles with sketches.
+type Options interface {
+	// SetRelativeAccuracy sets the relative accuracy of the quantiles.
+	SetRelativeAccuracy(value float64) Options
+
+	// RelativeAccuracy returns the relative accuracy of the quantiles.
+	RelativeAccuracy() float64
+
+	// SetMaxNumBuckets sets the maximum number of buckets of the values of
+	// each sign, the lowest buckets are collapsed beyond it.
+	SetMaxNumBuckets(value int) Options
+
+	// MaxNumBuckets returns the maximum number of buckets of the values of
+	// each sign, the lowest buckets are collapsed beyond it.
+	MaxNumBuckets() int
+
+	// Validate validates the options.
+	Validate() error
+}
+
+type options struct {
+	relativeAccuracy float64
+	maxNumBuckets    int
+}
+
+// NewOptions creates a new options.
+func NewOptions() Options {
+	return &options{
+		relativeAccuracy: defaultRelativeAccuracy,
+		maxNumBuckets:    defaultMaxNumBuckets,
+	}
+}
+
+func (o *options) SetRelativeAccuracy(value float64) Options {
+	opts := *o
+	opts.relativeAccuracy = value
+	return &opts
+}
+
+func (o *options) RelativeAccuracy() float64 {
+	return o.relativeAccuracy
+}
+
+func (o *options) SetMaxNumBuckets(value int) Options {
+	opts := *o
+	opts.maxNumBuckets = value
+	return &opts
+}
+
+func (o *options) MaxNumBuckets() int {
+	return o.maxNumBuckets
+}
+
+func (o *options) Validate() error {
+	if o.relativeAccuracy <= minRelativeAccuracy || o.relativeAccuracy >= maxRelativeAccuracy {
+		return errInvalidRelativeAccuracy
+	}
+	if o.maxNumBuckets <= 0 {
+		return errInvalidMaxNumBuckets
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	nan = math.NaN()
+
+	errMismatchedSketches = errors.New("sketches have different relative accuracies")
+)
+
+// Sketch is a DDSketch. A positive value v is counted in the bucket with
+// index ceil(log(v) / log(gamma)) where gamma is (1+a)/(1-a) for a relative
+// accuracy a, negative values are counted in a separate set of buckets by
+// their absolute value and values too close to zero to be indexed are
+// counted separately. Sketch APIs are not thread-safe.
+type Sketch struct {
+	relativeAccuracy  float64
+	maxNumBuckets     int
+	gamma             float64
+	multiplier        float64
+	minIndexableValue float64
+
+	positive  store
+	negative  store
+	zeroCount float64
+	count     float64
+	sum       float64
+	min       float64
+	max       float64
+}
+
+// NewSketch creates a new sketch.
+func NewSketch(opts Options) *Sketch {
+	s := &Sketch{}
+	s.setAccuracy(opts.RelativeAccuracy(), opts.MaxNumBuckets())
+	s.Reset()
+	return s
+}
+
+func (s *Sketch) setAccuracy(relativeAccuracy float64, maxNumBuckets int) {
+	s.relativeAccuracy = relativeAccuracy
+	s.maxNumBuckets = maxNumBuckets
+	s.gamma = (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	s.multiplier = 1 / math.Log(s.gamma)
+	// Values below the smallest normal float times gamma would have the value
+	// of their bucket lose precision, they are counted as zeros instead.
+	s.minIndexableValue = math.Max(
+		math.Exp(float64(math.MinInt32+1)/s.multiplier),
+		minNormalFloat64*s.gamma,
+	)
+}
+
+// minNormalFloat64 is the smallest positive normal float64.
+const minNormalFloat64 = 2.2250738585072014e-308
+
+func (s *Sketch) index(value float64) int32 {
+	return int32(math.Ceil(math.Log(value) * s.multiplier))
+}
+
+// value returns the value representing the bucket with the given index, the
+// bucket covers (gamma^(index-1), gamma^index] and the value is within the
+// relative accuracy of both bounds.
+func (s *Sketch) value(index int32) float64 {
+	return 2 * math.Exp(float64(index)/s.multiplier) / (1 + s.gamma)
+}
+
+// RelativeAccuracy returns the relative accuracy of the sketch.
+func (s *Sketch) RelativeAccuracy() float64 { return s.relativeAccuracy }
+
+// Add adds a value to the sketch, NaN and infinite values are ignored.
+func (s *Sketch) Add(value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	switch {
+	case value >= s.minIndexableValue:
+		s.positive.add(s.index(value), 1, s.maxNumBuckets)
+	case value <= -s.minIndexableValue:
+		s.negative.add(s.index(-value), 1, s.maxNumBuckets)
+	default:
+		s.zeroCount++
+	}
+	s.count++
+	s.sum += value
+	s.min = math.Min(s.min, value)
+	s.max = math.Max(s.max, value)
+}
+
+// Merge merges the other sketch into this one, both sketches must have the
+// same relative accuracy.
+func (s *Sketch) Merge(other *Sketch) error {
+	if s.relativeAccuracy != other.relativeAccuracy {
+		return errMismatchedSketches
+	}
+	if other.count == 0 {
+		return nil
+	}
+	s.positive.merge(&other.positive, s.maxNumBuckets)
+	s.negative.merge(&other.negative, s.maxNumBuckets)
+	s.zeroCount += other.zeroCount
+	s.count += other.count
+	s.sum += other.sum
+	s.min = math.Min(s.min, other.min)
+	s.max = math.Max(s.max, other.max)
+	return nil
+}
+
+// Count returns the number of values added.
+func (s *Sketch) Count() float64 { return s.count }
+
+// Sum returns the sum of the values added.
+func (s *Sketch) Sum() float64 { return s.sum }
+
+// Min returns the minimum value added.
+func (s *Sketch) Min() float64 {
+	if s.count == 0 {
+		return 0.0
+	}
+	return s.min
+}
+
+// Max returns the maximum value added.
+func (s *Sketch) Max() float64 {
+	if s.count == 0 {
+		return 0.0
+	}
+	return s.max
+}
+
+// Quantile returns the value at the given quantile, within the relative
+// accuracy of the sketch.
+func (s *Sketch) Quantile(q float64) float64 {
+	if q < 0.0 || q > 1.0 {
+		return nan
+	}
+	if s.count == 0 {
+		return 0.0
+	}
+	if q == 0.0 {
+		return s.min
+	}
+	if q == 1.0 {
+		return s.max
+	}
+
+	var (
+		rank          = q * (s.count - 1)
+		negativeCount = s.negative.total()
+		value         float64
+	)
+	switch {
+	case rank < negativeCount:
+		// The most negative values are in the highest negative buckets.
+		value = -s.value(s.negative.indexAtRank(negativeCount - 1 - rank))
+	case rank < negativeCount+s.zeroCount:
+		value = 0
+	default:
+		value = s.value(s.positive.indexAtRank(rank - negativeCount - s.zeroCount))
+	}
+	return math.Max(s.min, math.Min(s.max, value))
+}
+
+// Clone returns a deep copy of the sketch.
+func (s *Sketch) Clone() *Sketch {
+	c := *s
+	c.positive = s.positive.clone()
+	c.negative = s.negative.clone()
+	return &c
+}
+
+// Reset resets the sketch, keeping its accuracy.
+func (s *Sketch) Reset() {
+	s.positive.reset()
+	s.negative.reset()
+	s.zeroCount = 0
+	s.count = 0
+	s.sum = 0
+	s.min = math.Inf(1)
+	s.max = math.Inf(-1)
+}
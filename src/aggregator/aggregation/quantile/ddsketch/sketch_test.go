@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testQuantiles = []float64{0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999}
+
+func exactQuantile(sorted []float64, q float64) float64 {
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func requireWithinAccuracy(t *testing.T, expected, actual, accuracy float64) {
+	require.InDelta(t, expected, actual, math.Abs(expected)*accuracy+1e-12,
+		"expected %v, actual %v", expected, actual)
+}
+
+func TestSketchEmpty(t *testing.T) {
+	s := NewSketch(NewOptions())
+	require.Equal(t, 0.0, s.Count())
+	require.Equal(t, 0.0, s.Quantile(0.5))
+	require.Equal(t, 0.0, s.Min())
+	require.Equal(t, 0.0, s.Max())
+	require.True(t, math.IsNaN(s.Quantile(1.5)))
+}
+
+func TestSketchQuantiles(t *testing.T) {
+	opts := NewOptions()
+	s := NewSketch(opts)
+	rnd := rand.New(rand.NewSource(0))
+	values := make([]float64, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		v := rnd.ExpFloat64() * 100
+		if i%10 == 0 {
+			v = -v
+		}
+		if i%100 == 0 {
+			v = 0
+		}
+		values = append(values, v)
+		s.Add(v)
+	}
+	sort.Float64s(values)
+
+	require.Equal(t, float64(len(values)), s.Count())
+	require.Equal(t, values[0], s.Min())
+	require.Equal(t, values[len(values)-1], s.Max())
+	require.Equal(t, values[0], s.Quantile(0))
+	require.Equal(t, values[len(values)-1], s.Quantile(1))
+	for _, q := range testQuantiles {
+		requireWithinAccuracy(t, exactQuantile(values, q), s.Quantile(q), opts.RelativeAccuracy())
+	}
+}
+
+func TestSketchIgnoresNonFiniteValues(t *testing.T) {
+	s := NewSketch(NewOptions())
+	s.Add(math.NaN())
+	s.Add(math.Inf(1))
+	s.Add(math.Inf(-1))
+	require.Equal(t, 0.0, s.Count())
+}
+
+func TestSketchMerge(t *testing.T) {
+	opts := NewOptions().SetRelativeAccuracy(0.02)
+	var (
+		merged = NewSketch(opts)
+		single = NewSketch(opts)
+		values []float64
+	)
+	for i := 0; i < 4; i++ {
+		s := NewSketch(opts)
+		for j := 1; j <= 1000; j++ {
+			v := float64(i*1000 + j)
+			s.Add(v)
+			single.Add(v)
+			values = append(values, v)
+		}
+		require.NoError(t, merged.Merge(s))
+	}
+
+	require.Equal(t, single.Count(), merged.Count())
+	require.Equal(t, single.Sum(), merged.Sum())
+	require.Equal(t, single.Min(), merged.Min())
+	require.Equal(t, single.Max(), merged.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, single.Quantile(q), merged.Quantile(q))
+		requireWithinAccuracy(t, exactQuantile(values, q), merged.Quantile(q), 0.02)
+	}
+
+	other := NewSketch(NewOptions().SetRelativeAccuracy(0.05))
+	require.Equal(t, errMismatchedSketches, merged.Merge(other))
+}
+
+func TestSketchCollapsesLowestBuckets(t *testing.T) {
+	opts := NewOptions().SetMaxNumBuckets(64)
+	s := NewSketch(opts)
+	for v := 1.0; v < 1e9; v *= 1.01 {
+		s.Add(v)
+	}
+	require.Len(t, s.positive.counts, 64)
+	// The highest quantiles keep their accuracy.
+	requireWithinAccuracy(t, 1e9, s.Quantile(0.9999), 0.05)
+	require.Equal(t, s.count, s.positive.total())
+}
+
+func TestSketchMarshalRoundTrip(t *testing.T) {
+	s := NewSketch(NewOptions().SetRelativeAccuracy(0.005).SetMaxNumBuckets(512))
+	for i := -100; i <= 1000; i++ {
+		s.Add(float64(i) * 1.5)
+	}
+
+	data := s.Marshal(nil)
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, s, decoded)
+
+	// Decoding into an existing sketch takes the decoded accuracy.
+	existing := NewSketch(NewOptions())
+	existing.Add(42)
+	require.NoError(t, existing.Unmarshal(data))
+	require.Equal(t, 0.005, existing.RelativeAccuracy())
+	for _, q := range testQuantiles {
+		require.Equal(t, s.Quantile(q), existing.Quantile(q))
+	}
+}
+
+func TestSketchUnmarshalErrors(t *testing.T) {
+	s := NewSketch(NewOptions())
+	s.Add(1)
+	data := s.Marshal(nil)
+
+	for i := 0; i < len(data); i++ {
+		_, err := Unmarshal(data[:i])
+		require.Error(t, err)
+	}
+
+	bad := append([]byte(nil), data...)
+	bad[0] = marshalVersion + 1
+	_, err := Unmarshal(bad)
+	require.Equal(t, errUnmarshalVersion, err)
+
+	invalid := NewSketch(NewOptions())
+	invalid.relativeAccuracy = 2
+	_, err = Unmarshal(invalid.Marshal(nil))
+	require.Equal(t, errUnmarshalInvalid, err)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	require.NoError(t, NewOptions().Validate())
+	require.Error(t, NewOptions().SetRelativeAccuracy(0).Validate())
+	require.Error(t, NewOptions().SetRelativeAccuracy(1).Validate())
+	require.Error(t, NewOptions().SetMaxNumBuckets(0).Validate())
+}
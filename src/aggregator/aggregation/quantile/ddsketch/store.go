@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+// store is a dense store of bucket counts, counts[i] is the count of the
+// bucket with index offset+i. When the store spans more than the maximum
+// number of buckets, the lowest buckets are collapsed into the lowest one
+// kept, which trades the accuracy of the lowest quantiles for bounded memory.
+type store struct {
+	offset int32
+	counts []float64
+}
+
+func (s *store) empty() bool {
+	return len(s.counts) == 0
+}
+
+func (s *store) minIndex() int32 {
+	return s.offset
+}
+
+func (s *store) maxIndex() int32 {
+	return s.offset + int32(len(s.counts)) - 1
+}
+
+func (s *store) total() float64 {
+	var total float64
+	for _, count := range s.counts {
+		total += count
+	}
+	return total
+}
+
+func (s *store) add(index int32, count float64, maxNumBuckets int) {
+	lo, hi := index, index
+	if !s.empty() {
+		lo, hi = minInt32(lo, s.minIndex()), maxInt32(hi, s.maxIndex())
+	}
+	lo = s.resize(lo, hi, maxNumBuckets)
+	if index < lo {
+		index = lo
+	}
+	s.counts[index-s.offset] += count
+}
+
+func (s *store) merge(other *store, maxNumBuckets int) {
+	if other.empty() {
+		return
+	}
+	lo, hi := other.minIndex(), other.maxIndex()
+	if !s.empty() {
+		lo, hi = minInt32(lo, s.minIndex()), maxInt32(hi, s.maxIndex())
+	}
+	lo = s.resize(lo, hi, maxNumBuckets)
+	for i, count := range other.counts {
+		index := other.offset + int32(i)
+		if index < lo {
+			index = lo
+		}
+		s.counts[index-s.offset] += count
+	}
+}
+
+// resize resizes the store to span the [lo, hi] indexes, collapsing the
+// lowest buckets if they span more than the maximum number of buckets, and
+// returns the lowest index kept.
+func (s *store) resize(lo, hi int32, maxNumBuckets int) int32 {
+	if int64(hi)-int64(lo)+1 > int64(maxNumBuckets) {
+		lo = hi - int32(maxNumBuckets) + 1
+	}
+	if !s.empty() && lo == s.minIndex() && hi == s.maxIndex() {
+		return lo
+	}
+	if s.empty() || lo == s.minIndex() {
+		// Growing upwards only, extend the existing counts in place.
+		for n := int(hi-lo) + 1 - len(s.counts); n > 0; n-- {
+			s.counts = append(s.counts, 0)
+		}
+		s.offset = lo
+		return lo
+	}
+
+	counts := make([]float64, int(hi-lo)+1)
+	for i, count := range s.counts {
+		index := s.offset + int32(i)
+		if index < lo {
+			index = lo
+		}
+		counts[index-lo] += count
+	}
+	s.offset = lo
+	s.counts = counts
+	return lo
+}
+
+// indexAtRank returns the index of the bucket holding the value with the
+// given rank, ranks start at zero from the lowest index.
+func (s *store) indexAtRank(rank float64) int32 {
+	var n float64
+	for i, count := range s.counts {
+		n += count
+		if n > rank {
+			return s.offset + int32(i)
+		}
+	}
+	return s.maxIndex()
+}
+
+func (s *store) reset() {
+	s.offset = 0
+	s.counts = s.counts[:0]
+}
+
+func (s *store) clone() store {
+	return store{
+		offset: s.offset,
+		counts: append([]float64(nil), s.counts...),
+	}
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+)
+
+var errStateTruncated = errors.New("aggregation state is truncated")
+
+// StateEncoder encodes the state of aggregations so they can be checkpointed
+// and restored later on.
+type StateEncoder struct {
+	buf []byte
+}
+
+// NewStateEncoder creates a new state encoder.
+func NewStateEncoder() *StateEncoder {
+	return &StateEncoder{}
+}
+
+// Reset resets the encoder, discarding the encoded state.
+func (e *StateEncoder) Reset() { e.buf = e.buf[:0] }
+
+// Bytes returns the encoded state, the bytes are only valid until the
+// encoder is reset.
+func (e *StateEncoder) Bytes() []byte { return e.buf }
+
+// EncodeVarint encodes a signed integer.
+func (e *StateEncoder) EncodeVarint(v int64) { e.buf = binary.AppendVarint(e.buf, v) }
+
+// EncodeUvarint encodes an unsigned integer.
+func (e *StateEncoder) EncodeUvarint(v uint64) { e.buf = binary.AppendUvarint(e.buf, v) }
+
+// EncodeFloat64 encodes a float.
+func (e *StateEncoder) EncodeFloat64(v float64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+// EncodeBool encodes a boolean.
+func (e *StateEncoder) EncodeBool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+// EncodeBytes encodes a byte slice.
+func (e *StateEncoder) EncodeBytes(v []byte) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// EncodeTime encodes a time, the zero time is preserved.
+func (e *StateEncoder) EncodeTime(v time.Time) {
+	if v.IsZero() {
+		e.EncodeVarint(0)
+		return
+	}
+	e.EncodeVarint(v.UnixNano())
+}
+
+// StateDecoder decodes the state encoded by a StateEncoder. Decoding errors
+// are sticky, once an error is encountered all subsequent values decode to
+// their zero value and the error is returned by Err.
+type StateDecoder struct {
+	data []byte
+	err  error
+}
+
+// NewStateDecoder creates a new state decoder.
+func NewStateDecoder(data []byte) *StateDecoder {
+	return &StateDecoder{data: data}
+}
+
+// Reset resets the decoder to decode the given data.
+func (d *StateDecoder) Reset(data []byte) {
+	d.data = data
+	d.err = nil
+}
+
+// Err returns the first error encountered while decoding.
+func (d *StateDecoder) Err() error { return d.err }
+
+// Remaining returns the number of bytes left to decode.
+func (d *StateDecoder) Remaining() int { return len(d.data) }
+
+// DecodeVarint decodes a signed integer.
+func (d *StateDecoder) DecodeVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errStateTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// DecodeUvarint decodes an unsigned integer.
+func (d *StateDecoder) DecodeUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errStateTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// DecodeFloat64 decodes a float.
+func (d *StateDecoder) DecodeFloat64() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 8 {
+		d.err = errStateTruncated
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[8:]
+	return v
+}
+
+// DecodeBool decodes a boolean.
+func (d *StateDecoder) DecodeBool() bool {
+	if d.err != nil {
+		return false
+	}
+	if len(d.data) < 1 {
+		d.err = errStateTruncated
+		return false
+	}
+	v := d.data[0] != 0
+	d.data = d.data[1:]
+	return v
+}
+
+// DecodeBytes decodes a byte slice, the returned slice is a copy that does
+// not reference the decoded data.
+func (d *StateDecoder) DecodeBytes() []byte {
+	n := d.DecodeUvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.data)) {
+		d.err = errStateTruncated
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	v := make([]byte, n)
+	copy(v, d.data)
+	d.data = d.data[n:]
+	return v
+}
+
+// DecodeTime decodes a time.
+func (d *StateDecoder) DecodeTime() time.Time {
+	nanos := d.DecodeVarint()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
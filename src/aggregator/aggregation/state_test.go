@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/x/histogram"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func TestCounterStateRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.HasExpensiveAggregations = true
+	c := NewCounter(opts)
+	for i := 1; i <= 100; i++ {
+		c.Update(time.Unix(int64(i), 0), int64(i), []byte("foo"))
+	}
+
+	enc := NewStateEncoder()
+	c.EncodeState(enc)
+	restored := NewCounter(opts)
+	require.NoError(t, restored.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, c, restored)
+
+	// The restored counter keeps aggregating.
+	restored.Update(time.Unix(101, 0), 101, nil)
+	require.Equal(t, 5151.0, restored.ValueOf(aggregation.Sum))
+	require.Equal(t, 101.0, restored.ValueOf(aggregation.Max))
+}
+
+func TestGaugeStateRoundTrip(t *testing.T) {
+	g := NewGauge(NewOptions(instrument.NewOptions()))
+	for i := 1; i <= 100; i++ {
+		g.Update(time.Unix(int64(i), 0), float64(i), nil)
+	}
+
+	enc := NewStateEncoder()
+	g.EncodeState(enc)
+	restored := NewGauge(NewOptions(instrument.NewOptions()))
+	require.NoError(t, restored.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, g, restored)
+}
+
+func TestTimerStateRoundTrip(t *testing.T) {
+	streamOpts := cm.NewOptions()
+	timer := NewTimer(testQuantiles, streamOpts, NewOptions(instrument.NewOptions()))
+	for i := 1; i <= 1000; i++ {
+		timer.Add(time.Unix(int64(i), 0), float64(i), nil)
+	}
+
+	enc := NewStateEncoder()
+	timer.EncodeState(enc)
+	restored := NewTimer(testQuantiles, streamOpts, NewOptions(instrument.NewOptions()))
+	require.NoError(t, restored.DecodeState(NewStateDecoder(enc.Bytes())))
+
+	require.Equal(t, timer.LastAt(), restored.LastAt())
+	for _, aggType := range []aggregation.Type{
+		aggregation.Min, aggregation.Max, aggregation.Count, aggregation.Sum,
+		aggregation.P50, aggregation.P95, aggregation.P99,
+	} {
+		require.Equal(t, timer.ValueOf(aggType), restored.ValueOf(aggType), aggType.String())
+	}
+}
+
+func TestSketchTimerStateRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.TimerSketch = ddsketch.NewOptions()
+	timer := NewTimer(testQuantiles, cm.NewOptions(), opts)
+	for i := 1; i <= 1000; i++ {
+		timer.Add(time.Unix(int64(i), 0), float64(i), nil)
+	}
+
+	enc := NewStateEncoder()
+	timer.EncodeState(enc)
+	restored := NewTimer(testQuantiles, cm.NewOptions(), opts)
+	require.NoError(t, restored.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, timer, restored)
+}
+
+func TestCountDistinctStateRoundTrip(t *testing.T) {
+	opts := testDistinctOptions()
+	counter := NewCounter(opts)
+	gauge := NewGauge(opts)
+	timer := NewTimer(testQuantiles, cm.NewOptions(), opts)
+	for i := 0; i < 100; i++ {
+		data := testDistinctAnnotation(t, fmt.Sprintf("value%d", i))
+		counter.Update(time.Unix(int64(i), 0), int64(i), data)
+		gauge.Update(time.Unix(int64(i), 0), float64(i), data)
+		timer.Add(time.Unix(int64(i), 0), float64(i), data)
+	}
+
+	enc := NewStateEncoder()
+	counter.EncodeState(enc)
+	restoredCounter := NewCounter(opts)
+	require.NoError(t, restoredCounter.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, counter, restoredCounter)
+
+	enc = NewStateEncoder()
+	gauge.EncodeState(enc)
+	restoredGauge := NewGauge(opts)
+	require.NoError(t, restoredGauge.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, gauge, restoredGauge)
+
+	enc = NewStateEncoder()
+	timer.EncodeState(enc)
+	restoredTimer := NewTimer(testQuantiles, cm.NewOptions(), opts)
+	require.NoError(t, restoredTimer.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, 100.0, restoredTimer.ValueOf(aggregation.CountDistinct))
+}
+
+func TestHistogramStateRoundTrip(t *testing.T) {
+	var (
+		now   = time.Now()
+		value = &histogram.Histogram{Count: 3, Sum: 5, ZeroCount: 1,
+			PositiveSpans: []histogram.Span{{Offset: 1, Length: 1}}, PositiveBuckets: []float64{2}}
+		h = NewHistogram(NewOptions(instrument.NewOptions()), true)
+	)
+	h.Update(now, testHistogramAnnotation(t, value))
+
+	enc := NewStateEncoder()
+	h.EncodeState(enc)
+	restored := NewHistogram(NewOptions(instrument.NewOptions()), true)
+	require.NoError(t, restored.DecodeState(NewStateDecoder(enc.Bytes())))
+	require.Equal(t, h.Count(), restored.Count())
+	require.True(t, value.Equal(restored.Last()))
+	require.True(t, value.Equal(restored.Sum()))
+	require.Equal(t, h.Annotation(), restored.Annotation())
+}
+
+func TestStateDecoderTruncated(t *testing.T) {
+	c := NewCounter(NewOptions(instrument.NewOptions()))
+	c.Update(time.Now(), 1, []byte("foo"))
+
+	enc := NewStateEncoder()
+	c.EncodeState(enc)
+	data := enc.Bytes()
+	require.Error(t, c.DecodeState(NewStateDecoder(data[:len(data)-1])))
+}
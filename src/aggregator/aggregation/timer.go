@@ -23,29 +23,48 @@ package aggregation
 import (
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/aggregation"
 )
 
 // Timer aggregates timer values. Timer APIs are not thread-safe.
+//
+// The quantiles of a timer are computed either with a CM stream, or with a
+// DDSketch when sketch options are set. Sketches are returned as part of the
+// annotation of the aggregation so that they can be merged by the next stage
+// of a pipeline and queried after the fact.
 type Timer struct {
 	lastAt                   time.Time
-	stream                   *cm.Stream // Stream of values received.
+	stream                   *cm.Stream       // Stream of values received.
+	sketch                   *ddsketch.Sketch // Sketch of values received.
+	distinct                 *hll.Sketch      // Sketch of the distinct values counted.
 	annotation               []byte
 	count                    int64   // Number of values received.
 	sum                      float64 // Sum of the values.
 	sumSq                    float64 // Sum of squared values.
 	hasExpensiveAggregations bool
+	metrics                  TimerMetrics
+	distinctMetrics          DistinctMetrics
 }
 
 // NewTimer creates a new timer
 func NewTimer(quantiles []float64, streamOpts cm.Options, opts Options) Timer {
-	stream := streamOpts.StreamPool().Get()
-	stream.ResetSetData(quantiles)
-	return Timer{
+	t := Timer{
 		hasExpensiveAggregations: opts.HasExpensiveAggregations,
-		stream:                   stream,
+		metrics:                  opts.Metrics.Timer,
+		distinct:                 newDistinctSketch(opts),
+		distinctMetrics:          opts.Metrics.Distinct,
+	}
+	if opts.TimerSketch != nil {
+		t.sketch = ddsketch.NewSketch(opts.TimerSketch)
+		return t
 	}
+	t.stream = streamOpts.StreamPool().Get()
+	t.stream.ResetSetData(quantiles)
+	return t
 }
 
 // Add adds a timer value.
@@ -70,11 +89,61 @@ func (t *Timer) AddBatch(timestamp time.Time, values []float64, annotation []byt
 		}
 	}
 
-	t.stream.AddBatch(values)
+	if t.sketch != nil {
+		for _, v := range values {
+			t.sketch.Add(v)
+		}
+	} else {
+		t.stream.AddBatch(values)
+	}
 
+	updateDistinctSketch(t.distinct, t.annotation, annotation, t.distinctMetrics)
 	t.annotation = MaybeReplaceAnnotation(t.annotation, annotation)
 }
 
+// AddForwarded adds the values of a timer forwarded by the previous stage of
+// a pipeline. If both timers are backed by sketches, the sketch carried in the
+// annotation is merged in place of the values so that quantiles are computed
+// over all the values of the previous stage. Otherwise the values are added and
+// the sketch, if any, is dropped from the annotation.
+//
+// NB: the sum of squared values is not carried by sketches, it only accounts
+// for the values added when sketches are merged.
+func (t *Timer) AddForwarded(timestamp time.Time, values []float64, annotationBytes []byte) {
+	var payload annotation.Payload
+	if len(annotationBytes) == 0 || payload.Unmarshal(annotationBytes) != nil ||
+		len(payload.TimerSketch) == 0 {
+		t.AddBatch(timestamp, values, annotationBytes)
+		return
+	}
+
+	if t.sketch == nil {
+		payload.TimerSketch = nil
+		stripped, err := payload.Marshal()
+		if err != nil {
+			stripped = nil
+		}
+		t.AddBatch(timestamp, values, stripped)
+		return
+	}
+
+	sketch, err := ddsketch.Unmarshal(payload.TimerSketch)
+	if err == nil {
+		err = t.sketch.Merge(sketch)
+	}
+	if err != nil {
+		t.metrics.IncInvalidSketches()
+		t.AddBatch(timestamp, values, annotationBytes)
+		return
+	}
+
+	t.recordLastAt(timestamp)
+	t.count += int64(sketch.Count())
+	t.sum += sketch.Sum()
+	updateDistinctSketch(t.distinct, t.annotation, annotationBytes, t.distinctMetrics)
+	t.annotation = MaybeReplaceAnnotation(t.annotation, annotationBytes)
+}
+
 func (t *Timer) recordLastAt(timestamp time.Time) {
 	if t.lastAt.IsZero() || timestamp.After(t.lastAt) {
 		// NB(r): Only set the last value if this value arrives
@@ -89,6 +158,9 @@ func (t *Timer) LastAt() time.Time { return t.lastAt }
 
 // Quantile returns the value at a given quantile.
 func (t *Timer) Quantile(q float64) float64 {
+	if t.sketch != nil {
+		return t.sketch.Quantile(q)
+	}
 	t.stream.Flush()
 	return t.stream.Quantile(q)
 }
@@ -98,12 +170,18 @@ func (t *Timer) Count() int64 { return t.count }
 
 // Min returns the minimum timer value.
 func (t *Timer) Min() float64 {
+	if t.sketch != nil {
+		return t.sketch.Min()
+	}
 	t.stream.Flush()
 	return t.stream.Min()
 }
 
 // Max returns the maximum timer value.
 func (t *Timer) Max() float64 {
+	if t.sketch != nil {
+		return t.sketch.Max()
+	}
 	t.stream.Flush()
 	return t.stream.Max()
 }
@@ -148,16 +226,139 @@ func (t *Timer) ValueOf(aggType aggregation.Type) float64 {
 		return t.SumSq()
 	case aggregation.Stdev:
 		return t.Stdev()
+	case aggregation.CountDistinct:
+		return distinctEstimate(t.distinct)
 	}
 	return 0
 }
 
-// Annotation returns the annotation associated with the timer.
+// StripTimerSketch returns the annotation without the timer sketch it may
+// carry.
+func StripTimerSketch(annotationBytes []byte) []byte {
+	var payload annotation.Payload
+	if len(annotationBytes) == 0 || payload.Unmarshal(annotationBytes) != nil ||
+		len(payload.TimerSketch) == 0 {
+		return annotationBytes
+	}
+	payload.TimerSketch = nil
+	result, err := payload.Marshal()
+	if err != nil {
+		return annotationBytes
+	}
+	return result
+}
+
+// TimerSketchAggregationType returns the aggregation type whose flushed values
+// carry the sketch of a timer, so that the sketch is only stored once: the
+// first quantile aggregation type, or the first aggregation type if the timer
+// has no quantile aggregation type.
+func TimerSketchAggregationType(aggTypes aggregation.Types) aggregation.Type {
+	for _, aggType := range aggTypes {
+		if _, ok := aggType.Quantile(); ok {
+			return aggType
+		}
+	}
+	if len(aggTypes) == 0 {
+		return aggregation.UnknownType
+	}
+	return aggTypes[0]
+}
+
+// Sketch returns the sketch of the timer values, or nil if the timer is not
+// backed by a sketch.
+func (t *Timer) Sketch() *ddsketch.Sketch { return t.sketch }
+
+// Annotation returns the annotation associated with the timer, along with the
+// sketch of the timer values if the timer is backed by a sketch and the sketch
+// of the distinct values counted if any.
 func (t *Timer) Annotation() []byte {
-	return t.annotation
+	if t.sketch == nil {
+		return annotationWithDistinctSketch(t.annotation, t.distinct)
+	}
+
+	var payload annotation.Payload
+	if err := payload.Unmarshal(t.annotation); err != nil {
+		return t.annotation
+	}
+	payload.TimerSketch = t.sketch.Marshal(payload.TimerSketch[:0])
+	if t.distinct != nil {
+		payload.DistinctSketch = t.distinct.Marshal(payload.DistinctSketch[:0])
+	}
+	result, err := payload.Marshal()
+	if err != nil {
+		return t.annotation
+	}
+	return result
+}
+
+// EncodeState encodes the state of the timer, the values buffered in the
+// stream are merged into its samples first.
+func (t *Timer) EncodeState(enc *StateEncoder) {
+	enc.EncodeTime(t.lastAt)
+	enc.EncodeBytes(t.annotation)
+	enc.EncodeVarint(t.count)
+	enc.EncodeFloat64(t.sum)
+	enc.EncodeFloat64(t.sumSq)
+	var (
+		sketch  []byte
+		samples []cm.SampleState
+	)
+	if t.sketch != nil {
+		sketch = t.sketch.Marshal(nil)
+	} else {
+		samples = t.stream.Samples(nil)
+	}
+	enc.EncodeBytes(sketch)
+	enc.EncodeUvarint(uint64(len(samples)))
+	for _, sample := range samples {
+		enc.EncodeFloat64(sample.Value)
+		enc.EncodeVarint(sample.NumRanks)
+		enc.EncodeVarint(sample.Delta)
+	}
+	encodeDistinctSketch(enc, t.distinct)
+}
+
+// DecodeState restores the state of the timer encoded by EncodeState. The
+// quantiles of a timer whose state was encoded with a different backend than
+// its own are only computed over the values added after it is restored.
+func (t *Timer) DecodeState(dec *StateDecoder) error {
+	t.lastAt = dec.DecodeTime()
+	t.annotation = dec.DecodeBytes()
+	t.count = dec.DecodeVarint()
+	t.sum = dec.DecodeFloat64()
+	t.sumSq = dec.DecodeFloat64()
+	sketch := dec.DecodeBytes()
+	numSamples := dec.DecodeUvarint()
+	if numSamples > uint64(dec.Remaining()) {
+		// Each sample takes at least ten bytes, guard against allocating
+		// based on a corrupt length.
+		return errStateTruncated
+	}
+	samples := make([]cm.SampleState, 0, numSamples)
+	for i := uint64(0); i < numSamples; i++ {
+		samples = append(samples, cm.SampleState{
+			Value:    dec.DecodeFloat64(),
+			NumRanks: dec.DecodeVarint(),
+			Delta:    dec.DecodeVarint(),
+		})
+	}
+	if err := decodeDistinctSketch(dec, t.distinct); err != nil {
+		return err
+	}
+	if t.sketch != nil {
+		if len(sketch) == 0 {
+			return nil
+		}
+		return t.sketch.Unmarshal(sketch)
+	}
+	t.stream.SetSamples(samples)
+	return nil
 }
 
 // Close closes the timer.
 func (t *Timer) Close() {
-	t.stream.Close()
+	if t.stream != nil {
+		t.stream.Close()
+	}
+	t.sketch = nil
 }
@@ -30,6 +30,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
@@ -220,3 +222,103 @@ func TestTimerReturnsLastNonEmptyAnnotation(t *testing.T) {
 
 	require.Equal(t, []byte("second"), timer.Annotation())
 }
+
+func testSketchTimerOptions() Options {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+	opts.TimerSketch = ddsketch.NewOptions()
+	return opts
+}
+
+func TestSketchTimerAggregations(t *testing.T) {
+	timer := NewTimer(testQuantiles, testStreamOptions(), testSketchTimerOptions())
+	require.Nil(t, timer.stream)
+	require.Equal(t, 0.0, timer.Quantile(0.5))
+	require.Equal(t, 0.0, timer.Min())
+
+	at := time.Now()
+	for i := 1; i <= 100; i++ {
+		timer.Add(at, float64(i), nil)
+	}
+
+	require.Equal(t, int64(100), timer.Count())
+	require.Equal(t, 5050.0, timer.Sum())
+	require.Equal(t, 338350.0, timer.SumSq())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 100.0, timer.Max())
+	require.InDelta(t, 50.0, timer.ValueOf(aggregation.P50), 0.5)
+	require.InDelta(t, 95.0, timer.ValueOf(aggregation.P95), 0.95)
+	require.InDelta(t, 99.0, timer.ValueOf(aggregation.P99), 0.99)
+
+	timer.Close()
+	require.Nil(t, timer.Sketch())
+}
+
+func TestSketchTimerAnnotationCarriesSketch(t *testing.T) {
+	timer := NewTimer(testQuantiles, testStreamOptions(), testSketchTimerOptions())
+	source, err := (&annotation.Payload{GraphiteType: annotation.GraphiteType_GRAPHITE_TIMER}).Marshal()
+	require.NoError(t, err)
+	for i := 1; i <= 10; i++ {
+		timer.Add(time.Now(), float64(i), source)
+	}
+
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(timer.Annotation()))
+	require.Equal(t, annotation.GraphiteType_GRAPHITE_TIMER, payload.GraphiteType)
+	sketch, err := ddsketch.Unmarshal(payload.TimerSketch)
+	require.NoError(t, err)
+	require.Equal(t, timer.Sketch(), sketch)
+
+	stripped := StripTimerSketch(timer.Annotation())
+	require.Equal(t, source, stripped)
+}
+
+func TestTimerSketchAggregationType(t *testing.T) {
+	require.Equal(t, aggregation.P95, TimerSketchAggregationType(
+		aggregation.Types{aggregation.Sum, aggregation.P95, aggregation.P99}))
+	require.Equal(t, aggregation.Sum, TimerSketchAggregationType(
+		aggregation.Types{aggregation.Sum, aggregation.Max}))
+	require.Equal(t, aggregation.UnknownType, TimerSketchAggregationType(nil))
+}
+
+func TestSketchTimerAddForwardedMergesSketches(t *testing.T) {
+	var (
+		opts   = testSketchTimerOptions()
+		merged = NewTimer(testQuantiles, testStreamOptions(), opts)
+		at     = time.Now()
+	)
+	for i := 0; i < 4; i++ {
+		source := NewTimer(testQuantiles, testStreamOptions(), opts)
+		for j := 1; j <= 100; j++ {
+			source.Add(at, float64(i*100+j), nil)
+		}
+		// The forwarded values are ignored in favor of the sketch.
+		merged.AddForwarded(at, []float64{source.Quantile(0.99)}, source.Annotation())
+	}
+
+	require.Equal(t, int64(400), merged.Count())
+	require.Equal(t, 80200.0, merged.Sum())
+	require.Equal(t, 1.0, merged.Min())
+	require.Equal(t, 400.0, merged.Max())
+	require.InDelta(t, 200.0, merged.Quantile(0.5), 2)
+	require.InDelta(t, 396.0, merged.Quantile(0.99), 4)
+
+	// Values forwarded without a sketch are added.
+	merged.AddForwarded(at, []float64{1000}, nil)
+	require.Equal(t, int64(401), merged.Count())
+	require.Equal(t, 1000.0, merged.Max())
+}
+
+func TestTimerAddForwardedDropsSketch(t *testing.T) {
+	source := NewTimer(testQuantiles, testStreamOptions(), testSketchTimerOptions())
+	source.Add(time.Now(), 1, nil)
+
+	timer := NewTimer(testQuantiles, testStreamOptions(), NewOptions(instrument.NewOptions()))
+	timer.AddForwarded(time.Now(), []float64{2, 3}, source.Annotation())
+	require.Equal(t, int64(2), timer.Count())
+	require.Equal(t, 2.0, timer.Min())
+
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(timer.Annotation()))
+	require.Empty(t, payload.TimerSketch)
+}
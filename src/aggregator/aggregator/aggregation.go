@@ -41,6 +41,12 @@ func (a *counterAggregation) Add(t time.Time, value float64, annotation []byte)
 	a.Counter.Update(t, int64(value), annotation)
 }
 
+func (a *counterAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) {
+	for _, v := range values {
+		a.Add(t, v, annotation)
+	}
+}
+
 func (a *counterAggregation) UpdateVal(t time.Time, value float64, prevValue float64) error {
 	return errors.New("counters do not support updating values")
 }
@@ -62,6 +68,10 @@ func (a *timerAggregation) Add(timestamp time.Time, value float64, annotation []
 	a.Timer.Add(timestamp, value, annotation)
 }
 
+func (a *timerAggregation) AddForwarded(timestamp time.Time, values []float64, annotation []byte) {
+	a.Timer.AddForwarded(timestamp, values, annotation)
+}
+
 func (a *timerAggregation) UpdateVal(t time.Time, value float64, prevValue float64) error {
 	return errors.New("timers do not support updating values")
 }
@@ -83,6 +93,12 @@ func (a *gaugeAggregation) Add(t time.Time, value float64, annotation []byte) {
 	a.Gauge.Update(t, value, annotation)
 }
 
+func (a *gaugeAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) {
+	for _, v := range values {
+		a.Add(t, v, annotation)
+	}
+}
+
 func (a *gaugeAggregation) UpdateVal(t time.Time, value float64, prevValue float64) error {
 	a.Gauge.UpdatePrevious(t, value, prevValue)
 	return nil
@@ -91,3 +107,31 @@ func (a *gaugeAggregation) UpdateVal(t time.Time, value float64, prevValue float
 func (a *gaugeAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion) {
 	a.Gauge.Update(t, mu.GaugeVal, mu.Annotation)
 }
+
+// histogramAggregation is a native histogram aggregation, the histogram of
+// each value is carried in its annotation.
+type histogramAggregation struct {
+	aggregation.Histogram
+}
+
+func newHistogramAggregation(h aggregation.Histogram) histogramAggregation {
+	return histogramAggregation{Histogram: h}
+}
+
+func (a *histogramAggregation) Add(t time.Time, _ float64, annotation []byte) {
+	a.Histogram.Update(t, annotation)
+}
+
+func (a *histogramAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) {
+	for _, v := range values {
+		a.Add(t, v, annotation)
+	}
+}
+
+func (a *histogramAggregation) UpdateVal(t time.Time, value float64, prevValue float64) error {
+	return errors.New("histograms do not support updating values")
+}
+
+func (a *histogramAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion) {
+	a.Histogram.Update(t, mu.Annotation)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/m3db/m3/src/aggregator/sharding"
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/cluster/shard"
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
@@ -89,6 +91,10 @@ type Aggregator interface {
 	// Status returns the run-time status of the aggregator.
 	Status() RuntimeStatus
 
+	// Inspect returns the state of the aggregation elements selected by the
+	// query.
+	Inspect(query InspectQuery) (InspectResult, error)
+
 	// Close closes the aggregator.
 	Close() error
 }
@@ -111,6 +117,13 @@ type aggregator struct {
 	passthroughWriter writer.Writer
 	adminClient       client.AdminClient
 	resignTimeout     time.Duration
+	checkpointOpts    CheckpointOptions
+
+	// checkpointLock serializes checkpoints and the removal of the checkpoints
+	// of closed shards.
+	checkpointLock       sync.Mutex
+	checkpointCompressor maggregation.IDCompressor
+	checkpointDoneCh     chan struct{}
 
 	shardSetID         uint32
 	shardSetOpen       bool
@@ -146,9 +159,13 @@ func NewAggregator(opts Options) Aggregator {
 		passthroughWriter: opts.PassthroughWriter(),
 		adminClient:       opts.AdminClient(),
 		resignTimeout:     opts.ResignTimeout(),
+		checkpointOpts:    opts.CheckpointOptions(),
 		sleepFn:           time.Sleep,
 		metrics:           newAggregatorMetrics(scope, timerOpts, opts.MaxAllowedForwardingDelayFn()),
 		logger:            logger,
+
+		checkpointCompressor: maggregation.NewIDCompressor(),
+		checkpointDoneCh:     make(chan struct{}),
 	}
 
 	return agg
@@ -184,6 +201,9 @@ func (agg *aggregator) Open() error {
 	// closed, it's fine to ignore the result of the placement update, as applying
 	// the change only affects the current aggregator that is being closed anyway.
 	go agg.placementTick()
+	if agg.checkpointOpts.Enabled() {
+		go agg.checkpointLoop()
+	}
 	agg.state = aggregatorOpen
 	return nil
 }
@@ -377,6 +397,51 @@ func (agg *aggregator) Status() RuntimeStatus {
 	}
 }
 
+func (agg *aggregator) Inspect(query InspectQuery) (InspectResult, error) {
+	agg.RLock()
+	if agg.state != aggregatorOpen {
+		agg.RUnlock()
+		return InspectResult{}, errAggregatorNotOpenOrClosed
+	}
+	shards := agg.shardsWithLock()
+	agg.RUnlock()
+
+	numShards := uint32(agg.currNumShards.Load())
+	insp := newElemInspector(query, func(metricID id.RawID) uint32 {
+		if numShards == 0 {
+			return 0
+		}
+		return agg.shardFn(metricID, numShards)
+	})
+	if len(query.IDs) == 0 {
+		for _, shard := range shards {
+			if insp.Done() {
+				break
+			}
+			shard.InspectEntries(insp)
+		}
+		return insp.Result(), nil
+	}
+
+	seen := make(map[string]struct{}, len(query.IDs))
+	for _, metricID := range query.IDs {
+		if _, ok := seen[string(metricID)]; ok {
+			continue
+		}
+		seen[string(metricID)] = struct{}{}
+		if insp.Done() {
+			break
+		}
+		// NB: the metrics of the shards that are not owned are not inspected.
+		shard, err := agg.shardFor(metricID)
+		if err != nil {
+			continue
+		}
+		shard.InspectID(metricID, insp)
+	}
+	return insp.Result(), nil
+}
+
 func (agg *aggregator) Close() error {
 	agg.Lock()
 	defer agg.Unlock()
@@ -391,7 +456,15 @@ func (agg *aggregator) Close() error {
 	// currently running flush completes, and updates the shared shard flush
 	// times map in etcd, allowing the follower that will be promoted to leader
 	// to avoid re-computing and re-flushing this data.
-	return agg.flushManager.Close()
+	err := agg.flushManager.Close()
+
+	// NB: the final checkpoint is taken once the flush manager is closed so
+	// the aggregations that have not been flushed yet can be restored on start.
+	if agg.checkpointOpts.Enabled() {
+		close(agg.checkpointDoneCh)
+		agg.checkpointShards(agg.shardsWithLock())
+	}
+	return err
 }
 
 func (agg *aggregator) shardFor(id id.RawID) (*aggregatorShard, error) {
@@ -608,6 +681,10 @@ func (agg *aggregator) updateShardsWithLock(
 		} else {
 			incoming[shardID] = newAggregatorShard(shardID, agg.opts)
 			agg.metrics.shards.add.Inc(1)
+			if agg.checkpointOpts.Enabled() {
+				// NB: new shards are restored before any write is routed to them.
+				agg.restoreShard(incoming[shardID])
+			}
 		}
 
 		incoming[shardID].SetRedirectToShardID(shard.RedirectToShardID())
@@ -640,6 +717,9 @@ func (agg *aggregator) checkMetricType(mu unaggregated.MetricUnion) error {
 	case metric.GaugeType:
 		agg.metrics.gauges.Inc(1)
 		return nil
+	case metric.HistogramType:
+		agg.metrics.histograms.Inc(1)
+		return nil
 	default:
 		return errInvalidMetricType
 	}
@@ -697,6 +777,9 @@ func (agg *aggregator) closeShardsAsync(shards []*aggregatorShard) {
 		shard := shard
 		go func() {
 			shard.Close()
+			if agg.checkpointOpts.Enabled() {
+				agg.removeShardCheckpoint(shard)
+			}
 			pendingClose := agg.shardsPendingClose.Add(-1)
 			agg.metrics.shards.pendingClose.Update(float64(pendingClose))
 			agg.metrics.shards.close.Inc(1)
@@ -704,6 +787,109 @@ func (agg *aggregator) closeShardsAsync(shards []*aggregatorShard) {
 	}
 }
 
+func (agg *aggregator) checkpointLoop() {
+	ticker := time.NewTicker(agg.checkpointOpts.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-agg.checkpointDoneCh:
+			return
+		}
+
+		agg.RLock()
+		if agg.state != aggregatorOpen {
+			agg.RUnlock()
+			return
+		}
+		shards := agg.shardsWithLock()
+		agg.RUnlock()
+
+		agg.checkpointShards(shards)
+	}
+}
+
+func (agg *aggregator) shardsWithLock() []*aggregatorShard {
+	shards := make([]*aggregatorShard, 0, len(agg.shardIDs))
+	for _, shardID := range agg.shardIDs {
+		if shard := agg.shards[shardID]; shard != nil {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+// checkpointShards writes the checkpoints of the aggregations of the given shards.
+func (agg *aggregator) checkpointShards(shards []*aggregatorShard) {
+	agg.checkpointLock.Lock()
+	defer agg.checkpointLock.Unlock()
+
+	m := agg.metrics.checkpoint
+	for _, shard := range shards {
+		var (
+			start = agg.nowFn()
+			path  = checkpointFilePath(agg.checkpointOpts.FilePathPrefix(), shard.ID())
+		)
+		numElems, err := shard.Checkpoint(path, agg.checkpointOpts, agg.checkpointCompressor, start)
+		if err != nil {
+			m.errors.Inc(1)
+			agg.logger.Error("could not checkpoint shard",
+				zap.Uint32("shard", shard.ID()), zap.Error(err))
+			continue
+		}
+		m.success.Inc(1)
+		m.elems.Inc(int64(numElems))
+		m.duration.Record(agg.nowFn().Sub(start))
+	}
+}
+
+// restoreShard restores the aggregations of a shard from its checkpoint if it
+// is recent enough.
+func (agg *aggregator) restoreShard(shard *aggregatorShard) {
+	var (
+		m       = agg.metrics.checkpoint
+		path    = checkpointFilePath(agg.checkpointOpts.FilePathPrefix(), shard.ID())
+		minTime = agg.nowFn().Add(-agg.checkpointOpts.MaxAge())
+	)
+	checkpointAt, numElems, err := shard.Restore(path, minTime)
+	if os.IsNotExist(err) {
+		return
+	}
+	m.restoredElems.Inc(int64(numElems))
+	if err != nil {
+		m.restoreErrors.Inc(1)
+		agg.logger.Error("could not restore shard from checkpoint",
+			zap.Uint32("shard", shard.ID()),
+			zap.Int("restored", numElems),
+			zap.Error(err))
+		return
+	}
+	if checkpointAt.Before(minTime) {
+		m.restoreSkipped.Inc(1)
+		agg.logger.Info("skipped restoring shard from stale checkpoint",
+			zap.Uint32("shard", shard.ID()),
+			zap.Time("checkpointAt", checkpointAt))
+		return
+	}
+	m.restoreSuccess.Inc(1)
+	agg.logger.Info("restored shard from checkpoint",
+		zap.Uint32("shard", shard.ID()),
+		zap.Time("checkpointAt", checkpointAt),
+		zap.Int("restored", numElems))
+}
+
+func (agg *aggregator) removeShardCheckpoint(shard *aggregatorShard) {
+	agg.checkpointLock.Lock()
+	defer agg.checkpointLock.Unlock()
+
+	path := checkpointFilePath(agg.checkpointOpts.FilePathPrefix(), shard.ID())
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		agg.logger.Error("could not remove shard checkpoint",
+			zap.Uint32("shard", shard.ID()), zap.Error(err))
+	}
+}
+
 func (agg *aggregator) tick() {
 	for {
 		agg.tickInternal()
@@ -1159,11 +1345,37 @@ func newAggregatorShardSetIDMetrics(scope tally.Scope) aggregatorShardSetIDMetri
 	}
 }
 
+type aggregatorCheckpointMetrics struct {
+	success        tally.Counter
+	errors         tally.Counter
+	elems          tally.Counter
+	duration       tally.Timer
+	restoreSuccess tally.Counter
+	restoreErrors  tally.Counter
+	restoreSkipped tally.Counter
+	restoredElems  tally.Counter
+}
+
+func newAggregatorCheckpointMetrics(scope tally.Scope) aggregatorCheckpointMetrics {
+	restoreScope := scope.SubScope("restore")
+	return aggregatorCheckpointMetrics{
+		success:        scope.Counter("success"),
+		errors:         scope.Counter("errors"),
+		elems:          scope.Counter("elems"),
+		duration:       scope.Timer("duration"),
+		restoreSuccess: restoreScope.Counter("success"),
+		restoreErrors:  restoreScope.Counter("errors"),
+		restoreSkipped: restoreScope.Counter("skipped"),
+		restoredElems:  restoreScope.Counter("elems"),
+	}
+}
+
 type aggregatorMetrics struct {
 	counters       tally.Counter
 	timers         tally.Counter
 	timerBatches   tally.Counter
 	gauges         tally.Counter
+	histograms     tally.Counter
 	forwarded      tally.Counter
 	timed          tally.Counter
 	passthrough    tally.Counter
@@ -1176,6 +1388,7 @@ type aggregatorMetrics struct {
 	shards         aggregatorShardsMetrics
 	shardSetID     aggregatorShardSetIDMetrics
 	tick           aggregatorTickMetrics
+	checkpoint     aggregatorCheckpointMetrics
 }
 
 func newAggregatorMetrics(
@@ -1191,11 +1404,13 @@ func newAggregatorMetrics(
 	shardsScope := scope.SubScope("shards")
 	shardSetIDScope := scope.SubScope("shard-set-id")
 	tickScope := scope.SubScope("tick")
+	checkpointScope := scope.SubScope("checkpoint")
 	return aggregatorMetrics{
 		counters:       scope.Counter("counters"),
 		timers:         scope.Counter("timers"),
 		timerBatches:   scope.Counter("timer-batches"),
 		gauges:         scope.Counter("gauges"),
+		histograms:     scope.Counter("histograms"),
 		forwarded:      scope.Counter("forwarded"),
 		timed:          scope.Counter("timed"),
 		passthrough:    scope.Counter("passthrough"),
@@ -1208,6 +1423,7 @@ func newAggregatorMetrics(
 		shards:         newAggregatorShardsMetrics(shardsScope),
 		shardSetID:     newAggregatorShardSetIDMetrics(shardSetIDScope),
 		tick:           newAggregatorTickMetrics(tickScope),
+		checkpoint:     newAggregatorCheckpointMetrics(checkpointScope),
 	}
 }
 
@@ -147,6 +147,21 @@ func (mr *MockAggregatorMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAggregator)(nil).Close))
 }
 
+// Inspect mocks base method.
+func (m *MockAggregator) Inspect(arg0 InspectQuery) (InspectResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inspect", arg0)
+	ret0, _ := ret[0].(InspectResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inspect indicates an expected call of Inspect.
+func (mr *MockAggregatorMockRecorder) Inspect(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockAggregator)(nil).Inspect), arg0)
+}
+
 // Open mocks base method.
 func (m *MockAggregator) Open() error {
 	m.ctrl.T.Helper()
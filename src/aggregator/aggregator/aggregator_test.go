@@ -23,6 +23,7 @@ package aggregator
 import (
 	"errors"
 	"math"
+	"os"
 	"sort"
 	"testing"
 	"time"
@@ -822,6 +823,31 @@ func TestAggregatorCloseSuccess(t *testing.T) {
 	require.Equal(t, aggregatorClosed, agg.state)
 }
 
+func TestAggregatorCloseCheckpointsAndOpenRestores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checkpointOpts := NewCheckpointOptions().
+		SetEnabled(true).
+		SetFilePathPrefix(t.TempDir())
+	agg, _ := testAggregator(t, ctrl)
+	agg.checkpointOpts = checkpointOpts
+	agg.shardFn = func([]byte, uint32) uint32 { return 1 }
+	require.NoError(t, agg.Open())
+	require.NoError(t, agg.AddUntimed(testUntimedMetric, testStagedMetadatas))
+	require.NoError(t, agg.Close())
+
+	_, err := os.Stat(checkpointFilePath(checkpointOpts.FilePathPrefix(), 1))
+	require.NoError(t, err)
+
+	restored, _ := testAggregator(t, ctrl)
+	restored.checkpointOpts = checkpointOpts
+	require.NoError(t, restored.Open())
+	require.Equal(t, 1, len(restored.shards[1].metricMap.entries))
+	require.Equal(t, 0, len(restored.shards[0].metricMap.entries))
+	require.NoError(t, restored.Close())
+}
+
 func TestAggregatorTick(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
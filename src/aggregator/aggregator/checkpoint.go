@@ -0,0 +1,355 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/generated/proto/pipelinepb"
+	"github.com/m3db/m3/src/metrics/metric"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/pipeline/applied"
+	"github.com/m3db/m3/src/metrics/policy"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// A checkpoint file starts with a header made of the checkpoint magic, the
+// checkpoint version and the time the checkpoint was taken at. It is followed
+// by one record per element, each made of the length of the record, the
+// CRC32 checksum of the record and the record itself, and terminated by an
+// empty record.
+const (
+	checkpointFileSuffix    = ".checkpoint"
+	checkpointTmpFileSuffix = ".tmp"
+	checkpointMagic         = "m3aggckp"
+	checkpointVersion       = 1
+	checkpointHeaderLen     = len(checkpointMagic) + 1 + 8
+	checkpointChecksumLen   = 4
+)
+
+var (
+	errCheckpointInvalidHeader          = errors.New("invalid checkpoint header")
+	errCheckpointChecksumMismatch       = errors.New("checkpoint record checksum mismatch")
+	errCheckpointTruncated              = errors.New("checkpoint is truncated")
+	errDuplicateCheckpointedAggregation = errors.New("duplicate checkpointed aggregation")
+
+	checkpointCRCTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// elemCheckpointKey identifies an element in a checkpoint.
+type elemCheckpointKey struct {
+	id             id.RawID
+	metricType     metric.Type
+	listType       metricListType
+	aggregationKey aggregationKey
+	tombstoned     bool
+}
+
+func checkpointFilePath(filePathPrefix string, shard uint32) string {
+	return filepath.Join(filePathPrefix, fmt.Sprintf("shard-%d%s", shard, checkpointFileSuffix))
+}
+
+// writeCheckpoint writes the checkpoint of the elements of the given lists to
+// the file at the given path, replacing the existing checkpoint atomically.
+// It returns the number of elements checkpointed.
+func writeCheckpoint(
+	path string,
+	opts CheckpointOptions,
+	lists *metricLists,
+	compressor maggregation.IDCompressor,
+	checkpointAt time.Time,
+) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(path), opts.NewDirectoryMode()); err != nil {
+		return 0, err
+	}
+	tmpPath := path + checkpointTmpFileSuffix
+	fd, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, opts.NewFileMode())
+	if err != nil {
+		return 0, err
+	}
+
+	numElems, err := writeCheckpointTo(fd, lists, compressor, checkpointAt)
+	if err == nil {
+		err = fd.Sync()
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, err
+	}
+	return numElems, nil
+}
+
+func writeCheckpointTo(
+	w io.Writer,
+	lists *metricLists,
+	compressor maggregation.IDCompressor,
+	checkpointAt time.Time,
+) (int, error) {
+	var (
+		bw       = bufio.NewWriter(w)
+		enc      = raggregation.NewStateEncoder()
+		pb       pipelinepb.AppliedPipeline
+		buf      []byte
+		numElems int
+	)
+	header := make([]byte, 0, checkpointHeaderLen)
+	header = append(header, checkpointMagic...)
+	header = append(header, checkpointVersion)
+	header = binary.LittleEndian.AppendUint64(header, uint64(checkpointAt.UnixNano()))
+	if _, err := bw.Write(header); err != nil {
+		return 0, err
+	}
+
+	err := lists.Checkpoint(func(elem metricElem) error {
+		key, err := elem.CheckpointKey(compressor)
+		if err != nil {
+			return err
+		}
+		if err := key.aggregationKey.pipeline.ToProto(&pb); err != nil {
+			return err
+		}
+		if buf, err = marshalPipeline(&pb, buf); err != nil {
+			return err
+		}
+		enc.Reset()
+		encodeCheckpointKey(enc, elem.Type(), key, buf)
+		elem.Checkpoint(enc)
+		if err := writeCheckpointRecord(bw, enc.Bytes()); err != nil {
+			return err
+		}
+		numElems++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := writeCheckpointRecord(bw, nil); err != nil {
+		return 0, err
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return numElems, nil
+}
+
+func marshalPipeline(pb *pipelinepb.AppliedPipeline, buf []byte) ([]byte, error) {
+	size := pb.Size()
+	if cap(buf) < size {
+		buf = make([]byte, size)
+	}
+	buf = buf[:size]
+	n, err := pb.MarshalTo(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func encodeCheckpointKey(
+	enc *raggregation.StateEncoder,
+	metricType metric.Type,
+	key elemCheckpointKey,
+	pipeline []byte,
+) {
+	enc.EncodeUvarint(uint64(key.listType))
+	enc.EncodeUvarint(uint64(metricType))
+	enc.EncodeBytes(key.id)
+	for _, word := range key.aggregationKey.aggregationID {
+		enc.EncodeUvarint(word)
+	}
+	resolution := key.aggregationKey.storagePolicy.Resolution()
+	enc.EncodeVarint(int64(resolution.Window))
+	enc.EncodeUvarint(uint64(resolution.Precision))
+	enc.EncodeVarint(int64(key.aggregationKey.storagePolicy.Retention().Duration()))
+	enc.EncodeBytes(pipeline)
+	enc.EncodeVarint(int64(key.aggregationKey.numForwardedTimes))
+	enc.EncodeUvarint(uint64(key.aggregationKey.idPrefixSuffixType))
+	enc.EncodeBool(key.tombstoned)
+}
+
+func decodeCheckpointKey(dec *raggregation.StateDecoder) (elemCheckpointKey, error) {
+	var key elemCheckpointKey
+	key.listType = metricListType(dec.DecodeUvarint())
+	key.metricType = metric.Type(dec.DecodeUvarint())
+	key.id = dec.DecodeBytes()
+	for i := range key.aggregationKey.aggregationID {
+		key.aggregationKey.aggregationID[i] = dec.DecodeUvarint()
+	}
+	window := time.Duration(dec.DecodeVarint())
+	precision := xtime.Unit(dec.DecodeUvarint())
+	retention := time.Duration(dec.DecodeVarint())
+	key.aggregationKey.storagePolicy = policy.NewStoragePolicy(window, precision, retention)
+	pipeline := dec.DecodeBytes()
+	key.aggregationKey.numForwardedTimes = int(dec.DecodeVarint())
+	key.aggregationKey.idPrefixSuffixType = IDPrefixSuffixType(dec.DecodeUvarint())
+	key.tombstoned = dec.DecodeBool()
+	if err := dec.Err(); err != nil {
+		return elemCheckpointKey{}, err
+	}
+
+	var pb pipelinepb.AppliedPipeline
+	if err := pb.Unmarshal(pipeline); err != nil {
+		return elemCheckpointKey{}, err
+	}
+	var p applied.Pipeline
+	if err := p.FromProto(pb); err != nil {
+		return elemCheckpointKey{}, err
+	}
+	key.aggregationKey.pipeline = p
+	return key, nil
+}
+
+func writeCheckpointRecord(w io.Writer, data []byte) error {
+	var header [binary.MaxVarintLen64 + checkpointChecksumLen]byte
+	n := binary.PutUvarint(header[:], uint64(len(data)))
+	if len(data) > 0 {
+		binary.LittleEndian.PutUint32(header[n:], crc32.Checksum(data, checkpointCRCTable))
+		n += checkpointChecksumLen
+	}
+	if _, err := w.Write(header[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+type restoreElemFn func(key elemCheckpointKey, dec *raggregation.StateDecoder) error
+
+// readCheckpoint reads the checkpoint at the given path and calls the given
+// function for each element checkpointed. Checkpoints taken before minTime
+// are skipped. It returns the time the checkpoint was taken at and the number
+// of elements restored.
+func readCheckpoint(
+	path string,
+	minTime time.Time,
+	fn restoreElemFn,
+) (time.Time, int, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	defer fd.Close() // nolint: errcheck
+
+	return readCheckpointFrom(fd, minTime, fn)
+}
+
+func readCheckpointFrom(
+	r io.Reader,
+	minTime time.Time,
+	fn restoreElemFn,
+) (time.Time, int, error) {
+	br := bufio.NewReader(r)
+	var header [checkpointHeaderLen]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return time.Time{}, 0, errCheckpointInvalidHeader
+	}
+	if string(header[:len(checkpointMagic)]) != checkpointMagic ||
+		header[len(checkpointMagic)] != checkpointVersion {
+		return time.Time{}, 0, errCheckpointInvalidHeader
+	}
+	checkpointAt := time.Unix(0, int64(binary.LittleEndian.Uint64(header[len(checkpointMagic)+1:])))
+	if checkpointAt.Before(minTime) {
+		return checkpointAt, 0, nil
+	}
+
+	var (
+		dec      = raggregation.NewStateDecoder(nil)
+		buf      bytes.Buffer
+		checksum [checkpointChecksumLen]byte
+		numElems int
+	)
+	for {
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return checkpointAt, numElems, errCheckpointTruncated
+		}
+		if size == 0 {
+			return checkpointAt, numElems, nil
+		}
+		if _, err := io.ReadFull(br, checksum[:]); err != nil {
+			return checkpointAt, numElems, errCheckpointTruncated
+		}
+		// NB: the buffer grows as the record is read rather than being allocated
+		// upfront, so a corrupt record length does not cause a large allocation.
+		buf.Reset()
+		if _, err := io.CopyN(&buf, br, int64(size)); err != nil {
+			return checkpointAt, numElems, errCheckpointTruncated
+		}
+		if crc32.Checksum(buf.Bytes(), checkpointCRCTable) != binary.LittleEndian.Uint32(checksum[:]) {
+			return checkpointAt, numElems, errCheckpointChecksumMismatch
+		}
+
+		dec.Reset(buf.Bytes())
+		key, err := decodeCheckpointKey(dec)
+		if err != nil {
+			return checkpointAt, numElems, err
+		}
+		if err := fn(key, dec); err != nil {
+			return checkpointAt, numElems, err
+		}
+		numElems++
+	}
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func encodeFloat64s(enc *raggregation.StateEncoder, values []float64) {
+	enc.EncodeUvarint(uint64(len(values)))
+	for _, v := range values {
+		enc.EncodeFloat64(v)
+	}
+}
+
+func decodeFloat64s(dec *raggregation.StateDecoder) []float64 {
+	numValues := dec.DecodeUvarint()
+	if numValues == 0 {
+		return nil
+	}
+	// Each value takes eight bytes, guard against allocating based on a
+	// corrupt length.
+	values := make([]float64, 0, minUint64(numValues, uint64(dec.Remaining()/8)))
+	for i := uint64(0); i < numValues && dec.Err() == nil; i++ {
+		values = append(values, dec.DecodeFloat64())
+	}
+	return values
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	defaultCheckpointInterval         = 30 * time.Second
+	defaultCheckpointMaxAge           = 10 * time.Minute
+	defaultCheckpointNewFileMode      = os.FileMode(0o644)
+	defaultCheckpointNewDirectoryMode = os.ModeDir | os.FileMode(0o755)
+)
+
+// CheckpointOptions provide a set of options for checkpointing the state of
+// the aggregations of the shards owned by the aggregator.
+type CheckpointOptions interface {
+	// SetEnabled sets whether checkpointing is enabled.
+	SetEnabled(value bool) CheckpointOptions
+
+	// Enabled returns whether checkpointing is enabled.
+	Enabled() bool
+
+	// SetFilePathPrefix sets the directory checkpoints are written to.
+	SetFilePathPrefix(value string) CheckpointOptions
+
+	// FilePathPrefix returns the directory checkpoints are written to.
+	FilePathPrefix() string
+
+	// SetInterval sets the interval between two checkpoints.
+	SetInterval(value time.Duration) CheckpointOptions
+
+	// Interval returns the interval between two checkpoints.
+	Interval() time.Duration
+
+	// SetMaxAge sets the maximum age of a checkpoint for it to be restored.
+	SetMaxAge(value time.Duration) CheckpointOptions
+
+	// MaxAge returns the maximum age of a checkpoint for it to be restored.
+	MaxAge() time.Duration
+
+	// SetNewFileMode sets the new file mode of checkpoint files.
+	SetNewFileMode(value os.FileMode) CheckpointOptions
+
+	// NewFileMode returns the new file mode of checkpoint files.
+	NewFileMode() os.FileMode
+
+	// SetNewDirectoryMode sets the new directory mode of the checkpoint
+	// directory.
+	SetNewDirectoryMode(value os.FileMode) CheckpointOptions
+
+	// NewDirectoryMode returns the new directory mode of the checkpoint
+	// directory.
+	NewDirectoryMode() os.FileMode
+}
+
+type checkpointOptions struct {
+	enabled          bool
+	filePathPrefix   string
+	interval         time.Duration
+	maxAge           time.Duration
+	newFileMode      os.FileMode
+	newDirectoryMode os.FileMode
+}
+
+// NewCheckpointOptions creates a new set of checkpoint options.
+func NewCheckpointOptions() CheckpointOptions {
+	return &checkpointOptions{
+		interval:         defaultCheckpointInterval,
+		maxAge:           defaultCheckpointMaxAge,
+		newFileMode:      defaultCheckpointNewFileMode,
+		newDirectoryMode: defaultCheckpointNewDirectoryMode,
+	}
+}
+
+func (o *checkpointOptions) SetEnabled(value bool) CheckpointOptions {
+	opts := *o
+	opts.enabled = value
+	return &opts
+}
+
+func (o *checkpointOptions) Enabled() bool {
+	return o.enabled
+}
+
+func (o *checkpointOptions) SetFilePathPrefix(value string) CheckpointOptions {
+	opts := *o
+	opts.filePathPrefix = value
+	return &opts
+}
+
+func (o *checkpointOptions) FilePathPrefix() string {
+	return o.filePathPrefix
+}
+
+func (o *checkpointOptions) SetInterval(value time.Duration) CheckpointOptions {
+	opts := *o
+	opts.interval = value
+	return &opts
+}
+
+func (o *checkpointOptions) Interval() time.Duration {
+	return o.interval
+}
+
+func (o *checkpointOptions) SetMaxAge(value time.Duration) CheckpointOptions {
+	opts := *o
+	opts.maxAge = value
+	return &opts
+}
+
+func (o *checkpointOptions) MaxAge() time.Duration {
+	return o.maxAge
+}
+
+func (o *checkpointOptions) SetNewFileMode(value os.FileMode) CheckpointOptions {
+	opts := *o
+	opts.newFileMode = value
+	return &opts
+}
+
+func (o *checkpointOptions) NewFileMode() os.FileMode {
+	return o.newFileMode
+}
+
+func (o *checkpointOptions) SetNewDirectoryMode(value os.FileMode) CheckpointOptions {
+	opts := *o
+	opts.newDirectoryMode = value
+	return &opts
+}
+
+func (o *checkpointOptions) NewDirectoryMode() os.FileMode {
+	return o.newDirectoryMode
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/metric"
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/metrics/pipeline/applied"
+)
+
+func TestCounterElemCheckpointRestore(t *testing.T) {
+	opts := newTestOptions()
+	e := testCounterElem(testAlignedStarts[:len(testAlignedStarts)-1], testCounterVals,
+		maggregation.DefaultTypes, applied.DefaultPipeline, opts)
+
+	// Flush the first window so the flush state is checkpointed as well.
+	localFn, _ := testFlushLocalMetricFn()
+	forwardFn, _ := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType)
+
+	enc := raggregation.NewStateEncoder()
+	e.Checkpoint(enc)
+
+	elemData := testCounterElemData
+	elemData.Pipeline = applied.DefaultPipeline
+	restored := MustNewCounterElem(elemData, NewElemOptions(opts))
+	require.NoError(t, restored.Restore(raggregation.NewStateDecoder(enc.Bytes())))
+	require.Equal(t, len(e.values), len(restored.values))
+	require.Equal(t, e.minStartTime, restored.minStartTime)
+	require.Equal(t, e.maxStartTime, restored.maxStartTime)
+	require.Equal(t, e.dirty, restored.dirty)
+	require.Equal(t, e.flushState, restored.flushState)
+
+	// Restoring into an element that has values is an error.
+	require.Equal(t, errElemNotEmpty, restored.Restore(raggregation.NewStateDecoder(enc.Bytes())))
+
+	// Consuming the restored element produces the same values.
+	expectedLocalFn, expectedLocalRes := testFlushLocalMetricFn()
+	e.Consume(testAlignedStarts[2], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, expectedLocalFn, forwardFn, onForwardedFlushedFn, 0, consumeType)
+	localFn, localRes := testFlushLocalMetricFn()
+	restored.Consume(testAlignedStarts[2], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType)
+	require.Equal(t, 1, len(*localRes))
+	require.Equal(t, *expectedLocalRes, *localRes)
+}
+
+func TestCounterElemRestoreTruncated(t *testing.T) {
+	opts := newTestOptions()
+	e := testCounterElem(testAlignedStarts[:len(testAlignedStarts)-1], testCounterVals,
+		maggregation.DefaultTypes, applied.DefaultPipeline, opts)
+	enc := raggregation.NewStateEncoder()
+	e.Checkpoint(enc)
+
+	data := enc.Bytes()
+	restored := MustNewCounterElem(testCounterElemData, NewElemOptions(opts))
+	require.Error(t, restored.Restore(raggregation.NewStateDecoder(data[:len(data)-1])))
+}
+
+func TestMetricMapCheckpointRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions(ctrl)
+	m := newMetricMap(testShard, opts)
+	require.NoError(t, m.AddUntimed(testCounter, testDefaultStagedMetadatas))
+	require.NoError(t, m.AddUntimed(unaggregated.MetricUnion{
+		Type:     metric.GaugeType,
+		ID:       testCounterID,
+		GaugeVal: 123.456,
+	}, testCustomStagedMetadatas))
+	require.NoError(t, m.AddTimed(aggregated.Metric{
+		Type:      metric.CounterType,
+		ID:        []byte("timedMetric"),
+		TimeNanos: 12345,
+		Value:     76109,
+	}, testTimedMetadata))
+	require.NoError(t, m.AddForwarded(aggregated.ForwardedMetric{
+		Type:      metric.CounterType,
+		ID:        []byte("forwardedMetric"),
+		TimeNanos: 12345,
+		Values:    []float64{76109},
+	}, testForwardMetadata))
+
+	path := checkpointFilePath(t.TempDir(), testShard)
+	now := time.Now()
+	numElems, err := m.Checkpoint(path, NewCheckpointOptions(), maggregation.NewIDCompressor(), now)
+	require.NoError(t, err)
+	require.Equal(t, 7, numElems)
+
+	restored := newMetricMap(testShard, opts)
+	checkpointAt, numRestored, err := restored.Restore(path, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, now.UnixNano(), checkpointAt.UnixNano())
+	require.Equal(t, numElems, numRestored)
+	require.Equal(t, len(m.entries), len(restored.entries))
+	require.Equal(t, m.metricLists.Len(), restored.metricLists.Len())
+	require.Equal(t, testCheckpointedElems(t, m.metricLists), testCheckpointedElems(t, restored.metricLists))
+
+	// Writes reuse the restored aggregations.
+	require.NoError(t, restored.AddUntimed(testCounter, testDefaultStagedMetadatas))
+	require.Equal(t, numElems, testNumElems(restored.metricLists))
+
+	// Restoring the same checkpoint again is an error since the aggregations
+	// already exist.
+	_, _, err = restored.Restore(path, now.Add(-time.Minute))
+	require.Error(t, err)
+}
+
+func TestMetricMapRestoreStaleCheckpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions(ctrl)
+	m := newMetricMap(testShard, opts)
+	require.NoError(t, m.AddUntimed(testCounter, testDefaultStagedMetadatas))
+
+	path := checkpointFilePath(t.TempDir(), testShard)
+	now := time.Now()
+	_, err := m.Checkpoint(path, NewCheckpointOptions(), maggregation.NewIDCompressor(), now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	restored := newMetricMap(testShard, opts)
+	_, numRestored, err := restored.Restore(path, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 0, numRestored)
+	require.Equal(t, 0, len(restored.entries))
+}
+
+func TestMetricMapCheckpointFileModes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newMetricMap(testShard, testOptions(ctrl))
+	require.NoError(t, m.AddUntimed(testCounter, testDefaultStagedMetadatas))
+
+	dir := filepath.Join(t.TempDir(), "checkpoints")
+	path := checkpointFilePath(dir, testShard)
+	checkpointOpts := NewCheckpointOptions().
+		SetNewFileMode(0o600).
+		SetNewDirectoryMode(os.ModeDir | 0o700)
+	_, err := m.Checkpoint(path, checkpointOpts, maggregation.NewIDCompressor(), time.Now())
+	require.NoError(t, err)
+
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeDir|os.FileMode(0o700), info.Mode())
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode())
+}
+
+func TestMetricMapRestoreCorruptCheckpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions(ctrl)
+	m := newMetricMap(testShard, opts)
+	require.NoError(t, m.AddUntimed(testCounter, testDefaultStagedMetadatas))
+
+	dir := t.TempDir()
+	path := checkpointFilePath(dir, testShard)
+	now := time.Now()
+	_, err := m.Checkpoint(path, NewCheckpointOptions(), maggregation.NewIDCompressor(), now)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	corrupt := func(data []byte) string {
+		p := filepath.Join(dir, "corrupt")
+		require.NoError(t, os.WriteFile(p, data, 0644))
+		return p
+	}
+	minTime := now.Add(-time.Minute)
+
+	// Invalid header.
+	invalid := append([]byte(nil), data...)
+	invalid[0] = 'x'
+	_, _, err = newMetricMap(testShard, opts).Restore(corrupt(invalid), minTime)
+	require.Equal(t, errCheckpointInvalidHeader, err)
+
+	// Corrupt record.
+	invalid = append([]byte(nil), data...)
+	invalid[checkpointHeaderLen+8] ^= 0xff
+	_, _, err = newMetricMap(testShard, opts).Restore(corrupt(invalid), minTime)
+	require.Equal(t, errCheckpointChecksumMismatch, err)
+
+	// Truncated file.
+	_, _, err = newMetricMap(testShard, opts).Restore(corrupt(data[:len(data)-1]), minTime)
+	require.Equal(t, errCheckpointTruncated, err)
+
+	// No temporary file is left behind.
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+checkpointTmpFileSuffix))
+	require.NoError(t, err)
+	require.Empty(t, matches)
+}
+
+func testCheckpointedElems(t *testing.T, lists *metricLists) map[string]struct{} {
+	var (
+		elems      = make(map[string]struct{})
+		compressor = maggregation.NewIDCompressor()
+		enc        = raggregation.NewStateEncoder()
+	)
+	require.NoError(t, lists.Checkpoint(func(elem metricElem) error {
+		key, err := elem.CheckpointKey(compressor)
+		require.NoError(t, err)
+		enc.Reset()
+		encodeCheckpointKey(enc, elem.Type(), key, []byte(key.aggregationKey.pipeline.String()))
+		elem.Checkpoint(enc)
+		elems[string(enc.Bytes())] = struct{}{}
+		return nil
+	}))
+	return elems
+}
+
+func testNumElems(lists *metricLists) int {
+	var numElems int
+	_ = lists.Checkpoint(func(metricElem) error {
+		numElems++
+		return nil
+	})
+	return numElems
+}
+
+func TestCheckpointRecordRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	records := [][]byte{[]byte("foo"), bytes.Repeat([]byte("bar"), 100)}
+	for _, r := range records {
+		require.NoError(t, writeCheckpointRecord(&buf, r))
+	}
+	require.NoError(t, writeCheckpointRecord(&buf, nil))
+	// One byte length, four bytes checksum and the record, then the terminator.
+	require.Equal(t, 1+4+3+2+4+300+1, buf.Len())
+}
@@ -72,6 +72,10 @@ var (
 	errAggregationClosed                  = errors.New("aggregation is closed")
 	errClosedBeforeResendEnabledMigration = errors.New("aggregation closed before resendEnabled migration")
 	errDuplicateForwardingSource          = errors.New("duplicate forwarding source")
+	errElemNotEmpty                       = errors.New("element is not empty")
+	errCheckpointOutOfOrder               = errors.New("checkpointed aggregations are out of order")
+
+	defaultHistogramAggregationTypes = maggregation.Types{maggregation.Last}
 )
 
 // isEarlierThanFn determines whether the timestamps of the metrics in a given
@@ -153,6 +157,20 @@ type metricElem interface {
 	// will be deleted once its aggregated values have been flushed.
 	MarkAsTombstoned()
 
+	// Checkpoint encodes the state of the aggregations of the element.
+	Checkpoint(enc *raggregation.StateEncoder)
+
+	// Restore restores the state of the aggregations of the element encoded
+	// by Checkpoint.
+	Restore(dec *raggregation.StateDecoder) error
+
+	// CheckpointKey returns the key identifying the element in a checkpoint.
+	CheckpointKey(compressor maggregation.IDCompressor) (elemCheckpointKey, error)
+
+	// Inspect returns the state of the element and of its open aggregation
+	// windows.
+	Inspect() ElemInspection
+
 	// Close closes the element.
 	Close()
 }
@@ -179,6 +197,7 @@ type elemBase struct {
 	sp                              policy.StoragePolicy
 	aggTypes                        maggregation.Types
 	aggOpts                         raggregation.Options
+	pipeline                        applied.Pipeline
 	parsedPipeline                  parsedPipeline
 	numForwardedTimes               int
 	idPrefixSuffixType              IDPrefixSuffixType
@@ -460,6 +479,8 @@ func (e *elemBase) resetSetData(data ElemData, useDefaultAggregation bool) error
 	e.aggTypes = data.AggTypes
 	e.useDefaultAggregation = useDefaultAggregation
 	e.aggOpts.ResetSetData(data.AggTypes)
+	e.aggOpts.TimerSketch = e.opts.TimerSketchOptionsFn()(aggregationPolicy(data, useDefaultAggregation))
+	e.pipeline = data.Pipeline
 	e.parsedPipeline = parsed
 	e.numForwardedTimes = data.NumForwardedTimes
 	e.tombstoned = false
@@ -470,6 +491,17 @@ func (e *elemBase) resetSetData(data ElemData, useDefaultAggregation bool) error
 	return nil
 }
 
+// aggregationPolicy returns the aggregation policy of the element data.
+func aggregationPolicy(data ElemData, useDefaultAggregation bool) policy.Policy {
+	aggID := maggregation.DefaultID
+	if !useDefaultAggregation {
+		if id, err := maggregation.CompressTypes(data.AggTypes...); err == nil {
+			aggID = id
+		}
+	}
+	return policy.NewPolicy(data.StoragePolicy, aggID)
+}
+
 func (e *elemBase) SetForwardedCallbacks(
 	writeFn writeForwardedMetricFn,
 	onDoneFn onForwardedAggregationDoneFn,
@@ -499,6 +531,74 @@ func (e *elemBase) ForwardedAggregationKey() (aggregationKey, bool) {
 	}, true
 }
 
+// inspectWithLock returns the state of the element, without its aggregation
+// windows.
+func (e *elemBase) inspectWithLock() ElemInspection {
+	insp := ElemInspection{
+		ID:                string(e.id),
+		ListType:          e.listType.String(),
+		StoragePolicy:     e.sp.String(),
+		AggregationTypes:  aggregationTypeStrings(e.aggTypes),
+		Pipeline:          e.pipeline.String(),
+		NumForwardedTimes: e.numForwardedTimes,
+		Tombstoned:        e.tombstoned,
+	}
+	// NB: the list type of an element is always valid.
+	insp.listID, _ = newMetricListID(e.listType, e.sp.Resolution().Window, e.numForwardedTimes)
+	if e.parsedPipeline.HasRollup {
+		rollup := e.parsedPipeline.Rollup
+		// NB: the forwarded aggregation uses the default aggregation types of
+		// its metric type if its aggregation ID is the default one.
+		var aggTypes []string
+		if types, err := rollup.AggregationID.Types(); err == nil {
+			aggTypes = aggregationTypeStrings(types)
+		}
+		insp.Forwarding = &ForwardingInspection{
+			ID:                string(rollup.ID),
+			AggregationTypes:  aggTypes,
+			Pipeline:          e.parsedPipeline.Remainder.String(),
+			NumForwardedTimes: e.numForwardedTimes + 1,
+		}
+	}
+	return insp
+}
+
+func aggregationTypeStrings(aggTypes maggregation.Types) []string {
+	strs := make([]string, 0, len(aggTypes))
+	for _, aggType := range aggTypes {
+		strs = append(strs, aggType.String())
+	}
+	return strs
+}
+
+// CheckpointKey returns the key identifying the element in a checkpoint.
+func (e *elemBase) CheckpointKey(compressor maggregation.IDCompressor) (elemCheckpointKey, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	key := elemCheckpointKey{
+		id:       e.id,
+		listType: e.listType,
+		aggregationKey: aggregationKey{
+			pipeline:           e.pipeline,
+			storagePolicy:      e.sp,
+			numForwardedTimes:  e.numForwardedTimes,
+			idPrefixSuffixType: e.idPrefixSuffixType,
+		},
+		tombstoned: e.tombstoned,
+	}
+	if e.useDefaultAggregation {
+		key.aggregationKey.aggregationID = maggregation.DefaultID
+		return key, nil
+	}
+	aggregationID, err := compressor.Compress(e.aggTypes)
+	if err != nil {
+		return elemCheckpointKey{}, err
+	}
+	key.aggregationKey.aggregationID = aggregationID
+	return key, nil
+}
+
 // MarkAsTombstoned marks an element as tombstoned, which means this element
 // will be deleted once its aggregated values have been flushed.
 func (e *elemBase) MarkAsTombstoned() {
@@ -636,6 +736,50 @@ func (e *gaugeElemBase) ResetSetData(
 
 func (e *gaugeElemBase) Close() {}
 
+// histogramElemBase shares the prefix and type strings of gauges. The last
+// histogram is kept by default, if the sum is requested the buckets of all
+// histograms are merged instead.
+type histogramElemBase struct {
+	merge bool
+}
+
+func (e histogramElemBase) Type() metric.Type { return metric.HistogramType }
+
+func (e histogramElemBase) FullPrefix(opts Options) []byte { return opts.FullGaugePrefix() }
+
+func (e histogramElemBase) DefaultAggregationTypes(_ maggregation.TypesOptions) maggregation.Types {
+	return defaultHistogramAggregationTypes
+}
+
+func (e histogramElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, aggType maggregation.Type) []byte {
+	return aggTypesOpts.TypeStringForGauge(aggType)
+}
+
+func (e histogramElemBase) ElemPool(opts Options) HistogramElemPool { return opts.HistogramElemPool() }
+
+func (e histogramElemBase) NewAggregation(_ Options, aggOpts raggregation.Options) histogramAggregation {
+	return newHistogramAggregation(raggregation.NewHistogram(aggOpts, e.merge))
+}
+
+func (e *histogramElemBase) ResetSetData(
+	_ maggregation.TypesOptions,
+	aggTypes maggregation.Types,
+	useDefaultAggregation bool,
+) error {
+	if useDefaultAggregation {
+		aggTypes = defaultHistogramAggregationTypes
+	}
+	// The aggregated histogram is carried in the annotation which is shared
+	// by all aggregation types, so only one of them can be used.
+	if len(aggTypes) != 1 || (aggTypes[0] != maggregation.Last && aggTypes[0] != maggregation.Sum) {
+		return fmt.Errorf("invalid aggregation types %s for histogram", aggTypes.String())
+	}
+	e.merge = aggTypes[0] == maggregation.Sum
+	return nil
+}
+
+func (e *histogramElemBase) Close() {}
+
 // nolint: maligned
 type parsedPipeline struct {
 	// Whether the source pipeline contains derivative transformations at its head.
@@ -814,3 +958,22 @@ func (l *lockedTimerAggregation) close() {
 	*l = lockedTimerAggregation{}
 	lockedTimerAggregationPool.Put(l)
 }
+
+var lockedHistogramAggregationPool = sync.Pool{New: func() interface{} { return &lockedHistogramAggregation{} }}
+
+func lockedHistogramAggregationFromPool(
+	aggregation histogramAggregation,
+	sourcesSeen map[uint32]*bitset.BitSet,
+) *lockedHistogramAggregation {
+	l := lockedHistogramAggregationPool.Get().(*lockedHistogramAggregation)
+	l.aggregation = aggregation
+	l.sourcesSeen = sourcesSeen
+
+	return l
+}
+
+func (l *lockedHistogramAggregation) close() {
+	l.aggregation.Close()
+	*l = lockedHistogramAggregation{}
+	lockedHistogramAggregationPool.Put(l)
+}
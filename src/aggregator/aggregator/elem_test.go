@@ -33,7 +33,10 @@ import (
 	"go.uber.org/atomic"
 
 	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
@@ -44,6 +47,7 @@ import (
 	"github.com/m3db/m3/src/metrics/pipeline/applied"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/metrics/transformation"
+	"github.com/m3db/m3/src/x/histogram"
 	"github.com/m3db/m3/src/x/instrument"
 	xtime "github.com/m3db/m3/src/x/time"
 )
@@ -721,6 +725,69 @@ func TestCounterElemConsumeCustomAggregationCustomPipeline(t *testing.T) {
 	require.Equal(t, 1, len(e.values))
 }
 
+func TestCounterElemConsumeCountDistinct(t *testing.T) {
+	elemData := testCounterElemData
+	elemData.AggTypes = maggregation.Types{maggregation.Sum, maggregation.CountDistinct}
+	elemData.Pipeline = applied.DefaultPipeline
+	e, err := NewCounterElem(elemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+	require.True(t, e.aggOpts.HasCountDistinct)
+
+	for i, values := range [][]string{{"a", "b"}, {"b", "c"}} {
+		sketch := hll.NewSketch()
+		for _, v := range values {
+			sketch.Add([]byte(v))
+		}
+		payload, err := (&annotation.Payload{
+			OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+			DistinctSketch:        sketch.Marshal(nil),
+		}).Marshal()
+		require.NoError(t, err)
+		require.NoError(t, e.AddUnique(testTimestamps[0],
+			aggregated.ForwardedMetric{Values: []float64{10}, Annotation: payload},
+			metadata.ForwardMetadata{SourceID: uint32(i)}))
+	}
+
+	type flushed struct {
+		suffix     []byte
+		value      float64
+		annotation annotation.Payload
+	}
+	var results []flushed
+	localFn := func(
+		_ []byte,
+		_ id.RawID,
+		idSuffix []byte,
+		_ int64,
+		value float64,
+		annotationBytes []byte,
+		_ policy.StoragePolicy,
+	) {
+		var payload annotation.Payload
+		require.NoError(t, payload.Unmarshal(annotationBytes))
+		results = append(results, flushed{suffix: idSuffix, value: value, annotation: payload})
+	}
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType))
+	require.Equal(t, 0, len(*forwardRes))
+
+	// The sketches are not flushed and the distinct count is flushed as a gauge.
+	require.Equal(t, []flushed{
+		{
+			suffix:     expectCounterSuffix(maggregation.Sum),
+			value:      20,
+			annotation: annotation.Payload{OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER},
+		},
+		{
+			suffix:     expectCounterSuffix(maggregation.CountDistinct),
+			value:      3,
+			annotation: annotation.Payload{OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_GAUGE},
+		},
+	}, results)
+}
+
 func TestCounterElemClose(t *testing.T) {
 	e := testCounterElem(testAlignedStarts[:len(testAlignedStarts)-1], testCounterVals,
 		maggregation.DefaultTypes, applied.DefaultPipeline, newTestOptions())
@@ -996,6 +1063,96 @@ func TestTimerElemAddUnique(t *testing.T) {
 		metadata.ForwardMetadata{SourceID: 3}))
 }
 
+func TestTimerElemAddUniqueMergesSketches(t *testing.T) {
+	sketchOpts := ddsketch.NewOptions()
+	opts := newTestOptions().SetTimerSketchOptionsFn(func(p policy.Policy) ddsketch.Options {
+		if p == policy.NewPolicy(testStoragePolicy, maggregation.DefaultID) {
+			return sketchOpts
+		}
+		return nil
+	})
+	e, err := NewTimerElem(testTimerElemData, NewElemOptions(opts))
+	require.NoError(t, err)
+	require.Equal(t, sketchOpts, e.aggOpts.TimerSketch)
+
+	for i, values := range [][]float64{{1, 2, 3}, {4, 5, 6, 7}} {
+		sketch := ddsketch.NewSketch(sketchOpts)
+		for _, v := range values {
+			sketch.Add(v)
+		}
+		payload, err := (&annotation.Payload{TimerSketch: sketch.Marshal(nil)}).Marshal()
+		require.NoError(t, err)
+		require.NoError(t, e.AddUnique(testTimestamps[0],
+			aggregated.ForwardedMetric{Values: []float64{sketch.Quantile(0.5)}, Annotation: payload},
+			metadata.ForwardMetadata{SourceID: uint32(i)}))
+	}
+
+	a, err := e.find(xtime.UnixNano(testAlignedStarts[0]))
+	require.NoError(t, err)
+	timer := a.lockedAgg.aggregation
+	require.Equal(t, int64(7), timer.Count())
+	require.Equal(t, 28.0, timer.Sum())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 7.0, timer.Max())
+	require.InDelta(t, 4.0, timer.Quantile(0.5), 0.04)
+
+	// Timers with other aggregation policies use the CM stream.
+	elemData := testTimerElemData
+	elemData.StoragePolicy = policy.MustParseStoragePolicy("1m:2d")
+	require.NoError(t, e.ResetSetData(elemData))
+	require.Nil(t, e.aggOpts.TimerSketch)
+
+	elemData = testTimerElemData
+	elemData.AggTypes = maggregation.Types{maggregation.P99}
+	require.NoError(t, e.ResetSetData(elemData))
+	require.Nil(t, e.aggOpts.TimerSketch)
+}
+
+func TestTimerElemConsumeFlushesSketchWithOneAggregationType(t *testing.T) {
+	sketchOpts := ddsketch.NewOptions()
+	opts := newTestOptions().SetTimerSketchOptionsFn(func(policy.Policy) ddsketch.Options {
+		return sketchOpts
+	})
+	elemData := testTimerElemData
+	elemData.AggTypes = maggregation.Types{maggregation.Max, maggregation.P50, maggregation.P99}
+	elemData.Pipeline = applied.DefaultPipeline
+	e, err := NewTimerElem(elemData, NewElemOptions(opts))
+	require.NoError(t, err)
+	require.NoError(t, e.AddUnique(testTimestamps[0],
+		aggregated.ForwardedMetric{Values: []float64{1, 2, 3}},
+		metadata.ForwardMetadata{SourceID: 1}))
+
+	sketches := make(map[string]int)
+	localFn := func(
+		_ []byte,
+		_ id.RawID,
+		idSuffix []byte,
+		_ int64,
+		_ float64,
+		annotationBytes []byte,
+		_ policy.StoragePolicy,
+	) {
+		var payload annotation.Payload
+		require.NoError(t, payload.Unmarshal(annotationBytes))
+		if len(payload.TimerSketch) == 0 {
+			return
+		}
+		sketch, err := ddsketch.Unmarshal(payload.TimerSketch)
+		require.NoError(t, err)
+		sketches[string(idSuffix)] = int(sketch.Count())
+	}
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType))
+	require.Equal(t, 0, len(*forwardRes))
+
+	// The sketch is only flushed with the first quantile aggregation type.
+	require.Equal(t, map[string]int{
+		string(expectTimerSuffix(maggregation.P50)): 3,
+	}, sketches)
+}
+
 func TestTimerElemConsumeDefaultAggregationDefaultPipeline(t *testing.T) {
 	// Set up stream options.
 	streamOpts := cm.NewOptions()
@@ -2582,6 +2739,68 @@ func TestGaugeFindOrCreateWithSourceSet(t *testing.T) {
 	require.Equal(t, 0, len(e.cachedSourceSets))
 }
 
+func TestHistogramResetSetData(t *testing.T) {
+	opts := NewElemOptions(newTestOptions())
+	elemData := ElemData{
+		ID:            testGaugeID,
+		StoragePolicy: testStoragePolicy,
+		Pipeline:      applied.DefaultPipeline,
+	}
+	he, err := NewHistogramElem(elemData, opts)
+	require.NoError(t, err)
+	require.Equal(t, defaultHistogramAggregationTypes, he.aggTypes)
+	require.False(t, he.merge)
+
+	elemData.AggTypes = maggregation.Types{maggregation.Sum}
+	require.NoError(t, he.ResetSetData(elemData))
+	require.True(t, he.merge)
+
+	for _, aggTypes := range []maggregation.Types{
+		{maggregation.Max},
+		{maggregation.Last, maggregation.Sum},
+	} {
+		elemData.AggTypes = aggTypes
+		require.Error(t, he.ResetSetData(elemData))
+	}
+}
+
+func TestHistogramElemAddUnion(t *testing.T) {
+	elemData := ElemData{
+		ID:            testGaugeID,
+		StoragePolicy: testStoragePolicy,
+		AggTypes:      maggregation.Types{maggregation.Sum},
+		Pipeline:      applied.DefaultPipeline,
+	}
+	e, err := NewHistogramElem(elemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+
+	payload := annotation.Payload{
+		NativeHistogram: (&histogram.Histogram{
+			Count:           2,
+			Sum:             3,
+			PositiveSpans:   []histogram.Span{{Offset: 0, Length: 1}},
+			PositiveBuckets: []float64{2},
+		}).Marshal(nil),
+	}
+	annotationBytes, err := payload.Marshal()
+	require.NoError(t, err)
+	mu := unaggregated.MetricUnion{
+		Type:       metric.HistogramType,
+		ID:         testGaugeID,
+		GaugeVal:   2,
+		Annotation: annotationBytes,
+	}
+
+	require.NoError(t, e.AddUnion(testTimestamps[0], mu, false))
+	require.NoError(t, e.AddUnion(testTimestamps[1], mu, false))
+	require.Equal(t, 1, len(e.values))
+	a, err := e.find(xtime.UnixNano(testAlignedStarts[0]))
+	require.NoError(t, err)
+	v := a.lockedAgg
+	require.Equal(t, 4.0, v.aggregation.ValueOf(maggregation.Sum))
+	require.Equal(t, []float64{4}, v.aggregation.Sum().PositiveBuckets)
+}
+
 func TestExpireValues(t *testing.T) {
 	opts := newTestOptions()
 	resolutionDuration := opts.DefaultStoragePolicies()[0].Resolution().Window
@@ -30,6 +30,7 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/atomic"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/bitset"
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
@@ -627,6 +628,34 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		newAggregations = append(newAggregations, a)
 		return newAggregations, nil
 	}
+	// NB: The pipeline may not be owned by us and as such we need to make a copy here.
+	key.pipeline = key.pipeline.Clone()
+	newElem, err := e.newElemWithLock(metricType, metricID, key, listID.listType)
+	if err != nil {
+		return nil, err
+	}
+	list, err := e.lists.FindOrCreate(listID)
+	if err != nil {
+		return nil, err
+	}
+	newListElem, err := list.PushBack(newElem)
+	if err != nil {
+		return nil, err
+	}
+	newAggregations = append(newAggregations, aggregationValue{
+		key:           key,
+		elem:          newListElem,
+		resendEnabled: resendEnabled,
+	})
+	return newAggregations, nil
+}
+
+func (e *Entry) newElemWithLock(
+	metricType metric.Type,
+	metricID metricid.RawID,
+	key aggregationKey,
+	listType metricListType,
+) (metricElem, error) {
 	aggTypes, err := e.decompressor.Decompress(key.aggregationID)
 	if err != nil {
 		return nil, err
@@ -639,11 +668,11 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		newElem = e.opts.TimerElemPool().Get()
 	case metric.GaugeType:
 		newElem = e.opts.GaugeElemPool().Get()
+	case metric.HistogramType:
+		newElem = e.opts.HistogramElemPool().Get()
 	default:
 		return nil, errInvalidMetricType
 	}
-	// NB: The pipeline may not be owned by us and as such we need to make a copy here.
-	key.pipeline = key.pipeline.Clone()
 	if err = newElem.ResetSetData(ElemData{
 		ID:                 metricID,
 		StoragePolicy:      key.storagePolicy,
@@ -651,24 +680,95 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		Pipeline:           key.pipeline,
 		NumForwardedTimes:  key.numForwardedTimes,
 		IDPrefixSuffixType: key.idPrefixSuffixType,
-		ListType:           listID.listType,
+		ListType:           listType,
 	}); err != nil {
 		return nil, err
 	}
+	return newElem, nil
+}
+
+// restoreAggregation restores an aggregation of the entry from a checkpoint.
+// The staged metadatas of the entry are left uninitialized so the aggregation
+// is reused by the first write matching its aggregation key.
+func (e *Entry) restoreAggregation(
+	key elemCheckpointKey,
+	dec *raggregation.StateDecoder,
+) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.closed {
+		return errEntryClosed
+	}
+	if !key.tombstoned && e.aggregations.contains(key.aggregationKey) {
+		return errDuplicateCheckpointedAggregation
+	}
+	listID, err := newMetricListID(
+		key.listType,
+		key.aggregationKey.storagePolicy.Resolution().Window,
+		key.aggregationKey.numForwardedTimes,
+	)
+	if err != nil {
+		return err
+	}
+	elemID := e.maybeCopyIDWithLock(key.id)
+	newElem, err := e.newElemWithLock(key.metricType, elemID, key.aggregationKey, key.listType)
+	if err != nil {
+		return err
+	}
+	if err := newElem.Restore(dec); err != nil {
+		newElem.Close()
+		return err
+	}
+	if key.tombstoned {
+		newElem.MarkAsTombstoned()
+	}
 	list, err := e.lists.FindOrCreate(listID)
 	if err != nil {
-		return nil, err
+		newElem.Close()
+		return err
 	}
 	newListElem, err := list.PushBack(newElem)
 	if err != nil {
-		return nil, err
+		newElem.Close()
+		return err
 	}
-	newAggregations = append(newAggregations, aggregationValue{
-		key:           key,
-		elem:          newListElem,
-		resendEnabled: resendEnabled,
-	})
-	return newAggregations, nil
+	if !key.tombstoned {
+		e.aggregations = append(e.aggregations, aggregationValue{
+			key:  key.aggregationKey,
+			elem: newListElem,
+		})
+	}
+	return nil
+}
+
+// inspect adds the inspections of the elements of the entry selected by the
+// inspector, returning false if the inspection reached its limit.
+func (e *Entry) inspect(insp *elemInspector) bool {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	if e.closed {
+		return true
+	}
+	for _, val := range e.aggregations {
+		elem := val.elem.Value.(metricElem)
+		if !insp.Matches(elem.ID()) {
+			continue
+		}
+		elemInsp := elem.Inspect()
+		elemInsp.Shard = e.lists.shard
+		elemInsp.ResendEnabled = val.resendEnabled
+		if list, ok := e.lists.Find(elemInsp.listID); ok {
+			if lastFlushedNanos := list.LastFlushedNanos(); lastFlushedNanos > 0 {
+				elemInsp.LastFlushedAt = time.Unix(0, lastFlushedNanos)
+			}
+		}
+		if !insp.Add(elemInsp) {
+			return false
+		}
+	}
+	return true
 }
 
 func (e *Entry) removeOldAggregations(newAggregations aggregationValues) {
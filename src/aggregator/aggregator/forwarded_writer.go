@@ -329,7 +329,8 @@ func (agg *forwardedAggregationWithKey) add(timeNanos xtime.UnixNano, value floa
 	bucket.timeNanos = timeNanos
 	bucket.values = append(bucket.values, value)
 	bucket.prevValues = append(bucket.prevValues, prevValue)
-	bucket.annotation = aggregation.MaybeReplaceAnnotation(bucket.annotation, annotation)
+	// Timer and distinct sketches of all the elements forwarding to this metric are merged.
+	bucket.annotation = aggregation.MergeAnnotations(bucket.annotation, annotation)
 	bucket.resendEnabled = resendEnabled
 	agg.buckets[idx] = bucket
 }
@@ -29,7 +29,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/client"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
@@ -640,6 +643,126 @@ func TestForwardedWriterResend(t *testing.T) {
 	require.Equal(t, 0, len(agg.byKey[0].versions))
 }
 
+func TestForwardedWriterMergesTimerSketches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		c      = client.NewMockAdminClient(ctrl)
+		opts   = NewOptions(clock.NewOptions()).SetAdminClient(c)
+		w      = newForwardedWriter(0, opts)
+		mid    = id.RawID("foo")
+		aggKey = testForwardedWriterAggregationKey
+	)
+
+	testSketchAnnotation := func(values ...float64) []byte {
+		sketch := ddsketch.NewSketch(ddsketch.NewOptions())
+		for _, v := range values {
+			sketch.Add(v)
+		}
+		payload := annotation.Payload{TimerSketch: sketch.Marshal(nil)}
+		data, err := payload.Marshal()
+		require.NoError(t, err)
+		return data
+	}
+
+	// Register two timers forwarding to the same metric.
+	var (
+		writeFns  []writeForwardedMetricFn
+		onDoneFns []onForwardedAggregationDoneFn
+	)
+	for i := 0; i < 2; i++ {
+		writeFn, onDoneFn, err := w.Register(testRegisterable{
+			metricType: metric.TimerType,
+			id:         mid,
+			key:        aggKey,
+		})
+		require.NoError(t, err)
+		writeFns = append(writeFns, writeFn)
+		onDoneFns = append(onDoneFns, onDoneFn)
+	}
+
+	writeFns[0](aggKey, 1234, 2.0, 0.0, testSketchAnnotation(1, 2, 3), false)
+	writeFns[1](aggKey, 1234, 20.0, 0.0, testSketchAnnotation(10, 20, 30, 40), false)
+
+	var written aggregated.ForwardedMetric
+	c.EXPECT().WriteForwarded(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(metric aggregated.ForwardedMetric, _ metadata.ForwardMetadata) error {
+			written = metric
+			return nil
+		})
+	require.NoError(t, onDoneFns[0](aggKey, nil))
+	require.NoError(t, onDoneFns[1](aggKey, nil))
+
+	require.Equal(t, []float64{2.0, 20.0}, written.Values)
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(written.Annotation))
+	sketch, err := ddsketch.Unmarshal(payload.TimerSketch)
+	require.NoError(t, err)
+	require.Equal(t, 7.0, sketch.Count())
+	require.Equal(t, 1.0, sketch.Min())
+	require.Equal(t, 40.0, sketch.Max())
+}
+
+func TestForwardedWriterMergesDistinctSketches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		c      = client.NewMockAdminClient(ctrl)
+		opts   = NewOptions(clock.NewOptions()).SetAdminClient(c)
+		w      = newForwardedWriter(0, opts)
+		mid    = id.RawID("foo")
+		aggKey = testForwardedWriterAggregationKey
+	)
+
+	testDistinctAnnotation := func(values ...string) []byte {
+		sketch := hll.NewSketch()
+		for _, v := range values {
+			sketch.Add([]byte(v))
+		}
+		payload := annotation.Payload{DistinctSketch: sketch.Marshal(nil)}
+		data, err := payload.Marshal()
+		require.NoError(t, err)
+		return data
+	}
+
+	// Register two counters forwarding to the same metric.
+	var (
+		writeFns  []writeForwardedMetricFn
+		onDoneFns []onForwardedAggregationDoneFn
+	)
+	for i := 0; i < 2; i++ {
+		writeFn, onDoneFn, err := w.Register(testRegisterable{
+			metricType: metric.CounterType,
+			id:         mid,
+			key:        aggKey,
+		})
+		require.NoError(t, err)
+		writeFns = append(writeFns, writeFn)
+		onDoneFns = append(onDoneFns, onDoneFn)
+	}
+
+	writeFns[0](aggKey, 1234, 2.0, 0.0, testDistinctAnnotation("a", "b"), false)
+	writeFns[1](aggKey, 1234, 3.0, 0.0, testDistinctAnnotation("b", "c", "d"), false)
+
+	var written aggregated.ForwardedMetric
+	c.EXPECT().WriteForwarded(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(metric aggregated.ForwardedMetric, _ metadata.ForwardMetadata) error {
+			written = metric
+			return nil
+		})
+	require.NoError(t, onDoneFns[0](aggKey, nil))
+	require.NoError(t, onDoneFns[1](aggKey, nil))
+
+	require.Equal(t, []float64{2.0, 3.0}, written.Values)
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(written.Annotation))
+	sketch, err := hll.Unmarshal(payload.DistinctSketch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sketch.Estimate())
+}
+
 func TestForwardedWriterCloseWriterClosed(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/x/serialize"
+)
+
+// InspectQuery selects the aggregation elements to inspect.
+type InspectQuery struct {
+	// IDs are the IDs of the metrics whose elements are looked up.
+	IDs []id.RawID
+
+	// Filter selects the elements of the metrics whose ID matches the filter.
+	// The entries of the aggregator are scanned if no IDs are set.
+	Filter filters.TagsFilter
+
+	// MaxElems bounds the number of elements returned.
+	MaxElems int
+
+	// MaxScannedEntries bounds the number of entries scanned when no IDs are
+	// set.
+	MaxScannedEntries int
+}
+
+// InspectResult is the result of an inspection.
+type InspectResult struct {
+	Elems             []ElemInspection `json:"elems"`
+	NumScannedEntries int              `json:"numScannedEntries"`
+	Truncated         bool             `json:"truncated"`
+}
+
+// ElemInspection is the state of an aggregation element.
+type ElemInspection struct {
+	ID                string                `json:"id"`
+	Tags              map[string]string     `json:"tags,omitempty"`
+	Shard             uint32                `json:"shard"`
+	MetricType        string                `json:"metricType"`
+	ListType          string                `json:"listType"`
+	StoragePolicy     string                `json:"storagePolicy"`
+	AggregationTypes  []string              `json:"aggregationTypes"`
+	Pipeline          string                `json:"pipeline"`
+	NumForwardedTimes int                   `json:"numForwardedTimes"`
+	ResendEnabled     bool                  `json:"resendEnabled"`
+	Tombstoned        bool                  `json:"tombstoned"`
+	LastFlushedAt     time.Time             `json:"lastFlushedAt"`
+	Forwarding        *ForwardingInspection `json:"forwarding,omitempty"`
+	Windows           []WindowInspection    `json:"windows"`
+
+	// listID is the id of the list of the element, used to look up the time
+	// the list was last flushed.
+	listID metricListID
+}
+
+// ForwardingInspection is the destination of the aggregations of an element
+// forwarded to the next stage of its pipeline.
+type ForwardingInspection struct {
+	ID                string            `json:"id"`
+	Tags              map[string]string `json:"tags,omitempty"`
+	Shard             uint32            `json:"shard"`
+	AggregationTypes  []string          `json:"aggregationTypes"`
+	Pipeline          string            `json:"pipeline"`
+	NumForwardedTimes int               `json:"numForwardedTimes"`
+}
+
+// WindowInspection is the state of an open aggregation window of an element.
+// Values that are not finite are omitted.
+type WindowInspection struct {
+	StartAt       time.Time          `json:"startAt"`
+	LastUpdatedAt time.Time          `json:"lastUpdatedAt"`
+	Dirty         bool               `json:"dirty"`
+	ResendEnabled bool               `json:"resendEnabled"`
+	Closed        bool               `json:"closed"`
+	Values        map[string]float64 `json:"values"`
+}
+
+// elemInspector collects the inspections of the elements selected by a query.
+type elemInspector struct {
+	query     InspectQuery
+	shardFn   func(id id.RawID) uint32
+	tagIter   serialize.MetricTagsIterator
+	matchOpts filters.TagMatchOptions
+	result    InspectResult
+}
+
+func newElemInspector(query InspectQuery, shardFn func(id id.RawID) uint32) *elemInspector {
+	// NB: the IDs of the metrics sent by the coordinator are their encoded
+	// tags, the name of the metrics being one of their tags.
+	tagIter := serialize.NewUncheckedMetricTagsIterator(serialize.NewTagSerializationLimits())
+	return &elemInspector{
+		query:   query,
+		shardFn: shardFn,
+		tagIter: tagIter,
+		matchOpts: filters.TagMatchOptions{
+			NameAndTagsFn: func(id []byte) ([]byte, []byte, error) {
+				return nil, id, nil
+			},
+			SortedTagIteratorFn: func(tagPairs []byte) id.SortedTagIterator {
+				tagIter.Reset(tagPairs)
+				return tagIter
+			},
+		},
+		result: InspectResult{Elems: make([]ElemInspection, 0)},
+	}
+}
+
+// Done returns true once no more elements should be inspected.
+func (i *elemInspector) Done() bool {
+	return i.result.Truncated
+}
+
+// ScanEntry accounts for an entry scanned, returning false if the scan
+// reached its limit.
+func (i *elemInspector) ScanEntry() bool {
+	if i.result.NumScannedEntries >= i.query.MaxScannedEntries {
+		i.result.Truncated = true
+		return false
+	}
+	i.result.NumScannedEntries++
+	return true
+}
+
+// Matches returns true if the element of the metric with the given ID is
+// selected by the query.
+func (i *elemInspector) Matches(metricID id.RawID) bool {
+	if len(i.query.IDs) > 0 {
+		found := false
+		for _, queryID := range i.query.IDs {
+			if bytes.Equal(queryID, metricID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if i.query.Filter == nil {
+		return true
+	}
+	matched, err := i.query.Filter.Matches(metricID, i.matchOpts)
+	return err == nil && matched
+}
+
+// Add adds the inspection of an element, returning false if the inspection
+// reached its limit.
+func (i *elemInspector) Add(elem ElemInspection) bool {
+	if len(i.result.Elems) >= i.query.MaxElems {
+		i.result.Truncated = true
+		return false
+	}
+	elem.Tags = i.tags([]byte(elem.ID))
+	if fwd := elem.Forwarding; fwd != nil {
+		fwd.Tags = i.tags([]byte(fwd.ID))
+		fwd.Shard = i.shardFn([]byte(fwd.ID))
+	}
+	i.result.Elems = append(i.result.Elems, elem)
+	return true
+}
+
+// tags decodes the tags of a metric ID, returning nil if the ID is not made
+// of encoded tags.
+func (i *elemInspector) tags(metricID []byte) map[string]string {
+	i.tagIter.Reset(metricID)
+	tags := make(map[string]string, i.tagIter.NumTags())
+	for i.tagIter.Next() {
+		name, value := i.tagIter.Current()
+		tags[string(name)] = string(value)
+	}
+	if i.tagIter.Err() != nil || len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// Result returns the result of the inspection.
+func (i *elemInspector) Result() InspectResult {
+	return i.result
+}
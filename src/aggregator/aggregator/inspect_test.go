@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/metric"
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/serialize"
+)
+
+func testInspectShardFn(metricID id.RawID) uint32 { return uint32(len(metricID)) }
+
+func testEncodedTagsID(t *testing.T, tags ...string) id.RawID {
+	pool := serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(), nil)
+	pool.Init()
+	enc := pool.Get()
+	defer enc.Finalize()
+
+	require.NoError(t, enc.Encode(ident.MustNewTagStringsIterator(tags...)))
+	data, ok := enc.Data()
+	require.True(t, ok)
+	return append(id.RawID(nil), data.Bytes()...)
+}
+
+func testInspectFilter(t *testing.T, filter string) filters.TagsFilter {
+	filterValues, err := filters.ParseTagFilterValueMap(filter)
+	require.NoError(t, err)
+	f, err := filters.NewTagsFilter(filterValues, filters.Conjunction, filters.TagsFilterOptions{})
+	require.NoError(t, err)
+	return f
+}
+
+func TestMetricMapInspectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newMetricMap(testShard, testOptions(ctrl))
+	require.NoError(t, m.AddUntimed(testCounter, testCustomStagedMetadatas))
+	require.NoError(t, m.AddForwarded(aggregated.ForwardedMetric{
+		Type:      metric.CounterType,
+		ID:        []byte("forwardedMetric"),
+		TimeNanos: 12345,
+		Values:    []float64{76109},
+	}, testForwardMetadata))
+
+	query := InspectQuery{IDs: []id.RawID{testCounterID}, MaxElems: 10}
+	insp := newElemInspector(query, testInspectShardFn)
+	m.InspectID(testCounterID, insp)
+	res := insp.Result()
+	require.False(t, res.Truncated)
+	require.Len(t, res.Elems, 3)
+	var storagePolicies []string
+	for _, elem := range res.Elems {
+		require.Equal(t, string(testCounterID), elem.ID)
+		require.Nil(t, elem.Tags)
+		require.Equal(t, testShard, elem.Shard)
+		require.Equal(t, "counter", elem.MetricType)
+		require.Equal(t, "standard", elem.ListType)
+		require.Equal(t, []string{"Sum"}, elem.AggregationTypes)
+		require.Equal(t, 0, elem.NumForwardedTimes)
+		require.Nil(t, elem.Forwarding)
+		require.Len(t, elem.Windows, 1)
+		require.True(t, elem.Windows[0].Dirty)
+		require.Equal(t, map[string]float64{"Sum": 1234}, elem.Windows[0].Values)
+		storagePolicies = append(storagePolicies, elem.StoragePolicy)
+	}
+	sort.Strings(storagePolicies)
+	require.Equal(t, []string{"10m:30d", "10s:6h", "1m:2d"}, storagePolicies)
+
+	// Only the elements of the queried metrics are inspected.
+	m.InspectID(id.RawID("forwardedMetric"), insp)
+	require.Len(t, insp.Result().Elems, 3)
+
+	query = InspectQuery{IDs: []id.RawID{id.RawID("forwardedMetric")}, MaxElems: 10}
+	insp = newElemInspector(query, testInspectShardFn)
+	m.InspectID(id.RawID("forwardedMetric"), insp)
+	res = insp.Result()
+	require.Len(t, res.Elems, 1)
+	elem := res.Elems[0]
+	require.Equal(t, "forwarded", elem.ListType)
+	require.Equal(t, 3, elem.NumForwardedTimes)
+	require.Len(t, elem.Windows, 1)
+	require.Equal(t, map[string]float64{"Sum": 76109}, elem.Windows[0].Values)
+	require.Equal(t, &ForwardingInspection{
+		ID:                "foo",
+		Shard:             testInspectShardFn(id.RawID("foo")),
+		AggregationTypes:  []string{"Count"},
+		Pipeline:          "{operations: []}",
+		NumForwardedTimes: 4,
+	}, elem.Forwarding)
+
+	// The number of elements returned is bounded.
+	query = InspectQuery{IDs: []id.RawID{testCounterID}, MaxElems: 2}
+	insp = newElemInspector(query, testInspectShardFn)
+	m.InspectID(testCounterID, insp)
+	res = insp.Result()
+	require.True(t, res.Truncated)
+	require.True(t, insp.Done())
+	require.Len(t, res.Elems, 2)
+}
+
+func TestMetricMapInspectEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		m      = newMetricMap(testShard, testOptions(ctrl))
+		prodID = testEncodedTagsID(t, "__name__", "http_requests", "env", "prod")
+		devID  = testEncodedTagsID(t, "__name__", "http_requests", "env", "dev")
+	)
+	for _, metricID := range []id.RawID{prodID, devID, testCounterID} {
+		require.NoError(t, m.AddUntimed(unaggregated.MetricUnion{
+			Type:       metric.CounterType,
+			ID:         metricID,
+			CounterVal: 1234,
+		}, testCustomStagedMetadatas))
+	}
+
+	query := InspectQuery{
+		Filter:            testInspectFilter(t, "env:prod"),
+		MaxElems:          10,
+		MaxScannedEntries: 10,
+	}
+	insp := newElemInspector(query, testInspectShardFn)
+	m.InspectEntries(insp)
+	res := insp.Result()
+	require.False(t, res.Truncated)
+	require.Equal(t, 3, res.NumScannedEntries)
+	require.Len(t, res.Elems, 3)
+	for _, elem := range res.Elems {
+		require.Equal(t, string(prodID), elem.ID)
+		require.Equal(t, map[string]string{"__name__": "http_requests", "env": "prod"}, elem.Tags)
+	}
+
+	// The number of elements returned is bounded.
+	query.Filter = testInspectFilter(t, "__name__:http_*")
+	query.MaxElems = 4
+	insp = newElemInspector(query, testInspectShardFn)
+	m.InspectEntries(insp)
+	res = insp.Result()
+	require.True(t, res.Truncated)
+	require.Equal(t, 2, res.NumScannedEntries)
+	require.Len(t, res.Elems, 4)
+
+	// The number of entries scanned is bounded.
+	query.Filter = testInspectFilter(t, "env:dev")
+	query.MaxElems = 10
+	query.MaxScannedEntries = 1
+	insp = newElemInspector(query, testInspectShardFn)
+	m.InspectEntries(insp)
+	res = insp.Result()
+	require.True(t, res.Truncated)
+	require.Equal(t, 1, res.NumScannedEntries)
+	require.Empty(t, res.Elems)
+}
+
+func TestAggregatorInspect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	agg, _ := testAggregator(t, ctrl)
+	query := InspectQuery{MaxElems: 100, MaxScannedEntries: 100}
+	_, err := agg.Inspect(query)
+	require.Equal(t, errAggregatorNotOpenOrClosed, err)
+
+	require.NoError(t, agg.Open())
+	agg.shardFn = func([]byte, uint32) uint32 { return 1 }
+	require.NoError(t, agg.AddUntimed(testUntimedMetric, testStagedMetadatas))
+
+	scanned, err := agg.Inspect(query)
+	require.NoError(t, err)
+	require.Equal(t, 1, scanned.NumScannedEntries)
+	require.NotEmpty(t, scanned.Elems)
+
+	// Metrics queried more than once are only inspected once.
+	query.IDs = []id.RawID{testUntimedMetric.ID, testUntimedMetric.ID, id.RawID("unknown")}
+	res, err := agg.Inspect(query)
+	require.NoError(t, err)
+	require.Equal(t, scanned.Elems, res.Elems)
+	for _, elem := range res.Elems {
+		require.Equal(t, string(testUntimedMetric.ID), elem.ID)
+		require.Equal(t, uint32(1), elem.Shard)
+	}
+}
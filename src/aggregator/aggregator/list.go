@@ -52,9 +52,17 @@ type metricList interface {
 	// Len returns the number of elements in the list.
 	Len() int
 
+	// LastFlushedNanos returns the time before which the aggregations of the
+	// list were last flushed.
+	LastFlushedNanos() int64
+
 	// PushBack pushes a metric element to the back of the list.
 	PushBack(value metricElem) (*list.Element, error)
 
+	// Checkpoint calls the given function for each element in the list while
+	// no flush is in progress.
+	Checkpoint(fn metricElemFn) error
+
 	// Close closes the metric list.
 	Close()
 }
@@ -148,6 +156,8 @@ func newMetricListMetrics(scope tally.Scope) baseMetricListMetrics {
 // of aggregation windows that are eligible for flushing.
 type targetNanosFn func(nowNanos int64) int64
 
+type metricElemFn func(elem metricElem) error
+
 type flushBeforeFn func(beforeNanos int64, jitter time.Duration, flushType flushType)
 
 // baseMetricList is a metric list storing aggregations at a given resolution and
@@ -156,6 +166,10 @@ type flushBeforeFn func(beforeNanos int64, jitter time.Duration, flushType flush
 type baseMetricList struct {
 	sync.RWMutex
 
+	// flushLock serializes flushes and checkpoints, which both access the
+	// flush state of the elements.
+	flushLock sync.Mutex
+
 	shard            uint32
 	opts             Options
 	nowFn            clock.NowFn
@@ -271,6 +285,26 @@ func (l *baseMetricList) PushBack(value metricElem) (*list.Element, error) {
 	return elem, nil
 }
 
+// Checkpoint calls the given function for each element in the list. It
+// blocks flushing until all elements are processed.
+func (l *baseMetricList) Checkpoint(fn metricElemFn) error {
+	l.flushLock.Lock()
+	defer l.flushLock.Unlock()
+
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.closed {
+		return errListClosed
+	}
+	for e := l.aggregations.Front(); e != nil; e = e.Next() {
+		if err := fn(e.Value.(metricElem)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close closes the list.
 func (l *baseMetricList) Close() bool {
 	l.Lock()
@@ -339,6 +373,9 @@ func (l *baseMetricList) DiscardBefore(beforeNanos int64) {
 // flushBefore flushes or discards data before a given time based on the flush type.
 // It is not thread-safe.
 func (l *baseMetricList) flushBefore(beforeNanos int64, jitter time.Duration, flushType flushType) {
+	l.flushLock.Lock()
+	defer l.flushLock.Unlock()
+
 	if l.LastFlushedNanos() >= beforeNanos {
 		l.metrics.flushBeforeStale.Inc(1)
 		return
@@ -809,6 +846,28 @@ type metricListID struct {
 	timed     timedMetricListID
 }
 
+// newMetricListID returns the id of the list storing the aggregations of the
+// given list type, resolution and number of times forwarded.
+func newMetricListID(
+	listType metricListType,
+	resolution time.Duration,
+	numForwardedTimes int,
+) (metricListID, error) {
+	switch listType {
+	case standardMetricListType:
+		return standardMetricListID{resolution: resolution}.toMetricListID(), nil
+	case forwardedMetricListType:
+		return forwardedMetricListID{
+			resolution:        resolution,
+			numForwardedTimes: numForwardedTimes,
+		}.toMetricListID(), nil
+	case timedMetricListType:
+		return timedMetricListID{resolution: resolution}.toMetricListID(), nil
+	default:
+		return metricListID{}, fmt.Errorf("unknown list type: %v", listType)
+	}
+}
+
 func newMetricList(shard uint32, id metricListID, opts Options) (metricList, error) {
 	switch id.listType {
 	case standardMetricListType:
@@ -888,6 +947,18 @@ func (l *metricLists) FindOrCreate(id metricListID) (metricList, error) {
 	return list, nil
 }
 
+// Find looks up a metric list, returning false if it does not exist.
+func (l *metricLists) Find(id metricListID) (metricList, bool) {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.closed {
+		return nil, false
+	}
+	list, exists := l.lists[id]
+	return list, exists
+}
+
 // Tick ticks through each list and returns the list sizes.
 func (l *metricLists) Tick() listsTickResult {
 	l.RLock()
@@ -913,6 +984,27 @@ func (l *metricLists) Tick() listsTickResult {
 	return res
 }
 
+// Checkpoint calls the given function for each element of each list.
+func (l *metricLists) Checkpoint(fn metricElemFn) error {
+	l.RLock()
+	if l.closed {
+		l.RUnlock()
+		return errListsClosed
+	}
+	lists := make([]metricList, 0, len(l.lists))
+	for _, list := range l.lists {
+		lists = append(lists, list)
+	}
+	l.RUnlock()
+
+	for _, list := range lists {
+		if err := list.Checkpoint(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close closes the metric lists.
 func (l *metricLists) Close() {
 	l.Lock()
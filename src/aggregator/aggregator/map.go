@@ -23,17 +23,22 @@ package aggregator
 import (
 	"container/list"
 	"errors"
+	"fmt"
 	"math"
 	"sync"
 	"time"
 
 	"github.com/uber-go/tally"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/hash"
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
+	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 	"github.com/m3db/m3/src/x/clock"
 	xresource "github.com/m3db/m3/src/x/resource"
@@ -267,6 +272,130 @@ func (m *metricMap) SetRuntimeOptions(opts runtime.Options) {
 	m.entryListDelLock.Unlock()
 }
 
+// Checkpoint writes the checkpoint of the aggregations of the map to the given
+// path and returns the number of elements checkpointed.
+func (m *metricMap) Checkpoint(
+	path string,
+	opts CheckpointOptions,
+	compressor maggregation.IDCompressor,
+	checkpointAt time.Time,
+) (int, error) {
+	return writeCheckpoint(path, opts, m.metricLists, compressor, checkpointAt)
+}
+
+// Restore restores the aggregations of the map from the checkpoint at the
+// given path unless it was taken before minTime, and returns the number of
+// elements restored. The map must not be written to before it is restored.
+func (m *metricMap) Restore(path string, minTime time.Time) (time.Time, int, error) {
+	return readCheckpoint(path, minTime, m.restoreElem)
+}
+
+func (m *metricMap) restoreElem(ckptKey elemCheckpointKey, dec *raggregation.StateDecoder) error {
+	var category metricCategory
+	switch ckptKey.listType {
+	case standardMetricListType:
+		category = untimedMetric
+	case forwardedMetricListType:
+		category = forwardedMetric
+	case timedMetricListType:
+		category = timedMetric
+	default:
+		return fmt.Errorf("unknown list type: %v", ckptKey.listType)
+	}
+	key := entryKey{
+		metricCategory: category,
+		metricType:     metricType(ckptKey.metricType),
+		idHash:         hash.Murmur3Hash128(ckptKey.id),
+	}
+
+	// NB: restored entries are not subject to the new metric rate limit since
+	// they were already accepted before the checkpoint was taken.
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		return errMetricMapClosed
+	}
+	entry, found := m.lookupEntryWithLock(key)
+	if !found {
+		entry = m.entryPool.Get()
+		entry.ResetSetData(m.metricLists, m.runtimeOpts, m.opts)
+		m.entries[key] = m.entryList.PushBack(hashedEntry{
+			key:   key,
+			entry: entry,
+		})
+		m.metrics.newEntries.Inc(1)
+	}
+	entry.IncWriter()
+	m.Unlock()
+
+	err := entry.restoreAggregation(ckptKey, dec)
+	entry.DecWriter()
+	return err
+}
+
+// InspectID inspects the elements of the entries of the metric with the given
+// ID until the inspection reaches its limit.
+func (m *metricMap) InspectID(metricID id.RawID, insp *elemInspector) {
+	idHash := hash.Murmur3Hash128(metricID)
+	for _, category := range validMetricCategories {
+		for _, mType := range metric.ValidTypes {
+			key := entryKey{
+				metricCategory: category,
+				metricType:     metricType(mType),
+				idHash:         idHash,
+			}
+			m.RLock()
+			if m.closed {
+				m.RUnlock()
+				return
+			}
+			entry, found := m.lookupEntryWithLock(key)
+			if found {
+				// NB: the writer count keeps the entry from expiring while
+				// it is inspected.
+				entry.IncWriter()
+			}
+			m.RUnlock()
+			if !found {
+				continue
+			}
+			more := entry.inspect(insp)
+			entry.DecWriter()
+			if !more {
+				return
+			}
+		}
+	}
+}
+
+// InspectEntries scans the entries of the map and inspects their elements
+// until the inspection reaches its limits.
+func (m *metricMap) InspectEntries(insp *elemInspector) {
+	// NB: the entries cannot be deleted from the list while the delete lock
+	// is held so the scan can resume from the last entry scanned.
+	m.entryListDelLock.Lock()
+	defer m.entryListDelLock.Unlock()
+
+	m.RLock()
+	if m.closed {
+		m.RUnlock()
+		return
+	}
+	currElem := m.entryList.Front()
+	m.RUnlock()
+
+	for currElem != nil && insp.ScanEntry() {
+		m.RLock()
+		entry := currElem.Value.(hashedEntry).entry
+		currElem = currElem.Next()
+		m.RUnlock()
+
+		if !entry.inspect(insp) {
+			return
+		}
+	}
+}
+
 func (m *metricMap) Close() {
 	m.Lock()
 	defer m.Unlock()
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
@@ -81,6 +82,11 @@ type MaxAllowedForwardingDelayFn func(resolution time.Duration, numForwardedTime
 // BufferForPastTimedMetricFn returns the buffer duration for past timed metrics.
 type BufferForPastTimedMetricFn func(resolution time.Duration) time.Duration
 
+// TimerSketchOptionsFn returns the sketch options of the timers aggregated
+// with the given aggregation policy, or nil if their quantiles are computed
+// with the CM stream.
+type TimerSketchOptionsFn func(p policy.Policy) ddsketch.Options
+
 // Options provide a set of base and derived options for the aggregator.
 type Options interface {
 	/// Read-write base options.
@@ -139,6 +145,14 @@ type Options interface {
 	// StreamOptions returns the stream options.
 	StreamOptions() cm.Options
 
+	// SetTimerSketchOptionsFn sets the function that determines the sketch
+	// options of timers given their aggregation policy.
+	SetTimerSketchOptionsFn(value TimerSketchOptionsFn) Options
+
+	// TimerSketchOptionsFn returns the function that determines the sketch
+	// options of timers given their aggregation policy.
+	TimerSketchOptionsFn() TimerSketchOptionsFn
+
 	// SetAdminClient sets the administrative client.
 	SetAdminClient(value client.AdminClient) Options
 
@@ -273,6 +287,12 @@ type Options interface {
 	// MaxNumCachedSourceSets returns the maximum number of cached source sets.
 	MaxNumCachedSourceSets() int
 
+	// SetCheckpointOptions sets the checkpoint options.
+	SetCheckpointOptions(value CheckpointOptions) Options
+
+	// CheckpointOptions returns the checkpoint options.
+	CheckpointOptions() CheckpointOptions
+
 	// SetDiscardNaNAggregatedValues determines whether NaN aggregated values are discarded.
 	SetDiscardNaNAggregatedValues(value bool) Options
 
@@ -303,6 +323,12 @@ type Options interface {
 	// GaugeElemPool returns the gauge element pool.
 	GaugeElemPool() GaugeElemPool
 
+	// SetHistogramElemPool sets the histogram element pool.
+	SetHistogramElemPool(value HistogramElemPool) Options
+
+	// HistogramElemPool returns the histogram element pool.
+	HistogramElemPool() HistogramElemPool
+
 	/// Read-only derived options.
 
 	// FullCounterPrefix returns the full prefix for counters.
@@ -360,6 +386,7 @@ type options struct {
 	clockOpts                        clock.Options
 	instrumentOpts                   instrument.Options
 	streamOpts                       cm.Options
+	timerSketchOptionsFn             TimerSketchOptionsFn
 	adminClient                      client.AdminClient
 	runtimeOptsManager               runtime.OptionsManager
 	placementManager                 PlacementManager
@@ -382,11 +409,13 @@ type options struct {
 	bufferForPastTimedMetricFn       BufferForPastTimedMetricFn
 	bufferForFutureTimedMetric       time.Duration
 	maxNumCachedSourceSets           int
+	checkpointOpts                   CheckpointOptions
 	discardNaNAggregatedValues       bool
 	entryPool                        EntryPool
 	counterElemPool                  CounterElemPool
 	timerElemPool                    TimerElemPool
 	gaugeElemPool                    GaugeElemPool
+	histogramElemPool                HistogramElemPool
 	verboseErrors                    bool
 	addToReset                       bool
 	timedMetricsFlushOffsetEnabled   bool
@@ -416,6 +445,7 @@ func NewOptions(clockOpts clock.Options) Options {
 		clockOpts:                        clockOpts,
 		instrumentOpts:                   instrument.NewOptions(),
 		streamOpts:                       cm.NewOptions(),
+		timerSketchOptionsFn:             defaultTimerSketchOptionsFn,
 		runtimeOptsManager:               runtime.NewOptionsManager(runtime.NewOptions()),
 		shardFn:                          sharding.Murmur32Hash.MustShardFn(),
 		bufferDurationBeforeShardCutover: defaultBufferDurationBeforeShardCutover,
@@ -432,6 +462,7 @@ func NewOptions(clockOpts clock.Options) Options {
 		bufferForPastTimedMetricFn:       defaultBufferForPastTimedMetricFn,
 		bufferForFutureTimedMetric:       defaultTimedMetricBuffer,
 		maxNumCachedSourceSets:           defaultMaxNumCachedSourceSets,
+		checkpointOpts:                   NewCheckpointOptions(),
 		discardNaNAggregatedValues:       defaultDiscardNaNAggregatedValues,
 		verboseErrors:                    defaultVerboseErrors,
 	}
@@ -539,6 +570,16 @@ func (o *options) StreamOptions() cm.Options {
 	return o.streamOpts
 }
 
+func (o *options) SetTimerSketchOptionsFn(value TimerSketchOptionsFn) Options {
+	opts := *o
+	opts.timerSketchOptionsFn = value
+	return &opts
+}
+
+func (o *options) TimerSketchOptionsFn() TimerSketchOptionsFn {
+	return o.timerSketchOptionsFn
+}
+
 func (o *options) SetAdminClient(value client.AdminClient) Options {
 	opts := *o
 	opts.adminClient = value
@@ -759,6 +800,16 @@ func (o *options) MaxNumCachedSourceSets() int {
 	return o.maxNumCachedSourceSets
 }
 
+func (o *options) SetCheckpointOptions(value CheckpointOptions) Options {
+	opts := *o
+	opts.checkpointOpts = value
+	return &opts
+}
+
+func (o *options) CheckpointOptions() CheckpointOptions {
+	return o.checkpointOpts
+}
+
 func (o *options) SetDiscardNaNAggregatedValues(value bool) Options {
 	opts := *o
 	opts.discardNaNAggregatedValues = value
@@ -809,6 +860,16 @@ func (o *options) GaugeElemPool() GaugeElemPool {
 	return o.gaugeElemPool
 }
 
+func (o *options) SetHistogramElemPool(value HistogramElemPool) Options {
+	opts := *o
+	opts.histogramElemPool = value
+	return &opts
+}
+
+func (o *options) HistogramElemPool() HistogramElemPool {
+	return o.histogramElemPool
+}
+
 func (o *options) SetVerboseErrors(value bool) Options {
 	opts := *o
 	opts.verboseErrors = value
@@ -858,6 +919,11 @@ func (o *options) initPools() {
 	o.gaugeElemPool.Init(func() *GaugeElem {
 		return MustNewGaugeElem(ElemData{}, elemOpts)
 	})
+
+	o.histogramElemPool = NewHistogramElemPool(nil)
+	o.histogramElemPool.Init(func() *HistogramElem {
+		return MustNewHistogramElem(ElemData{}, elemOpts)
+	})
 }
 
 func (o *options) computeAllDerived() {
@@ -941,3 +1007,7 @@ func defaultMaxAllowedForwardingDelayFn(
 func defaultBufferForPastTimedMetricFn(resolution time.Duration) time.Duration {
 	return resolution + defaultTimedMetricBuffer
 }
+
+func defaultTimerSketchOptionsFn(policy.Policy) ddsketch.Options {
+	return nil
+}
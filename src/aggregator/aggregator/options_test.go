@@ -29,10 +29,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
 	"github.com/m3db/m3/src/aggregator/runtime"
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/instrument"
 )
@@ -123,6 +126,17 @@ func TestSetStreamOptions(t *testing.T) {
 	require.Equal(t, value, o.StreamOptions())
 }
 
+func TestSetTimerSketchOptionsFn(t *testing.T) {
+	p := policy.NewPolicy(testStoragePolicy, aggregation.DefaultID)
+	require.Nil(t, newTestOptions().TimerSketchOptionsFn()(p))
+
+	value := ddsketch.NewOptions()
+	o := newTestOptions().SetTimerSketchOptionsFn(func(policy.Policy) ddsketch.Options {
+		return value
+	})
+	require.Equal(t, value, o.TimerSketchOptionsFn()(p))
+}
+
 func TestSetAdminClient(t *testing.T) {
 	var c client.AdminClient = &client.M3MsgClient{}
 	o := newTestOptions().SetAdminClient(c)
@@ -29,8 +29,10 @@ import (
 
 	"github.com/uber-go/tally"
 
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 	"github.com/m3db/m3/src/x/clock"
 )
@@ -264,6 +266,34 @@ func (s *aggregatorShard) Tick(target time.Duration) tickResult {
 	return s.metricMap.Tick(target)
 }
 
+// Checkpoint writes the checkpoint of the aggregations of the shard to the
+// given path and returns the number of elements checkpointed.
+func (s *aggregatorShard) Checkpoint(
+	path string,
+	opts CheckpointOptions,
+	compressor maggregation.IDCompressor,
+	checkpointAt time.Time,
+) (int, error) {
+	return s.metricMap.Checkpoint(path, opts, compressor, checkpointAt)
+}
+
+// Restore restores the aggregations of the shard from the checkpoint at the
+// given path unless it was taken before minTime. It returns the time the
+// checkpoint was taken at and the number of elements restored.
+func (s *aggregatorShard) Restore(path string, minTime time.Time) (time.Time, int, error) {
+	return s.metricMap.Restore(path, minTime)
+}
+
+// InspectID inspects the elements of the metric with the given ID.
+func (s *aggregatorShard) InspectID(metricID id.RawID, insp *elemInspector) {
+	s.metricMap.InspectID(metricID, insp)
+}
+
+// InspectEntries scans the entries of the shard and inspects their elements.
+func (s *aggregatorShard) InspectEntries(insp *elemInspector) {
+	s.metricMap.InspectEntries(insp)
+}
+
 func (s *aggregatorShard) Close() {
 	s.Lock()
 	defer s.Unlock()
@@ -31,9 +31,11 @@ import (
 	"time"
 
 	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
+	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/metrics/pipeline/applied"
 	"github.com/m3db/m3/src/metrics/transformation"
 	"github.com/m3db/m3/src/x/instrument"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -213,9 +215,7 @@ func (e *TimerElem) AddUnique(
 			}
 		}
 	} else {
-		for _, v := range metric.Values {
-			lockedAgg.aggregation.Add(timestamp, v, metric.Annotation)
-		}
+		lockedAgg.aggregation.AddForwarded(timestamp, metric.Values, metric.Annotation)
 	}
 	lockedAgg.dirty = true
 	lockedAgg.lastUpdatedAt = xtime.Now()
@@ -535,6 +535,7 @@ func (e *TimerElem) Close() {
 	}
 	e.closed = true
 	e.id = nil
+	e.pipeline = applied.Pipeline{}
 	e.parsedPipeline = parsedPipeline{}
 	e.writeForwardedMetricFn = nil
 	e.onForwardedAggregationWrittenFn = nil
@@ -588,6 +589,165 @@ func (e *TimerElem) Close() {
 	pool.Put(e)
 }
 
+// Checkpoint encodes the state of the aggregations of the element along with
+// their flush state, so that it can be restored by Restore.
+// NB: Checkpoint must not be called concurrently with Consume since the flush
+// state is only accessed by the flushing goroutine.
+func (e *TimerElem) Checkpoint(enc *raggregation.StateEncoder) {
+	e.RLock()
+	defer e.RUnlock()
+
+	enc.EncodeUvarint(uint64(len(e.values)))
+	if len(e.values) == 0 {
+		return
+	}
+	for agg, ok := e.values[e.minStartTime], true; ok; agg, ok = e.nextAggWithLock(agg) {
+		enc.EncodeVarint(int64(agg.startAt))
+
+		agg.lockedAgg.mtx.Lock()
+		enc.EncodeVarint(int64(agg.lockedAgg.lastUpdatedAt))
+		enc.EncodeBool(agg.lockedAgg.dirty)
+		enc.EncodeBool(agg.lockedAgg.resendEnabled)
+		enc.EncodeBool(agg.lockedAgg.closed)
+		enc.EncodeUvarint(uint64(len(agg.lockedAgg.sourcesSeen)))
+		for sourceID, versionsSeen := range agg.lockedAgg.sourcesSeen {
+			enc.EncodeUvarint(uint64(sourceID))
+			words := versionsSeen.Bytes()
+			enc.EncodeUvarint(uint64(len(words)))
+			for _, word := range words {
+				enc.EncodeUvarint(word)
+			}
+		}
+		agg.lockedAgg.aggregation.EncodeState(enc)
+		agg.lockedAgg.mtx.Unlock()
+
+		fState, ok := e.flushState[agg.startAt]
+		enc.EncodeBool(ok)
+		if !ok {
+			continue
+		}
+		enc.EncodeBool(fState.flushed)
+		enc.EncodeBool(fState.latestResendEnabled)
+		encodeFloat64s(enc, fState.consumedValues)
+		encodeFloat64s(enc, fState.emittedValues)
+	}
+}
+
+// Inspect returns the state of the element and of its open aggregation windows.
+func (e *TimerElem) Inspect() ElemInspection {
+	e.RLock()
+	defer e.RUnlock()
+
+	insp := e.inspectWithLock()
+	insp.MetricType = e.Type().String()
+	insp.Windows = make([]WindowInspection, 0, len(e.values))
+	if len(e.values) == 0 {
+		return insp
+	}
+	for agg, ok := e.values[e.minStartTime], true; ok; agg, ok = e.nextAggWithLock(agg) {
+		agg.lockedAgg.mtx.Lock()
+		window := WindowInspection{
+			StartAt:       agg.startAt.ToTime(),
+			LastUpdatedAt: agg.lockedAgg.lastUpdatedAt.ToTime(),
+			Dirty:         agg.lockedAgg.dirty,
+			ResendEnabled: agg.lockedAgg.resendEnabled,
+			Closed:        agg.lockedAgg.closed,
+			Values:        make(map[string]float64, len(e.aggTypes)),
+		}
+		for _, aggType := range e.aggTypes {
+			value := agg.lockedAgg.aggregation.ValueOf(aggType)
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				continue
+			}
+			window.Values[aggType.String()] = value
+		}
+		agg.lockedAgg.mtx.Unlock()
+		insp.Windows = append(insp.Windows, window)
+	}
+	return insp
+}
+
+// Restore restores the state of the aggregations of the element encoded by
+// Checkpoint. It must be called before any value is added to the element.
+func (e *TimerElem) Restore(dec *raggregation.StateDecoder) error {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.closed {
+		return errElemClosed
+	}
+	if len(e.values) > 0 {
+		return errElemNotEmpty
+	}
+	numValues := dec.DecodeUvarint()
+	var prevStart xtime.UnixNano
+	for i := uint64(0); i < numValues && dec.Err() == nil; i++ {
+		startAt := xtime.UnixNano(dec.DecodeVarint())
+		if i > 0 && startAt <= prevStart {
+			return errCheckpointOutOfOrder
+		}
+		lastUpdatedAt := xtime.UnixNano(dec.DecodeVarint())
+		dirty := dec.DecodeBool()
+		resendEnabled := dec.DecodeBool()
+		closed := dec.DecodeBool()
+		var sourcesSeen map[uint32]*bitset.BitSet
+		if numSources := dec.DecodeUvarint(); numSources > 0 {
+			sourcesSeen = make(map[uint32]*bitset.BitSet)
+			for j := uint64(0); j < numSources && dec.Err() == nil; j++ {
+				sourceID := uint32(dec.DecodeUvarint())
+				var words []uint64
+				for k, numWords := uint64(0), dec.DecodeUvarint(); k < numWords && dec.Err() == nil; k++ {
+					words = append(words, dec.DecodeUvarint())
+				}
+				sourcesSeen[sourceID] = bitset.From(words)
+			}
+		}
+		if err := dec.Err(); err != nil {
+			return err
+		}
+		aggregation := e.NewAggregation(e.opts, e.aggOpts)
+		if err := aggregation.DecodeState(dec); err != nil {
+			aggregation.Close()
+			return err
+		}
+
+		timedAgg := timedTimer{
+			startAt:   startAt,
+			lockedAgg: lockedTimerAggregationFromPool(aggregation, sourcesSeen),
+		}
+		timedAgg.lockedAgg.lastUpdatedAt = lastUpdatedAt
+		timedAgg.lockedAgg.dirty = dirty
+		timedAgg.lockedAgg.resendEnabled = resendEnabled
+		timedAgg.lockedAgg.closed = closed
+		if i > 0 {
+			timedAgg.prevStart = prevStart
+			prevAgg := e.values[prevStart]
+			prevAgg.nextStart = startAt
+			e.values[prevStart] = prevAgg
+		} else {
+			e.minStartTime = startAt
+		}
+		if dirty {
+			timedAgg.inDirtySet = true
+			e.insertDirty(startAt)
+		}
+		e.values[startAt] = timedAgg
+		e.maxStartTime = startAt
+		prevStart = startAt
+
+		if hasFlushState := dec.DecodeBool(); !hasFlushState {
+			continue
+		}
+		e.flushState[startAt] = flushState{
+			flushed:             dec.DecodeBool(),
+			latestResendEnabled: dec.DecodeBool(),
+			consumedValues:      decodeFloat64s(dec),
+			emittedValues:       decodeFloat64s(dec),
+		}
+	}
+	return dec.Err()
+}
+
 func (e *TimerElem) insertDirty(alignedStart xtime.UnixNano) {
 	numValues := len(e.dirty)
 
@@ -754,7 +914,25 @@ func (e *TimerElem) processValue(
 		prevTimestamp    = xtime.UnixNano(timestampNanosFn(int64(cState.prevStartTime), resolution))
 		// expectedProcessingTime should be the next resolution window after the aggregation was updated.
 		expectedProcessingTime = cState.lastUpdatedAt.Truncate(resolution).Add(resolution)
+		// The annotation is only forwarded with the first value forwarded, so that the timer
+		// sketch it may carry is merged once per aggregation by the forwarded writer.
+		forwardedAnnotation = cState.annotation
+		// Distinct sketches are only forwarded, the values flushed locally do not carry them.
+		localAnnotation         = cState.annotation
+		countDistinctAnnotation []byte
+		// Timer sketches are only flushed locally with the values of one aggregation type.
+		timerSketchAnnotation []byte
+		timerSketchAggType    = maggregation.UnknownType
 	)
+	if e.aggOpts.HasCountDistinct && !e.parsedPipeline.HasRollup {
+		localAnnotation = raggregation.StripDistinctSketch(cState.annotation)
+		countDistinctAnnotation = raggregation.CountDistinctAnnotation(cState.annotation)
+	}
+	if e.aggOpts.TimerSketch != nil && !e.parsedPipeline.HasRollup {
+		timerSketchAnnotation = localAnnotation
+		timerSketchAggType = raggregation.TimerSketchAggregationType(e.aggTypes)
+		localAnnotation = raggregation.StripTimerSketch(localAnnotation)
+	}
 	fState := e.flushState[cState.startAt]
 	if cState.dirty && fState.flushed && !cState.resendEnabled {
 		cState := cState
@@ -856,20 +1034,29 @@ func (e *TimerElem) processValue(
 			if extraDp.TimeNanos != 0 {
 				toFlush = append(toFlush, extraDp)
 			}
+			annotation := localAnnotation
+			switch aggType {
+			case maggregation.CountDistinct:
+				// Distinct counts are gauges regardless of the type of the aggregated metric.
+				annotation = countDistinctAnnotation
+			case timerSketchAggType:
+				annotation = timerSketchAnnotation
+			}
 			for _, point := range toFlush {
 				switch e.idPrefixSuffixType {
 				case NoPrefixNoSuffix:
-					flushLocalFn(nil, e.id, nil, point.TimeNanos, point.Value, cState.annotation,
+					flushLocalFn(nil, e.id, nil, point.TimeNanos, point.Value, annotation,
 						e.sp)
 				case WithPrefixWithSuffix:
 					flushLocalFn(e.FullPrefix(e.opts), e.id, e.TypeStringFor(e.aggTypesOpts, aggType),
-						point.TimeNanos, point.Value, cState.annotation, e.sp)
+						point.TimeNanos, point.Value, annotation, e.sp)
 				}
 			}
 		} else {
 			forwardedAggregationKey, _ := e.ForwardedAggregationKey()
 			flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey,
-				int64(timestamp), value, prevValue, cState.annotation, cState.resendEnabled)
+				int64(timestamp), value, prevValue, forwardedAnnotation, cState.resendEnabled)
+			forwardedAnnotation = nil
 		}
 		// add latenessAllowed and jitter to the timestamp of the aggregation, since those should not be
 		// counted towards the processing lag.
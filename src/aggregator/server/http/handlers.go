@@ -25,9 +25,12 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/m3db/m3/src/aggregator/aggregator"
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/metric/id"
 	xerrors "github.com/m3db/m3/src/x/errors"
 )
 
@@ -36,17 +39,24 @@ const (
 	HealthPath = "/health"
 	ResignPath = "/resign"
 	StatusPath = "/status"
+	// InspectPath is the path of the endpoint returning the state of the
+	// aggregation elements of the metrics with the given IDs or matching
+	// the given tag filter.
+	InspectPath = "/inspect"
 )
 
 var (
 	errRequestMustBeGet  = xerrors.NewInvalidParamsError(errors.New("request must be GET"))
 	errRequestMustBePost = xerrors.NewInvalidParamsError(errors.New("request must be POST"))
+	errNoInspectQuery    = xerrors.NewInvalidParamsError(errors.New("id or filter must be set"))
+	errInvalidLimit      = xerrors.NewInvalidParamsError(errors.New("limit must be a positive integer"))
 )
 
-func registerHandlers(mux *http.ServeMux, aggregator aggregator.Aggregator) {
+func registerHandlers(mux *http.ServeMux, aggregator aggregator.Aggregator, opts Options) {
 	registerHealthHandler(mux)
 	registerResignHandler(mux, aggregator)
 	registerStatusHandler(mux, aggregator)
+	registerInspectHandler(mux, aggregator, opts)
 }
 
 func registerHealthHandler(mux *http.ServeMux) {
@@ -92,6 +102,70 @@ func registerStatusHandler(mux *http.ServeMux, aggregator aggregator.Aggregator)
 	})
 }
 
+func registerInspectHandler(mux *http.ServeMux, aggregator aggregator.Aggregator, opts Options) {
+	mux.HandleFunc(InspectPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if httpMethod := strings.ToUpper(r.Method); httpMethod != http.MethodGet {
+			writeErrorResponse(w, errRequestMustBeGet)
+			return
+		}
+
+		query, err := newInspectQuery(r, opts)
+		if err != nil {
+			writeErrorResponse(w, err)
+			return
+		}
+		result, err := aggregator.Inspect(query)
+		if err != nil {
+			writeErrorResponse(w, err)
+			return
+		}
+		writeInspectResponse(w, result)
+	})
+}
+
+// newInspectQuery parses an inspect request. The metrics are selected by
+// their raw IDs with the id parameter, which can be repeated, and by the tag
+// filter set with the filter parameter using the syntax of the filters of
+// rules. The number of elements returned can be lowered with the limit
+// parameter.
+func newInspectQuery(r *http.Request, opts Options) (aggregator.InspectQuery, error) {
+	values := r.URL.Query()
+	query := aggregator.InspectQuery{
+		MaxElems:          opts.MaxInspectElems(),
+		MaxScannedEntries: opts.MaxInspectScannedEntries(),
+	}
+	for _, metricID := range values["id"] {
+		query.IDs = append(query.IDs, id.RawID(metricID))
+	}
+	if filter := values.Get("filter"); filter != "" {
+		filterValues, err := filters.ParseTagFilterValueMap(filter)
+		if err != nil {
+			return aggregator.InspectQuery{}, xerrors.NewInvalidParamsError(err)
+		}
+		// NB: the name of a metric is matched as any other tag.
+		query.Filter, err = filters.NewTagsFilter(filterValues, filters.Conjunction,
+			filters.TagsFilterOptions{})
+		if err != nil {
+			return aggregator.InspectQuery{}, xerrors.NewInvalidParamsError(err)
+		}
+	}
+	if len(query.IDs) == 0 && query.Filter == nil {
+		return aggregator.InspectQuery{}, errNoInspectQuery
+	}
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return aggregator.InspectQuery{}, errInvalidLimit
+		}
+		if n < query.MaxElems {
+			query.MaxElems = n
+		}
+	}
+	return query, nil
+}
+
 // Response is an HTTP response.
 type Response struct {
 	State string `json:"state,omitempty"`
@@ -104,12 +178,21 @@ type StatusResponse struct {
 	Status aggregator.RuntimeStatus `json:"status,omitempty"`
 }
 
+// InspectResponse is an inspect response.
+type InspectResponse struct {
+	Response
+	Result aggregator.InspectResult `json:"result"`
+}
+
 // NewResponse creates a new empty response.
 func NewResponse() Response { return Response{} }
 
 // NewStatusResponse creates a new empty status response.
 func NewStatusResponse() StatusResponse { return StatusResponse{} }
 
+// NewInspectResponse creates a new empty inspect response.
+func NewInspectResponse() InspectResponse { return InspectResponse{} }
+
 func newSuccessResponse() Response {
 	return Response{State: "OK"}
 }
@@ -137,6 +220,13 @@ func writeStatusResponse(w http.ResponseWriter, status aggregator.RuntimeStatus)
 	writeResponse(w, response, nil)
 }
 
+func writeInspectResponse(w http.ResponseWriter, result aggregator.InspectResult) {
+	response := NewInspectResponse()
+	response.State = "OK"
+	response.Result = result
+	writeResponse(w, response, nil)
+}
+
 func writeResponse(w http.ResponseWriter, resp interface{}, err error) {
 	buf := bytes.NewBuffer(nil)
 	if encodeErr := json.NewEncoder(buf).Encode(&resp); encodeErr != nil {
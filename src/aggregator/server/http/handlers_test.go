@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/aggregator/aggregator"
+	"github.com/m3db/m3/src/metrics/metric/id"
+)
+
+func testInspectRequest(
+	t *testing.T,
+	agg aggregator.Aggregator,
+	opts Options,
+	method string,
+	params url.Values,
+) (*httptest.ResponseRecorder, InspectResponse) {
+	mux := http.NewServeMux()
+	registerHandlers(mux, agg, opts)
+	req := httptest.NewRequest(method, InspectPath+"?"+params.Encode(), nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	var resp InspectResponse
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	}
+	return w, resp
+}
+
+func TestInspectHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	result := aggregator.InspectResult{
+		Elems:             []aggregator.ElemInspection{{ID: "foo", MetricType: "counter"}},
+		NumScannedEntries: 1,
+	}
+	agg := aggregator.NewMockAggregator(ctrl)
+	agg.EXPECT().Inspect(gomock.Any()).DoAndReturn(
+		func(query aggregator.InspectQuery) (aggregator.InspectResult, error) {
+			require.Equal(t, []id.RawID{id.RawID("foo"), id.RawID("bar")}, query.IDs)
+			require.NotNil(t, query.Filter)
+			require.Equal(t, 5, query.MaxElems)
+			require.Equal(t, 1000, query.MaxScannedEntries)
+			return result, nil
+		})
+
+	opts := NewOptions().SetMaxInspectElems(10).SetMaxInspectScannedEntries(1000)
+	w, resp := testInspectRequest(t, agg, opts, http.MethodGet, url.Values{
+		"id":     []string{"foo", "bar"},
+		"filter": []string{"__name__:http_* env:prod"},
+		"limit":  []string{"5"},
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "OK", resp.State)
+	require.Equal(t, "foo", resp.Result.Elems[0].ID)
+	require.Equal(t, "counter", resp.Result.Elems[0].MetricType)
+	require.Equal(t, 1, resp.Result.NumScannedEntries)
+}
+
+func TestInspectHandlerLimitCapped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	agg := aggregator.NewMockAggregator(ctrl)
+	agg.EXPECT().Inspect(gomock.Any()).DoAndReturn(
+		func(query aggregator.InspectQuery) (aggregator.InspectResult, error) {
+			require.Equal(t, 10, query.MaxElems)
+			return aggregator.InspectResult{}, nil
+		})
+
+	opts := NewOptions().SetMaxInspectElems(10)
+	w, _ := testInspectRequest(t, agg, opts, http.MethodGet, url.Values{
+		"id":    []string{"foo"},
+		"limit": []string{"1000"},
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestInspectHandlerInvalidRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	agg := aggregator.NewMockAggregator(ctrl)
+	opts := NewOptions()
+	for _, test := range []struct {
+		method string
+		params url.Values
+	}{
+		{method: http.MethodPost, params: url.Values{"id": []string{"foo"}}},
+		{method: http.MethodGet, params: url.Values{}},
+		{method: http.MethodGet, params: url.Values{"filter": []string{"env"}}},
+		{method: http.MethodGet, params: url.Values{"id": []string{"foo"}, "limit": []string{"0"}}},
+		{method: http.MethodGet, params: url.Values{"id": []string{"foo"}, "limit": []string{"foo"}}},
+	} {
+		w, _ := testInspectRequest(t, agg, opts, test.method, test.params)
+		require.Equal(t, http.StatusBadRequest, w.Code, "params: %v", test.params)
+	}
+}
@@ -28,6 +28,9 @@ import (
 const (
 	defaultReadTimeout  = 10 * time.Second
 	defaultWriteTimeout = 10 * time.Second
+
+	defaultMaxInspectElems          = 100
+	defaultMaxInspectScannedEntries = 100000
 )
 
 // Options is a set of server options.
@@ -49,20 +52,40 @@ type Options interface {
 
 	// SetMux sets the http mux for the server.
 	SetMux(value *http.ServeMux) Options
+
+	// SetMaxInspectElems sets the maximum number of elements returned by an
+	// inspect request.
+	SetMaxInspectElems(value int) Options
+
+	// MaxInspectElems returns the maximum number of elements returned by an
+	// inspect request.
+	MaxInspectElems() int
+
+	// SetMaxInspectScannedEntries sets the maximum number of entries scanned
+	// by an inspect request selecting metrics with a filter.
+	SetMaxInspectScannedEntries(value int) Options
+
+	// MaxInspectScannedEntries returns the maximum number of entries scanned
+	// by an inspect request selecting metrics with a filter.
+	MaxInspectScannedEntries() int
 }
 
 type options struct {
-	readTimeout  time.Duration
-	writeTimeout time.Duration
-	mux          *http.ServeMux
+	readTimeout              time.Duration
+	writeTimeout             time.Duration
+	mux                      *http.ServeMux
+	maxInspectElems          int
+	maxInspectScannedEntries int
 }
 
 // NewOptions creates a new set of server options.
 func NewOptions() Options {
 	return &options{
-		readTimeout:  defaultReadTimeout,
-		writeTimeout: defaultWriteTimeout,
-		mux:          http.NewServeMux(),
+		readTimeout:              defaultReadTimeout,
+		writeTimeout:             defaultWriteTimeout,
+		mux:                      http.NewServeMux(),
+		maxInspectElems:          defaultMaxInspectElems,
+		maxInspectScannedEntries: defaultMaxInspectScannedEntries,
 	}
 }
 
@@ -95,3 +118,23 @@ func (o *options) SetMux(value *http.ServeMux) Options {
 	opts.mux = value
 	return &opts
 }
+
+func (o *options) SetMaxInspectElems(value int) Options {
+	opts := *o
+	opts.maxInspectElems = value
+	return &opts
+}
+
+func (o *options) MaxInspectElems() int {
+	return o.maxInspectElems
+}
+
+func (o *options) SetMaxInspectScannedEntries(value int) Options {
+	opts := *o
+	opts.maxInspectScannedEntries = value
+	return &opts
+}
+
+func (o *options) MaxInspectScannedEntries() int {
+	return o.maxInspectScannedEntries
+}
@@ -32,6 +32,8 @@
 		KeyValueUpdateResult
 		QueryLimits
 		QueryLimit
+		TenantQuotas
+		TenantQuota
 */
 package kvpb
 
@@ -196,11 +198,85 @@ func (m *QueryLimit) GetForceWaited() bool {
 	return false
 }
 
+type TenantQuotas struct {
+	Tenants      []*TenantQuota `protobuf:"bytes,1,rep,name=tenants" json:"tenants,omitempty"`
+	DefaultQuota *TenantQuota   `protobuf:"bytes,2,opt,name=defaultQuota" json:"defaultQuota,omitempty"`
+}
+
+func (m *TenantQuotas) Reset()                    { *m = TenantQuotas{} }
+func (m *TenantQuotas) String() string            { return proto.CompactTextString(m) }
+func (*TenantQuotas) ProtoMessage()               {}
+func (*TenantQuotas) Descriptor() ([]byte, []int) { return fileDescriptorKv, []int{4} }
+
+func (m *TenantQuotas) GetTenants() []*TenantQuota {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *TenantQuotas) GetDefaultQuota() *TenantQuota {
+	if m != nil {
+		return m.DefaultQuota
+	}
+	return nil
+}
+
+type TenantQuota struct {
+	Tenant                string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	WriteSamplesPerSecond int64  `protobuf:"varint,2,opt,name=writeSamplesPerSecond,proto3" json:"writeSamplesPerSecond,omitempty"`
+	ActiveSeries          int64  `protobuf:"varint,3,opt,name=activeSeries,proto3" json:"activeSeries,omitempty"`
+	QuerySeriesFetched    int64  `protobuf:"varint,4,opt,name=querySeriesFetched,proto3" json:"querySeriesFetched,omitempty"`
+	ConcurrentQueries     int64  `protobuf:"varint,5,opt,name=concurrentQueries,proto3" json:"concurrentQueries,omitempty"`
+}
+
+func (m *TenantQuota) Reset()                    { *m = TenantQuota{} }
+func (m *TenantQuota) String() string            { return proto.CompactTextString(m) }
+func (*TenantQuota) ProtoMessage()               {}
+func (*TenantQuota) Descriptor() ([]byte, []int) { return fileDescriptorKv, []int{5} }
+
+func (m *TenantQuota) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *TenantQuota) GetWriteSamplesPerSecond() int64 {
+	if m != nil {
+		return m.WriteSamplesPerSecond
+	}
+	return 0
+}
+
+func (m *TenantQuota) GetActiveSeries() int64 {
+	if m != nil {
+		return m.ActiveSeries
+	}
+	return 0
+}
+
+func (m *TenantQuota) GetQuerySeriesFetched() int64 {
+	if m != nil {
+		return m.QuerySeriesFetched
+	}
+	return 0
+}
+
+func (m *TenantQuota) GetConcurrentQueries() int64 {
+	if m != nil {
+		return m.ConcurrentQueries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*KeyValueUpdate)(nil), "kvpb.KeyValueUpdate")
 	proto.RegisterType((*KeyValueUpdateResult)(nil), "kvpb.KeyValueUpdateResult")
 	proto.RegisterType((*QueryLimits)(nil), "kvpb.QueryLimits")
 	proto.RegisterType((*QueryLimit)(nil), "kvpb.QueryLimit")
+	proto.RegisterType((*TenantQuotas)(nil), "kvpb.TenantQuotas")
+	proto.RegisterType((*TenantQuota)(nil), "kvpb.TenantQuota")
 }
 func (m *KeyValueUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -384,6 +460,90 @@ func (m *QueryLimit) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TenantQuotas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantQuotas) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenants) > 0 {
+		for _, msg := range m.Tenants {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKv(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DefaultQuota != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.DefaultQuota.Size()))
+		n1, err := m.DefaultQuota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *TenantQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantQuota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(len(m.Tenant)))
+		i += copy(dAtA[i:], m.Tenant)
+	}
+	if m.WriteSamplesPerSecond != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.WriteSamplesPerSecond))
+	}
+	if m.ActiveSeries != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.ActiveSeries))
+	}
+	if m.QuerySeriesFetched != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.QuerySeriesFetched))
+	}
+	if m.ConcurrentQueries != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.ConcurrentQueries))
+	}
+	return i, nil
+}
+
 func encodeVarintKv(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -468,6 +628,44 @@ func (m *QueryLimit) Size() (n int) {
 	return n
 }
 
+func (m *TenantQuotas) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tenants) > 0 {
+		for _, e := range m.Tenants {
+			l = e.Size()
+			n += 1 + l + sovKv(uint64(l))
+		}
+	}
+	if m.DefaultQuota != nil {
+		l = m.DefaultQuota.Size()
+		n += 1 + l + sovKv(uint64(l))
+	}
+	return n
+}
+
+func (m *TenantQuota) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovKv(uint64(l))
+	}
+	if m.WriteSamplesPerSecond != 0 {
+		n += 1 + sovKv(uint64(m.WriteSamplesPerSecond))
+	}
+	if m.ActiveSeries != 0 {
+		n += 1 + sovKv(uint64(m.ActiveSeries))
+	}
+	if m.QuerySeriesFetched != 0 {
+		n += 1 + sovKv(uint64(m.QuerySeriesFetched))
+	}
+	if m.ConcurrentQueries != 0 {
+		n += 1 + sovKv(uint64(m.ConcurrentQueries))
+	}
+	return n
+}
+
 func sovKv(x uint64) (n int) {
 	for {
 		n++
@@ -1056,6 +1254,277 @@ func (m *QueryLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TenantQuotas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantQuotas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenants = append(m.Tenants, &TenantQuota{})
+			if err := m.Tenants[len(m.Tenants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultQuota == nil {
+				m.DefaultQuota = &TenantQuota{}
+			}
+			if err := m.DefaultQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TenantQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteSamplesPerSecond", wireType)
+			}
+			m.WriteSamplesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteSamplesPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSeries", wireType)
+			}
+			m.ActiveSeries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSeries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuerySeriesFetched", wireType)
+			}
+			m.QuerySeriesFetched = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuerySeriesFetched |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrentQueries", wireType)
+			}
+			m.ConcurrentQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrentQueries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipKv(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1166,30 +1635,38 @@ func init() {
 }
 
 var fileDescriptorKv = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
-	0x14, 0x35, 0xe6, 0xf9, 0x78, 0xef, 0x16, 0x35, 0x0c, 0x0f, 0xe9, 0x2a, 0x84, 0xa0, 0xd0, 0x55,
-	0x06, 0xec, 0xd6, 0x55, 0xd1, 0x95, 0x15, 0x74, 0x8a, 0x1f, 0x0b, 0x37, 0x93, 0x99, 0xdb, 0x1a,
-	0x32, 0xc9, 0x94, 0xcc, 0xa4, 0x36, 0xbf, 0xc0, 0xad, 0x0b, 0x7f, 0x94, 0x4b, 0x7f, 0x82, 0xd4,
-	0x3f, 0x22, 0x33, 0x29, 0xb4, 0x95, 0xd6, 0x76, 0x13, 0xee, 0x39, 0xb9, 0xe7, 0xdc, 0x3b, 0x87,
-	0x0b, 0x2f, 0x16, 0x85, 0xfd, 0xd2, 0xe6, 0x99, 0xd0, 0x15, 0xad, 0xc6, 0x32, 0xa7, 0xd5, 0x98,
-	0x9a, 0x46, 0x50, 0xa1, 0x5a, 0x63, 0xb1, 0xa1, 0x0b, 0xac, 0xb1, 0xe1, 0x16, 0x25, 0x5d, 0x36,
-	0xda, 0x6a, 0x5a, 0xae, 0x96, 0x39, 0x2d, 0x57, 0x99, 0x47, 0xe4, 0xca, 0xc1, 0xf4, 0x2d, 0x3c,
-	0x7a, 0x8d, 0xdd, 0x07, 0xae, 0x5a, 0x7c, 0xbf, 0x94, 0xdc, 0x22, 0x89, 0x20, 0x2c, 0xb1, 0x1b,
-	0x06, 0x49, 0x30, 0xba, 0x65, 0xae, 0x24, 0x77, 0xf0, 0x60, 0xe5, 0x1a, 0x86, 0xf7, 0x3d, 0xd7,
-	0x03, 0xf2, 0x04, 0xae, 0x85, 0xae, 0xaa, 0xc2, 0x0e, 0xc3, 0x24, 0x18, 0xdd, 0xb0, 0x2d, 0x4a,
-	0xa7, 0x70, 0x77, 0xe8, 0xc8, 0xd0, 0xb4, 0xca, 0x1e, 0xf1, 0x8d, 0x20, 0xd4, 0x4a, 0x6e, 0x5d,
-	0x5d, 0xe9, 0x98, 0x1a, 0xbf, 0x7a, 0xc3, 0x5b, 0xe6, 0xca, 0xf4, 0x5b, 0x08, 0x83, 0x77, 0x2d,
-	0x36, 0xdd, 0xb4, 0xa8, 0x0a, 0x6b, 0xc8, 0x27, 0x88, 0x2b, 0xbe, 0x66, 0x28, 0xb0, 0xb6, 0xaa,
-	0x73, 0x7f, 0x0a, 0x94, 0x33, 0xf7, 0x35, 0x13, 0xa5, 0x45, 0x69, 0xfc, 0x80, 0xc1, 0xf3, 0x28,
-	0x73, 0xcf, 0xcb, 0x76, 0x52, 0x76, 0x46, 0x47, 0xe6, 0xf0, 0xec, 0x54, 0xc7, 0xcb, 0xc2, 0x94,
-	0x93, 0xce, 0xa2, 0x61, 0xc8, 0xfb, 0x7d, 0x8f, 0x0d, 0xb8, 0x4c, 0x4e, 0x3e, 0x43, 0xf2, 0xbf,
-	0x46, 0x3f, 0x22, 0x3c, 0x31, 0xe2, 0xac, 0xf2, 0x78, 0x3e, 0x6f, 0xd0, 0x72, 0xc9, 0x2d, 0xf7,
-	0xde, 0x57, 0x97, 0xe7, 0xb3, 0xaf, 0x4b, 0x7f, 0x04, 0x00, 0xbb, 0x76, 0x77, 0x14, 0xca, 0x15,
-	0x3e, 0xef, 0x90, 0xf5, 0x80, 0x8c, 0xe0, 0xb1, 0xd2, 0xba, 0xcc, 0xb9, 0x28, 0x67, 0x28, 0x74,
-	0x2d, 0x8d, 0x8f, 0x2b, 0x64, 0xff, 0xd2, 0xe4, 0x29, 0x3c, 0x9c, 0xeb, 0x46, 0xe0, 0xab, 0xb5,
-	0x40, 0x94, 0x28, 0xb7, 0x57, 0x74, 0x48, 0x92, 0x04, 0x06, 0x9e, 0xf8, 0xc8, 0x0b, 0x8b, 0xfd,
-	0xee, 0x37, 0x6c, 0x9f, 0x9a, 0x44, 0x3f, 0x37, 0x71, 0xf0, 0x6b, 0x13, 0x07, 0xbf, 0x37, 0x71,
-	0xf0, 0xfd, 0x4f, 0x7c, 0x2f, 0xbf, 0xf6, 0xf7, 0x3d, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1c,
-	0x39, 0x9e, 0xf7, 0x1f, 0x03, 0x00, 0x00,
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0x66, 0xd9, 0xb6, 0xb4, 0x93, 0x02, 0xa9, 0x55, 0x50, 0x4e, 0x51, 0xb4, 0x02, 0x29, 0x12,
+	0x28, 0x2b, 0x35, 0x70, 0xe3, 0x14, 0x01, 0x17, 0x8a, 0xd4, 0x3a, 0xfc, 0x1d, 0xb8, 0x38, 0xf6,
+	0xa4, 0x5d, 0xad, 0x77, 0x1d, 0x6c, 0x6f, 0xda, 0x3c, 0x01, 0x57, 0x0e, 0x3c, 0x14, 0x47, 0x5e,
+	0x00, 0x09, 0x85, 0x17, 0x41, 0xb6, 0x17, 0x35, 0x81, 0x2d, 0xed, 0x25, 0x9a, 0x6f, 0xe6, 0x9b,
+	0x6f, 0xec, 0xcf, 0x93, 0x85, 0x67, 0x27, 0x99, 0x3d, 0xad, 0x26, 0x03, 0xae, 0x8a, 0xb4, 0x18,
+	0x8a, 0x49, 0x5a, 0x0c, 0x53, 0xa3, 0x79, 0xca, 0x65, 0x65, 0x2c, 0xea, 0xf4, 0x04, 0x4b, 0xd4,
+	0xcc, 0xa2, 0x48, 0x67, 0x5a, 0x59, 0x95, 0xe6, 0xf3, 0xd9, 0x24, 0xcd, 0xe7, 0x03, 0x8f, 0xc8,
+	0x86, 0x83, 0xc9, 0x11, 0xdc, 0x79, 0x85, 0x8b, 0x77, 0x4c, 0x56, 0xf8, 0x76, 0x26, 0x98, 0x45,
+	0xd2, 0x86, 0x38, 0xc7, 0x45, 0x27, 0xea, 0x45, 0xfd, 0x1d, 0xea, 0x42, 0xb2, 0x0f, 0x9b, 0x73,
+	0x47, 0xe8, 0xdc, 0xf4, 0xb9, 0x00, 0xc8, 0x7d, 0xd8, 0xe2, 0xaa, 0x28, 0x32, 0xdb, 0x89, 0x7b,
+	0x51, 0x7f, 0x9b, 0xd6, 0x28, 0x39, 0x84, 0xfd, 0x75, 0x45, 0x8a, 0xa6, 0x92, 0xb6, 0x41, 0xb7,
+	0x0d, 0xb1, 0x92, 0xa2, 0x56, 0x75, 0xa1, 0xcb, 0x94, 0x78, 0xe6, 0x05, 0x77, 0xa8, 0x0b, 0x93,
+	0xcf, 0x31, 0xb4, 0x8e, 0x2b, 0xd4, 0x8b, 0xc3, 0xac, 0xc8, 0xac, 0x21, 0x1f, 0xa0, 0x5b, 0xb0,
+	0x73, 0x8a, 0x1c, 0x4b, 0x2b, 0x17, 0xae, 0x92, 0xa1, 0x18, 0xbb, 0x5f, 0x33, 0x92, 0x8a, 0xe7,
+	0xc6, 0x0f, 0x68, 0x1d, 0xb4, 0x07, 0xee, 0x7a, 0x83, 0x8b, 0x56, 0x7a, 0x45, 0x1f, 0x99, 0xc2,
+	0xc3, 0xcb, 0x18, 0xcf, 0x33, 0x93, 0x8f, 0x16, 0x16, 0x0d, 0x45, 0x16, 0xce, 0xdb, 0x34, 0xe0,
+	0x7a, 0xed, 0xe4, 0x23, 0xf4, 0xfe, 0x47, 0xf4, 0x23, 0xe2, 0x4b, 0x46, 0x5c, 0xd9, 0xd9, 0xec,
+	0xcf, 0x6b, 0xb4, 0x4c, 0x30, 0xcb, 0xbc, 0xf6, 0xc6, 0xf5, 0xfd, 0x59, 0xed, 0x4b, 0xbe, 0x46,
+	0x00, 0x17, 0x74, 0xb7, 0x14, 0xd2, 0x05, 0xde, 0xef, 0x98, 0x06, 0x40, 0xfa, 0x70, 0x57, 0x2a,
+	0x95, 0x4f, 0x18, 0xcf, 0xc7, 0xc8, 0x55, 0x29, 0x8c, 0xb7, 0x2b, 0xa6, 0x7f, 0xa7, 0xc9, 0x03,
+	0xb8, 0x3d, 0x55, 0x9a, 0xe3, 0x8b, 0x73, 0x8e, 0x28, 0x50, 0xd4, 0x5b, 0xb4, 0x9e, 0x24, 0x3d,
+	0x68, 0xf9, 0xc4, 0x7b, 0x96, 0x59, 0x0c, 0x67, 0xdf, 0xa6, 0xab, 0xa9, 0x44, 0xc3, 0xee, 0x1b,
+	0x2c, 0x59, 0x69, 0x8f, 0x2b, 0x65, 0x99, 0x21, 0x8f, 0xe0, 0x96, 0xf5, 0xd8, 0x6d, 0x42, 0xdc,
+	0x6f, 0x1d, 0xec, 0x85, 0x9b, 0xae, 0x90, 0xe8, 0x1f, 0x06, 0x79, 0x0a, 0xbb, 0x02, 0xa7, 0xac,
+	0x92, 0xa1, 0x50, 0x3f, 0x6d, 0x43, 0xc7, 0x1a, 0x2d, 0xf9, 0x11, 0x41, 0x6b, 0xa5, 0xea, 0xfe,
+	0x0a, 0x41, 0xb1, 0xde, 0xee, 0x1a, 0x91, 0x27, 0x70, 0xef, 0x4c, 0x67, 0x16, 0xc7, 0xac, 0x98,
+	0x49, 0x34, 0x47, 0xa8, 0xc3, 0xed, 0x6b, 0x4f, 0x9a, 0x8b, 0x24, 0x81, 0x5d, 0xc6, 0x6d, 0x36,
+	0xc7, 0xf0, 0xb4, 0xde, 0x98, 0x98, 0xae, 0xe5, 0xc8, 0x00, 0xc8, 0x27, 0xf7, 0x16, 0x01, 0xbe,
+	0x44, 0xcb, 0x4f, 0x6b, 0x7b, 0x62, 0xda, 0x50, 0x21, 0x8f, 0x61, 0x8f, 0xab, 0x92, 0x57, 0x5a,
+	0xa3, 0x3b, 0x74, 0x10, 0xde, 0xf4, 0xf4, 0x7f, 0x0b, 0xa3, 0xf6, 0xb7, 0x65, 0x37, 0xfa, 0xbe,
+	0xec, 0x46, 0x3f, 0x97, 0xdd, 0xe8, 0xcb, 0xaf, 0xee, 0x8d, 0xc9, 0x96, 0xff, 0x66, 0x0c, 0x7f,
+	0x0f, 0x00, 0x50, 0xcb, 0xdf, 0xc2, 0x73, 0x04, 0x00, 0x00,
 }
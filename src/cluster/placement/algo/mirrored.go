@@ -303,6 +303,19 @@ func (a mirroredAlgorithm) MarkAllShardsAvailable(
 	return a.shardedAlgo.MarkAllShardsAvailable(p)
 }
 
+func (a mirroredAlgorithm) SplitShards(
+	p placement.Placement,
+	factor int,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	// NB: Every instance of a shard set gains the same child shards, so the
+	// mirrored shard sets are preserved by the sharded split.
+	return a.shardedAlgo.SplitShards(p, factor)
+}
+
 func (a mirroredAlgorithm) BalanceShards(
 	p placement.Placement,
 ) (placement.Placement, error) {
@@ -158,6 +158,16 @@ func (a nonShardedAlgorithm) MarkAllShardsAvailable(
 	return p, false, nil
 }
 
+func (a nonShardedAlgorithm) SplitShards(
+	p placement.Placement,
+	factor int,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+	return nil, errShardsOnNonShardedAlgo
+}
+
 func (a nonShardedAlgorithm) BalanceShards(
 	p placement.Placement,
 ) (placement.Placement, error) {
@@ -219,6 +219,17 @@ func (a shardedPlacementAlgorithm) MarkAllShardsAvailable(
 	return markAllShardsAvailable(p, a.opts)
 }
 
+func (a shardedPlacementAlgorithm) SplitShards(
+	p placement.Placement,
+	factor int,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	return splitShards(p.Clone(), factor, a.opts)
+}
+
 func (a shardedPlacementAlgorithm) BalanceShards(
 	p placement.Placement,
 ) (placement.Placement, error) {
@@ -836,6 +836,65 @@ func markShardsAvailable(p placement.Placement, instanceID string, shardIDs []ui
 	return p, nil
 }
 
+// splitShards multiplies the number of shards in the placement by factor. Every
+// shard s of the current placement gains the children s+i*N for i in [1, factor),
+// where N is the current number of shards, which are added as initializing shards
+// to every instance that owns s. Because the shard hash is a modulo of the series
+// hash, the series of each child are exactly a subset of the series of its parent,
+// so the children redirect to their parent until they are marked available.
+func splitShards(p placement.Placement, factor int, opts placement.Options) (placement.Placement, error) {
+	if factor < 2 {
+		return nil, fmt.Errorf("invalid shard split factor %d, must be at least 2", factor)
+	}
+
+	numShards := uint32(p.NumShards())
+	for i, shardID := range p.Shards() {
+		if shardID != uint32(i) {
+			return nil, fmt.Errorf("could not split shards, placement shards are not contiguous from 0")
+		}
+	}
+
+	for _, instance := range p.Instances() {
+		for _, s := range instance.Shards().All() {
+			if s.State() != shard.Available {
+				return nil, fmt.Errorf("could not split shards, shard %d on instance %s is in %s state",
+					s.ID(), instance.ID(), s.State().String())
+			}
+			if s.RedirectToShardID() != nil {
+				return nil, fmt.Errorf("could not split shards, shard %d on instance %s is redirected",
+					s.ID(), instance.ID())
+			}
+		}
+	}
+
+	newNumShards := numShards * uint32(factor)
+	for _, instance := range p.Instances() {
+		shards := instance.Shards()
+		for _, parent := range shards.All() {
+			parentID := parent.ID()
+			for i := uint32(1); i < uint32(factor); i++ {
+				redirectToShardID := parentID
+				shards.Add(shard.NewShard(parentID + i*numShards).
+					SetState(shard.Initializing).
+					SetRedirectToShardID(&redirectToShardID))
+			}
+		}
+	}
+
+	p = p.
+		SetShards(newShardIDs(newNumShards)).
+		SetCutoverNanos(opts.PlacementCutoverNanosFn()())
+	return tryCleanupShardState(p, opts)
+}
+
+func newShardIDs(numShards uint32) []uint32 {
+	ids := make([]uint32, numShards)
+	for i := range ids {
+		ids[i] = uint32(i)
+	}
+	return ids
+}
+
 // tryCleanupShardState cleans up the shard states if the user only
 // wants to keep stable shard state in the placement.
 func tryCleanupShardState(
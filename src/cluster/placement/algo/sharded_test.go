@@ -1381,3 +1381,65 @@ func timeNanosGen(v int64) placement.TimeNanosFn {
 		return v
 	}
 }
+
+func TestSplitShardsWithShardedAlgo(t *testing.T) {
+	i1 := placement.NewEmptyInstance("i1", "r1", "z1", "e1", 1)
+	i1.Shards().Add(shard.NewShard(0).SetState(shard.Available))
+	i1.Shards().Add(shard.NewShard(1).SetState(shard.Available))
+
+	i2 := placement.NewEmptyInstance("i2", "r2", "z1", "e2", 1)
+	i2.Shards().Add(shard.NewShard(0).SetState(shard.Available))
+	i2.Shards().Add(shard.NewShard(1).SetState(shard.Available))
+
+	p := placement.NewPlacement().
+		SetInstances([]placement.Instance{i1, i2}).
+		SetShards([]uint32{0, 1}).
+		SetReplicaFactor(2).
+		SetIsSharded(true)
+
+	a := newShardedAlgorithm(placement.NewOptions())
+	_, err := a.SplitShards(p, 1)
+	require.Error(t, err)
+
+	split, err := a.SplitShards(p, 3)
+	require.NoError(t, err)
+	require.NoError(t, placement.Validate(split))
+	require.Equal(t, 6, split.NumShards())
+	require.Equal(t, 2, p.NumShards())
+
+	for _, instance := range split.Instances() {
+		require.Equal(t, 6, instance.Shards().NumShards())
+		for _, id := range []uint32{0, 1} {
+			s, ok := instance.Shards().Shard(id)
+			require.True(t, ok)
+			require.Equal(t, shard.Available, s.State())
+			require.Nil(t, s.RedirectToShardID())
+		}
+		for _, id := range []uint32{2, 3, 4, 5} {
+			s, ok := instance.Shards().Shard(id)
+			require.True(t, ok)
+			require.Equal(t, shard.Initializing, s.State())
+			require.Equal(t, "", s.SourceID())
+			require.NotNil(t, s.RedirectToShardID())
+			require.Equal(t, id%2, *s.RedirectToShardID())
+		}
+	}
+
+	// Splitting again is rejected until the child shards are available.
+	_, err = a.SplitShards(split, 2)
+	require.Error(t, err)
+
+	split, err = a.MarkShardsAvailable(split, "i1", 2)
+	require.NoError(t, err)
+	s, ok := split.Instances()[0].Shards().Shard(2)
+	require.True(t, ok)
+	require.Equal(t, shard.Available, s.State())
+	require.Nil(t, s.RedirectToShardID())
+
+	split, _, err = a.MarkAllShardsAvailable(split)
+	require.NoError(t, err)
+	require.NoError(t, placement.Validate(split))
+
+	_, err = a.SplitShards(split, 2)
+	require.NoError(t, err)
+}
@@ -515,6 +515,10 @@ type operations interface {
 
 	// BalanceShards rebalances load in the cluster to achieve the most balanced shard distribution.
 	BalanceShards() (Placement, error)
+
+	// SplitShards multiplies the number of shards by the given factor, adding the child
+	// shards as initializing shards that redirect to their parent until marked available.
+	SplitShards(factor int) (Placement, error)
 }
 
 // Algorithm places shards on instances.
@@ -549,6 +553,10 @@ type Algorithm interface {
 
 	// BalanceShards rebalances load in the cluster to achieve the most balanced shard distribution.
 	BalanceShards(p Placement) (Placement, error)
+
+	// SplitShards multiplies the number of shards by the given factor, adding the child
+	// shards as initializing shards that redirect to their parent until marked available.
+	SplitShards(p Placement, factor int) (Placement, error)
 }
 
 // InstanceSelector selects valid instances for the placement change.
@@ -369,6 +369,20 @@ func MakeRoutes(
 		Methods: []string{SetHTTPMethod},
 	})
 
+	// Split
+	var (
+		splitHandler = NewSplitHandler(opts)
+		splitFn      = applyMiddleware(splitHandler.ServeHTTP, defaults)
+	)
+	routes = append(routes, Route{
+		Paths: []string{
+			M3DBSplitURL,
+			M3AggSplitURL,
+		},
+		Handler: splitFn,
+		Methods: []string{SplitHTTPMethod},
+	})
+
 	return routes
 }
 
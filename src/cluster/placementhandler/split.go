@@ -0,0 +1,146 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package placementhandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placementhandler/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// SplitHTTPMethod is the HTTP method for the split endpoint.
+	SplitHTTPMethod = http.MethodPost
+
+	splitPathName = "split"
+)
+
+var (
+	// M3DBSplitURL is the url for the m3db split handler (method POST).
+	M3DBSplitURL = path.Join(route.Prefix,
+		M3DBServicePlacementPathName, splitPathName)
+
+	// M3AggSplitURL is the url for the m3aggregator split handler (method
+	// POST).
+	M3AggSplitURL = path.Join(route.Prefix,
+		M3AggServicePlacementPathName, splitPathName)
+)
+
+// SplitRequest is a request to split the shards of a placement.
+type SplitRequest struct {
+	// Factor is the factor the number of shards is multiplied by, it must be
+	// at least 2.
+	Factor int `json:"factor"`
+}
+
+// SplitHandler is the type for placement shard splits.
+type SplitHandler Handler
+
+// NewSplitHandler returns a new SplitHandler.
+func NewSplitHandler(opts HandlerOptions) *SplitHandler {
+	return &SplitHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *SplitHandler) ServeHTTP(
+	svc handleroptions.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOptions)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.WriteError(w, pErr)
+		return
+	}
+
+	placement, err := h.Split(svc, r, req)
+	if err != nil {
+		logger.Error("unable to split shards", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	placementProto, err := placement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	resp := &admin.PlacementGetResponse{
+		Placement: placementProto,
+		Version:   int32(placement.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *SplitHandler) parseRequest(r *http.Request) (*SplitRequest, error) {
+	defer r.Body.Close()
+
+	req := &SplitRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+	if req.Factor < 2 {
+		return nil, xerrors.NewInvalidParamsError(fmt.Errorf(
+			"invalid shard split factor %d, must be at least 2", req.Factor))
+	}
+
+	return req, nil
+}
+
+// Split multiplies the number of shards of the placement by the factor of
+// the request.
+func (h *SplitHandler) Split(
+	svc handleroptions.ServiceNameAndDefaults,
+	httpReq *http.Request,
+	req *SplitRequest,
+) (placement.Placement, error) {
+	// M3Coordinator isn't sharded, it has no shards to split.
+	if isStateless(svc.ServiceName) {
+		return nil, xerrors.NewInvalidParamsError(fmt.Errorf(
+			"unable to split shards of stateless service %s", svc.ServiceName))
+	}
+
+	serviceOpts := handleroptions.NewServiceOptions(svc,
+		httpReq.Header, h.m3AggServiceOptions)
+	service, err := Service(h.clusterClient, serviceOpts,
+		h.placement, h.nowFn(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.SplitShards(req.Factor)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package placementhandler
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placementhandler/handleroptions"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func newSplitRequest(body string) *http.Request {
+	rb := strings.NewReader(body)
+	return httptest.NewRequest(SplitHTTPMethod, M3DBSplitURL, rb)
+}
+
+func TestPlacementSplitHandler(t *testing.T) {
+	runForAllAllowedServices(func(s string) {
+		t.Run(s, func(t *testing.T) {
+			testPlacementSplitHandler(t, s)
+		})
+	})
+}
+
+func testPlacementSplitHandler(t *testing.T, serviceName string) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(mockClient, placement.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewSplitHandler(handlerOpts)
+	handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+	svcDefaults := handleroptions.ServiceNameAndDefaults{
+		ServiceName: serviceName,
+	}
+
+	if isStateless(serviceName) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(svcDefaults, w, newSplitRequest(`{"factor": 2}`))
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.JSONEq(t,
+			`{"status":"error","error":"unable to split shards of stateless service m3coordinator"}`,
+			string(body))
+		return
+	}
+
+	mockPlacementService.EXPECT().SplitShards(2).
+		Return(placement.NewPlacement().SetVersion(2), nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(svcDefaults, w, newSplitRequest(`{"factor": 2}`))
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"placement":{"instances":{},"replicaFactor":0,"numShards":0,"isSharded":false,"cutoverTime":"0","isMirrored":false,"maxShardSetId":0},"version":2}`, string(body))
+
+	mockPlacementService.EXPECT().SplitShards(4).
+		Return(nil, errors.New("test"))
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(svcDefaults, w, newSplitRequest(`{"factor": 4}`))
+	resp = w.Result()
+	body, _ = ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.JSONEq(t, `{"status":"error","error":"test"}`, string(body))
+
+	// Factors under 2 are rejected without splitting the shards.
+	for _, body := range []string{`{"factor": 1}`, `{}`, `{"factor": "2"}`} {
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(svcDefaults, w, newSplitRequest(body))
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
@@ -94,6 +95,10 @@ type AggregatorConfiguration struct {
 	// Stream configuration for computing quantiles.
 	Stream streamConfiguration `yaml:"stream"`
 
+	// Timer sketch configuration for computing quantiles with mergeable
+	// sketches rather than the stream.
+	TimerSketch *timerSketchConfiguration `yaml:"timerSketch"`
+
 	// Client configuration.
 	Client aggclient.Configuration `yaml:"client"`
 
@@ -162,6 +167,9 @@ type AggregatorConfiguration struct {
 	// Whether to discard NaN aggregated values.
 	DiscardNaNAggregatedValues *bool `yaml:"discardNaNAggregatedValues"`
 
+	// Checkpointing of in-flight aggregations.
+	Checkpoint *checkpointConfiguration `yaml:"checkpoint"`
+
 	// Pool of counter elements.
 	CounterElemPool pool.ObjectPoolConfiguration `yaml:"counterElemPool"`
 
@@ -307,6 +315,15 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	}
 	opts = opts.SetStreamOptions(streamOpts)
 
+	// Set timer sketch options.
+	if c.TimerSketch != nil {
+		timerSketchOptionsFn, err := c.TimerSketch.NewTimerSketchOptionsFn()
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetTimerSketchOptionsFn(timerSketchOptionsFn)
+	}
+
 	// Set administrative client.
 	// TODO(xichen): client retry threshold likely needs to be low for faster retries.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("client"))
@@ -460,6 +477,11 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 		opts = opts.SetDiscardNaNAggregatedValues(*c.DiscardNaNAggregatedValues)
 	}
 
+	// Set checkpoint options.
+	if c.Checkpoint != nil {
+		opts = opts.SetCheckpointOptions(c.Checkpoint.NewCheckpointOptions())
+	}
+
 	// Set counter elem pool.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("counter-elem-pool"))
 	counterElemPoolOpts := c.CounterElemPool.NewObjectPoolOptions(iOpts)
@@ -592,6 +614,54 @@ func (c *streamConfiguration) NewStreamOptions(_ instrument.Options) (cm.Options
 	return opts, nil
 }
 
+type timerSketchConfiguration struct {
+	// Relative accuracy of the quantiles.
+	RelativeAccuracy float64 `yaml:"relativeAccuracy"`
+
+	// Maximum number of buckets of the values of each sign.
+	MaxNumBuckets int `yaml:"maxNumBuckets"`
+
+	// Aggregation policies of the timers whose quantiles are computed with
+	// sketches, a policy without aggregation types matches the timers of its
+	// storage policy regardless of their aggregation types. If empty sketches
+	// are used for all timers.
+	Policies []policy.Policy `yaml:"policies"`
+}
+
+func (c timerSketchConfiguration) NewTimerSketchOptionsFn() (aggregator.TimerSketchOptionsFn, error) {
+	opts := ddsketch.NewOptions()
+	if c.RelativeAccuracy != 0 {
+		opts = opts.SetRelativeAccuracy(c.RelativeAccuracy)
+	}
+	if c.MaxNumBuckets != 0 {
+		opts = opts.SetMaxNumBuckets(c.MaxNumBuckets)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(c.Policies) == 0 {
+		return func(policy.Policy) ddsketch.Options {
+			return opts
+		}, nil
+	}
+	policies := make(map[policy.Policy]struct{}, len(c.Policies))
+	for _, p := range c.Policies {
+		policies[p] = struct{}{}
+	}
+	return func(p policy.Policy) ddsketch.Options {
+		if _, ok := policies[p]; ok {
+			return opts
+		}
+		// Policies without aggregation types match any aggregation types.
+		anyAggregation := policy.NewPolicy(p.StoragePolicy, aggregation.DefaultID)
+		if _, ok := policies[anyAggregation]; ok {
+			return opts
+		}
+		return nil
+	}, nil
+}
+
 type placementManagerConfiguration struct {
 	KVConfig kv.OverrideConfiguration       `yaml:"kvConfig"`
 	Watcher  placement.WatcherConfiguration `yaml:"placementWatcher"`
@@ -649,6 +719,30 @@ func (c forwardingConfiguration) MaxAllowedForwardingDelayFn(
 	}
 }
 
+type checkpointConfiguration struct {
+	// Directory the checkpoints of the shards are written to.
+	FilePathPrefix string `yaml:"filePathPrefix" validate:"nonzero"`
+
+	// Interval between two checkpoints.
+	Interval time.Duration `yaml:"interval"`
+
+	// Maximum age of a checkpoint for it to be restored on start.
+	MaxAge time.Duration `yaml:"maxAge"`
+}
+
+func (c checkpointConfiguration) NewCheckpointOptions() aggregator.CheckpointOptions {
+	opts := aggregator.NewCheckpointOptions().
+		SetEnabled(true).
+		SetFilePathPrefix(c.FilePathPrefix)
+	if c.Interval > 0 {
+		opts = opts.SetInterval(c.Interval)
+	}
+	if c.MaxAge > 0 {
+		opts = opts.SetMaxAge(c.MaxAge)
+	}
+	return opts
+}
+
 type flushTimesManagerConfiguration struct {
 	// KV Configuration.
 	KVConfig kv.OverrideConfiguration `yaml:"kvConfig"`
@@ -26,6 +26,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	yaml "gopkg.in/yaml.v2"
+
+	"github.com/m3db/m3/src/metrics/policy"
 )
 
 func TestJitterBuckets(t *testing.T) {
@@ -118,3 +120,44 @@ func TestMaxAllowedForwardingDelayFnJitterDisabled(t *testing.T) {
 		require.Equal(t, input.expected, fn(input.resolution, input.numForwardedTimes))
 	}
 }
+
+func mustParsePolicy(t *testing.T, str string) policy.Policy {
+	p, err := policy.ParsePolicy(str)
+	require.NoError(t, err)
+	return p
+}
+
+func TestTimerSketchOptionsFn(t *testing.T) {
+	config := `
+relativeAccuracy: 0.02
+maxNumBuckets: 1024
+policies:
+  - 10s:2d
+  - 1m:40d|P99,P999`
+
+	var cfg timerSketchConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(config), &cfg))
+
+	fn, err := cfg.NewTimerSketchOptionsFn()
+	require.NoError(t, err)
+
+	opts := fn(mustParsePolicy(t, "1m:40d|P99,P999"))
+	require.NotNil(t, opts)
+	require.Equal(t, 0.02, opts.RelativeAccuracy())
+	require.Equal(t, 1024, opts.MaxNumBuckets())
+	require.Nil(t, fn(mustParsePolicy(t, "1m:40d")))
+	require.Nil(t, fn(mustParsePolicy(t, "1m:40d|P99")))
+	require.Nil(t, fn(mustParsePolicy(t, "1h:1y")))
+
+	// Policies without aggregation types match any aggregation types.
+	require.NotNil(t, fn(mustParsePolicy(t, "10s:2d")))
+	require.NotNil(t, fn(mustParsePolicy(t, "10s:2d|P50")))
+
+	// Sketches are used for all timers when no policies are set.
+	fn, err = timerSketchConfiguration{}.NewTimerSketchOptionsFn()
+	require.NoError(t, err)
+	require.NotNil(t, fn(mustParsePolicy(t, "1h:1y")))
+
+	_, err = timerSketchConfiguration{RelativeAccuracy: 2}.NewTimerSketchOptionsFn()
+	require.Error(t, err)
+}
@@ -163,6 +163,12 @@ type HTTPServerConfiguration struct {
 
 	// HTTP server write timeout.
 	WriteTimeout time.Duration `yaml:"writeTimeout"`
+
+	// Maximum number of elements returned by an inspect request.
+	MaxInspectElems int `yaml:"maxInspectElems"`
+
+	// Maximum number of entries scanned by an inspect request.
+	MaxInspectScannedEntries int `yaml:"maxInspectScannedEntries"`
 }
 
 // NewServerOptions create a new set of http server options.
@@ -174,5 +180,11 @@ func (c *HTTPServerConfiguration) NewServerOptions() http.Options {
 	if c.WriteTimeout != 0 {
 		opts = opts.SetWriteTimeout(c.WriteTimeout)
 	}
+	if c.MaxInspectElems != 0 {
+		opts = opts.SetMaxInspectElems(c.MaxInspectElems)
+	}
+	if c.MaxInspectScannedEntries != 0 {
+		opts = opts.SetMaxInspectScannedEntries(c.MaxInspectScannedEntries)
+	}
 	return opts
 }
@@ -225,6 +225,20 @@ func (mr *MockSamplesAppenderMockRecorder) AppendGaugeSample(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendGaugeSample", reflect.TypeOf((*MockSamplesAppender)(nil).AppendGaugeSample), arg0, arg1, arg2)
 }
 
+// AppendHistogramSample mocks base method.
+func (m *MockSamplesAppender) AppendHistogramSample(arg0 time.UnixNano, arg1 float64, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistogramSample", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendHistogramSample indicates an expected call of AppendHistogramSample.
+func (mr *MockSamplesAppenderMockRecorder) AppendHistogramSample(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistogramSample", reflect.TypeOf((*MockSamplesAppender)(nil).AppendHistogramSample), arg0, arg1, arg2)
+}
+
 // AppendTimerSample mocks base method.
 func (m *MockSamplesAppender) AppendTimerSample(arg0 time.UnixNano, arg1 float64, arg2 []byte) error {
 	m.ctrl.T.Helper()
@@ -267,6 +281,20 @@ func (mr *MockSamplesAppenderMockRecorder) AppendUntimedGaugeSample(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendUntimedGaugeSample", reflect.TypeOf((*MockSamplesAppender)(nil).AppendUntimedGaugeSample), arg0, arg1, arg2)
 }
 
+// AppendUntimedHistogramSample mocks base method.
+func (m *MockSamplesAppender) AppendUntimedHistogramSample(arg0 time.UnixNano, arg1 float64, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendUntimedHistogramSample", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendUntimedHistogramSample indicates an expected call of AppendUntimedHistogramSample.
+func (mr *MockSamplesAppenderMockRecorder) AppendUntimedHistogramSample(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendUntimedHistogramSample", reflect.TypeOf((*MockSamplesAppender)(nil).AppendUntimedHistogramSample), arg0, arg1, arg2)
+}
+
 // AppendUntimedTimerSample mocks base method.
 func (m *MockSamplesAppender) AppendUntimedTimerSample(arg0 time.UnixNano, arg1 float64, arg2 []byte) error {
 	m.ctrl.T.Helper()
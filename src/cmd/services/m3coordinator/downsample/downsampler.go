@@ -87,13 +87,17 @@ type SamplesAppenderOverrideRules struct {
 // that can only be called by a single caller at a time.
 // The client timestamp provided to Untimed methods is only used to monitor ingestion latency on the server. It is
 // dropped and a server-side timestamp is used for the metric.
+// Native histogram samples carry the histogram in their annotation and use
+// the count of observations of the histogram as their value.
 type SamplesAppender interface {
 	AppendUntimedCounterSample(t xtime.UnixNano, value int64, annotation []byte) error
 	AppendUntimedGaugeSample(t xtime.UnixNano, value float64, annotation []byte) error
 	AppendUntimedTimerSample(t xtime.UnixNano, value float64, annotation []byte) error
+	AppendUntimedHistogramSample(t xtime.UnixNano, value float64, annotation []byte) error
 	AppendCounterSample(t xtime.UnixNano, value int64, annotation []byte) error
 	AppendGaugeSample(t xtime.UnixNano, value float64, annotation []byte) error
 	AppendTimerSample(t xtime.UnixNano, value float64, annotation []byte) error
+	AppendHistogramSample(t xtime.UnixNano, value float64, annotation []byte) error
 }
 
 type downsampler struct {
@@ -1701,6 +1701,73 @@ func TestDownsamplerAggregationWithRulesConfigRollupRulesPerSecondSum(t *testing
 	testDownsamplerAggregation(t, testDownsampler)
 }
 
+func TestDownsamplerAggregationWithRulesConfigRollupRulesCountDistinct(t *testing.T) {
+	t.Parallel()
+
+	var gaugeMetrics []testGaugeMetric
+	for i, user := range []string{"alice", "bob", "alice", "carol"} {
+		gaugeMetrics = append(gaugeMetrics, testGaugeMetric{
+			tags: map[string]string{
+				nameTag:    "http_requests",
+				"app":      "nginx_edge",
+				"user":     user,
+				"instance": fmt.Sprintf("instance%d", i),
+			},
+			timedSamples: []testGaugeMetricTimedSample{{value: 1}},
+		})
+	}
+	res := 1 * time.Second
+	ret := 30 * 24 * time.Hour
+	testDownsampler := newTestDownsampler(t, testDownsamplerOptions{
+		rulesConfig: &RulesConfiguration{
+			RollupRules: []RollupRuleConfiguration{
+				{
+					Filter: fmt.Sprintf("%s:http_requests app:*", nameTag),
+					Transforms: []TransformConfiguration{
+						{
+							Rollup: &RollupOperationConfiguration{
+								MetricName:   "http_requests_distinct_users",
+								GroupBy:      []string{"app"},
+								Aggregations: []aggregation.Type{aggregation.CountDistinct},
+								DistinctTag:  "user",
+							},
+						},
+					},
+					StoragePolicies: []StoragePolicyConfiguration{
+						{
+							Resolution: res,
+							Retention:  ret,
+						},
+					},
+				},
+			},
+		},
+		ingest: &testDownsamplerOptionsIngest{
+			gaugeMetrics: gaugeMetrics,
+		},
+		expect: &testDownsamplerOptionsExpect{
+			writes: []testExpectedWrite{
+				{
+					tags: map[string]string{
+						nameTag:               "http_requests_distinct_users",
+						string(rollupTagName): string(rollupTagValue),
+						"app":                 "nginx_edge",
+					},
+					values: []expectedValue{{value: 3}},
+					attributes: &storagemetadata.Attributes{
+						MetricsType: storagemetadata.AggregatedMetricsType,
+						Resolution:  res,
+						Retention:   ret,
+					},
+				},
+			},
+		},
+	})
+
+	// Test expected output
+	testDownsamplerAggregation(t, testDownsampler)
+}
+
 func TestDownsamplerAggregationWithRulesConfigRollupRulesAugmentTags(t *testing.T) {
 	t.Parallel()
 
@@ -33,6 +33,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/hll"
 	"github.com/m3db/m3/src/aggregator/aggregator"
 	"github.com/m3db/m3/src/aggregator/client"
 	"github.com/m3db/m3/src/metrics/aggregation"
@@ -197,6 +198,8 @@ func (a *metricsAppender) SamplesAppender(opts SampleAppenderOptions) (SamplesAp
 		tags.append(metric.M3TypeTag, metric.M3GaugeValue)
 	case ts.M3MetricTypeTimer:
 		tags.append(metric.M3TypeTag, metric.M3TimerValue)
+	case ts.M3MetricTypeHistogram:
+		tags.append(metric.M3TypeTag, metric.M3HistogramValue)
 	}
 	switch opts.SeriesAttributes.PromType {
 	case ts.PromMetricTypeUnknown:
@@ -448,11 +451,20 @@ func (a *metricsAppender) SamplesAppender(opts SampleAppenderOptions) (SamplesAp
 
 		a.debugLogMatch("downsampler applying matched rollup rule",
 			debugLogMatchOptions{Meta: rollup.Metadatas, RollupID: rollup.ID})
+		var distinctSketch []byte
+		if len(rollup.DistinctValue) > 0 {
+			// The rollup counts the distinct values of a tag, each sample carries
+			// the sketch of the value of the tag for this metric.
+			sketch := hll.NewSketch()
+			sketch.Add(rollup.DistinctValue)
+			distinctSketch = sketch.Marshal(nil)
+		}
 		a.multiSamplesAppender.addSamplesAppender(samplesAppender{
 			agg:             a.agg,
 			clientRemote:    a.clientRemote,
 			unownedID:       rollup.ID,
 			stagedMetadatas: rollup.Metadatas,
+			distinctSketch:  distinctSketch,
 
 			processedCountNonRollup: a.metrics.processedCountNonRollup,
 			processedCountRollup:    a.metrics.processedCountRollup,
@@ -534,6 +534,7 @@ func (r RollupRuleConfiguration) Rule() (view.RollupRule, error) {
 					NewName:          cfg.MetricName,
 					Tags:             tags,
 					AggregationTypes: aggregationTypes,
+					DistinctTag:      cfg.DistinctTag,
 				},
 			})
 			if err != nil {
@@ -634,6 +635,10 @@ type RollupOperationConfiguration struct {
 
 	// Aggregations is a set of aggregate operations to perform.
 	Aggregations []aggregation.Type `yaml:"aggregations"`
+
+	// DistinctTag is the label whose distinct values are counted by the
+	// CountDistinct aggregation, only valid for the first rollup operation.
+	DistinctTag string `yaml:"distinctTag"`
 }
 
 // AggregateOperationConfiguration is an aggregate operation.
@@ -21,10 +21,13 @@
 package downsample
 
 import (
+	"errors"
+
 	"github.com/uber-go/tally"
 
 	"github.com/m3db/m3/src/aggregator/aggregator"
 	"github.com/m3db/m3/src/aggregator/client"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
@@ -33,6 +36,9 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
+var errUntimedHistogramRemoteAggregator = errors.New(
+	"untimed native histograms are not supported with a remote aggregator")
+
 // samplesAppender must have one of agg or client set
 type samplesAppender struct {
 	agg                     aggregator.Aggregator
@@ -43,6 +49,10 @@ type samplesAppender struct {
 
 	unownedID       []byte
 	stagedMetadatas metadata.StagedMetadatas
+	// distinctSketch is the marshalled sketch of the value of the distinct tag
+	// of a rollup counting distinct values, it is carried in the annotation
+	// of every sample.
+	distinctSketch []byte
 }
 
 // Ensure samplesAppender implements SamplesAppender.
@@ -50,6 +60,7 @@ var _ SamplesAppender = (*samplesAppender)(nil)
 
 // nolint:dupl
 func (a samplesAppender) AppendUntimedCounterSample(t xtime.UnixNano, value int64, annotation []byte) error {
+	annotation = a.withDistinctSketch(annotation)
 	a.emitMetrics()
 	if a.clientRemote != nil {
 		// Remote client write instead of local aggregation.
@@ -73,6 +84,7 @@ func (a samplesAppender) AppendUntimedCounterSample(t xtime.UnixNano, value int6
 
 // nolint:dupl
 func (a samplesAppender) AppendUntimedGaugeSample(t xtime.UnixNano, value float64, annotation []byte) error {
+	annotation = a.withDistinctSketch(annotation)
 	a.emitMetrics()
 	if a.clientRemote != nil {
 		// Remote client write instead of local aggregation.
@@ -95,6 +107,7 @@ func (a samplesAppender) AppendUntimedGaugeSample(t xtime.UnixNano, value float6
 }
 
 func (a samplesAppender) AppendUntimedTimerSample(t xtime.UnixNano, value float64, annotation []byte) error {
+	annotation = a.withDistinctSketch(annotation)
 	a.emitMetrics()
 	if a.clientRemote != nil {
 		// Remote client write instead of local aggregation.
@@ -116,6 +129,23 @@ func (a samplesAppender) AppendUntimedTimerSample(t xtime.UnixNano, value float6
 	return a.agg.AddUntimed(sample, a.stagedMetadatas)
 }
 
+func (a samplesAppender) AppendUntimedHistogramSample(t xtime.UnixNano, value float64, annotation []byte) error {
+	annotation = a.withDistinctSketch(annotation)
+	a.emitMetrics()
+	if a.clientRemote != nil {
+		// The untimed remote client protocol has no histogram type.
+		return errUntimedHistogramRemoteAggregator
+	}
+
+	sample := unaggregated.MetricUnion{
+		Type:       metric.HistogramType,
+		ID:         a.unownedID,
+		GaugeVal:   value,
+		Annotation: annotation,
+	}
+	return a.agg.AddUntimed(sample, a.stagedMetadatas)
+}
+
 func (a *samplesAppender) AppendCounterSample(t xtime.UnixNano, value int64, annotation []byte) error {
 	return a.appendTimedSample(aggregated.Metric{
 		Type:       metric.CounterType,
@@ -148,8 +178,21 @@ func (a *samplesAppender) AppendTimerSample(
 	})
 }
 
+func (a *samplesAppender) AppendHistogramSample(
+	t xtime.UnixNano, value float64, annotation []byte,
+) error {
+	return a.appendTimedSample(aggregated.Metric{
+		Type:       metric.HistogramType,
+		ID:         a.unownedID,
+		TimeNanos:  int64(t),
+		Value:      value,
+		Annotation: annotation,
+	})
+}
+
 func (a *samplesAppender) appendTimedSample(sample aggregated.Metric) error {
 	a.emitMetrics()
+	sample.Annotation = a.withDistinctSketch(sample.Annotation)
 	if a.clientRemote != nil {
 		return a.clientRemote.WriteTimedWithStagedMetadatas(sample, a.stagedMetadatas)
 	}
@@ -157,6 +200,24 @@ func (a *samplesAppender) appendTimedSample(sample aggregated.Metric) error {
 	return a.agg.AddTimedWithStagedMetadatas(sample, a.stagedMetadatas)
 }
 
+// withDistinctSketch returns the annotation of a sample along with the
+// distinct sketch of the appender, if any.
+func (a samplesAppender) withDistinctSketch(annotationBytes []byte) []byte {
+	if len(a.distinctSketch) == 0 {
+		return annotationBytes
+	}
+	var payload annotation.Payload
+	if err := payload.Unmarshal(annotationBytes); err != nil {
+		payload = annotation.Payload{}
+	}
+	payload.DistinctSketch = a.distinctSketch
+	result, err := payload.Marshal()
+	if err != nil {
+		return annotationBytes
+	}
+	return result
+}
+
 func (a *samplesAppender) emitMetrics() {
 	for _, metadata := range a.stagedMetadatas {
 		// Separate out the rollup and non-rollup processed counts. For rollups
@@ -230,6 +291,16 @@ func (a *multiSamplesAppender) AppendUntimedTimerSample(t xtime.UnixNano, value
 	return multiErr.LastError()
 }
 
+func (a *multiSamplesAppender) AppendUntimedHistogramSample(
+	t xtime.UnixNano, value float64, annotation []byte,
+) error {
+	var multiErr xerrors.MultiError
+	for _, appender := range a.appenders {
+		multiErr = multiErr.Add(appender.AppendUntimedHistogramSample(t, value, annotation))
+	}
+	return multiErr.LastError()
+}
+
 func (a *multiSamplesAppender) AppendCounterSample(
 	t xtime.UnixNano, value int64, annotation []byte,
 ) error {
@@ -259,3 +330,13 @@ func (a *multiSamplesAppender) AppendTimerSample(
 	}
 	return multiErr.LastError()
 }
+
+func (a *multiSamplesAppender) AppendHistogramSample(
+	t xtime.UnixNano, value float64, annotation []byte,
+) error {
+	var multiErr xerrors.MultiError
+	for _, appender := range a.appenders {
+		multiErr = multiErr.Add(appender.AppendHistogramSample(t, value, annotation))
+	}
+	return multiErr.LastError()
+}
@@ -504,6 +504,10 @@ func generateTagsFromName(
 		return models.EmptyTags(), errCannotGenerateTagsFromEmptyName
 	}
 
+	if carbon.IsTaggedName(name) {
+		return generateTagsFromTaggedName(name, opts, tags)
+	}
+
 	numTags := bytes.Count(name, carbonSeparatorBytes) + 1
 
 	if cap(tags) >= numTags {
@@ -609,6 +613,35 @@ func (i *ingester) compileRulesWithLock(rules CarbonIngesterRules) ([]ruleAndMat
 	return compiledRules, nil
 }
 
+// generateTagsFromTaggedName generates the tags of a tagged carbon metric of
+// the form path;tag1=value1;tag2=value2, the path is stored in the name tag
+// instead of being split into __gN__ tags.
+func generateTagsFromTaggedName(
+	name []byte,
+	opts models.TagOptions,
+	tags []models.Tag,
+) (models.Tags, error) {
+	path, carbonTags, err := carbon.ParseTaggedName(name, nil)
+	if err != nil {
+		return models.EmptyTags(), fmt.Errorf("carbon metric: %s has invalid tags: %w",
+			string(name), err)
+	}
+
+	tags = append(tags[:0], models.Tag{
+		Name:  graphite.TaggedNameTag,
+		Value: path,
+	})
+	for _, tag := range carbonTags {
+		tags = append(tags, models.Tag{Name: tag.Name, Value: tag.Value})
+	}
+
+	result := models.Tags{Opts: opts, Tags: tags}.Normalize()
+	if err := result.Validate(); err != nil {
+		return models.EmptyTags(), err
+	}
+	return result, nil
+}
+
 func (i *ingester) getLineResources() *lineResources {
 	return i.lineResourcesPool.Get().(*lineResources)
 }
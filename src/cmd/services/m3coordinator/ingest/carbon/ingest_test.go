@@ -601,6 +601,15 @@ func TestGenerateTagsFromName(t *testing.T) {
 				{Name: graphite.TagName(2), Value: []byte("baz")},
 			},
 		},
+		{
+			name: "foo.bar;host=a;dc=dc1",
+			id:   "foo.bar;dc=dc1;host=a",
+			expectedTags: []models.Tag{
+				{Name: []byte("dc"), Value: []byte("dc1")},
+				{Name: []byte("host"), Value: []byte("a")},
+				{Name: graphite.TaggedNameTag, Value: []byte("foo.bar")},
+			},
+		},
 		{
 			name:         "foo..bar..baz..",
 			expectedErr:  fmt.Errorf("carbon metric: foo..bar..baz.. has duplicate separator"),
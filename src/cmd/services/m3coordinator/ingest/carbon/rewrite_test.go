@@ -57,6 +57,14 @@ func TestCopyAndRewrite(t *testing.T) {
 				Cleanup: true,
 			},
 		},
+		{
+			name:     "tagged with rewrite cleanup",
+			input:    "foo$$..bar;dc=dc$1",
+			expected: "foo_.bar;dc=dc$1",
+			cfg: &config.CarbonIngesterRewriteConfiguration{
+				Cleanup: true,
+			},
+		},
 		{
 			name:     "bad with rewrite cleanup",
 			input:    "foo$$.bar%%.baz@@",
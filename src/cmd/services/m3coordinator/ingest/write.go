@@ -574,6 +574,14 @@ func (d *downsamplerAndWriter) writeAggregatedBatch(
 						dp.Timestamp, dp.Value, value.Annotation,
 					)
 				}
+			case ts.M3MetricTypeHistogram:
+				if result.ShouldDropTimestamp {
+					err = result.SamplesAppender.AppendUntimedHistogramSample(dp.Timestamp, dp.Value, value.Annotation)
+				} else {
+					err = result.SamplesAppender.AppendHistogramSample(
+						dp.Timestamp, dp.Value, value.Annotation,
+					)
+				}
 			}
 			if err != nil {
 				// If we see an error break out so we can try processing the
@@ -606,6 +606,48 @@ func TestDownsampleAndWriteBatchDifferentTypes(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDownsampleAndWriteBatchHistogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	downAndWrite, downsampler, session := newTestDownsamplerAndWriter(t, ctrl,
+		testDownsamplerAndWriterOptions{})
+
+	var (
+		mockSamplesAppender = downsample.NewMockSamplesAppender(ctrl)
+		mockMetricsAppender = downsample.NewMockMetricsAppender(ctrl)
+		attributes          = ts.SeriesAttributes{M3Type: ts.M3MetricTypeHistogram}
+		entries             = []testIterEntry{
+			{tags: testTags1, datapoints: testDatapoints1, attributes: attributes, annotation: testAnnotation1},
+		}
+	)
+
+	mockMetricsAppender.
+		EXPECT().
+		SamplesAppender(downsample.SampleAppenderOptions{SeriesAttributes: attributes}).
+		Return(downsample.SamplesAppenderResult{SamplesAppender: mockSamplesAppender}, nil)
+	for _, tag := range testTags1.Tags {
+		mockMetricsAppender.EXPECT().AddTag(tag.Name, tag.Value)
+	}
+	for _, dp := range testDatapoints1 {
+		mockSamplesAppender.EXPECT().AppendHistogramSample(dp.Timestamp, dp.Value, testAnnotation1)
+	}
+	downsampler.EXPECT().NewMetricsAppender().Return(mockMetricsAppender, nil)
+
+	mockMetricsAppender.EXPECT().NextMetric()
+	mockMetricsAppender.EXPECT().Finalize()
+
+	for _, dp := range testDatapoints1 {
+		session.EXPECT().WriteTagged(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), dp.Value, gomock.Any(), testAnnotation1,
+		)
+	}
+
+	iter := newTestIter(entries)
+	err := downAndWrite.WriteBatch(context.Background(), iter, WriteOptions{})
+	require.NoError(t, err)
+}
+
 func TestDownsampleAndWriteBatchSingleDrop(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/backup"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/commitlog"
 	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/peers"
@@ -39,6 +40,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
+	"github.com/m3db/m3/src/x/objectstore"
 )
 
 var (
@@ -151,6 +153,10 @@ type BootstrapConfiguration struct {
 	// Peers bootstrapper configuration.
 	Peers *BootstrapPeersConfiguration `yaml:"peers"`
 
+	// Backup bootstrapper configuration, when set the shards without data
+	// on disk are restored from backups before any other bootstrapper runs.
+	Backup *BootstrapBackupConfiguration `yaml:"backup"`
+
 	// CacheSeriesMetadata determines whether individual bootstrappers cache
 	// series metadata across all calls (namespaces / shards / blocks).
 	CacheSeriesMetadata *bool `yaml:"cacheSeriesMetadata"`
@@ -267,6 +273,16 @@ type BootstrapPeersConfiguration struct {
 	StreamPersistShardFlushConcurrency *int `yaml:"streamPersistShardFlushConcurrency"`
 }
 
+// BootstrapBackupConfiguration specifies config for the backup bootstrapper.
+type BootstrapBackupConfiguration struct {
+	// Store is the object store the backups are restored from.
+	Store objectstore.Configuration `yaml:"store"`
+
+	// BackupID is the ID of the backup to restore, the latest complete
+	// backup of each namespace is restored if it is not set.
+	BackupID string `yaml:"backupID"`
+}
+
 // New creates a bootstrap process based on the bootstrap configuration.
 func (bsc BootstrapConfiguration) New(
 	rsOpts result.Options,
@@ -379,6 +395,21 @@ func (bsc BootstrapConfiguration) New(
 			if err != nil {
 				return nil, err
 			}
+		case backup.BackupBootstrapperName:
+			store, err := bsc.Backup.Store.NewStore()
+			if err != nil {
+				return nil, err
+			}
+			bOpts := backup.NewOptions().
+				SetFilesystemOptions(fsOpts).
+				SetObjectStore(store).
+				SetBackupID(bsc.Backup.BackupID).
+				SetOrigin(origin).
+				SetInstrumentOptions(opts.InstrumentOptions())
+			bs, err = backup.NewBackupBootstrapperProvider(bOpts, bs)
+			if err != nil {
+				return nil, err
+			}
 		case uninitialized.UninitializedTopologyBootstrapperName:
 			uOpts := uninitialized.NewOptions().
 				SetResultOptions(rsOpts).
@@ -423,6 +454,16 @@ func (bsc BootstrapConfiguration) peersConfig() BootstrapPeersConfiguration {
 }
 
 func (bsc BootstrapConfiguration) orderedBootstrappers() []string {
+	bootstrappers := bsc.modeOrderedBootstrappers()
+	if bsc.Backup != nil {
+		// Restoring from backups must happen before the filesystem
+		// bootstrapper reads the filesets.
+		bootstrappers = append([]string{backup.BackupBootstrapperName}, bootstrappers...)
+	}
+	return bootstrappers
+}
+
+func (bsc BootstrapConfiguration) modeOrderedBootstrappers() []string {
 	if bsc.BootstrapMode != nil {
 		switch *bsc.BootstrapMode {
 		case DefaultBootstrapMode:
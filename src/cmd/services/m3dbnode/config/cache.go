@@ -40,6 +40,9 @@ type CacheConfigurations struct {
 
 	// Regexp cache policy.
 	Regexp *RegexpCacheConfiguration `yaml:"regexp"`
+
+	// Blocks cache policy.
+	Blocks *BlockCacheConfiguration `yaml:"blocks"`
 }
 
 // SeriesConfiguration returns the series cache configuration or default
@@ -70,6 +73,15 @@ func (c CacheConfigurations) RegexpConfiguration() RegexpCacheConfiguration {
 	return *c.Regexp
 }
 
+// BlocksConfiguration returns the block cache configuration or default
+// if none is specified.
+func (c CacheConfigurations) BlocksConfiguration() BlockCacheConfiguration {
+	if c.Blocks == nil {
+		return BlockCacheConfiguration{}
+	}
+	return *c.Blocks
+}
+
 // SeriesCacheConfiguration is the series cache configuration.
 type SeriesCacheConfiguration struct {
 	Policy series.CachePolicy                 `yaml:"policy"`
@@ -145,3 +157,21 @@ func (c RegexpCacheConfiguration) SizeOrDefault() int {
 
 	return *c.Size
 }
+
+// BlockCacheConfiguration is the configuration of the cache of blocks
+// retrieved from disk, shared by all namespaces. The cache is disabled
+// unless a maximum size is set.
+type BlockCacheConfiguration struct {
+	// MaxBytes is the maximum decoded size of the cached blocks in bytes.
+	MaxBytes int64 `yaml:"maxBytes"`
+
+	// NamespaceWeights scales how likely the blocks of a namespace are to be
+	// admitted to the cache, namespaces not listed have a weight of 1 and a
+	// weight of 0 disables caching for a namespace.
+	NamespaceWeights map[string]float64 `yaml:"namespaceWeights"`
+}
+
+// Enabled returns whether the block cache is enabled.
+func (c BlockCacheConfiguration) Enabled() bool {
+	return c.MaxBytes > 0
+}
@@ -39,12 +39,14 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/discovery"
 	"github.com/m3db/m3/src/dbnode/environment"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/x/config/hostid"
 	"github.com/m3db/m3/src/x/debug/config"
 	"github.com/m3db/m3/src/x/instrument"
 	xlog "github.com/m3db/m3/src/x/log"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/opentracing"
 )
 
@@ -221,6 +223,22 @@ type DBConfiguration struct {
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
 
+	// NativeHistograms contains the configuration for storing native histograms.
+	NativeHistograms *NativeHistogramsConfiguration `yaml:"nativeHistograms"`
+
+	// Exemplars contains the configuration for storing exemplars.
+	Exemplars *ExemplarsConfiguration `yaml:"exemplars"`
+
+	// BlockCompaction contains the configuration for compacting older flushed
+	// blocks into filesets with larger block sizes.
+	BlockCompaction *BlockCompactionConfiguration `yaml:"blockCompaction"`
+
+	// Backup contains the configuration for backing up namespaces.
+	Backup *BackupConfiguration `yaml:"backup"`
+
+	// SeriesDeletion contains the configuration for deleting series.
+	SeriesDeletion *SeriesDeletionConfiguration `yaml:"seriesDeletion"`
+
 	// Tracing configures opentracing. If not provided, tracing is disabled.
 	Tracing *opentracing.TracingConfiguration `yaml:"tracing"`
 
@@ -389,6 +407,10 @@ func (c *DBConfiguration) Validate() error {
 		return err
 	}
 
+	if c.NativeHistograms.IsEnabled() && c.Proto != nil && c.Proto.Enabled {
+		return errors.New("native histograms cannot be enabled with proto data mode")
+	}
+
 	if err := c.Transforms.Validate(); err != nil {
 		return err
 	}
@@ -533,6 +555,18 @@ type CommitLogPolicy struct {
 	// works in most cases because the default size of the QueueChannel should be large
 	// enough for almost all workloads assuming a reasonable batch size is used.
 	QueueChannel *CommitLogQueuePolicy `yaml:"queueChannel"`
+
+	// Compression is the compression applied to the chunks of commit log
+	// files, one of none, snappy or zstd. Defaults to none.
+	Compression *commitlog.CompressionType `yaml:"compression"`
+}
+
+// CompressionOrDefault returns the configured commit log compression or the default.
+func (p CommitLogPolicy) CompressionOrDefault() commitlog.CompressionType {
+	if p.Compression == nil {
+		return commitlog.CompressionNone
+	}
+	return *p.Compression
 }
 
 // CalculationType is a type of configuration parameter.
@@ -646,6 +680,63 @@ type ProtoConfiguration struct {
 	SchemaRegistry map[string]NamespaceProtoSchema `yaml:"schema_registry"`
 }
 
+// NativeHistogramsConfiguration is the configuration for storing native
+// histograms, when enabled series are encoded with an encoding that supports
+// both native histogram and float samples. Existing M3TSZ data remains
+// readable after enabling native histograms.
+type NativeHistogramsConfiguration struct {
+	// Enabled specifies whether native histograms are enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
+// IsEnabled returns whether native histograms are enabled.
+func (c *NativeHistogramsConfiguration) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+// ExemplarsConfiguration is the configuration for storing exemplars, the
+// most recent exemplars of each shard are kept in a bounded buffer that is
+// persisted alongside the shard's filesets.
+type ExemplarsConfiguration struct {
+	// MaxPerShard is the maximum number of exemplars retained per shard,
+	// exemplars are not stored if it is zero.
+	MaxPerShard int `yaml:"maxPerShard" validate:"min=0"`
+}
+
+// BlockCompactionConfiguration is the configuration for compacting older
+// flushed blocks of namespaces without cold writes into filesets with larger
+// block sizes, which reduces the number of filesets kept for long retentions.
+type BlockCompactionConfiguration struct {
+	// Tiers are the compaction tiers ordered by increasing age and block size.
+	Tiers []BlockCompactionTierConfiguration `yaml:"tiers"`
+}
+
+// BlockCompactionTierConfiguration is the configuration for a block
+// compaction tier.
+type BlockCompactionTierConfiguration struct {
+	// Age is the age after which blocks are compacted into the tier.
+	Age time.Duration `yaml:"age" validate:"nonzero"`
+
+	// BlockSize is the block size of the tier's filesets, which must be a
+	// multiple of the block size of the namespaces to compact.
+	BlockSize time.Duration `yaml:"blockSize" validate:"nonzero"`
+}
+
+// BackupConfiguration is the configuration for backing up namespaces, the
+// filesets of a namespace are uploaded to the store on request.
+type BackupConfiguration struct {
+	// Store is the object store the backups are uploaded to.
+	Store objectstore.Configuration `yaml:"store"`
+}
+
+// SeriesDeletionConfiguration is the configuration for deleting series.
+type SeriesDeletionConfiguration struct {
+	// MaxTombstonesPerShard is the maximum number of deleted ranges a shard
+	// holds until they are dropped from its filesets, deletes that would
+	// exceed it are rejected.
+	MaxTombstonesPerShard int `yaml:"maxTombstonesPerShard" validate:"min=1"`
+}
+
 // NamespaceProtoSchema is the namespace protobuf schema.
 type NamespaceProtoSchema struct {
 	// For application m3db client integration test convenience (where a local dbnode is started as a docker container),
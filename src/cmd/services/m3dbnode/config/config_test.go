@@ -406,6 +406,7 @@ func TestConfiguration(t *testing.T) {
     hashing:
       seed: 42
     proto: null
+    nativeHistograms: null
     asyncWriteWorkerPoolSize: null
     asyncWriteMaxConcurrency: null
     useV2BatchAPIs: null
@@ -416,6 +417,7 @@ func TestConfiguration(t *testing.T) {
     shardsLeavingCountTowardsConsistency: null
     shardsLeavingAndInitializingCountTowardsConsistency: null
     iterateEqualTimestampStrategy: null
+    readRepair: null
   gcPercentage: 100
   tick: null
   bootstrap:
@@ -426,6 +428,7 @@ func TestConfiguration(t *testing.T) {
     commitlog:
       returnUnfulfilledForCorruptCommitLogFiles: false
     peers: null
+    backup: null
     cacheSeriesMetadata: null
     indexSegmentConcurrency: null
     verify: null
@@ -440,6 +443,7 @@ func TestConfiguration(t *testing.T) {
       cacheTerms: false
       cacheSearch: null
     regexp: null
+    blocks: null
   filesystem:
     filePathPrefix: /var/lib/m3db
     writeBufferSize: 65536
@@ -454,6 +458,9 @@ func TestConfiguration(t *testing.T) {
     force_index_summaries_mmap_memory: true
     force_bloom_filter_mmap_memory: true
     bloomFilterFalsePositivePercent: null
+    offload: null
+    encryption: null
+    scrub: null
   commitlog:
     flushMaxBytes: 524288
     flushEvery: 1s
@@ -461,6 +468,7 @@ func TestConfiguration(t *testing.T) {
       calculationType: fixed
       size: 2097152
     queueChannel: null
+    compression: null
   repair:
     enabled: false
     type: default
@@ -702,6 +710,11 @@ func TestConfiguration(t *testing.T) {
   writeNewSeriesAsync: true
   writeNewSeriesBackoffDuration: 2ms
   proto: null
+  nativeHistograms: null
+  exemplars: null
+  blockCompaction: null
+  backup: null
+  seriesDeletion: null
   tracing:
     serviceName: ""
     backend: jaeger
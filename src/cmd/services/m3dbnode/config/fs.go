@@ -23,6 +23,11 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/m3db/m3/src/x/encryption"
+	"github.com/m3db/m3/src/x/objectstore"
 )
 
 const (
@@ -41,6 +46,9 @@ const (
 	defaultForceIndexSummariesMmapMemory   = false
 	defaultForceBloomFilterMmapMemory      = false
 	defaultBloomFilterFalsePositivePercent = 0.02
+	defaultOffloadCacheMaxBytes            = 10 << 30 // 10GiB
+	defaultScrubInterval                   = 24 * time.Hour
+	defaultScrubThroughputLimitMbps        = 100.0
 )
 
 // DefaultMmapConfiguration is the default mmap configuration.
@@ -98,6 +106,18 @@ type FilesystemConfiguration struct {
 	// BloomFilterFalsePositivePercent controls the target false positive percentage
 	// for the bloom filters for the fileset files.
 	BloomFilterFalsePositivePercent *float64 `yaml:"bloomFilterFalsePositivePercent"`
+
+	// Offload configures offloading the filesets of older flushed blocks to
+	// an S3 compatible object store.
+	Offload *FileSetOffloadConfiguration `yaml:"offload"`
+
+	// Encryption configures encrypting the filesets, index segments and
+	// commit logs at rest.
+	Encryption *encryption.Configuration `yaml:"encryption"`
+
+	// Scrub configures the background scrubber which periodically verifies
+	// the flushed filesets against their digests.
+	Scrub *FileSetScrubConfiguration `yaml:"scrub"`
 }
 
 // Validate validates the Filesystem configuration. We use this method to validate
@@ -145,6 +165,25 @@ func (f FilesystemConfiguration) Validate() error {
 			*f.BloomFilterFalsePositivePercent)
 	}
 
+	if f.Offload != nil && f.Offload.Cache.MaxBytes != nil && *f.Offload.Cache.MaxBytes < 1 {
+		return fmt.Errorf(
+			"fs offload cache maxBytes is set to: %d, but must be at least 1",
+			*f.Offload.Cache.MaxBytes)
+	}
+
+	if scrub := f.Scrub; scrub != nil {
+		if scrub.Interval != nil && *scrub.Interval <= 0 {
+			return fmt.Errorf(
+				"fs scrub interval is set to: %v, but must be positive",
+				*scrub.Interval)
+		}
+		if scrub.ThroughputLimitMbps != nil && *scrub.ThroughputLimitMbps <= 0 {
+			return fmt.Errorf(
+				"fs scrub throughputLimitMbps is set to: %f, but must be positive",
+				*scrub.ThroughputLimitMbps)
+		}
+	}
+
 	return nil
 }
 
@@ -320,3 +359,87 @@ func (f FilesystemConfiguration) ParseNewDirectoryMode() (os.FileMode, error) {
 	}
 	return os.ModeDir | os.FileMode(v), nil
 }
+
+// FileSetOffloadConfiguration is the configuration for offloading the
+// filesets of older flushed blocks of namespaces without cold writes to an
+// S3 compatible object store.
+type FileSetOffloadConfiguration struct {
+	// Age is the age after which the filesets of flushed blocks are
+	// offloaded.
+	Age time.Duration `yaml:"age" validate:"nonzero"`
+
+	// S3 is the object store the filesets are offloaded to.
+	S3 objectstore.S3Configuration `yaml:"s3"`
+
+	// Cache configures the local cache of offloaded filesets.
+	Cache FileSetOffloadCacheConfiguration `yaml:"cache"`
+}
+
+// FileSetOffloadCacheConfiguration is the configuration for the local cache
+// of offloaded filesets, which are fetched on reads.
+type FileSetOffloadCacheConfiguration struct {
+	// FilePathPrefix is the file path prefix offloaded filesets are cached
+	// under, which must not be shared with the file path prefix of the
+	// database.
+	FilePathPrefix *string `yaml:"filePathPrefix"`
+
+	// MaxBytes is the size of the cached filesets above which the least
+	// recently read filesets are evicted.
+	MaxBytes *int64 `yaml:"maxBytes"`
+}
+
+// FilePathPrefixOrDefault returns the configured cache file path prefix if
+// configured, or a directory within the database file path prefix otherwise.
+func (c FileSetOffloadCacheConfiguration) FilePathPrefixOrDefault(
+	filePathPrefix string,
+) string {
+	if c.FilePathPrefix != nil {
+		return *c.FilePathPrefix
+	}
+
+	return filepath.Join(filePathPrefix, "offload-cache")
+}
+
+// MaxBytesOrDefault returns the configured cache max bytes if configured, or
+// a default value otherwise.
+func (c FileSetOffloadCacheConfiguration) MaxBytesOrDefault() int64 {
+	if c.MaxBytes != nil {
+		return *c.MaxBytes
+	}
+
+	return defaultOffloadCacheMaxBytes
+}
+
+// FileSetScrubConfiguration is the configuration for the background scrubber
+// which periodically verifies the flushed filesets against their digests,
+// quarantines the corrupt ones and restores their blocks from peers.
+type FileSetScrubConfiguration struct {
+	// Enabled enables the scrubber.
+	Enabled bool `yaml:"enabled"`
+
+	// Interval is the interval between two scrubs of all the filesets.
+	Interval *time.Duration `yaml:"interval"`
+
+	// ThroughputLimitMbps limits the rate filesets are read at in Mb/s.
+	ThroughputLimitMbps *float64 `yaml:"throughputLimitMbps"`
+}
+
+// IntervalOrDefault returns the configured scrub interval if configured, or
+// a default value otherwise.
+func (c FileSetScrubConfiguration) IntervalOrDefault() time.Duration {
+	if c.Interval != nil {
+		return *c.Interval
+	}
+
+	return defaultScrubInterval
+}
+
+// ThroughputLimitMbpsOrDefault returns the configured scrub throughput limit
+// if configured, or a default value otherwise.
+func (c FileSetScrubConfiguration) ThroughputLimitMbpsOrDefault() float64 {
+	if c.ThroughputLimitMbps != nil {
+		return *c.ThroughputLimitMbps
+	}
+
+	return defaultScrubThroughputLimitMbps
+}
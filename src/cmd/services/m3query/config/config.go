@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
 	ingestm3msg "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/m3msg"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/server/m3msg"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/graphite/graphite"
@@ -69,6 +70,8 @@ const (
 
 	defaultQueryTimeout = 30 * time.Second
 
+	defaultOTLPDeltaTemporalityTTL = time.Hour
+
 	defaultPrometheusMaxSamplesPerQuery = 100000000
 )
 
@@ -140,7 +143,8 @@ type Configuration struct {
 	ClusterManagement ClusterManagementConfiguration `yaml:"clusterManagement"`
 
 	// PrometheusRemoteBackend configures prometheus remote write backend.
-	// Used only when backend property is "prom-remote"
+	// Used for writes only when backend property is "prom-remote", with the
+	// m3db backend its endpoints are queried alongside M3DB for reads.
 	PrometheusRemoteBackend *PrometheusRemoteBackendConfiguration `yaml:"prometheusRemoteBackend"`
 
 	// ListenAddress is the server listen address.
@@ -179,6 +183,15 @@ type Configuration struct {
 	// Carbon is the carbon configuration.
 	Carbon *CarbonConfiguration `yaml:"carbon"`
 
+	// Rules is the recording and alerting rules configuration.
+	Rules *RulesConfiguration `yaml:"rules"`
+
+	// OTLP is the OpenTelemetry protocol metrics ingestion configuration.
+	OTLP *OTLPConfiguration `yaml:"otlp"`
+
+	// Quotas is the per-tenant write and query quotas configuration.
+	Quotas *QuotasConfiguration `yaml:"quotas"`
+
 	// Middleware is middleware-specific configuration.
 	Middleware MiddlewareConfiguration `yaml:"middleware"`
 
@@ -499,6 +512,70 @@ type IngestConfiguration struct {
 	M3Msg m3msg.Configuration `yaml:"m3msg"`
 }
 
+// RulesConfiguration is the configuration for evaluating Prometheus recording
+// and alerting rules.
+type RulesConfiguration struct {
+	// RuleFiles are the Prometheus rule files to load, globs are supported.
+	RuleFiles []string `yaml:"ruleFiles" validate:"nonzero"`
+	// EvaluationInterval is the interval for groups that do not set one.
+	EvaluationInterval *time.Duration `yaml:"evaluationInterval"`
+	// QueryTimeout is the timeout for evaluating a rule expression.
+	QueryTimeout *time.Duration `yaml:"queryTimeout"`
+	// ResendDelay is the minimum delay before resending a firing alert.
+	ResendDelay *time.Duration `yaml:"resendDelay"`
+	// Alertmanager if set configures where alerts are sent.
+	Alertmanager *AlertmanagerConfiguration `yaml:"alertmanager"`
+}
+
+// AlertmanagerConfiguration is the configuration for sending alerts to an
+// Alertmanager compatible webhook.
+type AlertmanagerConfiguration struct {
+	// URL is the endpoint alerts are posted to.
+	URL string `yaml:"url" validate:"nonzero"`
+	// Timeout is the timeout for sending alerts.
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+// QuotasConfiguration is the configuration for enforcing per-tenant write
+// and query quotas, the quotas themselves are set dynamically in KV.
+type QuotasConfiguration struct {
+	// TenantHeader is the header identifying the tenant of a request.
+	TenantHeader string `yaml:"tenantHeader"`
+	// DefaultTenant is the tenant of requests without a tenant header.
+	DefaultTenant string `yaml:"defaultTenant"`
+	// KVKey is the KV key the tenant quotas are stored at.
+	KVKey string `yaml:"kvKey"`
+	// ActiveSeriesWindow is how long a series counts towards the active
+	// series quota after its last write.
+	ActiveSeriesWindow *time.Duration `yaml:"activeSeriesWindow"`
+}
+
+// KVKeyOrDefault returns the KV key for tenant quotas or default.
+func (c *QuotasConfiguration) KVKeyOrDefault() string {
+	if c.KVKey != "" {
+		return c.KVKey
+	}
+	return kvconfig.TenantQuotas
+}
+
+// OTLPConfiguration is the configuration for ingesting OpenTelemetry protocol
+// metric exports, OTLP/HTTP is always served on the HTTP listen address.
+type OTLPConfiguration struct {
+	// GRPCListenAddress if set is the address to serve OTLP/gRPC on.
+	GRPCListenAddress string `yaml:"grpcListenAddress"`
+	// DeltaTemporalityTTL is how long the accumulated value of a series with
+	// delta temporality is kept after its last point.
+	DeltaTemporalityTTL *time.Duration `yaml:"deltaTemporalityTTL"`
+}
+
+// DeltaTemporalityTTLOrDefault returns the delta temporality TTL or default.
+func (c *OTLPConfiguration) DeltaTemporalityTTLOrDefault() time.Duration {
+	if c == nil || c.DeltaTemporalityTTL == nil {
+		return defaultOTLPDeltaTemporalityTTL
+	}
+	return *c.DeltaTemporalityTTL
+}
+
 // CarbonConfiguration is the configuration for the carbon server.
 type CarbonConfiguration struct {
 	// Ingester if set defines an ingester to run for carbon.
@@ -832,6 +909,9 @@ type PrometheusRemoteBackendConfiguration struct {
 type PrometheusRemoteBackendEndpointConfiguration struct {
 	Name    string `yaml:"name"`
 	Address string `yaml:"address"`
+	// ReadAddress is the Prometheus remote read url of the endpoint, when
+	// empty the endpoint is not queried for reads.
+	ReadAddress string `yaml:"readAddress"`
 	// When nil all unaggregated data will be sent to this endpoint.
 	StoragePolicy *PrometheusRemoteBackendStoragePolicyConfiguration `yaml:"storagePolicy"`
 }
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package cardinality implements series cardinality endpoint interaction.
+package cardinality
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/cmd/tools/m3ctl/client"
+)
+
+// DoGet calls the backend api for the top series cardinality contributors
+func DoGet(
+	endpoint string,
+	headers map[string]string,
+	opts Options,
+	logger *zap.Logger,
+) ([]byte, error) {
+	params := url.Values{}
+	if opts.Start != "" {
+		params.Set("start", opts.Start)
+	}
+	if opts.End != "" {
+		params.Set("end", opts.End)
+	}
+	if opts.Match != "" {
+		params.Set("match[]", opts.Match)
+	}
+	if opts.Limit > 0 {
+		params.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.BlockSize != "" {
+		params.Set("blockSize", opts.BlockSize)
+	}
+
+	url := fmt.Sprintf("%s%s", endpoint, DefaultPath)
+	if len(params) > 0 {
+		url = fmt.Sprintf("%s?%s", url, params.Encode())
+	}
+	return client.DoGet(url, headers, logger)
+}
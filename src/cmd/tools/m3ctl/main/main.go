@@ -33,6 +33,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/m3db/m3/src/cmd/tools/m3ctl/apply"
+	"github.com/m3db/m3/src/cmd/tools/m3ctl/cardinality"
 	"github.com/m3db/m3/src/cmd/tools/m3ctl/namespaces"
 	"github.com/m3db/m3/src/cmd/tools/m3ctl/placements"
 	"github.com/m3db/m3/src/cmd/tools/m3ctl/topics"
@@ -79,6 +80,8 @@ func main() {
 		showAll   bool
 		deleteAll bool
 		nodeName  string
+
+		cardinalityOpts cardinality.Options
 	)
 
 	logger := mustNewLogger(defaultLoggerOptions)
@@ -243,10 +246,31 @@ database creation, database init, adding a node, and replacing a node, are suppo
 		},
 	}
 
+	getCardinalityCmd := &cobra.Command{
+		Use:   "cardinality",
+		Short: "Get the metric names and labels with the most series from the remote endpoint",
+		Long: `This reports the top metric names by series count, the top label names by
+distinct values, the top label pairs by series count, and the series count
+growth between index blocks, in the same format as the Prometheus TSDB status API.
+`,
+		Aliases: []string{"card"},
+		Run: func(cmd *cobra.Command, args []string) {
+			logger.Debug("running command", zap.String("command", cmd.Name()))
+
+			resp, err := cardinality.DoGet(endPoint, headers, cardinalityOpts, logger)
+			if err != nil {
+				logger.Fatal("get cardinality failed", zap.Error(err))
+			}
+
+			os.Stdout.Write(resp) //nolint:errcheck
+		},
+	}
+
 	rootCmd.AddCommand(getCmd, applyCmd, deleteCmd)
 	getCmd.AddCommand(getNamespaceCmd)
 	getCmd.AddCommand(getPlacementCmd)
 	getCmd.AddCommand(getTopicCmd)
+	getCmd.AddCommand(getCardinalityCmd)
 	deleteCmd.AddCommand(deletePlacementCmd)
 	deleteCmd.AddCommand(deleteNamespaceCmd)
 	deleteCmd.AddCommand(deleteTopicCmd)
@@ -259,6 +283,11 @@ database creation, database init, adding a node, and replacing a node, are suppo
 	getNamespaceCmd.Flags().BoolVarP(&showAll, "show-all", "a", false, "times to echo the input")
 	deletePlacementCmd.Flags().BoolVarP(&deleteAll, "delete-all", "a", false, "delete the entire placement")
 	deleteCmd.PersistentFlags().StringVarP(&nodeName, "name", "n", "", "which namespace or node to delete")
+	getCardinalityCmd.Flags().StringVar(&cardinalityOpts.Start, "start", "", "start of the queried range (default 6 index blocks before end)")
+	getCardinalityCmd.Flags().StringVar(&cardinalityOpts.End, "end", "", "end of the queried range (default now)")
+	getCardinalityCmd.Flags().StringVarP(&cardinalityOpts.Match, "match", "m", "", "series selector to restrict the series counted")
+	getCardinalityCmd.Flags().IntVarP(&cardinalityOpts.Limit, "limit", "l", 0, "number of top entries to return (default 10)")
+	getCardinalityCmd.Flags().StringVar(&cardinalityOpts.BlockSize, "block-size", "", "index block size of the namespaces (default 2h)")
 
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		// Override logger if debug flag set.
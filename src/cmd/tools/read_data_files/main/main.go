@@ -34,6 +34,7 @@ import (
 	"github.com/pborman/getopt"
 	"go.uber.org/zap"
 
+	"github.com/m3db/m3/src/cmd/tools"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/persist"
@@ -77,6 +78,8 @@ func main() {
 		idFilter       = getopt.StringLong("id-filter", 'f', "", "ID Contains Filter (optional)")
 		benchmark      = getopt.StringLong(
 			"benchmark", 'B', "", "benchmark mode (optional), [series|datapoints]")
+		keyFiles = getopt.ListLong("key-file", 'k',
+			"Encryption key files to read encrypted files with, comma separated (optional)")
 	)
 	getopt.Parse()
 
@@ -121,7 +124,14 @@ func main() {
 	var bytesPool pool.CheckedBytesPool
 	encodingOpts := encoding.NewOptions().SetBytesPool(bytesPool)
 
-	fsOpts := fs.NewOptions().SetFilePathPrefix(*optPathPrefix)
+	keyProvider, err := tools.NewEncryptionKeyProvider(*keyFiles)
+	if err != nil {
+		log.Fatalf("unable to read encryption key files: %v", err)
+	}
+
+	fsOpts := fs.NewOptions().
+		SetFilePathPrefix(*optPathPrefix).
+		SetEncryptionKeyProvider(keyProvider)
 
 	shards := []uint32{uint32(*optShard)}
 	if *optShard == allShards {
@@ -21,10 +21,7 @@
 package main
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
-	"io"
 	iofs "io/fs"
 	"log"
 	"os"
@@ -37,7 +34,6 @@ import (
 	"github.com/pborman/getopt"
 	"go.uber.org/zap"
 
-	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -129,9 +125,24 @@ func main() {
 			return nil
 		}
 
-		if err = splitFileSet(
-			srcReader, dstWriters, hashFn, *optShards, *optFactor, namespace, uint32(shard),
-			xtime.UnixNano(blockStart), volume); err != nil {
+		splitOpts := fs.SplitFileSetOptions{
+			NamespaceID:    ident.StringID(namespace),
+			Shard:          uint32(shard),
+			NumShards:      *optShards,
+			BlockStart:     xtime.UnixNano(blockStart),
+			VolumeIndex:    volume,
+			DstVolumeIndex: volume + 1,
+			HashFn:         hashFn,
+		}
+		splitWriters := make(map[uint32]fs.StreamingWriter, len(dstWriters))
+		splitReaders := make(map[uint32]fs.DataFileSetReader, len(dstReaders))
+		for i := range dstWriters {
+			dstShard := fs.SplitShardID(*optShards, i, uint32(shard))
+			splitWriters[dstShard] = dstWriters[i]
+			splitReaders[dstShard] = dstReaders[i]
+		}
+
+		if err = fs.SplitFileSet(srcReader, splitWriters, splitOpts); err != nil {
 			if strings.Contains(err.Error(), "no such file or directory") {
 				fmt.Println(" - skip (incomplete fileset)") // nolint: forbidigo
 				return nil
@@ -139,9 +150,7 @@ func main() {
 			return err
 		}
 
-		err = verifySplitShards(
-			srcReader, dstReaders, hashFn, *optShards, namespace, uint32(shard),
-			xtime.UnixNano(blockStart), volume)
+		err = fs.VerifySplitFileSet(srcReader, splitReaders, splitOpts)
 		if err != nil && strings.Contains(err.Error(), "no such file or directory") {
 			return nil
 		}
@@ -154,190 +163,6 @@ func main() {
 	fmt.Printf("Running time: %s\n", runTime) // nolint: forbidigo
 }
 
-func splitFileSet(
-	srcReader fs.DataFileSetReader,
-	dstWriters []fs.StreamingWriter,
-	hashFn sharding.HashFn,
-	srcNumShards uint32,
-	factor int,
-	namespace string,
-	srcShard uint32,
-	blockStart xtime.UnixNano,
-	volume int,
-) error {
-	if srcShard >= srcNumShards {
-		return fmt.Errorf("unexpected source shard ID %d (must be under %d)", srcShard, srcNumShards)
-	}
-
-	readOpts := fs.DataReaderOpenOptions{
-		Identifier: fs.FileSetFileIdentifier{
-			Namespace:   ident.StringID(namespace),
-			Shard:       srcShard,
-			BlockStart:  blockStart,
-			VolumeIndex: volume,
-		},
-		FileSetType:      persist.FileSetFlushType,
-		StreamingEnabled: true,
-	}
-
-	err := srcReader.Open(readOpts)
-	if err != nil {
-		return fmt.Errorf("unable to open srcReader: %w", err)
-	}
-
-	plannedRecordsCount := uint(srcReader.Entries() / factor)
-	if plannedRecordsCount == 0 {
-		plannedRecordsCount = 1
-	}
-
-	for i := range dstWriters {
-		writeOpts := fs.StreamingWriterOpenOptions{
-			NamespaceID:         ident.StringID(namespace),
-			ShardID:             mapToDstShard(srcNumShards, i, srcShard),
-			BlockStart:          blockStart,
-			BlockSize:           srcReader.Status().BlockSize,
-			VolumeIndex:         volume + 1,
-			PlannedRecordsCount: plannedRecordsCount,
-		}
-		if err := dstWriters[i].Open(writeOpts); err != nil {
-			return fmt.Errorf("unable to open dstWriters[%d]: %w", i, err)
-		}
-	}
-
-	dataHolder := make([][]byte, 1)
-	for {
-		entry, err := srcReader.StreamingRead()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("read error: %w", err)
-		}
-
-		newShardID := hashFn(entry.ID)
-		if newShardID%srcNumShards != srcShard {
-			return fmt.Errorf("mismatched shards, %d to %d", srcShard, newShardID)
-		}
-		writer := dstWriters[newShardID/srcNumShards]
-
-		dataHolder[0] = entry.Data
-		if err := writer.WriteAll(entry.ID, entry.EncodedTags, dataHolder, entry.DataChecksum); err != nil {
-			return err
-		}
-	}
-
-	for i := range dstWriters {
-		if err := dstWriters[i].Close(); err != nil {
-			return err
-		}
-	}
-
-	return srcReader.Close()
-}
-
-func verifySplitShards(
-	srcReader fs.DataFileSetReader,
-	dstReaders []fs.DataFileSetReader,
-	hashFn sharding.HashFn,
-	srcNumShards uint32,
-	namespace string,
-	srcShard uint32,
-	blockStart xtime.UnixNano,
-	volume int,
-) error {
-	if srcShard >= srcNumShards {
-		return fmt.Errorf("unexpected source shard ID %d (must be under %d)", srcShard, srcNumShards)
-	}
-
-	srcReadOpts := fs.DataReaderOpenOptions{
-		Identifier: fs.FileSetFileIdentifier{
-			Namespace:   ident.StringID(namespace),
-			Shard:       srcShard,
-			BlockStart:  blockStart,
-			VolumeIndex: volume,
-		},
-		FileSetType:      persist.FileSetFlushType,
-		StreamingEnabled: true,
-	}
-
-	err := srcReader.Open(srcReadOpts)
-	if err != nil {
-		return fmt.Errorf("unable to open srcReader: %w", err)
-	}
-
-	dstEntries := 0
-	for i := range dstReaders {
-		dstReadOpts := fs.DataReaderOpenOptions{
-			Identifier: fs.FileSetFileIdentifier{
-				Namespace:   ident.StringID(namespace),
-				Shard:       mapToDstShard(srcNumShards, i, srcShard),
-				BlockStart:  blockStart,
-				VolumeIndex: volume + 1,
-			},
-			FileSetType:      persist.FileSetFlushType,
-			StreamingEnabled: true,
-		}
-		if err := dstReaders[i].Open(dstReadOpts); err != nil {
-			return fmt.Errorf("unable to open dstReaders[%d]: %w", i, err)
-		}
-		dstEntries += dstReaders[i].Entries()
-	}
-
-	if srcReader.Entries() != dstEntries {
-		return fmt.Errorf("entry count mismatch: src %d != dst %d", srcReader.Entries(), dstEntries)
-	}
-
-	for {
-		srcEntry, err := srcReader.StreamingReadMetadata()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("src read error: %w", err)
-		}
-
-		newShardID := hashFn(srcEntry.ID)
-		if newShardID%srcNumShards != srcShard {
-			return fmt.Errorf("mismatched shards, %d to %d", srcShard, newShardID)
-		}
-		dstReader := dstReaders[newShardID/srcNumShards]
-
-		// Using StreamingRead() on destination filesets here because it also verifies data checksums.
-		dstEntry, err := dstReader.StreamingRead()
-		if err != nil {
-			return fmt.Errorf("dst read error: %w", err)
-		}
-
-		if !bytes.Equal(srcEntry.ID, dstEntry.ID) {
-			return fmt.Errorf("ID mismatch: %s != %s", srcEntry.ID, dstEntry.ID)
-		}
-		if !bytes.Equal(srcEntry.EncodedTags, dstEntry.EncodedTags) {
-			return fmt.Errorf("EncodedTags mismatch: %s != %s", srcEntry.EncodedTags, dstEntry.EncodedTags)
-		}
-		if srcEntry.DataChecksum != dstEntry.DataChecksum {
-			return fmt.Errorf("data checksum mismatch: %d != %d, id=%s",
-				srcEntry.DataChecksum, dstEntry.DataChecksum, srcEntry.ID)
-		}
-	}
-
-	for i := range dstReaders {
-		dstReader := dstReaders[i]
-		if _, err := dstReader.StreamingReadMetadata(); !errors.Is(err, io.EOF) {
-			return fmt.Errorf("expected EOF on split shard %d, but got %w",
-				dstReader.Status().Shard, err)
-		}
-		if err := dstReader.Close(); err != nil {
-			return err
-		}
-	}
-
-	return srcReader.Close()
-}
-
-func mapToDstShard(srcNumShards uint32, i int, srcShard uint32) uint32 {
-	return srcNumShards*uint32(i) + srcShard
-}
-
 func dropDataSuffix(path string) string {
 	dataIdx := strings.LastIndex(path, "/data")
 	if dataIdx < 0 {
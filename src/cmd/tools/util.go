@@ -21,6 +21,7 @@
 package tools
 
 import (
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/pool"
 )
 
@@ -70,3 +71,13 @@ func NewCheckedBytesPool() pool.CheckedBytesPool {
 	bytesPool.Init()
 	return bytesPool
 }
+
+// NewEncryptionKeyProvider returns a key provider that reads the files
+// encrypted with any of the given key files and encrypts new files with the
+// first one, or nil if no key files are given.
+func NewEncryptionKeyProvider(keyFiles []string) (encryption.KeyProvider, error) {
+	if len(keyFiles) == 0 {
+		return nil, nil
+	}
+	return encryption.NewKeyFileProvider(keyFiles[0], keyFiles[1:]...)
+}
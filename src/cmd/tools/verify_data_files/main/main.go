@@ -40,6 +40,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 )
@@ -53,6 +54,7 @@ func main() {
 		optFixInvalidTags      = getopt.BoolLong("fix-invalid-tags", 't', "Fix invalid tags will remove entries with tags that have name/values non-UTF8 chars")
 		optFixInvalidChecksums = getopt.BoolLong("fix-invalid-checksums", 'c', "Fix invalid checksums will remove entries with bad checksums")
 		optDebugLog            = getopt.BoolLong("debug", 'd', "Enable debug log level")
+		optKeyFiles            = getopt.ListLong("key-file", 'k', "Encryption key files to read encrypted files with, comma separated, fixed files are encrypted with the first one (optional)")
 	)
 	getopt.Parse()
 
@@ -73,6 +75,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	keyProvider, err := tools.NewEncryptionKeyProvider(*optKeyFiles)
+	if err != nil {
+		log.Fatal("unable to read encryption key files", zap.Error(err))
+	}
+
 	log.Info("creating bytes pool")
 	bytesPool := tools.NewCheckedBytesPool()
 	bytesPool.Init()
@@ -84,6 +91,7 @@ func main() {
 		fixInvalidIDs:       *optFixInvalidIDs,
 		fixInvalidTags:      *optFixInvalidTags,
 		fixInvalidChecksums: *optFixInvalidChecksums,
+		keyProvider:         keyProvider,
 		bytesPool:           bytesPool,
 		log:                 log,
 	})
@@ -96,6 +104,7 @@ type runOptions struct {
 	fixInvalidIDs       bool
 	fixInvalidTags      bool
 	fixInvalidChecksums bool
+	keyProvider         encryption.KeyProvider
 	bytesPool           pool.CheckedBytesPool
 	log                 *zap.Logger
 }
@@ -169,6 +178,7 @@ func run(opts runOptions) {
 		log.Info("verifying file set file", zap.Any("fileSet", fileSet))
 		if err := verifyFileSet(verifyFileSetOptions{
 			filePathPrefix:      filePathPrefix,
+			keyProvider:         opts.keyProvider,
 			bytesPool:           bytesPool,
 			fileSet:             fileSet,
 			fixDir:              opts.fixDir,
@@ -220,6 +230,7 @@ func dirFiles(dirPath string) ([]string, error) {
 
 type verifyFileSetOptions struct {
 	filePathPrefix string
+	keyProvider    encryption.KeyProvider
 	bytesPool      pool.CheckedBytesPool
 	fileSet        fs.FileSetFile
 
@@ -233,7 +244,9 @@ func verifyFileSet(
 	opts verifyFileSetOptions,
 	log *zap.Logger,
 ) error {
-	fsOpts := fs.NewOptions().SetFilePathPrefix(opts.filePathPrefix)
+	fsOpts := fs.NewOptions().
+		SetFilePathPrefix(opts.filePathPrefix).
+		SetEncryptionKeyProvider(opts.keyProvider)
 	reader, err := fs.NewReader(opts.bytesPool, fsOpts)
 	if err != nil {
 		return err
@@ -343,7 +356,9 @@ func fixFileSet(
 	opts verifyFileSetOptions,
 	log *zap.Logger,
 ) error {
-	fsOpts := fs.NewOptions().SetFilePathPrefix(opts.filePathPrefix)
+	fsOpts := fs.NewOptions().
+		SetFilePathPrefix(opts.filePathPrefix).
+		SetEncryptionKeyProvider(opts.keyProvider)
 	reader, err := fs.NewReader(opts.bytesPool, fsOpts)
 	if err != nil {
 		return err
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/topology/testutil"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -86,6 +87,72 @@ func TestAggregateResultsAccumulatorIdsMerge(t *testing.T) {
 	s.assertMatchesAggregatedTagsIter(t, resultsIter)
 }
 
+func TestAggregateResultsAccumulatorDocsCountMerge(t *testing.T) {
+	// rf=3, 3 identical hosts, with same shards
+	topoMap := testutil.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": testutil.ShardsRange(0, 29, shard.Available),
+		"testhost1": testutil.ShardsRange(0, 29, shard.Available),
+		"testhost2": testutil.ShardsRange(0, 29, shard.Available),
+	})
+
+	newResult := func(counts map[string]int64) *rpc.AggregateQueryRawResult_ {
+		elem := &rpc.AggregateQueryRawResultTagNameElement{TagName: []byte("__name__")}
+		for value, count := range counts {
+			count := count
+			elem.TagValues = append(elem.TagValues, &rpc.AggregateQueryRawResultTagValueElement{
+				TagValue:  []byte(value),
+				DocsCount: &count,
+			})
+		}
+		return &rpc.AggregateQueryRawResult_{
+			Results:    []*rpc.AggregateQueryRawResultTagNameElement{elem},
+			Exhaustive: true,
+		}
+	}
+
+	th := newTestFetchTaggedHelper(t)
+	workflow := testFetchStateWorkflow{
+		t:         t,
+		topoMap:   topoMap,
+		level:     topology.ReadConsistencyLevelAll,
+		startTime: testStartTime,
+		endTime:   testEndTime,
+		steps: []testFetchStateWorklowStep{
+			{
+				hostname:        "testhost0",
+				aggregateResult: newResult(map[string]int64{"bar": 3, "foo": 6}),
+			},
+			{
+				hostname:        "testhost1",
+				aggregateResult: newResult(map[string]int64{"bar": 3, "foo": 6}),
+			},
+			{
+				hostname:        "testhost2",
+				aggregateResult: newResult(map[string]int64{"baz": 3, "foo": 6}),
+				expectedDone:    true,
+			},
+		},
+	}
+	accum := workflow.run()
+	accum.includeDocsCount = true
+
+	resultsIter, _, err := accum.AsAggregatedTagsIterator(1000, th.pools)
+	require.NoError(t, err)
+	require.True(t, resultsIter.Next())
+
+	name, values := resultsIter.Current()
+	require.Equal(t, "__name__", name.String())
+
+	var actual []string
+	for values.Next() {
+		actual = append(actual, values.Current().String())
+	}
+	require.Equal(t, []string{"bar", "baz", "foo"}, actual)
+	require.Equal(t, []int64{2, 1, 6}, resultsIter.CurrentDocsCounts())
+	require.False(t, resultsIter.Next())
+	resultsIter.Finalize()
+}
+
 func TestAggregateResultsAccumulatorIdsMergeUnstrictMajority(t *testing.T) {
 	// rf=3, 3 identical hosts, with same shards
 	topoMap := testutil.MustNewTopologyMap(3, map[string][]shard.Shard{
@@ -31,16 +31,25 @@ type aggregateTagsIterator struct {
 	pools      fetchTaggedPools
 
 	current struct {
-		tagName   ident.ID
-		tagValues ident.Iterator
+		tagName    ident.ID
+		tagValues  ident.Iterator
+		docsCounts []int64
 	}
 
 	backing []*aggregateTagsIteratorTag
 }
 
 type aggregateTagsIteratorTag struct {
-	tagName   ident.ID
-	tagValues []ident.ID
+	tagName    ident.ID
+	tagValues  []ident.ID
+	docsCounts []int64
+}
+
+func (t *aggregateTagsIteratorTag) docsCount(idx int) int64 {
+	if idx >= len(t.docsCounts) {
+		return 0
+	}
+	return t.docsCounts[idx]
 }
 
 // make the compiler ensure the concrete type `&aggregateTagsIterator{}` implements
@@ -67,6 +76,7 @@ func (i *aggregateTagsIterator) Next() bool {
 
 	i.current.tagName = i.backing[i.currentIdx].tagName
 	i.current.tagValues = ident.NewIDSliceIterator(i.backing[i.currentIdx].tagValues)
+	i.current.docsCounts = i.backing[i.currentIdx].docsCounts
 	return true
 }
 
@@ -81,6 +91,7 @@ func (i *aggregateTagsIterator) addTag(tagName []byte) *aggregateTagsIteratorTag
 func (i *aggregateTagsIterator) release() {
 	i.current.tagName = nil
 	i.current.tagValues = nil
+	i.current.docsCounts = nil
 }
 
 func (i *aggregateTagsIterator) Finalize() {
@@ -102,6 +113,10 @@ func (i *aggregateTagsIterator) Current() (tagName ident.ID, tagValues ident.Ite
 	return i.current.tagName, i.current.tagValues
 }
 
+func (i *aggregateTagsIterator) CurrentDocsCounts() []int64 {
+	return i.current.docsCounts
+}
+
 func (i *aggregateTagsIterator) Err() error {
 	return i.err
 }
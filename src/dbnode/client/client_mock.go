@@ -389,6 +389,20 @@ func (mr *MockAggregatedTagsIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockAggregatedTagsIterator)(nil).Current))
 }
 
+// CurrentDocsCounts mocks base method.
+func (m *MockAggregatedTagsIterator) CurrentDocsCounts() []int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentDocsCounts")
+	ret0, _ := ret[0].([]int64)
+	return ret0
+}
+
+// CurrentDocsCounts indicates an expected call of CurrentDocsCounts.
+func (mr *MockAggregatedTagsIteratorMockRecorder) CurrentDocsCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentDocsCounts", reflect.TypeOf((*MockAggregatedTagsIterator)(nil).CurrentDocsCounts))
+}
+
 // Err mocks base method.
 func (m *MockAggregatedTagsIterator) Err() error {
 	m.ctrl.T.Helper()
@@ -880,6 +894,21 @@ func (mr *MockAdminSessionMockRecorder) DedicatedConnection(shardID, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockAdminSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockAdminSession) DeleteSeries(namespace ident.ID, q index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockAdminSessionMockRecorder) DeleteSeries(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockAdminSession)(nil).DeleteSeries), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockAdminSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -955,6 +984,21 @@ func (mr *MockAdminSessionMockRecorder) FetchBootstrapBlocksMetadataFromPeers(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBootstrapBlocksMetadataFromPeers", reflect.TypeOf((*MockAdminSession)(nil).FetchBootstrapBlocksMetadataFromPeers), namespace, shard, start, end, result)
 }
 
+// FetchExemplars mocks base method.
+func (m *MockAdminSession) FetchExemplars(namespace ident.ID, q index.Query, start, end time0.UnixNano, limit int) ([]SeriesExemplars, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", namespace, q, start, end, limit)
+	ret0, _ := ret[0].([]SeriesExemplars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockAdminSessionMockRecorder) FetchExemplars(namespace, q, start, end, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*MockAdminSession)(nil).FetchExemplars), namespace, q, start, end, limit)
+}
+
 // FetchIDs mocks base method.
 func (m *MockAdminSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterators, error) {
 	m.ctrl.T.Helper()
@@ -1703,6 +1747,62 @@ func (mr *MockOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).ReadConsistencyLevel))
 }
 
+// ReadRepairEnabled mocks base method.
+func (m *MockOptions) ReadRepairEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled.
+func (mr *MockOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).ReadRepairEnabled))
+}
+
+// ReadRepairQueueSize mocks base method.
+func (m *MockOptions) ReadRepairQueueSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize.
+func (mr *MockOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).ReadRepairQueueSize))
+}
+
+// ReadRepairRateLimit mocks base method.
+func (m *MockOptions) ReadRepairRateLimit() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairRateLimit")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairRateLimit indicates an expected call of ReadRepairRateLimit.
+func (mr *MockOptionsMockRecorder) ReadRepairRateLimit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairRateLimit", reflect.TypeOf((*MockOptions)(nil).ReadRepairRateLimit))
+}
+
+// ReadRepairSampleRate mocks base method.
+func (m *MockOptions) ReadRepairSampleRate() sampler.Rate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairSampleRate")
+	ret0, _ := ret[0].(sampler.Rate)
+	return ret0
+}
+
+// ReadRepairSampleRate indicates an expected call of ReadRepairSampleRate.
+func (mr *MockOptionsMockRecorder) ReadRepairSampleRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairSampleRate", reflect.TypeOf((*MockOptions)(nil).ReadRepairSampleRate))
+}
+
 // ReaderIteratorAllocate mocks base method.
 func (m *MockOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
 	m.ctrl.T.Helper()
@@ -2305,6 +2405,62 @@ func (mr *MockOptionsMockRecorder) SetReadConsistencyLevel(value interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).SetReadConsistencyLevel), value)
 }
 
+// SetReadRepairEnabled mocks base method.
+func (m *MockOptions) SetReadRepairEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled.
+func (mr *MockOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).SetReadRepairEnabled), value)
+}
+
+// SetReadRepairQueueSize mocks base method.
+func (m *MockOptions) SetReadRepairQueueSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize.
+func (mr *MockOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).SetReadRepairQueueSize), value)
+}
+
+// SetReadRepairRateLimit mocks base method.
+func (m *MockOptions) SetReadRepairRateLimit(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairRateLimit", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairRateLimit indicates an expected call of SetReadRepairRateLimit.
+func (mr *MockOptionsMockRecorder) SetReadRepairRateLimit(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairRateLimit", reflect.TypeOf((*MockOptions)(nil).SetReadRepairRateLimit), value)
+}
+
+// SetReadRepairSampleRate mocks base method.
+func (m *MockOptions) SetReadRepairSampleRate(value sampler.Rate) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairSampleRate", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairSampleRate indicates an expected call of SetReadRepairSampleRate.
+func (mr *MockOptionsMockRecorder) SetReadRepairSampleRate(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairSampleRate", reflect.TypeOf((*MockOptions)(nil).SetReadRepairSampleRate), value)
+}
+
 // SetReaderIteratorAllocate mocks base method.
 func (m *MockOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
 	m.ctrl.T.Helper()
@@ -3532,6 +3688,62 @@ func (mr *MockAdminOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ReadConsistencyLevel))
 }
 
+// ReadRepairEnabled mocks base method.
+func (m *MockAdminOptions) ReadRepairEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairEnabled))
+}
+
+// ReadRepairQueueSize mocks base method.
+func (m *MockAdminOptions) ReadRepairQueueSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairQueueSize))
+}
+
+// ReadRepairRateLimit mocks base method.
+func (m *MockAdminOptions) ReadRepairRateLimit() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairRateLimit")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairRateLimit indicates an expected call of ReadRepairRateLimit.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairRateLimit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairRateLimit", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairRateLimit))
+}
+
+// ReadRepairSampleRate mocks base method.
+func (m *MockAdminOptions) ReadRepairSampleRate() sampler.Rate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairSampleRate")
+	ret0, _ := ret[0].(sampler.Rate)
+	return ret0
+}
+
+// ReadRepairSampleRate indicates an expected call of ReadRepairSampleRate.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairSampleRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairSampleRate))
+}
+
 // ReaderIteratorAllocate mocks base method.
 func (m *MockAdminOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
 	m.ctrl.T.Helper()
@@ -4232,6 +4444,62 @@ func (mr *MockAdminOptionsMockRecorder) SetReadConsistencyLevel(value interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetReadConsistencyLevel), value)
 }
 
+// SetReadRepairEnabled mocks base method.
+func (m *MockAdminOptions) SetReadRepairEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairEnabled), value)
+}
+
+// SetReadRepairQueueSize mocks base method.
+func (m *MockAdminOptions) SetReadRepairQueueSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairQueueSize), value)
+}
+
+// SetReadRepairRateLimit mocks base method.
+func (m *MockAdminOptions) SetReadRepairRateLimit(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairRateLimit", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairRateLimit indicates an expected call of SetReadRepairRateLimit.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairRateLimit(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairRateLimit", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairRateLimit), value)
+}
+
+// SetReadRepairSampleRate mocks base method.
+func (m *MockAdminOptions) SetReadRepairSampleRate(value sampler.Rate) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairSampleRate", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairSampleRate indicates an expected call of SetReadRepairSampleRate.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairSampleRate(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairSampleRate), value)
+}
+
 // SetReaderIteratorAllocate mocks base method.
 func (m *MockAdminOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
 	m.ctrl.T.Helper()
@@ -4918,6 +5186,21 @@ func (mr *MockclientSessionMockRecorder) DedicatedConnection(shardID, opts inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockclientSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockclientSession) DeleteSeries(namespace ident.ID, q index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockclientSessionMockRecorder) DeleteSeries(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockclientSession)(nil).DeleteSeries), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockclientSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -4993,6 +5276,21 @@ func (mr *MockclientSessionMockRecorder) FetchBootstrapBlocksMetadataFromPeers(n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBootstrapBlocksMetadataFromPeers", reflect.TypeOf((*MockclientSession)(nil).FetchBootstrapBlocksMetadataFromPeers), namespace, shard, start, end, result)
 }
 
+// FetchExemplars mocks base method.
+func (m *MockclientSession) FetchExemplars(namespace ident.ID, q index.Query, start, end time0.UnixNano, limit int) ([]SeriesExemplars, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", namespace, q, start, end, limit)
+	ret0, _ := ret[0].([]SeriesExemplars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockclientSessionMockRecorder) FetchExemplars(namespace, q, start, end, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*MockclientSession)(nil).FetchExemplars), namespace, q, start, end, limit)
+}
+
 // FetchIDs mocks base method.
 func (m *MockclientSession) FetchIDs(namespace ident.ID, ids ident.Iterator, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterators, error) {
 	m.ctrl.T.Helper()
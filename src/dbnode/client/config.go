@@ -27,6 +27,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/encoding/nativehistogram"
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/topology"
@@ -95,6 +96,9 @@ type Configuration struct {
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
 
+	// NativeHistograms contains the configuration for reading native histograms.
+	NativeHistograms *NativeHistogramsConfiguration `yaml:"nativeHistograms"`
+
 	// AsyncWriteWorkerPoolSize is the worker pool size for async write requests.
 	AsyncWriteWorkerPoolSize *int `yaml:"asyncWriteWorkerPoolSize"`
 
@@ -130,6 +134,46 @@ type Configuration struct {
 
 	// IterateEqualTimestampStrategy specifies the iterate equal timestamp strategy.
 	IterateEqualTimestampStrategy *encoding.IterateEqualTimestampStrategy `yaml:"iterateEqualTimestampStrategy"`
+
+	// ReadRepair contains the configuration for repairing divergent replicas
+	// observed when fetching tagged series.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+}
+
+// ReadRepairConfiguration is the configuration for client side read repair.
+type ReadRepairConfiguration struct {
+	// Enabled specifies whether read repair is enabled.
+	Enabled bool `yaml:"enabled"`
+
+	// SampleRate is the rate of fetches checked for divergent replicas.
+	SampleRate *sampler.Rate `yaml:"sampleRate"`
+
+	// RateLimit is the maximum number of series repaired per second,
+	// zero means no limit.
+	RateLimit *int `yaml:"rateLimit"`
+
+	// QueueSize is the number of fetches that can be queued for repair,
+	// repairs are dropped when the queue is full.
+	QueueSize *int `yaml:"queueSize"`
+}
+
+// Validate validates the ReadRepairConfiguration.
+func (c *ReadRepairConfiguration) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.SampleRate != nil {
+		if err := c.SampleRate.Validate(); err != nil {
+			return err
+		}
+	}
+	if c.RateLimit != nil && *c.RateLimit < 0 {
+		return fmt.Errorf("rateLimit was: %d but must be >= 0", *c.RateLimit)
+	}
+	if c.QueueSize != nil && *c.QueueSize <= 0 {
+		return fmt.Errorf("queueSize was: %d but must be > 0", *c.QueueSize)
+	}
+	return nil
 }
 
 // ProtoConfiguration is the configuration for running with ProtoDataMode enabled.
@@ -141,6 +185,18 @@ type ProtoConfiguration struct {
 	SchemaRegistry map[string]NamespaceProtoSchema `yaml:"schema_registry"`
 }
 
+// NativeHistogramsConfiguration is the configuration for reading series
+// stored with native histograms enabled.
+type NativeHistogramsConfiguration struct {
+	// Enabled specifies whether native histograms are enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
+// IsEnabled returns whether native histograms are enabled.
+func (c *NativeHistogramsConfiguration) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
 // NamespaceProtoSchema is the protobuf schema for a namespace.
 type NamespaceProtoSchema struct {
 	MessageName    string `yaml:"messageName"`
@@ -227,6 +283,14 @@ func (c *Configuration) Validate() error {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
 
+	if c.NativeHistograms.IsEnabled() && c.Proto != nil && c.Proto.Enabled {
+		return errors.New("m3db client cannot have both native histograms and proto enabled")
+	}
+
+	if err := c.ReadRepair.Validate(); err != nil {
+		return fmt.Errorf("error validating M3DB client read repair configuration: %v", err)
+	}
+
 	return nil
 }
 
@@ -431,6 +495,19 @@ func (c Configuration) NewAdminClient(
 		v = v.SetHostQueueOpsFlushInterval(*syncClientOverrides.HostQueueFlushInterval)
 	}
 
+	if rr := c.ReadRepair; rr != nil {
+		v = v.SetReadRepairEnabled(rr.Enabled)
+		if rr.SampleRate != nil {
+			v = v.SetReadRepairSampleRate(*rr.SampleRate)
+		}
+		if rr.RateLimit != nil {
+			v = v.SetReadRepairRateLimit(*rr.RateLimit)
+		}
+		if rr.QueueSize != nil {
+			v = v.SetReadRepairQueueSize(*rr.QueueSize)
+		}
+	}
+
 	if c.IterateEqualTimestampStrategy != nil {
 		o := v.IterationOptions()
 		o.IterateEqualTimestampStrategy = *c.IterateEqualTimestampStrategy
@@ -444,6 +521,10 @@ func (c Configuration) NewAdminClient(
 
 	v = v.SetReaderIteratorAllocate(m3tsz.DefaultReaderIteratorAllocFn(encodingOpts))
 
+	if c.NativeHistograms.IsEnabled() {
+		v = v.SetReaderIteratorAllocate(nativehistogram.NewReaderIteratorAllocFn(encodingOpts))
+	}
+
 	if c.Proto != nil && c.Proto.Enabled {
 		v = v.SetEncodingProto(encodingOpts)
 		schemaRegistry := namespace.NewSchemaRegistry(true, nil)
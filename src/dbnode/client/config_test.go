@@ -34,6 +34,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/topology"
 	xconfig "github.com/m3db/m3/src/x/config"
 	"github.com/m3db/m3/src/x/retry"
+	"github.com/m3db/m3/src/x/sampler"
 )
 
 func TestConfiguration(t *testing.T) {
@@ -68,6 +69,10 @@ proto:
     ns2:
       schemaDeployID: "deployID-345"
       messageName: "ns2_msg_name"
+readRepair:
+  enabled: true
+  sampleRate: 0.1
+  rateLimit: 50
 `
 
 	fd, err := ioutil.TempFile("", "config.yaml")
@@ -95,6 +100,8 @@ proto:
 		num4                 = 4
 		numHalf              = 0.5
 		boolTrue             = true
+		sampleRate           = sampler.Rate(0.1)
+		num50                = 50
 	)
 
 	expected := Configuration{
@@ -129,6 +136,11 @@ proto:
 				"ns2":    {SchemaDeployID: "deployID-345", MessageName: "ns2_msg_name"},
 			},
 		},
+		ReadRepair: &ReadRepairConfiguration{
+			Enabled:    true,
+			SampleRate: &sampleRate,
+			RateLimit:  &num50,
+		},
 	}
 
 	assert.Equal(t, expected, cfg)
@@ -148,3 +160,25 @@ func TestValidateConfig(t *testing.T) {
 	require.Equal(t, err, fmt.Errorf("m3db client cannot have both shardsLeavingCountTowardsConsistency and "+
 		"shardsLeavingAndInitializingCountTowardsConsistency as true"))
 }
+
+func TestValidateReadRepairConfig(t *testing.T) {
+	var (
+		rateLimit = -1
+		queueSize = 0
+	)
+
+	config := Configuration{
+		ReadRepair: &ReadRepairConfiguration{Enabled: true, RateLimit: &rateLimit},
+	}
+	require.Error(t, config.Validate())
+
+	config = Configuration{
+		ReadRepair: &ReadRepairConfiguration{Enabled: true, QueueSize: &queueSize},
+	}
+	require.Error(t, config.Validate())
+
+	config = Configuration{
+		ReadRepair: &ReadRepairConfiguration{Enabled: true},
+	}
+	require.NoError(t, config.Validate())
+}
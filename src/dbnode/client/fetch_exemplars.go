@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// SeriesExemplars are the exemplars of a series returned by FetchExemplars.
+type SeriesExemplars struct {
+	ID          ident.ID
+	EncodedTags ts.EncodedTags
+	Exemplars   []ts.Exemplar
+}
+
+type fetchExemplarsOp struct {
+	request      rpc.FetchExemplarsRequest
+	completionFn completionFn
+}
+
+func (f *fetchExemplarsOp) Size() int {
+	// Fetch exemplars is always a single op
+	return 1
+}
+
+func (f *fetchExemplarsOp) CompletionFn() completionFn {
+	return f.completionFn
+}
+
+// exemplarsMerger merges the exemplars returned by each node, replicas of a
+// series return the same exemplars so duplicates are dropped.
+type exemplarsMerger struct {
+	series map[string]*SeriesExemplars
+}
+
+func newExemplarsMerger() *exemplarsMerger {
+	return &exemplarsMerger{series: make(map[string]*SeriesExemplars)}
+}
+
+func (m *exemplarsMerger) add(res *rpc.FetchExemplarsResult_) {
+	for _, elem := range res.Elements {
+		key := string(elem.ID)
+		series, ok := m.series[key]
+		if !ok {
+			series = &SeriesExemplars{
+				ID:          ident.BytesID(elem.ID),
+				EncodedTags: elem.EncodedTags,
+			}
+			m.series[key] = series
+		}
+		for _, exemplar := range elem.Exemplars {
+			series.Exemplars = append(series.Exemplars, ts.Exemplar{
+				TimestampNanos: xtime.UnixNano(exemplar.Timestamp),
+				Value:          exemplar.Value,
+				EncodedLabels:  exemplar.EncodedLabels,
+			})
+		}
+	}
+}
+
+// results returns the merged series ordered by ID, with their exemplars
+// ordered by timestamp, truncated to at most limit series if limit is set.
+func (m *exemplarsMerger) results(limit int) []SeriesExemplars {
+	results := make([]SeriesExemplars, 0, len(m.series))
+	for _, series := range m.series {
+		exemplars := series.Exemplars
+		sort.SliceStable(exemplars, func(i, j int) bool {
+			return exemplars[i].TimestampNanos < exemplars[j].TimestampNanos
+		})
+		deduped := exemplars[:0]
+		for i, exemplar := range exemplars {
+			if i > 0 && exemplar.TimestampNanos == exemplars[i-1].TimestampNanos &&
+				exemplar.Value == exemplars[i-1].Value {
+				continue
+			}
+			deduped = append(deduped, exemplar)
+		}
+		series.Exemplars = deduped
+		results = append(results, *series)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ID.String() < results[j].ID.String()
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
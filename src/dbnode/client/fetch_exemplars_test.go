@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/ts"
+)
+
+func TestExemplarsMergerDedupesReplicas(t *testing.T) {
+	replica := &rpc.FetchExemplarsResult_{
+		Elements: []*rpc.FetchExemplarsIDResult_{
+			{
+				ID:          []byte("foo"),
+				EncodedTags: []byte("tags"),
+				Exemplars: []*rpc.Exemplar{
+					{EncodedLabels: []byte("a"), Value: 1, Timestamp: 2},
+					{EncodedLabels: []byte("b"), Value: 2, Timestamp: 3},
+				},
+			},
+		},
+	}
+
+	merger := newExemplarsMerger()
+	merger.add(replica)
+	merger.add(&rpc.FetchExemplarsResult_{
+		Elements: []*rpc.FetchExemplarsIDResult_{
+			{
+				ID: []byte("bar"),
+				Exemplars: []*rpc.Exemplar{
+					{Value: 3, Timestamp: 1},
+				},
+			},
+			{
+				ID:          []byte("foo"),
+				EncodedTags: []byte("tags"),
+				Exemplars: []*rpc.Exemplar{
+					{EncodedLabels: []byte("c"), Value: 4, Timestamp: 1},
+					{EncodedLabels: []byte("a"), Value: 1, Timestamp: 2},
+				},
+			},
+		},
+	})
+	merger.add(replica)
+
+	results := merger.results(0)
+	require.Len(t, results, 2)
+	require.Equal(t, "bar", results[0].ID.String())
+	require.Equal(t, "foo", results[1].ID.String())
+	require.Equal(t, ts.EncodedTags("tags"), results[1].EncodedTags)
+	require.Equal(t, []ts.Exemplar{
+		{TimestampNanos: 1, Value: 4, EncodedLabels: ts.EncodedTags("c")},
+		{TimestampNanos: 2, Value: 1, EncodedLabels: ts.EncodedTags("a")},
+		{TimestampNanos: 3, Value: 2, EncodedLabels: ts.EncodedTags("b")},
+	}, results[1].Exemplars)
+
+	limited := newExemplarsMerger()
+	limited.add(replica)
+	limited.add(&rpc.FetchExemplarsResult_{
+		Elements: []*rpc.FetchExemplarsIDResult_{{ID: []byte("bar")}},
+	})
+	results = limited.results(1)
+	require.Len(t, results, 1)
+	require.Equal(t, "bar", results[0].ID.String())
+}
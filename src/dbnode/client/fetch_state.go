@@ -134,6 +134,7 @@ func (f *fetchState) ResetAggregate(
 	f.stateType = aggregateFetchState
 	f.lastResetTime = time.Now()
 	f.tagResultAccumulator.Reset(startTime, endTime, topoMap, majority, consistencyLevel)
+	f.tagResultAccumulator.includeDocsCount = op.request.GetIncludeDocsCount()
 }
 
 func (f *fetchState) completionFn(
@@ -250,6 +251,16 @@ func (f *fetchState) asEncodingSeriesIterators(
 	return f.tagResultAccumulator.AsEncodingSeriesIterators(limit, pools, descr, opts)
 }
 
+func (f *fetchState) readRepairSeries() []readRepairSeries {
+	f.Lock()
+	defer f.Unlock()
+
+	if !f.done || f.err != nil {
+		return nil
+	}
+	return f.tagResultAccumulator.ReadRepairSeries()
+}
+
 func (f *fetchState) asAggregatedTagsIterator(pools fetchTaggedPools, limit int) (
 	AggregatedTagsIterator, FetchResponseMetadata, error,
 ) {
@@ -23,6 +23,7 @@ package client
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/m3db/m3/src/cluster/shard"
@@ -68,6 +69,13 @@ type fetchTaggedResultAccumulator struct {
 	exhaustive       bool
 	waitedIndex      int
 	waitedSeriesRead int
+	includeDocsCount bool
+
+	// NB: per replica responses are only tracked for fetches that have
+	// been sampled for read repair.
+	trackReplicas    bool
+	replicaResponses fetchTaggedReplicaResults
+	respondedHosts   []topology.Host
 
 	startTime        xtime.UnixNano
 	endTime          xtime.UnixNano
@@ -104,6 +112,13 @@ func (accum *fetchTaggedResultAccumulator) AddFetchTaggedResponse(
 		for _, elem := range opts.response.Elements {
 			accum.fetchResponses = append(accum.fetchResponses, elem)
 		}
+		if accum.trackReplicas && opts.host != nil {
+			accum.respondedHosts = append(accum.respondedHosts, opts.host)
+			for _, elem := range opts.response.Elements {
+				accum.replicaResponses = append(accum.replicaResponses,
+					fetchTaggedReplicaResult{host: opts.host, result: elem})
+			}
+		}
 	}
 
 	// NB(r): Write the response to calculate transport to work out length.
@@ -252,6 +267,16 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 	accum.exhaustive = true
 	accum.waitedIndex = 0
 	accum.waitedSeriesRead = 0
+	accum.includeDocsCount = false
+	for i := range accum.replicaResponses {
+		accum.replicaResponses[i] = fetchTaggedReplicaResult{}
+	}
+	accum.replicaResponses = accum.replicaResponses[:0]
+	for i := range accum.respondedHosts {
+		accum.respondedHosts[i] = nil
+	}
+	accum.respondedHosts = accum.respondedHosts[:0]
+	accum.trackReplicas = false
 	accum.calcTransport.Reset()
 }
 
@@ -406,7 +431,11 @@ func (accum *fetchTaggedResultAccumulator) AsAggregatedTagsIterator(
 	results := aggregateResultsSortedByTag(accum.aggResponses)
 	sort.Sort(results)
 
-	var tempValues []ident.ID
+	var (
+		tempValues     []ident.ID
+		tempDocsCounts []int64
+		replicas       = accum.respondedReplicas()
+	)
 
 	accum.aggResponses = aggregateResults(results)
 	accum.aggResponses.forEachTag(func(elems aggregateResults, hasMore bool) bool {
@@ -422,38 +451,61 @@ func (accum *fetchTaggedResultAccumulator) AsAggregatedTagsIterator(
 				tempValues = make([]ident.ID, 0, len(tagResult.tagValues))
 			}
 			tempValues = tempValues[:0]
+			tempDocsCounts = tempDocsCounts[:0]
 
 			// perform a merge sort with the final results.
 			i, j := 0, 0
 			for i < len(tagResult.tagValues) || j < len(values) {
-				var nextValue ident.ID
+				var (
+					nextValue     ident.ID
+					nextDocsCount int64
+				)
 
 				switch {
 				case i == len(tagResult.tagValues):
 					nextValue = ident.BytesID(values[j].TagValue)
+					nextDocsCount = values[j].GetDocsCount()
 					j++
 				case j == len(values):
 					nextValue = tagResult.tagValues[i]
+					nextDocsCount = tagResult.docsCount(i)
 					i++
 				default:
 					switch bytes.Compare(tagResult.tagValues[i].Bytes(), values[j].TagValue) {
 					case -1:
 						nextValue = tagResult.tagValues[i]
+						nextDocsCount = tagResult.docsCount(i)
 						i++
 					case 0:
 						nextValue = tagResult.tagValues[i]
+						nextDocsCount = tagResult.docsCount(i) + values[j].GetDocsCount()
 						i++
 						j++
 					case 1:
 						nextValue = ident.BytesID(values[j].TagValue)
+						nextDocsCount = values[j].GetDocsCount()
 						j++
 					}
 				}
 				tempValues = append(tempValues, nextValue)
+				if accum.includeDocsCount {
+					tempDocsCounts = append(tempDocsCounts, nextDocsCount)
+				}
 			}
 
 			// Copy out of temp values back to final result
 			tagResult.tagValues = append(tagResult.tagValues[:0], tempValues...)
+			if accum.includeDocsCount {
+				tagResult.docsCounts = append(tagResult.docsCounts[:0], tempDocsCounts...)
+			}
+		}
+
+		// NB: every replica of a shard that responded reports the documents
+		// it holds, normalize the summed counts back to a per series count.
+		if accum.includeDocsCount && replicas > 1 {
+			for i := range tagResult.docsCounts {
+				tagResult.docsCounts[i] = int64(math.Round(float64(tagResult.docsCounts[i]) / replicas))
+			}
 		}
 
 		count += len(tagResult.tagValues)
@@ -472,6 +524,82 @@ func (accum *fetchTaggedResultAccumulator) AsAggregatedTagsIterator(
 	}, nil
 }
 
+// ReadRepairSeries returns the series that the replicas of a shard returned
+// divergent data for, along with the response of each replica that owns the
+// series' shard. Only valid when replica responses were tracked for the fetch.
+func (accum *fetchTaggedResultAccumulator) ReadRepairSeries() []readRepairSeries {
+	if !accum.trackReplicas || len(accum.respondedHosts) < 2 {
+		return nil
+	}
+
+	sort.Sort(accum.replicaResponses)
+
+	var (
+		shardSet = accum.topoMap.ShardSet()
+		results  []readRepairSeries
+	)
+	accum.replicaResponses.forEachID(func(group fetchTaggedReplicaResults) {
+		elem := group[0].result
+		shardID := shardSet.Lookup(ident.BytesID(elem.ID))
+		replicas := make([]readRepairReplica, 0, len(accum.respondedHosts))
+		for _, host := range accum.respondedHosts {
+			if !accum.hostOwnsAvailableShard(host, shardID) {
+				continue
+			}
+			replica := readRepairReplica{host: host}
+			for _, r := range group {
+				if r.host.ID() == host.ID() {
+					replica.segments = r.result.Segments
+					break
+				}
+			}
+			replicas = append(replicas, replica)
+		}
+		if len(replicas) < 2 || !readRepairReplicasDiverge(replicas) {
+			return
+		}
+		results = append(results, readRepairSeries{
+			namespace:   elem.NameSpace,
+			id:          elem.ID,
+			encodedTags: elem.EncodedTags,
+			replicas:    replicas,
+		})
+	})
+	return results
+}
+
+func (accum *fetchTaggedResultAccumulator) hostOwnsAvailableShard(
+	host topology.Host,
+	shardID uint32,
+) bool {
+	hostShardSet, ok := accum.topoMap.LookupHostShardSet(host.ID())
+	if !ok {
+		return false
+	}
+	state, err := hostShardSet.ShardSet().LookupStateByID(shardID)
+	return err == nil && state == shard.Available
+}
+
+// respondedReplicas returns the average number of replicas that successfully
+// responded for each shard in the topology.
+func (accum *fetchTaggedResultAccumulator) respondedReplicas() float64 {
+	if accum.topoMap == nil {
+		return 1
+	}
+	var shards, success int
+	for _, id := range accum.topoMap.ShardSet().AllIDs() {
+		if int(id) >= len(accum.shardConsistencyResults) {
+			continue
+		}
+		shards++
+		success += int(accum.shardConsistencyResults[id].success)
+	}
+	if shards == 0 || success == 0 {
+		return 1
+	}
+	return float64(success) / float64(shards)
+}
+
 type fetchTaggedShardConsistencyResults []fetchTaggedShardConsistencyResult
 
 func (res fetchTaggedShardConsistencyResults) initialize(length int) fetchTaggedShardConsistencyResults {
@@ -536,6 +664,34 @@ func (a fetchTaggedIDResultsSortedByID) Less(i, j int) bool {
 	return bytes.Compare(a[i].ID, a[j].ID) < 0
 }
 
+type fetchTaggedReplicaResult struct {
+	host   topology.Host
+	result *rpc.FetchTaggedIDResult_
+}
+
+// fetchTaggedReplicaResults implements sort.Interface based on the ID field
+// of each result.
+type fetchTaggedReplicaResults []fetchTaggedReplicaResult
+
+func (a fetchTaggedReplicaResults) Len() int      { return len(a) }
+func (a fetchTaggedReplicaResults) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a fetchTaggedReplicaResults) Less(i, j int) bool {
+	return bytes.Compare(a[i].result.ID, a[j].result.ID) < 0
+}
+
+// forEachID calls `fn` on each group of results with the same ID.
+// NB: assumes the results being operated upon have been sorted.
+func (a fetchTaggedReplicaResults) forEachID(fn func(group fetchTaggedReplicaResults)) {
+	for start := 0; start < len(a); {
+		end := start + 1
+		for end < len(a) && bytes.Equal(a[end].result.ID, a[start].result.ID) {
+			end++
+		}
+		fn(a[start:end])
+		start = end
+	}
+}
+
 type aggregateResults []*rpc.AggregateQueryRawResultTagNameElement
 
 type aggregateValueResults []*rpc.AggregateQueryRawResultTagValueElement
@@ -179,6 +179,15 @@ const (
 	// defaultHostQueueWorkerPoolKillProbability is the default host queue worker pool
 	// kill probability.
 	defaultHostQueueWorkerPoolKillProbability = 0.01
+
+	// defaultReadRepairSampleRate is the default rate of fetches checked for read repair.
+	defaultReadRepairSampleRate = 0.01
+
+	// defaultReadRepairRateLimit is the default maximum number of series repaired per second.
+	defaultReadRepairRateLimit = 100
+
+	// defaultReadRepairQueueSize is the default number of fetches that can be queued for repair.
+	defaultReadRepairQueueSize = 128
 )
 
 var (
@@ -230,6 +239,8 @@ var (
 
 	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
 	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errNegativeReadRepairRateLimit = errors.New("read repair rate limit must be non-negative")
+	errInvalidReadRepairQueueSize  = errors.New("read repair queue size must be positive")
 )
 
 type options struct {
@@ -301,6 +312,10 @@ type options struct {
 	writeTimestampOffset                                time.Duration
 	namespaceInitializer                                namespace.Initializer
 	thriftContextFn                                     ThriftContextFn
+	readRepairEnabled                                   bool
+	readRepairSampleRate                                sampler.Rate
+	readRepairRateLimit                                 int
+	readRepairQueueSize                                 int
 }
 
 // NewOptions creates a new set of client options with defaults
@@ -452,6 +467,9 @@ func newOptions() *options {
 		asyncWriteMaxConcurrency:              defaultAsyncWriteMaxConcurrency,
 		useV2BatchAPIs:                        defaultUseV2BatchAPIs,
 		thriftContextFn:                       defaultThriftContextFn,
+		readRepairSampleRate:                  defaultReadRepairSampleRate,
+		readRepairRateLimit:                   defaultReadRepairRateLimit,
+		readRepairQueueSize:                   defaultReadRepairQueueSize,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -489,6 +507,15 @@ func validate(opts *options) error {
 	if err := opts.logHostFetchErrorSampleRate.Validate(); err != nil {
 		return err
 	}
+	if err := opts.readRepairSampleRate.Validate(); err != nil {
+		return err
+	}
+	if opts.readRepairRateLimit < 0 {
+		return errNegativeReadRepairRateLimit
+	}
+	if opts.readRepairQueueSize <= 0 {
+		return errInvalidReadRepairQueueSize
+	}
 	return opts.logErrorSampleRate.Validate()
 }
 
@@ -1188,3 +1215,43 @@ func (o *options) SetThriftContextFn(value ThriftContextFn) Options {
 func (o *options) ThriftContextFn() ThriftContextFn {
 	return o.thriftContextFn
 }
+
+func (o *options) SetReadRepairEnabled(value bool) Options {
+	opts := *o
+	opts.readRepairEnabled = value
+	return &opts
+}
+
+func (o *options) ReadRepairEnabled() bool {
+	return o.readRepairEnabled
+}
+
+func (o *options) SetReadRepairSampleRate(value sampler.Rate) Options {
+	opts := *o
+	opts.readRepairSampleRate = value
+	return &opts
+}
+
+func (o *options) ReadRepairSampleRate() sampler.Rate {
+	return o.readRepairSampleRate
+}
+
+func (o *options) SetReadRepairRateLimit(value int) Options {
+	opts := *o
+	opts.readRepairRateLimit = value
+	return &opts
+}
+
+func (o *options) ReadRepairRateLimit() int {
+	return o.readRepairRateLimit
+}
+
+func (o *options) SetReadRepairQueueSize(value int) Options {
+	opts := *o
+	opts.readRepairQueueSize = value
+	return &opts
+}
+
+func (o *options) ReadRepairQueueSize() int {
+	return o.readRepairQueueSize
+}
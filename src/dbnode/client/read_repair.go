@@ -0,0 +1,375 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go/thrift"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/sampler"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// readRepairSeries is a series that the replicas of its shard returned
+// divergent data for during a fetch.
+type readRepairSeries struct {
+	namespace   []byte
+	id          []byte
+	encodedTags []byte
+	replicas    []readRepairReplica
+}
+
+// readRepairReplica is the response of a single replica for a series, the
+// segments are nil if the replica did not return the series at all.
+type readRepairReplica struct {
+	host     topology.Host
+	segments []*rpc.Segments
+}
+
+type readRepairRequest struct {
+	series []readRepairSeries
+	descr  namespace.SchemaDescr
+}
+
+type readRepairDatapoint struct {
+	timestamp  xtime.UnixNano
+	value      float64
+	annotation []byte
+}
+
+type readRepairMetrics struct {
+	sampled            tally.Counter
+	divergentSeries    tally.Counter
+	dropped            tally.Counter
+	rateLimited        tally.Counter
+	decodeErrors       tally.Counter
+	writeErrors        tally.Counter
+	repairedSeries     tally.Counter
+	repairedDatapoints tally.Counter
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		sampled:            scope.Counter("sampled"),
+		divergentSeries:    scope.Counter("divergent-series"),
+		dropped:            scope.Counter("dropped"),
+		rateLimited:        scope.Counter("rate-limited"),
+		decodeErrors:       scope.Counter("decode-errors"),
+		writeErrors:        scope.Counter("write-errors"),
+		repairedSeries:     scope.Counter("repaired-series"),
+		repairedDatapoints: scope.Counter("repaired-datapoints"),
+	}
+}
+
+// readRepairer asynchronously writes back the datapoints that lagging
+// replicas are missing when replicas disagree on a fetched series.
+// NB: datapoints that replicas hold different values for are left as is,
+// only datapoints missing from a replica are repaired. Repairing datapoints
+// outside of the buffer past of a namespace requires cold writes to be
+// enabled on the namespace.
+type readRepairer struct {
+	borrowConnectionFn     func(hostID string, fn WithConnectionFn) error
+	readerIteratorAllocate encoding.ReaderIteratorAllocate
+	writeTimeout           time.Duration
+	writeBatchSize         int
+	sampler                *sampler.Sampler
+	limiter                *rate.Limiter
+	nowFn                  clock.NowFn
+	logger                 *zap.Logger
+	metrics                readRepairMetrics
+
+	requests  chan readRepairRequest
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+func newReadRepairer(
+	opts Options,
+	borrowConnectionFn func(hostID string, fn WithConnectionFn) error,
+) (*readRepairer, error) {
+	repairSampler, err := sampler.NewSampler(opts.ReadRepairSampleRate())
+	if err != nil {
+		return nil, err
+	}
+	iOpts := opts.InstrumentOptions()
+	return &readRepairer{
+		borrowConnectionFn:     borrowConnectionFn,
+		readerIteratorAllocate: opts.ReaderIteratorAllocate(),
+		writeTimeout:           opts.WriteRequestTimeout(),
+		writeBatchSize:         opts.WriteBatchSize(),
+		sampler:                repairSampler,
+		limiter:                newReadRepairLimiter(opts.ReadRepairRateLimit()),
+		nowFn:                  opts.ClockOptions().NowFn(),
+		logger:                 iOpts.Logger(),
+		metrics:                newReadRepairMetrics(iOpts.MetricsScope().SubScope("read-repair")),
+		requests:               make(chan readRepairRequest, opts.ReadRepairQueueSize()),
+		closeCh:                make(chan struct{}),
+	}, nil
+}
+
+// Open starts the background repair loop.
+// newReadRepairLimiter returns a limiter allowing up to limit repairs per
+// second, zero means no limit.
+func newReadRepairLimiter(limit int) *rate.Limiter {
+	if limit == 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(limit), limit)
+}
+
+func (r *readRepairer) Open() {
+	r.wg.Add(1)
+	go r.repairLoop()
+}
+
+// Close stops the background repair loop, any pending repairs are dropped.
+func (r *readRepairer) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closeCh)
+	})
+	r.wg.Wait()
+}
+
+// Sample returns whether a fetch should track replica responses for repair.
+func (r *readRepairer) Sample() bool {
+	if !r.sampler.Sample() {
+		return false
+	}
+	r.metrics.sampled.Inc(1)
+	return true
+}
+
+// Repair enqueues the divergent series to be repaired, the request is
+// dropped rather than blocking the fetch if the repair queue is full.
+func (r *readRepairer) Repair(series []readRepairSeries, descr namespace.SchemaDescr) {
+	if len(series) == 0 {
+		return
+	}
+	r.metrics.divergentSeries.Inc(int64(len(series)))
+	select {
+	case r.requests <- readRepairRequest{series: series, descr: descr}:
+	default:
+		r.metrics.dropped.Inc(int64(len(series)))
+	}
+}
+
+func (r *readRepairer) repairLoop() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case req := <-r.requests:
+			for _, series := range req.series {
+				r.repairSeries(series, req.descr)
+			}
+		}
+	}
+}
+
+func (r *readRepairer) repairSeries(series readRepairSeries, descr namespace.SchemaDescr) {
+	if !r.limiter.AllowN(r.nowFn(), 1) {
+		r.metrics.rateLimited.Inc(1)
+		return
+	}
+
+	var (
+		merged   = make(map[xtime.UnixNano]readRepairDatapoint)
+		existing = make([]map[xtime.UnixNano]struct{}, 0, len(series.replicas))
+	)
+	for _, replica := range series.replicas {
+		datapoints, err := r.decode(replica.segments, descr)
+		if err != nil {
+			r.metrics.decodeErrors.Inc(1)
+			r.logger.Warn("read repair could not decode replica response",
+				zap.String("host", replica.host.ID()),
+				zap.ByteString("id", series.id),
+				zap.Error(err))
+			return
+		}
+		seen := make(map[xtime.UnixNano]struct{}, len(datapoints))
+		for _, dp := range datapoints {
+			seen[dp.timestamp] = struct{}{}
+			if _, ok := merged[dp.timestamp]; !ok {
+				merged[dp.timestamp] = dp
+			}
+		}
+		existing = append(existing, seen)
+	}
+
+	timestamps := make([]xtime.UnixNano, 0, len(merged))
+	for t := range merged {
+		timestamps = append(timestamps, t)
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+
+	repaired := false
+	for i, replica := range series.replicas {
+		var elems []*rpc.WriteTaggedBatchRawRequestElement
+		for _, t := range timestamps {
+			if _, ok := existing[i][t]; ok {
+				continue
+			}
+			dp := merged[t]
+			elems = append(elems, &rpc.WriteTaggedBatchRawRequestElement{
+				ID:          series.id,
+				EncodedTags: series.encodedTags,
+				Datapoint: &rpc.Datapoint{
+					Timestamp:         int64(dp.timestamp),
+					TimestampTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+					Value:             dp.value,
+					Annotation:        dp.annotation,
+				},
+			})
+		}
+		if len(elems) == 0 {
+			continue
+		}
+		if err := r.write(replica.host, series.namespace, elems); err != nil {
+			r.metrics.writeErrors.Inc(1)
+			r.logger.Warn("read repair could not write to replica",
+				zap.String("host", replica.host.ID()),
+				zap.ByteString("id", series.id),
+				zap.Error(err))
+			continue
+		}
+		r.metrics.repairedDatapoints.Inc(int64(len(elems)))
+		repaired = true
+	}
+	if repaired {
+		r.metrics.repairedSeries.Inc(1)
+	}
+}
+
+func (r *readRepairer) decode(
+	segments []*rpc.Segments,
+	descr namespace.SchemaDescr,
+) ([]readRepairDatapoint, error) {
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	iter := encoding.NewMultiReaderIterator(r.readerIteratorAllocate, nil)
+	iter.ResetSliceOfSlices(NewReaderSliceOfSlicesIterator(segments, nil), descr)
+	defer iter.Close()
+
+	var datapoints []readRepairDatapoint
+	for iter.Next() {
+		dp, _, annotation := iter.Current()
+		var annotationCopy []byte
+		if len(annotation) > 0 {
+			annotationCopy = append([]byte(nil), annotation...)
+		}
+		datapoints = append(datapoints, readRepairDatapoint{
+			timestamp:  dp.TimestampNanos,
+			value:      dp.Value,
+			annotation: annotationCopy,
+		})
+	}
+	return datapoints, iter.Err()
+}
+
+func (r *readRepairer) write(
+	host topology.Host,
+	nsID []byte,
+	elems []*rpc.WriteTaggedBatchRawRequestElement,
+) error {
+	for len(elems) > 0 {
+		n := len(elems)
+		if r.writeBatchSize > 0 && n > r.writeBatchSize {
+			n = r.writeBatchSize
+		}
+		req := &rpc.WriteTaggedBatchRawRequest{
+			NameSpace: nsID,
+			Elements:  elems[:n],
+		}
+		var writeErr error
+		if err := r.borrowConnectionFn(host.ID(), func(client rpc.TChanNode, _ Channel) {
+			ctx, _ := thrift.NewContext(r.writeTimeout)
+			writeErr = client.WriteTaggedBatchRaw(ctx, req)
+		}); err != nil {
+			return err
+		}
+		if writeErr != nil {
+			return writeErr
+		}
+		elems = elems[n:]
+	}
+	return nil
+}
+
+// readRepairReplicasDiverge returns whether the replicas returned different
+// data for any block of the series.
+func readRepairReplicasDiverge(replicas []readRepairReplica) bool {
+	var first map[xtime.UnixNano]uint32
+	for i, replica := range replicas {
+		checksums := readRepairBlockChecksums(replica.segments)
+		if i == 0 {
+			first = checksums
+			continue
+		}
+		if len(checksums) != len(first) {
+			return true
+		}
+		for blockStart, checksum := range checksums {
+			if v, ok := first[blockStart]; !ok || v != checksum {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func readRepairBlockChecksums(segments []*rpc.Segments) map[xtime.UnixNano]uint32 {
+	checksums := make(map[xtime.UnixNano]uint32, len(segments))
+	for _, s := range segments {
+		var (
+			d          = digest.NewDigest()
+			blockStart xtime.UnixNano
+		)
+		if s.Merged != nil {
+			blockStart = timeConvert(s.Merged.StartTime)
+			d = d.Update(s.Merged.Head).Update(s.Merged.Tail)
+		} else if len(s.Unmerged) > 0 {
+			blockStart = timeConvert(s.Unmerged[0].StartTime)
+			for _, seg := range s.Unmerged {
+				d = d.Update(seg.Head).Update(seg.Tail)
+			}
+		}
+		checksums[blockStart] = d.Sum32()
+	}
+	return checksums
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go/thrift"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/topology/testutil"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestFetchTaggedResultsAccumulatorReadRepairSeries(t *testing.T) {
+	topoMap := testutil.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": testutil.ShardsRange(0, 29, shard.Available),
+		"testhost1": testutil.ShardsRange(0, 29, shard.Available),
+		"testhost2": testutil.ShardsRange(0, 29, shard.Available),
+	})
+
+	var (
+		now       = xtime.Now()
+		startTime = now.Add(-time.Hour).Truncate(time.Hour)
+		endTime   = now.Truncate(time.Hour)
+		th        = newTestFetchTaggedHelper(t)
+		complete  = newTestSeries(1)
+		lagging   = newTestSeries(1)
+		missing   = newTestSeries(2)
+		same      = newTestSeries(3)
+	)
+	complete.datapoints = newTestDatapoints(4, startTime, endTime)
+	lagging.datapoints = complete.datapoints[:2]
+	missing.datapoints = complete.datapoints
+	same.datapoints = complete.datapoints
+
+	accum := newFetchTaggedResultAccumulator()
+	accum.Reset(startTime, endTime, topoMap,
+		topoMap.MajorityReplicas(), topology.ReadConsistencyLevelAll)
+	accum.trackReplicas = true
+
+	responses := map[string]testSerieses{
+		"testhost0": {complete, missing, same},
+		"testhost1": {lagging, missing, same},
+		"testhost2": {complete, same},
+	}
+	for _, hostname := range []string{"testhost0", "testhost1", "testhost2"} {
+		_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+			host:     host(t, topoMap, hostname),
+			response: responses[hostname].toRPCResult(th, startTime, true),
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	series := accum.ReadRepairSeries()
+	require.Len(t, series, 2)
+
+	require.Equal(t, complete.id.Bytes(), series[0].id)
+	require.Len(t, series[0].replicas, 3)
+	for _, replica := range series[0].replicas {
+		require.NotNil(t, replica.segments)
+	}
+
+	require.Equal(t, missing.id.Bytes(), series[1].id)
+	require.Len(t, series[1].replicas, 3)
+	for _, replica := range series[1].replicas {
+		require.Equal(t, replica.host.ID() == "testhost2", replica.segments == nil)
+	}
+
+	accum.Clear()
+	require.Nil(t, accum.ReadRepairSeries())
+}
+
+func TestReadRepairerRepairSeriesWritesMissingDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topoMap := testutil.MustNewTopologyMap(2, map[string][]shard.Shard{
+		"testhost0": testutil.ShardsRange(0, 29, shard.Available),
+		"testhost1": testutil.ShardsRange(0, 29, shard.Available),
+	})
+
+	var (
+		now       = xtime.Now()
+		startTime = now.Add(-time.Hour).Truncate(time.Hour)
+		endTime   = now.Truncate(time.Hour)
+		th        = newTestFetchTaggedHelper(t)
+		complete  = newTestSeries(1)
+		lagging   = newTestSeries(1)
+	)
+	complete.datapoints = newTestDatapoints(4, startTime, endTime)
+	lagging.datapoints = complete.datapoints[:2]
+
+	client := rpc.NewMockTChanNode(ctrl)
+	client.EXPECT().
+		WriteTaggedBatchRaw(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ thrift.Context, req *rpc.WriteTaggedBatchRawRequest) error {
+			require.Equal(t, complete.ns.Bytes(), req.NameSpace)
+			require.Len(t, req.Elements, 2)
+			for i, elem := range req.Elements {
+				dp := complete.datapoints[2+i]
+				require.Equal(t, complete.id.Bytes(), elem.ID)
+				require.Equal(t, int64(dp.TimestampNanos), elem.Datapoint.Timestamp)
+				require.Equal(t, rpc.TimeType_UNIX_NANOSECONDS, elem.Datapoint.TimestampTimeType)
+				require.Equal(t, dp.Value, elem.Datapoint.Value)
+			}
+			return nil
+		})
+
+	var borrowed []string
+	borrowFn := func(hostID string, fn WithConnectionFn) error {
+		borrowed = append(borrowed, hostID)
+		fn(client, nil)
+		return nil
+	}
+
+	scope := tally.NewTestScope("", nil)
+	opts := NewOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope))
+	r, err := newReadRepairer(opts, borrowFn)
+	require.NoError(t, err)
+
+	r.repairSeries(readRepairSeries{
+		namespace:   complete.ns.Bytes(),
+		id:          complete.id.Bytes(),
+		encodedTags: th.encodeTags(complete.tags),
+		replicas: []readRepairReplica{
+			{
+				host:     host(t, topoMap, "testhost0"),
+				segments: complete.datapoints.toRPCSegments(th, startTime),
+			},
+			{
+				host:     host(t, topoMap, "testhost1"),
+				segments: lagging.datapoints.toRPCSegments(th, startTime),
+			},
+		},
+	}, nil)
+
+	require.Equal(t, []string{"testhost1"}, borrowed)
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(1), counters["read-repair.repaired-series+"].Value())
+	require.Equal(t, int64(2), counters["read-repair.repaired-datapoints+"].Value())
+}
+
+func TestReadRepairerRateLimitAndQueueFull(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	opts := NewOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetReadRepairRateLimit(1).
+		SetReadRepairQueueSize(1)
+	r, err := newReadRepairer(opts, func(string, WithConnectionFn) error {
+		require.FailNow(t, "unexpected borrow")
+		return nil
+	})
+	require.NoError(t, err)
+	r.nowFn = func() time.Time { return time.Unix(100, 0) }
+
+	series := []readRepairSeries{{id: []byte("foo")}}
+	r.Repair(series, nil)
+	r.Repair(series, nil)
+
+	// Repairing the queued series consumes the single allowed repair for the
+	// current second, so the next one is rate limited.
+	r.repairSeries(readRepairSeries{id: []byte("bar")}, nil)
+	r.repairSeries(readRepairSeries{id: []byte("baz")}, nil)
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(2), counters["read-repair.divergent-series+"].Value())
+	require.Equal(t, int64(1), counters["read-repair.dropped+"].Value())
+	require.Equal(t, int64(1), counters["read-repair.rate-limited+"].Value())
+
+	r.Open()
+	r.Close()
+}
@@ -348,6 +348,25 @@ func (s replicatedSession) Truncate(namespace ident.ID) (int64, error) {
 	return s.session.Truncate(namespace)
 }
 
+// DeleteSeries deletes the data of all series matching the query in the given time range.
+func (s replicatedSession) DeleteSeries(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	return s.session.DeleteSeries(namespace, q, start, end)
+}
+
+// FetchExemplars fetches the exemplars of all series matching the query in the given time range.
+func (s replicatedSession) FetchExemplars(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+	limit int,
+) ([]SeriesExemplars, error) {
+	return s.session.FetchExemplars(namespace, q, start, end, limit)
+}
+
 // FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 // for each series using the runtime configurable bootstrap level consistency.
 func (s replicatedSession) FetchBootstrapBlocksFromPeers(
@@ -157,6 +157,7 @@ type session struct {
 	newHostQueueFn                                      newHostQueueFn
 	writeRetrier                                        xretry.Retrier
 	fetchRetrier                                        xretry.Retrier
+	readRepairer                                        *readRepairer
 	streamBlocksRetrier                                 xretry.Retrier
 	pools                                               sessionPools
 	fetchBatchSize                                      int
@@ -327,6 +328,12 @@ func newSession(opts Options) (clientSession, error) {
 		shardsLeavingAndInitializingCountTowardsConsistency: opts.ShardsLeavingAndInitializingCountTowardsConsistency(),
 		metrics: newSessionMetrics(scope),
 	}
+	if opts.ReadRepairEnabled() {
+		s.readRepairer, err = newReadRepairer(opts, s.BorrowConnection)
+		if err != nil {
+			return nil, err
+		}
+	}
 	s.reattemptStreamBlocksFromPeersFn = s.streamBlocksReattemptFromPeers
 	s.pickBestPeerFn = s.streamBlocksPickBestPeer
 	writeAttemptPoolOpts := pool.NewObjectPoolOptions().
@@ -663,6 +670,10 @@ func (s *session) Open() error {
 	s.state.status = statusOpen
 	s.state.Unlock()
 
+	if s.readRepairer != nil {
+		s.readRepairer.Open()
+	}
+
 	go func() {
 		for range watch.C() {
 			s.log.Info("received update for topology")
@@ -1656,12 +1667,14 @@ func (s *session) fetchTaggedAttempt(
 		}
 	}
 
+	readRepair := s.readRepairer != nil && s.readRepairer.Sample()
 	fetchState, err := s.newFetchStateWithRLock(ctx, nsClone, newFetchStateOpts{
 		stateType:            fetchTaggedFetchState,
 		fetchTaggedRequest:   req,
 		startInclusive:       opts.StartInclusive,
 		endExclusive:         opts.EndExclusive,
 		readConsistencyLevel: opts.ReadConsistencyLevel,
+		readRepair:           readRepair,
 	})
 	s.state.RUnlock()
 
@@ -1684,6 +1697,9 @@ func (s *session) fetchTaggedAttempt(
 
 	iters, metadata, err := fetchState.asEncodingSeriesIterators(
 		s.pools, nsCtx.Schema, iterOpts, opts.SeriesLimit)
+	if err == nil && readRepair {
+		s.readRepairer.Repair(fetchState.readRepairSeries(), nsCtx.Schema)
+	}
 
 	// must Unlock() before decRef'ing, as the latter releases the fetchState back into a
 	// pool if ref count == 0.
@@ -1765,6 +1781,7 @@ type newFetchStateOpts struct {
 
 	// only valid if stateType == fetchTaggedFetchState
 	fetchTaggedRequest rpc.FetchTaggedRequest
+	readRepair         bool
 
 	// only valid if stateType == aggregateFetchState
 	aggregateRequest rpc.AggregateQueryRawRequest
@@ -1801,6 +1818,7 @@ func (s *session) newFetchStateWithRLock(
 		fetchOp.update(ctx, opts.fetchTaggedRequest, fetchState.completionFn)
 		fetchState.ResetFetchTagged(opts.startInclusive, opts.endExclusive,
 			fetchOp, topoMap, s.state.majority, readLevel)
+		fetchState.tagResultAccumulator.trackReplicas = opts.readRepair
 		op = fetchOp
 
 	case aggregateFetchState:
@@ -2196,6 +2214,10 @@ func (s *session) Close() error {
 	topo := s.state.topo
 	s.state.Unlock()
 
+	if s.readRepairer != nil {
+		s.readRepairer.Close()
+	}
+
 	for _, q := range queues {
 		q.Close()
 	}
@@ -2288,6 +2310,114 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) DeleteSeries(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	request, err := convert.ToRPCDeleteSeriesRequest(namespace, q, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg            sync.WaitGroup
+		enqueueErr    xerrors.MultiError
+		resultErrLock sync.Mutex
+		resultErr     xerrors.MultiError
+		deleted       int64
+	)
+
+	d := &deleteSeriesOp{request: request}
+	d.completionFn = func(result interface{}, err error) {
+		if err != nil {
+			resultErrLock.Lock()
+			resultErr = resultErr.Add(err)
+			resultErrLock.Unlock()
+		} else {
+			res := result.(*rpc.DeleteSeriesResult_)
+			atomic.AddInt64(&deleted, res.NumSeries)
+		}
+		wg.Done()
+	}
+
+	s.state.RLock()
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(d); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return 0, err
+	}
+
+	// Wait for series to be deleted on all replicas
+	wg.Wait()
+
+	return deleted, resultErr.FinalError()
+}
+
+func (s *session) FetchExemplars(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+	limit int,
+) ([]SeriesExemplars, error) {
+	request, err := convert.ToRPCFetchExemplarsRequest(namespace, q, start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		enqueueErr xerrors.MultiError
+		resultLock sync.Mutex
+		resultErr  xerrors.MultiError
+		merger     = newExemplarsMerger()
+	)
+
+	f := &fetchExemplarsOp{request: request}
+	f.completionFn = func(result interface{}, err error) {
+		resultLock.Lock()
+		if err != nil {
+			resultErr = resultErr.Add(err)
+		} else {
+			merger.add(result.(*rpc.FetchExemplarsResult_))
+		}
+		resultLock.Unlock()
+		wg.Done()
+	}
+
+	s.state.RLock()
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(f); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return nil, err
+	}
+
+	// Exemplars are only held by the nodes that received the writes so
+	// every node is queried.
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return nil, err
+	}
+	return merger.results(limit), nil
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
@@ -175,6 +175,11 @@ type AggregatedTagsIterator interface {
 	// These remain valid until Next() is called again.
 	Current() (tagName ident.ID, tagValues ident.Iterator)
 
+	// CurrentDocsCounts returns the number of series matching each of the
+	// current tag values, in the same order as the tag values iterator. It is
+	// only populated when documents counts were requested by the query.
+	CurrentDocsCounts() []int64
+
 	// Err returns any error encountered.
 	Err() error
 
@@ -258,6 +263,24 @@ type AdminSession interface {
 	// Truncate will truncate the namespace for a given shard.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteSeries deletes the data of all series matching the query in the
+	// given time range, the range is widened to block boundaries on each node.
+	DeleteSeries(
+		namespace ident.ID,
+		q index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
+	// FetchExemplars fetches the exemplars within [start, end] of up to
+	// limit series matching the query from all nodes, a limit of zero means
+	// no limit.
+	FetchExemplars(
+		namespace ident.ID,
+		q index.Query,
+		start, end xtime.UnixNano,
+		limit int,
+	) ([]SeriesExemplars, error)
+
 	// FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 	// for each series using the runtime configurable bootstrap level consistency.
 	FetchBootstrapBlocksFromPeers(
@@ -739,6 +762,34 @@ type Options interface {
 
 	// ThriftContextFn returns the retrier for streaming blocks.
 	ThriftContextFn() ThriftContextFn
+
+	// SetReadRepairEnabled sets whether divergent replica responses to tagged
+	// fetches are asynchronously repaired.
+	SetReadRepairEnabled(value bool) Options
+
+	// ReadRepairEnabled returns whether divergent replica responses to tagged
+	// fetches are asynchronously repaired.
+	ReadRepairEnabled() bool
+
+	// SetReadRepairSampleRate sets the rate of fetches checked for read repair between [0,1.0].
+	SetReadRepairSampleRate(value sampler.Rate) Options
+
+	// ReadRepairSampleRate returns the rate of fetches checked for read repair between [0,1.0].
+	ReadRepairSampleRate() sampler.Rate
+
+	// SetReadRepairRateLimit sets the maximum number of series repaired per second,
+	// zero means no limit.
+	SetReadRepairRateLimit(value int) Options
+
+	// ReadRepairRateLimit returns the maximum number of series repaired per second,
+	// zero means no limit.
+	ReadRepairRateLimit() int
+
+	// SetReadRepairQueueSize sets the number of fetches that can be queued for repair.
+	SetReadRepairQueueSize(value int) Options
+
+	// ReadRepairQueueSize returns the number of fetches that can be queued for repair.
+	ReadRepairQueueSize() int
 }
 
 // ThriftContextFn turns a context into a thrift context for a thrift call.
@@ -32,11 +32,16 @@ type FdWithDigestWriter interface {
 	io.Writer
 
 	Flush() error
+
+	// ResetWithWriter resets the writer to write to the given writer rather
+	// than directly to the file, the writer is closed before the file.
+	ResetWithWriter(fd *os.File, w io.WriteCloser)
 }
 
 type fdWithDigestWriter struct {
 	FdWithDigest
 	writer *bufio.Writer
+	closer io.Closer
 }
 
 // NewFdWithDigestWriter creates a new FdWithDigestWriter.
@@ -50,6 +55,13 @@ func NewFdWithDigestWriter(bufferSize int) FdWithDigestWriter {
 func (w *fdWithDigestWriter) Reset(fd *os.File) {
 	w.FdWithDigest.Reset(fd)
 	w.writer.Reset(fd)
+	w.closer = nil
+}
+
+func (w *fdWithDigestWriter) ResetWithWriter(fd *os.File, writer io.WriteCloser) {
+	w.FdWithDigest.Reset(fd)
+	w.writer.Reset(writer)
+	w.closer = writer
 }
 
 // Write bytes to the underlying file.
@@ -70,6 +82,13 @@ func (w *fdWithDigestWriter) Close() error {
 	if err := w.writer.Flush(); err != nil {
 		return err
 	}
+	if w.closer != nil {
+		closer := w.closer
+		w.closer = nil
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
 	return w.FdWithDigest.Close()
 }
 
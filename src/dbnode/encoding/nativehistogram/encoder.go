@@ -0,0 +1,410 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nativehistogram
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/histogram"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Make sure encoder implements encoding.Encoder.
+var _ encoding.Encoder = &encoder{}
+
+var (
+	errEncoderClosed       = errors.New("native histogram encoder: encoder is closed")
+	errNoEncodedDatapoints = errors.New("native histogram encoder: encoder has no encoded datapoints")
+)
+
+type encoder struct {
+	opts   encoding.Options
+	stream encoding.OStream
+
+	tsEncoder m3tsz.TimestampEncoder
+	value     m3tsz.FloatEncoderAndIterator
+	histState histogramState
+
+	prevHistogram  histogram.Histogram
+	prevAnnotation []byte
+
+	lastEncoded            ts.Datapoint
+	lastAnnotationChecksum uint64
+	numEncoded             int
+
+	// Fields that are reused between function calls to
+	// avoid allocations.
+	payload    annotation.Payload
+	histogram  histogram.Histogram
+	marshalBuf []byte
+	varIntBuf  [binary.MaxVarintLen64]byte
+
+	closed bool
+}
+
+// NewEncoder creates a new native histogram encoder.
+func NewEncoder(start xtime.UnixNano, opts encoding.Options) encoding.Encoder {
+	if opts == nil {
+		opts = encoding.NewOptions()
+	}
+	initAllocIfEmpty := opts.EncoderPool() == nil
+	return &encoder{
+		opts:      opts,
+		stream:    encoding.NewOStream(nil, initAllocIfEmpty, opts.BytesPool()),
+		tsEncoder: m3tsz.NewTimestampEncoder(start, opts.DefaultTimeUnit(), opts),
+	}
+}
+
+func (enc *encoder) SetSchema(descr namespace.SchemaDescr) {}
+
+// Encode encodes a datapoint, if the annotation is a payload that contains a
+// native histogram the histogram is encoded and the value of the datapoint
+// is ignored in favour of the histogram count.
+func (enc *encoder) Encode(dp ts.Datapoint, timeUnit xtime.Unit, ant ts.Annotation) error {
+	if enc.closed {
+		return errEncoderClosed
+	}
+
+	// Decode the histogram before anything is written so that an invalid
+	// histogram does not leave the stream in a corrupted state.
+	rest, isHistogram, err := enc.splitAnnotation(ant)
+	if err != nil {
+		return err
+	}
+
+	if enc.numEncoded == 0 {
+		enc.stream.WriteBits(streamMagic, 64)
+		enc.stream.WriteByte(streamVersion)
+	}
+
+	if timeUnit != enc.tsEncoder.TimeUnit {
+		enc.stream.WriteBit(opCodeNoMoreDataOrTimeUnitChange)
+		enc.stream.WriteBit(opCodeTimeUnitChange)
+		enc.tsEncoder.WriteTimeUnit(enc.stream, timeUnit)
+	} else {
+		enc.stream.WriteBit(opCodeMoreData)
+	}
+
+	if err := enc.tsEncoder.WriteTime(enc.stream, dp.TimestampNanos, nil, timeUnit); err != nil {
+		return fmt.Errorf("native histogram encoder: error encoding timestamp: %v", err)
+	}
+
+	enc.encodeAnnotation(rest)
+
+	if isHistogram {
+		enc.stream.WriteBit(opCodeHistogramSample)
+		enc.encodeHistogram(&enc.histogram)
+		dp.Value = enc.histogram.Count
+	} else {
+		enc.stream.WriteBit(opCodeFloatSample)
+		enc.value.WriteFloat(enc.stream, dp.Value)
+	}
+
+	enc.numEncoded++
+	enc.lastEncoded = dp
+	enc.lastAnnotationChecksum = xxhash.Sum64(ant)
+	return nil
+}
+
+// splitAnnotation returns the annotation without the native histogram and
+// whether the annotation contained a histogram, which is decoded into the
+// encoder's histogram.
+func (enc *encoder) splitAnnotation(ant ts.Annotation) ([]byte, bool, error) {
+	if len(ant) == 0 {
+		return nil, false, nil
+	}
+
+	enc.payload.Reset()
+	if err := enc.payload.Unmarshal(ant); err != nil || len(enc.payload.NativeHistogram) == 0 {
+		// Not a payload that contains a histogram, keep the annotation as is.
+		return ant, false, nil
+	}
+
+	if err := enc.histogram.Unmarshal(enc.payload.NativeHistogram); err != nil {
+		return nil, false, fmt.Errorf("native histogram encoder: invalid histogram: %v", err)
+	}
+
+	enc.payload.NativeHistogram = nil
+	size := enc.payload.Size()
+	if cap(enc.marshalBuf) < size {
+		enc.marshalBuf = make([]byte, size)
+	}
+	n, err := enc.payload.MarshalTo(enc.marshalBuf[:size])
+	if err != nil {
+		return nil, false, fmt.Errorf("native histogram encoder: error marshalling annotation: %v", err)
+	}
+	return enc.marshalBuf[:n], true, nil
+}
+
+func (enc *encoder) encodeAnnotation(ant []byte) {
+	if bytes.Equal(ant, enc.prevAnnotation) {
+		enc.stream.WriteBit(opCodeAnnotationUnchanged)
+		return
+	}
+
+	enc.stream.WriteBit(opCodeAnnotationChanged)
+	enc.encodeUvarint(uint64(len(ant)))
+	enc.stream.WriteBytes(ant)
+	enc.prevAnnotation = append(enc.prevAnnotation[:0], ant...)
+}
+
+func (enc *encoder) encodeHistogram(h *histogram.Histogram) {
+	state := &enc.histState
+	if state.hasLayout && sameLayout(h, &enc.prevHistogram) {
+		enc.stream.WriteBit(opCodeLayoutUnchanged)
+	} else {
+		enc.stream.WriteBit(opCodeLayoutChanged)
+		enc.encodeVarint(int64(h.Schema))
+		enc.stream.WriteBits(math.Float64bits(h.ZeroThreshold), 64)
+		enc.encodeSpans(h.PositiveSpans)
+		enc.encodeSpans(h.NegativeSpans)
+		state.resetBuckets(len(h.PositiveBuckets), len(h.NegativeBuckets))
+		state.hasLayout = true
+	}
+
+	state.zeroCount.WriteFloat(enc.stream, h.ZeroCount)
+	state.count.WriteFloat(enc.stream, h.Count)
+	state.sum.WriteFloat(enc.stream, h.Sum)
+	for i, count := range h.PositiveBuckets {
+		state.positive[i].WriteFloat(enc.stream, count)
+	}
+	for i, count := range h.NegativeBuckets {
+		state.negative[i].WriteFloat(enc.stream, count)
+	}
+
+	enc.prevHistogram.Schema = h.Schema
+	enc.prevHistogram.ZeroThreshold = h.ZeroThreshold
+	enc.prevHistogram.PositiveSpans = append(enc.prevHistogram.PositiveSpans[:0], h.PositiveSpans...)
+	enc.prevHistogram.NegativeSpans = append(enc.prevHistogram.NegativeSpans[:0], h.NegativeSpans...)
+}
+
+func sameLayout(a, b *histogram.Histogram) bool {
+	if a.Schema != b.Schema ||
+		math.Float64bits(a.ZeroThreshold) != math.Float64bits(b.ZeroThreshold) ||
+		len(a.PositiveSpans) != len(b.PositiveSpans) ||
+		len(a.NegativeSpans) != len(b.NegativeSpans) {
+		return false
+	}
+	for i := range a.PositiveSpans {
+		if a.PositiveSpans[i] != b.PositiveSpans[i] {
+			return false
+		}
+	}
+	for i := range a.NegativeSpans {
+		if a.NegativeSpans[i] != b.NegativeSpans[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (enc *encoder) encodeSpans(spans []histogram.Span) {
+	enc.encodeUvarint(uint64(len(spans)))
+	for _, span := range spans {
+		enc.encodeVarint(int64(span.Offset))
+		enc.encodeUvarint(uint64(span.Length))
+	}
+}
+
+func (enc *encoder) encodeVarint(x int64) {
+	n := binary.PutVarint(enc.varIntBuf[:], x)
+	enc.stream.WriteBytes(enc.varIntBuf[:n])
+}
+
+func (enc *encoder) encodeUvarint(x uint64) {
+	n := binary.PutUvarint(enc.varIntBuf[:], x)
+	enc.stream.WriteBytes(enc.varIntBuf[:n])
+}
+
+// Stream returns a copy of the underlying data stream.
+func (enc *encoder) Stream(ctx context.Context) (xio.SegmentReader, bool) {
+	seg := enc.segmentZeroCopy(ctx)
+	if seg.Len() == 0 {
+		return nil, false
+	}
+
+	if readerPool := enc.opts.SegmentReaderPool(); readerPool != nil {
+		reader := readerPool.Get()
+		reader.Reset(seg)
+		return reader, true
+	}
+	return xio.NewSegmentReader(seg), true
+}
+
+func (enc *encoder) segmentZeroCopy(ctx context.Context) ts.Segment {
+	length := enc.stream.Len()
+	if length == 0 {
+		return ts.Segment{}
+	}
+
+	// We need a tail to capture an immutable snapshot of the encoder data
+	// as the last byte can change after this method returns.
+	rawBuffer, _ := enc.stream.RawBytes()
+	lastByte := rawBuffer[length-1]
+
+	// Take ref up to last byte.
+	headBytes := rawBuffer[:length-1]
+
+	// Zero copy from the output stream.
+	var head checked.Bytes
+	if pool := enc.opts.CheckedBytesWrapperPool(); pool != nil {
+		head = pool.Get(headBytes)
+	} else {
+		head = checked.NewBytes(headBytes, nil)
+	}
+
+	// Make sure the ostream bytes ref is delayed from finalizing
+	// until this operation is complete (since this is zero copy).
+	buffer, _ := enc.stream.CheckedBytes()
+	ctx.RegisterCloser(buffer.DelayFinalizer())
+
+	// Take a shared ref to a known good tail, the stream ends with zero
+	// padding which is read as the end of the stream.
+	tail := tails[lastByte]
+
+	// Only discard the head since tails are shared for process life time.
+	return ts.NewSegment(head, tail, 0, ts.FinalizeHead)
+}
+
+func (enc *encoder) segmentTakeOwnership() ts.Segment {
+	if enc.stream.Len() == 0 {
+		return ts.Segment{}
+	}
+
+	// Take ref from the ostream.
+	head := enc.stream.Discard()
+	return ts.NewSegment(head, nil, 0, ts.FinalizeHead)
+}
+
+// NumEncoded returns the number of encoded datapoints.
+func (enc *encoder) NumEncoded() int {
+	return enc.numEncoded
+}
+
+// LastEncoded returns the last encoded datapoint, the value of a histogram
+// sample is its count.
+func (enc *encoder) LastEncoded() (ts.Datapoint, error) {
+	if enc.closed {
+		return ts.Datapoint{}, errEncoderClosed
+	}
+	if enc.numEncoded == 0 {
+		return ts.Datapoint{}, errNoEncodedDatapoints
+	}
+	return enc.lastEncoded, nil
+}
+
+// LastAnnotationChecksum returns the checksum of the last encoded
+// annotation, including the histogram.
+func (enc *encoder) LastAnnotationChecksum() (uint64, error) {
+	if enc.numEncoded == 0 {
+		return 0, errNoEncodedDatapoints
+	}
+	return enc.lastAnnotationChecksum, nil
+}
+
+// Empty returns true when underlying stream is empty.
+func (enc *encoder) Empty() bool {
+	return enc.stream.Empty()
+}
+
+// Len returns the length of the data stream.
+func (enc *encoder) Len() int {
+	return enc.stream.Len()
+}
+
+// Reset resets the encoder for reuse.
+func (enc *encoder) Reset(start xtime.UnixNano, capacity int, descr namespace.SchemaDescr) {
+	enc.stream.Reset(enc.newBuffer(capacity))
+	enc.tsEncoder = m3tsz.NewTimestampEncoder(start, enc.opts.DefaultTimeUnit(), enc.opts)
+	enc.value = m3tsz.FloatEncoderAndIterator{}
+	enc.histState.reset()
+	enc.prevAnnotation = enc.prevAnnotation[:0]
+	enc.lastEncoded = ts.Datapoint{}
+	enc.lastAnnotationChecksum = 0
+	enc.numEncoded = 0
+	enc.closed = false
+}
+
+// Close closes the encoder.
+func (enc *encoder) Close() {
+	if enc.closed {
+		return
+	}
+
+	enc.Reset(0, 0, nil)
+	enc.stream.Reset(nil)
+	enc.closed = true
+
+	if pool := enc.opts.EncoderPool(); pool != nil {
+		pool.Put(enc)
+	}
+}
+
+// Discard closes the encoder and transfers ownership of the data stream to
+// the caller.
+func (enc *encoder) Discard() ts.Segment {
+	segment := enc.segmentTakeOwnership()
+	enc.Close()
+	return segment
+}
+
+// DiscardReset does the same thing as Discard except it also resets the
+// encoder for reuse.
+func (enc *encoder) DiscardReset(
+	start xtime.UnixNano,
+	capacity int,
+	descr namespace.SchemaDescr,
+) ts.Segment {
+	segment := enc.segmentTakeOwnership()
+	enc.Reset(start, capacity, descr)
+	return segment
+}
+
+func (enc *encoder) newBuffer(capacity int) checked.Bytes {
+	if bytesPool := enc.opts.BytesPool(); bytesPool != nil {
+		return bytesPool.Get(capacity)
+	}
+	return checked.NewBytes(make([]byte, 0, capacity), nil)
+}
+
+// tails is a list of all possible tails based on the byte value of the
+// last byte.
+var tails [256]checked.Bytes
+
+func init() {
+	for i := 0; i < 256; i++ {
+		tails[i] = checked.NewBytes([]byte{byte(i)}, nil)
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nativehistogram
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/histogram"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const itErrPrefix = "native histogram iterator:"
+
+var errIteratorClosed = errors.New("native histogram iterator: iterator is closed")
+
+type iterator struct {
+	opts   encoding.Options
+	reader xio.Reader64
+	stream *encoding.IStream
+	err    error
+
+	// m3tsz decodes streams that were not written by this encoding.
+	m3tsz encoding.ReaderIterator
+
+	tsIterator m3tsz.TimestampIterator
+	value      m3tsz.FloatEncoderAndIterator
+	histState  histogramState
+	histogram  histogram.Histogram
+
+	curr       ts.Datapoint
+	annotation []byte
+	// currAnnotation is the annotation of the current datapoint including
+	// the histogram if the datapoint is a histogram sample.
+	currAnnotation []byte
+
+	initialized bool
+	isM3TSZ     bool
+	done        bool
+	closed      bool
+}
+
+// NewReaderIterator returns a new iterator for native histogram streams,
+// M3TSZ streams are decoded as well.
+func NewReaderIterator(reader xio.Reader64, opts encoding.Options) encoding.ReaderIterator {
+	if opts == nil {
+		opts = encoding.NewOptions()
+	}
+	return &iterator{
+		opts:       opts,
+		reader:     reader,
+		stream:     encoding.NewIStream(reader),
+		tsIterator: m3tsz.NewTimestampIterator(opts, true),
+	}
+}
+
+func (it *iterator) Next() bool {
+	if !it.initialized {
+		it.initialize()
+	}
+	if it.isM3TSZ {
+		return it.m3tsz.Next()
+	}
+	if !it.hasNext() {
+		return false
+	}
+
+	moreDataControlBit, err := it.stream.ReadBit()
+	if err == io.EOF {
+		it.done = true
+		return false
+	}
+	if err != nil {
+		it.err = fmt.Errorf("%s error reading more data control bit: %v", itErrPrefix, err)
+		return false
+	}
+
+	if moreDataControlBit == opCodeNoMoreDataOrTimeUnitChange {
+		noMoreDataControlBit, err := it.stream.ReadBit()
+		if err == io.EOF || (err == nil && noMoreDataControlBit == opCodeNoMoreData) {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = fmt.Errorf("%s error reading no more data control bit: %v", itErrPrefix, err)
+			return false
+		}
+		if err := it.tsIterator.ReadTimeUnit(it.stream); err != nil {
+			it.err = fmt.Errorf("%s error reading new time unit: %v", itErrPrefix, err)
+			return false
+		}
+	}
+
+	_, done, err := it.tsIterator.ReadTimestamp(it.stream)
+	if err != nil {
+		it.err = fmt.Errorf("%s error reading timestamp: %v", itErrPrefix, err)
+		return false
+	}
+	if done {
+		// This should never happen since the end of stream marker is never
+		// encoded.
+		it.err = fmt.Errorf("%s unexpected end of timestamp stream", itErrPrefix)
+		return false
+	}
+
+	if err := it.readAnnotation(); err != nil {
+		it.err = fmt.Errorf("%s error reading annotation: %v", itErrPrefix, err)
+		return false
+	}
+
+	sampleKind, err := it.stream.ReadBit()
+	if err != nil {
+		it.err = fmt.Errorf("%s error reading sample kind: %v", itErrPrefix, err)
+		return false
+	}
+
+	it.curr.TimestampNanos = it.tsIterator.PrevTime
+	it.currAnnotation = it.annotation
+	if sampleKind == opCodeHistogramSample {
+		if err := it.readHistogram(); err != nil {
+			it.err = fmt.Errorf("%s error reading histogram: %v", itErrPrefix, err)
+			return false
+		}
+		it.curr.Value = it.histogram.Count
+		it.currAnnotation = it.annotationWithHistogram()
+	} else {
+		if err := it.value.ReadFloat(it.stream); err != nil {
+			it.err = fmt.Errorf("%s error reading value: %v", itErrPrefix, err)
+			return false
+		}
+		it.curr.Value = math.Float64frombits(it.value.PrevFloatBits)
+	}
+
+	return it.hasNext()
+}
+
+// initialize determines whether the stream was written by this encoding or
+// is an M3TSZ stream.
+func (it *iterator) initialize() {
+	it.initialized = true
+	if it.reader == nil {
+		it.done = true
+		return
+	}
+
+	// An empty stream has no header.
+	word, n, err := it.reader.Peek64()
+	if n == 0 && (err == nil || err == io.EOF) {
+		it.done = true
+		return
+	}
+	if err == nil && n == 8 && word == streamMagic {
+		it.isM3TSZ = false
+		if _, err := it.stream.ReadBits(64); err != nil {
+			it.err = fmt.Errorf("%s error reading stream header: %v", itErrPrefix, err)
+			return
+		}
+		version, err := it.stream.ReadByte()
+		if err != nil {
+			it.err = fmt.Errorf("%s error reading stream version: %v", itErrPrefix, err)
+			return
+		}
+		if version != streamVersion {
+			it.err = fmt.Errorf("%s unknown stream version: %d", itErrPrefix, version)
+		}
+		return
+	}
+
+	it.isM3TSZ = true
+	if it.m3tsz == nil {
+		// NB: the M3TSZ iterator is owned by this iterator and must not be
+		// returned to the pool on its own.
+		it.m3tsz = m3tsz.NewReaderIterator(it.reader,
+			m3tsz.DefaultIntOptimizationEnabled, it.opts.SetReaderIteratorPool(nil))
+		return
+	}
+	it.m3tsz.Reset(it.reader, nil)
+}
+
+func (it *iterator) readAnnotation() error {
+	changed, err := it.stream.ReadBit()
+	if err != nil {
+		return err
+	}
+	if changed == opCodeAnnotationUnchanged {
+		return nil
+	}
+
+	length, err := binary.ReadUvarint(it.stream)
+	if err != nil {
+		return err
+	}
+	if length > uint64(math.MaxInt32) {
+		return fmt.Errorf("annotation length too large: %d", length)
+	}
+	// NB: always allocate a new slice since annotations returned from
+	// previous calls to Current may still be referenced.
+	annotation := make([]byte, int(length))
+	if _, err := io.ReadFull(it.stream, annotation); err != nil {
+		return err
+	}
+	if len(annotation) == 0 {
+		annotation = nil
+	}
+	it.annotation = annotation
+	return nil
+}
+
+func (it *iterator) readHistogram() error {
+	var (
+		state = &it.histState
+		h     = &it.histogram
+	)
+	layoutBit, err := it.stream.ReadBit()
+	if err != nil {
+		return err
+	}
+	if layoutBit == opCodeLayoutChanged {
+		schema, err := binary.ReadVarint(it.stream)
+		if err != nil {
+			return err
+		}
+		zeroThreshold, err := it.stream.ReadBits(64)
+		if err != nil {
+			return err
+		}
+		h.Schema = int32(schema)
+		h.ZeroThreshold = math.Float64frombits(zeroThreshold)
+		if h.PositiveSpans, err = it.readSpans(h.PositiveSpans[:0]); err != nil {
+			return err
+		}
+		if h.NegativeSpans, err = it.readSpans(h.NegativeSpans[:0]); err != nil {
+			return err
+		}
+		h.PositiveBuckets = resizeBuckets(h.PositiveBuckets, h.PositiveSpans)
+		h.NegativeBuckets = resizeBuckets(h.NegativeBuckets, h.NegativeSpans)
+		state.resetBuckets(len(h.PositiveBuckets), len(h.NegativeBuckets))
+		state.hasLayout = true
+	} else if !state.hasLayout {
+		return errors.New("histogram without layout")
+	}
+
+	if err := state.zeroCount.ReadFloat(it.stream); err != nil {
+		return err
+	}
+	if err := state.count.ReadFloat(it.stream); err != nil {
+		return err
+	}
+	if err := state.sum.ReadFloat(it.stream); err != nil {
+		return err
+	}
+	h.ZeroCount = math.Float64frombits(state.zeroCount.PrevFloatBits)
+	h.Count = math.Float64frombits(state.count.PrevFloatBits)
+	h.Sum = math.Float64frombits(state.sum.PrevFloatBits)
+	for i := range h.PositiveBuckets {
+		if err := state.positive[i].ReadFloat(it.stream); err != nil {
+			return err
+		}
+		h.PositiveBuckets[i] = math.Float64frombits(state.positive[i].PrevFloatBits)
+	}
+	for i := range h.NegativeBuckets {
+		if err := state.negative[i].ReadFloat(it.stream); err != nil {
+			return err
+		}
+		h.NegativeBuckets[i] = math.Float64frombits(state.negative[i].PrevFloatBits)
+	}
+	return nil
+}
+
+func (it *iterator) readSpans(spans []histogram.Span) ([]histogram.Span, error) {
+	n, err := binary.ReadUvarint(it.stream)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < n; i++ {
+		offset, err := binary.ReadVarint(it.stream)
+		if err != nil {
+			return nil, err
+		}
+		length, err := binary.ReadUvarint(it.stream)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, histogram.Span{
+			Offset: int32(offset),
+			Length: uint32(length),
+		})
+	}
+	return spans, nil
+}
+
+func resizeBuckets(buckets []float64, spans []histogram.Span) []float64 {
+	var n int
+	for _, span := range spans {
+		n += int(span.Length)
+	}
+	if cap(buckets) < n {
+		return make([]float64, n)
+	}
+	return buckets[:n]
+}
+
+// annotationWithHistogram returns the current annotation with the histogram
+// set, as it was passed to the encoder.
+func (it *iterator) annotationWithHistogram() []byte {
+	marshalled := it.histogram.Marshal(nil)
+	result := make([]byte, 0, len(it.annotation)+1+binary.MaxVarintLen64+len(marshalled))
+	result = append(result, it.annotation...)
+	result = append(result, annotationHistogramTag)
+	result = binary.AppendUvarint(result, uint64(len(marshalled)))
+	return append(result, marshalled...)
+}
+
+func (it *iterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	if it.isM3TSZ {
+		return it.m3tsz.Current()
+	}
+	return it.curr, it.tsIterator.TimeUnit, it.currAnnotation
+}
+
+func (it *iterator) Err() error {
+	if it.isM3TSZ {
+		return it.m3tsz.Err()
+	}
+	return it.err
+}
+
+func (it *iterator) Reset(reader xio.Reader64, schema namespace.SchemaDescr) {
+	it.reader = reader
+	it.stream.Reset(reader)
+	it.tsIterator = m3tsz.NewTimestampIterator(it.opts, true)
+	it.value = m3tsz.FloatEncoderAndIterator{}
+	it.histState.reset()
+	it.curr = ts.Datapoint{}
+	it.annotation = nil
+	it.currAnnotation = nil
+	it.err = nil
+	it.initialized = false
+	it.isM3TSZ = false
+	it.done = false
+	it.closed = false
+}
+
+func (it *iterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.Reset(nil, nil)
+	it.closed = true
+	it.err = errIteratorClosed
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
+
+func (it *iterator) hasNext() bool {
+	return it.err == nil && !it.done && !it.closed
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package nativehistogram implements an encoding for series that contain
+// native histogram samples as well as regular float samples.
+//
+// Timestamps are compressed the same way as M3TSZ, float samples are XOR
+// compressed and histograms are compressed field by field by XORing each
+// count with the same count of the previous histogram, the bucket layout
+// is only written when it changes. Histograms are passed to the encoder
+// inside the annotation payload and the datapoint value of a histogram
+// sample is its count.
+//
+// Streams start with a header that can never be the start of an M3TSZ
+// stream, the iterator uses it to fall back to decoding M3TSZ so that data
+// written before the encoding was enabled remains readable.
+package nativehistogram
+
+import (
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+)
+
+const (
+	// streamMagic is written in place of the block start of an M3TSZ
+	// stream, the top bit would make it a negative block start.
+	streamMagic   uint64 = 0xFFFFFFFF4E484953
+	streamVersion        = 1
+
+	opCodeNoMoreDataOrTimeUnitChange = 0
+	opCodeMoreData                   = 1
+
+	opCodeNoMoreData     = 0
+	opCodeTimeUnitChange = 1
+
+	opCodeAnnotationUnchanged = 0
+	opCodeAnnotationChanged   = 1
+
+	opCodeFloatSample     = 0
+	opCodeHistogramSample = 1
+
+	opCodeLayoutUnchanged = 0
+	opCodeLayoutChanged   = 1
+
+	// annotationHistogramTag is the protobuf tag of the native histogram
+	// field of the annotation payload.
+	annotationHistogramTag = 5<<3 | 2
+)
+
+// NewReaderIteratorAllocFn returns a reader iterator allocation function
+// for native histogram streams, which also decodes M3TSZ streams.
+func NewReaderIteratorAllocFn(opts encoding.Options) encoding.ReaderIteratorAllocate {
+	return func(r xio.Reader64, descr namespace.SchemaDescr) encoding.ReaderIterator {
+		return NewReaderIterator(r, opts)
+	}
+}
+
+// histogramState is the state shared between the encoder and the iterator
+// to compress consecutive histograms.
+type histogramState struct {
+	hasLayout bool
+
+	zeroCount m3tsz.FloatEncoderAndIterator
+	count     m3tsz.FloatEncoderAndIterator
+	sum       m3tsz.FloatEncoderAndIterator
+	positive  []m3tsz.FloatEncoderAndIterator
+	negative  []m3tsz.FloatEncoderAndIterator
+}
+
+func (s *histogramState) resetBuckets(numPositive, numNegative int) {
+	s.positive = resetFloatStates(s.positive, numPositive)
+	s.negative = resetFloatStates(s.negative, numNegative)
+}
+
+func (s *histogramState) reset() {
+	s.hasLayout = false
+	s.zeroCount = m3tsz.FloatEncoderAndIterator{}
+	s.count = m3tsz.FloatEncoderAndIterator{}
+	s.sum = m3tsz.FloatEncoderAndIterator{}
+	s.resetBuckets(0, 0)
+}
+
+func resetFloatStates(
+	states []m3tsz.FloatEncoderAndIterator,
+	n int,
+) []m3tsz.FloatEncoderAndIterator {
+	if cap(states) < n {
+		return make([]m3tsz.FloatEncoderAndIterator, n)
+	}
+	states = states[:n]
+	for i := range states {
+		states[i] = m3tsz.FloatEncoderAndIterator{}
+	}
+	return states
+}
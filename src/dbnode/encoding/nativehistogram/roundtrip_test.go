@@ -0,0 +1,263 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nativehistogram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/histogram"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testSample struct {
+	dp        ts.Datapoint
+	unit      xtime.Unit
+	histogram *histogram.Histogram
+	payload   annotation.Payload
+}
+
+func (s testSample) annotation(t *testing.T) ts.Annotation {
+	payload := s.payload
+	if s.histogram != nil {
+		payload.NativeHistogram = s.histogram.Marshal(nil)
+	}
+	if payload.Size() == 0 {
+		return nil
+	}
+	b, err := payload.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func testHistogramSample(count float64) *histogram.Histogram {
+	return &histogram.Histogram{
+		Schema:          1,
+		ZeroThreshold:   0.001,
+		ZeroCount:       count / 4,
+		Count:           count,
+		Sum:             count * 3.5,
+		PositiveSpans:   []histogram.Span{{Offset: 1, Length: 2}, {Offset: 3, Length: 1}},
+		PositiveBuckets: []float64{count / 4, count / 4, count / 8},
+		NegativeSpans:   []histogram.Span{{Offset: 2, Length: 1}},
+		NegativeBuckets: []float64{count / 8},
+	}
+}
+
+func testSamples(start xtime.UnixNano) []testSample {
+	var (
+		samples []testSample
+		counter = annotation.Payload{
+			OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+		}
+		histogramType = annotation.Payload{
+			OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_HISTOGRAM,
+		}
+	)
+	for i := 0; i < 20; i++ {
+		samples = append(samples, testSample{
+			dp: ts.Datapoint{
+				TimestampNanos: start.Add(time.Duration(i) * 10 * time.Second),
+				Value:          float64(i) * 1.5,
+			},
+			unit:    xtime.Second,
+			payload: counter,
+		})
+	}
+	for i := 20; i < 40; i++ {
+		h := testHistogramSample(float64(i * 8))
+		samples = append(samples, testSample{
+			dp: ts.Datapoint{
+				TimestampNanos: start.Add(time.Duration(i) * 10 * time.Second),
+				Value:          h.Count,
+			},
+			unit:      xtime.Second,
+			histogram: h,
+			payload:   histogramType,
+		})
+	}
+	// Change the layout and the time unit.
+	for i := 40; i < 60; i++ {
+		h := testHistogramSample(float64(i * 8))
+		h.Schema = 2
+		h.PositiveSpans = append(h.PositiveSpans, histogram.Span{Offset: 0, Length: 1})
+		h.PositiveBuckets = append(h.PositiveBuckets, 1)
+		h.Count++
+		samples = append(samples, testSample{
+			dp: ts.Datapoint{
+				TimestampNanos: start.Add(time.Duration(i)*10*time.Second + time.Millisecond),
+				Value:          h.Count,
+			},
+			unit:      xtime.Millisecond,
+			histogram: h,
+			payload:   histogramType,
+		})
+	}
+	// Float samples without annotations.
+	for i := 60; i < 70; i++ {
+		samples = append(samples, testSample{
+			dp: ts.Datapoint{
+				TimestampNanos: start.Add(time.Duration(i) * 10 * time.Second),
+				Value:          float64(i),
+			},
+			unit: xtime.Second,
+		})
+	}
+	return samples
+}
+
+func TestRoundTrip(t *testing.T) {
+	var (
+		start   = xtime.Now().Truncate(2 * time.Hour)
+		opts    = encoding.NewOptions()
+		enc     = NewEncoder(start, opts)
+		samples = testSamples(start)
+	)
+	enc.Reset(start, 0, nil)
+	for _, sample := range samples {
+		require.NoError(t, enc.Encode(sample.dp, sample.unit, sample.annotation(t)))
+	}
+	require.Equal(t, len(samples), enc.NumEncoded())
+
+	last, err := enc.LastEncoded()
+	require.NoError(t, err)
+	require.Equal(t, samples[len(samples)-1].dp, last)
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	iter := NewReaderIterator(stream, opts)
+	defer iter.Close()
+	for _, sample := range samples {
+		require.True(t, iter.Next(), iter.Err())
+		dp, unit, ant := iter.Current()
+		require.Equal(t, sample.dp, dp)
+		require.Equal(t, sample.unit, unit)
+
+		var payload annotation.Payload
+		require.NoError(t, payload.Unmarshal(ant))
+		require.Equal(t, sample.payload.OpenMetricsFamilyType, payload.OpenMetricsFamilyType)
+		if sample.histogram == nil {
+			require.Equal(t, sample.annotation(t), ant)
+			continue
+		}
+		h, err := histogram.Unmarshal(payload.NativeHistogram)
+		require.NoError(t, err)
+		require.True(t, sample.histogram.Equal(h), "expected %v, got %v", sample.histogram, h)
+	}
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestRoundTripAfterDiscardReset(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(2 * time.Hour)
+		opts  = encoding.NewOptions()
+		enc   = NewEncoder(start, opts)
+	)
+	enc.Reset(start, 0, nil)
+	sample := testSamples(start)[25]
+	require.NoError(t, enc.Encode(sample.dp, sample.unit, sample.annotation(t)))
+
+	next := start.Add(2 * time.Hour)
+	seg := enc.DiscardReset(next, 0, nil)
+	require.True(t, seg.Len() > 0)
+
+	sample.dp.TimestampNanos = next.Add(time.Second)
+	require.NoError(t, enc.Encode(sample.dp, sample.unit, sample.annotation(t)))
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+	iter := NewReaderIterator(stream, opts)
+	require.True(t, iter.Next(), iter.Err())
+	dp, _, _ := iter.Current()
+	require.Equal(t, sample.dp, dp)
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestEncodeInvalidHistogram(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(2 * time.Hour)
+		enc   = NewEncoder(start, encoding.NewOptions())
+	)
+	enc.Reset(start, 0, nil)
+
+	payload := annotation.Payload{NativeHistogram: []byte{0xff}}
+	ant, err := payload.Marshal()
+	require.NoError(t, err)
+
+	err = enc.Encode(ts.Datapoint{TimestampNanos: start}, xtime.Second, ant)
+	require.Error(t, err)
+	require.Equal(t, 0, enc.NumEncoded())
+	require.True(t, enc.Empty())
+}
+
+func TestIteratorDecodesM3TSZ(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(2 * time.Hour)
+		opts  = encoding.NewOptions()
+		enc   = m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, opts)
+		dps   []ts.Datapoint
+	)
+	for i := 0; i < 10; i++ {
+		dp := ts.Datapoint{
+			TimestampNanos: start.Add(time.Duration(i) * time.Second),
+			Value:          float64(i),
+		}
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+		dps = append(dps, dp)
+	}
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	iter := NewReaderIterator(stream, opts)
+	for _, expected := range dps {
+		require.True(t, iter.Next(), iter.Err())
+		dp, _, _ := iter.Current()
+		require.Equal(t, expected, dp)
+	}
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+
+	// An empty stream has no datapoints.
+	iter.Reset(xio.NewSegmentReader(ts.Segment{}), nil)
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
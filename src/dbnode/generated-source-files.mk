@@ -165,7 +165,7 @@ genny-map-storage-index-aggregate-values:
 	cd $(m3x_package_path) && make hashmap-gen \
 		pkg=index                                \
 		key_type=ident.ID                        \
-		value_type=struct{}                      \
+		value_type=int64                         \
 		rename_type_prefix=AggregateValues       \
 		rename_nogen_key=true                    \
 		rename_nogen_value=true                  \
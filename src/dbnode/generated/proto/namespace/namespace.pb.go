@@ -40,6 +40,7 @@
 		Registry
 		NamespaceRuntimeOptions
 		ExtendedOptions
+		RetentionOverride
 		SchemaOptions
 		SchemaHistory
 		FileDescriptorSet
@@ -196,6 +197,7 @@ type NamespaceOptions struct {
 	CacheBlocksOnRetrieve *google_protobuf1.BoolValue `protobuf:"bytes,12,opt,name=cacheBlocksOnRetrieve" json:"cacheBlocksOnRetrieve,omitempty"`
 	AggregationOptions    *AggregationOptions         `protobuf:"bytes,13,opt,name=aggregationOptions" json:"aggregationOptions,omitempty"`
 	StagingState          *StagingState               `protobuf:"bytes,14,opt,name=stagingState" json:"stagingState,omitempty"`
+	RetentionOverrides    []*RetentionOverride        `protobuf:"bytes,15,rep,name=retentionOverrides" json:"retentionOverrides,omitempty"`
 	// Use larger field ID to ensure new fields are always added before extended options.
 	ExtendedOptions *ExtendedOptions `protobuf:"bytes,1000,opt,name=extendedOptions" json:"extendedOptions,omitempty"`
 }
@@ -303,6 +305,13 @@ func (m *NamespaceOptions) GetStagingState() *StagingState {
 	return nil
 }
 
+func (m *NamespaceOptions) GetRetentionOverrides() []*RetentionOverride {
+	if m != nil {
+		return m.RetentionOverrides
+	}
+	return nil
+}
+
 func (m *NamespaceOptions) GetExtendedOptions() *ExtendedOptions {
 	if m != nil {
 		return m.ExtendedOptions
@@ -487,6 +496,32 @@ func (m *ExtendedOptions) GetOptions() *google_protobuf.Struct {
 	return nil
 }
 
+// RetentionOverride overrides the retention period of the series
+// in the namespace matching a tag filter.
+type RetentionOverride struct {
+	Filter               string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	RetentionPeriodNanos int64  `protobuf:"varint,2,opt,name=retentionPeriodNanos,proto3" json:"retentionPeriodNanos,omitempty"`
+}
+
+func (m *RetentionOverride) Reset()                    { *m = RetentionOverride{} }
+func (m *RetentionOverride) String() string            { return proto.CompactTextString(m) }
+func (*RetentionOverride) ProtoMessage()               {}
+func (*RetentionOverride) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{11} }
+
+func (m *RetentionOverride) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *RetentionOverride) GetRetentionPeriodNanos() int64 {
+	if m != nil {
+		return m.RetentionPeriodNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
@@ -499,6 +534,7 @@ func init() {
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
 	proto.RegisterType((*NamespaceRuntimeOptions)(nil), "namespace.NamespaceRuntimeOptions")
 	proto.RegisterType((*ExtendedOptions)(nil), "namespace.ExtendedOptions")
+	proto.RegisterType((*RetentionOverride)(nil), "namespace.RetentionOverride")
 	proto.RegisterEnum("namespace.StagingStatus", StagingStatus_name, StagingStatus_value)
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
@@ -747,6 +783,18 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if len(m.RetentionOverrides) > 0 {
+		for _, msg := range m.RetentionOverrides {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintNamespace(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.ExtendedOptions != nil {
 		dAtA[i] = 0xc2
 		i++
@@ -1032,6 +1080,35 @@ func (m *ExtendedOptions) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RetentionOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionOverride) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	if m.RetentionPeriodNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.RetentionPeriodNanos))
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1132,6 +1209,12 @@ func (m *NamespaceOptions) Size() (n int) {
 		l = m.StagingState.Size()
 		n += 1 + l + sovNamespace(uint64(l))
 	}
+	if len(m.RetentionOverrides) > 0 {
+		for _, e := range m.RetentionOverrides {
+			l = e.Size()
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
 	if m.ExtendedOptions != nil {
 		l = m.ExtendedOptions.Size()
 		n += 2 + l + sovNamespace(uint64(l))
@@ -1242,6 +1325,19 @@ func (m *ExtendedOptions) Size() (n int) {
 	return n
 }
 
+func (m *RetentionOverride) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.RetentionPeriodNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.RetentionPeriodNanos))
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -1928,6 +2024,37 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetentionOverrides = append(m.RetentionOverrides, &RetentionOverride{})
+			if err := m.RetentionOverrides[len(m.RetentionOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1000:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedOptions", wireType)
@@ -2808,6 +2935,104 @@ func (m *ExtendedOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetentionOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriodNanos", wireType)
+			}
+			m.RetentionPeriodNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionPeriodNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2918,68 +3143,69 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x80, 0xbb, 0xf9, 0x73, 0x72, 0xec, 0x24, 0xce, 0xa8, 0x10, 0x2b, 0x2d, 0xa6, 0x5a, 0x7e,
-	0x14, 0x55, 0xc8, 0xa6, 0xc9, 0x0d, 0x14, 0xa9, 0xe0, 0x24, 0x26, 0x72, 0x29, 0x8e, 0x35, 0x69,
-	0x29, 0xe4, 0x6e, 0x76, 0xf7, 0x78, 0xb3, 0xea, 0x7a, 0x66, 0x35, 0x33, 0xdb, 0x24, 0x3c, 0x43,
-	0x2f, 0x78, 0x0f, 0x5e, 0x84, 0x4b, 0x1e, 0x01, 0x05, 0x21, 0x71, 0xc3, 0x3b, 0xa0, 0x9d, 0xf5,
-	0x3a, 0xfb, 0xe3, 0x96, 0x88, 0x9b, 0x68, 0x72, 0xce, 0x77, 0x7e, 0xe6, 0xfc, 0xcc, 0x1a, 0x8e,
-	0xfd, 0x40, 0x9f, 0xc7, 0x4e, 0xc7, 0x15, 0x93, 0xee, 0x64, 0xdf, 0x73, 0xba, 0x93, 0xfd, 0xae,
-	0x92, 0x6e, 0xd7, 0x73, 0xb8, 0xf0, 0xb0, 0xeb, 0x23, 0x47, 0xc9, 0x34, 0x7a, 0xdd, 0x48, 0x0a,
-	0x2d, 0xba, 0x9c, 0x4d, 0x50, 0x45, 0xcc, 0xc5, 0x9b, 0x53, 0xc7, 0x68, 0xc8, 0xda, 0x4c, 0xb0,
-	0x73, 0xdf, 0x17, 0xc2, 0x0f, 0x31, 0x35, 0x71, 0xe2, 0x71, 0x57, 0x69, 0x19, 0xbb, 0x3a, 0x05,
-	0x77, 0xda, 0x65, 0xed, 0x85, 0x64, 0x51, 0x84, 0x52, 0x4d, 0xf5, 0x47, 0xff, 0x37, 0x23, 0xe5,
-	0x9e, 0xe3, 0x84, 0xa5, 0x5e, 0xec, 0x37, 0x8b, 0xd0, 0xa4, 0xa8, 0x91, 0xeb, 0x40, 0xf0, 0x93,
-	0x28, 0xf9, 0xab, 0xc8, 0x1e, 0xdc, 0x95, 0x99, 0x6c, 0x84, 0x32, 0x10, 0xde, 0x90, 0x71, 0xa1,
-	0x5a, 0xd6, 0x03, 0x6b, 0x77, 0x91, 0xce, 0xd5, 0x91, 0x4f, 0x61, 0xc3, 0x09, 0x85, 0xfb, 0xea,
-	0x34, 0xf8, 0x19, 0x53, 0x7a, 0xc1, 0xd0, 0x25, 0x29, 0xf9, 0x0c, 0xb6, 0x9c, 0x78, 0x3c, 0x46,
-	0xf9, 0x6d, 0xac, 0x63, 0x39, 0x45, 0x17, 0x0d, 0x5a, 0x55, 0x90, 0x5d, 0xd8, 0x4c, 0x85, 0x23,
-	0xa6, 0x74, 0xca, 0x2e, 0x19, 0xb6, 0x2c, 0x36, 0x64, 0x12, 0xe9, 0x88, 0x69, 0xd6, 0xbf, 0x8c,
-	0x02, 0x79, 0xd5, 0x5a, 0x7e, 0x60, 0xed, 0xae, 0xd2, 0xb2, 0x98, 0x9c, 0xc1, 0x6e, 0x49, 0xd4,
-	0x1b, 0x6b, 0x94, 0x43, 0xa1, 0x7b, 0xae, 0x8b, 0x4a, 0xe5, 0x6f, 0xbc, 0x62, 0x82, 0xdd, 0x9a,
-	0x27, 0x4f, 0x60, 0x67, 0x6c, 0xd2, 0xa7, 0xf3, 0xea, 0x57, 0x33, 0xde, 0xde, 0x41, 0xd8, 0x23,
-	0x68, 0x0c, 0xb8, 0x87, 0x97, 0x59, 0x27, 0x5a, 0x50, 0x43, 0xce, 0x9c, 0x10, 0x3d, 0x53, 0xfc,
-	0x55, 0x9a, 0xfd, 0x7b, 0xdb, 0x7a, 0xdb, 0xff, 0xac, 0x40, 0x73, 0x98, 0xf5, 0x3e, 0x73, 0xfb,
-	0x10, 0x9a, 0x8e, 0x10, 0x5a, 0x69, 0xc9, 0xa2, 0x7e, 0xc1, 0x7f, 0x45, 0x4e, 0x6c, 0x68, 0x8c,
-	0xc3, 0x58, 0x9d, 0x67, 0xdc, 0x82, 0xe1, 0x0a, 0xb2, 0xa4, 0xa9, 0x17, 0x32, 0xd0, 0xa8, 0x9e,
-	0x8b, 0x43, 0x31, 0x99, 0x04, 0xfa, 0x99, 0xf0, 0x4d, 0x53, 0x57, 0x69, 0x55, 0x91, 0xa4, 0xee,
-	0x86, 0xc8, 0x78, 0x3c, 0x8b, 0xbd, 0x64, 0xd0, 0x92, 0x94, 0x7c, 0x0c, 0xeb, 0x12, 0x23, 0x16,
-	0xc8, 0x0c, 0x4b, 0x1b, 0x5a, 0x14, 0x92, 0x63, 0x68, 0xca, 0xd2, 0x00, 0x9b, 0xb6, 0xd5, 0xf7,
-	0xee, 0x75, 0x6e, 0x96, 0xaf, 0x3c, 0xe3, 0xb4, 0x62, 0x94, 0x4c, 0x90, 0xe2, 0x2c, 0x52, 0xe7,
-	0x42, 0x67, 0x01, 0x6b, 0xe9, 0x04, 0x95, 0xc4, 0xe4, 0x2b, 0x68, 0x04, 0xb9, 0x2e, 0xb5, 0x56,
-	0x4d, 0xb8, 0xed, 0x5c, 0xb8, 0x7c, 0x13, 0x69, 0x01, 0x26, 0x4f, 0x60, 0x3d, 0xdd, 0xc0, 0xcc,
-	0x7a, 0xcd, 0x58, 0xb7, 0x72, 0xd6, 0xa7, 0x79, 0x3d, 0x2d, 0xe2, 0x49, 0xad, 0x5d, 0x11, 0x7a,
-	0x2f, 0x4d, 0x59, 0xb3, 0x44, 0x21, 0xad, 0x75, 0x45, 0x41, 0x9e, 0xc2, 0x86, 0x8c, 0xb9, 0x0e,
-	0x26, 0x59, 0xef, 0x5b, 0x75, 0x13, 0xce, 0xce, 0x85, 0x9b, 0x8d, 0x07, 0x2d, 0x90, 0xb4, 0x64,
-	0x49, 0x46, 0xf0, 0x9e, 0xcb, 0xdc, 0x73, 0x3c, 0x48, 0x26, 0x4c, 0x9d, 0x70, 0x8a, 0x5a, 0x06,
-	0xf8, 0x1a, 0x5b, 0x0d, 0xe3, 0x72, 0xa7, 0x93, 0xbe, 0x58, 0x9d, 0xec, 0xc5, 0xea, 0x1c, 0x08,
-	0x11, 0xfe, 0xc0, 0xc2, 0x18, 0xe9, 0x7c, 0x43, 0xf2, 0x3d, 0x10, 0xe6, 0xfb, 0x12, 0x7d, 0x96,
-	0xef, 0xde, 0xba, 0x71, 0xf7, 0x41, 0x2e, 0xc3, 0x5e, 0x05, 0xa2, 0x73, 0x0c, 0x93, 0xbe, 0x28,
-	0xcd, 0xfc, 0x80, 0xfb, 0xa7, 0x9a, 0x69, 0x6c, 0x6d, 0x54, 0xfa, 0x72, 0x9a, 0x53, 0xd3, 0x02,
-	0x4c, 0xfa, 0xb0, 0x89, 0x97, 0x1a, 0xb9, 0x87, 0x5e, 0x96, 0xc8, 0xdf, 0xb5, 0xe9, 0xc5, 0x6e,
-	0x1c, 0xf4, 0x8b, 0x08, 0x2d, 0xdb, 0xd8, 0x23, 0x20, 0xd5, 0x6c, 0xc9, 0x63, 0x68, 0xe4, 0xf2,
-	0x4d, 0x5e, 0xd2, 0xc5, 0xdd, 0xfa, 0xde, 0xfb, 0xf3, 0xaf, 0x48, 0x0b, 0xac, 0xcd, 0xa1, 0x9e,
-	0x53, 0x92, 0x36, 0x40, 0xa6, 0x9e, 0x6d, 0x6d, 0x4e, 0x42, 0xbe, 0x06, 0x60, 0x5a, 0xcb, 0xc0,
-	0x89, 0x35, 0xa6, 0x8f, 0x42, 0x7d, 0xef, 0xc3, 0x39, 0x81, 0xd0, 0xeb, 0xcd, 0x30, 0x9a, 0x33,
-	0xb1, 0xdf, 0x58, 0x70, 0x77, 0x1e, 0x94, 0x2c, 0x88, 0x44, 0x25, 0xc2, 0x38, 0xc9, 0x23, 0xff,
-	0x45, 0x28, 0x8b, 0xc9, 0x53, 0xd8, 0xf2, 0xc4, 0x05, 0x57, 0x6c, 0x12, 0x85, 0xb3, 0xc1, 0x4b,
-	0x53, 0xb9, 0x9f, 0x4b, 0xe5, 0xa8, 0xcc, 0xd0, 0xaa, 0x99, 0xfd, 0x09, 0x6c, 0x55, 0x38, 0xd2,
-	0x84, 0x45, 0x16, 0x86, 0xd3, 0xdb, 0x27, 0x47, 0xfb, 0x1b, 0x68, 0xe4, 0x9b, 0x4b, 0x3e, 0x87,
-	0x15, 0xa5, 0x99, 0x8e, 0xd3, 0x1c, 0x37, 0x8a, 0xfb, 0x75, 0x03, 0xc6, 0x8a, 0x4e, 0x39, 0xfb,
-	0x57, 0x0b, 0x56, 0x29, 0xfa, 0x81, 0xd2, 0xf2, 0x8a, 0x1c, 0x02, 0xcc, 0xf8, 0xac, 0x5d, 0x1f,
-	0x15, 0xde, 0x93, 0x14, 0xbc, 0x59, 0x1e, 0xd5, 0xe7, 0x5a, 0x5e, 0xd1, 0x9c, 0xd9, 0xce, 0x19,
-	0x6c, 0x96, 0xd4, 0x49, 0xe2, 0xaf, 0xf0, 0xca, 0xe4, 0xb4, 0x46, 0x93, 0x23, 0x79, 0x04, 0xcb,
-	0xaf, 0x93, 0x1d, 0x99, 0xd6, 0xe7, 0xde, 0xbc, 0xc5, 0xcc, 0xca, 0x93, 0x92, 0x8f, 0x17, 0xbe,
-	0xb0, 0xec, 0xbf, 0x2c, 0xd8, 0x7e, 0xcb, 0xe2, 0x12, 0x0f, 0xda, 0xe6, 0xd5, 0x35, 0xaf, 0x50,
-	0xc0, 0xfd, 0x11, 0xca, 0xc3, 0xd1, 0x8b, 0x43, 0xc1, 0xdd, 0x58, 0x4a, 0xe4, 0x6e, 0x1a, 0x3f,
-	0xe9, 0x45, 0x79, 0x63, 0x8f, 0x44, 0xec, 0x84, 0x98, 0xee, 0xec, 0x7f, 0xf8, 0x48, 0xa2, 0x98,
-	0x8f, 0xc0, 0xdb, 0xa3, 0x2c, 0xdc, 0x26, 0xca, 0xbb, 0x7d, 0xd8, 0x3f, 0xc2, 0x66, 0x69, 0xe7,
-	0x08, 0x81, 0x25, 0x7d, 0x15, 0xe1, 0xb4, 0x88, 0xe6, 0x4c, 0x1e, 0x41, 0x4d, 0x14, 0xe6, 0x6c,
-	0xbb, 0x12, 0xf5, 0xd4, 0xfc, 0xba, 0xa2, 0x19, 0xf7, 0xf0, 0x4b, 0x58, 0x2f, 0x0c, 0x02, 0xa9,
-	0x43, 0xed, 0xc5, 0xf0, 0xbb, 0xe1, 0xc9, 0xcb, 0x61, 0xf3, 0x0e, 0x69, 0x42, 0x63, 0x30, 0x1c,
-	0x3c, 0x1f, 0xf4, 0x9e, 0x0d, 0xce, 0x06, 0xc3, 0xe3, 0xa6, 0x45, 0xd6, 0x60, 0x99, 0xf6, 0x7b,
-	0x47, 0x3f, 0x35, 0x17, 0x0e, 0x9a, 0xbf, 0x5d, 0xb7, 0xad, 0xdf, 0xaf, 0xdb, 0xd6, 0x1f, 0xd7,
-	0x6d, 0xeb, 0x97, 0x3f, 0xdb, 0x77, 0x9c, 0x15, 0x13, 0x66, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0x8b, 0xb8, 0x2d, 0x28, 0x0a, 0x00, 0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x80, 0x7f, 0xc9, 0x07, 0xd9, 0x23, 0xd9, 0x96, 0x17, 0xf9, 0x63, 0xc2, 0x4d, 0x5d, 0x83,
+	0x3d, 0xc0, 0x08, 0x0a, 0xa9, 0xb1, 0x6f, 0xda, 0x14, 0x48, 0xab, 0xd8, 0xaa, 0xa1, 0xd4, 0x95,
+	0x85, 0x75, 0xd2, 0xb4, 0xbe, 0x29, 0x96, 0xe4, 0x88, 0x26, 0x42, 0xed, 0x12, 0xbb, 0x4b, 0x1f,
+	0xfa, 0x0c, 0x79, 0x93, 0x3e, 0x42, 0x9f, 0xa7, 0x40, 0x1f, 0xa3, 0xe0, 0x52, 0x94, 0x78, 0x90,
+	0x53, 0xa3, 0x37, 0x02, 0x35, 0xf3, 0xcd, 0x61, 0x67, 0x66, 0x87, 0x84, 0x53, 0x3f, 0xd0, 0x57,
+	0xb1, 0xd3, 0x71, 0xc5, 0xa4, 0x3b, 0x39, 0xf2, 0x9c, 0xee, 0xe4, 0xa8, 0xab, 0xa4, 0xdb, 0xf5,
+	0x1c, 0x2e, 0x3c, 0xec, 0xfa, 0xc8, 0x51, 0x32, 0x8d, 0x5e, 0x37, 0x92, 0x42, 0x8b, 0x2e, 0x67,
+	0x13, 0x54, 0x11, 0x73, 0x71, 0xfe, 0xd4, 0x31, 0x1a, 0xb2, 0x3e, 0x13, 0xec, 0x3e, 0xf1, 0x85,
+	0xf0, 0x43, 0x4c, 0x4d, 0x9c, 0x78, 0xdc, 0x55, 0x5a, 0xc6, 0xae, 0x4e, 0xc1, 0xdd, 0xbd, 0xb2,
+	0xf6, 0x46, 0xb2, 0x28, 0x42, 0xa9, 0xa6, 0xfa, 0x93, 0xff, 0x9a, 0x91, 0x72, 0xaf, 0x70, 0xc2,
+	0x52, 0x2f, 0xf6, 0xfb, 0x25, 0x68, 0x53, 0xd4, 0xc8, 0x75, 0x20, 0xf8, 0x79, 0x94, 0xfc, 0x2a,
+	0x72, 0x08, 0x8f, 0x64, 0x26, 0x1b, 0xa1, 0x0c, 0x84, 0x37, 0x64, 0x5c, 0x28, 0xab, 0xb6, 0x5f,
+	0x3b, 0x58, 0xa2, 0x0b, 0x75, 0xe4, 0x0b, 0xd8, 0x74, 0x42, 0xe1, 0xbe, 0xbb, 0x08, 0x7e, 0xc7,
+	0x94, 0xae, 0x1b, 0xba, 0x24, 0x25, 0x5f, 0xc2, 0xb6, 0x13, 0x8f, 0xc7, 0x28, 0x7f, 0x88, 0x75,
+	0x2c, 0xa7, 0xe8, 0x92, 0x41, 0xab, 0x0a, 0x72, 0x00, 0x5b, 0xa9, 0x70, 0xc4, 0x94, 0x4e, 0xd9,
+	0x65, 0xc3, 0x96, 0xc5, 0x86, 0x4c, 0x22, 0x9d, 0x30, 0xcd, 0xfa, 0xb7, 0x51, 0x20, 0xef, 0xac,
+	0x95, 0xfd, 0xda, 0xc1, 0x1a, 0x2d, 0x8b, 0xc9, 0x25, 0x1c, 0x94, 0x44, 0xbd, 0xb1, 0x46, 0x39,
+	0x14, 0xba, 0xe7, 0xba, 0xa8, 0x54, 0xfe, 0xc4, 0xab, 0x26, 0xd8, 0x83, 0x79, 0xf2, 0x02, 0x76,
+	0xc7, 0x26, 0x7d, 0xba, 0xa8, 0x7e, 0x0d, 0xe3, 0xed, 0x03, 0x84, 0x3d, 0x82, 0xd6, 0x80, 0x7b,
+	0x78, 0x9b, 0x75, 0xc2, 0x82, 0x06, 0x72, 0xe6, 0x84, 0xe8, 0x99, 0xe2, 0xaf, 0xd1, 0xec, 0xef,
+	0x43, 0xeb, 0x6d, 0xff, 0xd9, 0x80, 0xf6, 0x30, 0xeb, 0x7d, 0xe6, 0xf6, 0x29, 0xb4, 0x1d, 0x21,
+	0xb4, 0xd2, 0x92, 0x45, 0xfd, 0x82, 0xff, 0x8a, 0x9c, 0xd8, 0xd0, 0x1a, 0x87, 0xb1, 0xba, 0xca,
+	0xb8, 0xba, 0xe1, 0x0a, 0xb2, 0xa4, 0xa9, 0x37, 0x32, 0xd0, 0xa8, 0x5e, 0x8b, 0x63, 0x31, 0x99,
+	0x04, 0xfa, 0x4c, 0xf8, 0xa6, 0xa9, 0x6b, 0xb4, 0xaa, 0x48, 0x52, 0x77, 0x43, 0x64, 0x3c, 0x9e,
+	0xc5, 0x5e, 0x36, 0x68, 0x49, 0x4a, 0x3e, 0x83, 0x0d, 0x89, 0x11, 0x0b, 0x64, 0x86, 0xa5, 0x0d,
+	0x2d, 0x0a, 0xc9, 0x29, 0xb4, 0x65, 0x69, 0x80, 0x4d, 0xdb, 0x9a, 0x87, 0x1f, 0x75, 0xe6, 0x97,
+	0xaf, 0x3c, 0xe3, 0xb4, 0x62, 0x94, 0x4c, 0x90, 0xe2, 0x2c, 0x52, 0x57, 0x42, 0x67, 0x01, 0x1b,
+	0xe9, 0x04, 0x95, 0xc4, 0xe4, 0x5b, 0x68, 0x05, 0xb9, 0x2e, 0x59, 0x6b, 0x26, 0xdc, 0x4e, 0x2e,
+	0x5c, 0xbe, 0x89, 0xb4, 0x00, 0x93, 0x17, 0xb0, 0x91, 0xde, 0xc0, 0xcc, 0x7a, 0xdd, 0x58, 0x5b,
+	0x39, 0xeb, 0x8b, 0xbc, 0x9e, 0x16, 0xf1, 0xa4, 0xd6, 0xae, 0x08, 0xbd, 0xb7, 0xa6, 0xac, 0x59,
+	0xa2, 0x90, 0xd6, 0xba, 0xa2, 0x20, 0xaf, 0x60, 0x53, 0xc6, 0x5c, 0x07, 0x93, 0xac, 0xf7, 0x56,
+	0xd3, 0x84, 0xb3, 0x73, 0xe1, 0x66, 0xe3, 0x41, 0x0b, 0x24, 0x2d, 0x59, 0x92, 0x11, 0xfc, 0xdf,
+	0x65, 0xee, 0x15, 0xbe, 0x4c, 0x26, 0x4c, 0x9d, 0x73, 0x8a, 0x5a, 0x06, 0x78, 0x8d, 0x56, 0xcb,
+	0xb8, 0xdc, 0xed, 0xa4, 0x1b, 0xab, 0x93, 0x6d, 0xac, 0xce, 0x4b, 0x21, 0xc2, 0x9f, 0x59, 0x18,
+	0x23, 0x5d, 0x6c, 0x48, 0x7e, 0x02, 0xc2, 0x7c, 0x5f, 0xa2, 0xcf, 0xf2, 0xdd, 0xdb, 0x30, 0xee,
+	0x3e, 0xce, 0x65, 0xd8, 0xab, 0x40, 0x74, 0x81, 0x61, 0xd2, 0x17, 0xa5, 0x99, 0x1f, 0x70, 0xff,
+	0x42, 0x33, 0x8d, 0xd6, 0x66, 0xa5, 0x2f, 0x17, 0x39, 0x35, 0x2d, 0xc0, 0xe4, 0x0c, 0xc8, 0x7c,
+	0x24, 0xae, 0x51, 0xca, 0xc0, 0x43, 0x65, 0x6d, 0xed, 0x2f, 0x1d, 0x34, 0x0f, 0x9f, 0x2c, 0x9c,
+	0xa4, 0x29, 0x44, 0x17, 0xd8, 0x91, 0x3e, 0x6c, 0xe1, 0xad, 0x46, 0xee, 0xa1, 0x97, 0x1d, 0xeb,
+	0xef, 0xc6, 0xb4, 0x4c, 0x73, 0x5f, 0xfd, 0x22, 0x42, 0xcb, 0x36, 0xf6, 0x08, 0x48, 0xf5, 0xec,
+	0xe4, 0x39, 0xb4, 0x72, 0xa7, 0x4f, 0xf6, 0x72, 0x92, 0xe4, 0xe3, 0xc5, 0x05, 0xa3, 0x05, 0xd6,
+	0xe6, 0xd0, 0xcc, 0x29, 0xc9, 0x1e, 0x40, 0xa6, 0x9e, 0xed, 0x80, 0x9c, 0x84, 0x7c, 0x07, 0xc0,
+	0xb4, 0x96, 0x81, 0x13, 0x6b, 0x4c, 0x57, 0x4c, 0xf3, 0xf0, 0x93, 0x05, 0x81, 0xd0, 0xeb, 0xcd,
+	0x30, 0x9a, 0x33, 0xb1, 0xdf, 0xd7, 0xe0, 0xd1, 0x22, 0x28, 0xb9, 0x6e, 0x12, 0x95, 0x08, 0xe3,
+	0x24, 0x8f, 0xfc, 0xfb, 0xa5, 0x2c, 0x26, 0xaf, 0x60, 0xdb, 0x13, 0x37, 0x5c, 0xb1, 0x49, 0x14,
+	0xce, 0xc6, 0x38, 0x4d, 0x25, 0xdf, 0x98, 0x93, 0x32, 0x43, 0xab, 0x66, 0xf6, 0xe7, 0xb0, 0x5d,
+	0xe1, 0x48, 0x1b, 0x96, 0x58, 0x18, 0x4e, 0x4f, 0x9f, 0x3c, 0xda, 0xdf, 0x43, 0x2b, 0x3f, 0x2a,
+	0xe4, 0x2b, 0x58, 0x55, 0x9a, 0xe9, 0x38, 0xcd, 0x71, 0xb3, 0x78, 0x5b, 0xe7, 0x60, 0xac, 0xe8,
+	0x94, 0xb3, 0xff, 0xa8, 0xc1, 0x1a, 0x45, 0x3f, 0x50, 0x5a, 0xde, 0x91, 0x63, 0x80, 0x19, 0x9f,
+	0xb5, 0xeb, 0xd3, 0xc2, 0x4c, 0xa5, 0xe0, 0xfc, 0x2a, 0xaa, 0x3e, 0xd7, 0xf2, 0x8e, 0xe6, 0xcc,
+	0x76, 0x2f, 0x61, 0xab, 0xa4, 0x4e, 0x12, 0x7f, 0x87, 0x77, 0x26, 0xa7, 0x75, 0x9a, 0x3c, 0x92,
+	0x67, 0xb0, 0x72, 0x9d, 0xdc, 0x38, 0xab, 0x5e, 0x59, 0x81, 0xe5, 0xb7, 0x00, 0x4d, 0xc9, 0xe7,
+	0xf5, 0xaf, 0x6b, 0xf6, 0x5f, 0x35, 0xd8, 0xb9, 0x67, 0x0d, 0x10, 0x0f, 0xf6, 0xcc, 0x0e, 0x37,
+	0x3b, 0x2d, 0xe0, 0xfe, 0x08, 0xe5, 0xf1, 0xe8, 0xcd, 0xb1, 0xe0, 0x6e, 0x2c, 0x25, 0x72, 0x37,
+	0x8d, 0x9f, 0xf4, 0xa2, 0x7c, 0xff, 0x4f, 0x44, 0xec, 0x84, 0x98, 0x6e, 0x80, 0x7f, 0xf1, 0x91,
+	0x44, 0x31, 0xaf, 0x94, 0xfb, 0xa3, 0xd4, 0x1f, 0x12, 0xe5, 0xc3, 0x3e, 0xec, 0x5f, 0x60, 0xab,
+	0x74, 0xe7, 0x08, 0x81, 0x65, 0x7d, 0x17, 0xe1, 0xb4, 0x88, 0xe6, 0x99, 0x3c, 0x83, 0x86, 0x28,
+	0xcc, 0xd9, 0x4e, 0x25, 0xea, 0x85, 0xf9, 0x56, 0xa3, 0x19, 0x67, 0xff, 0x06, 0xdb, 0x95, 0xcd,
+	0x40, 0x1e, 0xc3, 0xea, 0x38, 0x08, 0x35, 0xca, 0xa9, 0xf7, 0xe9, 0xbf, 0x7b, 0x3f, 0xb0, 0xea,
+	0xf7, 0x7f, 0x60, 0x3d, 0xfd, 0x06, 0x36, 0x0a, 0x93, 0x46, 0x9a, 0xd0, 0x78, 0x33, 0xfc, 0x71,
+	0x78, 0xfe, 0x76, 0xd8, 0xfe, 0x1f, 0x69, 0x43, 0x6b, 0x30, 0x1c, 0xbc, 0x1e, 0xf4, 0xce, 0x06,
+	0x97, 0x83, 0xe1, 0x69, 0xbb, 0x46, 0xd6, 0x61, 0x85, 0xf6, 0x7b, 0x27, 0xbf, 0xb6, 0xeb, 0xce,
+	0xaa, 0xc9, 0xfa, 0xe8, 0x9f, 0x01, 0x00, 0xf6, 0x8d, 0x65, 0x77, 0xc5, 0x0a, 0x00, 0x00,
 }
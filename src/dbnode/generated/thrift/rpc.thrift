@@ -69,6 +69,9 @@ service Node {
 	void                           writeTaggedBatchRawV2(1: WriteTaggedBatchRawV2Request req) throws (1: WriteBatchRawErrors err)
 	void                           repair() throws (1: Error err)
 	TruncateResult                 truncate(1: TruncateRequest req) throws (1: Error err)
+	DeleteSeriesResult             deleteSeries(1: DeleteSeriesRequest req) throws (1: Error err)
+	BackupResult                   backup(1: BackupRequest req) throws (1: Error err)
+	FetchExemplarsResult           fetchExemplars(1: FetchExemplarsRequest req) throws (1: Error err)
 
 	AggregateTilesResult aggregateTiles(1: AggregateTilesRequest req) throws (1: Error err)
 
@@ -322,6 +325,59 @@ struct TruncateResult {
 	1: required i64 numSeries
 }
 
+struct DeleteSeriesRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	6: optional binary source
+}
+
+struct DeleteSeriesResult {
+	1: required i64 numSeries
+}
+
+struct BackupRequest {
+	1: required binary nameSpace
+	2: optional string backupID
+}
+
+struct BackupResult {
+	1: required string id
+	2: required i64 numFiles
+	3: required i64 numUploadedFiles
+	4: required i64 numUploadedBytes
+	5: required bool clusterComplete
+}
+
+struct FetchExemplarsRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	6: optional i64 limit
+}
+
+struct FetchExemplarsResult {
+	1: required list<FetchExemplarsIDResult> elements
+}
+
+struct FetchExemplarsIDResult {
+	1: required binary id
+	2: required binary encodedTags
+	3: required list<Exemplar> exemplars
+}
+
+struct Exemplar {
+	// encodedLabels are the labels of the exemplar, encoded like series tags.
+	1: required binary encodedLabels
+	2: required double value
+	// timestamp is in nanoseconds.
+	3: required i64 timestamp
+}
+
 struct NodeHealthResult {
 	1: required bool ok
 	2: required string status
@@ -410,6 +466,7 @@ struct AggregateQueryRawRequest {
 	10: optional i64 docsLimit
 	11: optional bool requireExhaustive
 	12: optional bool requireNoWait
+	13: optional bool includeDocsCount
 }
 
 struct AggregateQueryRawResult {
@@ -425,6 +482,7 @@ struct AggregateQueryRawResultTagNameElement {
 
 struct AggregateQueryRawResultTagValueElement {
 	1: required binary tagValue
+	2: optional i64 docsCount
 }
 
 // AggregateQueryRequest is identical to AggregateQueryRawRequest save for using string instead of binary for types.
@@ -29,6 +29,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/topology"
 )
@@ -344,6 +345,12 @@ type TestOptions interface {
 
 	// FetchSeriesBlocksBatchSize gets the batch size for fetching series blocks in batch.
 	FetchSeriesBlocksBatchSize() int
+
+	// SetBlockCompactionTiers sets the block compaction tiers of the nodes.
+	SetBlockCompactionTiers(value []storage.BlockCompactionTier) TestOptions
+
+	// BlockCompactionTiers returns the block compaction tiers of the nodes.
+	BlockCompactionTiers() []storage.BlockCompactionTier
 }
 
 type options struct {
@@ -385,6 +392,7 @@ type options struct {
 	reportInterval                                     time.Duration
 	storageOptsFn                                      StorageOption
 	customAdminOpts                                    []client.CustomAdminOption
+	blockCompactionTiers                               []storage.BlockCompactionTier
 }
 
 // NewTestOptions returns a new set of integration test options.
@@ -806,3 +814,13 @@ func (o *options) SetFetchSeriesBlocksBatchSize(value int) TestOptions {
 func (o *options) FetchSeriesBlocksBatchSize() int {
 	return o.fetchSeriesBlocksBatchSize
 }
+
+func (o *options) SetBlockCompactionTiers(value []storage.BlockCompactionTier) TestOptions {
+	opts := *o
+	opts.blockCompactionTiers = value
+	return &opts
+}
+
+func (o *options) BlockCompactionTiers() []storage.BlockCompactionTier {
+	return o.blockCompactionTiers
+}
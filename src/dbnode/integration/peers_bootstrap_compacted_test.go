@@ -0,0 +1,151 @@
+//go:build integration
+// +build integration
+
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/integration/generate"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// TestPeersBootstrapCompacted verifies that a node bootstraps from a peer the
+// blocks the peer has compacted into the filesets of larger blocks.
+func TestPeersBootstrapCompacted(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	// Test setups
+	log := xtest.NewLogger(t)
+	retentionOpts := retention.NewOptions().
+		SetRetentionPeriod(20 * time.Hour).
+		SetBlockSize(2 * time.Hour).
+		SetBufferPast(10 * time.Minute).
+		SetBufferFuture(2 * time.Minute)
+	namesp, err := namespace.NewMetadata(testNamespaces[0], namespace.NewOptions().SetRetentionOptions(retentionOpts))
+	require.NoError(t, err)
+
+	blockSize := retentionOpts.BlockSize()
+	compactedBlockSize := 2 * blockSize
+	opts := NewTestOptions(t).
+		SetNamespaces([]namespace.Metadata{namesp}).
+		// Use TChannel clients for writing / reading because we want to target individual nodes at a time
+		// and not write/read all nodes in the cluster.
+		SetUseTChannelClientForWriting(true).
+		SetUseTChannelClientForReading(true).
+		// Blocks are compacted below before the nodes start, the age of the
+		// tier prevents the nodes from compacting any other block.
+		SetBlockCompactionTiers([]storage.BlockCompactionTier{
+			{Age: retentionOpts.RetentionPeriod(), BlockSize: compactedBlockSize},
+		})
+
+	setupOpts := []BootstrappableTestSetupOptions{
+		{DisablePeersBootstrapper: true},
+		{
+			DisableCommitLogBootstrapper: true,
+			DisablePeersBootstrapper:     false,
+		},
+	}
+	setups, closeFn := NewDefaultBootstrappableTestSetups(t, opts, setupOpts)
+	defer closeFn()
+
+	// Write test data for first node, with series that only have datapoints
+	// in one of the blocks of the compacted block.
+	now := setups[0].NowFn()()
+	compactedStart := now.Add(-4 * blockSize).Truncate(compactedBlockSize)
+	inputData := []generate.BlockConfig{
+		{IDs: []string{"foo", "baz"}, NumPoints: 90, Start: compactedStart.Add(-blockSize)},
+		{IDs: []string{"foo", "bar"}, NumPoints: 90, Start: compactedStart},
+		{IDs: []string{"foo", "baz"}, NumPoints: 90, Start: compactedStart.Add(blockSize)},
+		{IDs: []string{"foo", "baz"}, NumPoints: 90, Start: now.Add(-blockSize)},
+		{IDs: []string{"foo", "baz"}, NumPoints: 90, Start: now},
+	}
+	seriesMaps := generate.BlocksByStart(inputData)
+	require.NoError(t, writeTestDataToDisk(namesp, setups[0], seriesMaps, 0))
+
+	// Compact the blocks of the compacted block on the first node and remove
+	// the filesets they replace, as the compacted filesets cleanup does.
+	var (
+		storageOpts = setups[0].StorageOpts()
+		fsOpts      = storageOpts.CommitLogOptions().FilesystemOptions()
+		blockStarts = []xtime.UnixNano{compactedStart, compactedStart.Add(blockSize)}
+	)
+	for _, shard := range setups[0].ShardSet().AllIDs() {
+		var (
+			fileSets = make([]fs.FileSetFileIdentifier, 0, len(blockStarts))
+			readers  = make([]fs.DataFileSetReader, 0, len(blockStarts))
+		)
+		for _, blockStart := range blockStarts {
+			fileSets = append(fileSets, fs.FileSetFileIdentifier{BlockStart: blockStart})
+			reader, err := fs.NewReader(storageOpts.BytesPool(), fsOpts)
+			require.NoError(t, err)
+			readers = append(readers, reader)
+		}
+		writer, err := fs.NewStreamingWriter(fsOpts)
+		require.NoError(t, err)
+		require.NoError(t, fs.CompactFileSets(readers, writer, fs.CompactFileSetsOptions{
+			NamespaceID:             namesp.ID(),
+			Shard:                   shard,
+			BlockStart:              compactedStart,
+			BlockSize:               compactedBlockSize,
+			VolumeIndex:             1,
+			FileSets:                fileSets,
+			MultiReaderIteratorPool: storageOpts.MultiReaderIteratorPool(),
+			EncoderPool:             storageOpts.EncoderPool(),
+		}))
+		for _, blockStart := range blockStarts {
+			require.NoError(t, fs.DeleteFileSetAt(fsOpts.FilePathPrefix(),
+				namesp.ID(), shard, blockStart, 0))
+		}
+	}
+
+	// Start the first server with filesystem bootstrapper
+	require.NoError(t, setups[0].StartServer())
+
+	// Start the last server with peers and filesystem bootstrappers
+	require.NoError(t, setups[1].StartServer())
+	log.Debug("servers are now up")
+
+	// Stop the servers
+	defer func() {
+		setups.parallel(func(s TestSetup) {
+			require.NoError(t, s.StopServer())
+		})
+		log.Debug("servers are now down")
+	}()
+
+	// Verify in-memory data match what we expect
+	for _, setup := range setups {
+		verifySeriesMaps(t, setup, namesp.ID(), seriesMaps)
+	}
+}
@@ -413,6 +413,15 @@ func NewTestSetup(
 			SetClockOptions(storageOpts.ClockOptions())
 	}
 
+	if tiers := opts.BlockCompactionTiers(); len(tiers) > 0 {
+		blockSizes := make([]time.Duration, 0, len(tiers))
+		for _, tier := range tiers {
+			blockSizes = append(blockSizes, tier.BlockSize)
+		}
+		storageOpts = storageOpts.SetBlockCompactionTiers(tiers)
+		fsOpts = fsOpts.SetCompactedBlockSizes(blockSizes)
+	}
+
 	storageOpts = storageOpts.SetCommitLogOptions(
 		storageOpts.CommitLogOptions().
 			SetFilesystemOptions(fsOpts))
@@ -447,7 +456,9 @@ func NewTestSetup(
 			blockRetrieverMgr := block.NewDatabaseBlockRetrieverManager(
 				func(md namespace.Metadata, shardSet sharding.ShardSet) (block.DatabaseBlockRetriever, error) {
 					retrieverOpts := fs.NewBlockRetrieverOptions().
-						SetBlockLeaseManager(blockLeaseManager)
+						SetBlockLeaseManager(blockLeaseManager).
+						SetMultiReaderIteratorPool(storageOpts.MultiReaderIteratorPool()).
+						SetEncoderPool(storageOpts.EncoderPool())
 					retriever, err := fs.NewBlockRetriever(retrieverOpts, fsOpts)
 					if err != nil {
 						return nil, err
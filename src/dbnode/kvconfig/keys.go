@@ -52,4 +52,8 @@ const (
 
 	// QueryLimits is the KV config key for query limits enforced on each dbnode.
 	QueryLimits = "m3db.query.limits"
+
+	// TenantQuotas is the KV config key for per-tenant write and query quotas
+	// enforced by the coordinator.
+	TenantQuotas = "m3db.query.tenant-quotas"
 )
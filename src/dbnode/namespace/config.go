@@ -48,16 +48,17 @@ func (m *MapConfiguration) Map() (Map, error) {
 
 // MetadataConfiguration is the configuration for a single namespace
 type MetadataConfiguration struct {
-	ID                    string                  `yaml:"id" validate:"nonzero"`
-	BootstrapEnabled      *bool                   `yaml:"bootstrapEnabled"`
-	FlushEnabled          *bool                   `yaml:"flushEnabled"`
-	WritesToCommitLog     *bool                   `yaml:"writesToCommitLog"`
-	CleanupEnabled        *bool                   `yaml:"cleanupEnabled"`
-	RepairEnabled         *bool                   `yaml:"repairEnabled"`
-	ColdWritesEnabled     *bool                   `yaml:"coldWritesEnabled"`
-	CacheBlocksOnRetrieve *bool                   `yaml:"cacheBlocksOnRetrieve"`
-	Retention             retention.Configuration `yaml:"retention" validate:"nonzero"`
-	Index                 IndexConfiguration      `yaml:"index"`
+	ID                    string                           `yaml:"id" validate:"nonzero"`
+	BootstrapEnabled      *bool                            `yaml:"bootstrapEnabled"`
+	FlushEnabled          *bool                            `yaml:"flushEnabled"`
+	WritesToCommitLog     *bool                            `yaml:"writesToCommitLog"`
+	CleanupEnabled        *bool                            `yaml:"cleanupEnabled"`
+	RepairEnabled         *bool                            `yaml:"repairEnabled"`
+	ColdWritesEnabled     *bool                            `yaml:"coldWritesEnabled"`
+	CacheBlocksOnRetrieve *bool                            `yaml:"cacheBlocksOnRetrieve"`
+	Retention             retention.Configuration          `yaml:"retention" validate:"nonzero"`
+	Index                 IndexConfiguration               `yaml:"index"`
+	RetentionOverrides    []RetentionOverrideConfiguration `yaml:"retentionOverrides"`
 }
 
 // Metadata returns a Metadata corresponding to the receiver struct
@@ -88,6 +89,13 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.CacheBlocksOnRetrieve; v != nil {
 		opts = opts.SetCacheBlocksOnRetrieve(*v)
 	}
+	if len(mc.RetentionOverrides) > 0 {
+		overrides := make([]RetentionOverride, 0, len(mc.RetentionOverrides))
+		for _, o := range mc.RetentionOverrides {
+			overrides = append(overrides, o.RetentionOverride())
+		}
+		opts = opts.SetRetentionOverrides(overrides)
+	}
 	return NewMetadata(ident.StringID(mc.ID), opts)
 }
 
@@ -103,3 +111,18 @@ func (ic *IndexConfiguration) Options() IndexOptions {
 		SetEnabled(ic.Enabled).
 		SetBlockSize(ic.BlockSize)
 }
+
+// RetentionOverrideConfiguration overrides the retention period of the
+// series matching a tags filter.
+type RetentionOverrideConfiguration struct {
+	Filter          string        `yaml:"filter" validate:"nonzero"`
+	RetentionPeriod time.Duration `yaml:"retentionPeriod" validate:"nonzero"`
+}
+
+// RetentionOverride returns the RetentionOverride corresponding to the receiver struct.
+func (c RetentionOverrideConfiguration) RetentionOverride() RetentionOverride {
+	return RetentionOverride{
+		Filter:          c.Filter,
+		RetentionPeriod: c.RetentionPeriod,
+	}
+}
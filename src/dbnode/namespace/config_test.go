@@ -166,6 +166,9 @@ metadatas:
     index:
       enabled: true
       blockSize: 24h
+    retentionOverrides:
+      - filter: "app:debug*"
+        retentionPeriod: 48h
 `)
 
 	var conf MapConfiguration
@@ -230,5 +233,8 @@ metadatas:
 		SetBufferFuture(10 * time.Minute).
 		SetBufferPast(10 * time.Minute)
 	require.True(t, testRetentionOpts.Equal(opts.RetentionOptions()))
+	require.Equal(t, []RetentionOverride{
+		{Filter: "app:debug*", RetentionPeriod: 48 * time.Hour},
+	}, opts.RetentionOverrides())
 
 }
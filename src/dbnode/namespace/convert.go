@@ -210,7 +210,8 @@ func ToMetadata(
 		SetRuntimeOptions(runtimeOpts).
 		SetExtendedOptions(extendedOpts).
 		SetAggregationOptions(aggOpts).
-		SetStagingState(stagingState)
+		SetStagingState(stagingState).
+		SetRetentionOverrides(ToRetentionOverrides(opts.RetentionOverrides))
 
 	if opts.CacheBlocksOnRetrieve != nil {
 		mOpts = mOpts.SetCacheBlocksOnRetrieve(opts.CacheBlocksOnRetrieve.Value)
@@ -223,6 +224,22 @@ func ToMetadata(
 	return NewMetadata(ident.StringID(id), mOpts)
 }
 
+// ToRetentionOverrides converts nsproto.RetentionOverride to RetentionOverride.
+func ToRetentionOverrides(overrides []*nsproto.RetentionOverride) []RetentionOverride {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	result := make([]RetentionOverride, 0, len(overrides))
+	for _, override := range overrides {
+		result = append(result, RetentionOverride{
+			Filter:          override.Filter,
+			RetentionPeriod: time.Duration(override.RetentionPeriodNanos),
+		})
+	}
+	return result
+}
+
 // ToStagingState converts nsproto.StagingState to StagingState.
 func ToStagingState(state *nsproto.StagingState) (StagingState, error) {
 	if state == nil {
@@ -340,11 +357,27 @@ func OptionsToProto(opts Options) (*nsproto.NamespaceOptions, error) {
 		ExtendedOptions:       extendedOpts,
 		AggregationOptions:    toProtoAggregationOptions(opts.AggregationOptions()),
 		StagingState:          stagingState,
+		RetentionOverrides:    toProtoRetentionOverrides(opts.RetentionOverrides()),
 	}
 
 	return nsOpts, nil
 }
 
+func toProtoRetentionOverrides(overrides []RetentionOverride) []*nsproto.RetentionOverride {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	result := make([]*nsproto.RetentionOverride, 0, len(overrides))
+	for _, override := range overrides {
+		result = append(result, &nsproto.RetentionOverride{
+			Filter:               override.Filter,
+			RetentionPeriodNanos: override.RetentionPeriod.Nanoseconds(),
+		})
+	}
+	return result
+}
+
 func toProtoStagingState(state StagingState) (*nsproto.StagingState, error) {
 	var protoStatus nsproto.StagingStatus
 	switch state.Status() {
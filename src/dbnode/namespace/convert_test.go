@@ -76,6 +76,9 @@ var (
 			SchemaOptions:         testSchemaOptions,
 			ExtendedOptions:       validExtendedOpts,
 			StagingState:          &nsproto.StagingState{Status: nsproto.StagingStatus_INITIALIZING},
+			RetentionOverrides: []*nsproto.RetentionOverride{
+				{Filter: "app:debug*", RetentionPeriodNanos: toNanos(240)}, // 4h
+			},
 		},
 		{
 			BootstrapEnabled:  true,
@@ -423,6 +426,19 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	assertEqualRetentions(t, *expected.RetentionOptions, opts.RetentionOptions())
 	assertEqualStagingState(t, expected.StagingState, opts.StagingState())
 	assertEqualExtendedOpts(t, expected.ExtendedOptions, opts.ExtendedOptions())
+	assertEqualRetentionOverrides(t, expected.RetentionOverrides, opts.RetentionOverrides())
+}
+
+func assertEqualRetentionOverrides(
+	t *testing.T,
+	expected []*nsproto.RetentionOverride,
+	observed []namespace.RetentionOverride,
+) {
+	require.Equal(t, len(expected), len(observed))
+	for i := range expected {
+		require.Equal(t, expected[i].Filter, observed[i].Filter)
+		require.Equal(t, expected[i].RetentionPeriodNanos, observed[i].RetentionPeriod.Nanoseconds())
+	}
 }
 
 func assertEqualRetentions(t *testing.T, expected nsproto.RetentionOptions, observed retention.Options) {
@@ -215,6 +215,20 @@ func (mr *MockOptionsMockRecorder) RetentionOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetentionOptions", reflect.TypeOf((*MockOptions)(nil).RetentionOptions))
 }
 
+// RetentionOverrides mocks base method.
+func (m *MockOptions) RetentionOverrides() []RetentionOverride {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetentionOverrides")
+	ret0, _ := ret[0].([]RetentionOverride)
+	return ret0
+}
+
+// RetentionOverrides indicates an expected call of RetentionOverrides.
+func (mr *MockOptionsMockRecorder) RetentionOverrides() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetentionOverrides", reflect.TypeOf((*MockOptions)(nil).RetentionOverrides))
+}
+
 // RuntimeOptions mocks base method.
 func (m *MockOptions) RuntimeOptions() RuntimeOptions {
 	m.ctrl.T.Helper()
@@ -383,6 +397,20 @@ func (mr *MockOptionsMockRecorder) SetRetentionOptions(value interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionOptions", reflect.TypeOf((*MockOptions)(nil).SetRetentionOptions), value)
 }
 
+// SetRetentionOverrides mocks base method.
+func (m *MockOptions) SetRetentionOverrides(value []RetentionOverride) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionOverrides", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetRetentionOverrides indicates an expected call of SetRetentionOverrides.
+func (mr *MockOptionsMockRecorder) SetRetentionOverrides(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionOverrides", reflect.TypeOf((*MockOptions)(nil).SetRetentionOverrides), value)
+}
+
 // SetRuntimeOptions mocks base method.
 func (m *MockOptions) SetRuntimeOptions(value RuntimeOptions) Options {
 	m.ctrl.T.Helper()
@@ -77,6 +77,7 @@ type options struct {
 	extendedOpts          ExtendedOptions
 	aggregationOpts       AggregationOptions
 	stagingState          StagingState
+	retentionOverrides    []RetentionOverride
 }
 
 // NewSchemaHistory returns an empty schema history.
@@ -118,6 +119,10 @@ func (o *options) Validate() error {
 		return err
 	}
 
+	if err := validateRetentionOverrides(o.retentionOpts, o.retentionOverrides); err != nil {
+		return err
+	}
+
 	if !o.indexOpts.Enabled() {
 		return nil
 	}
@@ -160,7 +165,8 @@ func (o *options) Equal(value Options) bool {
 		o.schemaHis.Equal(value.SchemaHistory()) &&
 		o.runtimeOpts.Equal(value.RuntimeOptions()) &&
 		o.aggregationOpts.Equal(value.AggregationOptions()) &&
-		o.stagingState == value.StagingState()
+		o.stagingState == value.StagingState() &&
+		retentionOverridesEqual(o.retentionOverrides, value.RetentionOverrides())
 }
 
 func (o *options) SetBootstrapEnabled(value bool) Options {
@@ -312,3 +318,13 @@ func (o *options) SetStagingState(value StagingState) Options {
 func (o *options) StagingState() StagingState {
 	return o.stagingState
 }
+
+func (o *options) SetRetentionOverrides(value []RetentionOverride) Options {
+	opts := *o
+	opts.retentionOverrides = value
+	return &opts
+}
+
+func (o *options) RetentionOverrides() []RetentionOverride {
+	return o.retentionOverrides
+}
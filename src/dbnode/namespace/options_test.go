@@ -217,3 +217,41 @@ func TestOptionsValidateStagingStatus(t *testing.T) {
 	o1 = o1.SetStagingState(StagingState{status: StagingStatus(12)})
 	require.Error(t, o1.Validate())
 }
+
+func TestOptionsValidateRetentionOverrides(t *testing.T) {
+	rOpts := retention.NewOptions().
+		SetRetentionPeriod(48 * time.Hour).
+		SetBlockSize(2 * time.Hour)
+	opts := NewOptions().SetRetentionOptions(rOpts)
+
+	opts = opts.SetRetentionOverrides([]RetentionOverride{
+		{Filter: "app:debug* env:dev", RetentionPeriod: 4 * time.Hour},
+		{Filter: "app:slo", RetentionPeriod: 48 * time.Hour},
+	})
+	require.NoError(t, opts.Validate())
+
+	invalid := []RetentionOverride{
+		{Filter: "", RetentionPeriod: 4 * time.Hour},
+		{Filter: "app", RetentionPeriod: 4 * time.Hour},
+		{Filter: "app:debug*", RetentionPeriod: time.Hour},
+		{Filter: "app:debug*", RetentionPeriod: 72 * time.Hour},
+	}
+	for _, override := range invalid {
+		opts = opts.SetRetentionOverrides([]RetentionOverride{override})
+		require.Error(t, opts.Validate(), "override %+v", override)
+	}
+}
+
+func TestOptionsEqualsRetentionOverrides(t *testing.T) {
+	o1 := NewOptions()
+	o2 := o1.SetRetentionOverrides([]RetentionOverride{
+		{Filter: "app:debug*", RetentionPeriod: time.Hour},
+	})
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+
+	o3 := o1.SetRetentionOverrides([]RetentionOverride{
+		{Filter: "app:debug*", RetentionPeriod: time.Hour},
+	})
+	require.True(t, o2.Equal(o3))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/metrics/filters"
+)
+
+var (
+	errRetentionOverrideFilterEmpty = errors.New("retention override filter must not be empty")
+)
+
+// RetentionOverride overrides the retention period of the series in a
+// namespace whose tags match a filter, overrides are evaluated in order and
+// the first one matching a series applies to it.
+type RetentionOverride struct {
+	// Filter is the tags filter selecting the series, e.g. "app:debug* env:dev".
+	Filter string
+	// RetentionPeriod is the retention period of the series matching the filter.
+	RetentionPeriod time.Duration
+}
+
+func validateRetentionOverrides(
+	retentionOpts retention.Options,
+	overrides []RetentionOverride,
+) error {
+	for i, override := range overrides {
+		if override.Filter == "" {
+			return fmt.Errorf("retention override %d: %w", i, errRetentionOverrideFilterEmpty)
+		}
+		if _, err := filters.ValidateTagsFilter(override.Filter); err != nil {
+			return fmt.Errorf("retention override %d: %w", i, err)
+		}
+		if override.RetentionPeriod < retentionOpts.BlockSize() {
+			return fmt.Errorf("retention override %d: retention period %v must be >= block size %v",
+				i, override.RetentionPeriod, retentionOpts.BlockSize())
+		}
+		if override.RetentionPeriod > retentionOpts.RetentionPeriod() {
+			return fmt.Errorf("retention override %d: retention period %v must be <= namespace retention period %v",
+				i, override.RetentionPeriod, retentionOpts.RetentionPeriod())
+		}
+	}
+	return nil
+}
+
+func retentionOverridesEqual(a, b []RetentionOverride) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -131,6 +131,14 @@ type Options interface {
 
 	// StagingState returns the state related to a namespace's availability for use.
 	StagingState() StagingState
+
+	// SetRetentionOverrides sets the retention overrides of the series matching
+	// tag filters, the namespace retention period applies to all other series.
+	SetRetentionOverrides(value []RetentionOverride) Options
+
+	// RetentionOverrides returns the retention overrides of the series matching
+	// tag filters, the namespace retention period applies to all other series.
+	RetentionOverrides() []RetentionOverride
 }
 
 // IndexOptions controls the indexing options for a namespace.
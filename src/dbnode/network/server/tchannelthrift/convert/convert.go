@@ -329,6 +329,127 @@ func ToRPCFetchTaggedRequest(
 	return request, nil
 }
 
+// FromRPCDeleteSeriesRequest converts the rpc request type for DeleteSeriesRequest
+// into corresponding Go API types.
+func FromRPCDeleteSeriesRequest(
+	req *rpc.DeleteSeriesRequest,
+) (ident.ID, index.Query, xtime.UnixNano, xtime.UnixNano, error) {
+	start, err := ToTime(req.RangeStart, req.RangeTimeType)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, err
+	}
+
+	end, err := ToTime(req.RangeEnd, req.RangeTimeType)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, err
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, err
+	}
+
+	ns := ident.StringID(string(req.NameSpace))
+	return ns, index.Query{Query: q}, start, end, nil
+}
+
+// ToRPCDeleteSeriesRequest converts the Go `client/` types into rpc request type
+// for DeleteSeriesRequest.
+func ToRPCDeleteSeriesRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (rpc.DeleteSeriesRequest, error) {
+	rangeStart, err := ToValue(start, fetchTaggedTimeType)
+	if err != nil {
+		return rpc.DeleteSeriesRequest{}, err
+	}
+
+	rangeEnd, err := ToValue(end, fetchTaggedTimeType)
+	if err != nil {
+		return rpc.DeleteSeriesRequest{}, err
+	}
+
+	query, err := idx.Marshal(q.Query)
+	if err != nil {
+		return rpc.DeleteSeriesRequest{}, err
+	}
+
+	return rpc.DeleteSeriesRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}, nil
+}
+
+// FromRPCFetchExemplarsRequest converts the rpc request type for
+// FetchExemplarsRequest into corresponding Go API types.
+func FromRPCFetchExemplarsRequest(
+	req *rpc.FetchExemplarsRequest,
+) (ident.ID, index.Query, xtime.UnixNano, xtime.UnixNano, int, error) {
+	start, err := ToTime(req.RangeStart, req.RangeTimeType)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, 0, err
+	}
+
+	end, err := ToTime(req.RangeEnd, req.RangeTimeType)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, 0, err
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, 0, err
+	}
+
+	var limit int
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+
+	ns := ident.StringID(string(req.NameSpace))
+	return ns, index.Query{Query: q}, start, end, limit, nil
+}
+
+// ToRPCFetchExemplarsRequest converts the Go `client/` types into rpc request
+// type for FetchExemplarsRequest.
+func ToRPCFetchExemplarsRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+	limit int,
+) (rpc.FetchExemplarsRequest, error) {
+	rangeStart, err := ToValue(start, fetchTaggedTimeType)
+	if err != nil {
+		return rpc.FetchExemplarsRequest{}, err
+	}
+
+	rangeEnd, err := ToValue(end, fetchTaggedTimeType)
+	if err != nil {
+		return rpc.FetchExemplarsRequest{}, err
+	}
+
+	query, err := idx.Marshal(q.Query)
+	if err != nil {
+		return rpc.FetchExemplarsRequest{}, err
+	}
+
+	request := rpc.FetchExemplarsRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}
+	if limit > 0 {
+		l := int64(limit)
+		request.Limit = &l
+	}
+	return request, nil
+}
+
 // FromRPCAggregateQueryRequest converts the rpc request type for AggregateRawQueryRequest into corresponding Go API types.
 func FromRPCAggregateQueryRequest(
 	req *rpc.AggregateQueryRequest,
@@ -419,6 +540,9 @@ func FromRPCAggregateQueryRawRequest(
 	if r := req.RequireNoWait; r != nil {
 		opts.RequireNoWait = *r
 	}
+	if r := req.IncludeDocsCount; r != nil {
+		opts.IncludeDocsCount = *r
+	}
 
 	if len(req.Source) > 0 {
 		opts.Source = req.Source
@@ -485,6 +609,10 @@ func ToRPCAggregateQueryRawRequest(
 		r := opts.RequireNoWait
 		request.RequireNoWait = &r
 	}
+	if opts.IncludeDocsCount {
+		r := opts.IncludeDocsCount
+		request.IncludeDocsCount = &r
+	}
 
 	if len(opts.Source) > 0 {
 		request.Source = opts.Source
@@ -180,12 +180,88 @@ func TestConvertFetchTaggedRequest(t *testing.T) {
 	}
 }
 
+func TestConvertDeleteSeriesRequest(t *testing.T) {
+	var (
+		ns    = ident.StringID("abc")
+		end   = xtime.Now().Truncate(time.Second)
+		start = end.Add(-2 * time.Hour)
+	)
+	q, rpcQ := conjunctionQueryATestCase(t)
+
+	req, err := convert.ToRPCDeleteSeriesRequest(ns, index.Query{Query: q}, start, end)
+	require.NoError(t, err)
+	require.Equal(t, rpc.DeleteSeriesRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         rpcQ,
+		RangeStart:    mustToRPCTime(t, start),
+		RangeEnd:      mustToRPCTime(t, end),
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+	}, req)
+
+	id, observedQuery, observedStart, observedEnd, err := convert.FromRPCDeleteSeriesRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.True(t, index.NewQueryMatcher(index.Query{Query: q}).Matches(observedQuery))
+	require.Equal(t, start, observedStart)
+	require.Equal(t, end, observedEnd)
+
+	// Ranges default to seconds when the time type is not set.
+	id, _, observedStart, observedEnd, err = convert.FromRPCDeleteSeriesRequest(&rpc.DeleteSeriesRequest{
+		NameSpace:  ns.Bytes(),
+		Query:      rpcQ,
+		RangeStart: start.Seconds(),
+		RangeEnd:   end.Seconds(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.Equal(t, start, observedStart)
+	require.Equal(t, end, observedEnd)
+}
+
+func TestConvertFetchExemplarsRequest(t *testing.T) {
+	var (
+		ns    = ident.StringID("abc")
+		end   = xtime.Now().Truncate(time.Second)
+		start = end.Add(-2 * time.Hour)
+		limit = int64(10)
+	)
+	q, rpcQ := conjunctionQueryATestCase(t)
+
+	req, err := convert.ToRPCFetchExemplarsRequest(ns, index.Query{Query: q}, start, end, int(limit))
+	require.NoError(t, err)
+	require.Equal(t, rpc.FetchExemplarsRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         rpcQ,
+		RangeStart:    mustToRPCTime(t, start),
+		RangeEnd:      mustToRPCTime(t, end),
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+		Limit:         &limit,
+	}, req)
+
+	id, observedQuery, observedStart, observedEnd, observedLimit, err := convert.FromRPCFetchExemplarsRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.True(t, index.NewQueryMatcher(index.Query{Query: q}).Matches(observedQuery))
+	require.Equal(t, start, observedStart)
+	require.Equal(t, end, observedEnd)
+	require.Equal(t, int(limit), observedLimit)
+
+	// No limit is set when the limit is zero.
+	req, err = convert.ToRPCFetchExemplarsRequest(ns, index.Query{Query: q}, start, end, 0)
+	require.NoError(t, err)
+	require.Nil(t, req.Limit)
+	_, _, _, _, observedLimit, err = convert.FromRPCFetchExemplarsRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, 0, observedLimit)
+}
+
 func TestConvertAggregateRawQueryRequest(t *testing.T) {
 	var (
 		seriesLimit       int64 = 10
 		docsLimit         int64 = 10
 		requireExhaustive       = true
 		requireNoWait           = true
+		includeDocsCount        = true
 		ns                      = ident.StringID("abc")
 	)
 	opts := index.AggregationOptions{
@@ -197,7 +273,8 @@ func TestConvertAggregateRawQueryRequest(t *testing.T) {
 			RequireExhaustive: requireExhaustive,
 			RequireNoWait:     requireNoWait,
 		},
-		Type: index.AggregateTagNamesAndValues,
+		Type:             index.AggregateTagNamesAndValues,
+		IncludeDocsCount: includeDocsCount,
 		FieldFilter: index.AggregateFieldFilter{
 			[]byte("some"),
 			[]byte("string"),
@@ -211,6 +288,7 @@ func TestConvertAggregateRawQueryRequest(t *testing.T) {
 		DocsLimit:         &docsLimit,
 		RequireExhaustive: &requireExhaustive,
 		RequireNoWait:     &requireNoWait,
+		IncludeDocsCount:  &includeDocsCount,
 		TagNameFilter: [][]byte{
 			[]byte("some"),
 			[]byte("string"),
@@ -114,6 +114,9 @@ type serviceMetrics struct {
 	fetchBlocksMetadata     instrument.MethodMetrics
 	repair                  instrument.MethodMetrics
 	truncate                instrument.MethodMetrics
+	deleteSeries            instrument.MethodMetrics
+	backup                  instrument.MethodMetrics
+	fetchExemplars          instrument.MethodMetrics
 	fetchBatchRawRPCS       tally.Counter
 	fetchBatchRaw           instrument.BatchMethodMetrics
 	writeBatchRawRPCs       tally.Counter
@@ -138,6 +141,9 @@ func newServiceMetrics(scope tally.Scope, opts instrument.TimerOptions) serviceM
 		fetchBlocksMetadata:     instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		repair:                  instrument.NewMethodMetrics(scope, "repair", opts),
 		truncate:                instrument.NewMethodMetrics(scope, "truncate", opts),
+		deleteSeries:            instrument.NewMethodMetrics(scope, "deleteSeries", opts),
+		backup:                  instrument.NewMethodMetrics(scope, "backup", opts),
+		fetchExemplars:          instrument.NewMethodMetrics(scope, "fetchExemplars", opts),
 		fetchBatchRawRPCS:       scope.Counter("fetchBatchRaw-rpcs"),
 		fetchBatchRaw:           instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", opts),
 		writeBatchRawRPCs:       scope.Counter("writeBatchRaw-rpcs"),
@@ -1243,10 +1249,16 @@ func (s *service) AggregateRaw(tctx thrift.Context, req *rpc.AggregateQueryRawRe
 		if tagValues.HasValues() {
 			tagValuesMap := tagValues.Map()
 			responseElem.TagValues = make([]*rpc.AggregateQueryRawResultTagValueElement, 0, tagValuesMap.Len())
+			includeDocsCount := results.AggregateResultsOptions().IncludeDocsCount
 			for _, entry := range tagValuesMap.Iter() {
-				responseElem.TagValues = append(responseElem.TagValues, &rpc.AggregateQueryRawResultTagValueElement{
+				elem := &rpc.AggregateQueryRawResultTagValueElement{
 					TagValue: entry.Key().Bytes(),
-				})
+				}
+				if includeDocsCount {
+					docsCount := entry.Value()
+					elem.DocsCount = &docsCount
+				}
+				responseElem.TagValues = append(responseElem.TagValues, elem)
 			}
 		}
 		response.Results = append(response.Results, responseElem)
@@ -2211,6 +2223,136 @@ func (s *service) Truncate(tctx thrift.Context, req *rpc.TruncateRequest) (r *rp
 	return res, nil
 }
 
+func (s *service) DeleteSeries(
+	tctx thrift.Context,
+	req *rpc.DeleteSeriesRequest,
+) (*rpc.DeleteSeriesResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, start, end, err := convert.FromRPCDeleteSeriesRequest(req)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	deleted, err := db.DeleteSeries(ctx, ns, query, start, end)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewDeleteSeriesResult_()
+	res.NumSeries = deleted
+
+	s.metrics.deleteSeries.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
+func (s *service) Backup(
+	tctx thrift.Context,
+	req *rpc.BackupRequest,
+) (*rpc.BackupResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+	result, err := db.Backup(s.newID(ctx, req.NameSpace), storage.BackupOptions{
+		ID: req.GetBackupID(),
+	})
+	if err != nil {
+		s.metrics.backup.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewBackupResult_()
+	res.ID = result.ID
+	res.NumFiles = int64(result.NumFiles)
+	res.NumUploadedFiles = int64(result.NumUploadedFiles)
+	res.NumUploadedBytes = result.NumUploadedBytes
+	res.ClusterComplete = result.ClusterComplete
+
+	s.metrics.backup.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
+func (s *service) FetchExemplars(
+	tctx thrift.Context,
+	req *rpc.FetchExemplarsRequest,
+) (*rpc.FetchExemplarsResult_, error) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted(tctx)
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, start, end, limit, err := convert.FromRPCFetchExemplarsRequest(req)
+	if err != nil {
+		s.metrics.fetchExemplars.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	results, err := db.FetchExemplars(ctx, ns, query, start, end, limit)
+	if err != nil {
+		s.metrics.fetchExemplars.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	var (
+		res = &rpc.FetchExemplarsResult_{
+			Elements: make([]*rpc.FetchExemplarsIDResult_, 0, len(results)),
+		}
+		reader = docs.NewEncodedDocumentReader()
+		enc    = s.pools.tagEncoder.Get()
+	)
+	ctx.RegisterFinalizer(enc)
+	for _, result := range results {
+		metadata, err := docs.MetadataFromDocument(result.Document, reader)
+		if err != nil {
+			s.metrics.fetchExemplars.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+		tags := idxconvert.ToSeriesTags(metadata, idxconvert.Opts{NoClone: true})
+		encodedTags, err := encodeTags(enc, tags, s.opts.InstrumentOptions())
+		if err != nil {
+			s.metrics.fetchExemplars.ReportError(s.nowFn().Sub(callStart))
+			return nil, tterrors.NewInternalError(err)
+		}
+
+		elem := &rpc.FetchExemplarsIDResult_{
+			ID:          result.ID.Bytes(),
+			EncodedTags: append([]byte(nil), encodedTags.Bytes()...),
+			Exemplars:   make([]*rpc.Exemplar, 0, len(result.Exemplars)),
+		}
+		enc.Reset()
+		for _, exemplar := range result.Exemplars {
+			elem.Exemplars = append(elem.Exemplars, &rpc.Exemplar{
+				EncodedLabels: exemplar.EncodedLabels,
+				Value:         exemplar.Value,
+				Timestamp:     int64(exemplar.TimestampNanos),
+			})
+		}
+		res.Elements = append(res.Elements, elem)
+	}
+
+	s.metrics.fetchExemplars.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
 func (s *service) GetPersistRateLimit(
 	ctx thrift.Context,
 ) (*rpc.NodePersistRateLimitResult_, error) {
@@ -2275,6 +2275,75 @@ func TestServiceAggregate(t *testing.T) {
 		ctx.GoContext().Value(tchannelthrift.EndpointContextKey).(tchannelthrift.Endpoint).String())
 }
 
+func TestServiceAggregateDocsCount(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := xtime.Now().Add(-2 * time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	start, end = start.Truncate(time.Second), end.Truncate(time.Second)
+	nsID := "metrics"
+
+	req := idx.NewAllQuery()
+	qry := index.Query{Query: req}
+
+	resMap := index.NewAggregateResults(ident.StringID(nsID),
+		index.AggregateResultsOptions{IncludeDocsCount: true}, testIndexOptions)
+	values := index.NewAggregateValues(testIndexOptions)
+	values.Map().Set(ident.StringID("baz"), 3)
+	resMap.Map().Set(ident.StringID("bar"), values)
+
+	mockDB.EXPECT().AggregateQuery(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		index.AggregationOptions{
+			QueryOptions: index.QueryOptions{
+				StartInclusive: start,
+				EndExclusive:   end,
+			},
+			Type:             index.AggregateTagNamesAndValues,
+			IncludeDocsCount: true,
+		}).Return(
+		index.AggregateQueryResult{Results: resMap, Exhaustive: true}, nil)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	includeDocsCount := true
+	r, err := service.AggregateRaw(tctx, &rpc.AggregateQueryRawRequest{
+		NameSpace:          []byte(nsID),
+		Query:              data,
+		RangeStart:         startNanos,
+		RangeEnd:           endNanos,
+		AggregateQueryType: rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE,
+		IncludeDocsCount:   &includeDocsCount,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(r.Results))
+	require.Equal(t, "bar", string(r.Results[0].TagName))
+	require.Equal(t, 1, len(r.Results[0].TagValues))
+	require.Equal(t, "baz", string(r.Results[0].TagValues[0].TagValue))
+	require.True(t, r.Results[0].TagValues[0].IsSetDocsCount())
+	require.Equal(t, int64(3), r.Results[0].TagValues[0].GetDocsCount())
+}
+
 func TestServiceAggregateNameOnly(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
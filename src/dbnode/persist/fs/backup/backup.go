@@ -0,0 +1,508 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup implements backups of the filesets of namespaces to an
+// object store and restoring them.
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// filesKeyPrefix is the prefix of the keys of backed up files, which
+	// are the hashes of the contents of the files. Files are shared between
+	// all the backups and nodes that include files with the same contents.
+	filesKeyPrefix = "files/"
+	// manifestsKeyPrefix is the prefix of the keys of backup manifests. The
+	// manifest of the backup of a node is stored under the ID of the backup
+	// and the host ID of the node, and the backup of the cluster is only
+	// complete once its own manifest is written.
+	manifestsKeyPrefix = "manifests/"
+	manifestKeySuffix  = ".json"
+
+	idTimeFormat = "20060102T150405.000000000Z"
+)
+
+var (
+	// ErrNoBackup is returned when a namespace has no complete backups.
+	ErrNoBackup = errors.New("namespace has no backups")
+
+	errBackupExists      = errors.New("backup already exists")
+	errBackupNotComplete = errors.New("backup is not complete")
+	errBackupHostNotSet  = errors.New("backup host ID is not set")
+	errInvalidBackupID   = errors.New("backup ID must be set and not contain slashes")
+)
+
+// File is a backed up file.
+type File struct {
+	// Path is the path of the file relative to the file path prefix.
+	Path string `json:"path"`
+	// Hash is the hex encoded SHA-256 hash of the contents of the file.
+	Hash string `json:"hash"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+}
+
+// Manifest describes the backup of a namespace taken by a node.
+type Manifest struct {
+	// ID is the ID of the backup of the cluster the backup is part of,
+	// backup IDs sort by the time the backups were taken.
+	ID string `json:"id"`
+	// Namespace is the backed up namespace.
+	Namespace string `json:"namespace"`
+	// HostID is the host ID of the node.
+	HostID string `json:"hostID"`
+	// CreatedAt is the time the backup was taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// Shards are the backed up shards.
+	Shards []uint32 `json:"shards"`
+	// Files are the backed up files.
+	Files []File `json:"files"`
+	// Metadata are the documents describing the cluster at the time of the
+	// backup by name, such as the namespace and placement metadata.
+	Metadata map[string]json.RawMessage `json:"metadata,omitempty"`
+}
+
+// NodeBackup is the backup of a node that is part of a backup of a cluster.
+type NodeBackup struct {
+	// HostID is the host ID of the node.
+	HostID string `json:"hostID"`
+	// Shards are the shards backed up by the node.
+	Shards []uint32 `json:"shards"`
+}
+
+// ClusterManifest describes a complete backup of a namespace across a
+// cluster, which is made of the backups of its nodes that were taken with
+// the same ID and together cover all the shards of the cluster.
+type ClusterManifest struct {
+	// ID is the ID of the backup.
+	ID string `json:"id"`
+	// Namespace is the backed up namespace.
+	Namespace string `json:"namespace"`
+	// CreatedAt is the time the last backup of a node was taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// Nodes are the backups of the nodes ordered by host ID.
+	Nodes []NodeBackup `json:"nodes"`
+	// Metadata are the documents describing the cluster at the time of the
+	// last backup of a node by name.
+	Metadata map[string]json.RawMessage `json:"metadata,omitempty"`
+}
+
+// NewID returns the ID of a backup taken at the given time.
+func NewID(t time.Time) string {
+	return t.UTC().Format(idTimeFormat)
+}
+
+// StageOptions are the options for staging the files of a backup.
+type StageOptions struct {
+	// FilePathPrefix is the file path prefix of the backed up filesets.
+	FilePathPrefix string
+	// StagingPath is the directory the backed up files are linked into.
+	StagingPath string
+	// Namespace is the namespace to back up.
+	Namespace ident.ID
+	// Shards are the shards to back up.
+	Shards []uint32
+	// InfoReaderBufferSize is the buffer size used to read index info files.
+	InfoReaderBufferSize int
+	// NewDirectoryMode is the mode of the created staging directories.
+	NewDirectoryMode os.FileMode
+}
+
+// Stage links the files of a consistent copy of a namespace into the staging
+// path and returns the paths of the staged files relative to the file path
+// prefix. The copy includes the latest complete volume of the data and
+// snapshot filesets of every block of the shards, and all the complete index
+// volumes of the namespace. Filesets that have been offloaded to an object
+// store are not included.
+//
+// Staging only creates hard links, so it is cheap to run while the file
+// operations that create and remove filesets are paused, after which the
+// staged files can be uploaded at leisure.
+func Stage(opts StageOptions) ([]string, error) {
+	var filePaths []string
+	for _, shard := range opts.Shards {
+		dataFiles, err := fs.DataFiles(opts.FilePathPrefix, opts.Namespace, shard)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileSet := range latestVolumes(dataFiles) {
+			offloaded, err := fs.DataFileSetOffloaded(opts.FilePathPrefix, opts.Namespace,
+				shard, fileSet.ID.BlockStart, fileSet.ID.VolumeIndex)
+			if err != nil {
+				return nil, err
+			}
+			if offloaded {
+				continue
+			}
+			filePaths = append(filePaths, fileSet.AbsoluteFilePaths...)
+		}
+
+		snapshotFiles, err := fs.SnapshotFiles(opts.FilePathPrefix, opts.Namespace, shard)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileSet := range latestVolumes(snapshotFiles) {
+			filePaths = append(filePaths, fileSet.AbsoluteFilePaths...)
+		}
+	}
+
+	indexInfoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+		FilePathPrefix:   opts.FilePathPrefix,
+		Namespace:        opts.Namespace,
+		ReaderBufferSize: opts.InfoReaderBufferSize,
+	})
+	for _, result := range indexInfoFiles {
+		if result.Err.Error() != nil {
+			continue
+		}
+		filePaths = append(filePaths, result.AbsoluteFilePaths...)
+	}
+
+	files := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		file, err := relativePath(opts.FilePathPrefix, filePath)
+		if err != nil {
+			return nil, err
+		}
+		stagedPath := filepath.Join(opts.StagingPath, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(stagedPath), opts.NewDirectoryMode); err != nil {
+			return nil, err
+		}
+		if err := os.Link(filePath, stagedPath); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// UploadResult is the result of uploading a backup.
+type UploadResult struct {
+	// NumFiles is the number of files in the backup.
+	NumFiles int
+	// NumUploadedFiles is the number of files uploaded, which excludes the
+	// files already uploaded by previous backups or other nodes.
+	NumUploadedFiles int
+	// NumUploadedBytes is the number of bytes uploaded.
+	NumUploadedBytes int64
+}
+
+// Upload uploads the staged files of the backup of a node that are not
+// already in the object store, followed by the manifest of the backup.
+func Upload(
+	store objectstore.Store,
+	stagingPath string,
+	files []string,
+	manifest Manifest,
+) (UploadResult, error) {
+	if manifest.HostID == "" {
+		return UploadResult{}, errBackupHostNotSet
+	}
+	if manifest.ID == "" || strings.Contains(manifest.ID, "/") {
+		return UploadResult{}, errInvalidBackupID
+	}
+	manifestKey := nodeManifestKey(manifest.Namespace, manifest.ID, manifest.HostID)
+	existingManifests, err := store.List(manifestKey)
+	if err != nil {
+		return UploadResult{}, err
+	}
+	if len(existingManifests) > 0 {
+		return UploadResult{}, errBackupExists
+	}
+
+	result := UploadResult{NumFiles: len(files)}
+	manifest.Files = make([]File, 0, len(files))
+	for _, file := range files {
+		filePath := filepath.Join(stagingPath, filepath.FromSlash(file))
+		hash, size, err := hashFile(filePath)
+		if err != nil {
+			return UploadResult{}, err
+		}
+		manifest.Files = append(manifest.Files, File{Path: file, Hash: hash, Size: size})
+
+		// Hashes have a fixed length, so only the key of the file itself
+		// has it as a prefix.
+		key := filesKeyPrefix + hash
+		existing, err := store.List(key)
+		if err != nil {
+			return UploadResult{}, err
+		}
+		if len(existing) > 0 {
+			continue
+		}
+		if err := putFile(store, key, filePath); err != nil {
+			return UploadResult{}, fmt.Errorf("unable to upload %s: %w", file, err)
+		}
+		result.NumUploadedFiles++
+		result.NumUploadedBytes += size
+	}
+	sortFiles(manifest.Files)
+
+	if err := putJSON(store, manifestKey, manifest); err != nil {
+		return UploadResult{}, err
+	}
+	return result, nil
+}
+
+// CompleteClusterBackup writes the manifest of a backup of a cluster once
+// the backups of its nodes taken with the ID of the backup cover all the
+// given shards of the cluster, and returns whether the backup is complete.
+// Every node calls it once its own backup is uploaded, so that the last one
+// to finish completes the backup of the cluster.
+func CompleteClusterBackup(
+	store objectstore.Store,
+	namespace ident.ID,
+	id string,
+	shards []uint32,
+) (bool, error) {
+	prefix := nodeManifestsKeyPrefix(namespace.String(), id)
+	keys, err := store.List(prefix)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		manifest = ClusterManifest{ID: id, Namespace: namespace.String()}
+		covered  = make(map[uint32]struct{}, len(shards))
+	)
+	for _, key := range keys {
+		if !strings.HasSuffix(key, manifestKeySuffix) {
+			continue
+		}
+		var node Manifest
+		if err := getJSON(store, key, &node); err != nil {
+			return false, err
+		}
+		manifest.Nodes = append(manifest.Nodes, NodeBackup{
+			HostID: node.HostID,
+			Shards: node.Shards,
+		})
+		if node.CreatedAt.After(manifest.CreatedAt) {
+			manifest.CreatedAt = node.CreatedAt
+			manifest.Metadata = node.Metadata
+		}
+		for _, shard := range node.Shards {
+			covered[shard] = struct{}{}
+		}
+	}
+	for _, shard := range shards {
+		if _, ok := covered[shard]; !ok {
+			return false, nil
+		}
+	}
+
+	sort.Slice(manifest.Nodes, func(i, j int) bool {
+		return manifest.Nodes[i].HostID < manifest.Nodes[j].HostID
+	})
+	if err := putJSON(store, clusterManifestKey(namespace.String(), id), manifest); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReadClusterManifest reads the manifest of a complete backup of a
+// namespace, or of the latest complete backup of the namespace if the ID is
+// empty.
+func ReadClusterManifest(
+	store objectstore.Store,
+	namespace ident.ID,
+	id string,
+) (ClusterManifest, error) {
+	if id == "" {
+		ids, err := ListBackups(store, namespace)
+		if err != nil {
+			return ClusterManifest{}, err
+		}
+		if len(ids) == 0 {
+			return ClusterManifest{}, ErrNoBackup
+		}
+		id = ids[len(ids)-1]
+	}
+
+	var manifest ClusterManifest
+	err := getJSON(store, clusterManifestKey(namespace.String(), id), &manifest)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return ClusterManifest{}, fmt.Errorf("unable to read manifest of backup %s: %w",
+			id, errBackupNotComplete)
+	}
+	if err != nil {
+		return ClusterManifest{}, fmt.Errorf("unable to read manifest of backup %s: %w", id, err)
+	}
+	return manifest, nil
+}
+
+// ReadManifest reads the manifest of the backup of a node.
+func ReadManifest(
+	store objectstore.Store,
+	namespace ident.ID,
+	id string,
+	hostID string,
+) (Manifest, error) {
+	var manifest Manifest
+	if err := getJSON(store, nodeManifestKey(namespace.String(), id, hostID), &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("unable to read manifest of backup %s of host %s: %w",
+			id, hostID, err)
+	}
+	return manifest, nil
+}
+
+// ListBackups returns the IDs of the complete backups of a namespace,
+// ordered from oldest to latest.
+func ListBackups(store objectstore.Store, namespace ident.ID) ([]string, error) {
+	prefix := manifestsKeyPrefix + namespace.String() + "/"
+	keys, err := store.List(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, prefix)
+		// The manifests of the backups of nodes are nested under the ID.
+		if !strings.HasSuffix(name, manifestKeySuffix) || strings.Contains(name, "/") {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(name, manifestKeySuffix))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func clusterManifestKey(namespace, id string) string {
+	return manifestsKeyPrefix + namespace + "/" + id + manifestKeySuffix
+}
+
+func nodeManifestsKeyPrefix(namespace, id string) string {
+	return manifestsKeyPrefix + namespace + "/" + id + "/"
+}
+
+func nodeManifestKey(namespace, id, hostID string) string {
+	return nodeManifestsKeyPrefix(namespace, id) + hostID + manifestKeySuffix
+}
+
+// latestVolumes returns the latest complete volume of each block.
+func latestVolumes(fileSets fs.FileSetFilesSlice) fs.FileSetFilesSlice {
+	var (
+		latest = make(fs.FileSetFilesSlice, 0, len(fileSets))
+		seen   = make(map[xtime.UnixNano]struct{}, len(fileSets))
+	)
+	for _, fileSet := range fileSets {
+		blockStart := fileSet.ID.BlockStart
+		if _, ok := seen[blockStart]; ok {
+			continue
+		}
+		seen[blockStart] = struct{}{}
+		if volume, ok := fileSets.LatestVolumeForBlock(blockStart); ok {
+			latest = append(latest, volume)
+		}
+	}
+	return latest
+}
+
+func relativePath(filePathPrefix, filePath string) (string, error) {
+	rel, err := filepath.Rel(filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// sortFiles sorts files by path with the checkpoint files last, so that
+// filesets are only complete once all their files have been copied.
+func sortFiles(files []File) {
+	sort.Slice(files, func(i, j int) bool {
+		ci, cj := isCheckpointFile(files[i].Path), isCheckpointFile(files[j].Path)
+		if ci != cj {
+			return cj
+		}
+		return files[i].Path < files[j].Path
+	})
+}
+
+func isCheckpointFile(file string) bool {
+	return strings.HasSuffix(path.Base(file), fs.CheckpointFileSuffix+".db")
+}
+
+func hashFile(filePath string) (string, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func putFile(store objectstore.Store, key, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return store.Put(key, f)
+}
+
+func putJSON(store objectstore.Store, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return store.Put(key, bytes.NewReader(data))
+}
+
+func getJSON(store objectstore.Store, key string, v interface{}) error {
+	r, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("unable to decode %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	testNamespace = ident.StringID("testns")
+	testBlockSize = 2 * time.Hour
+)
+
+func writeTestFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	shard uint32,
+	blockStart xtime.UnixNano,
+	volume int,
+	fileSetType persist.FileSetType,
+	data []byte,
+) {
+	writer, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+
+	opts := fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   testNamespace,
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		BlockSize:   testBlockSize,
+		FileSetType: fileSetType,
+	}
+	if fileSetType == persist.FileSetSnapshotType {
+		opts.Snapshot.SnapshotTime = blockStart
+		opts.Snapshot.SnapshotID = uuid.NewRandom()
+	}
+	require.NoError(t, writer.Open(opts))
+
+	bytes := checked.NewBytes(data, nil)
+	bytes.IncRef()
+	meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+		ident.Tags{}, persist.MetadataOptions{})
+	require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+	require.NoError(t, writer.Close())
+}
+
+func newTestBackup(
+	t *testing.T,
+	store objectstore.Store,
+	filePathPrefix string,
+	id string,
+	hostID string,
+	shards []uint32,
+) (Manifest, UploadResult) {
+	stagingPath, err := ioutil.TempDir("", "staging")
+	require.NoError(t, err)
+	defer os.RemoveAll(stagingPath)
+
+	files, err := Stage(StageOptions{
+		FilePathPrefix:       filePathPrefix,
+		StagingPath:          stagingPath,
+		Namespace:            testNamespace,
+		Shards:               shards,
+		InfoReaderBufferSize: 128,
+		NewDirectoryMode:     os.ModeDir | os.FileMode(0755),
+	})
+	require.NoError(t, err)
+
+	result, err := Upload(store, stagingPath, files, Manifest{
+		ID:        id,
+		Namespace: testNamespace.String(),
+		HostID:    hostID,
+		CreatedAt: time.Now(),
+		Shards:    shards,
+	})
+	require.NoError(t, err)
+
+	manifest, err := ReadManifest(store, testNamespace, id, hostID)
+	require.NoError(t, err)
+	return manifest, result
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		filePathPrefix = filepath.Join(dir, "source")
+		blockStart     = xtime.Now().Truncate(testBlockSize).Add(-2 * testBlockSize)
+		store          = objectstore.NewMemoryStore()
+		now            = time.Now()
+		data           = []byte{1, 2, 3}
+	)
+
+	// Only the latest volume of each block of the backed up shards is
+	// backed up.
+	writeTestFileSet(t, filePathPrefix, 0, blockStart, 0, persist.FileSetFlushType, data)
+	writeTestFileSet(t, filePathPrefix, 0, blockStart, 1, persist.FileSetFlushType, data)
+	writeTestFileSet(t, filePathPrefix, 0, blockStart.Add(testBlockSize), 0, persist.FileSetSnapshotType, data)
+	writeTestFileSet(t, filePathPrefix, 1, blockStart, 0, persist.FileSetFlushType, data)
+
+	first, result := newTestBackup(t, store, filePathPrefix, NewID(now), "host", []uint32{0})
+	require.NotEmpty(t, first.Files)
+	require.Equal(t, len(first.Files), result.NumFiles)
+	for _, file := range first.Files {
+		shard, ok, err := shardOfFile(file.Path)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, uint32(0), shard)
+	}
+	require.True(t, isCheckpointFile(first.Files[len(first.Files)-1].Path))
+
+	// A backup of the same node with the same ID is rejected, while backups
+	// taken within the same second have different IDs.
+	_, err = Upload(store, dir, nil, first)
+	require.Equal(t, errBackupExists, err)
+	require.NotEqual(t, NewID(now), NewID(now.Add(time.Millisecond)))
+	invalid := first
+	invalid.ID = "a/b"
+	_, err = Upload(store, dir, nil, invalid)
+	require.Equal(t, errInvalidBackupID, err)
+
+	// The backup is not complete until the cluster manifest is written.
+	ids, err := ListBackups(store, testNamespace)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+	complete, err := CompleteClusterBackup(store, testNamespace, first.ID, []uint32{0})
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	// Backups are incremental, only the files with new contents are uploaded.
+	writeTestFileSet(t, filePathPrefix, 0, blockStart.Add(testBlockSize), 0, persist.FileSetFlushType, data)
+	second, result := newTestBackup(t, store, filePathPrefix, NewID(now.Add(time.Hour)),
+		"host", []uint32{0})
+	require.Equal(t, len(second.Files), result.NumFiles)
+	require.True(t, result.NumUploadedFiles < len(second.Files)-len(first.Files))
+	complete, err = CompleteClusterBackup(store, testNamespace, second.ID, []uint32{0})
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	ids, err = ListBackups(store, testNamespace)
+	require.NoError(t, err)
+	require.Equal(t, []string{first.ID, second.ID}, ids)
+
+	// The latest backup is restored by default.
+	restorePrefix := filepath.Join(dir, "restore")
+	restoreOpts := RestoreOptions{
+		FilePathPrefix:   restorePrefix,
+		Namespace:        testNamespace,
+		HostID:           "host",
+		Shards:           []uint32{0, 1},
+		NewFileMode:      os.FileMode(0666),
+		NewDirectoryMode: os.ModeDir | os.FileMode(0755),
+	}
+	restored, err := Restore(store, restoreOpts)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, restored.Manifest.ID)
+	require.Equal(t, len(second.Files), restored.NumRestoredFiles)
+
+	for _, fileSet := range []struct {
+		blockStart xtime.UnixNano
+		volume     int
+		exists     bool
+	}{
+		{blockStart, 0, false},
+		{blockStart, 1, true},
+		{blockStart.Add(testBlockSize), 0, true},
+	} {
+		exists, err := fs.DataFileSetExists(restorePrefix, testNamespace, 0,
+			fileSet.blockStart, fileSet.volume)
+		require.NoError(t, err)
+		require.Equal(t, fileSet.exists, exists)
+	}
+	snapshots, err := fs.SnapshotFiles(restorePrefix, testNamespace, 0)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.True(t, snapshots[0].HasCompleteCheckpointFile())
+
+	// Restoring again skips the files that already exist.
+	restored, err = Restore(store, restoreOpts)
+	require.NoError(t, err)
+	require.Equal(t, 0, restored.NumRestoredFiles)
+
+	// Backups of other shards are not restored.
+	restoreOpts.FilePathPrefix = filepath.Join(dir, "restore-other")
+	restoreOpts.Shards = []uint32{1}
+	restoreOpts.BackupID = first.ID
+	restored, err = Restore(store, restoreOpts)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, restored.Manifest.ID)
+	require.Equal(t, 0, restored.NumRestoredFiles)
+}
+
+func TestClusterBackupAndRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		prefixA    = filepath.Join(dir, "host-a")
+		prefixB    = filepath.Join(dir, "host-b")
+		blockStart = xtime.Now().Truncate(testBlockSize).Add(-2 * testBlockSize)
+		store      = objectstore.NewMemoryStore()
+		id         = NewID(time.Now())
+	)
+
+	// Both nodes have a fileset of shard 0 at the same path with different
+	// contents, and only the second node owns shard 1.
+	writeTestFileSet(t, prefixA, 0, blockStart, 0, persist.FileSetFlushType, []byte{1, 2, 3})
+	writeTestFileSet(t, prefixB, 0, blockStart, 0, persist.FileSetFlushType, []byte{4, 5, 6})
+	writeTestFileSet(t, prefixB, 1, blockStart, 0, persist.FileSetFlushType, []byte{7, 8, 9})
+
+	a, _ := newTestBackup(t, store, prefixA, id, "host-a", []uint32{0})
+	complete, err := CompleteClusterBackup(store, testNamespace, id, []uint32{0, 1})
+	require.NoError(t, err)
+	require.False(t, complete)
+
+	// Restores require the backup of the cluster to be complete.
+	restoreOpts := RestoreOptions{
+		FilePathPrefix:   filepath.Join(dir, "restore"),
+		Namespace:        testNamespace,
+		HostID:           "host-a",
+		Shards:           []uint32{0, 1},
+		NewFileMode:      os.FileMode(0666),
+		NewDirectoryMode: os.ModeDir | os.FileMode(0755),
+	}
+	_, err = Restore(store, restoreOpts)
+	require.Equal(t, ErrNoBackup, err)
+	restoreOpts.BackupID = id
+	_, err = Restore(store, restoreOpts)
+	require.True(t, errors.Is(err, errBackupNotComplete))
+
+	b, _ := newTestBackup(t, store, prefixB, id, "host-b", []uint32{0, 1})
+	complete, err = CompleteClusterBackup(store, testNamespace, id, []uint32{0, 1})
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	cluster, err := ReadClusterManifest(store, testNamespace, "")
+	require.NoError(t, err)
+	require.Equal(t, []NodeBackup{
+		{HostID: "host-a", Shards: []uint32{0}},
+		{HostID: "host-b", Shards: []uint32{0, 1}},
+	}, cluster.Nodes)
+
+	// Shard 0 is restored from the backup of the node being restored and
+	// shard 1 from the backup of the other node.
+	restored, err := Restore(store, restoreOpts)
+	require.NoError(t, err)
+	require.Equal(t, len(a.Files)+len(b.Files)/2, restored.NumRestoredFiles)
+	for _, fileSet := range []struct {
+		prefix string
+		shard  uint32
+	}{
+		{prefixA, 0},
+		{prefixB, 1},
+	} {
+		files, err := fs.DataFiles(fileSet.prefix, testNamespace, fileSet.shard)
+		require.NoError(t, err)
+		for _, filePath := range files[0].AbsoluteFilePaths {
+			rel, err := filepath.Rel(fileSet.prefix, filePath)
+			require.NoError(t, err)
+			expected, err := ioutil.ReadFile(filePath)
+			require.NoError(t, err)
+			actual, err := ioutil.ReadFile(filepath.Join(restoreOpts.FilePathPrefix, rel))
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		}
+	}
+}
+
+func TestReadClusterManifestNoBackup(t *testing.T) {
+	_, err := ReadClusterManifest(objectstore.NewMemoryStore(), testNamespace, "")
+	require.Equal(t, ErrNoBackup, err)
+}
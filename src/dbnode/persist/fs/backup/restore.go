@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+)
+
+// RestoreOptions are the options for restoring a backup.
+type RestoreOptions struct {
+	// FilePathPrefix is the file path prefix the filesets are restored to.
+	FilePathPrefix string
+	// Namespace is the namespace to restore.
+	Namespace ident.ID
+	// BackupID is the ID of the backup to restore, the latest complete
+	// backup of the namespace is restored if it is empty.
+	BackupID string
+	// HostID is the host ID of the node being restored.
+	HostID string
+	// Shards are the shards whose data and snapshot filesets are restored.
+	Shards []uint32
+	// RestoreIndex is whether the index filesets of the namespace are
+	// restored.
+	RestoreIndex bool
+	// NewFileMode and NewDirectoryMode are the modes of the restored files
+	// and directories.
+	NewFileMode      os.FileMode
+	NewDirectoryMode os.FileMode
+}
+
+// RestoreResult is the result of restoring a backup.
+type RestoreResult struct {
+	// Manifest is the manifest of the restored backup.
+	Manifest ClusterManifest
+	// NumRestoredFiles is the number of files restored.
+	NumRestoredFiles int
+}
+
+// Restore copies the files of a complete backup of a namespace to the file
+// path prefix, skipping the files that already exist. The filesets of each
+// shard are restored from the backup of a single node, preferring the node
+// being restored, and the index filesets are only restored from the backup
+// of the node being restored since they hold the series of its own shards.
+// The checkpoint files are copied last so that the restored filesets are
+// only complete once all their files are restored.
+func Restore(store objectstore.Store, opts RestoreOptions) (RestoreResult, error) {
+	if opts.HostID == "" {
+		return RestoreResult{}, errBackupHostNotSet
+	}
+	manifest, err := ReadClusterManifest(store, opts.Namespace, opts.BackupID)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+
+	// Order the backups of the nodes with the backup of the node being
+	// restored first.
+	nodes := make([]NodeBackup, 0, len(manifest.Nodes))
+	for _, node := range manifest.Nodes {
+		if node.HostID == opts.HostID {
+			nodes = append([]NodeBackup{node}, nodes...)
+		} else {
+			nodes = append(nodes, node)
+		}
+	}
+
+	var (
+		pending = make(map[uint32]struct{}, len(opts.Shards))
+		files   []File
+	)
+	for _, shard := range opts.Shards {
+		pending[shard] = struct{}{}
+	}
+	for _, node := range nodes {
+		var (
+			shards       = make(map[uint32]struct{}, len(node.Shards))
+			restoreIndex = opts.RestoreIndex && node.HostID == opts.HostID
+		)
+		for _, shard := range node.Shards {
+			if _, ok := pending[shard]; ok {
+				shards[shard] = struct{}{}
+				delete(pending, shard)
+			}
+		}
+		if len(shards) == 0 && !restoreIndex {
+			continue
+		}
+
+		nodeManifest, err := ReadManifest(store, opts.Namespace, manifest.ID, node.HostID)
+		if err != nil {
+			return RestoreResult{}, err
+		}
+		for _, file := range nodeManifest.Files {
+			shard, isShardFile, err := shardOfFile(file.Path)
+			if err != nil {
+				return RestoreResult{}, err
+			}
+			if isShardFile {
+				if _, ok := shards[shard]; !ok {
+					continue
+				}
+			} else if !restoreIndex {
+				continue
+			}
+			files = append(files, file)
+		}
+	}
+
+	result := RestoreResult{Manifest: manifest}
+	sortFiles(files)
+	for _, file := range files {
+		filePath := filepath.Join(opts.FilePathPrefix, filepath.FromSlash(file.Path))
+		if _, err := os.Stat(filePath); err == nil {
+			continue
+		} else if !os.IsNotExist(err) {
+			return RestoreResult{}, err
+		}
+
+		if err := restoreFile(store, filesKeyPrefix+file.Hash, filePath, opts); err != nil {
+			return RestoreResult{}, fmt.Errorf("unable to restore %s: %w", file.Path, err)
+		}
+		result.NumRestoredFiles++
+	}
+
+	return result, nil
+}
+
+// shardOfFile returns the shard of a data or snapshot fileset file, index
+// fileset files belong to no shard.
+func shardOfFile(file string) (uint32, bool, error) {
+	// Data and snapshot filesets are stored at "<dir>/<namespace>/<shard>/<file>"
+	// while index filesets are stored at "index/<dir>/<namespace>/<file>".
+	parts := strings.Split(file, "/")
+	if len(parts) != 4 || parts[0] == "index" {
+		return 0, false, nil
+	}
+	shard, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid shard in backed up file %s: %w", file, err)
+	}
+	return uint32(shard), true, nil
+}
+
+func restoreFile(
+	store objectstore.Store,
+	key string,
+	filePath string,
+	opts RestoreOptions,
+) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), opts.NewDirectoryMode); err != nil {
+		return err
+	}
+
+	r, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// Write to a temporary file first so that a partially restored file is
+	// never mistaken for a restored one.
+	tmpPath := filePath + ".restore"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, opts.NewFileMode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/m3db/bloom/v4"
 
 	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/mmap"
 )
 
@@ -79,13 +80,15 @@ func newManagedConcurrentBloomFilterFromFile(
 	numElementsM uint,
 	numHashesK uint,
 	forceMmapMemory bool,
+	keyProvider encryption.KeyProvider,
 	reporterOptions mmap.ReporterOptions,
 ) (*ManagedConcurrentBloomFilter, error) {
 	// Determine how many bytes to request for the mmap'd region
 	bloomFilterFdWithDigest.Reset(bloomFilterFd)
 
 	reporterOptions.Context.Name = mmapPersistFsBloomFilterName
-	bloomFilterMmap, err := validateAndMmap(bloomFilterFdWithDigest, expectedDigest, forceMmapMemory, reporterOptions)
+	bloomFilterMmap, err := validateAndMmap(bloomFilterFdWithDigest, expectedDigest,
+		forceMmapMemory, keyProvider, reporterOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +42,7 @@ type chunkReader struct {
 	buffer             *bufio.Reader
 	chunkData          []byte
 	chunkDataRemaining int
+	decompressBuff     []byte
 	charBuff           []byte
 }
 
@@ -53,9 +54,11 @@ func newChunkReader(bufferLen int) *chunkReader {
 	}
 }
 
-func (r *chunkReader) reset(fd *os.File) {
+// reset resets the reader to read from the given reader of the file, the
+// file is kept so that it can be closed.
+func (r *chunkReader) reset(fd *os.File, reader io.Reader) {
 	r.fd = fd
-	r.buffer.Reset(fd)
+	r.buffer.Reset(reader)
 	r.chunkDataRemaining = 0
 }
 
@@ -65,7 +68,9 @@ func (r *chunkReader) readHeader() error {
 		return err
 	}
 
-	size := endianness.Uint32(header[sizeStart:sizeEnd])
+	sizeAndCompression := endianness.Uint32(header[sizeStart:sizeEnd])
+	size := sizeAndCompression & chunkSizeMask
+	compression := CompressionType(sizeAndCompression >> chunkSizeCompressionShift)
 	checksumSize := digest.
 		Buffer(header[checksumSizeStart:checksumSizeEnd]).
 		ReadDigest()
@@ -111,8 +116,18 @@ func (r *chunkReader) readHeader() error {
 		return errCommitLogReaderChunkSizeChecksumMismatch
 	}
 
+	if compression != CompressionNone {
+		decompressed, err := decompressChunk(compression, r.decompressBuff, r.chunkData)
+		if err != nil {
+			return err
+		}
+		// Swap buffers so that both are reused for the next chunks.
+		r.decompressBuff = r.chunkData
+		r.chunkData = decompressed
+	}
+
 	// Set remaining data to be consumed
-	r.chunkDataRemaining = int(size)
+	r.chunkDataRemaining = len(r.chunkData)
 
 	return nil
 }
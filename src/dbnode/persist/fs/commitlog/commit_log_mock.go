@@ -335,6 +335,20 @@ func (mr *MockOptionsMockRecorder) ClockOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOptions", reflect.TypeOf((*MockOptions)(nil).ClockOptions))
 }
 
+// Compression mocks base method.
+func (m *MockOptions) Compression() CompressionType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(CompressionType)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression.
+func (mr *MockOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockOptions)(nil).Compression))
+}
+
 // FailureCallback mocks base method.
 func (m *MockOptions) FailureCallback() FailureCallback {
 	m.ctrl.T.Helper()
@@ -517,6 +531,20 @@ func (mr *MockOptionsMockRecorder) SetClockOptions(value interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClockOptions", reflect.TypeOf((*MockOptions)(nil).SetClockOptions), value)
 }
 
+// SetCompression mocks base method.
+func (m *MockOptions) SetCompression(value CompressionType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockOptions)(nil).SetCompression), value)
+}
+
 // SetFailureCallback mocks base method.
 func (m *MockOptions) SetFailureCallback(value FailureCallback) Options {
 	m.ctrl.T.Helper()
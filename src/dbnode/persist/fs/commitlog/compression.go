@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionType describes the compression applied to commit log chunks.
+type CompressionType uint
+
+const (
+	// CompressionNone describes commit log chunks written uncompressed.
+	CompressionNone CompressionType = iota
+
+	// CompressionSnappy describes commit log chunks compressed with snappy,
+	// which is cheap on CPU at the cost of a lower compression ratio.
+	CompressionSnappy
+
+	// CompressionZstd describes commit log chunks compressed with zstd,
+	// which has a better compression ratio at a higher CPU cost.
+	CompressionZstd
+)
+
+const (
+	// The compression of a chunk is stored in the top bits of the chunk
+	// size so that the chunk header is unchanged and commit logs written
+	// before compression was supported can still be read.
+	chunkSizeCompressionShift = 30
+	chunkSizeMask             = 1<<chunkSizeCompressionShift - 1
+)
+
+var (
+	errCompressionTypeUnspecified = errors.New("commit log compression type not specified")
+	errChunkTooLarge              = errors.New("commit log chunk too large")
+
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdEncoderErr  error
+
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+)
+
+// ValidCompressionTypes returns the valid commit log compression types.
+func ValidCompressionTypes() []CompressionType {
+	return []CompressionType{CompressionNone, CompressionSnappy, CompressionZstd}
+}
+
+func (t CompressionType) String() string {
+	switch t {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "unknown"
+}
+
+// ValidateCompressionType validates a commit log compression type.
+func ValidateCompressionType(t CompressionType) error {
+	for _, valid := range ValidCompressionTypes() {
+		if valid == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid commit log compression type '%d' valid types are: %v",
+		uint(t), ValidCompressionTypes())
+}
+
+// ParseCompressionType parses a CompressionType from a string.
+func ParseCompressionType(str string) (CompressionType, error) {
+	if str == "" {
+		return CompressionNone, errCompressionTypeUnspecified
+	}
+	for _, valid := range ValidCompressionTypes() {
+		if str == valid.String() {
+			return valid, nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("invalid commit log compression type '%s' valid types are: %v",
+		str, ValidCompressionTypes())
+}
+
+// MarshalYAML marshals a CompressionType.
+func (t CompressionType) MarshalYAML() (interface{}, error) {
+	return t.String(), nil
+}
+
+// UnmarshalYAML unmarshals a CompressionType into a valid type from string.
+func (t *CompressionType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	r, err := ParseCompressionType(str)
+	if err != nil {
+		return err
+	}
+	*t = r
+	return nil
+}
+
+// compressChunk compresses src into dst, reusing the capacity of dst.
+func compressChunk(t CompressionType, dst, src []byte) ([]byte, error) {
+	switch t {
+	case CompressionSnappy:
+		return snappy.Encode(dst[:cap(dst)], src), nil
+	case CompressionZstd:
+		zstdEncoderOnce.Do(func() {
+			zstdEncoder, zstdEncoderErr = zstd.NewWriter(nil,
+				zstd.WithEncoderLevel(zstd.SpeedFastest))
+		})
+		if zstdEncoderErr != nil {
+			return nil, zstdEncoderErr
+		}
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	}
+	return nil, ValidateCompressionType(t)
+}
+
+// decompressChunk decompresses src into dst, reusing the capacity of dst.
+func decompressChunk(t CompressionType, dst, src []byte) ([]byte, error) {
+	switch t {
+	case CompressionSnappy:
+		n, err := snappy.DecodedLen(src)
+		if err != nil {
+			return nil, err
+		}
+		if n > chunkSizeMask {
+			return nil, errChunkTooLarge
+		}
+		if n > cap(dst) {
+			dst = make([]byte, n)
+		}
+		return snappy.Decode(dst[:n], src)
+	case CompressionZstd:
+		zstdDecoderOnce.Do(func() {
+			zstdDecoder, zstdDecoderErr = zstd.NewReader(nil,
+				zstd.WithDecoderMaxMemory(chunkSizeMask))
+		})
+		if zstdDecoderErr != nil {
+			return nil, zstdDecoderErr
+		}
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	}
+	return nil, ValidateCompressionType(t)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestCommitLogCompressedWrite(t *testing.T) {
+	for _, compression := range []CompressionType{CompressionSnappy, CompressionZstd} {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			opts, scope := newTestOptions(t, overrides{
+				strategy: StrategyWriteWait,
+			})
+			defer cleanup(t, opts)
+			opts = opts.SetCompression(compression)
+
+			compressible := bytes.Repeat([]byte("compressible"), 2*opts.FlushSize())
+			writes := []testWrite{
+				{
+					testSeries(t, opts, 0, "foo.bar", testTags1, 127),
+					xtime.Now(), 123.456, xtime.Second,
+					[]byte{1, 2, 3},
+					nil,
+				},
+				{
+					testSeries(t, opts, 1, "foo.baz", testTags2, 150),
+					xtime.Now(), 456.789, xtime.Second, compressible, nil,
+				},
+				{
+					// Random data does not compress and is written uncompressed.
+					testSeries(t, opts, 2, "foo.qux", testTags3, 291),
+					xtime.Now(), 789.123, xtime.Second, randomByteSlice(opts.FlushSize()), nil,
+				},
+			}
+
+			commitLog := newTestCommitLog(t, opts)
+			writeCommitLogs(t, scope, commitLog, writes).Wait()
+			require.NoError(t, commitLog.Close())
+
+			assertCommitLogWritesByIterating(t, commitLog, writes)
+
+			files, err := fs.SortedCommitLogFiles(
+				fs.CommitLogsDirPath(opts.FilesystemOptions().FilePathPrefix()))
+			require.NoError(t, err)
+			var size int64
+			for _, file := range files {
+				info, err := os.Stat(file)
+				require.NoError(t, err)
+				size += info.Size()
+			}
+			require.True(t, size < int64(len(compressible)))
+		})
+	}
+}
+
+func TestParseCompressionType(t *testing.T) {
+	for _, valid := range ValidCompressionTypes() {
+		parsed, err := ParseCompressionType(valid.String())
+		require.NoError(t, err)
+		require.Equal(t, valid, parsed)
+	}
+
+	_, err := ParseCompressionType("lz4")
+	require.Error(t, err)
+	_, err = ParseCompressionType("")
+	require.Equal(t, errCompressionTypeUnspecified, err)
+	require.Error(t, ValidateCompressionType(CompressionType(3)))
+}
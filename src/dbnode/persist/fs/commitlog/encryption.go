@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/m3db/m3/src/x/encryption"
+	xos "github.com/m3db/m3/src/x/os"
+)
+
+var errCommitLogEncryptionKeyProviderNotSet = errors.New(
+	"commit log file is encrypted but no encryption key provider is set")
+
+// encryptedFile encrypts the writes to a commit log file. Every write is
+// flushed to the file so that it is durable once the file is synced.
+type encryptedFile struct {
+	xos.File
+
+	writer *encryption.Writer
+}
+
+func newEncryptedFile(fd *os.File, keyProvider encryption.KeyProvider) (xos.File, error) {
+	writer, err := encryption.NewWriter(fd, keyProvider)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedFile{File: fd, writer: writer}, nil
+}
+
+func (f *encryptedFile) Write(p []byte) (int, error) {
+	n, err := f.writer.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return n, f.writer.Flush()
+}
+
+// newFileReader returns a reader of the commit log file that decrypts it if
+// it is encrypted, commit log files written in plaintext are read as is.
+func newFileReader(fd *os.File, keyProvider encryption.KeyProvider) (io.Reader, error) {
+	encrypted, err := encryption.IsEncrypted(fd)
+	if err != nil || !encrypted {
+		return fd, err
+	}
+	if keyProvider == nil {
+		return nil, errCommitLogEncryptionKeyProviderNotSet
+	}
+	return encryption.NewReader(fd, keyProvider)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/encryption"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestCommitLogEncryptedWrite(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteWait,
+	})
+	defer cleanup(t, opts)
+
+	fsOpts := opts.FilesystemOptions()
+	keyFilePath := filepath.Join(fsOpts.FilePathPrefix(), "key")
+	err := ioutil.WriteFile(keyFilePath,
+		[]byte(base64.StdEncoding.EncodeToString(randomByteSlice(32))), 0600)
+	require.NoError(t, err)
+	keyProvider, err := encryption.NewKeyFileProvider(keyFilePath)
+	require.NoError(t, err)
+	opts = opts.SetFilesystemOptions(fsOpts.SetEncryptionKeyProvider(keyProvider))
+
+	writes := []testWrite{
+		{
+			testSeries(t, opts, 0, "foo.bar", testTags1, 127),
+			xtime.Now(), 123.456, xtime.Second,
+			[]byte{1, 2, 3},
+			nil,
+		},
+		{
+			testSeries(t, opts, 1, "foo.baz", testTags2, 150),
+			xtime.Now(), 456.789, xtime.Second, randomByteSlice(3 * opts.FlushSize()), nil,
+		},
+	}
+
+	commitLog := newTestCommitLog(t, opts)
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+	require.NoError(t, commitLog.Close())
+
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+
+	files, err := fs.SortedCommitLogFiles(fs.CommitLogsDirPath(fsOpts.FilePathPrefix()))
+	require.NoError(t, err)
+	require.True(t, len(files) > 0)
+	for i, file := range files {
+		fd, err := os.Open(file)
+		require.NoError(t, err)
+		encrypted, err := encryption.IsEncrypted(fd)
+		require.NoError(t, err)
+		require.True(t, encrypted)
+		require.NoError(t, fd.Close())
+
+		index, err := ReadLogInfo(file, opts)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), index)
+	}
+
+	// Encrypted commit logs cannot be read without the key provider.
+	reader := NewReader(ReaderOptions{commitLogOptions: opts.SetFilesystemOptions(fsOpts)})
+	_, err = reader.Open(files[0])
+	require.Equal(t, errCommitLogEncryptionKeyProviderNotSet, err)
+}
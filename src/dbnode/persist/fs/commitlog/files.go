@@ -88,8 +88,13 @@ func ReadLogInfo(filePath string, opts Options) (int64, error) {
 		return 0, fsError{err}
 	}
 
+	fileReader, err := newFileReader(fd, opts.FilesystemOptions().EncryptionKeyProvider())
+	if err != nil {
+		return 0, err
+	}
+
 	chunkReader := newChunkReader(opts.FlushSize())
-	chunkReader.reset(fd)
+	chunkReader.reset(fd, fileReader)
 	size, err := binary.ReadUvarint(chunkReader)
 	if err != nil {
 		return 0, err
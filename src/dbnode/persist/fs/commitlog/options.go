@@ -90,6 +90,7 @@ type options struct {
 	bytesPool               pool.CheckedBytesPool
 	identPool               ident.Pool
 	readConcurrency         int
+	compression             CompressionType
 	failureMode             FailureStrategy
 	failureCallback         FailureCallback
 }
@@ -176,6 +177,10 @@ func (o *options) Validate() error {
 		return errReadConcurrencyPositive
 	}
 
+	if err := ValidateCompressionType(o.Compression()); err != nil {
+		return err
+	}
+
 	if float64(o.BacklogQueueSize())/float64(o.BacklogQueueChannelSize()) > MaximumQueueSizeQueueChannelSizeRatio {
 		return fmt.Errorf(
 			"BacklogQueueSize / BacklogQueueChannelSize ratio must be at most: %f, but was: %f",
@@ -309,6 +314,16 @@ func (o *options) IdentifierPool() ident.Pool {
 	return o.identPool
 }
 
+func (o *options) SetCompression(value CompressionType) Options {
+	opts := *o
+	opts.compression = value
+	return &opts
+}
+
+func (o *options) Compression() CompressionType {
+	return o.compression
+}
+
 func (o *options) SetFailureStrategy(value FailureStrategy) Options {
 	opts := *o
 	opts.failureMode = value
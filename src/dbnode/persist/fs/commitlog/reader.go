@@ -135,7 +135,14 @@ func (r *reader) Open(filePath string) (int64, error) {
 		return 0, err
 	}
 
-	r.chunkReader.reset(fd)
+	fileReader, err := newFileReader(fd,
+		r.opts.commitLogOptions.FilesystemOptions().EncryptionKeyProvider())
+	if err != nil {
+		fd.Close()
+		return 0, err
+	}
+
+	r.chunkReader.reset(fd, fileReader)
 	info, err := r.readInfo()
 	if err != nil {
 		r.Close()
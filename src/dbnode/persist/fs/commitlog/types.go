@@ -236,6 +236,12 @@ type Options interface {
 	// IdentifierPool returns the IdentifierPool to use for pooling identifiers.
 	IdentifierPool() ident.Pool
 
+	// SetCompression sets the compression of commit log chunks.
+	SetCompression(value CompressionType) Options
+
+	// Compression returns the compression of commit log chunks.
+	Compression() CompressionType
+
 	// SetFailureStrategy sets the strategy.
 	SetFailureStrategy(value FailureStrategy) Options
 
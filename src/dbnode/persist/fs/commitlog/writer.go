@@ -128,7 +128,7 @@ func newCommitLogWriter(
 		newFileMode:         opts.FilesystemOptions().NewFileMode(),
 		newDirectoryMode:    opts.FilesystemOptions().NewDirectoryMode(),
 		nowFn:               opts.ClockOptions().NowFn(),
-		chunkWriter:         newChunkWriter(flushFn, shouldFsync),
+		chunkWriter:         newChunkWriter(flushFn, shouldFsync, opts.Compression()),
 		chunkReserveHeader:  make([]byte, chunkHeaderLen),
 		buffer:              bufio.NewWriterSize(nil, opts.FlushSize()),
 		sizeBuffer:          make([]byte, binary.MaxVarintLen64),
@@ -175,7 +175,16 @@ func (w *writer) Open() (persist.CommitLogFile, error) {
 		return persist.CommitLogFile{}, err
 	}
 
-	w.chunkWriter.reset(fd)
+	var file xos.File = fd
+	if keyProvider := w.opts.FilesystemOptions().EncryptionKeyProvider(); keyProvider != nil {
+		file, err = newEncryptedFile(fd, keyProvider)
+		if err != nil {
+			fd.Close()
+			return persist.CommitLogFile{}, err
+		}
+	}
+
+	w.chunkWriter.reset(file)
 	w.buffer.Reset(w.chunkWriter)
 	if err := w.write(w.logEncoder.Bytes()); err != nil {
 		w.Close()
@@ -301,17 +310,24 @@ func (w *writer) write(data []byte) error {
 }
 
 type fsChunkWriter struct {
-	fd      xos.File
-	flushFn flushFn
-	buff    []byte
-	fsync   bool
+	fd           xos.File
+	flushFn      flushFn
+	buff         []byte
+	compressBuff []byte
+	fsync        bool
+	compression  CompressionType
 }
 
-func newChunkWriter(flushFn flushFn, fsync bool) chunkWriter {
+func newChunkWriter(
+	flushFn flushFn,
+	fsync bool,
+	compression CompressionType,
+) chunkWriter {
 	return &fsChunkWriter{
-		flushFn: flushFn,
-		buff:    make([]byte, chunkHeaderLen),
-		fsync:   fsync,
+		flushFn:     flushFn,
+		buff:        make([]byte, chunkHeaderLen),
+		fsync:       fsync,
+		compression: compression,
 	}
 }
 
@@ -341,7 +357,17 @@ func (w *fsChunkWriter) sync() error {
 // If the header or p is not fully written to the file, then this method returns number of bytes of p actually written
 // to the file and an error explaining the reason of failure to write fully to the file.
 func (w *fsChunkWriter) Write(p []byte) (int, error) {
-	size := len(p)
+	data, compression, err := w.compress(p)
+	if err != nil {
+		w.flushFn(err)
+		return 0, err
+	}
+
+	size := len(data)
+	if size > chunkSizeMask {
+		w.flushFn(errChunkTooLarge)
+		return 0, errChunkTooLarge
+	}
 
 	sizeStart, sizeEnd :=
 		0, chunkHeaderSizeLen
@@ -350,12 +376,13 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	checksumDataStart, checksumDataEnd :=
 		checksumSizeEnd, checksumSizeEnd+chunkHeaderChecksumDataLen
 
-	// Write size
-	endianness.PutUint32(w.buff[sizeStart:sizeEnd], uint32(size))
+	// Write size along with the compression of the chunk
+	endianness.PutUint32(w.buff[sizeStart:sizeEnd],
+		uint32(size)|uint32(compression)<<chunkSizeCompressionShift)
 
 	// Calculate checksums
 	checksumSize := digest.Checksum(w.buff[sizeStart:sizeEnd])
-	checksumData := digest.Checksum(p)
+	checksumData := digest.Checksum(data)
 
 	// Write checksums
 	digest.
@@ -366,7 +393,7 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		WriteDigest(checksumData)
 
 	// Combine buffers to reduce to a single syscall
-	w.buff = append(w.buff[:chunkHeaderLen], p...)
+	w.buff = append(w.buff[:chunkHeaderLen], data...)
 
 	// Write contents to file descriptor
 	n, err := w.fd.Write(w.buff)
@@ -375,6 +402,14 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	if pBytesWritten < 0 {
 		pBytesWritten = 0
 	}
+	if compression != CompressionNone {
+		// Compressed bytes do not map back to bytes of p, a compressed
+		// chunk is either written entirely or not at all.
+		pBytesWritten = 0
+		if err == nil {
+			pBytesWritten = len(p)
+		}
+	}
 
 	if err != nil {
 		w.flushFn(err)
@@ -390,3 +425,21 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	w.flushFn(err)
 	return pBytesWritten, err
 }
+
+// compress returns the data to write for the chunk and the compression used,
+// chunks that do not shrink when compressed are written uncompressed.
+func (w *fsChunkWriter) compress(p []byte) ([]byte, CompressionType, error) {
+	if w.compression == CompressionNone {
+		return p, CompressionNone, nil
+	}
+
+	compressed, err := compressChunk(w.compression, w.compressBuff, p)
+	if err != nil {
+		return nil, CompressionNone, err
+	}
+	w.compressBuff = compressed
+	if len(compressed) >= len(p) {
+		return p, CompressionNone, nil
+	}
+	return compressed, w.compression, nil
+}
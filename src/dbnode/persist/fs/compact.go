@@ -0,0 +1,453 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	errCompactNoFileSets        = errors.New("no filesets to compact")
+	errCompactFileSetOutOfBlock = errors.New("compacted fileset is not within the compacted block")
+)
+
+// CompactFileSetsOptions describes consecutive flushed data filesets of a
+// shard that are compacted into a single fileset of a larger block.
+type CompactFileSetsOptions struct {
+	NamespaceID ident.ID
+	Shard       uint32
+
+	// BlockStart and BlockSize describe the block of the compacted fileset,
+	// which must contain the blocks of all the filesets that are compacted.
+	BlockStart  xtime.UnixNano
+	BlockSize   time.Duration
+	VolumeIndex int
+
+	// FileSets are the block starts and volume indices of the filesets that
+	// are compacted, ordered by block start.
+	FileSets []FileSetFileIdentifier
+
+	// FilterSeries, if provided, excludes the series for which it returns
+	// false from the compacted fileset.
+	FilterSeries func(id ident.BytesID, encodedTags ts.EncodedTags) (bool, error)
+
+	// DeletedRanges, if provided, returns the sorted ranges of a series whose
+	// datapoints are deleted and dropped from the compacted fileset.
+	DeletedRanges func(id ident.BytesID) []xtime.Range
+
+	BlockAllocSize          int
+	Schema                  namespace.SchemaDescr
+	MultiReaderIteratorPool encoding.MultiReaderIteratorPool
+	EncoderPool             encoding.EncoderPool
+}
+
+type compactFileSetSource struct {
+	reader DataFileSetReader
+	entry  StreamedDataEntry
+	done   bool
+}
+
+// CompactFileSets compacts consecutive flushed data filesets into a single
+// fileset of a larger block with the writer, one reader is required for each
+// of the compacted filesets. The series of every fileset are merged in order
+// of their IDs, series found in a single fileset are copied as is while the
+// data of series found in several filesets is re-encoded as a single segment.
+func CompactFileSets(
+	srcReaders []DataFileSetReader,
+	dstWriter StreamingWriter,
+	opts CompactFileSetsOptions,
+) error {
+	if len(opts.FileSets) == 0 {
+		return errCompactNoFileSets
+	}
+	if len(srcReaders) != len(opts.FileSets) {
+		return fmt.Errorf("expected %d readers, got %d", len(opts.FileSets), len(srcReaders))
+	}
+
+	blockEnd := opts.BlockStart.Add(opts.BlockSize)
+	sources := make([]*compactFileSetSource, 0, len(opts.FileSets))
+	defer func() {
+		for _, source := range sources {
+			source.reader.Close() // nolint: errcheck
+		}
+	}()
+
+	var plannedRecordsCount uint
+	for i, fileSet := range opts.FileSets {
+		if fileSet.BlockStart.Before(opts.BlockStart) || !fileSet.BlockStart.Before(blockEnd) {
+			return errCompactFileSetOutOfBlock
+		}
+		openOpts := DataReaderOpenOptions{
+			Identifier: FileSetFileIdentifier{
+				Namespace:   opts.NamespaceID,
+				Shard:       opts.Shard,
+				BlockStart:  fileSet.BlockStart,
+				VolumeIndex: fileSet.VolumeIndex,
+			},
+			FileSetType:      persist.FileSetFlushType,
+			StreamingEnabled: true,
+		}
+		if err := srcReaders[i].Open(openOpts); err != nil {
+			return fmt.Errorf("unable to open reader for block %s: %w", fileSet.BlockStart, err)
+		}
+
+		source := &compactFileSetSource{reader: srcReaders[i]}
+		sources = append(sources, source)
+		if err := source.next(); err != nil {
+			return err
+		}
+		if n := uint(srcReaders[i].Entries()); n > plannedRecordsCount {
+			plannedRecordsCount = n
+		}
+	}
+	if plannedRecordsCount == 0 {
+		plannedRecordsCount = 1
+	}
+
+	writeOpts := StreamingWriterOpenOptions{
+		NamespaceID:         opts.NamespaceID,
+		ShardID:             opts.Shard,
+		BlockStart:          opts.BlockStart,
+		BlockSize:           opts.BlockSize,
+		VolumeIndex:         opts.VolumeIndex,
+		PlannedRecordsCount: plannedRecordsCount,
+	}
+	if err := dstWriter.Open(writeOpts); err != nil {
+		return fmt.Errorf("unable to open writer: %w", err)
+	}
+
+	var (
+		multiIter      = opts.MultiReaderIteratorPool.Get()
+		merging        = make([]*compactFileSetSource, 0, len(sources))
+		segmentReaders = make([]xio.SegmentReader, 0, len(sources))
+		dataHolder     = make([][]byte, 1, 2)
+	)
+	defer multiIter.Close()
+
+	for {
+		// Collect the sources positioned at the lowest series ID, which are
+		// ordered by block start since the sources are.
+		merging = merging[:0]
+		for _, source := range sources {
+			if source.done {
+				continue
+			}
+			if len(merging) > 0 {
+				cmp := bytes.Compare(source.entry.ID, merging[0].entry.ID)
+				if cmp > 0 {
+					continue
+				}
+				if cmp < 0 {
+					merging = merging[:0]
+				}
+			}
+			merging = append(merging, source)
+		}
+		if len(merging) == 0 {
+			break
+		}
+
+		var (
+			entry   = merging[0].entry
+			write   = true
+			deleted []xtime.Range
+		)
+		if opts.FilterSeries != nil {
+			var err error
+			write, err = opts.FilterSeries(entry.ID, entry.EncodedTags)
+			if err != nil {
+				dstWriter.Abort() // nolint: errcheck
+				return err
+			}
+		}
+		if write && opts.DeletedRanges != nil {
+			deleted = opts.DeletedRanges(entry.ID)
+		}
+		switch {
+		case !write:
+			// The series is excluded from the compacted fileset.
+		case len(merging) == 1 && len(deleted) == 0:
+			dataHolder = dataHolder[:1]
+			dataHolder[0] = entry.Data
+			if err := dstWriter.WriteAll(entry.ID, entry.EncodedTags, dataHolder, entry.DataChecksum); err != nil {
+				dstWriter.Abort() // nolint: errcheck
+				return err
+			}
+		default:
+			segmentReaders = segmentReaders[:0]
+			for _, source := range merging {
+				segmentReaders = append(segmentReaders, compactSegmentReader(source.entry.Data))
+			}
+			multiIter.Reset(segmentReaders, opts.BlockStart, opts.BlockSize, opts.Schema)
+			segment, err := encodeIter(multiIter, opts.BlockStart, blockEnd, deleted,
+				opts.BlockAllocSize, opts.Schema, opts.EncoderPool)
+			if err != nil {
+				dstWriter.Abort() // nolint: errcheck
+				return err
+			}
+			if segment.Len() == 0 {
+				// All of the datapoints of the series are deleted.
+				break
+			}
+
+			dataHolder = append(dataHolder[:0], segmentBytes(segment.Head), segmentBytes(segment.Tail))
+			err = dstWriter.WriteAll(entry.ID, entry.EncodedTags, dataHolder, segment.CalculateChecksum())
+			segment.Finalize()
+			if err != nil {
+				dstWriter.Abort() // nolint: errcheck
+				return err
+			}
+		}
+
+		for _, source := range merging {
+			if err := source.next(); err != nil {
+				dstWriter.Abort() // nolint: errcheck
+				return err
+			}
+		}
+	}
+
+	return dstWriter.Close()
+}
+
+func (s *compactFileSetSource) next() error {
+	entry, err := s.reader.StreamingRead()
+	if errors.Is(err, io.EOF) {
+		s.done = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read error: %w", err)
+	}
+	s.entry = entry
+	return nil
+}
+
+// CompactedDataFileSetBlockStart returns the block start of the data fileset
+// of the given volume that holds the block of the given block start after it
+// was compacted into one of the compacted block sizes, if it exists.
+func CompactedDataFileSetBlockStart(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart xtime.UnixNano,
+	volume int,
+	compactedBlockSizes []time.Duration,
+) (xtime.UnixNano, bool, error) {
+	for _, blockSize := range compactedBlockSizes {
+		compactedBlockStart := blockStart.Truncate(blockSize)
+		if compactedBlockStart == blockStart {
+			// A fileset at the block start holds the block whether or not it
+			// was compacted.
+			continue
+		}
+
+		exists, err := DataFileSetExists(filePathPrefix, namespace, shard, compactedBlockStart, volume)
+		if err != nil {
+			return 0, false, err
+		}
+		if exists {
+			return compactedBlockStart, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// SliceCompactedSegment returns a segment with the datapoints of a segment of
+// a compacted block that are within the given block, re-encoded starting at
+// the block start.
+func SliceCompactedSegment(
+	segment ts.Segment,
+	blockStart xtime.UnixNano,
+	blockSize time.Duration,
+	schema namespace.SchemaDescr,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	encoderPool encoding.EncoderPool,
+) (ts.Segment, error) {
+	multiIter := multiIterPool.Get()
+	defer multiIter.Close()
+
+	segmentReaders := []xio.SegmentReader{xio.NewSegmentReader(segment)}
+	multiIter.Reset(segmentReaders, blockStart, blockSize, schema)
+	return encodeIter(multiIter, blockStart, blockStart.Add(blockSize), nil, 0, schema, encoderPool)
+}
+
+// RemoveDeletedDatapoints returns a segment with the datapoints read from the
+// segment readers of a block that are not within any of the sorted deleted
+// ranges, re-encoded starting at the block start. The segment is empty if all
+// of the datapoints are deleted.
+func RemoveDeletedDatapoints(
+	segmentReaders []xio.SegmentReader,
+	blockStart xtime.UnixNano,
+	blockSize time.Duration,
+	deleted []xtime.Range,
+	blockAllocSize int,
+	schema namespace.SchemaDescr,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	encoderPool encoding.EncoderPool,
+) (ts.Segment, error) {
+	multiIter := multiIterPool.Get()
+	defer multiIter.Close()
+
+	multiIter.Reset(segmentReaders, blockStart, blockSize, schema)
+	return encodeIter(multiIter, blockStart, blockStart.Add(blockSize), deleted,
+		blockAllocSize, schema, encoderPool)
+}
+
+// SplitCompactedSegment returns a segment for each of the blocks a compacted
+// block is made of with the datapoints of a segment of the compacted block
+// that are within the block, re-encoded starting at the block start. The
+// segment is decoded once for all the blocks, blocks without datapoints have
+// empty segments.
+func SplitCompactedSegment(
+	segment ts.Segment,
+	compactedRange xtime.Range,
+	blockSize time.Duration,
+	schema namespace.SchemaDescr,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	encoderPool encoding.EncoderPool,
+) ([]ts.Segment, error) {
+	multiIter := multiIterPool.Get()
+	defer multiIter.Close()
+
+	segmentReaders := []xio.SegmentReader{xio.NewSegmentReader(segment)}
+	multiIter.Reset(segmentReaders, compactedRange.Start,
+		compactedRange.End.Sub(compactedRange.Start), schema)
+
+	var (
+		numBlocks  = int(compactedRange.End.Sub(compactedRange.Start) / blockSize)
+		segments   = make([]ts.Segment, 0, numBlocks)
+		blockStart = compactedRange.Start
+		encoder    encoding.Encoder
+	)
+	closeAll := func() {
+		if encoder != nil {
+			encoder.Close()
+		}
+		for _, seg := range segments {
+			seg.Finalize()
+		}
+	}
+	nextBlock := func() {
+		var seg ts.Segment
+		if encoder != nil {
+			seg = encoder.Discard()
+			encoder = nil
+		}
+		segments = append(segments, seg)
+		blockStart = blockStart.Add(blockSize)
+	}
+	for multiIter.Next() {
+		dp, unit, annotation := multiIter.Current()
+		if dp.TimestampNanos.Before(compactedRange.Start) {
+			continue
+		}
+		if !dp.TimestampNanos.Before(compactedRange.End) {
+			break
+		}
+		for !dp.TimestampNanos.Before(blockStart.Add(blockSize)) {
+			nextBlock()
+		}
+		if encoder == nil {
+			encoder = encoderPool.Get()
+			encoder.Reset(blockStart, 0, schema)
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			closeAll()
+			return nil, err
+		}
+	}
+	if err := multiIter.Err(); err != nil {
+		closeAll()
+		return nil, err
+	}
+	for len(segments) < numBlocks {
+		nextBlock()
+	}
+
+	return segments, nil
+}
+
+// encodeIter encodes the datapoints of the iterator within [start, end) that
+// are not within any of the sorted deleted ranges.
+func encodeIter(
+	iter encoding.Iterator,
+	start, end xtime.UnixNano,
+	deleted []xtime.Range,
+	blockAllocSize int,
+	schema namespace.SchemaDescr,
+	encoderPool encoding.EncoderPool,
+) (ts.Segment, error) {
+	encoder := encoderPool.Get()
+	encoder.Reset(start, blockAllocSize, schema)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if dp.TimestampNanos.Before(start) {
+			continue
+		}
+		if !dp.TimestampNanos.Before(end) {
+			break
+		}
+		for len(deleted) > 0 && !deleted[0].End.After(dp.TimestampNanos) {
+			deleted = deleted[1:]
+		}
+		if len(deleted) > 0 && !dp.TimestampNanos.Before(deleted[0].Start) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return ts.Segment{}, err
+	}
+
+	return encoder.Discard(), nil
+}
+
+func compactSegmentReader(data []byte) xio.SegmentReader {
+	b := checked.NewBytes(data, nil)
+	b.IncRef()
+	return xio.NewSegmentReader(ts.NewSegment(b, nil, 0, ts.FinalizeNone))
+}
+
+func segmentBytes(b checked.Bytes) []byte {
+	if b == nil {
+		return nil
+	}
+	return b.Bytes()
+}
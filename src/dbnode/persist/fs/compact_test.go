@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestCompactFileSets(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	var (
+		shard              = uint32(0)
+		compactedBlockSize = 2 * testBlockSize
+		start              = xtime.Now().Truncate(compactedBlockSize)
+		next               = start.Add(testBlockSize)
+		firstEntries       = []testStreamingEntry{
+			{testEntry{"id.a", nil, nil}, []float64{1, 2}},
+			{testEntry{"id.b", nil, nil}, []float64{3}},
+		}
+		secondEntries = []testStreamingEntry{
+			{testEntry{"id.b", nil, nil}, []float64{4, 5}},
+			{testEntry{"id.c", nil, nil}, []float64{6}},
+		}
+	)
+
+	w := newOpenTestStreamingWriter(t, filePathPrefix, shard, start, 0, uint(len(firstEntries)))
+	require.NoError(t, streamingWriteTestData(t, w, start, firstEntries))
+	require.NoError(t, w.Close())
+	w = newOpenTestStreamingWriter(t, filePathPrefix, shard, next, 0, uint(len(secondEntries)))
+	require.NoError(t, streamingWriteTestData(t, w, next, secondEntries))
+	require.NoError(t, w.Close())
+
+	opts := CompactFileSetsOptions{
+		NamespaceID: testNs1ID,
+		Shard:       shard,
+		BlockStart:  start,
+		BlockSize:   compactedBlockSize,
+		VolumeIndex: 1,
+		FileSets: []FileSetFileIdentifier{
+			{BlockStart: start, VolumeIndex: 0},
+			{BlockStart: next, VolumeIndex: 0},
+		},
+		MultiReaderIteratorPool: multiIterPool,
+		EncoderPool:             encoderPool,
+	}
+	srcReaders := []DataFileSetReader{
+		newTestReader(t, filePathPrefix),
+		newTestReader(t, filePathPrefix),
+	}
+	require.NoError(t, CompactFileSets(srcReaders, newTestStreamingWriter(t, filePathPrefix), opts))
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   testNs1ID,
+			Shard:       shard,
+			BlockStart:  start,
+			VolumeIndex: 1,
+		},
+		StreamingEnabled: true,
+	}))
+	require.Equal(t, compactedBlockSize, r.Status().BlockSize)
+
+	tenMinutes := 10 * time.Minute
+	expected := []struct {
+		id     string
+		points []ts.Datapoint
+	}{
+		{id: "id.a", points: []ts.Datapoint{
+			{TimestampNanos: start, Value: 1},
+			{TimestampNanos: start.Add(tenMinutes), Value: 2},
+		}},
+		{id: "id.b", points: []ts.Datapoint{
+			{TimestampNanos: start, Value: 3},
+			{TimestampNanos: next, Value: 4},
+			{TimestampNanos: next.Add(tenMinutes), Value: 5},
+		}},
+		{id: "id.c", points: []ts.Datapoint{
+			{TimestampNanos: next, Value: 6},
+		}},
+	}
+	var compactedB []byte
+	for _, e := range expected {
+		entry, err := r.StreamingRead()
+		require.NoError(t, err)
+		require.Equal(t, e.id, string(entry.ID))
+		require.Equal(t, e.points, readCompactTestDatapoints(t, entry.Data))
+		if e.id == "id.b" {
+			compactedB = append([]byte(nil), entry.Data...)
+		}
+	}
+	_, err := r.StreamingRead()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, r.Close())
+
+	// The second block is located in the compacted fileset.
+	compactedStart, ok, err := CompactedDataFileSetBlockStart(filePathPrefix, testNs1ID,
+		shard, next, 1, []time.Duration{compactedBlockSize})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, start, compactedStart)
+
+	_, ok, err = CompactedDataFileSetBlockStart(filePathPrefix, testNs1ID,
+		shard, next, 2, []time.Duration{compactedBlockSize})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// The data of a block is sliced out of the compacted data.
+	segment := ts.NewSegment(checked.NewBytes(compactedB, nil), nil, 0, ts.FinalizeNone)
+	sliced, err := SliceCompactedSegment(segment, next, testBlockSize, nil,
+		multiIterPool, encoderPool)
+	require.NoError(t, err)
+	slicedData := append(segmentBytes(sliced.Head), segmentBytes(sliced.Tail)...)
+	require.Equal(t, expected[1].points[1:], readCompactTestDatapoints(t, slicedData))
+
+	// The data of all the blocks is split out of the compacted data at once.
+	split, err := SplitCompactedSegment(segment,
+		xtime.Range{Start: start, End: start.Add(compactedBlockSize)}, testBlockSize, nil,
+		multiIterPool, encoderPool)
+	require.NoError(t, err)
+	require.Len(t, split, 2)
+	for i, points := range [][]ts.Datapoint{expected[1].points[:1], expected[1].points[1:]} {
+		splitData := append(segmentBytes(split[i].Head), segmentBytes(split[i].Tail)...)
+		require.Equal(t, points, readCompactTestDatapoints(t, splitData))
+	}
+}
+
+func TestCompactFileSetsFilterSeries(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	var (
+		shard   = uint32(0)
+		start   = xtime.Now().Truncate(testBlockSize)
+		entries = []testStreamingEntry{
+			{testEntry{"id.a", nil, nil}, []float64{1}},
+			{testEntry{"id.b", nil, nil}, []float64{2}},
+			{testEntry{"id.c", nil, nil}, []float64{3}},
+		}
+	)
+
+	w := newOpenTestStreamingWriter(t, filePathPrefix, shard, start, 0, uint(len(entries)))
+	require.NoError(t, streamingWriteTestData(t, w, start, entries))
+	require.NoError(t, w.Close())
+
+	opts := CompactFileSetsOptions{
+		NamespaceID: testNs1ID,
+		Shard:       shard,
+		BlockStart:  start,
+		BlockSize:   testBlockSize,
+		VolumeIndex: 1,
+		FileSets: []FileSetFileIdentifier{
+			{BlockStart: start, VolumeIndex: 0},
+		},
+		FilterSeries: func(id ident.BytesID, _ ts.EncodedTags) (bool, error) {
+			return string(id) != "id.b", nil
+		},
+		MultiReaderIteratorPool: multiIterPool,
+		EncoderPool:             encoderPool,
+	}
+	srcReaders := []DataFileSetReader{newTestReader(t, filePathPrefix)}
+	require.NoError(t, CompactFileSets(srcReaders, newTestStreamingWriter(t, filePathPrefix), opts))
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   testNs1ID,
+			Shard:       shard,
+			BlockStart:  start,
+			VolumeIndex: 1,
+		},
+		StreamingEnabled: true,
+	}))
+	for _, id := range []string{"id.a", "id.c"} {
+		entry, err := r.StreamingRead()
+		require.NoError(t, err)
+		require.Equal(t, id, string(entry.ID))
+	}
+	_, err := r.StreamingRead()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, r.Close())
+}
+
+func TestCompactFileSetsDeletedRanges(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	var (
+		shard      = uint32(0)
+		start      = xtime.Now().Truncate(testBlockSize)
+		tenMinutes = 10 * time.Minute
+		entries    = []testStreamingEntry{
+			{testEntry{"id.a", nil, nil}, []float64{1, 2}},
+			{testEntry{"id.b", nil, nil}, []float64{3}},
+			{testEntry{"id.c", nil, nil}, []float64{4}},
+		}
+	)
+
+	w := newOpenTestStreamingWriter(t, filePathPrefix, shard, start, 0, uint(len(entries)))
+	require.NoError(t, streamingWriteTestData(t, w, start, entries))
+	require.NoError(t, w.Close())
+
+	opts := CompactFileSetsOptions{
+		NamespaceID: testNs1ID,
+		Shard:       shard,
+		BlockStart:  start,
+		BlockSize:   testBlockSize,
+		VolumeIndex: 1,
+		FileSets: []FileSetFileIdentifier{
+			{BlockStart: start, VolumeIndex: 0},
+		},
+		DeletedRanges: func(id ident.BytesID) []xtime.Range {
+			switch string(id) {
+			case "id.a":
+				return []xtime.Range{{Start: start.Add(tenMinutes), End: start.Add(2 * tenMinutes)}}
+			case "id.b":
+				return []xtime.Range{{Start: start, End: start.Add(tenMinutes)}}
+			}
+			return nil
+		},
+		MultiReaderIteratorPool: multiIterPool,
+		EncoderPool:             encoderPool,
+	}
+	srcReaders := []DataFileSetReader{newTestReader(t, filePathPrefix)}
+	require.NoError(t, CompactFileSets(srcReaders, newTestStreamingWriter(t, filePathPrefix), opts))
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   testNs1ID,
+			Shard:       shard,
+			BlockStart:  start,
+			VolumeIndex: 1,
+		},
+		StreamingEnabled: true,
+	}))
+	expected := []struct {
+		id     string
+		points []ts.Datapoint
+	}{
+		{id: "id.a", points: []ts.Datapoint{{TimestampNanos: start, Value: 1}}},
+		{id: "id.c", points: []ts.Datapoint{{TimestampNanos: start, Value: 4}}},
+	}
+	for _, e := range expected {
+		entry, err := r.StreamingRead()
+		require.NoError(t, err)
+		require.Equal(t, e.id, string(entry.ID))
+		require.Equal(t, e.points, readCompactTestDatapoints(t, entry.Data))
+	}
+	_, err := r.StreamingRead()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, r.Close())
+}
+
+func readCompactTestDatapoints(t *testing.T, data []byte) []ts.Datapoint {
+	iter := m3tsz.NewReaderIterator(xio.NewBytesReader64(data), true, encoding.NewOptions())
+	defer iter.Close()
+
+	var points []ts.Datapoint
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		points = append(points, dp)
+	}
+	require.NoError(t, iter.Err())
+	return points
+}
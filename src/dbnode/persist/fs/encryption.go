@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/x/encryption"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/mmap"
+)
+
+var errEncryptionKeyProviderNotSet = errors.New(
+	"file is encrypted but no encryption key provider is set")
+
+// resetFdWithDigestWriter resets the writer to write to the file, encrypting
+// its contents if the key provider is set. The digest is always calculated
+// over the plaintext.
+func resetFdWithDigestWriter(
+	fdWithDigest digest.FdWithDigestWriter,
+	fd *os.File,
+	keyProvider encryption.KeyProvider,
+) error {
+	if keyProvider == nil {
+		fdWithDigest.Reset(fd)
+		return nil
+	}
+
+	encryptionWriter, err := encryption.NewWriter(fd, keyProvider)
+	if err != nil {
+		return fmt.Errorf("could not encrypt %s: %v", fd.Name(), err)
+	}
+	fdWithDigest.ResetWithWriter(fd, encryptionWriter)
+	return nil
+}
+
+// newDecryptingReaderAt returns a reader that decrypts the file and true if
+// the file is encrypted, files written in plaintext can always be read even
+// if the key provider is set.
+func newDecryptingReaderAt(
+	fd *os.File,
+	keyProvider encryption.KeyProvider,
+) (*encryption.ReaderAt, bool, error) {
+	encrypted, err := encryption.IsEncrypted(fd)
+	if err != nil || !encrypted {
+		return nil, false, err
+	}
+	if keyProvider == nil {
+		return nil, true, fmt.Errorf("%s: %v", fd.Name(), errEncryptionKeyProviderNotSet)
+	}
+
+	stat, err := fd.Stat()
+	if err != nil {
+		return nil, true, err
+	}
+	reader, err := encryption.NewReaderAt(fd, stat.Size(), keyProvider)
+	if err != nil {
+		return nil, true, fmt.Errorf("could not decrypt %s: %v", fd.Name(), err)
+	}
+	return reader, true, nil
+}
+
+// mmapFile mmaps the file, if the file is encrypted it is decrypted into an
+// anonymous region of memory instead.
+func mmapFile(
+	fd *os.File,
+	keyProvider encryption.KeyProvider,
+	opts mmap.Options,
+) (mmap.Descriptor, error) {
+	reader, encrypted, err := newDecryptingReaderAt(fd, keyProvider)
+	if err != nil {
+		return mmap.Descriptor{}, err
+	}
+	if !encrypted {
+		return mmap.File(fd, opts)
+	}
+
+	// The region needs to be writable so the decrypted bytes can be copied
+	// into it.
+	opts.Write = true
+	desc, err := mmap.Bytes(reader.Size(), opts)
+	if err != nil {
+		return mmap.Descriptor{}, err
+	}
+	if _, err := reader.ReadAt(desc.Bytes, 0); err != nil && err != io.EOF {
+		mmap.Munmap(desc)
+		return mmap.Descriptor{}, fmt.Errorf("could not decrypt %s: %v", fd.Name(), err)
+	}
+	return desc, nil
+}
+
+// mmapFiles mmaps a group of files at once like mmap.Files, decrypting the
+// files that are encrypted.
+func mmapFiles(
+	opener mmap.FileOpener,
+	keyProvider encryption.KeyProvider,
+	files map[string]mmap.FileDesc,
+) (mmap.FilesResult, error) {
+	var (
+		multiWarn = xerrors.NewMultiError()
+		multiErr  = xerrors.NewMultiError()
+	)
+	for filePath, fileDesc := range files {
+		fd, err := opener(filePath)
+		if err != nil {
+			multiErr = multiErr.Add(fmt.Errorf("file %s encountered err: %v", filePath, err))
+			break
+		}
+
+		desc, err := mmapFile(fd, keyProvider, fileDesc.Options)
+		if err != nil {
+			multiErr = multiErr.Add(fmt.Errorf("file %s encountered err: %v", filePath, err))
+			fd.Close()
+			break
+		}
+		if desc.Warning != nil {
+			multiWarn = multiWarn.Add(fmt.Errorf("file %s encountered warning: %v", filePath, desc.Warning))
+		}
+
+		*fileDesc.File = fd
+		*fileDesc.Descriptor = desc
+	}
+
+	if multiErr.FinalError() == nil {
+		return mmap.FilesResult{Warning: multiWarn.FinalError()}, nil
+	}
+
+	// Close and unmap the files that have been opened.
+	for _, fileDesc := range files {
+		if *fileDesc.File != nil {
+			multiErr = multiErr.Add((*fileDesc.File).Close())
+			*fileDesc.File = nil
+		}
+		multiErr = multiErr.Add(mmap.Munmap(*fileDesc.Descriptor))
+		*fileDesc.Descriptor = mmap.Descriptor{}
+	}
+
+	return mmap.FilesResult{Warning: multiWarn.FinalError()}, multiErr.FinalError()
+}
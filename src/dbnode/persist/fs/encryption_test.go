@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+	"github.com/m3db/m3/src/x/encryption"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+func newTestEncryptionKeyProvider(t *testing.T, dir string) encryption.KeyProvider {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "key")
+	err = ioutil.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)), 0600)
+	require.NoError(t, err)
+
+	keyProvider, err := encryption.NewKeyFileProvider(path)
+	require.NoError(t, err)
+	return keyProvider
+}
+
+func TestEncryptedReadWrite(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "data")
+	defer os.RemoveAll(dir)
+
+	var (
+		keyProvider = newTestEncryptionKeyProvider(t, dir)
+		opts        = testDefaultOpts.
+				SetFilePathPrefix(filePathPrefix).
+				SetWriterBufferSize(testWriterBufferSize).
+				SetInfoReaderBufferSize(testReaderBufferSize).
+				SetDataReaderBufferSize(testReaderBufferSize).
+				SetEncryptionKeyProvider(keyProvider)
+		plaintext = bytes.Repeat([]byte("plaintext"), 10000)
+		entries   = []testEntry{
+			{"foo", nil, []byte{1, 2, 3}},
+			{"bar", nil, plaintext},
+			{"baz", map[string]string{"qux": "qaz"}, []byte{4, 5, 6}},
+		}
+	)
+
+	w, err := NewWriter(opts)
+	require.NoError(t, err)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	// The data file must not contain the plaintext.
+	shardDir := ShardDataDirPath(filePathPrefix, testNs1ID, 0)
+	dataFilePath := dataFilesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, dataFileSuffix, false)
+	data, err := ioutil.ReadFile(dataFilePath)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(data, plaintext[:100]))
+
+	r, err := NewReader(testBytesPool, opts)
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+
+	resources := newTestReusableSeekerResources()
+	s := NewSeeker(filePathPrefix, testReaderBufferSize, testReaderBufferSize,
+		testBytesPool, false, opts)
+	require.NoError(t, s.Open(testNs1ID, 0, testWriterStart, 0, resources))
+	for _, entry := range entries {
+		assert.True(t, s.ConcurrentIDBloomFilter().Test(entry.ID().Bytes()))
+
+		result, err := s.SeekByID(entry.ID(), resources)
+		require.NoError(t, err)
+		result.IncRef()
+		assert.Equal(t, entry.data, result.Bytes())
+		result.DecRef()
+	}
+	_, err = s.SeekByID(ident.StringID("qux"), resources)
+	assert.Equal(t, errSeekIDNotFound, err)
+	require.NoError(t, s.Close())
+
+	// Encrypted files cannot be read without the key provider.
+	r = newTestReader(t, filePathPrefix)
+	err = r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:  testNs1ID,
+			Shard:      0,
+			BlockStart: testWriterStart,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errEncryptionKeyProviderNotSet.Error())
+}
+
+func TestEncryptedReadPlaintextFiles(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "data")
+	defer os.RemoveAll(dir)
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", nil, []byte{4, 5, 6}},
+	}
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	r, err := NewReader(testBytesPool, testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetEncryptionKeyProvider(newTestEncryptionKeyProvider(t, dir)))
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+}
+
+func TestEncryptedIndexReadWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	test := newIndexWriteTestSetup(t)
+	defer test.cleanup()
+
+	var (
+		keyProvider = newTestEncryptionKeyProvider(t, test.rootDir)
+		opts        = testDefaultOpts.
+				SetFilePathPrefix(test.filePathPrefix).
+				SetWriterBufferSize(testWriterBufferSize).
+				SetIndexReaderAutovalidateIndexSegments(true).
+				SetEncryptionKeyProvider(keyProvider)
+	)
+
+	writer, err := NewIndexWriter(opts)
+	require.NoError(t, err)
+	err = writer.Open(IndexWriterOpenOptions{
+		Identifier:  test.fileSetID,
+		BlockSize:   test.blockSize,
+		FileSetType: persist.FileSetFlushType,
+		Shards:      shardsSet(1, 3),
+	})
+	require.NoError(t, err)
+
+	testSegments := []testIndexSegment{
+		{
+			segmentType:  idxpersist.IndexSegmentType("fst"),
+			majorVersion: 1,
+			minorVersion: 2,
+			files: []testIndexSegmentFile{
+				{idxpersist.IndexSegmentFileType("first"), randDataFactorOfBuffSize(t, 1.5)},
+				{idxpersist.IndexSegmentFileType("second"), randDataFactorOfBuffSize(t, 2.5)},
+			},
+		},
+	}
+	writeTestIndexSegments(t, ctrl, writer, testSegments)
+	require.NoError(t, writer.Close())
+
+	for _, segmentFile := range testSegments[0].files {
+		path := filesetIndexSegmentFilePathFromTime(
+			NamespaceIndexDataDirPath(test.filePathPrefix, test.fileSetID.Namespace),
+			test.blockStart, 0, 0, segmentFile.segmentFileType)
+		fd, err := os.Open(path)
+		require.NoError(t, err)
+		encrypted, err := encryption.IsEncrypted(fd)
+		require.NoError(t, err)
+		assert.True(t, encrypted)
+		require.NoError(t, fd.Close())
+	}
+
+	reader, err := NewIndexReader(opts)
+	require.NoError(t, err)
+	result, err := reader.Open(IndexReaderOpenOptions{
+		Identifier:  test.fileSetID,
+		FileSetType: persist.FileSetFlushType,
+	})
+	require.NoError(t, err)
+	require.Equal(t, shardsSet(1, 3), result.Shards)
+
+	readTestIndexSegments(t, ctrl, reader, testSegments)
+	require.NoError(t, reader.Validate())
+	require.NoError(t, reader.Close())
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	exemplarsFileName = "exemplars" + fileSuffix
+
+	exemplarsFormatVersion = 1
+)
+
+var (
+	errExemplarsFileTooShort  = errors.New("exemplars file too short")
+	errExemplarsFileCorrupted = errors.New("exemplars file corrupted")
+)
+
+// SeriesExemplars describes the exemplars retained for a series.
+type SeriesExemplars struct {
+	ID        []byte
+	Exemplars []ts.Exemplar
+}
+
+// ExemplarsFilePath returns the path to the exemplars file of a shard.
+func ExemplarsFilePath(prefix string, namespace ident.ID, shard uint32) string {
+	return path.Join(ShardDataDirPath(prefix, namespace, shard), exemplarsFileName)
+}
+
+// WriteExemplars persists the exemplars retained for a shard, replacing any
+// exemplars previously written for it.
+func WriteExemplars(
+	opts Options,
+	namespace ident.ID,
+	shard uint32,
+	exemplars []SeriesExemplars,
+) error {
+	var (
+		shardDir = ShardDataDirPath(opts.FilePathPrefix(), namespace, shard)
+		filePath = path.Join(shardDir, exemplarsFileName)
+	)
+	if len(exemplars) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	return writeShardSidecarFile(opts, shardDir, exemplarsFileName, encodeExemplars(exemplars))
+}
+
+// ReadExemplars reads the exemplars for a shard, returning no exemplars and
+// no error if none have been written.
+func ReadExemplars(
+	prefix string,
+	namespace ident.ID,
+	shard uint32,
+) ([]SeriesExemplars, error) {
+	filePath := ExemplarsFilePath(prefix, namespace, shard)
+	exists, err := FileExists(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	buf, err := read(filePath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeExemplars(buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read exemplars file %s: %w", filePath, err)
+	}
+	return result, nil
+}
+
+// encodeExemplars encodes exemplars as a version header followed by each
+// series ID and its exemplars, and a trailing digest of the contents.
+func encodeExemplars(exemplars []SeriesExemplars) []byte {
+	buf := make([]byte, 0, 128*len(exemplars))
+	buf = binary.AppendUvarint(buf, exemplarsFormatVersion)
+	buf = binary.AppendUvarint(buf, uint64(len(exemplars)))
+	for _, s := range exemplars {
+		buf = binary.AppendUvarint(buf, uint64(len(s.ID)))
+		buf = append(buf, s.ID...)
+		buf = binary.AppendUvarint(buf, uint64(len(s.Exemplars)))
+		for _, e := range s.Exemplars {
+			buf = binary.AppendVarint(buf, int64(e.TimestampNanos))
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(e.Value))
+			buf = binary.AppendUvarint(buf, uint64(len(e.EncodedLabels)))
+			buf = append(buf, e.EncodedLabels...)
+		}
+	}
+
+	digestBuf := digest.NewBuffer()
+	digestBuf.WriteDigest(digest.Checksum(buf))
+	return append(buf, digestBuf...)
+}
+
+func decodeExemplars(buf []byte) ([]SeriesExemplars, error) {
+	if len(buf) < digest.DigestLenBytes {
+		return nil, errExemplarsFileTooShort
+	}
+
+	var (
+		contents = buf[:len(buf)-digest.DigestLenBytes]
+		expected = digest.ToBuffer(buf[len(buf)-digest.DigestLenBytes:]).ReadDigest()
+	)
+	if digest.Checksum(contents) != expected {
+		return nil, errExemplarsFileCorrupted
+	}
+
+	d := sidecarDecoder{buf: contents, corrupted: errExemplarsFileCorrupted}
+	version := d.uvarint()
+	if d.err == nil && version != exemplarsFormatVersion {
+		return nil, fmt.Errorf("unsupported exemplars format version: %d", version)
+	}
+
+	n := d.uvarint()
+	if d.err != nil {
+		return nil, d.err
+	}
+	result := make([]SeriesExemplars, 0, n)
+	for i := uint64(0); i < n; i++ {
+		id := d.bytes(d.uvarint())
+		numExemplars := d.uvarint()
+		if d.err != nil {
+			return nil, d.err
+		}
+		exemplars := make([]ts.Exemplar, 0, numExemplars)
+		for j := uint64(0); j < numExemplars; j++ {
+			exemplars = append(exemplars, ts.Exemplar{
+				TimestampNanos: xtime.UnixNano(d.varint()),
+				Value:          d.float64(),
+				EncodedLabels:  d.bytes(d.uvarint()),
+			})
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		result = append(result, SeriesExemplars{
+			ID:        id,
+			Exemplars: exemplars,
+		})
+	}
+	return result, nil
+}
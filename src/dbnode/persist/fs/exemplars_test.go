@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestExemplarsWriteAndRead(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		shard     = uint32(3)
+	)
+	defer os.RemoveAll(dir)
+
+	exemplars, err := ReadExemplars(dir, namespace, shard)
+	require.NoError(t, err)
+	require.Nil(t, exemplars)
+
+	expected := []SeriesExemplars{
+		{
+			ID: []byte("foo"),
+			Exemplars: []ts.Exemplar{
+				{
+					TimestampNanos: xtime.UnixNano(1000),
+					Value:          1.5,
+					EncodedLabels:  ts.EncodedTags("trace_id=abc"),
+				},
+				{
+					TimestampNanos: xtime.UnixNano(2000),
+					Value:          -3,
+				},
+			},
+		},
+		{
+			ID: []byte("bar"),
+			Exemplars: []ts.Exemplar{
+				{
+					TimestampNanos: xtime.UnixNano(3000),
+					Value:          42,
+					EncodedLabels:  ts.EncodedTags("trace_id=def"),
+				},
+			},
+		},
+	}
+	require.NoError(t, WriteExemplars(opts, namespace, shard, expected))
+
+	exemplars, err = ReadExemplars(dir, namespace, shard)
+	require.NoError(t, err)
+	require.Equal(t, expected, exemplars)
+
+	// Writing an empty set of exemplars removes the file.
+	require.NoError(t, WriteExemplars(opts, namespace, shard, nil))
+	exists, err := FileExists(ExemplarsFilePath(dir, namespace, shard))
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestExemplarsReadCorrupted(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		shard     = uint32(0)
+	)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, WriteExemplars(opts, namespace, shard, []SeriesExemplars{
+		{ID: []byte("foo"), Exemplars: []ts.Exemplar{{TimestampNanos: xtime.UnixNano(1), Value: 1}}},
+	}))
+
+	filePath := ExemplarsFilePath(dir, namespace, shard)
+	buf, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	buf[1] ^= 0xff
+	require.NoError(t, os.WriteFile(filePath, buf, opts.NewFileMode()))
+
+	_, err = ReadExemplars(dir, namespace, shard)
+	require.Error(t, err)
+}
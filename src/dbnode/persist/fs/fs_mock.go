@@ -533,6 +533,20 @@ func (mr *MockDataFileSetSeekerMockRecorder) SeekIndexEntry(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekIndexEntry", reflect.TypeOf((*MockDataFileSetSeeker)(nil).SeekIndexEntry), arg0, arg1)
 }
 
+// Volume mocks base method.
+func (m *MockDataFileSetSeeker) Volume() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Volume")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Volume indicates an expected call of Volume.
+func (mr *MockDataFileSetSeekerMockRecorder) Volume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Volume", reflect.TypeOf((*MockDataFileSetSeeker)(nil).Volume))
+}
+
 // MockIndexFileSetWriter is a mock of IndexFileSetWriter interface.
 type MockIndexFileSetWriter struct {
 	ctrl     *gomock.Controller
@@ -1209,6 +1223,20 @@ func (mr *MockConcurrentDataFileSetSeekerMockRecorder) ConcurrentIDBloomFilter()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConcurrentIDBloomFilter", reflect.TypeOf((*MockConcurrentDataFileSetSeeker)(nil).ConcurrentIDBloomFilter))
 }
 
+// Range mocks base method.
+func (m *MockConcurrentDataFileSetSeeker) Range() time.Range {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Range")
+	ret0, _ := ret[0].(time.Range)
+	return ret0
+}
+
+// Range indicates an expected call of Range.
+func (mr *MockConcurrentDataFileSetSeekerMockRecorder) Range() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Range", reflect.TypeOf((*MockConcurrentDataFileSetSeeker)(nil).Range))
+}
+
 // SeekByID mocks base method.
 func (m *MockConcurrentDataFileSetSeeker) SeekByID(arg0 ident.ID, arg1 ReusableSeekerResources) (checked.Bytes, error) {
 	m.ctrl.T.Helper()
@@ -1254,6 +1282,20 @@ func (mr *MockConcurrentDataFileSetSeekerMockRecorder) SeekIndexEntry(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekIndexEntry", reflect.TypeOf((*MockConcurrentDataFileSetSeeker)(nil).SeekIndexEntry), arg0, arg1)
 }
 
+// Volume mocks base method.
+func (m *MockConcurrentDataFileSetSeeker) Volume() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Volume")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Volume indicates an expected call of Volume.
+func (mr *MockConcurrentDataFileSetSeekerMockRecorder) Volume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Volume", reflect.TypeOf((*MockConcurrentDataFileSetSeeker)(nil).Volume))
+}
+
 // MockMergeWith is a mock of MergeWith interface.
 type MockMergeWith struct {
 	ctrl     *gomock.Controller
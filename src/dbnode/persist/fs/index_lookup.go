@@ -27,6 +27,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/digest"
 	xmsgpack "github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/mmap"
 )
@@ -161,10 +162,12 @@ func newNearestIndexOffsetLookupFromSummariesFile(
 	decoderStream xmsgpack.ByteDecoderStream,
 	numEntries int,
 	forceMmapMemory bool,
+	keyProvider encryption.KeyProvider,
 	reporterOptions mmap.ReporterOptions,
 ) (*nearestIndexOffsetLookup, error) {
 	reporterOptions.Context.Name = mmapPersistFsSummariesFileName
-	summariesMmap, err := validateAndMmap(summariesFdWithDigest, expectedDigest, forceMmapMemory, reporterOptions)
+	summariesMmap, err := validateAndMmap(summariesFdWithDigest, expectedDigest,
+		forceMmapMemory, keyProvider, reporterOptions)
 	if err != nil {
 		return nil, err
 	}
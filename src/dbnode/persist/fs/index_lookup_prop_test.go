@@ -123,7 +123,7 @@ func TestIndexLookupWriteRead(t *testing.T) {
 		decoderStream := msgpack.NewByteDecoderStream(nil)
 		indexLookup, err := newNearestIndexOffsetLookupFromSummariesFile(
 			summariesFdWithDigest, expectedSummariesDigest,
-			decoder, decoderStream, len(writes), input.forceMmapMemory, mmap.ReporterOptions{})
+			decoder, decoderStream, len(writes), input.forceMmapMemory, nil, mmap.ReporterOptions{})
 		if err != nil {
 			return false, fmt.Errorf("err reading index lookup from summaries file: %v, ", err)
 		}
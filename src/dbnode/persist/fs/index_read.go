@@ -234,7 +234,7 @@ func (r *indexReader) ReadSegmentFileSet() (
 			fd   *os.File
 			desc mmap.Descriptor
 		)
-		mmapResult, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
+		mmapResult, err := mmapFiles(os.Open, r.opts.EncryptionKeyProvider(), map[string]mmap.FileDesc{
 			filePath: {
 				File:       &fd,
 				Descriptor: &desc,
@@ -270,7 +270,8 @@ func (r *indexReader) ReadSegmentFileSet() (
 		}
 
 		// NB(bodu): Free mmaped bytes after we take the checksum so we don't
-		// get memory spikes at bootstrap time.
+		// get memory spikes at bootstrap time. This is a no-op for encrypted
+		// files as they are decrypted into anonymous memory.
 		if err := mmap.MadviseDontNeed(desc); err != nil {
 			return nil, err
 		}
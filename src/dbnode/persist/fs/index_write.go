@@ -214,7 +214,11 @@ func (w *indexWriter) WriteSegmentFileSet(
 
 		// Use buffered IO writer to write the file in case the reader
 		// returns small chunks of data
-		w.fdWithDigest.Reset(fd)
+		err = resetFdWithDigestWriter(w.fdWithDigest, fd, w.opts.EncryptionKeyProvider())
+		if err != nil {
+			fd.Close()
+			return w.markSegmentWriteError(segType, segFileType, err)
+		}
 		digest := w.fdWithDigest.Digest()
 		writer := bufio.NewWriter(w.fdWithDigest)
 		writeErr := segmentFileSet.WriteFile(segFileType, writer)
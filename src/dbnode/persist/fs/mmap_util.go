@@ -22,8 +22,10 @@ package fs
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/mmap"
 )
 
@@ -31,8 +33,18 @@ func validateAndMmap(
 	fdWithDigest digest.FdWithDigestReader,
 	expectedDigest uint32,
 	forceMmapMemory bool,
+	keyProvider encryption.KeyProvider,
 	reporterOptions mmap.ReporterOptions,
 ) (mmap.Descriptor, error) {
+	reader, encrypted, err := newDecryptingReaderAt(fdWithDigest.Fd(), keyProvider)
+	if err != nil {
+		return mmap.Descriptor{}, err
+	}
+	if encrypted {
+		return validateAndMmapDecrypted(fdWithDigest.Fd().Name(), reader,
+			expectedDigest, reporterOptions)
+	}
+
 	if forceMmapMemory {
 		return validateAndMmapMemory(fdWithDigest, expectedDigest, reporterOptions)
 	}
@@ -91,3 +103,29 @@ func validateAndMmapFile(
 
 	return mmapDescriptor, nil
 }
+
+func validateAndMmapDecrypted(
+	name string,
+	reader *encryption.ReaderAt,
+	expectedDigest uint32,
+	reporterOptions mmap.ReporterOptions,
+) (mmap.Descriptor, error) {
+	// Encrypted files are always decrypted into an anonymous mmap'd region.
+	mmapDescriptor, err := mmap.Bytes(reader.Size(), mmap.Options{Read: true, Write: true, ReporterOptions: reporterOptions})
+	if err != nil {
+		return mmap.Descriptor{}, err
+	}
+
+	if _, err := reader.ReadAt(mmapDescriptor.Bytes, 0); err != nil && err != io.EOF {
+		mmap.Munmap(mmapDescriptor)
+		return mmap.Descriptor{}, fmt.Errorf("could not decrypt %s: %v", name, err)
+	}
+
+	if calculatedDigest := digest.Checksum(mmapDescriptor.Bytes); calculatedDigest != expectedDigest {
+		mmap.Munmap(mmapDescriptor)
+		return mmap.Descriptor{}, fmt.Errorf("expected %s file digest was: %d, but got: %d",
+			name, expectedDigest, calculatedDigest)
+	}
+
+	return mmapDescriptor, nil
+}
@@ -0,0 +1,435 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	errOffloadFileSetNotFound     = errors.New("data fileset to offload not found")
+	errRemoteCacheStoreNotSet     = errors.New("remote fileset cache object store is not set")
+	errRemoteCacheFilePathsNotSet = errors.New("remote fileset cache file path prefixes are not set")
+	errRemoteFileSetFetching      = xerrors.NewRetryableError(
+		errors.New("offloaded fileset is being fetched from the object store"))
+
+	// offloadedFileSetSuffixes are the suffixes of the data fileset files
+	// that are offloaded, the checkpoint file is uploaded and downloaded
+	// last so that a fileset is only complete once all its files are.
+	offloadedFileSetSuffixes = []string{
+		InfoFileSuffix,
+		indexFileSuffix,
+		summariesFileSuffix,
+		bloomFilterFileSuffix,
+		dataFileSuffix,
+		DigestFileSuffix,
+		CheckpointFileSuffix,
+	}
+
+	// remoteOnlyFileSetSuffixes are the suffixes of the data fileset files
+	// that are removed from local disk once offloaded. The info, digest and
+	// checkpoint files are kept so that offloaded filesets are still known
+	// locally, for instance to bootstrap the flush states of shards.
+	remoteOnlyFileSetSuffixes = []string{
+		indexFileSuffix,
+		summariesFileSuffix,
+		bloomFilterFileSuffix,
+		dataFileSuffix,
+	}
+)
+
+// OffloadDataFileSet uploads the files of a data fileset to the object store
+// and then removes the files only needed to read its data from local disk.
+func OffloadDataFileSet(
+	store objectstore.Store,
+	filePathPrefix string,
+	id FileSetFileIdentifier,
+) error {
+	exists, err := DataFileSetExists(filePathPrefix, id.Namespace, id.Shard,
+		id.BlockStart, id.VolumeIndex)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errOffloadFileSetNotFound
+	}
+
+	shardDir := ShardDataDirPath(filePathPrefix, id.Namespace, id.Shard)
+	for _, suffix := range offloadedFileSetSuffixes {
+		filePath := FilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex, suffix)
+		if err := putFile(store, filePathPrefix, filePath); err != nil {
+			return fmt.Errorf("unable to offload %s: %w", filePath, err)
+		}
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, suffix := range remoteOnlyFileSetSuffixes {
+		filePath := FilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex, suffix)
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// DataFileSetOffloaded returns whether a data fileset exists and has been
+// offloaded to an object store.
+func DataFileSetOffloaded(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart xtime.UnixNano,
+	volume int,
+) (bool, error) {
+	exists, err := DataFileSetExists(filePathPrefix, namespace, shard, blockStart, volume)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	shardDir := ShardDataDirPath(filePathPrefix, namespace, shard)
+	dataFilePath := FilesetPathFromTimeAndIndex(shardDir, blockStart, volume, dataFileSuffix)
+	_, err = os.Stat(dataFilePath)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+// DeleteOffloadedDataFileSetsBefore removes the offloaded data filesets of a
+// shard with block starts before the given time from the object store.
+func DeleteOffloadedDataFileSetsBefore(
+	store objectstore.Store,
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	t xtime.UnixNano,
+) error {
+	shardKey, err := objectKey(filePathPrefix, ShardDataDirPath(filePathPrefix, namespace, shard))
+	if err != nil {
+		return err
+	}
+	keys, err := store.List(shardKey + "/")
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, key := range keys {
+		blockStart, _, err := TimeAndVolumeIndexFromDataFileSetFilename(path.Base(key))
+		if err != nil || !blockStart.Before(t) {
+			continue
+		}
+		if err := store.Delete(key); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// objectKey returns the object store key of a file, which is its path
+// relative to the file path prefix.
+func objectKey(filePathPrefix, filePath string) (string, error) {
+	rel, err := filepath.Rel(filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func putFile(store objectstore.Store, filePathPrefix, filePath string) error {
+	key, err := objectKey(filePathPrefix, filePath)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filePath) // nolint: gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+	return store.Put(key, f)
+}
+
+// RemoteFileSetCacheOptions are the options of a remote fileset cache.
+type RemoteFileSetCacheOptions struct {
+	// Store is the object store the filesets are offloaded to.
+	Store objectstore.Store
+	// FilePathPrefix is the file path prefix the filesets were offloaded from.
+	FilePathPrefix string
+	// CacheFilePathPrefix is the file path prefix the filesets are cached
+	// under, any filesets cached under it are removed when the cache is
+	// created.
+	CacheFilePathPrefix string
+	// MaxBytes is the size of the cached filesets above which the least
+	// recently used filesets are evicted.
+	MaxBytes int64
+	// NewFileMode and NewDirectoryMode are the modes of the cached files
+	// and directories.
+	NewFileMode      os.FileMode
+	NewDirectoryMode os.FileMode
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
+
+type remoteFileSetCacheEntry struct {
+	key  string
+	id   FileSetFileIdentifier
+	done chan struct{}
+	err  error
+	size int64
+	pins int
+	elem *list.Element
+}
+
+type remoteFileSetCacheMetrics struct {
+	hits      tally.Counter
+	misses    tally.Counter
+	errors    tally.Counter
+	evictions tally.Counter
+	bytes     tally.Gauge
+}
+
+type remoteFileSetCache struct {
+	sync.Mutex
+
+	opts    RemoteFileSetCacheOptions
+	entries map[string]*remoteFileSetCacheEntry
+	lru     *list.List
+	size    int64
+	logger  *zap.Logger
+	metrics remoteFileSetCacheMetrics
+}
+
+// NewRemoteFileSetCache returns a new cache of offloaded data filesets.
+func NewRemoteFileSetCache(opts RemoteFileSetCacheOptions) (RemoteFileSetCache, error) {
+	if opts.Store == nil {
+		return nil, errRemoteCacheStoreNotSet
+	}
+	if opts.FilePathPrefix == "" || opts.CacheFilePathPrefix == "" {
+		return nil, errRemoteCacheFilePathsNotSet
+	}
+	if opts.InstrumentOptions == nil {
+		opts.InstrumentOptions = instrument.NewOptions()
+	}
+
+	// Filesets cached by a previous process are not tracked, so start over.
+	if err := os.RemoveAll(DataDirPath(opts.CacheFilePathPrefix)); err != nil {
+		return nil, err
+	}
+
+	scope := opts.InstrumentOptions.MetricsScope().SubScope("remote-fileset-cache")
+	return &remoteFileSetCache{
+		opts:    opts,
+		entries: make(map[string]*remoteFileSetCacheEntry),
+		lru:     list.New(),
+		logger:  opts.InstrumentOptions.Logger(),
+		metrics: remoteFileSetCacheMetrics{
+			hits:      scope.Counter("hits"),
+			misses:    scope.Counter("misses"),
+			errors:    scope.Counter("errors"),
+			evictions: scope.Counter("evictions"),
+			bytes:     scope.Gauge("bytes"),
+		},
+	}, nil
+}
+
+func (c *remoteFileSetCache) Fetch(id FileSetFileIdentifier) (string, error) {
+	return c.fetch(id, false)
+}
+
+func (c *remoteFileSetCache) FetchAndWait(id FileSetFileIdentifier) (string, error) {
+	return c.fetch(id, true)
+}
+
+func (c *remoteFileSetCache) fetch(id FileSetFileIdentifier, wait bool) (string, error) {
+	key := remoteFileSetCacheKey(id)
+
+	c.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &remoteFileSetCacheEntry{
+			key:  key,
+			id:   id,
+			done: make(chan struct{}),
+		}
+		c.entries[key] = entry
+		c.metrics.misses.Inc(1)
+		go c.download(entry)
+	}
+	if entry.elem != nil {
+		// The fileset is cached.
+		entry.pins++
+		c.lru.MoveToFront(entry.elem)
+		c.Unlock()
+		c.metrics.hits.Inc(1)
+		return c.opts.CacheFilePathPrefix, nil
+	}
+	if !wait {
+		c.Unlock()
+		return "", errRemoteFileSetFetching
+	}
+	// Pin the fileset before it is cached so that it is not evicted before
+	// it is returned.
+	entry.pins++
+	c.Unlock()
+
+	<-entry.done
+	if entry.err != nil {
+		return "", entry.err
+	}
+	c.metrics.hits.Inc(1)
+	return c.opts.CacheFilePathPrefix, nil
+}
+
+func (c *remoteFileSetCache) Release(id FileSetFileIdentifier) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, ok := c.entries[remoteFileSetCacheKey(id)]
+	if !ok || entry.pins == 0 {
+		return
+	}
+	entry.pins--
+	c.evictWithLock()
+}
+
+func remoteFileSetCacheKey(id FileSetFileIdentifier) string {
+	return fmt.Sprintf("%s/%d/%d/%d", id.Namespace.String(), id.Shard,
+		int64(id.BlockStart), id.VolumeIndex)
+}
+
+// evictWithLock evicts the least recently used filesets that are not pinned
+// until the cache is within its size, always keeping the most recently used
+// fileset. Evicted files that are still mmapped by open seekers and readers
+// stay readable until unmapped.
+func (c *remoteFileSetCache) evictWithLock() {
+	for elem := c.lru.Back(); elem != nil && elem != c.lru.Front() &&
+		c.size > c.opts.MaxBytes; {
+		prev := elem.Prev()
+		entry := elem.Value.(*remoteFileSetCacheEntry)
+		if entry.pins == 0 {
+			c.lru.Remove(elem)
+			delete(c.entries, entry.key)
+			c.size -= entry.size
+			c.removeFiles(entry.id) // nolint: errcheck
+			c.metrics.evictions.Inc(1)
+		}
+		elem = prev
+	}
+	c.metrics.bytes.Update(float64(c.size))
+}
+
+func (c *remoteFileSetCache) download(entry *remoteFileSetCacheEntry) {
+	size, err := c.downloadFiles(entry.id)
+
+	c.Lock()
+	entry.err = err
+	if err != nil {
+		c.metrics.errors.Inc(1)
+		delete(c.entries, entry.key)
+	} else {
+		entry.size = size
+		entry.elem = c.lru.PushFront(entry)
+		c.size += size
+		c.evictWithLock()
+	}
+	c.Unlock()
+	close(entry.done)
+
+	if err != nil {
+		c.logger.Error("unable to fetch offloaded fileset", zap.Error(err))
+	}
+}
+
+func (c *remoteFileSetCache) downloadFiles(id FileSetFileIdentifier) (int64, error) {
+	var (
+		srcShardDir = ShardDataDirPath(c.opts.FilePathPrefix, id.Namespace, id.Shard)
+		dstShardDir = ShardDataDirPath(c.opts.CacheFilePathPrefix, id.Namespace, id.Shard)
+		size        int64
+	)
+	if err := os.MkdirAll(dstShardDir, c.opts.NewDirectoryMode); err != nil {
+		return 0, err
+	}
+	for _, suffix := range offloadedFileSetSuffixes {
+		srcPath := FilesetPathFromTimeAndIndex(srcShardDir, id.BlockStart, id.VolumeIndex, suffix)
+		dstPath := FilesetPathFromTimeAndIndex(dstShardDir, id.BlockStart, id.VolumeIndex, suffix)
+		n, err := c.downloadFile(srcPath, dstPath)
+		if err != nil {
+			c.removeFiles(id) // nolint: errcheck
+			return 0, fmt.Errorf("unable to fetch offloaded %s: %w", srcPath, err)
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (c *remoteFileSetCache) downloadFile(srcPath, dstPath string) (int64, error) {
+	key, err := objectKey(c.opts.FilePathPrefix, srcPath)
+	if err != nil {
+		return 0, err
+	}
+	r, err := c.opts.Store.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close() // nolint: errcheck
+
+	f, err := OpenWritable(dstPath, c.opts.NewFileMode)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, r)
+	if err != nil {
+		f.Close() // nolint: errcheck
+		return 0, err
+	}
+	return n, f.Close()
+}
+
+func (c *remoteFileSetCache) removeFiles(id FileSetFileIdentifier) error {
+	shardDir := ShardDataDirPath(c.opts.CacheFilePathPrefix, id.Namespace, id.Shard)
+	multiErr := xerrors.NewMultiError()
+	// Remove the checkpoint file first so that the fileset is incomplete
+	// while its other files are removed.
+	for i := len(offloadedFileSetSuffixes) - 1; i >= 0; i-- {
+		filePath := FilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex,
+			offloadedFileSetSuffixes[i])
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
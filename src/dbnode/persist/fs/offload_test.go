@@ -0,0 +1,251 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func writeOffloadTestData(t *testing.T, filePathPrefix string, blockStarts ...int) {
+	w := newTestWriter(t, filePathPrefix)
+	for _, i := range blockStarts {
+		writeTestData(t, w, 0, testWriterStart.Add(testBlockSize*time.Duration(i)), []testEntry{
+			{"foo", nil, []byte{1, 2, 3}},
+			{"bar", nil, []byte{4, 5, 6}},
+		}, persist.FileSetFlushType)
+	}
+}
+
+func offloadTestFileSetID(i int) FileSetFileIdentifier {
+	return FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart.Add(testBlockSize * time.Duration(i)),
+	}
+}
+
+func TestOffloadDataFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	writeOffloadTestData(t, dir, 0)
+
+	id := offloadTestFileSetID(0)
+	offloaded, err := DataFileSetOffloaded(dir, id.Namespace, id.Shard, id.BlockStart, id.VolumeIndex)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	store := objectstore.NewMemoryStore()
+	require.NoError(t, OffloadDataFileSet(store, dir, id))
+
+	keys, err := store.List("")
+	require.NoError(t, err)
+	require.Len(t, keys, len(offloadedFileSetSuffixes))
+
+	offloaded, err = DataFileSetOffloaded(dir, id.Namespace, id.Shard, id.BlockStart, id.VolumeIndex)
+	require.NoError(t, err)
+	require.True(t, offloaded)
+
+	// The info, digest and checkpoint files are kept locally.
+	shardDir := ShardDataDirPath(dir, id.Namespace, id.Shard)
+	for _, suffix := range []string{InfoFileSuffix, DigestFileSuffix, CheckpointFileSuffix} {
+		_, err := os.Stat(FilesetPathFromTimeAndIndex(shardDir, id.BlockStart, 0, suffix))
+		require.NoError(t, err)
+	}
+	for _, suffix := range remoteOnlyFileSetSuffixes {
+		_, err := os.Stat(FilesetPathFromTimeAndIndex(shardDir, id.BlockStart, 0, suffix))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	// Offloading a fileset that does not exist fails.
+	require.Equal(t, errOffloadFileSetNotFound, OffloadDataFileSet(store, dir, offloadTestFileSetID(1)))
+}
+
+func TestDeleteOffloadedDataFileSetsBefore(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	writeOffloadTestData(t, dir, 0, 1, 2)
+
+	store := objectstore.NewMemoryStore()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, OffloadDataFileSet(store, dir, offloadTestFileSetID(i)))
+	}
+
+	require.NoError(t, DeleteOffloadedDataFileSetsBefore(store, dir, testNs1ID, 0,
+		offloadTestFileSetID(2).BlockStart))
+
+	keys, err := store.List("")
+	require.NoError(t, err)
+	require.Len(t, keys, len(offloadedFileSetSuffixes))
+	for _, key := range keys {
+		blockStart, _, err := TimeAndVolumeIndexFromDataFileSetFilename(filepath.Base(key))
+		require.NoError(t, err)
+		assert.Equal(t, offloadTestFileSetID(2).BlockStart, blockStart)
+	}
+}
+
+func TestRemoteFileSetCacheFetch(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	cacheDir := filepath.Join(dir, "cache")
+
+	writeOffloadTestData(t, dir, 0, 1)
+
+	store := objectstore.NewMemoryStore()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, OffloadDataFileSet(store, dir, offloadTestFileSetID(i)))
+	}
+
+	scope := tally.NewTestScope("", nil)
+	cache, err := NewRemoteFileSetCache(RemoteFileSetCacheOptions{
+		Store:               store,
+		FilePathPrefix:      dir,
+		CacheFilePathPrefix: cacheDir,
+		// Only ever keep a single fileset cached.
+		MaxBytes:          1,
+		NewFileMode:       defaultNewFileMode,
+		NewDirectoryMode:  defaultNewDirectoryMode,
+		InstrumentOptions: instrument.NewOptions().SetMetricsScope(scope),
+	})
+	require.NoError(t, err)
+
+	m := NewSeekerManager(nil, testDefaultOpts.
+		SetFilePathPrefix(dir).
+		SetRemoteFileSetCache(cache),
+		defaultTestBlockRetrieverOptions).(*seekerManager)
+	m.namespace = testNs1ID
+
+	resources := newTestReusableSeekerResources()
+	for _, i := range []int{0, 1} {
+		id := offloadTestFileSetID(i)
+
+		// Opening a fileset that is not cached does not wait for it to be
+		// fetched.
+		_, err := m.newOpenSeeker(id.Shard, id.BlockStart, id.VolumeIndex)
+		require.Equal(t, errRemoteFileSetFetching, err)
+		require.True(t, xerrors.IsRetryableError(err))
+
+		_, err = cache.FetchAndWait(id)
+		require.NoError(t, err)
+		cache.Release(id)
+
+		seeker, err := m.newOpenSeeker(id.Shard, id.BlockStart, id.VolumeIndex)
+		require.NoError(t, err)
+
+		data, err := seeker.SeekByID(ident.StringID("bar"), resources)
+		require.NoError(t, err)
+		data.IncRef()
+		assert.Equal(t, []byte{4, 5, 6}, data.Bytes())
+		data.DecRef()
+		require.NoError(t, seeker.Close())
+	}
+
+	// The first fileset was evicted when the second one was fetched.
+	id := offloadTestFileSetID(0)
+	exists, err := DataFileSetExists(cacheDir, id.Namespace, id.Shard, id.BlockStart, 0)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	id = offloadTestFileSetID(1)
+	exists, err = DataFileSetExists(cacheDir, id.Namespace, id.Shard, id.BlockStart, 0)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(4), counters["remote-fileset-cache.hits+"].Value())
+	assert.Equal(t, int64(2), counters["remote-fileset-cache.misses+"].Value())
+	assert.Equal(t, int64(1), counters["remote-fileset-cache.evictions+"].Value())
+}
+
+func TestRemoteFileSetCachePinsFileSets(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	cacheDir := filepath.Join(dir, "cache")
+
+	writeOffloadTestData(t, dir, 0, 1)
+
+	store := objectstore.NewMemoryStore()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, OffloadDataFileSet(store, dir, offloadTestFileSetID(i)))
+	}
+
+	cache, err := NewRemoteFileSetCache(RemoteFileSetCacheOptions{
+		Store:               store,
+		FilePathPrefix:      dir,
+		CacheFilePathPrefix: cacheDir,
+		MaxBytes:            1,
+		NewFileMode:         defaultNewFileMode,
+		NewDirectoryMode:    defaultNewDirectoryMode,
+	})
+	require.NoError(t, err)
+
+	cached := func(i int) bool {
+		id := offloadTestFileSetID(i)
+		exists, err := DataFileSetExists(cacheDir, id.Namespace, id.Shard, id.BlockStart, 0)
+		require.NoError(t, err)
+		return exists
+	}
+
+	for i := 0; i < 2; i++ {
+		prefix, err := cache.FetchAndWait(offloadTestFileSetID(i))
+		require.NoError(t, err)
+		require.Equal(t, cacheDir, prefix)
+	}
+
+	// The first fileset is pinned so it is not evicted until released.
+	assert.True(t, cached(0))
+	cache.Release(offloadTestFileSetID(0))
+	assert.False(t, cached(0))
+
+	// Offloaded filesets are read from the cache by readers.
+	id := offloadTestFileSetID(1)
+	r := newTestReader(t, dir)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier:     id,
+		FilePathPrefix: cacheDir,
+	}))
+	cache.Release(id)
+	require.Equal(t, 2, r.Entries())
+	require.NoError(t, r.Close())
+}
+
+func TestNewRemoteFileSetCacheValidatesOptions(t *testing.T) {
+	_, err := NewRemoteFileSetCache(RemoteFileSetCacheOptions{})
+	require.Equal(t, errRemoteCacheStoreNotSet, err)
+
+	_, err = NewRemoteFileSetCache(RemoteFileSetCacheOptions{
+		Store: objectstore.NewMemoryStore(),
+	})
+	require.Equal(t, errRemoteCacheFilePathsNotSet, err)
+}
@@ -24,13 +24,16 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/serialize"
 )
@@ -109,6 +112,10 @@ type options struct {
 	mmapReporter                         mmap.Reporter
 	indexReaderAutovalidateIndexSegments bool
 	encodingOptions                      msgpack.LegacyEncodingOptions
+	compactedBlockSizes                  []time.Duration
+	objectStore                          objectstore.Store
+	remoteFileSetCache                   RemoteFileSetCache
+	encryptionKeyProvider                encryption.KeyProvider
 }
 
 type optionsInput struct {
@@ -469,3 +476,43 @@ func (o *options) SetEncodingOptions(value msgpack.LegacyEncodingOptions) Option
 func (o *options) EncodingOptions() msgpack.LegacyEncodingOptions {
 	return o.encodingOptions
 }
+
+func (o *options) SetCompactedBlockSizes(value []time.Duration) Options {
+	opts := *o
+	opts.compactedBlockSizes = value
+	return &opts
+}
+
+func (o *options) CompactedBlockSizes() []time.Duration {
+	return o.compactedBlockSizes
+}
+
+func (o *options) SetObjectStore(value objectstore.Store) Options {
+	opts := *o
+	opts.objectStore = value
+	return &opts
+}
+
+func (o *options) ObjectStore() objectstore.Store {
+	return o.objectStore
+}
+
+func (o *options) SetRemoteFileSetCache(value RemoteFileSetCache) Options {
+	opts := *o
+	opts.remoteFileSetCache = value
+	return &opts
+}
+
+func (o *options) RemoteFileSetCache() RemoteFileSetCache {
+	return o.remoteFileSetCache
+}
+
+func (o *options) SetEncryptionKeyProvider(value encryption.KeyProvider) Options {
+	opts := *o
+	opts.encryptionKeyProvider = value
+	return &opts
+}
+
+func (o *options) EncryptionKeyProvider() encryption.KeyProvider {
+	return o.encryptionKeyProvider
+}
@@ -148,8 +148,12 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		shard       = opts.Identifier.Shard
 		blockStart  = opts.Identifier.BlockStart
 		volumeIndex = opts.Identifier.VolumeIndex
+		prefix      = r.filePathPrefix
 		err         error
 	)
+	if opts.FilePathPrefix != "" {
+		prefix = opts.FilePathPrefix
+	}
 
 	var (
 		shardDir            string
@@ -165,7 +169,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 
 	switch opts.FileSetType {
 	case persist.FileSetSnapshotType:
-		shardDir = ShardSnapshotsDirPath(r.filePathPrefix, namespace, shard)
+		shardDir = ShardSnapshotsDirPath(prefix, namespace, shard)
 		checkpointFilepath = FilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, CheckpointFileSuffix)
 		infoFilepath = FilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, InfoFileSuffix)
 		digestFilepath = FilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, DigestFileSuffix)
@@ -173,7 +177,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		indexFilepath = FilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix)
 		dataFilepath = FilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix)
 	case persist.FileSetFlushType:
-		shardDir = ShardDataDirPath(r.filePathPrefix, namespace, shard)
+		shardDir = ShardDataDirPath(prefix, namespace, shard)
 
 		isLegacy := false
 		if volumeIndex == 0 {
@@ -226,7 +230,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		r.digestFdWithDigestContents.Close()
 	}()
 
-	result, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
+	result, err := mmapFiles(os.Open, r.opts.EncryptionKeyProvider(), map[string]mmap.FileDesc{
 		indexFilepath: {
 			File:       &r.indexFd,
 			Descriptor: &r.indexMmap,
@@ -510,6 +514,7 @@ func (r *reader) ReadBloomFilter() (*ManagedConcurrentBloomFilter, error) {
 		uint(r.bloomFilterInfo.NumElementsM),
 		uint(r.bloomFilterInfo.NumHashesK),
 		r.opts.ForceBloomFilterMmapMemory(),
+		r.opts.EncryptionKeyProvider(),
 		mmap.ReporterOptions{
 			Reporter: r.opts.MmapReporter(),
 		},
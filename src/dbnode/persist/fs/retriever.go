@@ -59,10 +59,16 @@ var (
 	errBlockRetrieverAlreadyOpenOrClosed = errors.New("block retriever already open or is closed")
 	errBlockRetrieverAlreadyClosed       = errors.New("block retriever already closed")
 	errNoSeekerMgr                       = errors.New("there is no open seeker manager")
+	errRetrieverPoolsNotSet              = errors.New(
+		"multi reader iterator pool and encoder pool must be set to cache or retrieve compacted blocks")
 )
 
 const (
 	defaultRetrieveRequestQueueCapacity = 4096
+
+	// defaultCompactedBlockCacheBytes is the size of the cache of the blocks
+	// sliced out of compacted filesets when no block cache is configured.
+	defaultCompactedBlockCacheBytes = 64 << 20
 )
 
 type blockRetrieverStatus int
@@ -98,6 +104,7 @@ type blockRetriever struct {
 
 	blockSize               time.Duration
 	nsCacheBlocksOnRetrieve bool
+	compactedBlockCache     *block.BlockCache
 
 	status                     blockRetrieverStatus
 	reqsByShardIdx             []*shardRetrieveRequests
@@ -115,9 +122,26 @@ func NewBlockRetriever(
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
+	// The pools are used to decode and encode the blocks held by the block
+	// cache and to slice blocks out of compacted filesets.
+	if (opts.BlockCache() != nil || len(fsOpts.CompactedBlockSizes()) > 0) &&
+		(opts.MultiReaderIteratorPool() == nil || opts.EncoderPool() == nil) {
+		return nil, errRetrieverPoolsNotSet
+	}
 
 	scope := fsOpts.InstrumentOptions().MetricsScope().SubScope("retriever")
 
+	// All the blocks of a compacted series are sliced out of its data at once
+	// and cached so that reading the other blocks does not decode it again.
+	compactedBlockCache := opts.BlockCache()
+	if compactedBlockCache == nil && len(fsOpts.CompactedBlockSizes()) > 0 {
+		compactedBlockCache = block.NewBlockCache(block.BlockCacheOptions{
+			MaxBytes: defaultCompactedBlockCacheBytes,
+			InstrumentOptions: fsOpts.InstrumentOptions().
+				SetMetricsScope(scope.SubScope("compacted")),
+		})
+	}
+
 	return &blockRetriever{
 		opts:                    opts,
 		fsOpts:                  fsOpts,
@@ -129,6 +153,7 @@ func NewBlockRetriever(
 		reqPool:                 opts.RetrieveRequestPool(),
 		bytesPool:               opts.BytesPool(),
 		idPool:                  opts.IdentifierPool(),
+		compactedBlockCache:     compactedBlockCache,
 		status:                  blockRetrieverNotOpen,
 		notifyFetch:             make(chan struct{}, 1),
 		// We just close this channel when the fetchLoops should shutdown, so no
@@ -356,6 +381,33 @@ func (r *blockRetriever) fetchBatch(
 		return
 	}
 
+	// The seeker may be of the fileset of a larger block the block was
+	// compacted into, in which case the data of the block is sliced out of it.
+	var (
+		fileSetRange xtime.Range
+		compacted    = false
+	)
+	if len(r.fsOpts.CompactedBlockSizes()) > 0 {
+		fileSetRange = seeker.Range()
+		compacted = fileSetRange.Start != blockStart ||
+			fileSetRange.End != blockStart.Add(r.blockSize)
+	}
+
+	// Blocks are cached by the volume of the fileset they are retrieved from
+	// so that blocks of a volume that was replaced are never returned.
+	var (
+		blockCache   = r.opts.BlockCache()
+		fileSetStart xtime.UnixNano
+		volume       int
+	)
+	if compacted {
+		blockCache = r.compactedBlockCache
+	}
+	if blockCache != nil {
+		fileSetStart = seeker.Range().Start
+		volume = seeker.Volume()
+	}
+
 	retrieverResources.resetDataReqs()
 	retrieverResources.dataReqs = append(retrieverResources.dataReqs, allReqs...)
 	reqs := retrieverResources.dataReqs
@@ -381,6 +433,26 @@ func (r *blockRetriever) fetchBatch(
 		default:
 		}
 
+		if blockCache != nil {
+			points, ok := blockCache.Get(r.blockCacheKey(req, req.start, fileSetStart, volume))
+			if ok {
+				seg, err := r.encodeCachedBlock(req, points)
+				if err != nil {
+					req.err = err
+					continue
+				}
+				if err := r.bytesReadLimit.Inc(seg.Len(), req.source); err != nil {
+					seg.Finalize()
+					req.err = err
+					limitErr = err
+					continue
+				}
+				req.cachedSeg = seg
+				req.cacheHit = true
+				continue
+			}
+		}
+
 		entry, err := seeker.SeekIndexEntry(req.id, seekerResources)
 		if err != nil && !errors.Is(err, errSeekIDNotFound) {
 			req.err = err
@@ -421,6 +493,15 @@ func (r *blockRetriever) fetchBatch(
 			continue
 		}
 
+		if req.cacheHit {
+			// The request owns the segment encoded from the cached datapoints.
+			req.onRetrieved(req.cachedSeg, req.nsCtx)
+			req.cachedSeg = ts.Segment{}
+			req.success = true
+			req.onCallerOrRetrieverDone()
+			continue
+		}
+
 		select {
 		case <-req.stdCtx.Done():
 			req.err = req.stdCtx.Err()
@@ -441,6 +522,39 @@ func (r *blockRetriever) fetchBatch(
 			checksum           = req.indexEntry.DataChecksum
 		)
 		seg = ts.NewSegment(data, nil, checksum, ts.FinalizeHead)
+		if compacted {
+			sliced, err := SplitCompactedSegment(seg, fileSetRange, r.blockSize,
+				req.nsCtx.Schema, r.opts.MultiReaderIteratorPool(), r.opts.EncoderPool())
+			seg.Finalize()
+			if err != nil {
+				if tags := req.indexEntry.EncodedTags; tags != nil {
+					tags.DecRef()
+					tags.Finalize()
+				}
+				req.err = err
+				continue
+			}
+
+			// Cache the other blocks of the series, which are read next when
+			// querying a range of several blocks.
+			idx := int(req.start.Sub(fileSetRange.Start) / r.blockSize)
+			for i, blockSeg := range sliced {
+				if i == idx {
+					continue
+				}
+				if blockCache != nil {
+					at := fileSetRange.Start.Add(time.Duration(i) * r.blockSize)
+					r.putBlockCache(blockCache, req, at, fileSetStart, volume, blockSeg)
+				}
+				blockSeg.Finalize()
+			}
+			seg = sliced[idx]
+			checksum = seg.CalculateChecksum()
+		}
+
+		if blockCache != nil {
+			r.putBlockCache(blockCache, req, req.start, fileSetStart, volume, seg)
+		}
 
 		// We don't need to call onRetrieve.OnRetrieveBlock if the ID was not found.
 		callOnRetrieve := blockCachingEnabled && req.onRetrieve != nil
@@ -448,9 +562,14 @@ func (r *blockRetriever) fetchBatch(
 			// NB(r): Need to also trigger callback with a copy of the data.
 			// This is used by the database to cache the in memory data for
 			// consequent fetches.
-			dataCopy := r.bytesPool.Get(data.Len())
+			dataCopy := r.bytesPool.Get(seg.Len())
 			onRetrieveSeg = ts.NewSegment(dataCopy, nil, checksum, ts.FinalizeHead)
-			dataCopy.AppendAll(data.Bytes())
+			if seg.Head != nil {
+				dataCopy.AppendAll(seg.Head.Bytes())
+			}
+			if seg.Tail != nil {
+				dataCopy.AppendAll(seg.Tail.Bytes())
+			}
 
 			if tags := req.indexEntry.EncodedTags; tags != nil && tags.Len() > 0 {
 				decoder := tagDecoderPool.Get()
@@ -493,6 +612,82 @@ func (r *blockRetriever) fetchBatch(
 	}
 }
 
+func (r *blockRetriever) blockCacheKey(
+	req *retrieveRequest,
+	blockStart xtime.UnixNano,
+	fileSetStart xtime.UnixNano,
+	volume int,
+) block.BlockCacheKey {
+	return block.BlockCacheKey{
+		Namespace:    r.nsMetadata.ID(),
+		Shard:        req.shard,
+		ID:           req.id,
+		BlockStart:   blockStart,
+		FileSetStart: fileSetStart,
+		Volume:       volume,
+	}
+}
+
+func (r *blockRetriever) putBlockCache(
+	blockCache *block.BlockCache,
+	req *retrieveRequest,
+	blockStart xtime.UnixNano,
+	fileSetStart xtime.UnixNano,
+	volume int,
+	seg ts.Segment,
+) {
+	// NB: Blocks sliced out of compacted filesets can be empty, which are
+	// cached too so that they are not sliced again.
+	var points []block.BlockCacheDatapoint
+	if seg.Len() > 0 {
+		multiIter := r.opts.MultiReaderIteratorPool().Get()
+		defer multiIter.Close()
+
+		multiIter.Reset([]xio.SegmentReader{xio.NewSegmentReader(seg)},
+			blockStart, r.blockSize, req.nsCtx.Schema)
+		for multiIter.Next() {
+			dp, unit, annotation := multiIter.Current()
+			point := block.BlockCacheDatapoint{Datapoint: dp, Unit: unit}
+			if len(annotation) > 0 {
+				// The annotation is only valid until the iterator moves on.
+				point.Annotation = append(ts.Annotation(nil), annotation...)
+			}
+			points = append(points, point)
+		}
+		if err := multiIter.Err(); err != nil {
+			r.logger.Error("error decoding block to cache",
+				zap.Stringer("id", req.id),
+				zap.Int64("blockStart", blockStart.Seconds()),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	blockCache.Put(r.blockCacheKey(req, blockStart, fileSetStart, volume), points)
+}
+
+// encodeCachedBlock encodes the cached datapoints of a block into a segment
+// owned by the request.
+func (r *blockRetriever) encodeCachedBlock(
+	req *retrieveRequest,
+	points []block.BlockCacheDatapoint,
+) (ts.Segment, error) {
+	if len(points) == 0 {
+		return ts.Segment{}, nil
+	}
+
+	encoder := r.opts.EncoderPool().Get()
+	encoder.Reset(req.start, 0, req.nsCtx.Schema)
+	for _, p := range points {
+		if err := encoder.Encode(p.Datapoint, p.Unit, p.Annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	return encoder.Discard(), nil
+}
+
 func (r *blockRetriever) seriesPresentInBloomFilter(
 	id ident.ID,
 	shard uint32,
@@ -594,6 +789,7 @@ func (r *blockRetriever) Stream(
 	// only save the go ctx to ensure we don't accidentally use the m3 ctx after it's been closed by the caller.
 	req.stdCtx = ctx.GoContext()
 	req.onRetrieve = onRetrieve
+	req.nsCtx = nsCtx
 
 	if source, ok := req.stdCtx.Value(limits.SourceContextKey).([]byte); ok {
 		req.source = source
@@ -722,6 +918,11 @@ type retrieveRequest struct {
 	finalizes uint32
 	shard     uint32
 
+	// The segment encoded from the datapoints of the block if it was found
+	// in the block cache.
+	cachedSeg ts.Segment
+	cacheHit  bool
+
 	notFound bool
 	success  bool
 }
@@ -870,9 +1071,12 @@ func (req *retrieveRequest) resetForReuse() {
 	req.start = 0
 	req.blockSize = 0
 	req.onRetrieve = nil
+	req.nsCtx = namespace.Context{}
 	req.indexEntry = IndexEntry{}
 	req.reader = nil
 	req.err = nil
+	req.cachedSeg = ts.Segment{}
+	req.cacheHit = false
 	req.notFound = false
 	req.success = false
 	req.stdCtx = nil
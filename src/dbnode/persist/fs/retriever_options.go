@@ -24,6 +24,7 @@ import (
 	"errors"
 	"runtime"
 
+	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/x/xio"
@@ -44,9 +45,12 @@ type blockRetrieverOptions struct {
 	bytesPool         pool.CheckedBytesPool
 	fetchConcurrency  int
 	cacheOnRetrieve   bool
+	blockCache        *block.BlockCache
 	identifierPool    ident.Pool
 	blockLeaseManager block.LeaseManager
 	queryLimits       limits.QueryLimits
+	multiIterPool     encoding.MultiReaderIteratorPool
+	encoderPool       encoding.EncoderPool
 }
 
 // NewBlockRetrieverOptions creates a new set of block retriever options
@@ -123,6 +127,16 @@ func (o *blockRetrieverOptions) CacheBlocksOnRetrieve() bool {
 	return o.cacheOnRetrieve
 }
 
+func (o *blockRetrieverOptions) SetBlockCache(value *block.BlockCache) BlockRetrieverOptions {
+	opts := *o
+	opts.blockCache = value
+	return &opts
+}
+
+func (o *blockRetrieverOptions) BlockCache() *block.BlockCache {
+	return o.blockCache
+}
+
 func (o *blockRetrieverOptions) SetIdentifierPool(value ident.Pool) BlockRetrieverOptions {
 	opts := *o
 	opts.identifierPool = value
@@ -152,3 +166,25 @@ func (o *blockRetrieverOptions) SetQueryLimits(value limits.QueryLimits) BlockRe
 func (o *blockRetrieverOptions) QueryLimits() limits.QueryLimits {
 	return o.queryLimits
 }
+
+func (o *blockRetrieverOptions) SetMultiReaderIteratorPool(
+	value encoding.MultiReaderIteratorPool,
+) BlockRetrieverOptions {
+	opts := *o
+	opts.multiIterPool = value
+	return &opts
+}
+
+func (o *blockRetrieverOptions) MultiReaderIteratorPool() encoding.MultiReaderIteratorPool {
+	return o.multiIterPool
+}
+
+func (o *blockRetrieverOptions) SetEncoderPool(value encoding.EncoderPool) BlockRetrieverOptions {
+	opts := *o
+	opts.encoderPool = value
+	return &opts
+}
+
+func (o *blockRetrieverOptions) EncoderPool() encoding.EncoderPool {
+	return o.encoderPool
+}
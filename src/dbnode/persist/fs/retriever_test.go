@@ -799,6 +799,68 @@ func testBlockRetrieverOnRetrieve(t *testing.T, globalFlag bool, nsFlag bool) {
 	}
 }
 
+// TestBlockRetrieverBlockCache verifies that blocks retrieved from disk are
+// cached in the block cache and returned from it on consequent retrievals.
+func TestBlockRetrieverBlockCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	filePathPrefix := filepath.Join(dir, "")
+
+	var (
+		scope      = tally.NewTestScope("", nil)
+		blockCache = block.NewBlockCache(block.BlockCacheOptions{
+			MaxBytes:          1024,
+			InstrumentOptions: instrument.NewOptions().SetMetricsScope(scope),
+		})
+		fsOpts     = testDefaultOpts.SetFilePathPrefix(filePathPrefix)
+		nsMeta     = testNs1Metadata(t)
+		nsCtx      = namespace.NewContextFrom(nsMeta)
+		shard      = uint32(0)
+		blockStart = xtime.Now().Truncate(nsMeta.Options().RetentionOptions().BlockSize())
+	)
+
+	opts := testBlockRetrieverOptions{
+		retrieverOpts: defaultTestBlockRetrieverOptions.
+			SetBlockCache(blockCache).
+			SetMultiReaderIteratorPool(multiIterPool).
+			SetEncoderPool(encoderPool),
+		fsOpts: fsOpts,
+		shards: []uint32{shard},
+	}
+	retriever, cleanup := newOpenTestBlockRetriever(t, nsMeta, opts)
+	defer cleanup()
+
+	values := []float64{1, 2, 3}
+	w := newOpenTestStreamingWriter(t, filePathPrefix, shard, blockStart, 0, 1)
+	entries := []testStreamingEntry{{testEntry{"foo", nil, nil}, values}}
+	require.NoError(t, streamingWriteTestData(t, w, blockStart, entries))
+	require.NoError(t, w.Close())
+
+	for i := 0; i < 2; i++ {
+		ctx := context.NewBackground()
+		reader, err := retriever.Stream(ctx, shard,
+			ident.StringID("foo"), blockStart, nil, nsCtx)
+		require.NoError(t, err)
+
+		seg, err := reader.Segment()
+		require.NoError(t, err)
+		data := append(segmentBytes(seg.Head), segmentBytes(seg.Tail)...)
+
+		var actual []float64
+		for _, dp := range readCompactTestDatapoints(t, data) {
+			actual = append(actual, dp.Value)
+		}
+		require.Equal(t, values, actual)
+		ctx.Close()
+	}
+
+	require.Equal(t, 1, blockCache.Len())
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(1), snapshot.Counters()["block-cache.misses+"].Value())
+	require.Equal(t, int64(1), snapshot.Counters()["block-cache.hits+"].Value())
+}
+
 // TestBlockRetrieverHandlesErrors verifies the behavior of the Stream() method
 // on the retriever in the case where the SeekIndexEntry function returns an
 // error.
@@ -900,3 +962,80 @@ func stripVolumeTag(tags ident.Tags) ident.Tags {
 	tagsSlice = tagsSlice[:len(tagsSlice)-1]
 	return ident.NewTags(tagsSlice...)
 }
+
+// TestBlockRetrieverCompactedBlock verifies that the block retriever streams
+// the data of a block out of the fileset of the larger block it was compacted
+// into.
+func TestBlockRetrieverCompactedBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	filePathPrefix := filepath.Join(dir, "")
+
+	var (
+		compactedBlockSize = 2 * testBlockSize
+		fsOpts             = testDefaultOpts.
+					SetFilePathPrefix(filePathPrefix).
+					SetCompactedBlockSizes([]time.Duration{compactedBlockSize})
+		nsCtx      = namespace.NewContextFrom(testNs1Metadata(t))
+		shard      = uint32(0)
+		blockStart = xtime.Now().Truncate(compactedBlockSize)
+		values     = make([]float64, 15)
+	)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	opts := testBlockRetrieverOptions{
+		retrieverOpts: defaultTestBlockRetrieverOptions.
+			SetMultiReaderIteratorPool(multiIterPool).
+			SetEncoderPool(encoderPool),
+		fsOpts: fsOpts,
+		shards: []uint32{shard},
+	}
+	retriever, cleanup := newOpenTestBlockRetriever(t, testNs1Metadata(t), opts)
+	defer cleanup()
+
+	// Write out a compacted fileset with the datapoints spanning both blocks,
+	// every 10 minutes from the block start.
+	w := newTestStreamingWriter(t, filePathPrefix)
+	require.NoError(t, w.Open(StreamingWriterOpenOptions{
+		NamespaceID:         testNs1ID,
+		ShardID:             shard,
+		BlockStart:          blockStart,
+		BlockSize:           compactedBlockSize,
+		PlannedRecordsCount: 1,
+	}))
+	entries := []testStreamingEntry{{testEntry{"foo", nil, nil}, values}}
+	require.NoError(t, streamingWriteTestData(t, w, blockStart, entries))
+	require.NoError(t, w.Close())
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	valuesPerBlock := int(testBlockSize / (10 * time.Minute))
+	for i, start := range []xtime.UnixNano{blockStart, blockStart.Add(testBlockSize)} {
+		reader, err := retriever.Stream(ctx, shard, ident.StringID("foo"), start, nil, nsCtx)
+		require.NoError(t, err)
+
+		segment, err := reader.Segment()
+		require.NoError(t, err)
+		data := append(segmentBytes(segment.Head), segmentBytes(segment.Tail)...)
+
+		var actual []float64
+		for _, dp := range readCompactTestDatapoints(t, data) {
+			require.False(t, dp.TimestampNanos.Before(start))
+			require.True(t, dp.TimestampNanos.Before(start.Add(testBlockSize)))
+			actual = append(actual, dp.Value)
+		}
+		end := (i + 1) * valuesPerBlock
+		if end > len(values) {
+			end = len(values)
+		}
+		require.Equal(t, values[i*valuesPerBlock:end], actual)
+
+		// Both blocks are cached when the first one is sliced out of the
+		// compacted data, so the second one is served from the cache.
+		require.Equal(t, 2, retriever.compactedBlockCache.Len())
+	}
+}
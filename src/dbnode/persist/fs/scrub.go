@@ -0,0 +1,348 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/generated/proto/index"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+	"github.com/m3db/m3/src/x/encryption"
+	xerrors "github.com/m3db/m3/src/x/errors"
+)
+
+const (
+	quarantineDirName = "quarantine"
+
+	// quarantineRestoredFileSuffix is the suffix of the marker file written
+	// next to a quarantined volume once what it held has been restored.
+	quarantineRestoredFileSuffix = "restored"
+
+	// numDataFileSetDigests is the number of digests in the digests file of
+	// a data fileset, one per file in the order they are written.
+	numDataFileSetDigests = 5
+
+	scrubReadBufferSize = 65536
+)
+
+var errDigestsFileTooShort = errors.New("digests file is too short")
+
+// CorruptFileSetError is returned when a file of a fileset volume does not
+// match the digest it was written with.
+type CorruptFileSetError struct {
+	FilePath string
+	Err      error
+}
+
+func (e CorruptFileSetError) Error() string {
+	return fmt.Sprintf("fileset file %s is corrupt: %v", e.FilePath, e.Err)
+}
+
+// IsCorruptFileSetError returns whether the error is due to a corrupt
+// fileset file.
+func IsCorruptFileSetError(err error) bool {
+	var corruptErr CorruptFileSetError
+	return errors.As(err, &corruptErr)
+}
+
+// ScrubOptions are the options for scrubbing fileset volumes.
+type ScrubOptions struct {
+	// FilePathPrefix is the file path prefix of the filesets.
+	FilePathPrefix string
+	// EncryptionKeyProvider decrypts the encrypted fileset files.
+	EncryptionKeyProvider encryption.KeyProvider
+	// ThrottleFn, if set, is called with the number of bytes read after
+	// every read so that the caller can limit the rate files are read at.
+	ThrottleFn func(bytesRead int)
+}
+
+// ScrubDataFileSet reads every file of a flushed data fileset volume and
+// verifies it against the digests the volume was written with, returning the
+// number of bytes read. A CorruptFileSetError is returned if any file does not
+// match its digest.
+func ScrubDataFileSet(id FileSetFileIdentifier, opts ScrubOptions) (int64, error) {
+	var (
+		shardDir = ShardDataDirPath(opts.FilePathPrefix, id.Namespace, id.Shard)
+		isLegacy bool
+		err      error
+	)
+	if id.VolumeIndex == 0 {
+		isLegacy, err = isFirstVolumeLegacy(shardDir, id.BlockStart, CheckpointFileSuffix)
+		if err != nil {
+			return 0, err
+		}
+	}
+	pathFn := func(suffix string) string {
+		return dataFilesetPathFromTimeAndIndex(shardDir, id.BlockStart,
+			id.VolumeIndex, suffix, isLegacy)
+	}
+
+	digestsFilePath := pathFn(DigestFileSuffix)
+	digestsData, err := readAndVerifyDigestsFile(pathFn(CheckpointFileSuffix), digestsFilePath)
+	if err != nil {
+		return 0, err
+	}
+	if len(digestsData) < numDataFileSetDigests*digest.DigestLenBytes {
+		return 0, CorruptFileSetError{FilePath: digestsFilePath, Err: errDigestsFileTooShort}
+	}
+
+	var bytesRead int64
+	for i, suffix := range []string{
+		InfoFileSuffix,
+		indexFileSuffix,
+		summariesFileSuffix,
+		bloomFilterFileSuffix,
+		dataFileSuffix,
+	} {
+		expected := digest.ToBuffer(digestsData[i*digest.DigestLenBytes:]).ReadDigest()
+		n, err := scrubFile(pathFn(suffix), expected, opts)
+		bytesRead += n
+		if err != nil {
+			return bytesRead, err
+		}
+	}
+
+	return bytesRead, nil
+}
+
+// ScrubIndexFileSet reads every file of a flushed index fileset volume and
+// verifies it against the digests the volume was written with, returning the
+// number of bytes read. A CorruptFileSetError is returned if any file does not
+// match its digest.
+func ScrubIndexFileSet(id FileSetFileIdentifier, opts ScrubOptions) (int64, error) {
+	var (
+		namespaceDir = NamespaceIndexDataDirPath(opts.FilePathPrefix, id.Namespace)
+		pathFn       = func(suffix string) string {
+			return FilesetPathFromTimeAndIndex(namespaceDir, id.BlockStart,
+				id.VolumeIndex, suffix)
+		}
+		digestsFilePath = pathFn(DigestFileSuffix)
+		infoFilePath    = pathFn(InfoFileSuffix)
+		digests         index.IndexDigests
+		info            index.IndexVolumeInfo
+	)
+	digestsData, err := readAndVerifyDigestsFile(pathFn(CheckpointFileSuffix), digestsFilePath)
+	if err != nil {
+		return 0, err
+	}
+	if err := digests.Unmarshal(digestsData); err != nil {
+		return 0, CorruptFileSetError{FilePath: digestsFilePath, Err: err}
+	}
+
+	infoData, err := ioutil.ReadFile(infoFilePath)
+	if err != nil {
+		return 0, err
+	}
+	bytesRead := int64(len(infoData))
+	if actual := digest.Checksum(infoData); actual != digests.InfoDigest {
+		return bytesRead, CorruptFileSetError{
+			FilePath: infoFilePath,
+			Err:      newDigestMismatchError(digests.InfoDigest, actual),
+		}
+	}
+	if err := info.Unmarshal(infoData); err != nil {
+		return bytesRead, CorruptFileSetError{FilePath: infoFilePath, Err: err}
+	}
+
+	if len(info.Segments) != len(digests.SegmentDigests) {
+		return bytesRead, CorruptFileSetError{
+			FilePath: digestsFilePath,
+			Err: fmt.Errorf("digests of %d segments, expected %d",
+				len(digests.SegmentDigests), len(info.Segments)),
+		}
+	}
+	for i, segment := range info.Segments {
+		segmentDigests := digests.SegmentDigests[i]
+		if len(segment.Files) != len(segmentDigests.Files) {
+			return bytesRead, CorruptFileSetError{
+				FilePath: digestsFilePath,
+				Err: fmt.Errorf("digests of %d files for segment %d, expected %d",
+					len(segmentDigests.Files), i, len(segment.Files)),
+			}
+		}
+		for j, file := range segment.Files {
+			segmentFileType := idxpersist.IndexSegmentFileType(file.SegmentFileType)
+			filePath := filesetIndexSegmentFilePathFromTime(namespaceDir,
+				id.BlockStart, id.VolumeIndex, i, segmentFileType)
+			n, err := scrubFile(filePath, segmentDigests.Files[j].Digest, opts)
+			bytesRead += n
+			if err != nil {
+				return bytesRead, err
+			}
+		}
+	}
+
+	return bytesRead, nil
+}
+
+// QuarantineFileSet moves the files of a fileset volume from the file path
+// prefix into its quarantine directory, keeping their relative paths, and
+// returns the quarantined volume. The checkpoint file is moved first so that
+// the volume is no longer considered complete even if moving the other files
+// fails.
+func QuarantineFileSet(
+	filePathPrefix string,
+	fileSet FileSetFile,
+	newDirectoryMode os.FileMode,
+) (FileSetFile, error) {
+	checkpointFilePath, _ := fileSet.filepath(CheckpointFileSuffix)
+	filePaths := make([]string, 0, len(fileSet.AbsoluteFilePaths))
+	if checkpointFilePath != "" {
+		filePaths = append(filePaths, checkpointFilePath)
+	}
+	for _, filePath := range fileSet.AbsoluteFilePaths {
+		if filePath != checkpointFilePath {
+			filePaths = append(filePaths, filePath)
+		}
+	}
+
+	var (
+		quarantinePath = QuarantineDirPath(filePathPrefix)
+		quarantined    = NewFileSetFile(fileSet.ID, quarantinePath)
+		multiErr       = xerrors.NewMultiError()
+	)
+	for _, filePath := range filePaths {
+		relPath, err := filepath.Rel(filePathPrefix, filePath)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		dstPath := filepath.Join(quarantinePath, relPath)
+		if err := os.MkdirAll(filepath.Dir(dstPath), newDirectoryMode); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if err := os.Rename(filePath, dstPath); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		quarantined.AbsoluteFilePaths = append(quarantined.AbsoluteFilePaths, dstPath)
+	}
+
+	return quarantined, multiErr.FinalError()
+}
+
+// MarkQuarantinedFileSetRestored writes a marker file next to a quarantined
+// fileset volume to record that the blocks or the index it held have been
+// restored, so that they are not restored again after a restart.
+func MarkQuarantinedFileSetRestored(fileSet FileSetFile, newFileMode os.FileMode) error {
+	checkpointFilePath, ok := fileSet.filepath(CheckpointFileSuffix)
+	if !ok {
+		return ErrCheckpointFileNotFound
+	}
+	markerFilePath := strings.TrimSuffix(checkpointFilePath, CheckpointFileSuffix+fileSuffix) +
+		quarantineRestoredFileSuffix + fileSuffix
+	return ioutil.WriteFile(markerFilePath, nil, newFileMode)
+}
+
+// IsQuarantinedFileSetRestored returns whether the blocks or the index held
+// by a quarantined fileset volume have been restored.
+func IsQuarantinedFileSetRestored(fileSet FileSetFile) bool {
+	suffix := separator + quarantineRestoredFileSuffix + fileSuffix
+	for _, filePath := range fileSet.AbsoluteFilePaths {
+		if strings.HasSuffix(filePath, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// QuarantineDirPath returns the path to the directory corrupt filesets are
+// moved to.
+func QuarantineDirPath(prefix string) string {
+	return filepath.Join(prefix, quarantineDirName)
+}
+
+// readAndVerifyDigestsFile reads the digests file of a volume and verifies it
+// against the digest in the checkpoint file.
+func readAndVerifyDigestsFile(checkpointFilePath, digestsFilePath string) ([]byte, error) {
+	expected, err := readCheckpointFile(checkpointFilePath, digest.NewBuffer())
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(digestsFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if actual := digest.Checksum(data); actual != expected {
+		return nil, CorruptFileSetError{
+			FilePath: digestsFilePath,
+			Err:      newDigestMismatchError(expected, actual),
+		}
+	}
+	return data, nil
+}
+
+// scrubFile reads the file and verifies its digest, decrypting it first if
+// it is encrypted. Failing to open the file or to decrypt its header, which
+// happens when the key is missing, is not considered a corruption, failing
+// to read or decrypt its contents is.
+func scrubFile(filePath string, expected uint32, opts ScrubOptions) (int64, error) {
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer fd.Close()
+
+	var reader io.Reader = fd
+	decryptingReader, encrypted, err := newDecryptingReaderAt(fd, opts.EncryptionKeyProvider)
+	if err != nil {
+		return 0, err
+	}
+	if encrypted {
+		reader = io.NewSectionReader(decryptingReader, 0, decryptingReader.Size())
+	}
+
+	var (
+		digestReader = digest.NewReaderWithDigest(reader)
+		buf          = make([]byte, scrubReadBufferSize)
+		bytesRead    int64
+	)
+	for {
+		n, err := digestReader.Read(buf)
+		bytesRead += int64(n)
+		if opts.ThrottleFn != nil && n > 0 {
+			opts.ThrottleFn(n)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return bytesRead, CorruptFileSetError{FilePath: filePath, Err: err}
+		}
+	}
+
+	if err := digestReader.Validate(expected); err != nil {
+		return bytesRead, CorruptFileSetError{FilePath: filePath, Err: err}
+	}
+	return bytesRead, nil
+}
+
+func newDigestMismatchError(expected, actual uint32) error {
+	return fmt.Errorf("checksum mismatch: expected=%d, actual=%d", expected, actual)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+)
+
+func corruptTestFile(t *testing.T, filePath string) {
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+	data[len(data)-1]++
+	require.NoError(t, ioutil.WriteFile(filePath, data, 0666))
+}
+
+func TestScrubDataFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "data")
+	defer os.RemoveAll(dir)
+
+	keyProvider := newTestEncryptionKeyProvider(t, dir)
+	for _, test := range []struct {
+		name  string
+		shard uint32
+		opts  ScrubOptions
+	}{
+		{
+			name:  "plaintext",
+			shard: 0,
+			opts:  ScrubOptions{FilePathPrefix: filePathPrefix},
+		},
+		{
+			name:  "encrypted",
+			shard: 1,
+			opts: ScrubOptions{
+				FilePathPrefix:        filePathPrefix,
+				EncryptionKeyProvider: keyProvider,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			w, err := NewWriter(testDefaultOpts.
+				SetFilePathPrefix(filePathPrefix).
+				SetWriterBufferSize(testWriterBufferSize).
+				SetEncryptionKeyProvider(test.opts.EncryptionKeyProvider))
+			require.NoError(t, err)
+			writeTestData(t, w, test.shard, testWriterStart, []testEntry{
+				{"foo", nil, []byte{1, 2, 3}},
+				{"bar", map[string]string{"baz": "qux"}, []byte{4, 5, 6}},
+			}, persist.FileSetFlushType)
+
+			var throttled int
+			opts := test.opts
+			opts.ThrottleFn = func(n int) { throttled += n }
+
+			id := FileSetFileIdentifier{
+				Namespace:  testNs1ID,
+				Shard:      test.shard,
+				BlockStart: testWriterStart,
+			}
+			bytesRead, err := ScrubDataFileSet(id, opts)
+			require.NoError(t, err)
+			assert.True(t, bytesRead > 0)
+			assert.Equal(t, int(bytesRead), throttled)
+
+			shardDir := ShardDataDirPath(filePathPrefix, testNs1ID, test.shard)
+			corruptTestFile(t, dataFilesetPathFromTimeAndIndex(shardDir,
+				testWriterStart, 0, dataFileSuffix, false))
+			_, err = ScrubDataFileSet(id, opts)
+			require.Error(t, err)
+			assert.True(t, IsCorruptFileSetError(err))
+		})
+	}
+}
+
+func TestScrubDataFileSetMissingCheckpoint(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "data")
+	defer os.RemoveAll(dir)
+
+	_, err := ScrubDataFileSet(FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		BlockStart: testWriterStart,
+	}, ScrubOptions{FilePathPrefix: filePathPrefix})
+	require.Equal(t, ErrCheckpointFileNotFound, err)
+	assert.False(t, IsCorruptFileSetError(err))
+}
+
+func TestScrubIndexFileSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	test := newIndexWriteTestSetup(t)
+	defer test.cleanup()
+
+	writer := newTestIndexWriter(t, test.filePathPrefix)
+	err := writer.Open(IndexWriterOpenOptions{
+		Identifier:  test.fileSetID,
+		BlockSize:   test.blockSize,
+		FileSetType: persist.FileSetFlushType,
+		Shards:      shardsSet(1, 3),
+	})
+	require.NoError(t, err)
+
+	testSegments := []testIndexSegment{
+		{
+			segmentType:  idxpersist.IndexSegmentType("fst"),
+			majorVersion: 1,
+			minorVersion: 2,
+			files: []testIndexSegmentFile{
+				{idxpersist.IndexSegmentFileType("first"), randDataFactorOfBuffSize(t, 1.5)},
+				{idxpersist.IndexSegmentFileType("second"), randDataFactorOfBuffSize(t, 2.5)},
+			},
+		},
+	}
+	writeTestIndexSegments(t, ctrl, writer, testSegments)
+	require.NoError(t, writer.Close())
+
+	opts := ScrubOptions{FilePathPrefix: test.filePathPrefix}
+	bytesRead, err := ScrubIndexFileSet(test.fileSetID, opts)
+	require.NoError(t, err)
+	assert.True(t, bytesRead > 0)
+
+	corruptTestFile(t, filesetIndexSegmentFilePathFromTime(
+		NamespaceIndexDataDirPath(test.filePathPrefix, test.fileSetID.Namespace),
+		test.blockStart, 0, 0, testSegments[0].files[1].segmentFileType))
+	_, err = ScrubIndexFileSet(test.fileSetID, opts)
+	require.Error(t, err)
+	assert.True(t, IsCorruptFileSetError(err))
+}
+
+func TestQuarantineFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "data")
+	defer os.RemoveAll(dir)
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}, persist.FileSetFlushType)
+
+	fileSets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 1)
+
+	quarantined, err := QuarantineFileSet(filePathPrefix, fileSets[0], defaultNewDirectoryMode)
+	require.NoError(t, err)
+	assert.False(t, IsQuarantinedFileSetRestored(quarantined))
+
+	fileSets, err = DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	assert.Empty(t, fileSets)
+
+	fileSets, err = DataFiles(QuarantineDirPath(filePathPrefix), testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 1)
+	assert.True(t, fileSets[0].HasCompleteCheckpointFile())
+	assert.Len(t, quarantined.AbsoluteFilePaths, len(fileSets[0].AbsoluteFilePaths))
+	assert.True(t, quarantined.HasCompleteCheckpointFile())
+	assert.False(t, IsQuarantinedFileSetRestored(fileSets[0]))
+
+	// The restored marker is listed with the quarantined volume.
+	require.NoError(t, MarkQuarantinedFileSetRestored(quarantined, defaultNewFileMode))
+	fileSets, err = DataFiles(QuarantineDirPath(filePathPrefix), testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 1)
+	assert.True(t, fileSets[0].HasCompleteCheckpointFile())
+	assert.True(t, IsQuarantinedFileSetRestored(fileSets[0]))
+}
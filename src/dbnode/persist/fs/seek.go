@@ -70,12 +70,18 @@ type seeker struct {
 	// instead of time.Time to avoid keeping an extra pointer around.
 	start          xtime.UnixNano
 	blockSize      time.Duration
+	volume         int
 	versionChecker schema.VersionChecker
 
 	dataFd        *os.File
 	indexFd       *os.File
 	indexFileSize int64
 
+	// Readers of the data and index files, which decrypt the files if they
+	// are encrypted.
+	dataReader  io.ReaderAt
+	indexReader io.ReaderAt
+
 	unreadBuf []byte
 
 	// Bloom filter associated with the shard / block the seeker is responsible
@@ -228,10 +234,35 @@ func (s *seeker) Open(
 	}
 	s.start = xtime.UnixNano(info.BlockStart)
 	s.blockSize = time.Duration(info.BlockSize)
+	s.volume = volumeIndex
 	s.versionChecker = schema.NewVersionChecker(int(info.MajorVersion), int(info.MinorVersion))
 
+	keyProvider := s.opts.opts.EncryptionKeyProvider()
+	indexDecryptingReader, indexEncrypted, err := newDecryptingReaderAt(s.indexFd, keyProvider)
+	if err != nil {
+		s.Close()
+		return err
+	}
+	dataDecryptingReader, dataEncrypted, err := newDecryptingReaderAt(s.dataFd, keyProvider)
+	if err != nil {
+		s.Close()
+		return err
+	}
+	s.indexReader, s.dataReader = s.indexFd, s.dataFd
+	if indexEncrypted {
+		s.indexReader = indexDecryptingReader
+	}
+	if dataEncrypted {
+		s.dataReader = dataDecryptingReader
+	}
+
+	var indexReaderWithDigest validatingReader = indexFdWithDigest
+	if indexEncrypted {
+		indexReaderWithDigest = digest.NewReaderWithDigest(
+			io.NewSectionReader(indexDecryptingReader, 0, indexDecryptingReader.Size()))
+	}
 	err = s.validateIndexFileDigest(
-		indexFdWithDigest, expectedDigests.indexDigest)
+		indexReaderWithDigest, expectedDigests.indexDigest)
 	if err != nil {
 		s.Close()
 		return fmt.Errorf(
@@ -240,12 +271,16 @@ func (s *seeker) Open(
 		)
 	}
 
-	indexFdStat, err := s.indexFd.Stat()
-	if err != nil {
-		s.Close()
-		return err
+	if indexEncrypted {
+		s.indexFileSize = indexDecryptingReader.Size()
+	} else {
+		indexFdStat, err := s.indexFd.Stat()
+		if err != nil {
+			s.Close()
+			return err
+		}
+		s.indexFileSize = indexFdStat.Size()
 	}
-	s.indexFileSize = indexFdStat.Size()
 
 	s.bloomFilter, err = newManagedConcurrentBloomFilterFromFile(
 		bloomFilterFd,
@@ -254,6 +289,7 @@ func (s *seeker) Open(
 		uint(info.BloomFilter.NumElementsM),
 		uint(info.BloomFilter.NumHashesK),
 		s.opts.opts.ForceBloomFilterMmapMemory(),
+		keyProvider,
 		mmap.ReporterOptions{
 			Reporter: s.opts.opts.MmapReporter(),
 		},
@@ -271,6 +307,7 @@ func (s *seeker) Open(
 		resources.byteDecoderStream,
 		int(info.Summaries.Summaries),
 		s.opts.opts.ForceIndexSummariesMmapMemory(),
+		keyProvider,
 		mmap.ReporterOptions{
 			Reporter: s.opts.opts.MmapReporter(),
 		},
@@ -338,7 +375,7 @@ func (s *seeker) SeekByIndexEntry(
 	entry IndexEntry,
 	resources ReusableSeekerResources,
 ) (checked.Bytes, error) {
-	resources.offsetFileReader.reset(s.dataFd, entry.Offset)
+	resources.offsetFileReader.reset(s.dataReader, entry.Offset)
 
 	// Obtain an appropriately sized buffer.
 	var buffer checked.Bytes
@@ -396,7 +433,7 @@ func (s *seeker) SeekIndexEntry(
 		return IndexEntry{}, err
 	}
 
-	resources.offsetFileReader.reset(s.indexFd, offset)
+	resources.offsetFileReader.reset(s.indexReader, offset)
 	resources.fileDecoderStream.Reset(resources.offsetFileReader)
 	resources.xmsgpackDecoder.Reset(resources.fileDecoderStream)
 
@@ -468,6 +505,10 @@ func (s *seeker) Range() xtime.Range {
 	return xtime.Range{Start: s.start, End: s.start.Add(s.blockSize)}
 }
 
+func (s *seeker) Volume() int {
+	return s.volume
+}
+
 func (s *seeker) Close() error {
 	// Parent should handle cleaning up shared resources
 	if s.isClone {
@@ -491,6 +532,8 @@ func (s *seeker) Close() error {
 		multiErr = multiErr.Add(s.dataFd.Close())
 		s.dataFd = nil
 	}
+	s.indexReader = nil
+	s.dataReader = nil
 	return multiErr.FinalError()
 }
 
@@ -512,17 +555,30 @@ func (s *seeker) ConcurrentClone() (ConcurrentDataFileSetSeeker, error) {
 
 		// Index and data fd's are always accessed via the ReadAt() / pread APIs so
 		// they are concurrency safe and can be shared among clones.
-		indexFd: s.indexFd,
-		dataFd:  s.dataFd,
+		indexFd:     s.indexFd,
+		dataFd:      s.dataFd,
+		indexReader: s.indexReader,
+		dataReader:  s.dataReader,
 
 		versionChecker: s.versionChecker,
+
+		start:     s.start,
+		blockSize: s.blockSize,
+		volume:    s.volume,
 	}
 
 	return seeker, nil
 }
 
+// validatingReader is a reader that validates the digest of what it read.
+type validatingReader interface {
+	io.Reader
+
+	Validate(expectedDigest uint32) error
+}
+
 func (s *seeker) validateIndexFileDigest(
-	indexFdWithDigest digest.FdWithDigestReader,
+	indexFdWithDigest validatingReader,
 	expectedDigest uint32,
 ) error {
 	// If piecemeal checksumming validation enabled for index entries, do not attempt to validate the
@@ -649,14 +705,15 @@ func (s *simpleBytesPool) Put(b []byte) {
 
 var _ io.Reader = &offsetFileReader{}
 
-// offsetFileReader implements io.Reader() and allows an *os.File to be wrapped
-// such that any calls to Read() are issued at the provided offset. This is used
+// offsetFileReader implements io.Reader() and allows an *os.File, or a reader
+// that decrypts one, to be wrapped such that any calls to Read() are issued at
+// the provided offset. This is used
 // to issue reads to specific portions of the index and data files without having
 // to first call Seek(). This reduces the number of syscalls that need to be made
 // and also allows the fds to be shared among concurrent goroutines since the
 // internal F.D offset managed by the kernel is not being used.
 type offsetFileReader struct {
-	fd     *os.File
+	fd     io.ReaderAt
 	offset int64
 }
 
@@ -670,7 +727,7 @@ func (p *offsetFileReader) Read(b []byte) (n int, err error) {
 	return n, err
 }
 
-func (p *offsetFileReader) reset(fd *os.File, offset int64) {
+func (p *offsetFileReader) reset(fd io.ReaderAt, offset int64) {
 	p.fd = fd
 	p.offset = offset
 }
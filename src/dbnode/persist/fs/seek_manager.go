@@ -809,7 +809,8 @@ func (m *seekerManager) openAnyUnopenSeekers(byTime *seekersByTime) error {
 		byTime.Lock()
 		_, err := m.getOrOpenSeekersWithLock(t, byTime)
 		byTime.Unlock()
-		if err != nil && err != errSeekerManagerFileSetNotFound {
+		if err != nil && err != errSeekerManagerFileSetNotFound &&
+			err != errRemoteFileSetFetching {
 			multiErr = multiErr.Add(err)
 		}
 	}
@@ -822,13 +823,50 @@ func (m *seekerManager) newOpenSeeker(
 	blockStart xtime.UnixNano,
 	volume int,
 ) (DataFileSetSeeker, error) {
+	requestedBlockStart := blockStart
 	exists, err := DataFileSetExists(
 		m.filePathPrefix, m.namespace, shard, blockStart, volume)
 	if err != nil {
 		return nil, err
 	}
 	if !exists {
-		return nil, errSeekerManagerFileSetNotFound
+		// The block may have been compacted into the fileset of a larger
+		// block, which is then opened in place of the fileset of the block.
+		compactedBlockStart, ok, err := CompactedDataFileSetBlockStart(m.filePathPrefix,
+			m.namespace, shard, blockStart, volume, m.opts.CompactedBlockSizes())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errSeekerManagerFileSetNotFound
+		}
+		blockStart = compactedBlockStart
+	}
+
+	// Offloaded filesets are opened from the remote fileset cache, opening
+	// one that is not cached yet fails with a retryable error while it is
+	// fetched in the background.
+	filePathPrefix := m.filePathPrefix
+	if cache := m.opts.RemoteFileSetCache(); cache != nil {
+		offloaded, err := DataFileSetOffloaded(m.filePathPrefix, m.namespace,
+			shard, blockStart, volume)
+		if err != nil {
+			return nil, err
+		}
+		if offloaded {
+			id := FileSetFileIdentifier{
+				Namespace:   m.namespace,
+				Shard:       shard,
+				BlockStart:  blockStart,
+				VolumeIndex: volume,
+			}
+			filePathPrefix, err = cache.Fetch(id)
+			if err != nil {
+				return nil, err
+			}
+			// Keep the fileset cached until the seeker has opened it.
+			defer cache.Release(id)
+		}
 	}
 
 	// NB(r): Use a lock on the unread buffer to avoid multiple
@@ -838,7 +876,7 @@ func (m *seekerManager) newOpenSeeker(
 	defer m.unreadBuf.Unlock()
 
 	seekerIface := NewSeeker(
-		m.filePathPrefix,
+		filePathPrefix,
 		m.opts.DataReaderBufferSize(),
 		m.opts.InfoReaderBufferSize(),
 		m.bytesPool,
@@ -867,6 +905,14 @@ func (m *seekerManager) newOpenSeeker(
 	m.unreadBuf.value = seeker.unreadBuffer()
 	seeker.setUnreadBuffer(nil)
 
+	if !seeker.Range().End.After(requestedBlockStart) {
+		// The fileset of the larger block does not cover the block.
+		if err := seeker.Close(); err != nil {
+			return nil, err
+		}
+		return nil, errSeekerManagerFileSetNotFound
+	}
+
 	return seeker, nil
 }
 
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// SplitFileSetOptions describes a flushed data fileset that is split into the
+// shards it is divided into when the number of shards is multiplied by a factor.
+type SplitFileSetOptions struct {
+	NamespaceID ident.ID
+	Shard       uint32
+	BlockStart  xtime.UnixNano
+	VolumeIndex int
+
+	// NumShards is the number of shards before the split, if set every series
+	// of the fileset is verified to belong to the shard.
+	NumShards uint32
+
+	// DstVolumeIndex is the volume index of the split filesets.
+	DstVolumeIndex int
+
+	// HashFn is the hash function over the multiplied number of shards.
+	HashFn sharding.HashFn
+}
+
+// SplitShardID returns the ID of the i-th shard split from the given shard,
+// the 0-th split shard being the shard itself.
+func SplitShardID(numShards uint32, i int, shard uint32) uint32 {
+	return numShards*uint32(i) + shard
+}
+
+// SplitFileSet splits a flushed data fileset by writing the series of each split
+// shard with the writer of the shard, series of split shards without a writer
+// are skipped.
+func SplitFileSet(
+	srcReader DataFileSetReader,
+	dstWriters map[uint32]StreamingWriter,
+	opts SplitFileSetOptions,
+) error {
+	if opts.NumShards > 0 && opts.Shard >= opts.NumShards {
+		return fmt.Errorf("unexpected source shard ID %d (must be under %d)", opts.Shard, opts.NumShards)
+	}
+
+	if err := srcReader.Open(splitReaderOpenOptions(opts, opts.Shard, opts.VolumeIndex)); err != nil {
+		return fmt.Errorf("unable to open srcReader: %w", err)
+	}
+
+	plannedRecordsCount := uint(srcReader.Entries() / len(dstWriters))
+	if plannedRecordsCount == 0 {
+		plannedRecordsCount = 1
+	}
+
+	for shardID, dstWriter := range dstWriters {
+		writeOpts := StreamingWriterOpenOptions{
+			NamespaceID:         opts.NamespaceID,
+			ShardID:             shardID,
+			BlockStart:          opts.BlockStart,
+			BlockSize:           srcReader.Status().BlockSize,
+			VolumeIndex:         opts.DstVolumeIndex,
+			PlannedRecordsCount: plannedRecordsCount,
+		}
+		if err := dstWriter.Open(writeOpts); err != nil {
+			return fmt.Errorf("unable to open writer for shard %d: %w", shardID, err)
+		}
+	}
+
+	dataHolder := make([][]byte, 1)
+	for {
+		entry, err := srcReader.StreamingRead()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read error: %w", err)
+		}
+
+		newShardID := opts.HashFn(entry.ID)
+		if opts.NumShards > 0 && newShardID%opts.NumShards != opts.Shard {
+			return fmt.Errorf("mismatched shards, %d to %d", opts.Shard, newShardID)
+		}
+		writer, ok := dstWriters[newShardID]
+		if !ok {
+			continue
+		}
+
+		dataHolder[0] = entry.Data
+		if err := writer.WriteAll(entry.ID, entry.EncodedTags, dataHolder, entry.DataChecksum); err != nil {
+			return err
+		}
+	}
+
+	for _, dstWriter := range dstWriters {
+		if err := dstWriter.Close(); err != nil {
+			return err
+		}
+	}
+
+	return srcReader.Close()
+}
+
+// VerifySplitFileSet verifies that the series of a flushed data fileset were
+// written to the filesets of the split shards with a reader.
+func VerifySplitFileSet(
+	srcReader DataFileSetReader,
+	dstReaders map[uint32]DataFileSetReader,
+	opts SplitFileSetOptions,
+) error {
+	if opts.NumShards > 0 && opts.Shard >= opts.NumShards {
+		return fmt.Errorf("unexpected source shard ID %d (must be under %d)", opts.Shard, opts.NumShards)
+	}
+
+	if err := srcReader.Open(splitReaderOpenOptions(opts, opts.Shard, opts.VolumeIndex)); err != nil {
+		return fmt.Errorf("unable to open srcReader: %w", err)
+	}
+
+	for shardID, dstReader := range dstReaders {
+		if err := dstReader.Open(splitReaderOpenOptions(opts, shardID, opts.DstVolumeIndex)); err != nil {
+			return fmt.Errorf("unable to open reader for shard %d: %w", shardID, err)
+		}
+	}
+
+	for {
+		srcEntry, err := srcReader.StreamingReadMetadata()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("src read error: %w", err)
+		}
+
+		newShardID := opts.HashFn(srcEntry.ID)
+		if opts.NumShards > 0 && newShardID%opts.NumShards != opts.Shard {
+			return fmt.Errorf("mismatched shards, %d to %d", opts.Shard, newShardID)
+		}
+		dstReader, ok := dstReaders[newShardID]
+		if !ok {
+			continue
+		}
+
+		// Using StreamingRead() on destination filesets here because it also verifies data checksums.
+		dstEntry, err := dstReader.StreamingRead()
+		if err != nil {
+			return fmt.Errorf("dst read error: %w", err)
+		}
+
+		if !bytes.Equal(srcEntry.ID, dstEntry.ID) {
+			return fmt.Errorf("ID mismatch: %s != %s", srcEntry.ID, dstEntry.ID)
+		}
+		if !bytes.Equal(srcEntry.EncodedTags, dstEntry.EncodedTags) {
+			return fmt.Errorf("EncodedTags mismatch: %s != %s", srcEntry.EncodedTags, dstEntry.EncodedTags)
+		}
+		if srcEntry.DataChecksum != dstEntry.DataChecksum {
+			return fmt.Errorf("data checksum mismatch: %d != %d, id=%s",
+				srcEntry.DataChecksum, dstEntry.DataChecksum, srcEntry.ID)
+		}
+	}
+
+	for shardID, dstReader := range dstReaders {
+		if _, err := dstReader.StreamingReadMetadata(); !errors.Is(err, io.EOF) {
+			return fmt.Errorf("expected EOF on split shard %d, but got %w", shardID, err)
+		}
+		if err := dstReader.Close(); err != nil {
+			return err
+		}
+	}
+
+	return srcReader.Close()
+}
+
+func splitReaderOpenOptions(
+	opts SplitFileSetOptions,
+	shard uint32,
+	volumeIndex int,
+) DataReaderOpenOptions {
+	return DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   opts.NamespaceID,
+			Shard:       shard,
+			BlockStart:  opts.BlockStart,
+			VolumeIndex: volumeIndex,
+		},
+		FileSetType:      persist.FileSetFlushType,
+		StreamingEnabled: true,
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/sharding"
+)
+
+func TestSplitFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	var (
+		numShards = uint32(2)
+		factor    = 2
+		srcShard  = uint32(0)
+		hashFn    = sharding.DefaultHashFn(int(numShards) * factor)
+		entries   []testStreamingEntry
+		expected  []testStreamingEntry
+	)
+	for i := 0; len(entries) < 20; i++ {
+		id := fmt.Sprintf("id.%03d", i)
+		if hashFn(testEntry{id: id}.ID())%numShards != srcShard {
+			continue
+		}
+		entries = append(entries, testStreamingEntry{testEntry{id, nil, nil}, []float64{float64(i)}})
+	}
+
+	w := newOpenTestStreamingWriter(t, filePathPrefix, srcShard, testWriterStart, 0, uint(len(entries)))
+	require.NoError(t, streamingWriteTestData(t, w, testWriterStart, entries))
+	require.NoError(t, w.Close())
+
+	childShard := SplitShardID(numShards, 1, srcShard)
+	require.Equal(t, uint32(2), childShard)
+	for _, entry := range entries {
+		if hashFn(entry.ID()) == childShard {
+			expected = append(expected, entry)
+		}
+	}
+	require.NotEmpty(t, expected)
+	require.True(t, len(expected) < len(entries))
+
+	opts := SplitFileSetOptions{
+		NamespaceID:    testNs1ID,
+		Shard:          srcShard,
+		NumShards:      numShards,
+		BlockStart:     testWriterStart,
+		VolumeIndex:    0,
+		DstVolumeIndex: 0,
+		HashFn:         hashFn,
+	}
+
+	// Only write the child shard, the source shard keeps its own fileset.
+	dstWriters := map[uint32]StreamingWriter{childShard: newTestStreamingWriter(t, filePathPrefix)}
+	require.NoError(t, SplitFileSet(newTestReader(t, filePathPrefix), dstWriters, opts))
+
+	dstReaders := map[uint32]DataFileSetReader{childShard: newTestReader(t, filePathPrefix)}
+	require.NoError(t, VerifySplitFileSet(newTestReader(t, filePathPrefix), dstReaders, opts))
+
+	r := newTestReader(t, filePathPrefix)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:  testNs1ID,
+			Shard:      childShard,
+			BlockStart: testWriterStart,
+		},
+		StreamingEnabled: true,
+	}))
+	require.Equal(t, len(expected), r.Entries())
+	for _, entry := range expected {
+		read, err := r.StreamingRead()
+		require.NoError(t, err)
+		require.Equal(t, entry.id, string(read.ID))
+		require.Equal(t, entry.data, read.Data)
+	}
+	_, err := r.StreamingRead()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, r.Close())
+
+	readTestData(t, newTestReader(t, filePathPrefix), srcShard, testWriterStart,
+		toTestEntries(entries))
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	tombstonesFileName      = "tombstones" + fileSuffix
+	tombstonesFormatVersion = 1
+)
+
+var (
+	errTombstonesFileTooShort  = errors.New("tombstones file too short")
+	errTombstonesFileCorrupted = errors.New("tombstones file corrupted")
+)
+
+// SeriesTombstone describes the data of a series that has been deleted.
+type SeriesTombstone struct {
+	ID []byte
+	// DeletedAt is the time of the latest delete of the series.
+	DeletedAt xtime.UnixNano
+	// RemovedAt is the time at which all of the data of the series was
+	// deleted, or zero if only some of its data has been deleted.
+	RemovedAt xtime.UnixNano
+	Blocks    []BlockTombstone
+}
+
+// BlockTombstone describes the deleted data of a block, the datapoints of
+// the block within [From, Until) are deleted. A block may have several
+// tombstones with disjoint ranges.
+type BlockTombstone struct {
+	BlockStart xtime.UnixNano
+	From       xtime.UnixNano
+	Until      xtime.UnixNano
+}
+
+// TombstonesFilePath returns the path to the tombstones file of a shard.
+func TombstonesFilePath(prefix string, namespace ident.ID, shard uint32) string {
+	return path.Join(ShardDataDirPath(prefix, namespace, shard), tombstonesFileName)
+}
+
+// WriteTombstones persists the full set of tombstones for a shard, replacing
+// any tombstones previously written for it.
+func WriteTombstones(
+	opts Options,
+	namespace ident.ID,
+	shard uint32,
+	tombstones []SeriesTombstone,
+) error {
+	var (
+		shardDir = ShardDataDirPath(opts.FilePathPrefix(), namespace, shard)
+		filePath = path.Join(shardDir, tombstonesFileName)
+	)
+	if len(tombstones) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	return writeShardSidecarFile(opts, shardDir, tombstonesFileName, encodeTombstones(tombstones))
+}
+
+// ReadTombstones reads the tombstones for a shard, returning no tombstones
+// and no error if none have been written.
+func ReadTombstones(
+	prefix string,
+	namespace ident.ID,
+	shard uint32,
+) ([]SeriesTombstone, error) {
+	filePath := TombstonesFilePath(prefix, namespace, shard)
+	exists, err := FileExists(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	buf, err := read(filePath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeTombstones(buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tombstones file %s: %w", filePath, err)
+	}
+	return result, nil
+}
+
+// encodeTombstones encodes tombstones as a version header followed by each
+// series ID, the times it was deleted and removed at and its deleted block
+// ranges, all varint encoded, and a trailing digest of the contents.
+func encodeTombstones(tombstones []SeriesTombstone) []byte {
+	buf := make([]byte, 0, 64*len(tombstones))
+	buf = binary.AppendUvarint(buf, tombstonesFormatVersion)
+	buf = binary.AppendUvarint(buf, uint64(len(tombstones)))
+	for _, t := range tombstones {
+		buf = binary.AppendUvarint(buf, uint64(len(t.ID)))
+		buf = append(buf, t.ID...)
+		buf = binary.AppendVarint(buf, int64(t.DeletedAt))
+		buf = binary.AppendVarint(buf, int64(t.RemovedAt))
+		buf = binary.AppendUvarint(buf, uint64(len(t.Blocks)))
+		for _, b := range t.Blocks {
+			buf = binary.AppendVarint(buf, int64(b.BlockStart))
+			buf = binary.AppendVarint(buf, int64(b.From))
+			buf = binary.AppendVarint(buf, int64(b.Until))
+		}
+	}
+
+	digestBuf := digest.NewBuffer()
+	digestBuf.WriteDigest(digest.Checksum(buf))
+	return append(buf, digestBuf...)
+}
+
+func decodeTombstones(buf []byte) ([]SeriesTombstone, error) {
+	if len(buf) < digest.DigestLenBytes {
+		return nil, errTombstonesFileTooShort
+	}
+
+	var (
+		contents = buf[:len(buf)-digest.DigestLenBytes]
+		expected = digest.ToBuffer(buf[len(buf)-digest.DigestLenBytes:]).ReadDigest()
+	)
+	if digest.Checksum(contents) != expected {
+		return nil, errTombstonesFileCorrupted
+	}
+
+	d := sidecarDecoder{buf: contents, corrupted: errTombstonesFileCorrupted}
+	version := d.uvarint()
+	if d.err == nil && version != tombstonesFormatVersion {
+		return nil, fmt.Errorf("unsupported tombstones format version: %d", version)
+	}
+
+	n := d.uvarint()
+	if d.err != nil {
+		return nil, d.err
+	}
+	result := make([]SeriesTombstone, 0, n)
+	for i := uint64(0); i < n; i++ {
+		id := d.bytes(d.uvarint())
+		deletedAt := xtime.UnixNano(d.varint())
+		removedAt := xtime.UnixNano(d.varint())
+		numBlocks := d.uvarint()
+		if d.err != nil {
+			return nil, d.err
+		}
+		blocks := make([]BlockTombstone, 0, numBlocks)
+		for j := uint64(0); j < numBlocks; j++ {
+			blocks = append(blocks, BlockTombstone{
+				BlockStart: xtime.UnixNano(d.varint()),
+				From:       xtime.UnixNano(d.varint()),
+				Until:      xtime.UnixNano(d.varint()),
+			})
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		result = append(result, SeriesTombstone{
+			ID:        id,
+			DeletedAt: deletedAt,
+			RemovedAt: removedAt,
+			Blocks:    blocks,
+		})
+	}
+	return result, nil
+}
+
+// writeShardSidecarFile writes a file that lives alongside the filesets of a
+// shard. The file is written to a temporary location and renamed so that
+// readers never observe a partially written file.
+func writeShardSidecarFile(opts Options, shardDir, fileName string, buf []byte) error {
+	var (
+		tmpPath  = path.Join(shardDir, fileName+".tmp")
+		filePath = path.Join(shardDir, fileName)
+	)
+	if err := os.MkdirAll(shardDir, opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	fd, err := OpenWritable(tmpPath, opts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(buf); err != nil {
+		_ = fd.Close()
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		_ = fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(shardDir) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	if err := dir.Sync(); err != nil {
+		_ = dir.Close()
+		return err
+	}
+	return dir.Close()
+}
+
+// sidecarDecoder decodes the varint encoded contents of a shard sidecar
+// file, recording the first error encountered.
+type sidecarDecoder struct {
+	buf       []byte
+	err       error
+	corrupted error
+}
+
+func (d *sidecarDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = d.corrupted
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *sidecarDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = d.corrupted
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *sidecarDecoder) float64() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 8 {
+		d.err = d.corrupted
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *sidecarDecoder) bytes(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.buf)) < n {
+		d.err = d.corrupted
+		return nil
+	}
+	v := append([]byte(nil), d.buf[:n]...)
+	d.buf = d.buf[n:]
+	return v
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestTombstonesWriteAndRead(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		shard     = uint32(3)
+	)
+	defer os.RemoveAll(dir)
+
+	tombstones, err := ReadTombstones(dir, namespace, shard)
+	require.NoError(t, err)
+	require.Nil(t, tombstones)
+
+	expected := []SeriesTombstone{
+		{
+			ID:        []byte("foo"),
+			DeletedAt: xtime.UnixNano(10800000000000),
+			RemovedAt: xtime.UnixNano(10800000000000),
+			Blocks: []BlockTombstone{
+				{BlockStart: xtime.UnixNano(0), From: xtime.UnixNano(0), Until: xtime.UnixNano(7200000000000)},
+				{BlockStart: xtime.UnixNano(7200000000000), From: xtime.UnixNano(7200000000000), Until: xtime.UnixNano(10800000000000)},
+			},
+		},
+		{
+			ID:        []byte("bar"),
+			DeletedAt: xtime.UnixNano(21600000000000),
+			Blocks: []BlockTombstone{
+				{BlockStart: xtime.UnixNano(14400000000000), From: xtime.UnixNano(15000000000000), Until: xtime.UnixNano(16000000000000)},
+				{BlockStart: xtime.UnixNano(14400000000000), From: xtime.UnixNano(18000000000000), Until: xtime.UnixNano(21600000000000)},
+			},
+		},
+	}
+	require.NoError(t, WriteTombstones(opts, namespace, shard, expected))
+
+	tombstones, err = ReadTombstones(dir, namespace, shard)
+	require.NoError(t, err)
+	require.Equal(t, expected, tombstones)
+
+	// Writing an empty set of tombstones removes the file.
+	require.NoError(t, WriteTombstones(opts, namespace, shard, nil))
+	exists, err := FileExists(TombstonesFilePath(dir, namespace, shard))
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestTombstonesReadCorrupted(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		shard     = uint32(0)
+	)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, WriteTombstones(opts, namespace, shard, []SeriesTombstone{
+		{ID: []byte("foo"), Blocks: []BlockTombstone{{BlockStart: 0, From: 0, Until: 1}}},
+	}))
+
+	filePath := TombstonesFilePath(dir, namespace, shard)
+	buf, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	buf[1] ^= 0xff
+	require.NoError(t, os.WriteFile(filePath, buf, opts.NewFileMode()))
+
+	_, err = ReadTombstones(dir, namespace, shard)
+	require.Error(t, err)
+}
@@ -43,9 +43,11 @@ import (
 	"github.com/m3db/m3/src/x/checked"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/serialize"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -133,6 +135,9 @@ type DataReaderOpenOptions struct {
 	// StreamingEnabled enables using streaming methods, such as
 	// DataFileSetReader.StreamingRead and DataFileSetReader.StreamingReadMetadata.
 	StreamingEnabled bool
+	// FilePathPrefix is the file path prefix to open the fileset under in
+	// place of the file path prefix of the reader, if set.
+	FilePathPrefix string
 }
 
 // DataFileSetReader provides an unsynchronized reader for a TSDB file set.
@@ -232,6 +237,9 @@ type DataFileSetSeeker interface {
 	// Range returns the time range associated with data in the volume
 	Range() xtime.Range
 
+	// Volume returns the index of the volume.
+	Volume() int
+
 	// ConcurrentIDBloomFilter returns a concurrency-safe bloom filter that can
 	// be used to quickly disqualify ID's that definitely do not exist. I.E if the
 	// Test() method returns true, the ID may exist on disk, but if it returns
@@ -266,6 +274,12 @@ type ConcurrentDataFileSetSeeker interface {
 
 	// ConcurrentIDBloomFilter is the same as in DataFileSetSeeker.
 	ConcurrentIDBloomFilter() *ManagedConcurrentBloomFilter
+
+	// Range is the same as in DataFileSetSeeker.
+	Range() xtime.Range
+
+	// Volume is the same as in DataFileSetSeeker.
+	Volume() int
 }
 
 // DataFileSetSeekerManager provides management of seekers for a TSDB namespace.
@@ -540,6 +554,52 @@ type Options interface {
 
 	// EncodingOptions returns the encoder options used by the encoder.
 	EncodingOptions() msgpack.LegacyEncodingOptions
+
+	// SetCompactedBlockSizes sets the block sizes that data filesets can be
+	// compacted into, used to locate the data of compacted blocks.
+	SetCompactedBlockSizes(value []time.Duration) Options
+
+	// CompactedBlockSizes returns the block sizes that data filesets can be
+	// compacted into, used to locate the data of compacted blocks.
+	CompactedBlockSizes() []time.Duration
+
+	// SetObjectStore sets the object store that data filesets are offloaded to.
+	SetObjectStore(value objectstore.Store) Options
+
+	// ObjectStore returns the object store that data filesets are offloaded to.
+	ObjectStore() objectstore.Store
+
+	// SetRemoteFileSetCache sets the cache of offloaded data filesets.
+	SetRemoteFileSetCache(value RemoteFileSetCache) Options
+
+	// RemoteFileSetCache returns the cache of offloaded data filesets.
+	RemoteFileSetCache() RemoteFileSetCache
+
+	// SetEncryptionKeyProvider sets the key provider used to encrypt filesets
+	// and commit logs at rest, files are written in plaintext when not set.
+	SetEncryptionKeyProvider(value encryption.KeyProvider) Options
+
+	// EncryptionKeyProvider returns the key provider used to encrypt filesets
+	// and commit logs at rest.
+	EncryptionKeyProvider() encryption.KeyProvider
+}
+
+// RemoteFileSetCache caches data filesets offloaded to an object store on
+// local disk so that they can be read.
+type RemoteFileSetCache interface {
+	// Fetch returns the file path prefix the offloaded data fileset is cached
+	// under and pins it in the cache until it is released. A fileset that is
+	// not cached is fetched in the background and a retryable error is
+	// returned until it is, so that reads do not wait on the object store.
+	Fetch(id FileSetFileIdentifier) (string, error)
+
+	// FetchAndWait is like Fetch but waits for a fileset that is not cached
+	// to be fetched, for reads that are not on the query path.
+	FetchAndWait(id FileSetFileIdentifier) (string, error)
+
+	// Release releases the pin of a fileset returned by Fetch or FetchAndWait
+	// so that it can be evicted.
+	Release(id FileSetFileIdentifier)
 }
 
 // BlockRetrieverOptions represents the options for block retrieval.
@@ -571,6 +631,12 @@ type BlockRetrieverOptions interface {
 	// CacheBlocksOnRetrieve returns whether to cache blocks after retrieval at a global level.
 	CacheBlocksOnRetrieve() bool
 
+	// SetBlockCache sets the cache of retrieved blocks, shared across namespaces.
+	SetBlockCache(value *block.BlockCache) BlockRetrieverOptions
+
+	// BlockCache returns the cache of retrieved blocks, shared across namespaces.
+	BlockCache() *block.BlockCache
+
 	// SetIdentifierPool sets the identifierPool.
 	SetIdentifierPool(value ident.Pool) BlockRetrieverOptions
 
@@ -588,6 +654,22 @@ type BlockRetrieverOptions interface {
 
 	// QueryLimits returns the query limits.
 	QueryLimits() limits.QueryLimits
+
+	// SetMultiReaderIteratorPool sets the multi reader iterator pool used to
+	// read the data of compacted blocks.
+	SetMultiReaderIteratorPool(value encoding.MultiReaderIteratorPool) BlockRetrieverOptions
+
+	// MultiReaderIteratorPool returns the multi reader iterator pool used to
+	// read the data of compacted blocks.
+	MultiReaderIteratorPool() encoding.MultiReaderIteratorPool
+
+	// SetEncoderPool sets the encoder pool used to read the data of compacted
+	// blocks.
+	SetEncoderPool(value encoding.EncoderPool) BlockRetrieverOptions
+
+	// EncoderPool returns the encoder pool used to read the data of compacted
+	// blocks.
+	EncoderPool() encoding.EncoderPool
 }
 
 // ForEachRemainingFn is the function that is run on each of the remaining
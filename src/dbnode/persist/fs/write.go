@@ -38,6 +38,8 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/schema"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/encryption"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	xresource "github.com/m3db/m3/src/x/resource"
 	"github.com/m3db/m3/src/x/serialize"
@@ -61,6 +63,7 @@ type writer struct {
 	summariesPercent                float64
 	bloomFilterFalsePositivePercent float64
 	bufferSize                      int
+	encryptionKeyProvider           encryption.KeyProvider
 
 	infoFdWithDigest           digest.FdWithDigestWriter
 	indexFdWithDigest          digest.FdWithDigestWriter
@@ -138,6 +141,7 @@ func NewWriter(opts Options) (DataFileSetWriter, error) {
 		summariesPercent:                opts.IndexSummariesPercent(),
 		bloomFilterFalsePositivePercent: opts.IndexBloomFilterFalsePositivePercent(),
 		bufferSize:                      bufferSize,
+		encryptionKeyProvider:           opts.EncryptionKeyProvider(),
 		infoFdWithDigest:                digest.NewFdWithDigestWriter(bufferSize),
 		indexFdWithDigest:               digest.NewFdWithDigestWriter(bufferSize),
 		summariesFdWithDigest:           digest.NewFdWithDigestWriter(bufferSize),
@@ -222,12 +226,24 @@ func (w *writer) Open(opts DataWriterOpenOptions) error {
 		return err
 	}
 
+	// The info and digest files are never encrypted so that filesets can be
+	// listed and validated without the keys.
 	w.infoFdWithDigest.Reset(infoFd)
-	w.indexFdWithDigest.Reset(indexFd)
-	w.summariesFdWithDigest.Reset(summariesFd)
-	w.bloomFilterFdWithDigest.Reset(bloomFilterFd)
-	w.dataFdWithDigest.Reset(dataFd)
 	w.digestFdWithDigestContents.Reset(digestFd)
+	err = xerrors.FirstError(
+		resetFdWithDigestWriter(w.indexFdWithDigest, indexFd, w.encryptionKeyProvider),
+		resetFdWithDigestWriter(w.summariesFdWithDigest, summariesFd, w.encryptionKeyProvider),
+		resetFdWithDigestWriter(w.bloomFilterFdWithDigest, bloomFilterFd, w.encryptionKeyProvider),
+		resetFdWithDigestWriter(w.dataFdWithDigest, dataFd, w.encryptionKeyProvider),
+	)
+	if err != nil {
+		for _, fd := range []*os.File{
+			infoFd, indexFd, summariesFd, bloomFilterFd, dataFd, digestFd,
+		} {
+			fd.Close()
+		}
+		return err
+	}
 
 	return nil
 }
@@ -22,7 +22,9 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -57,6 +59,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/encoding/nativehistogram"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/kvconfig"
@@ -95,6 +98,7 @@ import (
 	xdebug "github.com/m3db/m3/src/x/debug"
 	extdebug "github.com/m3db/m3/src/x/debug/ext"
 	xdocs "github.com/m3db/m3/src/x/docs"
+	"github.com/m3db/m3/src/x/encryption"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
@@ -164,6 +168,11 @@ type RunOptions struct {
 	// CustomBuildTags are additional tags to be added to the instrument build
 	// reporter.
 	CustomBuildTags map[string]string
+
+	// EncryptionKeyProvider is the key provider used to encrypt files at rest,
+	// such as one backed by a KMS, and is used instead of the key provider
+	// from the filesystem encryption configuration if set.
+	EncryptionKeyProvider encryption.KeyProvider
 }
 
 // Run runs the server programmatically given a filename for the
@@ -640,6 +649,70 @@ func Run(runOpts RunOptions) {
 		SetIndexBloomFilterFalsePositivePercent(cfg.Filesystem.BloomFilterFalsePositivePercentOrDefault()).
 		SetMmapReporter(mmapReporter)
 
+	if blockCompactionCfg := cfg.BlockCompaction; blockCompactionCfg != nil {
+		var (
+			tiers      = make([]storage.BlockCompactionTier, 0, len(blockCompactionCfg.Tiers))
+			blockSizes = make([]time.Duration, 0, len(blockCompactionCfg.Tiers))
+		)
+		for _, tier := range blockCompactionCfg.Tiers {
+			tiers = append(tiers, storage.BlockCompactionTier{
+				Age:       tier.Age,
+				BlockSize: tier.BlockSize,
+			})
+			blockSizes = append(blockSizes, tier.BlockSize)
+		}
+		// The seeker manager needs the compacted block sizes to locate the
+		// compacted filesets that hold the blocks it opens.
+		opts = opts.SetBlockCompactionTiers(tiers)
+		fsopts = fsopts.SetCompactedBlockSizes(blockSizes)
+	}
+
+	if offloadCfg := cfg.Filesystem.Offload; offloadCfg != nil {
+		store, err := offloadCfg.S3.NewStore()
+		if err != nil {
+			logger.Fatal("could not create fileset offload object store", zap.Error(err))
+		}
+		cache, err := fs.NewRemoteFileSetCache(fs.RemoteFileSetCacheOptions{
+			Store:          store,
+			FilePathPrefix: fsopts.FilePathPrefix(),
+			CacheFilePathPrefix: offloadCfg.Cache.
+				FilePathPrefixOrDefault(fsopts.FilePathPrefix()),
+			MaxBytes:          offloadCfg.Cache.MaxBytesOrDefault(),
+			NewFileMode:       newFileMode,
+			NewDirectoryMode:  newDirectoryMode,
+			InstrumentOptions: fsopts.InstrumentOptions(),
+		})
+		if err != nil {
+			logger.Fatal("could not create remote fileset cache", zap.Error(err))
+		}
+		opts = opts.SetFileSetOffloadAge(offloadCfg.Age)
+		fsopts = fsopts.
+			SetObjectStore(store).
+			SetRemoteFileSetCache(cache)
+	}
+
+	if keyProvider := runOpts.EncryptionKeyProvider; keyProvider != nil {
+		fsopts = fsopts.SetEncryptionKeyProvider(keyProvider)
+	} else if encryptionCfg := cfg.Filesystem.Encryption; encryptionCfg != nil {
+		keyProvider, err := encryptionCfg.NewKeyProvider()
+		if err != nil {
+			logger.Fatal("could not create encryption key provider", zap.Error(err))
+		}
+		fsopts = fsopts.SetEncryptionKeyProvider(keyProvider)
+	}
+
+	if backupCfg := cfg.Backup; backupCfg != nil {
+		store, err := backupCfg.Store.NewStore()
+		if err != nil {
+			logger.Fatal("could not create backup object store", zap.Error(err))
+		}
+		opts = opts.SetBackupStore(store)
+	}
+
+	if deletionCfg := cfg.SeriesDeletion; deletionCfg != nil {
+		opts = opts.SetMaxTombstonesPerShard(deletionCfg.MaxTombstonesPerShard)
+	}
+
 	var commitLogQueueSize int
 	cfgCommitLog := cfg.CommitLogOrDefault()
 	specified := cfgCommitLog.Queue.Size
@@ -687,7 +760,8 @@ func Run(runOpts RunOptions) {
 		SetFlushSize(cfgCommitLog.FlushMaxBytes).
 		SetFlushInterval(cfgCommitLog.FlushEvery).
 		SetBacklogQueueSize(commitLogQueueSize).
-		SetBacklogQueueChannelSize(commitLogQueueChannelSize))
+		SetBacklogQueueChannelSize(commitLogQueueChannelSize).
+		SetCompression(cfgCommitLog.CompressionOrDefault()))
 
 	// Setup the block retriever
 	switch seriesCachePolicy {
@@ -701,7 +775,9 @@ func Run(runOpts RunOptions) {
 			SetRetrieveRequestPool(opts.RetrieveRequestPool()).
 			SetIdentifierPool(opts.IdentifierPool()).
 			SetBlockLeaseManager(blockLeaseManager).
-			SetQueryLimits(queryLimits)
+			SetQueryLimits(queryLimits).
+			SetMultiReaderIteratorPool(opts.MultiReaderIteratorPool()).
+			SetEncoderPool(opts.EncoderPool())
 		if blockRetrieveCfg := cfg.BlockRetrieve; blockRetrieveCfg != nil {
 			if v := blockRetrieveCfg.FetchConcurrency; v != nil {
 				retrieverOpts = retrieverOpts.SetFetchConcurrency(*v)
@@ -710,6 +786,14 @@ func Run(runOpts RunOptions) {
 				retrieverOpts = retrieverOpts.SetCacheBlocksOnRetrieve(*v)
 			}
 		}
+		if blockCacheCfg := cfg.Cache.BlocksConfiguration(); blockCacheCfg.Enabled() {
+			blockCache := block.NewBlockCache(block.BlockCacheOptions{
+				MaxBytes:          blockCacheCfg.MaxBytes,
+				NamespaceWeights:  blockCacheCfg.NamespaceWeights,
+				InstrumentOptions: opts.InstrumentOptions(),
+			})
+			retrieverOpts = retrieverOpts.SetBlockCache(blockCache)
+		}
 		blockRetrieverMgr := block.NewDatabaseBlockRetrieverManager(
 			func(md namespace.Metadata, shardSet sharding.ShardSet) (block.DatabaseBlockRetriever, error) {
 				retriever, err := fs.NewBlockRetriever(retrieverOpts, fsopts)
@@ -747,6 +831,10 @@ func Run(runOpts RunOptions) {
 		opts = opts.SetForceColdWritesEnabled(*value)
 	}
 
+	if cfg.Exemplars != nil {
+		opts = opts.SetMaxExemplarsPerShard(cfg.Exemplars.MaxPerShard)
+	}
+
 	forceColdWrites := opts.ForceColdWritesEnabled()
 	var envCfgResults environment.ConfigureResults
 	if len(envConfig.Statics) == 0 {
@@ -888,6 +976,17 @@ func Run(runOpts RunOptions) {
 		defer debugClose()
 	}
 
+	if cfg.Backup != nil && syncCfg.ClusterClient != nil && len(envConfig.Services) > 0 {
+		backupMetadataFn, err := newBackupMetadataFn(syncCfg.ClusterClient,
+			envConfig.Services, iOpts)
+		if err != nil {
+			logger.Warn("could not create backup metadata sources, "+
+				"backups will not include the namespace and placement", zap.Error(err))
+		} else {
+			opts = opts.SetBackupMetadataFn(backupMetadataFn)
+		}
+	}
+
 	topo, err := syncCfg.TopologyInitializer.Init()
 	if err != nil {
 		var interruptErr *xos.InterruptError
@@ -906,6 +1005,10 @@ func Run(runOpts RunOptions) {
 		protoEnabled = true
 	}
 	schemaRegistry := namespace.NewSchemaRegistry(protoEnabled, logger)
+	if cfg.NativeHistograms.IsEnabled() {
+		// The node's own client must be able to read the series it stores.
+		cfg.Client.NativeHistograms = &client.NativeHistogramsConfiguration{Enabled: true}
+	}
 	// For application m3db client integration test convenience (where a local dbnode is started as a docker container),
 	// we allow loading user schema from local file into schema registry.
 	if protoEnabled {
@@ -1006,6 +1109,16 @@ func Run(runOpts RunOptions) {
 		opts = opts.SetRepairEnabled(false)
 	}
 
+	if scrubCfg := cfg.Filesystem.Scrub; scrubCfg != nil && scrubCfg.Enabled {
+		opts = opts.SetFileSetScrubOptions(storage.FileSetScrubOptions{
+			Enabled:             true,
+			Interval:            scrubCfg.IntervalOrDefault(),
+			ThroughputLimitMbps: scrubCfg.ThroughputLimitMbpsOrDefault(),
+			AdminClient:         m3dbClient,
+			ResultOptions:       rsOpts,
+		})
+	}
+
 	// Set bootstrap options - We need to create a topology map provider from the
 	// same topology that will be passed to the cluster so that when we make
 	// bootstrapping decisions they are in sync with the clustered database
@@ -1163,6 +1276,65 @@ func startDebugServer(
 	}
 }
 
+// newBackupMetadataFn returns a function that captures the namespaces and
+// the placement of the cluster, which are stored alongside backups so that
+// they can be recreated before restoring to a new cluster.
+func newBackupMetadataFn(
+	clusterClient clusterclient.Client,
+	services environment.DynamicConfiguration,
+	iOpts instrument.Options,
+) (storage.BackupMetadataFn, error) {
+	envCfgCluster, err := services.SyncCluster()
+	if err != nil {
+		return nil, err
+	}
+	handlerOpts, err := placementhandler.NewHandlerOptions(clusterClient,
+		placement.Configuration{}, nil, iOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	service := handleroptions.ServiceNameAndDefaults{
+		ServiceName: handleroptions.M3DBServiceName,
+		Defaults: []handleroptions.ServiceOptionsDefault{
+			handleroptions.WithDefaultServiceEnvironment(envCfgCluster.Service.Env),
+			handleroptions.WithDefaultServiceZone(envCfgCluster.Service.Zone),
+		},
+	}
+	nsSource, err := extdebug.NewNamespaceInfoSource(clusterClient,
+		[]handleroptions.ServiceNameAndDefaults{service}, iOpts)
+	if err != nil {
+		return nil, err
+	}
+	placementSource, err := extdebug.NewPlacementInfoSource(service, handlerOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := map[string]xdebug.Source{
+		"namespace": nsSource,
+		"placement": placementSource,
+	}
+	return func() (map[string]json.RawMessage, error) {
+		// The sources serve the debug endpoints and read the service from
+		// the request headers, an empty request uses the defaults.
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := make(map[string]json.RawMessage, len(sources))
+		for name, source := range sources {
+			var buf bytes.Buffer
+			if err := source.Write(&buf, req); err != nil {
+				return nil, fmt.Errorf("unable to get %s: %w", name, err)
+			}
+			metadata[name] = buf.Bytes()
+		}
+		return metadata, nil
+	}, nil
+}
+
 func bgValidateProcessLimits(logger *zap.Logger) {
 	// If unable to validate process limits on the current configuration,
 	// do not run background validator task.
@@ -1782,6 +1954,9 @@ func withEncodingAndPoolingOptions(
 			enc := proto.NewEncoder(0, encodingOpts)
 			return enc
 		}
+		if cfg.NativeHistograms.IsEnabled() {
+			return nativehistogram.NewEncoder(0, encodingOpts)
+		}
 
 		return m3tsz.NewEncoder(0, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
@@ -1790,6 +1965,9 @@ func withEncodingAndPoolingOptions(
 		if cfg.Proto != nil && cfg.Proto.Enabled {
 			return proto.NewIterator(r, descr, encodingOpts)
 		}
+		if cfg.NativeHistograms.IsEnabled() {
+			return nativehistogram.NewReaderIterator(r, encodingOpts)
+		}
 		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
 
@@ -39,10 +39,11 @@ var (
 )
 
 type shardSet struct {
-	shards   []shard.Shard
-	ids      []uint32
-	shardMap map[uint32]shard.Shard
-	fn       HashFn
+	shards    []shard.Shard
+	ids       []uint32
+	shardMap  map[uint32]shard.Shard
+	redirects map[uint32]uint32
+	fn        HashFn
 }
 
 // NewShardSet creates a new sharding scheme with a set of shards
@@ -61,20 +62,34 @@ func NewEmptyShardSet(fn HashFn) ShardSet {
 func newValidatedShardSet(shards []shard.Shard, fn HashFn) ShardSet {
 	ids := make([]uint32, len(shards))
 	shardMap := make(map[uint32]shard.Shard, len(shards))
+	var redirects map[uint32]uint32
 	for i, shard := range shards {
 		ids[i] = shard.ID()
 		shardMap[shard.ID()] = shard
+		if redirectTo := shard.RedirectToShardID(); redirectTo != nil {
+			if redirects == nil {
+				redirects = make(map[uint32]uint32)
+			}
+			redirects[shard.ID()] = *redirectTo
+		}
 	}
 	return &shardSet{
-		shards:   shards,
-		ids:      ids,
-		shardMap: shardMap,
-		fn:       fn,
+		shards:    shards,
+		ids:       ids,
+		shardMap:  shardMap,
+		redirects: redirects,
+		fn:        fn,
 	}
 }
 
 func (s *shardSet) Lookup(identifier ident.ID) uint32 {
-	return s.fn(identifier)
+	shardID := s.fn(identifier)
+	// NB: Shards that are being split off from a parent shard redirect to the
+	// parent until they are marked available.
+	if redirectTo, ok := s.redirects[shardID]; ok {
+		return redirectTo
+	}
+	return shardID
 }
 
 func (s *shardSet) LookupShard(shardID uint32) (shard.Shard, error) {
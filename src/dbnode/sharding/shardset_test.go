@@ -21,6 +21,7 @@
 package sharding
 
 import (
+	"fmt"
 	"math"
 	"testing"
 
@@ -117,6 +118,37 @@ func TestHashFunctions(t *testing.T) {
 	require.True(t, hash1 < 10)
 }
 
+func TestShardSetLookupRedirect(t *testing.T) {
+	var (
+		numShards = 4
+		parent    = uint32(1)
+		child     = uint32(3)
+		hashFn    = DefaultHashFn(numShards)
+	)
+
+	var id ident.ID
+	for i := 0; id == nil; i++ {
+		candidate := ident.StringID(fmt.Sprintf("id.%d", i))
+		if hashFn(candidate) == child {
+			id = candidate
+		}
+	}
+
+	ss, err := NewShardSet([]shard.Shard{
+		shard.NewShard(parent).SetState(shard.Available),
+		shard.NewShard(child).SetState(shard.Initializing).SetRedirectToShardID(&parent),
+	}, hashFn)
+	require.NoError(t, err)
+	require.Equal(t, parent, ss.Lookup(id))
+
+	ss, err = NewShardSet([]shard.Shard{
+		shard.NewShard(parent).SetState(shard.Available),
+		shard.NewShard(child).SetState(shard.Available),
+	}, hashFn)
+	require.NoError(t, err)
+	require.Equal(t, child, ss.Lookup(id))
+}
+
 func TestShardOperations(t *testing.T) {
 	states := []shard.State{
 		shard.Available,
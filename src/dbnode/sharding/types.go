@@ -40,7 +40,8 @@ type ShardSet interface {
 	// AllIDs returns a slice to the shard IDs in this set.
 	AllIDs() []uint32
 
-	// Lookup will return a shard for a given identifier.
+	// Lookup will return a shard for a given identifier, following the redirect
+	// of the shard the identifier hashes to if it has one.
 	Lookup(id ident.ID) uint32
 
 	// LookupShard will return a shard for a given shard id.
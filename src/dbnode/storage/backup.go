@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const backupStagingDirName = "backup-staging"
+
+var (
+	errBackupStoreNotSet             = errors.New("backup store is not set")
+	errBackupInProgress              = errors.New("a backup is already in progress")
+	errBackupHostIDNotSet            = errors.New("backup host ID is not set")
+	errBackupDatabaseNotBootstrapped = errors.New("database is not bootstrapped")
+)
+
+// BackupOptions are the options of a backup of a namespace.
+type BackupOptions struct {
+	// ID is the ID of the backup of the cluster the backup of the node is
+	// part of, which the backups of all the nodes share. An ID is generated
+	// from the current time if it is empty.
+	ID string
+	// HostID is the host ID of the node.
+	HostID string
+	// ClusterShards are the shards of the cluster, the backup of the
+	// cluster is complete once the backups of its nodes cover all of them.
+	ClusterShards []uint32
+}
+
+// BackupResult is the result of backing up a namespace.
+type BackupResult struct {
+	// ID is the ID of the backup.
+	ID string
+	// ClusterComplete is whether the backup of the cluster is complete.
+	ClusterComplete bool
+	// NumFiles is the number of files in the backup.
+	NumFiles int
+	// NumUploadedFiles is the number of files uploaded, which excludes the
+	// files already uploaded by previous backups.
+	NumUploadedFiles int
+	// NumUploadedBytes is the number of bytes uploaded.
+	NumUploadedBytes int64
+}
+
+// BackupMetadataFn returns the documents describing the cluster that are
+// included in backups by name, such as the namespace and placement metadata.
+type BackupMetadataFn func() (map[string]json.RawMessage, error)
+
+func (d *db) Backup(namespace ident.ID, opts BackupOptions) (BackupResult, error) {
+	store := d.opts.BackupStore()
+	if store == nil {
+		return BackupResult{}, errBackupStoreNotSet
+	}
+	if opts.HostID == "" {
+		return BackupResult{}, errBackupHostIDNotSet
+	}
+	if !d.IsBootstrapped() {
+		return BackupResult{}, errBackupDatabaseNotBootstrapped
+	}
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return BackupResult{}, err
+	}
+
+	if !d.backupLock.TryLock() {
+		return BackupResult{}, errBackupInProgress
+	}
+	defer d.backupLock.Unlock()
+
+	var (
+		fsOpts      = d.opts.CommitLogOptions().FilesystemOptions()
+		createdAt   = d.nowFn()
+		id          = opts.ID
+		stagingPath = filepath.Join(fsOpts.FilePathPrefix(), backupStagingDirName, id)
+		ownedShards = n.OwnedShards()
+		shards      = make([]uint32, 0, len(ownedShards))
+	)
+	if id == "" {
+		id = backup.NewID(createdAt)
+	}
+	for _, shard := range ownedShards {
+		shards = append(shards, shard.ID())
+	}
+	defer os.RemoveAll(stagingPath)
+
+	// Pause the file operations that write and remove filesets while the
+	// files of the backup are linked into the staging directory, so that
+	// the backup is a consistent copy of the namespace.
+	d.mediator.DisableFileOpsAndWait()
+	files, err := backup.Stage(backup.StageOptions{
+		FilePathPrefix:       fsOpts.FilePathPrefix(),
+		StagingPath:          stagingPath,
+		Namespace:            namespace,
+		Shards:               shards,
+		InfoReaderBufferSize: fsOpts.InfoReaderBufferSize(),
+		NewDirectoryMode:     fsOpts.NewDirectoryMode(),
+	})
+	d.mediator.EnableFileOps()
+	if err != nil {
+		return BackupResult{}, err
+	}
+
+	var metadata map[string]json.RawMessage
+	if fn := d.opts.BackupMetadataFn(); fn != nil {
+		metadata, err = fn()
+		if err != nil {
+			return BackupResult{}, err
+		}
+	}
+
+	result, err := backup.Upload(store, stagingPath, files, backup.Manifest{
+		ID:        id,
+		Namespace: namespace.String(),
+		HostID:    opts.HostID,
+		CreatedAt: createdAt,
+		Shards:    shards,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		return BackupResult{}, err
+	}
+
+	complete, err := backup.CompleteClusterBackup(store, namespace, id, opts.ClusterShards)
+	if err != nil {
+		return BackupResult{}, err
+	}
+
+	d.log.Info("backed up namespace",
+		zap.Stringer("namespace", namespace),
+		zap.String("id", id),
+		zap.Bool("clusterComplete", complete),
+		zap.Int("numFiles", result.NumFiles),
+		zap.Int("numUploadedFiles", result.NumUploadedFiles),
+		zap.Int64("numUploadedBytes", result.NumUploadedBytes))
+
+	return BackupResult{
+		ID:               id,
+		ClusterComplete:  complete,
+		NumFiles:         result.NumFiles,
+		NumUploadedFiles: result.NumUploadedFiles,
+		NumUploadedBytes: result.NumUploadedBytes,
+	}, nil
+}
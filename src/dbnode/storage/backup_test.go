@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestDatabaseBackup(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		nsID       = ident.StringID("testns1")
+		blockStart = xtime.Now().Truncate(2 * time.Hour).Add(-4 * time.Hour)
+		store      = objectstore.NewMemoryStore()
+		opts       = DefaultTestOptions()
+		fsOpts     = opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+		metadata   = map[string]json.RawMessage{"placement": json.RawMessage(`{"shards":2}`)}
+	)
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetBackupStore(store).
+		SetBackupMetadataFn(func() (map[string]json.RawMessage, error) {
+			return metadata, nil
+		})
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  nsID,
+			BlockStart: blockStart,
+		},
+		BlockSize: 2 * time.Hour,
+	}))
+	data := []byte{1, 2, 3}
+	bytes := checked.NewBytes(data, nil)
+	bytes.IncRef()
+	meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+		ident.Tags{}, persist.MetadataOptions{})
+	require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+	require.NoError(t, writer.Close())
+
+	d, mapCh, _ := newTestDatabase(t, ctrl, newTestDatabaseOpt{
+		bs:    Bootstrapped,
+		nsMap: testNamespaceMap(t),
+		dbOpt: opts,
+	})
+	defer func() {
+		close(mapCh)
+	}()
+
+	mediator := NewMockdatabaseMediator(ctrl)
+	mediator.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	gomock.InOrder(
+		mediator.EXPECT().DisableFileOpsAndWait(),
+		mediator.EXPECT().EnableFileOps(),
+	)
+	d.mediator = mediator
+
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	ns := dbAddNewMockNamespace(ctrl, d, nsID.String())
+	ns.EXPECT().OwnedShards().Return([]databaseShard{shard})
+
+	_, err = d.Backup(nsID, BackupOptions{})
+	require.Equal(t, errBackupHostIDNotSet, err)
+
+	result, err := d.Backup(nsID, BackupOptions{
+		ID:            "backup",
+		HostID:        "host",
+		ClusterShards: []uint32{0},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "backup", result.ID)
+	require.True(t, result.ClusterComplete)
+	require.True(t, result.NumFiles > 0)
+	require.Equal(t, result.NumFiles, result.NumUploadedFiles)
+
+	manifest, err := backup.ReadManifest(store, nsID, result.ID, "host")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0}, manifest.Shards)
+	require.Equal(t, metadata, manifest.Metadata)
+
+	cluster, err := backup.ReadClusterManifest(store, nsID, "")
+	require.NoError(t, err)
+	require.Equal(t, result.ID, cluster.ID)
+	require.Equal(t, []backup.NodeBackup{{HostID: "host", Shards: []uint32{0}}}, cluster.Nodes)
+
+	// The staged files are removed once uploaded.
+	_, err = os.Stat(filepath.Join(dir, backupStagingDirName, result.ID))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDatabaseBackupRequiresStore(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	d, mapCh, _ := defaultTestDatabase(t, ctrl, Bootstrapped)
+	defer func() {
+		close(mapCh)
+	}()
+
+	_, err := d.Backup(ident.StringID("testns1"), BackupOptions{HostID: "host"})
+	require.Equal(t, errBackupStoreNotSet, err)
+}
@@ -0,0 +1,457 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// The block cache is a size bounded cache of the blocks retrieved from disk,
+// it is shared between all namespaces and complements the WiredList: the
+// WiredList keeps blocks wired to their series, which requires the series to
+// be kept in memory, whereas the block cache keeps the bytes of recently
+// retrieved blocks regardless of the series and saves the disk reads and the
+// decoding of consequent retrievals.
+//
+// The cache holds the decoded datapoints of blocks and accounts for their
+// decoded size, which is typically an order of magnitude larger than the
+// size of the encoded block, so the cache should be sized accordingly.
+// Retrievals that hit the cache encode the cached datapoints into a new
+// segment, which is cheaper than reading and decoding the block from disk
+// but is not free.
+//
+// Blocks are admitted into the cache using a TinyLFU admission policy: the
+// access frequency of every block, cached or not, is estimated using a count
+// min sketch whose counters are periodically halved so that the frequencies
+// reflect recent accesses. When the cache is full a retrieved block is only
+// admitted if its frequency is higher than the frequency of the least recently
+// used blocks it would evict, which prevents a scan over many blocks from
+// evicting the blocks that are frequently read. The frequency of blocks is
+// scaled by the weight of their namespace, so that namespaces can be given a
+// larger or smaller share of the cache.
+//
+// The cache is split into shards by the hash of the series, each with its
+// own lock, LRU list and frequency sketch, so that concurrent retrievals of
+// different series do not contend on a single lock.
+
+package block
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+	"unsafe"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/uber-go/tally"
+	"go.uber.org/atomic"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// defaultBlockCacheAverageBlockBytes is the expected average decoded size
+	// of a block, used to size the frequency sketch of the cache.
+	defaultBlockCacheAverageBlockBytes = 16 << 10
+
+	// defaultBlockCacheShards is the maximum number of shards of the cache.
+	defaultBlockCacheShards = 64
+	// blockCacheMinShardBytes is the minimum number of bytes per shard, caches
+	// that are smaller have fewer shards so that large blocks still fit.
+	blockCacheMinShardBytes = 16 << 20
+
+	blockCacheSketchDepth     = 4
+	blockCacheSketchMinWidth  = 1 << 10
+	blockCacheSketchMaxWidth  = 1 << 24
+	blockCacheSketchMaxCount  = 15
+	blockCacheSketchResetMult = 10
+
+	// blockCacheDatapointBytes is the size of a decoded datapoint in the
+	// cache, excluding the bytes of its annotation.
+	blockCacheDatapointBytes = int64(unsafe.Sizeof(BlockCacheDatapoint{}))
+)
+
+// BlockCacheDatapoint is a decoded datapoint of a block in the block cache.
+type BlockCacheDatapoint struct {
+	ts.Datapoint
+	Unit       xtime.Unit
+	Annotation ts.Annotation
+}
+
+// BlockCacheKey identifies a block of a series in the block cache.
+type BlockCacheKey struct {
+	Namespace  ident.ID
+	Shard      uint32
+	ID         ident.ID
+	BlockStart xtime.UnixNano
+	// FileSetStart is the block start of the fileset the block was retrieved
+	// from, which differs from the block start if the block was compacted.
+	FileSetStart xtime.UnixNano
+	// Volume is the volume of the fileset the block was retrieved from, so
+	// that blocks of a volume that was replaced are never returned.
+	Volume int
+}
+
+type blockCacheMapKey struct {
+	hash         uint64
+	shard        uint32
+	blockStart   xtime.UnixNano
+	fileSetStart xtime.UnixNano
+	volume       int
+}
+
+type blockCacheEntry struct {
+	key       blockCacheMapKey
+	namespace []byte
+	id        []byte
+	points    []BlockCacheDatapoint
+	size      int64
+	weight    float64
+}
+
+type blockCacheMetrics struct {
+	hits       tally.Counter
+	misses     tally.Counter
+	admitted   tally.Counter
+	rejected   tally.Counter
+	evictions  tally.Counter
+	hitRatio   tally.Gauge
+	bytes      tally.Gauge
+	entries    tally.Gauge
+	sketchAged tally.Counter
+}
+
+func newBlockCacheMetrics(scope tally.Scope) blockCacheMetrics {
+	return blockCacheMetrics{
+		hits:       scope.Counter("hits"),
+		misses:     scope.Counter("misses"),
+		admitted:   scope.Counter("admitted"),
+		rejected:   scope.Counter("rejected"),
+		evictions:  scope.Counter("evictions"),
+		hitRatio:   scope.Gauge("hit-ratio"),
+		bytes:      scope.Gauge("bytes"),
+		entries:    scope.Gauge("entries"),
+		sketchAged: scope.Counter("sketch-aged"),
+	}
+}
+
+// BlockCacheOptions is the options struct for the BlockCache constructor.
+type BlockCacheOptions struct {
+	// MaxBytes is the maximum number of bytes of decoded blocks held by the
+	// cache.
+	MaxBytes int64
+	// NamespaceWeights are the weights of the blocks of namespaces in the
+	// admission and eviction of blocks, namespaces without a weight have a
+	// weight of 1 and namespaces with a weight of 0 are not cached.
+	NamespaceWeights  map[string]float64
+	InstrumentOptions instrument.Options
+}
+
+// BlockCache is a size bounded cache of blocks retrieved from disk.
+type BlockCache struct {
+	weights map[string]float64
+	shards  []*blockCacheShard
+
+	// Hits and misses since the creation of the cache, used for the hit ratio.
+	numHits   atomic.Int64
+	numMisses atomic.Int64
+	bytes     atomic.Int64
+	entries   atomic.Int64
+
+	metrics blockCacheMetrics
+}
+
+type blockCacheShard struct {
+	sync.Mutex
+
+	maxBytes int64
+	bytes    int64
+	entries  map[blockCacheMapKey]*list.Element
+	lru      *list.List
+	sketch   *frequencySketch
+}
+
+// NewBlockCache returns a new block cache.
+func NewBlockCache(opts BlockCacheOptions) *BlockCache {
+	scope := opts.InstrumentOptions.MetricsScope().SubScope("block-cache")
+	weights := make(map[string]float64, len(opts.NamespaceWeights))
+	for ns, weight := range opts.NamespaceWeights {
+		weights[ns] = weight
+	}
+
+	numShards := opts.MaxBytes / blockCacheMinShardBytes
+	if numShards > defaultBlockCacheShards {
+		numShards = defaultBlockCacheShards
+	}
+	if numShards < 1 {
+		numShards = 1
+	}
+
+	var (
+		shardMaxBytes = opts.MaxBytes / numShards
+		width         = shardMaxBytes / defaultBlockCacheAverageBlockBytes
+		shards        = make([]*blockCacheShard, 0, numShards)
+	)
+	for i := int64(0); i < numShards; i++ {
+		shards = append(shards, &blockCacheShard{
+			maxBytes: shardMaxBytes,
+			entries:  make(map[blockCacheMapKey]*list.Element),
+			lru:      list.New(),
+			sketch:   newFrequencySketch(width),
+		})
+	}
+
+	c := &BlockCache{
+		weights: weights,
+		shards:  shards,
+		metrics: newBlockCacheMetrics(scope),
+	}
+	c.metrics.hitRatio.Update(0)
+	return c
+}
+
+// Get returns the decoded datapoints of a cached block, the returned
+// datapoints must not be modified.
+func (c *BlockCache) Get(key BlockCacheKey) ([]BlockCacheDatapoint, bool) {
+	var (
+		mapKey = newBlockCacheMapKey(key)
+		shard  = c.shard(mapKey)
+	)
+
+	shard.Lock()
+	c.recordAccessWithLock(shard, mapKey.hash)
+	elem, ok := shard.entries[mapKey]
+	if !ok || !elem.Value.(*blockCacheEntry).matches(key) {
+		shard.Unlock()
+		c.numMisses.Inc()
+		c.metrics.misses.Inc(1)
+		c.updateHitRatio()
+		return nil, false
+	}
+
+	shard.lru.MoveToFront(elem)
+	entry := elem.Value.(*blockCacheEntry)
+	shard.Unlock()
+
+	c.numHits.Inc()
+	c.metrics.hits.Inc(1)
+	c.updateHitRatio()
+	return entry.points, true
+}
+
+// Put offers the decoded datapoints of a block retrieved from disk to the
+// cache, the block is admitted if there is room for it or if it is accessed
+// more frequently than the blocks it would evict. The cache takes ownership
+// of the datapoints, which must not be modified after being put.
+func (c *BlockCache) Put(key BlockCacheKey, points []BlockCacheDatapoint) bool {
+	var (
+		mapKey = newBlockCacheMapKey(key)
+		shard  = c.shard(mapKey)
+		size   = blockCacheDatapointsSize(points)
+		weight = c.weight(key.Namespace)
+	)
+	if weight <= 0 || size > shard.maxBytes {
+		c.metrics.rejected.Inc(1)
+		return false
+	}
+
+	shard.Lock()
+	defer shard.Unlock()
+
+	if elem, ok := shard.entries[mapKey]; ok {
+		// Already cached, e.g. by a concurrent retrieval of the same block, or
+		// the hash collided with another block in which case it is replaced.
+		c.removeWithLock(shard, elem)
+	}
+
+	// Find the least recently used blocks that need to be evicted to make room
+	// for the block and only admit it if it is accessed more frequently.
+	var (
+		score    = float64(shard.sketch.estimate(mapKey.hash)) * weight
+		needed   = shard.bytes + size - shard.maxBytes
+		victims  = 0
+		elem     = shard.lru.Back()
+		freeable = int64(0)
+	)
+	for ; freeable < needed && elem != nil; elem = elem.Prev() {
+		victim := elem.Value.(*blockCacheEntry)
+		victimScore := float64(shard.sketch.estimate(victim.key.hash)) * victim.weight
+		if victimScore >= score {
+			c.metrics.rejected.Inc(1)
+			return false
+		}
+		freeable += victim.size
+		victims++
+	}
+	for i := 0; i < victims; i++ {
+		c.removeWithLock(shard, shard.lru.Back())
+		c.metrics.evictions.Inc(1)
+	}
+
+	entry := &blockCacheEntry{
+		key:       mapKey,
+		namespace: append([]byte(nil), key.Namespace.Bytes()...),
+		id:        append([]byte(nil), key.ID.Bytes()...),
+		points:    points,
+		size:      size,
+		weight:    weight,
+	}
+	shard.entries[mapKey] = shard.lru.PushFront(entry)
+	shard.bytes += size
+	c.metrics.admitted.Inc(1)
+	c.updateSize(size, 1)
+	return true
+}
+
+// Len returns the number of blocks in the cache.
+func (c *BlockCache) Len() int {
+	return int(c.entries.Load())
+}
+
+// Bytes returns the decoded size of the blocks in the cache.
+func (c *BlockCache) Bytes() int64 {
+	return c.bytes.Load()
+}
+
+func (c *BlockCache) shard(key blockCacheMapKey) *blockCacheShard {
+	// NB: use the upper bits of the hash since the lower bits index the
+	// sketch, otherwise each shard would only use a fraction of its sketch.
+	return c.shards[(key.hash>>32)%uint64(len(c.shards))]
+}
+
+func (c *BlockCache) weight(namespace ident.ID) float64 {
+	weight, ok := c.weights[string(namespace.Bytes())]
+	if !ok {
+		return 1
+	}
+	return weight
+}
+
+func (c *BlockCache) recordAccessWithLock(shard *blockCacheShard, hash uint64) {
+	if shard.sketch.increment(hash) {
+		c.metrics.sketchAged.Inc(1)
+	}
+}
+
+func (c *BlockCache) removeWithLock(shard *blockCacheShard, elem *list.Element) {
+	entry := shard.lru.Remove(elem).(*blockCacheEntry)
+	delete(shard.entries, entry.key)
+	shard.bytes -= entry.size
+	c.updateSize(-entry.size, -1)
+}
+
+func (c *BlockCache) updateSize(bytes, entries int64) {
+	c.metrics.bytes.Update(float64(c.bytes.Add(bytes)))
+	c.metrics.entries.Update(float64(c.entries.Add(entries)))
+}
+
+func (c *BlockCache) updateHitRatio() {
+	hits, misses := c.numHits.Load(), c.numMisses.Load()
+	c.metrics.hitRatio.Update(float64(hits) / float64(hits+misses))
+}
+
+func blockCacheDatapointsSize(points []BlockCacheDatapoint) int64 {
+	size := int64(len(points)) * blockCacheDatapointBytes
+	for _, p := range points {
+		size += int64(len(p.Annotation))
+	}
+	return size
+}
+
+func newBlockCacheMapKey(key BlockCacheKey) blockCacheMapKey {
+	var digest xxhash.Digest
+	digest.Reset()
+	_, _ = digest.Write(key.Namespace.Bytes())
+	_, _ = digest.Write([]byte{0})
+	_, _ = digest.Write(key.ID.Bytes())
+	return blockCacheMapKey{
+		hash:         digest.Sum64(),
+		shard:        key.Shard,
+		blockStart:   key.BlockStart,
+		fileSetStart: key.FileSetStart,
+		volume:       key.Volume,
+	}
+}
+
+func (e *blockCacheEntry) matches(key BlockCacheKey) bool {
+	return bytes.Equal(e.namespace, key.Namespace.Bytes()) &&
+		bytes.Equal(e.id, key.ID.Bytes())
+}
+
+// frequencySketch is a count min sketch of 4 bit saturating counters
+// estimating the access frequency of keys, all counters are halved once the
+// number of accesses recorded reaches a multiple of the width of the sketch
+// so that old accesses are forgotten.
+type frequencySketch struct {
+	rows       [blockCacheSketchDepth][]uint8
+	mask       uint64
+	additions  int64
+	resetAfter int64
+}
+
+func newFrequencySketch(width int64) *frequencySketch {
+	w := int64(blockCacheSketchMinWidth)
+	for w < width && w < blockCacheSketchMaxWidth {
+		w <<= 1
+	}
+	s := &frequencySketch{
+		mask:       uint64(w - 1),
+		resetAfter: w * blockCacheSketchResetMult,
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, w)
+	}
+	return s
+}
+
+func (s *frequencySketch) index(hash uint64, row int) uint64 {
+	// Derive the index of each row from two halves of the hash.
+	h1, h2 := hash, hash>>32|1
+	return (h1 + uint64(row)*h2) & s.mask
+}
+
+// increment records an access and returns whether the counters were aged.
+func (s *frequencySketch) increment(hash uint64) bool {
+	for i := range s.rows {
+		idx := s.index(hash, i)
+		if s.rows[i][idx] < blockCacheSketchMaxCount {
+			s.rows[i][idx]++
+		}
+	}
+	s.additions++
+	if s.additions < s.resetAfter {
+		return false
+	}
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] >>= 1
+		}
+	}
+	s.additions /= 2
+	return true
+}
+
+func (s *frequencySketch) estimate(hash uint64) uint8 {
+	min := uint8(blockCacheSketchMaxCount)
+	for i := range s.rows {
+		if v := s.rows[i][s.index(hash, i)]; v < min {
+			min = v
+		}
+	}
+	return min
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package block
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestBlockCache(
+	maxBytes int64,
+	weights map[string]float64,
+) (*BlockCache, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	return NewBlockCache(BlockCacheOptions{
+		MaxBytes:          maxBytes,
+		NamespaceWeights:  weights,
+		InstrumentOptions: instrument.NewOptions().SetMetricsScope(scope),
+	}), scope
+}
+
+func testBlockCacheKey(ns string, id int) BlockCacheKey {
+	return BlockCacheKey{
+		Namespace:  ident.StringID(ns),
+		Shard:      1,
+		ID:         ident.StringID(fmt.Sprintf("series-%d", id)),
+		BlockStart: xtime.UnixNano(100),
+		Volume:     1,
+	}
+}
+
+func testBlockCachePoints(n int) []BlockCacheDatapoint {
+	points := make([]BlockCacheDatapoint, 0, n)
+	for i := 0; i < n; i++ {
+		points = append(points, BlockCacheDatapoint{
+			Datapoint: ts.Datapoint{
+				TimestampNanos: xtime.UnixNano(100 + i),
+				Value:          float64(i),
+			},
+			Unit: xtime.Second,
+		})
+	}
+	return points
+}
+
+func TestBlockCacheGetPut(t *testing.T) {
+	cache, scope := newTestBlockCache(1024, nil)
+
+	key := testBlockCacheKey("ns", 0)
+	_, ok := cache.Get(key)
+	require.False(t, ok)
+
+	points := testBlockCachePoints(2)
+	points[1].Annotation = ts.Annotation("annotation")
+	require.True(t, cache.Put(key, points))
+
+	cached, ok := cache.Get(key)
+	require.True(t, ok)
+	require.Equal(t, points, cached)
+	require.Equal(t, 1, cache.Len())
+
+	// The size of the block is the decoded size of its datapoints.
+	size := 2*blockCacheDatapointBytes + int64(len("annotation"))
+	require.Equal(t, size, cache.Bytes())
+
+	// Blocks of another volume or fileset are distinct.
+	otherVolume := key
+	otherVolume.Volume = 2
+	_, ok = cache.Get(otherVolume)
+	require.False(t, ok)
+	otherFileSet := key
+	otherFileSet.FileSetStart = xtime.UnixNano(50)
+	_, ok = cache.Get(otherFileSet)
+	require.False(t, ok)
+
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(1), snapshot.Counters()["block-cache.hits+"].Value())
+	require.Equal(t, int64(3), snapshot.Counters()["block-cache.misses+"].Value())
+	require.Equal(t, int64(1), snapshot.Counters()["block-cache.admitted+"].Value())
+	require.Equal(t, 0.25, snapshot.Gauges()["block-cache.hit-ratio+"].Value())
+	require.Equal(t, float64(size), snapshot.Gauges()["block-cache.bytes+"].Value())
+}
+
+func TestBlockCacheAdmitsFrequentlyAccessedBlocks(t *testing.T) {
+	var (
+		points    = testBlockCachePoints(4)
+		blockSize = blockCacheDatapointsSize(points)
+	)
+	cache, scope := newTestBlockCache(4*blockSize, nil)
+
+	// Fill the cache with hot blocks that are accessed often.
+	for i := 0; i < 4; i++ {
+		key := testBlockCacheKey("ns", i)
+		for j := 0; j < 5; j++ {
+			cache.Get(key)
+		}
+		require.True(t, cache.Put(key, points))
+	}
+
+	// A scan over blocks accessed once does not evict the hot blocks.
+	for i := 4; i < 100; i++ {
+		key := testBlockCacheKey("ns", i)
+		cache.Get(key)
+		require.False(t, cache.Put(key, points))
+	}
+	for i := 0; i < 4; i++ {
+		_, ok := cache.Get(testBlockCacheKey("ns", i))
+		require.True(t, ok)
+	}
+
+	// A block accessed more often than the least recently used hot block
+	// evicts it.
+	key := testBlockCacheKey("ns", 100)
+	for j := 0; j < 10; j++ {
+		cache.Get(key)
+	}
+	require.True(t, cache.Put(key, points))
+	_, ok := cache.Get(testBlockCacheKey("ns", 0))
+	require.False(t, ok)
+	require.Equal(t, 4, cache.Len())
+	require.Equal(t, 4*blockSize, cache.Bytes())
+
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(1), snapshot.Counters()["block-cache.evictions+"].Value())
+	require.Equal(t, int64(96), snapshot.Counters()["block-cache.rejected+"].Value())
+}
+
+func TestBlockCacheNamespaceWeights(t *testing.T) {
+	var (
+		points    = testBlockCachePoints(4)
+		blockSize = blockCacheDatapointsSize(points)
+	)
+	cache, _ := newTestBlockCache(2*blockSize, map[string]float64{
+		"important": 4,
+		"ignored":   0,
+	})
+
+	// Blocks of namespaces with a weight of 0 are never cached.
+	require.False(t, cache.Put(testBlockCacheKey("ignored", 0), points))
+
+	for i := 0; i < 2; i++ {
+		key := testBlockCacheKey("default", i)
+		for j := 0; j < 2; j++ {
+			cache.Get(key)
+		}
+		require.True(t, cache.Put(key, points))
+	}
+
+	// A block of the important namespace accessed less often than the blocks
+	// of the default namespace is admitted thanks to its weight.
+	key := testBlockCacheKey("important", 0)
+	cache.Get(key)
+	require.True(t, cache.Put(key, points))
+	_, ok := cache.Get(key)
+	require.True(t, ok)
+}
+
+func TestBlockCacheRejectsBlocksLargerThanCache(t *testing.T) {
+	cache, _ := newTestBlockCache(10*blockCacheDatapointBytes, nil)
+	require.False(t, cache.Put(testBlockCacheKey("ns", 0), testBlockCachePoints(11)))
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestBlockCacheShardedConcurrentAccess(t *testing.T) {
+	cache, _ := newTestBlockCache(4*blockCacheMinShardBytes, nil)
+	require.Len(t, cache.shards, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := testBlockCacheKey("ns", i*100+j)
+				cache.Get(key)
+				require.True(t, cache.Put(key, testBlockCachePoints(1)))
+				_, ok := cache.Get(key)
+				require.True(t, ok)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 800, cache.Len())
+	require.Equal(t, 800*blockCacheDatapointBytes, cache.Bytes())
+	var inShards int
+	for _, shard := range cache.shards {
+		inShards += shard.lru.Len()
+	}
+	require.Equal(t, 800, inShards)
+}
+
+func TestFrequencySketchAging(t *testing.T) {
+	sketch := newFrequencySketch(0)
+	require.Equal(t, int64(blockCacheSketchMinWidth*blockCacheSketchResetMult), sketch.resetAfter)
+
+	for i := 0; i < 20; i++ {
+		sketch.increment(1)
+	}
+	require.Equal(t, uint8(blockCacheSketchMaxCount), sketch.estimate(1))
+
+	aged := false
+	for i := uint64(0); !aged; i++ {
+		aged = sketch.increment(i + 2)
+	}
+	require.True(t, sketch.estimate(1) <= blockCacheSketchMaxCount/2)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	fsbackup "github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// Compilation of this statement ensures struct implements the interface.
+var _ bootstrap.Bootstrapper = (*backupBootstrapper)(nil)
+
+// backupBootstrapper restores the filesets of the shards that have no data
+// on disk from the backups of their namespaces and then hands off to the
+// next bootstrapper, which reads the restored filesets as if they were
+// written by this node.
+type backupBootstrapper struct {
+	opts Options
+	next bootstrap.Bootstrapper
+	log  *zap.Logger
+}
+
+func newBackupBootstrapper(opts Options, next bootstrap.Bootstrapper) *backupBootstrapper {
+	return &backupBootstrapper{
+		opts: opts,
+		next: next,
+		log:  opts.InstrumentOptions().Logger(),
+	}
+}
+
+func (b *backupBootstrapper) String() string {
+	return BackupBootstrapperName
+}
+
+func (b *backupBootstrapper) Bootstrap(
+	ctx context.Context,
+	namespaces bootstrap.Namespaces,
+	cache bootstrap.Cache,
+) (bootstrap.NamespaceResults, error) {
+	restored := 0
+	for _, elem := range namespaces.Namespaces.Iter() {
+		n, err := b.restoreNamespace(elem.Value())
+		if err != nil {
+			return bootstrap.NamespaceResults{}, err
+		}
+		restored += n
+	}
+
+	if restored > 0 {
+		// The cached info files predate the restored filesets.
+		cache.Evict()
+	}
+
+	return b.next.Bootstrap(ctx, namespaces, cache)
+}
+
+func (b *backupBootstrapper) restoreNamespace(ns bootstrap.Namespace) (int, error) {
+	var (
+		fsOpts = b.opts.FilesystemOptions()
+		prefix = fsOpts.FilePathPrefix()
+		nsID   = ns.Metadata.ID()
+		shards = make([]uint32, 0, len(ns.Shards))
+	)
+	for _, shard := range ns.Shards {
+		empty, err := shardIsEmpty(prefix, nsID, shard)
+		if err != nil {
+			return 0, err
+		}
+		if empty {
+			shards = append(shards, shard)
+		}
+	}
+
+	restoreIndex := false
+	if ns.Metadata.Options().IndexOptions().Enabled() {
+		infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+			FilePathPrefix:   prefix,
+			Namespace:        nsID,
+			ReaderBufferSize: fsOpts.InfoReaderBufferSize(),
+			IncludeCorrupted: true,
+		})
+		restoreIndex = len(infoFiles) == 0
+	}
+
+	if len(shards) == 0 && !restoreIndex {
+		return 0, nil
+	}
+
+	result, err := fsbackup.Restore(b.opts.ObjectStore(), fsbackup.RestoreOptions{
+		FilePathPrefix:   prefix,
+		Namespace:        nsID,
+		BackupID:         b.opts.BackupID(),
+		HostID:           b.opts.Origin().ID(),
+		Shards:           shards,
+		RestoreIndex:     restoreIndex,
+		NewFileMode:      fsOpts.NewFileMode(),
+		NewDirectoryMode: fsOpts.NewDirectoryMode(),
+	})
+	if errors.Is(err, fsbackup.ErrNoBackup) {
+		b.log.Info("no backup to restore namespace from",
+			zap.Stringer("namespace", nsID))
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to restore namespace %s: %w", nsID.String(), err)
+	}
+
+	b.log.Info("restored namespace from backup",
+		zap.Stringer("namespace", nsID),
+		zap.String("backupID", result.Manifest.ID),
+		zap.Int("numShards", len(shards)),
+		zap.Bool("index", restoreIndex),
+		zap.Int("numRestoredFiles", result.NumRestoredFiles))
+
+	return result.NumRestoredFiles, nil
+}
+
+// shardIsEmpty returns whether a shard has neither data nor snapshot
+// filesets on disk.
+func shardIsEmpty(prefix string, nsID ident.ID, shard uint32) (bool, error) {
+	dataFiles, err := fs.DataFiles(prefix, nsID, shard)
+	if err != nil {
+		return false, err
+	}
+	if len(dataFiles) > 0 {
+		return false, nil
+	}
+	snapshotFiles, err := fs.SnapshotFiles(prefix, nsID, shard)
+	if err != nil {
+		return false, err
+	}
+	return len(snapshotFiles) == 0, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	fsbackup "github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	testNamespace = ident.StringID("testns")
+	testBlockSize = 2 * time.Hour
+	testOrigin    = topology.NewHost("host", "127.0.0.1:9000")
+)
+
+func writeTestDataFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	shard uint32,
+	blockStart xtime.UnixNano,
+) {
+	writer, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  testNamespace,
+			Shard:      shard,
+			BlockStart: blockStart,
+		},
+		BlockSize: testBlockSize,
+	}))
+
+	data := []byte{1, 2, 3}
+	bytes := checked.NewBytes(data, nil)
+	bytes.IncRef()
+	meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+		ident.Tags{}, persist.MetadataOptions{})
+	require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+	require.NoError(t, writer.Close())
+}
+
+func writeTestBackup(
+	t *testing.T,
+	store objectstore.Store,
+	filePathPrefix string,
+	shards []uint32,
+) {
+	stagingPath, err := ioutil.TempDir("", "staging")
+	require.NoError(t, err)
+	defer os.RemoveAll(stagingPath)
+
+	files, err := fsbackup.Stage(fsbackup.StageOptions{
+		FilePathPrefix:       filePathPrefix,
+		StagingPath:          stagingPath,
+		Namespace:            testNamespace,
+		Shards:               shards,
+		InfoReaderBufferSize: 128,
+		NewDirectoryMode:     os.ModeDir | os.FileMode(0755),
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	id := fsbackup.NewID(now)
+	_, err = fsbackup.Upload(store, stagingPath, files, fsbackup.Manifest{
+		ID:        id,
+		Namespace: testNamespace.String(),
+		HostID:    testOrigin.ID(),
+		CreatedAt: now,
+		Shards:    shards,
+	})
+	require.NoError(t, err)
+	complete, err := fsbackup.CompleteClusterBackup(store, testNamespace, id, shards)
+	require.NoError(t, err)
+	require.True(t, complete)
+}
+
+func newTestNamespaces(t *testing.T, shards []uint32) bootstrap.Namespaces {
+	md, err := namespace.NewMetadata(testNamespace, namespace.NewOptions())
+	require.NoError(t, err)
+
+	namespaces := bootstrap.NewNamespacesMap(bootstrap.NamespacesMapOptions{})
+	namespaces.Set(testNamespace, bootstrap.Namespace{
+		Metadata: md,
+		Shards:   shards,
+	})
+	return bootstrap.Namespaces{Namespaces: namespaces}
+}
+
+func TestBackupBootstrapperRestoresEmptyShards(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		sourcePrefix = filepath.Join(dir, "source")
+		targetPrefix = filepath.Join(dir, "target")
+		blockStart   = xtime.Now().Truncate(testBlockSize).Add(-4 * testBlockSize)
+		store        = objectstore.NewMemoryStore()
+	)
+	writeTestDataFileSet(t, sourcePrefix, 0, blockStart)
+	writeTestDataFileSet(t, sourcePrefix, 1, blockStart)
+	writeTestBackup(t, store, sourcePrefix, []uint32{0, 1})
+
+	// Shard 1 already has data so it is left untouched.
+	writeTestDataFileSet(t, targetPrefix, 1, blockStart.Add(testBlockSize))
+
+	var (
+		namespaces = newTestNamespaces(t, []uint32{0, 1})
+		cache      = bootstrap.NewMockCache(ctrl)
+		next       = bootstrap.NewMockBootstrapper(ctrl)
+		ctx        = context.NewBackground()
+		opts       = NewOptions().
+				SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(targetPrefix)).
+				SetObjectStore(store).
+				SetOrigin(testOrigin)
+	)
+	defer ctx.Close()
+
+	gomock.InOrder(
+		cache.EXPECT().Evict(),
+		next.EXPECT().Bootstrap(ctx, namespaces, cache).
+			Return(bootstrap.NamespaceResults{}, nil),
+	)
+
+	b := newBackupBootstrapper(opts, next)
+	_, err = b.Bootstrap(ctx, namespaces, cache)
+	require.NoError(t, err)
+
+	exists, err := fs.DataFileSetExists(targetPrefix, testNamespace, 0, blockStart, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = fs.DataFileSetExists(targetPrefix, testNamespace, 1, blockStart, 0)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Once restored the shard is no longer empty and is not restored again.
+	next.EXPECT().Bootstrap(ctx, namespaces, cache).
+		Return(bootstrap.NamespaceResults{}, nil)
+	_, err = b.Bootstrap(ctx, namespaces, cache)
+	require.NoError(t, err)
+}
+
+func TestBackupBootstrapperNoBackup(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		namespaces = newTestNamespaces(t, []uint32{0})
+		cache      = bootstrap.NewMockCache(ctrl)
+		next       = bootstrap.NewMockBootstrapper(ctrl)
+		ctx        = context.NewBackground()
+		opts       = NewOptions().
+				SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(dir)).
+				SetObjectStore(objectstore.NewMemoryStore()).
+				SetOrigin(testOrigin)
+	)
+	defer ctx.Close()
+
+	next.EXPECT().Bootstrap(ctx, namespaces, cache).
+		Return(bootstrap.NamespaceResults{}, nil)
+
+	b := newBackupBootstrapper(opts, next)
+	_, err = b.Bootstrap(ctx, namespaces, cache)
+	require.NoError(t, err)
+}
+
+func TestNewBackupBootstrapperProviderRequiresStore(t *testing.T) {
+	_, err := NewBackupBootstrapperProvider(NewOptions(), nil)
+	require.Error(t, err)
+}
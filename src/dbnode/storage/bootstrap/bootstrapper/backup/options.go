@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup implements a bootstrapper that restores the filesets of
+// namespaces from backups before handing off to the next bootstrapper.
+package backup
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/objectstore"
+)
+
+var (
+	errFilesystemOptionsNotSet = errors.New("filesystem options not set")
+	errObjectStoreNotSet       = errors.New("object store not set")
+	errOriginNotSet            = errors.New("origin not set")
+	errNoInstrumentOptions     = errors.New("instrument options not set")
+)
+
+type options struct {
+	fsOpts   fs.Options
+	store    objectstore.Store
+	backupID string
+	origin   topology.Host
+	iOpts    instrument.Options
+}
+
+// NewOptions creates a new Options.
+func NewOptions() Options {
+	return &options{
+		fsOpts: fs.NewOptions(),
+		iOpts:  instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.fsOpts == nil {
+		return errFilesystemOptionsNotSet
+	}
+	if o.store == nil {
+		return errObjectStoreNotSet
+	}
+	if o.origin == nil {
+		return errOriginNotSet
+	}
+	if o.iOpts == nil {
+		return errNoInstrumentOptions
+	}
+	return nil
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetObjectStore(value objectstore.Store) Options {
+	opts := *o
+	opts.store = value
+	return &opts
+}
+
+func (o *options) ObjectStore() objectstore.Store {
+	return o.store
+}
+
+func (o *options) SetBackupID(value string) Options {
+	opts := *o
+	opts.backupID = value
+	return &opts
+}
+
+func (o *options) BackupID() string {
+	return o.backupID
+}
+
+func (o *options) SetOrigin(value topology.Host) Options {
+	opts := *o
+	opts.origin = value
+	return &opts
+}
+
+func (o *options) Origin() topology.Host {
+	return o.origin
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.iOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.iOpts
+}
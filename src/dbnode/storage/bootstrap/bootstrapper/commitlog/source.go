@@ -99,6 +99,15 @@ type accumulateArg struct {
 	shortAnnotationLen uint8
 }
 
+// commitLogReader reads a partition of the commit log files.
+type commitLogReader struct {
+	iter commitlog.Iterator
+
+	datapointsSkippedNotBootstrappingNamespace int
+	datapointsSkippedNotBootstrappingShard     int
+	datapointsSkippedShardNoLongerOwned        int
+}
+
 type accumulateWorker struct {
 	inputCh        chan accumulateArg
 	datapointsRead int
@@ -455,30 +464,31 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		}
 	}
 
-	// Setup the commit log iterator.
+	// Setup the commit log iterators, the commit log files are split
+	// between the iterators so that they are read and decoded in parallel.
 	var (
-		iterOpts = commitlog.IteratorOpts{
-			CommitLogOptions:    s.opts.CommitLogOptions(),
-			FileFilterPredicate: s.readCommitLogFilePredicate,
-			// NB(r): ReturnMetadataAsRef used to all series metadata as
-			// references instead of pulling from pool and allocating,
-			// which means need to not hold onto any references returned
-			// from a call to the commit log read log entry call.
-			ReturnMetadataAsRef: true,
-		}
-		datapointsSkippedNotBootstrappingNamespace = 0
-		datapointsSkippedNotBootstrappingShard     = 0
-		datapointsSkippedShardNoLongerOwned        = 0
-		startCommitLogsRead                        = s.nowFn()
-		encounteredCorruptData                     = false
+		numReaders             = s.opts.CommitLogOptions().ReadConcurrency()
+		readers                = make([]*commitLogReader, 0, numReaders)
+		startCommitLogsRead    = s.nowFn()
+		encounteredCorruptData = false
 	)
-	s.log.Info("read commit logs start")
+	s.log.Info("read commit logs start", zap.Int("readers", numReaders))
 	span.LogEvent("read_commitlogs_start")
 	defer func() {
-		datapointsRead := 0
+		var (
+			datapointsRead                             = 0
+			datapointsSkippedNotBootstrappingNamespace = 0
+			datapointsSkippedNotBootstrappingShard     = 0
+			datapointsSkippedShardNoLongerOwned        = 0
+		)
 		for _, worker := range workers {
 			datapointsRead += worker.datapointsRead
 		}
+		for _, reader := range readers {
+			datapointsSkippedNotBootstrappingNamespace += reader.datapointsSkippedNotBootstrappingNamespace
+			datapointsSkippedNotBootstrappingShard += reader.datapointsSkippedNotBootstrappingShard
+			datapointsSkippedShardNoLongerOwned += reader.datapointsSkippedShardNoLongerOwned
+		}
 		s.log.Info("read commit logs done",
 			zap.Duration("took", s.nowFn().Sub(startCommitLogsRead)),
 			zap.Int("datapointsRead", datapointsRead),
@@ -488,18 +498,34 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		span.LogEvent("read_commitlogs_done")
 	}()
 
-	iter, corruptFiles, err := s.newIteratorFn(iterOpts)
-	if err != nil {
-		err = fmt.Errorf("unable to create commit log iterator: %v", err)
-		return commitLogResult{}, err
-	}
+	defer func() {
+		for _, reader := range readers {
+			reader.iter.Close()
+		}
+	}()
+	for i := 0; i < numReaders; i++ {
+		iterOpts := commitlog.IteratorOpts{
+			CommitLogOptions:    s.opts.CommitLogOptions(),
+			FileFilterPredicate: s.readCommitLogPartitionPredicate(i, numReaders),
+			// NB(r): ReturnMetadataAsRef used to all series metadata as
+			// references instead of pulling from pool and allocating,
+			// which means need to not hold onto any references returned
+			// from a call to the commit log read log entry call.
+			ReturnMetadataAsRef: true,
+		}
+		iter, corruptFiles, err := s.newIteratorFn(iterOpts)
+		if err != nil {
+			err = fmt.Errorf("unable to create commit log iterator: %v", err)
+			return commitLogResult{}, err
+		}
 
-	if len(corruptFiles) > 0 {
-		s.logAndEmitCorruptFiles(corruptFiles)
-		encounteredCorruptData = true
-	}
+		if len(corruptFiles) > 0 {
+			s.logAndEmitCorruptFiles(corruptFiles)
+			encounteredCorruptData = true
+		}
 
-	defer iter.Close()
+		readers = append(readers, &commitLogReader{iter: iter})
+	}
 
 	// Spin up numWorkers background go-routines to handle accumulation. This must
 	// happen before we start reading to prevent infinitely blocking writes to
@@ -514,6 +540,68 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		}()
 	}
 
+	// Read and accumulate all the log entries in the commit log that we need
+	// to read.
+	var (
+		readersWg sync.WaitGroup
+		readErrs  = make([]error, len(readers))
+	)
+	for i, reader := range readers {
+		i, reader := i, reader
+		readersWg.Add(1)
+		go func() {
+			readErrs[i] = s.readCommitLogEntries(reader, namespaceResults, workers)
+			readersWg.Done()
+		}()
+	}
+	readersWg.Wait()
+
+	for _, err := range readErrs {
+		if err != nil {
+			return commitLogResult{}, err
+		}
+	}
+
+	var iterErr error
+	for _, reader := range readers {
+		if err := reader.iter.Err(); err != nil {
+			// Log the error and mark that we encountered corrupt data, but don't
+			// return the error because we want to give the peers bootstrapper the
+			// opportunity to repair the data instead of failing the bootstrap
+			// altogether.
+			s.log.Error("error in commitlog iterator", zap.Error(err))
+			s.metrics.corruptCommitlogFile.Inc(1)
+			encounteredCorruptData = true
+			iterErr = err
+		}
+	}
+
+	// Close the worker channels since we've enqueued all required data.
+	closeWorkerChannels()
+
+	// Block until all required data from the commit log has been read and
+	// accumulated by the worker goroutines.
+	wg.Wait()
+
+	// Log the outcome and calculate if required to return unfulfilled.
+	s.logAccumulateOutcome(workers, iterErr)
+	shouldReturnUnfulfilled, err := s.shouldReturnUnfulfilled(
+		workers, encounteredCorruptData, initialTopologyState)
+	if err != nil {
+		return commitLogResult{}, err
+	}
+	return commitLogResult{shouldReturnUnfulfilled: shouldReturnUnfulfilled, read: true}, nil
+}
+
+// readCommitLogEntries reads the log entries of a commit log reader and
+// distributes them to the accumulate workers by shard, so that the entries of
+// a series are always accumulated by the same worker in the order they were
+// read.
+func (s *commitLogSource) readCommitLogEntries(
+	reader *commitLogReader,
+	namespaceResults map[string]*readNamespaceResult,
+	workers []*accumulateWorker,
+) error {
 	var (
 		// NB(r): Use pointer type for the namespaces so we don't have to
 		// memcopy the large namespace context struct to the work channel and
@@ -525,14 +613,13 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		// log files much easier to do).
 		commitLogNamespaces    []*bootstrapNamespace
 		commitLogSeries        = make(map[seriesMapKey]seriesMapEntry)
-		workerEnqueue          = 0
+		iter                   = reader.iter
+		numWorkers             = uint32(len(workers))
 		tagDecoder             = s.opts.CommitLogOptions().FilesystemOptions().TagDecoderPool().Get()
 		tagDecoderCheckedBytes = checked.NewBytes(nil, nil)
 	)
 	tagDecoderCheckedBytes.IncRef()
 
-	// Read and accumulate all the log entries in the commit log that we need
-	// to read.
 	var lastFileReadID uint64
 	for iter.Next() {
 		s.metrics.commitLogEntriesRead.Inc(1)
@@ -617,10 +704,9 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 					tagIter = ident.EmptyTagIterator
 				}
 
-				// Check out the series for writing, no need for concurrency
-				// as commit log bootstrapper does not perform parallel
-				// checking out of series.
-				series, owned, err := accumulator.CheckoutSeriesWithoutLock(
+				// Check out the series for writing, with lock since the
+				// commit log files are read concurrently.
+				series, owned, err := accumulator.CheckoutSeriesWithLock(
 					entry.Series.Shard,
 					entry.Series.ID,
 					tagIter)
@@ -633,7 +719,7 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 						commitLogSeries[seriesKey] = seriesMapEntry{shardNoLongerOwned: true}
 						continue
 					}
-					return commitLogResult{}, err
+					return err
 				}
 
 				seriesEntry = seriesMapEntry{
@@ -648,13 +734,13 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		// If series is no longer owned, then we can safely skip trying to
 		// bootstrap the result.
 		if seriesEntry.shardNoLongerOwned {
-			datapointsSkippedShardNoLongerOwned++
+			reader.datapointsSkippedShardNoLongerOwned++
 			continue
 		}
 
 		// If not bootstrapping this namespace then skip this result.
 		if !seriesEntry.namespace.bootstrapping {
-			datapointsSkippedNotBootstrappingNamespace++
+			reader.datapointsSkippedNotBootstrappingNamespace++
 			continue
 		}
 
@@ -664,7 +750,7 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 		shard := seriesEntry.series.Shard
 		_, ok = seriesEntry.namespace.dataAndIndexShardRanges.Get(shard)
 		if !ok {
-			datapointsSkippedNotBootstrappingShard++
+			reader.datapointsSkippedNotBootstrappingShard++
 			continue
 		}
 
@@ -688,39 +774,14 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 			}
 		}
 
-		// Distribute work.
+		// Distribute work by shard.
 		// NB(r): In future we could batch a few points together before sending
 		// to a channel to alleviate lock contention/stress on the channels.
-		workerEnqueue++
-		worker := workers[workerEnqueue%numWorkers]
+		worker := workers[arg.shard%numWorkers]
 		worker.inputCh <- arg
 	}
 
-	if iterErr := iter.Err(); iterErr != nil {
-		// Log the error and mark that we encountered corrupt data, but don't
-		// return the error because we want to give the peers bootstrapper the
-		// opportunity to repair the data instead of failing the bootstrap
-		// altogether.
-		s.log.Error("error in commitlog iterator", zap.Error(iterErr))
-		s.metrics.corruptCommitlogFile.Inc(1)
-		encounteredCorruptData = true
-	}
-
-	// Close the worker channels since we've enqueued all required data.
-	closeWorkerChannels()
-
-	// Block until all required data from the commit log has been read and
-	// accumulated by the worker goroutines.
-	wg.Wait()
-
-	// Log the outcome and calculate if required to return unfulfilled.
-	s.logAccumulateOutcome(workers, iter)
-	shouldReturnUnfulfilled, err := s.shouldReturnUnfulfilled(
-		workers, encounteredCorruptData, initialTopologyState)
-	if err != nil {
-		return commitLogResult{}, err
-	}
-	return commitLogResult{shouldReturnUnfulfilled: shouldReturnUnfulfilled, read: true}, nil
+	return nil
 }
 
 func (s *commitLogSource) snapshotFilesByShard(
@@ -1057,6 +1118,26 @@ func (s *commitLogSource) readCommitLogFilePredicate(f commitlog.FileFilterInfo)
 	return ok
 }
 
+// readCommitLogPartitionPredicate returns a predicate selecting the commit log
+// files to read for one of numPartitions partitions of the commit log files.
+func (s *commitLogSource) readCommitLogPartitionPredicate(
+	partition int,
+	numPartitions int,
+) commitlog.FileFilterPredicate {
+	return func(f commitlog.FileFilterInfo) bool {
+		if f.IsCorrupt {
+			// The index of corrupt files is unknown, only the first partition
+			// includes them.
+			if partition != 0 {
+				return false
+			}
+		} else if int(f.File.Index%int64(numPartitions)) != partition {
+			return false
+		}
+		return s.readCommitLogFilePredicate(f)
+	}
+}
+
 func (s *commitLogSource) startAccumulateWorker(worker *accumulateWorker) {
 	ctx := xcontext.NewBackground()
 	defer ctx.Close()
@@ -1114,7 +1195,7 @@ func (s *commitLogSource) startAccumulateWorker(worker *accumulateWorker) {
 
 func (s *commitLogSource) logAccumulateOutcome(
 	workers []*accumulateWorker,
-	iter commitlog.Iterator,
+	iterErr error,
 ) {
 	errs := 0
 	for _, worker := range workers {
@@ -1123,8 +1204,8 @@ func (s *commitLogSource) logAccumulateOutcome(
 	if errs > 0 {
 		s.log.Error("error bootstrapping from commit log", zap.Int("accumulateErrors", errs))
 	}
-	if err := iter.Err(); err != nil {
-		s.log.Error("error reading commit log", zap.Error(err))
+	if iterErr != nil {
+		s.log.Error("error reading commit log", zap.Error(iterErr))
 	}
 }
 
@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type replayTestSetup struct {
+	fsOpts        fs.Options
+	commitLogOpts commitlog.Options
+	md            namespace.Metadata
+	ranges        result.ShardTimeRanges
+	values        testValues
+}
+
+// newReplayTestSetup writes numSeries series with numPoints datapoints each
+// across numFiles commit log files.
+func newReplayTestSetup(
+	t require.TestingT,
+	compression commitlog.CompressionType,
+	numSeries int,
+	numPoints int,
+	numFiles int,
+) (replayTestSetup, func()) {
+	dir, err := ioutil.TempDir("", "commitlog-replay")
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(testNamespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+
+	var (
+		fsOpts        = fs.NewOptions().SetFilePathPrefix(dir)
+		commitLogOpts = commitlog.NewOptions().
+				SetFilesystemOptions(fsOpts).
+				SetCompression(compression)
+		blockSize = md.Options().RetentionOptions().BlockSize()
+		start     = xtime.Now().Truncate(blockSize).Add(-blockSize)
+		ranges    = xtime.NewRanges(xtime.Range{Start: start, End: start.Add(blockSize)})
+		numShards = uint32(8)
+		values    = make(testValues, 0, numSeries*numPoints)
+	)
+	targetRanges := result.NewShardTimeRanges()
+	for shard := uint32(0); shard < numShards; shard++ {
+		targetRanges.Set(shard, ranges)
+	}
+	for i := 0; i < numSeries; i++ {
+		s := ts.Series{
+			UniqueIndex: uint64(i),
+			Namespace:   md.ID(),
+			Shard:       uint32(i) % numShards,
+			ID:          ident.StringID(fmt.Sprintf("series-%d", i)),
+		}
+		for j := 0; j < numPoints; j++ {
+			values = append(values, testValue{
+				s, start.Add(time.Duration(j) * time.Second), float64(j), xtime.Second, nil,
+			})
+		}
+	}
+
+	cl, err := commitlog.NewCommitLog(commitLogOpts)
+	require.NoError(t, err)
+	require.NoError(t, cl.Open())
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	// Write the datapoints of each series in order, rotating the commit log
+	// so that they are spread across the commit log files.
+	perFile := (numPoints + numFiles - 1) / numFiles
+	for j := 0; j < numPoints; j++ {
+		if j > 0 && j%perFile == 0 {
+			_, err := cl.RotateLogs()
+			require.NoError(t, err)
+		}
+		for i := 0; i < numSeries; i++ {
+			v := values[i*numPoints+j]
+			dp := ts.Datapoint{TimestampNanos: v.t, Value: v.v}
+			require.NoError(t, cl.Write(ctx, v.s, dp, v.u, v.a))
+		}
+	}
+	require.NoError(t, cl.Close())
+
+	return replayTestSetup{
+		fsOpts:        fsOpts,
+		commitLogOpts: commitLogOpts,
+		md:            md,
+		ranges:        targetRanges,
+		values:        values,
+	}, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func (s replayTestSetup) read(
+	t require.TestingT,
+	opts Options,
+	readConcurrency int,
+) bootstrap.NamespacesTester {
+	inspection, err := fs.InspectFilesystem(s.fsOpts)
+	require.NoError(t, err)
+
+	commitLogOpts := s.commitLogOpts.
+		SetInstrumentOptions(opts.ResultOptions().InstrumentOptions()).
+		SetReadConcurrency(readConcurrency)
+	src := newCommitLogSource(opts.SetCommitLogOptions(commitLogOpts), inspection)
+
+	tester := bootstrap.BuildNamespacesTesterWithFilesystemOptions(t,
+		testDefaultRunOpts, s.ranges, s.fsOpts, s.md)
+	tester.TestReadWith(src)
+	return tester
+}
+
+func TestReadCommitLogFilesInParallel(t *testing.T) {
+	compressions := []commitlog.CompressionType{
+		commitlog.CompressionNone,
+		commitlog.CompressionSnappy,
+		commitlog.CompressionZstd,
+	}
+	for _, compression := range compressions {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			setup, cleanup := newReplayTestSetup(t, compression, 20, 12, 5)
+			defer cleanup()
+
+			files, corruptFiles, err := commitlog.Files(setup.commitLogOpts)
+			require.NoError(t, err)
+			require.Empty(t, corruptFiles)
+			require.True(t, len(files) >= 5)
+
+			for _, readConcurrency := range []int{1, 3} {
+				tester := setup.read(t, testDefaultOpts, readConcurrency)
+				tester.TestUnfulfilledForNamespaceIsEmpty(setup.md)
+
+				read := tester.EnsureDumpWritesForNamespace(setup.md)
+				enforceValuesAreCorrect(t, setup.values, read)
+				tester.Finish()
+			}
+		})
+	}
+}
+
+func BenchmarkReadCommitLog(b *testing.B) {
+	compressions := []commitlog.CompressionType{
+		commitlog.CompressionNone,
+		commitlog.CompressionSnappy,
+		commitlog.CompressionZstd,
+	}
+	resultOpts := testDefaultOpts.ResultOptions()
+	opts := testDefaultOpts.SetResultOptions(resultOpts.SetInstrumentOptions(
+		resultOpts.InstrumentOptions().SetLogger(zap.NewNop())))
+	for _, compression := range compressions {
+		setup, cleanup := newReplayTestSetup(b, compression, 1000, 100, 8)
+		for _, readConcurrency := range []int{1, 4, 8} {
+			name := fmt.Sprintf("compression=%s/readers=%d", compression, readConcurrency)
+			b.Run(name, func(b *testing.B) {
+				for n := 0; n < b.N; n++ {
+					tester := setup.read(b, opts, readConcurrency)
+					tester.Finish()
+				}
+			})
+		}
+		cleanup()
+	}
+}
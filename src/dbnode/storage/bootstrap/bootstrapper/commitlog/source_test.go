@@ -38,9 +38,13 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
+// NB: The test iterators return all their entries regardless of the commit
+// log files assigned to each reader, so the commit logs are read by a single
+// reader.
 var (
 	testDefaultOpts = NewOptions().
-			SetRuntimeOptionsManager(runtime.NewOptionsManager())
+			SetRuntimeOptionsManager(runtime.NewOptionsManager()).
+			SetCommitLogOptions(NewOptions().CommitLogOptions().SetReadConcurrency(1))
 	notSelfID = "not-self"
 )
 
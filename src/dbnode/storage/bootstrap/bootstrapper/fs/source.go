@@ -392,6 +392,8 @@ func (s *fileSystemSource) loadShardReadersDataIntoShardResult(
 
 	requestedRanges := timeWindowReaders.Ranges
 	remainingRanges := requestedRanges.Copy()
+	windowStart, windowEnd := requestedRanges.MinMax()
+	window := xtime.Range{Start: windowStart, End: windowEnd}
 	shardReaders := timeWindowReaders.Readers
 	defer func() {
 		// Return readers to pool.
@@ -409,8 +411,10 @@ func (s *fileSystemSource) loadShardReadersDataIntoShardResult(
 		readers := shardReaders.Readers
 
 		for _, r := range readers {
+			// NB: Compacted filesets span more than a single block, so only
+			// consider the part of the reader's range inside this window.
+			timeRange, _ := r.Range().Intersect(window)
 			var (
-				timeRange = r.Range()
 				start     = timeRange.Start
 				blockSize = ns.Options().RetentionOptions().BlockSize()
 				err       error
@@ -187,7 +187,7 @@ func newShardReaders(
 		blockStart := xtime.UnixNano(info.BlockStart)
 		if !tr.Overlaps(xtime.Range{
 			Start: blockStart,
-			End:   blockStart.Add(ns.Options().RetentionOptions().BlockSize()),
+			End:   blockStart.Add(time.Duration(info.BlockSize)),
 		}) {
 			// Errors are marked unfulfilled by markRunResultErrorsAndUnfulfilled
 			// and will be re-attempted by the next bootstrapper.
@@ -287,6 +287,9 @@ func (m *cleanupManager) cleanupDataFiles(t xtime.UnixNano, namespaces []databas
 		earliestToRetain := retention.FlushTimeStart(n.Options().RetentionOptions(), t)
 		shards := n.OwnedShards()
 		multiErr = multiErr.Add(m.cleanupExpiredNamespaceDataFiles(earliestToRetain, shards))
+		if len(n.Options().RetentionOverrides()) > 0 {
+			multiErr = multiErr.Add(m.cleanupExpiredNamespaceSeries(t, shards))
+		}
 		multiErr = multiErr.Add(m.cleanupCompactedNamespaceDataFiles(shards))
 	}
 	return multiErr.FinalError()
@@ -362,6 +365,25 @@ func (m *cleanupManager) cleanupExpiredNamespaceDataFiles(
 	return multiErr.FinalError()
 }
 
+// cleanupExpiredNamespaceSeries removes the series whose retention override
+// expired from the filesets, which runs before the cleanup of compacted
+// filesets so that the filesets they are removed from are deleted.
+func (m *cleanupManager) cleanupExpiredNamespaceSeries(
+	t xtime.UnixNano, shards []databaseShard,
+) error {
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range shards {
+		if !shard.IsBootstrapped() {
+			continue
+		}
+		if err := shard.CleanupExpiredSeries(t); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
 func (m *cleanupManager) cleanupCompactedNamespaceDataFiles(shards []databaseShard) error {
 	multiErr := xerrors.NewMultiError()
 	for _, shard := range shards {
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
 )
 
 var (
@@ -135,6 +136,17 @@ func (d *clusterDB) TopologyMap() (topology.Map, error) {
 	return d.topo.Get(), nil
 }
 
+// Backup backs up the namespace as part of the backup of the cluster, which
+// is complete once the backups of the nodes cover all its shards.
+func (d *clusterDB) Backup(
+	namespace ident.ID,
+	opts storage.BackupOptions,
+) (storage.BackupResult, error) {
+	opts.HostID = d.hostID
+	opts.ClusterShards = d.watch.Get().ShardSet().AllIDs()
+	return d.Database.Backup(namespace, opts)
+}
+
 func (d *clusterDB) Open() error {
 	select {
 	case <-d.watch.C():
@@ -399,6 +411,11 @@ func (d *clusterDB) analyzeAndReportShardStates() {
 			if !s.IsBootstrapped() {
 				continue
 			}
+			// Shards being split off from a parent shard only become available
+			// once they hold every block that was split from the parent.
+			if !n.ShardSplitComplete(s.ID()) {
+				continue
+			}
 			d.bootstrapCount[s.ID()]++
 		}
 	}
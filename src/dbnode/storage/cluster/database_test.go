@@ -22,6 +22,7 @@ package cluster
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/topology/testutil"
+	"github.com/m3db/m3/src/x/ident"
 )
 
 var testOpts = storage.DefaultTestOptions()
@@ -146,6 +148,7 @@ func TestDatabaseMarksShardAsAvailableOnReshard(t *testing.T) {
 
 	mockNamespace := storage.NewMockNamespace(ctrl)
 	mockNamespace.EXPECT().Shards().Return(expectShards).AnyTimes()
+	mockNamespace.EXPECT().ShardSplitComplete(gomock.Any()).Return(true).AnyTimes()
 
 	expectNamespaces := []storage.Namespace{mockNamespace}
 	mockStorageDB.EXPECT().Namespaces().Return(expectNamespaces).AnyTimes()
@@ -531,3 +534,40 @@ func TestDatabaseCloseCanRetry(t *testing.T) {
 	err = db.Close()
 	require.NoError(t, err)
 }
+
+func TestDatabaseBackupCoversClusterShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorageDB, restore := mockNewStorageDatabase(ctrl)
+	defer restore()
+
+	viewsCh := make(chan testutil.TopologyView, 64)
+	defer close(viewsCh)
+
+	viewsCh <- testutil.NewTopologyView(1, map[string][]shard.Shard{
+		"testhost0": sharding.NewShards([]uint32{0}, shard.Available),
+		"testhost1": sharding.NewShards([]uint32{1, 2}, shard.Available),
+	})
+
+	topoInit, _ := newMockTopoInit(t, ctrl, viewsCh)
+
+	db, err := newTestDatabase(t, "testhost0", topoInit)
+	require.NoError(t, err)
+
+	nsID := ident.StringID("testns")
+	mockStorageDB.EXPECT().Backup(nsID, gomock.Any()).DoAndReturn(
+		func(_ ident.ID, opts storage.BackupOptions) (storage.BackupResult, error) {
+			assert.Equal(t, "backup", opts.ID)
+			assert.Equal(t, "testhost0", opts.HostID)
+			sort.Slice(opts.ClusterShards, func(i, j int) bool {
+				return opts.ClusterShards[i] < opts.ClusterShards[j]
+			})
+			assert.Equal(t, []uint32{0, 1, 2}, opts.ClusterShards)
+			return storage.BackupResult{ID: opts.ID}, nil
+		})
+
+	result, err := db.Backup(nsID, storage.BackupOptions{ID: "backup"})
+	require.NoError(t, err)
+	require.Equal(t, "backup", result.ID)
+}
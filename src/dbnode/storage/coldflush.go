@@ -132,6 +132,21 @@ func (m *coldFlushManager) Run(t xtime.UnixNano) bool {
 			})
 	}
 
+	if err := m.removeSplitSeries(); err != nil {
+		m.log.Error("error when removing split series",
+			zap.Time("time", t.ToTime()), zap.Error(err))
+	}
+
+	if err := m.compactBlocks(t); err != nil {
+		m.log.Error("error when compacting blocks",
+			zap.Time("time", t.ToTime()), zap.Error(err))
+	}
+
+	if err := m.offloadBlocks(t); err != nil {
+		m.log.Error("error when offloading blocks",
+			zap.Time("time", t.ToTime()), zap.Error(err))
+	}
+
 	if log := m.log.Check(zapcore.DebugLevel, "cold flush run complete"); log != nil {
 		log.Write(zap.Time("time", t.ToTime()))
 	}
@@ -196,6 +211,68 @@ func (m *coldFlushManager) coldFlush() error {
 	return err
 }
 
+// removeSplitSeries removes the series of completed shard splits from their
+// parent shards, which runs after cold flushes so that it never runs
+// concurrently with them or with the cleanup of replaced filesets.
+func (m *coldFlushManager) removeSplitSeries() error {
+	namespaces, err := m.database.OwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range namespaces {
+		if err := ns.RemoveSplitSeries(); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// compactBlocks compacts older flushed blocks into larger filesets, which
+// runs after cold flushes so that it never runs concurrently with them or
+// with the cleanup of compacted filesets.
+func (m *coldFlushManager) compactBlocks(t xtime.UnixNano) error {
+	if len(m.opts.BlockCompactionTiers()) == 0 {
+		return nil
+	}
+
+	namespaces, err := m.database.OwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range namespaces {
+		if err := ns.CompactBlocks(t); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+// offloadBlocks offloads the filesets of older flushed blocks to the object
+// store, which runs after blocks are compacted so that blocks are offloaded
+// in their compacted filesets.
+func (m *coldFlushManager) offloadBlocks(t xtime.UnixNano) error {
+	if m.opts.FileSetOffloadAge() <= 0 {
+		return nil
+	}
+
+	namespaces, err := m.database.OwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, ns := range namespaces {
+		if err := ns.OffloadBlocks(t); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (m *coldFlushManager) Report() {
 	m.databaseCleanupManager.Report()
 
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -120,6 +121,77 @@ func TestColdFlushManagerFlushDoneFlushError(t *testing.T) {
 	require.EqualError(t, fakeErr, cfm.coldFlush().Error())
 }
 
+func TestColdFlushManagerRemoveSplitSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		fakeErr = errors.New("fake error while removing split series")
+		ns1     = NewMockdatabaseNamespace(ctrl)
+		ns2     = NewMockdatabaseNamespace(ctrl)
+	)
+	ns1.EXPECT().RemoveSplitSeries().Return(fakeErr)
+	ns2.EXPECT().RemoveSplitSeries().Return(nil)
+
+	db := newMockdatabase(ctrl)
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns1, ns2}, nil)
+
+	cfm := newColdFlushManager(db, nil, DefaultTestOptions()).(*coldFlushManager)
+	require.EqualError(t, cfm.removeSplitSeries(), fakeErr.Error())
+}
+
+func TestColdFlushManagerCompactBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = xtime.Now()
+		fakeErr = errors.New("fake error while compacting blocks")
+		ns1     = NewMockdatabaseNamespace(ctrl)
+		ns2     = NewMockdatabaseNamespace(ctrl)
+	)
+	ns1.EXPECT().CompactBlocks(now).Return(nil)
+	ns2.EXPECT().CompactBlocks(now).Return(fakeErr)
+
+	testOpts := DefaultTestOptions().SetBlockCompactionTiers([]BlockCompactionTier{
+		{Age: 24 * time.Hour, BlockSize: 24 * time.Hour},
+	})
+	db := newMockdatabase(ctrl)
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns1, ns2}, nil)
+
+	cfm := newColdFlushManager(db, nil, testOpts).(*coldFlushManager)
+	require.EqualError(t, cfm.compactBlocks(now), fakeErr.Error())
+
+	// Nothing is compacted without any tiers.
+	cfm = newColdFlushManager(db, nil, DefaultTestOptions()).(*coldFlushManager)
+	require.NoError(t, cfm.compactBlocks(now))
+}
+
+func TestColdFlushManagerOffloadBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = xtime.Now()
+		fakeErr = errors.New("fake error while offloading blocks")
+		ns1     = NewMockdatabaseNamespace(ctrl)
+		ns2     = NewMockdatabaseNamespace(ctrl)
+	)
+	ns1.EXPECT().OffloadBlocks(now).Return(fakeErr)
+	ns2.EXPECT().OffloadBlocks(now).Return(nil)
+
+	testOpts := DefaultTestOptions().SetFileSetOffloadAge(7 * 24 * time.Hour)
+	db := newMockdatabase(ctrl)
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns1, ns2}, nil)
+
+	cfm := newColdFlushManager(db, nil, testOpts).(*coldFlushManager)
+	require.EqualError(t, cfm.offloadBlocks(now), fakeErr.Error())
+
+	// Nothing is offloaded without an offload age.
+	cfm = newColdFlushManager(db, nil, DefaultTestOptions()).(*coldFlushManager)
+	require.NoError(t, cfm.offloadBlocks(now))
+}
+
 func TestColdFlushManagerSkipRun(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -125,6 +125,9 @@ type db struct {
 	writeBatchPool *writes.WriteBatchPool
 
 	queryLimits limits.QueryLimits
+
+	// backupLock ensures a single backup runs at a time.
+	backupLock sync.Mutex
 }
 
 type databaseMetrics struct {
@@ -255,6 +258,13 @@ func NewDatabase(
 		}
 	}
 
+	if opts.FileSetScrubOptions().Enabled {
+		scrubber := newDatabaseScrubber(d, d.mediator, opts)
+		if err := d.mediator.RegisterBackgroundProcess(scrubber); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, fn := range opts.BackgroundProcessFns() {
 		process, err := fn(d, opts)
 		if err != nil {
@@ -1220,6 +1230,33 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteSeries(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return 0, err
+	}
+	return n.DeleteSeries(ctx, query, start, end)
+}
+
+func (d *db) FetchExemplars(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end xtime.UnixNano,
+	limit int,
+) ([]SeriesExemplars, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return n.FetchExemplars(ctx, query, start, end, limit)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
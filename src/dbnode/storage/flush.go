@@ -240,6 +240,19 @@ func (m *flushManager) dataSnapshot(
 	finalErr := multiErr.FinalError()
 	if finalErr == nil {
 		m.lastSuccessfulSnapshotStartTime.Store(int64(startTime))
+
+		// The commit logs holding the data removed by the rewrites that
+		// completed before the snapshot started are no longer bootstrapped
+		// from, so the tombstones they applied can be dropped.
+		for _, ns := range namespaces {
+			if err := ns.RemoveCompactedTombstones(startTime); err != nil {
+				detailedErr := fmt.Errorf(
+					"namespace %s failed to remove compacted tombstones: %w",
+					ns.ID().String(), err)
+				multiErr = multiErr.Add(detailedErr)
+			}
+		}
+		finalErr = multiErr.FinalError()
 	}
 	m.metrics.dataSnapshotDuration.Record(m.nowFn().Sub(start))
 	return finalErr
@@ -224,6 +224,7 @@ func TestFlushManagerNamespaceFlushTimesErr(t *testing.T) {
 	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
 	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(false, fakeErr).AnyTimes()
 	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().RemoveCompactedTombstones(gomock.Any()).Return(nil).AnyTimes()
 	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns}, nil)
 
 	cl := commitlog.NewMockCommitLog(ctrl)
@@ -323,6 +324,7 @@ func TestFlushManagerSkipNamespaceIndexingDisabled(t *testing.T) {
 	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
 	ns.EXPECT().WarmFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().RemoveCompactedTombstones(gomock.Any()).Return(nil).AnyTimes()
 	s1.EXPECT().ID().Return(uint32(1)).AnyTimes()
 	s2.EXPECT().ID().Return(uint32(2)).AnyTimes()
 
@@ -377,6 +379,7 @@ func TestFlushManagerNamespaceIndexingEnabled(t *testing.T) {
 	gomock.InOrder(
 		ns.EXPECT().WarmFlush(gomock.Any(), gomock.Any()).Return(nil).AnyTimes(),
 		ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes(),
+		ns.EXPECT().RemoveCompactedTombstones(gomock.Any()).Return(nil).AnyTimes(),
 		ns.EXPECT().FlushIndex(gomock.Any()).Return(nil),
 	)
 
@@ -582,6 +585,7 @@ func TestFlushManagerFlushSnapshot(t *testing.T) {
 			snapshotBlocks = append(snapshotBlocks, start.Add(time.Duration(i)*blockSize))
 		}
 		ns.EXPECT().Snapshot(snapshotBlocks, now, gomock.Any())
+		ns.EXPECT().RemoveCompactedTombstones(now)
 	}
 
 	require.NoError(t, fm.Flush(now))
@@ -22,6 +22,7 @@ package storage
 
 import (
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -71,7 +72,19 @@ type fileOpState struct {
 	// cold version that has been flushed and to validate lease requests from the SeekerManager when it
 	// receives a signal to open a new lease.
 	ColdVersionFlushed int
-	NumFailures        int
+	// CompactedBlockSize is the block size of the fileset the block has been
+	// compacted into by block compaction, or zero if the block is persisted
+	// in its own fileset. The compacted fileset starts at the block start
+	// truncated to the compacted block size.
+	CompactedBlockSize time.Duration
+	// Offloaded is whether the fileset of the block has been offloaded to
+	// the object store and is read through the remote fileset cache.
+	Offloaded bool
+	// ExpiredRetentionPeriod is the longest retention override period of the
+	// series that have been removed from the fileset of the block once their
+	// retention expired, or zero if no series has been removed yet.
+	ExpiredRetentionPeriod time.Duration
+	NumFailures            int
 }
 
 type forceType int
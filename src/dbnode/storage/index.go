@@ -82,6 +82,7 @@ var (
 	errDbIndexTerminatingTickCancellation = errors.New("terminating tick early due to cancellation")
 	errDbIndexIsBootstrapping             = errors.New("index is already bootstrapping")
 	errDbIndexDoNotIndexSeries            = errors.New("series matched do not index fields")
+	errDbIndexBlockNotFlushed             = errors.New("index block data is not flushed to disk for all shards")
 )
 
 const (
@@ -106,6 +107,7 @@ type nsIndex struct {
 	bufferPast            time.Duration
 	bufferFuture          time.Duration
 	coldWritesEnabled     bool
+	retentionOverrides    retentionOverrides
 
 	namespaceRuntimeOptsMgr namespace.RuntimeOptionsManager
 	indexFilesetsBeforeFn   indexFilesetsBeforeFn
@@ -338,6 +340,11 @@ func newNamespaceIndexWithOptions(
 	nowFn := indexOpts.ClockOptions().NowFn()
 	logger := indexOpts.InstrumentOptions().Logger()
 
+	retentionOverrides, err := newRetentionOverrides(nsMD.Options().RetentionOverrides(), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var doNotIndexWithFields []doc.Field
 	if m := newIndexOpts.opts.DoNotIndexWithFieldsMap(); m != nil && len(m) != 0 {
 		for k, v := range m {
@@ -365,6 +372,7 @@ func newNamespaceIndexWithOptions(
 		bufferPast:            nsMD.Options().RetentionOptions().BufferPast(),
 		bufferFuture:          nsMD.Options().RetentionOptions().BufferFuture(),
 		coldWritesEnabled:     nsMD.Options().ColdWritesEnabled(),
+		retentionOverrides:    retentionOverrides,
 
 		namespaceRuntimeOptsMgr: newIndexOpts.namespaceRuntimeOptsMgr,
 		indexFilesetsBeforeFn:   fs.IndexFileSetsBefore,
@@ -910,6 +918,38 @@ func (i *nsIndex) Bootstrap(
 	return multiErr.FinalError()
 }
 
+func (i *nsIndex) RemoveSeries(ids []ident.ID, removedAt xtime.UnixNano) error {
+	// Only the blocks that ended by the time the series were removed are
+	// rewritten, the later blocks may index the series written since.
+	i.state.RLock()
+	blocks := make([]index.Block, 0, len(i.state.blocksByTime))
+	for _, block := range i.state.blocksByTime {
+		if !block.EndTime().After(removedAt) {
+			blocks = append(blocks, block)
+		}
+	}
+	i.state.RUnlock()
+
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	idBytes := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		idBytes = append(idBytes, id.Bytes())
+	}
+
+	var multiErr xerrors.MultiError
+	for _, block := range blocks {
+		if err := block.RemoveDocuments(idBytes); err != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"unable to remove documents from index block %s: %w",
+				block.StartTime().ToTime(), err))
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (i *nsIndex) Bootstrapped() bool {
 	i.state.RLock()
 	result := i.state.bootstrapState == Bootstrapped
@@ -1124,6 +1164,59 @@ func (i *nsIndex) WarmFlushBlockStarts() []xtime.UnixNano {
 	return flushed
 }
 
+// RebuildBlock writes a new index volume for the block start from the data
+// filesets of the shards, replacing a quarantined index volume. The segments
+// already loaded by the block are kept in memory.
+func (i *nsIndex) RebuildBlock(
+	flush persist.IndexFlush,
+	blockStart xtime.UnixNano,
+	shards []databaseShard,
+) error {
+	now := xtime.ToUnixNano(i.nowFn())
+	earliestBlockStartToRetain := retention.FlushTimeStartForRetentionPeriod(i.retentionPeriod, i.blockSize, now)
+	if blockStart.Before(earliestBlockStartToRetain) {
+		// Out of retention, the block is about to be cleaned up.
+		return nil
+	}
+
+	// The index is read from the data filesets so all of the data of the
+	// block needs to be flushed.
+	for _, shard := range shards {
+		if !shard.IsBootstrapped() {
+			return errDbIndexBlockNotFlushed
+		}
+		for _, t := range i.blockStartsFromIndexBlockStart(blockStart) {
+			flushState, err := shard.FlushState(t)
+			if err != nil {
+				return err
+			}
+			if flushState.WarmStatus.DataFlushed != fileOpSuccess {
+				return errDbIndexBlockNotFlushed
+			}
+		}
+	}
+
+	blockResult, err := i.ensureBlockPresent(blockStart)
+	if err != nil {
+		return err
+	}
+
+	builder, err := builder.NewBuilderFromDocuments(i.opts.IndexOptions().SegmentBuilderOptions())
+	if err != nil {
+		return err
+	}
+	defer builder.Close()
+
+	segments, err := i.flushBlock(flush, blockResult.block, shards, builder)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		segment.Close()
+	}
+	return nil
+}
+
 // BackgroundCompact background compacts eligible segments.
 func (i *nsIndex) BackgroundCompact() {
 	if i.activeBlock != nil {
@@ -1501,10 +1594,29 @@ func (i *nsIndex) Query(
 	}
 
 	// Get results and set the namespace ID and size limit.
+	filterID := i.shardsFilterID()
+	if queryFilterID := opts.FilterID; queryFilterID != nil {
+		shardsFilterID := filterID
+		filterID = func(id ident.ID) bool {
+			if shardsFilterID != nil && !shardsFilterID(id) {
+				return false
+			}
+			return queryFilterID(id)
+		}
+	}
+	var filterDocument func(d doc.Metadata) bool
+	if len(i.retentionOverrides) > 0 {
+		// Exclude the series whose data has expired before the end of the
+		// query due to the retention override they match.
+		filterDocument = i.retentionOverrides.queryFilter(
+			i.nsMetadata.Options().RetentionOptions(), opts.EndExclusive,
+			xtime.ToUnixNano(i.nowFn()))
+	}
 	results := i.resultsPool.Get()
 	results.Reset(i.nsMetadata.ID(), index.QueryResultsOptions{
-		SizeLimit: opts.SeriesLimit,
-		FilterID:  i.shardsFilterID(),
+		SizeLimit:      opts.SeriesLimit,
+		FilterID:       filterID,
+		FilterDocument: filterDocument,
 	})
 	ctx.RegisterFinalizer(results)
 	queryRes, err := i.query(ctx, query, results, opts, i.execBlockQueryFn,
@@ -1548,6 +1660,7 @@ func (i *nsIndex) AggregateQuery(
 		DocsLimit:             opts.DocsLimit,
 		FieldFilter:           opts.FieldFilter,
 		Type:                  opts.Type,
+		IncludeDocsCount:      opts.IncludeDocsCount,
 		AggregateUsageMetrics: metrics,
 	}
 	ctx.RegisterFinalizer(results)
@@ -1566,6 +1679,16 @@ func (i *nsIndex) AggregateQuery(
 			aopts.RestrictByQuery = &query
 		}
 	}
+	if exclude := opts.ExcludeQuery; exclude != nil {
+		restrict := query
+		if aopts.RestrictByQuery != nil {
+			restrict = *aopts.RestrictByQuery
+		}
+		restrict = index.Query{
+			Query: idx.NewConjunctionQuery(restrict.Query, idx.NewNegationQuery(exclude.Query)),
+		}
+		aopts.RestrictByQuery = &restrict
+	}
 	aopts.FieldFilter = aopts.FieldFilter.SortAndDedupe()
 	results.Reset(id, aopts)
 	queryRes, err := i.query(ctx, query, results, opts.QueryOptions, fn,
@@ -21,6 +21,8 @@
 package index
 
 import (
+	pilosaroaring "github.com/m3dbx/pilosa/roaring"
+
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -43,6 +45,7 @@ type aggregateIter struct {
 	done                   bool
 	currField, currTerm    []byte
 	nextField, nextTerm    []byte
+	currDocs, nextDocs     int
 	docsCount, seriesCount int
 }
 
@@ -51,8 +54,21 @@ func (it *aggregateIter) Next(ctx context.Context) bool {
 		return false
 	}
 	if it.iters == nil {
-		for _, reader := range it.readers {
-			iter, err := it.newIterFn(ctx, reader, it.iterateOpts)
+		var duplicates []*pilosaroaring.Bitmap
+		if it.iterateOpts.countDocs {
+			var err error
+			duplicates, err = duplicateDocs(it.readers)
+			if err != nil {
+				it.err = err
+				return false
+			}
+		}
+		for i, reader := range it.readers {
+			iterOpts := it.iterateOpts
+			if duplicates != nil {
+				iterOpts.duplicateDocs = duplicates[i]
+			}
+			iter, err := it.newIterFn(ctx, reader, iterOpts)
 			if err != nil {
 				it.err = err
 				return false
@@ -63,14 +79,15 @@ func (it *aggregateIter) Next(ctx context.Context) bool {
 			it.done = true
 			return false
 		}
-		it.nextField, it.nextTerm = it.current()
+		it.nextField, it.nextTerm, it.nextDocs = it.current()
 	}
 	// the fieldAndTermsIterator mutates the underlying byte slice, so we need to copy to preserve the value.
 	it.currField = append(it.currField[:0], it.nextField...)
 	it.currTerm = append(it.currTerm[:0], it.nextTerm...)
+	it.currDocs = it.nextDocs
 
 	if it.next() {
-		it.nextField, it.nextTerm = it.current()
+		it.nextField, it.nextTerm, it.nextDocs = it.current()
 	} else {
 		// the iterators have been exhausted. mark done so the next call Done returns true. Still return true from
 		// this call so the caller can retrieve the last element with Current.
@@ -79,6 +96,45 @@ func (it *aggregateIter) Next(ctx context.Context) bool {
 	return true
 }
 
+// duplicateDocs returns, for each reader, the postings IDs of the documents
+// which are also present in an earlier reader, so that a series indexed in
+// multiple segments of a block is only counted once.
+func duplicateDocs(readers []segment.Reader) ([]*pilosaroaring.Bitmap, error) {
+	if len(readers) < 2 {
+		return nil, nil
+	}
+
+	var (
+		seen       = make(map[string]struct{})
+		duplicates = make([]*pilosaroaring.Bitmap, 0, len(readers))
+	)
+	for _, reader := range readers {
+		docs, err := reader.AllDocs()
+		if err != nil {
+			return nil, err
+		}
+
+		bitmap := pilosaroaring.NewBitmap()
+		for docs.Next() {
+			id := docs.Current().ID
+			if _, ok := seen[string(id)]; ok {
+				bitmap.DirectAdd(uint64(docs.PostingsID()))
+				continue
+			}
+			seen[string(id)] = struct{}{}
+		}
+		if err := docs.Err(); err != nil {
+			docs.Close() // nolint:errcheck
+			return nil, err
+		}
+		if err := docs.Close(); err != nil {
+			return nil, err
+		}
+		duplicates = append(duplicates, bitmap)
+	}
+	return duplicates, nil
+}
+
 func (it *aggregateIter) next() bool {
 	if it.idx == len(it.iters) {
 		return false
@@ -106,8 +162,13 @@ func (it *aggregateIter) next() bool {
 	return true
 }
 
-func (it *aggregateIter) current() (field, term []byte) {
-	return it.iters[it.idx].Current()
+func (it *aggregateIter) current() (field, term []byte, docsCount int) {
+	iter := it.iters[it.idx]
+	field, term = iter.Current()
+	if it.iterateOpts.countDocs {
+		docsCount = iter.CurrentDocsCount()
+	}
+	return field, term, docsCount
 }
 
 func (it *aggregateIter) Err() error {
@@ -122,6 +183,10 @@ func (it *aggregateIter) Current() (field, term []byte) {
 	return it.currField, it.currTerm
 }
 
+func (it *aggregateIter) CurrentDocsCount() int {
+	return it.currDocs
+}
+
 func (it *aggregateIter) fieldsAndTermsIteratorOpts() fieldsAndTermsIteratorOpts {
 	return it.iterateOpts
 }
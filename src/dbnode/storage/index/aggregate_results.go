@@ -271,22 +271,31 @@ func (r *aggregatedResults) AddFields(batch []AggregateResultsEntry) (int, int)
 		}
 
 		valuesMap := aggValues.Map()
-		for _, t := range entry.Terms {
+		for i, t := range entry.Terms {
 			r.aggregateOpts.AggregateUsageMetrics.IncTotalTerms(1)
+			var termDocs int64
+			if i < len(entry.DocsCounts) {
+				termDocs = entry.DocsCounts[i]
+			}
 			if remainingDocs > docs {
 				docs++
-				if !valuesMap.Contains(t) {
+				existingDocs, exists := valuesMap.Get(t)
+				if !exists {
 					// we can avoid the copy because we assume ownership of the passed ident.ID,
 					// but still need to finalize it.
 					if remainingInserts > numInserts {
 						r.aggregateOpts.AggregateUsageMetrics.IncDedupedTerms(1)
-						valuesMap.SetUnsafe(t, struct{}{}, AggregateValuesMapSetUnsafeOptions{
+						valuesMap.SetUnsafe(t, termDocs, AggregateValuesMapSetUnsafeOptions{
 							NoCopyKey:     true,
 							NoFinalizeKey: false,
 						})
 						numInserts++
 						continue
 					}
+				} else if termDocs > 0 {
+					// NB: the same term can be present in multiple segments
+					// and blocks, so accumulate the documents count.
+					valuesMap.Set(t, existingDocs+termDocs)
 				}
 			}
 
@@ -273,6 +273,29 @@ func TestWithLimits(t *testing.T) {
 	}
 }
 
+func TestAggResultsDocsCount(t *testing.T) {
+	res := NewAggregateResults(ident.StringID("qux"),
+		AggregateResultsOptions{IncludeDocsCount: true}, testOpts)
+
+	first := genResultsEntry("foo", "bar", "baz")
+	first.DocsCounts = []int64{3, 1}
+	second := genResultsEntry("foo", "bar")
+	second.DocsCounts = []int64{2}
+	res.AddFields(entries(first, second))
+
+	aggVals, ok := res.Map().Get(ident.StringID("foo"))
+	require.True(t, ok)
+	require.Equal(t, 2, aggVals.Size())
+
+	docs, ok := aggVals.Map().Get(ident.StringID("bar"))
+	require.True(t, ok)
+	require.Equal(t, int64(5), docs)
+
+	docs, ok = aggVals.Map().Get(ident.StringID("baz"))
+	require.True(t, ok)
+	require.Equal(t, int64(1), docs)
+}
+
 func TestAggResultsReset(t *testing.T) {
 	res := NewAggregateResults(ident.StringID("qux"),
 		AggregateResultsOptions{}, testOpts)
@@ -58,8 +58,9 @@ func (v *AggregateValues) HasValues() bool {
 	return v.hasValues
 }
 
-// Map returns a map from an ID -> empty struct to signify existence of the
-// ID in the set this structure represents.
+// Map returns a map from an ID -> documents count to signify existence of the
+// ID in the set this structure represents, the documents count is only
+// tracked when requested by the aggregate query and zero otherwise.
 func (v *AggregateValues) Map() *AggregateValuesMap {
 	return v.valuesMap
 }
@@ -95,6 +96,6 @@ func (v *AggregateValues) addValue(value ident.ID) error {
 	bytesID := ident.BytesID(value.Bytes())
 
 	// NB: fine to overwrite the values here.
-	v.valuesMap.Set(bytesID, struct{}{})
+	v.valuesMap.Set(bytesID, 0)
 	return nil
 }
@@ -36,6 +36,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
 	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/m3ninx/doc"
@@ -49,6 +50,7 @@ import (
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/mmap"
 	xresource "github.com/m3db/m3/src/x/resource"
 	xsync "github.com/m3db/m3/src/x/sync"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -677,6 +679,7 @@ func (b *block) AggregateIter(ctx context.Context, aggOpts AggregateResultsOptio
 	iterateOpts := fieldsAndTermsIteratorOpts{
 		restrictByQuery: aggOpts.RestrictByQuery,
 		iterateTerms:    aggOpts.Type == AggregateTagNamesAndValues,
+		countDocs:       aggOpts.IncludeDocsCount && aggOpts.Type == AggregateTagNamesAndValues,
 		allowFn: func(field []byte) bool {
 			// skip any field names that we shouldn't allow.
 			if bytes.Equal(field, doc.IDReservedFieldName) {
@@ -834,8 +837,13 @@ func (b *block) aggregateWithSpan(
 			}
 		}
 
+		iterOpts := iter.fieldsAndTermsIteratorOpts()
+		var termDocs int
+		if iterOpts.countDocs {
+			termDocs = iter.CurrentDocsCount()
+		}
 		batch, fieldAppended, termAppended = b.appendFieldAndTermToBatch(batch, field, term,
-			iter.fieldsAndTermsIteratorOpts().iterateTerms)
+			iterOpts.iterateTerms, iterOpts.countDocs, termDocs)
 		if fieldAppended {
 			currFields++
 		}
@@ -882,6 +890,8 @@ func (b *block) appendFieldAndTermToBatch(
 	batch []AggregateResultsEntry,
 	field, term []byte,
 	includeTerms bool,
+	includeDocsCount bool,
+	docsCount int,
 ) ([]AggregateResultsEntry, bool, bool) {
 	// NB(prateek): we make a copy of the (field, term) entries returned
 	// by the iterator during traversal, because the []byte are only valid per entry during
@@ -933,6 +943,9 @@ func (b *block) appendFieldAndTermToBatch(
 		// since we are pushing/popping characters from the stack as we iterate
 		// the terms FST and reusing the same byte slice.
 		entry.Terms = append(entry.Terms, b.pooledID(term))
+		if includeDocsCount {
+			entry.DocsCounts = append(entry.DocsCounts, int64(docsCount))
+		}
 	}
 
 	if reuseLastEntry {
@@ -1083,6 +1096,111 @@ func (b *block) addResults(
 	return multiErr.FinalError()
 }
 
+// removedDocumentsFilter filters the documents of the removed IDs out of
+// the compacted segments.
+type removedDocumentsFilter map[string]struct{}
+
+func (f removedDocumentsFilter) ContainsDoc(d doc.Metadata) bool {
+	_, removed := f[string(d.ID)]
+	return !removed
+}
+
+func (f removedDocumentsFilter) OnDuplicateDoc(d doc.Metadata) {}
+
+func (b *block) RemoveDocuments(ids [][]byte) error {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.state == blockStateClosed {
+		return nil
+	}
+
+	removed := make(removedDocumentsFilter, len(ids))
+	for _, id := range ids {
+		removed[string(id)] = struct{}{}
+	}
+
+	var compactor *compaction.Compactor
+	defer func() {
+		if compactor != nil {
+			_ = compactor.Close()
+		}
+	}()
+
+	// NB: Only the segments added to the block are rewritten, the documents
+	// of the mutable segments are garbage collected once their series are
+	// removed from the shards.
+	multiErr := xerrors.NewMultiError()
+	for _, groups := range b.shardRangesSegmentsByVolumeType {
+		for i := range groups {
+			segments := make([]segment.Segment, 0, len(groups[i].segments))
+			for j, seg := range groups[i].segments {
+				contains, err := segmentContainsAnyID(seg, ids)
+				if err != nil {
+					groups[i].segments = append(segments, groups[i].segments[j:]...)
+					return err
+				}
+				if !contains {
+					segments = append(segments, seg)
+					continue
+				}
+
+				if compactor == nil {
+					compactor, err = compaction.NewCompactor(b.opts.MetadataArrayPool(),
+						MetadataArrayPoolCapacity,
+						b.opts.SegmentBuilderOptions(),
+						b.opts.FSTSegmentOptions(),
+						compaction.CompactorOptions{
+							MmapDocsData: b.blockOpts.BackgroundCompactorMmapDocsData,
+						})
+					if err != nil {
+						groups[i].segments = append(segments, groups[i].segments[j:]...)
+						return err
+					}
+				}
+
+				result, err := compactor.Compact([]segment.Segment{seg}, removed,
+					mmap.ReporterOptions{
+						Context: mmap.Context{
+							Name: mmapIndexBlockName,
+						},
+						Reporter: b.opts.MmapReporter(),
+					})
+				if err != nil && !errors.Is(err, compaction.ErrCompactorBuilderEmpty) {
+					// Keep the segments that are yet to be rewritten.
+					groups[i].segments = append(segments, groups[i].segments[j:]...)
+					return err
+				}
+				multiErr = multiErr.Add(seg.Close())
+				if err != nil {
+					// All of the documents of the segment were removed.
+					continue
+				}
+				segments = append(segments, NewReadThroughSegment(result.Compacted,
+					ReadThroughSegmentCaches{
+						SegmentPostingsListCache: b.opts.PostingsListCache(),
+						SearchPostingsListCache:  b.opts.SearchPostingsListCache(),
+					}, b.opts.ReadThroughSegmentOptions()))
+			}
+			groups[i].segments = segments
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func segmentContainsAnyID(seg segment.Segment, ids [][]byte) (bool, error) {
+	for _, id := range ids {
+		contains, err := seg.ContainsID(id)
+		if err != nil {
+			return false, err
+		}
+		if contains {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (b *block) Tick(c context.Cancellable) (BlockTickResult, error) {
 	b.Lock()
 	defer b.Unlock()
@@ -47,6 +47,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
+	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/m3ninx/search"
@@ -1071,6 +1072,46 @@ func TestBlockAddResultsAfterSealWorks(t *testing.T) {
 	require.Equal(t, seg1, shardRangesSegments[0].segments[0])
 }
 
+func TestBlockRemoveDocuments(t *testing.T) {
+	testMD := newTestNSMetadata(t)
+	start := xtime.Now().Truncate(time.Hour)
+	blk, err := NewBlock(start, testMD, BlockOptions{},
+		namespace.NewRuntimeOptionsManager("foo"), testOpts)
+	require.NoError(t, err)
+	defer blk.Close()
+
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	memSeg := testSegment(t, testDoc1(), testDoc2()).(segment.MutableSegment)
+	fstSeg := fst.ToTestSegment(t, memSeg, testFstOptions)
+	results := result.NewIndexBlockByVolumeType(start)
+	results.SetBlock(idxpersist.DefaultIndexVolumeType,
+		result.NewIndexBlock([]result.Segment{result.NewSegment(fstSeg, false)},
+			result.NewShardTimeRangesFromRange(start, start.Add(time.Hour), 1, 2, 3)))
+	require.NoError(t, b.AddResults(results))
+
+	// Segments without the documents are left untouched.
+	require.NoError(t, b.RemoveDocuments([][]byte{[]byte("unknown")}))
+	segments := b.shardRangesSegmentsByVolumeType[idxpersist.DefaultIndexVolumeType][0].segments
+	require.Len(t, segments, 1)
+
+	require.NoError(t, b.RemoveDocuments([][]byte{testDoc1().ID}))
+	segments = b.shardRangesSegmentsByVolumeType[idxpersist.DefaultIndexVolumeType][0].segments
+	require.Len(t, segments, 1)
+	contains, err := segments[0].ContainsID(testDoc1().ID)
+	require.NoError(t, err)
+	require.False(t, contains)
+	contains, err = segments[0].ContainsID(testDoc2().ID)
+	require.NoError(t, err)
+	require.True(t, contains)
+
+	// Segments left without documents are dropped.
+	require.NoError(t, b.RemoveDocuments([][]byte{testDoc2().ID}))
+	segments = b.shardRangesSegmentsByVolumeType[idxpersist.DefaultIndexVolumeType][0].segments
+	require.Empty(t, segments)
+}
+
 func TestBlockTickSingleSegment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -40,8 +40,13 @@ var errUnpackBitmapFromPostingsList = errors.New("unable to unpack bitmap from p
 type fieldsAndTermsIteratorOpts struct {
 	restrictByQuery *Query
 	iterateTerms    bool
-	allowFn         allowFn
-	fieldIterFn     newFieldIterFn
+	countDocs       bool
+	// duplicateDocs are the postings IDs of the segment's documents which
+	// are also present in another segment already iterated, these are
+	// excluded from the documents counts so each document is counted once.
+	duplicateDocs *pilosaroaring.Bitmap
+	allowFn       allowFn
+	fieldIterFn   newFieldIterFn
 }
 
 func (o fieldsAndTermsIteratorOpts) allow(f []byte) bool {
@@ -71,9 +76,10 @@ type fieldsAndTermsIter struct {
 	termIter  segment.TermsIterator
 
 	current struct {
-		field    []byte
-		term     []byte
-		postings postings.List
+		field     []byte
+		term      []byte
+		postings  postings.List
+		docsCount int
 	}
 
 	restrictByPostings *pilosaroaring.Bitmap
@@ -136,6 +142,9 @@ func newFieldsAndTermsIterator(
 		return nil, errUnpackBitmapFromPostingsList
 	}
 
+	if opts.countDocs && opts.duplicateDocs != nil {
+		bitmap = bitmap.Difference(opts.duplicateDocs)
+	}
 	iter.restrictByPostings = bitmap
 	return iter, nil
 }
@@ -230,6 +239,13 @@ func (fti *fieldsAndTermsIter) nextTermsIterResult() (bool, error) {
 		fti.current.term, fti.current.postings = fti.termIter.Current()
 		if fti.restrictByPostings == nil {
 			// No restrictions.
+			if fti.opts.countDocs {
+				docsCount, err := fti.uniqueDocsCount(fti.current.postings)
+				if err != nil {
+					return false, err
+				}
+				fti.current.docsCount = docsCount
+			}
 			return true, nil
 		}
 
@@ -245,6 +261,7 @@ func (fti *fieldsAndTermsIter) nextTermsIterResult() (bool, error) {
 		// counting results and also does not allocate.
 		if n := fti.restrictByPostings.IntersectionCount(bitmap); n > 0 {
 			// Matches, this is next result.
+			fti.current.docsCount = int(n)
 			return true, nil
 		}
 	}
@@ -259,6 +276,19 @@ func (fti *fieldsAndTermsIter) nextTermsIterResult() (bool, error) {
 	return false, nil
 }
 
+// uniqueDocsCount returns the number of documents in the postings list
+// which are not also present in a previously iterated segment.
+func (fti *fieldsAndTermsIter) uniqueDocsCount(pl postings.List) (int, error) {
+	if fti.opts.duplicateDocs == nil {
+		return pl.Len(), nil
+	}
+	bitmap, ok := roaring.BitmapFromPostingsList(pl)
+	if !ok {
+		return 0, errUnpackBitmapFromPostingsList
+	}
+	return pl.Len() - int(fti.opts.duplicateDocs.IntersectionCount(bitmap)), nil
+}
+
 func (fti *fieldsAndTermsIter) Next() bool {
 	if fti.err != nil {
 		return false
@@ -275,6 +305,10 @@ func (fti *fieldsAndTermsIter) Current() (field, term []byte) {
 	return fti.current.field, fti.current.term
 }
 
+func (fti *fieldsAndTermsIter) CurrentDocsCount() int {
+	return fti.current.docsCount
+}
+
 func (fti *fieldsAndTermsIter) Err() error {
 	return fti.err
 }
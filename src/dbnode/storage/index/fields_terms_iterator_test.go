@@ -271,6 +271,137 @@ func TestFieldsTermsIteratorIterateTermsAndRestrictByQuery(t *testing.T) {
 	}, slice)
 }
 
+func TestFieldsTermsIteratorCountDocs(t *testing.T) {
+	ctx := context.NewBackground()
+
+	testDocs := []doc.Metadata{
+		{Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("yellow")},
+			{Name: []byte("fruit"), Value: []byte("banana")},
+		}},
+		{Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("red")},
+			{Name: []byte("fruit"), Value: []byte("apple")},
+		}},
+		{Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("yellow")},
+			{Name: []byte("fruit"), Value: []byte("pineapple")},
+		}},
+	}
+
+	seg, err := mem.NewSegment(mem.NewOptions())
+	require.NoError(t, err)
+	require.NoError(t, seg.InsertBatch(m3ninxindex.Batch{
+		Docs:                testDocs,
+		AllowPartialUpdates: true,
+	}))
+	require.NoError(t, seg.Seal())
+
+	allowNonIDFields := func(field []byte) bool {
+		return !bytes.Equal(field, doc.IDReservedFieldName)
+	}
+	countDocs := func(opts fieldsAndTermsIteratorOpts) map[string]int {
+		reader, err := seg.Reader()
+		require.NoError(t, err)
+
+		iter, err := newFieldsAndTermsIterator(ctx, reader, opts)
+		require.NoError(t, err)
+
+		counts := make(map[string]int)
+		for iter.Next() {
+			field, term := iter.Current()
+			counts[string(field)+"="+string(term)] = iter.CurrentDocsCount()
+		}
+		require.NoError(t, iter.Err())
+		require.NoError(t, iter.Close())
+		return counts
+	}
+
+	require.Equal(t, map[string]int{
+		"color=red":       1,
+		"color=yellow":    2,
+		"fruit=apple":     1,
+		"fruit=banana":    1,
+		"fruit=pineapple": 1,
+	}, countDocs(fieldsAndTermsIteratorOpts{
+		iterateTerms: true,
+		countDocs:    true,
+		allowFn:      allowNonIDFields,
+	}))
+
+	fruitRegexp, err := idx.NewRegexpQuery([]byte("fruit"), []byte("^.*apple$"))
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int{
+		"color=red":       1,
+		"color=yellow":    1,
+		"fruit=apple":     1,
+		"fruit=pineapple": 1,
+	}, countDocs(fieldsAndTermsIteratorOpts{
+		iterateTerms:    true,
+		countDocs:       true,
+		allowFn:         allowNonIDFields,
+		restrictByQuery: &Query{Query: fruitRegexp},
+	}))
+}
+
+func TestAggregateIterCountDocsDedupesSegments(t *testing.T) {
+	ctx := context.NewBackground()
+
+	var (
+		yellowBanana = doc.Metadata{ID: []byte("banana"), Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("yellow")},
+		}}
+		redApple = doc.Metadata{ID: []byte("apple"), Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("red")},
+		}}
+		yellowLemon = doc.Metadata{ID: []byte("lemon"), Fields: []doc.Field{
+			{Name: []byte("color"), Value: []byte("yellow")},
+		}}
+	)
+	newReader := func(docs ...doc.Metadata) segment.Reader {
+		seg, err := mem.NewSegment(mem.NewOptions())
+		require.NoError(t, err)
+		require.NoError(t, seg.InsertBatch(m3ninxindex.Batch{
+			Docs:                docs,
+			AllowPartialUpdates: true,
+		}))
+		require.NoError(t, seg.Seal())
+		reader, err := seg.Reader()
+		require.NoError(t, err)
+		return reader
+	}
+
+	// The banana series is present in both segments.
+	iter := &aggregateIter{
+		readers: []segment.Reader{
+			newReader(yellowBanana, redApple),
+			newReader(yellowBanana, yellowLemon),
+		},
+		iterateOpts: fieldsAndTermsIteratorOpts{
+			iterateTerms: true,
+			countDocs:    true,
+			allowFn: func(field []byte) bool {
+				return !bytes.Equal(field, doc.IDReservedFieldName)
+			},
+		},
+		newIterFn: newFieldsAndTermsIterator,
+	}
+
+	counts := make(map[string]int)
+	for iter.Next(ctx) {
+		field, term := iter.Current()
+		counts[string(field)+"="+string(term)] += iter.CurrentDocsCount()
+	}
+	require.NoError(t, iter.Err())
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, map[string]int{
+		"color=red":    1,
+		"color=yellow": 2,
+	}, counts)
+}
+
 type terms struct {
 	values   []term
 	postings postings.List
@@ -1024,6 +1024,20 @@ func (mr *MockBlockMockRecorder) QueryWithIter(ctx, opts, iter, results, deadlin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWithIter", reflect.TypeOf((*MockBlock)(nil).QueryWithIter), ctx, opts, iter, results, deadline, logFields)
 }
 
+// RemoveDocuments mocks base method.
+func (m *MockBlock) RemoveDocuments(ids [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDocuments", ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDocuments indicates an expected call of RemoveDocuments.
+func (mr *MockBlockMockRecorder) RemoveDocuments(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDocuments", reflect.TypeOf((*MockBlock)(nil).RemoveDocuments), ids)
+}
+
 // RotateColdMutableSegments mocks base method.
 func (m *MockBlock) RotateColdMutableSegments() error {
 	m.ctrl.T.Helper()
@@ -1380,6 +1394,20 @@ func (mr *MockAggregateIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockAggregateIterator)(nil).Current))
 }
 
+// CurrentDocsCount mocks base method.
+func (m *MockAggregateIterator) CurrentDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CurrentDocsCount indicates an expected call of CurrentDocsCount.
+func (mr *MockAggregateIteratorMockRecorder) CurrentDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentDocsCount", reflect.TypeOf((*MockAggregateIterator)(nil).CurrentDocsCount))
+}
+
 // Done mocks base method.
 func (m *MockAggregateIterator) Done() bool {
 	m.ctrl.T.Helper()
@@ -1606,6 +1634,20 @@ func (mr *MockfieldsAndTermsIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).Current))
 }
 
+// CurrentDocsCount mocks base method.
+func (m *MockfieldsAndTermsIterator) CurrentDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CurrentDocsCount indicates an expected call of CurrentDocsCount.
+func (mr *MockfieldsAndTermsIteratorMockRecorder) CurrentDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentDocsCount", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).CurrentDocsCount))
+}
+
 // Err mocks base method.
 func (m *MockfieldsAndTermsIterator) Err() error {
 	m.ctrl.T.Helper()
@@ -322,6 +322,23 @@ func (s *readThroughSegmentReader) MatchField(field []byte) (postings.List, erro
 	return pl, err
 }
 
+// MatchPrefix is a pass through call, prefix postings lists are only cached
+// as part of the searches that use them.
+func (s *readThroughSegmentReader) MatchPrefix(
+	field, prefix []byte,
+) (postings.List, error) {
+	return s.reader.MatchPrefix(field, prefix)
+}
+
+// MatchRange is a pass through call, range postings lists are only cached
+// as part of the searches that use them.
+func (s *readThroughSegmentReader) MatchRange(
+	field []byte,
+	r index.NumericRange,
+) (postings.List, error) {
+	return s.reader.MatchRange(field, r)
+}
+
 // MatchAll is a pass through call, since there's no postings list to cache.
 // NB(r): The postings list returned by match all is just an iterator
 // from zero to the maximum document number indexed by the segment and as such
@@ -44,6 +44,7 @@ type results struct {
 	opts QueryResultsOptions
 
 	reusableID     *ident.ReusableBytesID
+	reusableReader *docs.EncodedDocumentReader
 	resultsMap     *ResultsMap
 	totalDocsCount int
 
@@ -141,6 +142,20 @@ func (r *results) addDocumentWithLock(w doc.Document) (bool, int, error) {
 		return false, r.resultsMap.Len(), nil
 	}
 
+	// Then apply the document filter if set, which requires the tags.
+	if r.opts.FilterDocument != nil {
+		if r.reusableReader == nil {
+			r.reusableReader = docs.NewEncodedDocumentReader()
+		}
+		metadata, err := docs.MetadataFromDocument(w, r.reusableReader)
+		if err != nil {
+			return false, r.resultsMap.Len(), err
+		}
+		if !r.opts.FilterDocument(metadata) {
+			return false, r.resultsMap.Len(), nil
+		}
+	}
+
 	// It is assumed that the document is valid for the lifetime of the index
 	// results.
 	r.resultsMap.SetUnsafe(id, w, resultMapNoFinalizeOpts)
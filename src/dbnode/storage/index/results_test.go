@@ -113,6 +113,35 @@ func TestResultsInsertBatchOfTwo(t *testing.T) {
 	require.Equal(t, 2, res.TotalDocsCount())
 }
 
+func TestResultsInsertFilterDocument(t *testing.T) {
+	res := NewQueryResults(nil, QueryResultsOptions{
+		FilterDocument: func(d doc.Metadata) bool {
+			for _, f := range d.Fields {
+				if string(f.Name) == "app" && string(f.Value) == "debug" {
+					return false
+				}
+			}
+			return true
+		},
+	}, testOpts)
+	d1 := doc.Metadata{ID: []byte("d1"), Fields: []doc.Field{
+		{Name: []byte("app"), Value: []byte("debug")},
+	}}
+	d2 := doc.Metadata{ID: []byte("d2"), Fields: []doc.Field{
+		{Name: []byte("app"), Value: []byte("slo")},
+	}}
+	size, docsCount, err := res.AddDocuments([]doc.Document{
+		doc.NewDocumentFromMetadata(d1),
+		doc.NewDocumentFromMetadata(d2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, size)
+	require.Equal(t, 2, docsCount)
+
+	_, ok := res.Map().Get(d2.ID)
+	require.True(t, ok)
+}
+
 func TestResultsFirstInsertWins(t *testing.T) {
 	res := NewQueryResults(nil, QueryResultsOptions{}, testOpts)
 	d1 := doc.Metadata{ID: []byte("abc")}
@@ -94,6 +94,9 @@ type QueryOptions struct {
 	IterateEqualTimestampStrategy *encoding.IterateEqualTimestampStrategy
 	// Source is an optional query source.
 	Source []byte
+	// FilterID, if provided, excludes any IDs for which it returns false
+	// from the query results. It is only honored by the local index.
+	FilterID func(id ident.ID) bool
 }
 
 // IterationOptions enables users to specify iteration preferences.
@@ -111,6 +114,12 @@ type AggregationOptions struct {
 	FieldFilter AggregateFieldFilter
 	// Type indicates the aggregation type.
 	Type AggregationType
+	// IncludeDocsCount indicates whether to count the documents matching
+	// each aggregated term.
+	IncludeDocsCount bool
+	// ExcludeQuery, if provided, excludes the documents it matches from the
+	// aggregation. It is only honored by the local index.
+	ExcludeQuery *Query
 }
 
 // QueryResult is the collection of results for a query.
@@ -220,6 +229,9 @@ type QueryResultsOptions struct {
 	// NB(r): This is used to filter out results from shards the DB node
 	// node no longer owns but is still included in index segments.
 	FilterID func(id ident.ID) bool
+	// FilterDocument, if provided, can be used to filter out unwanted
+	// documents from the query results based on their tags.
+	FilterDocument func(d doc.Metadata) bool
 }
 
 // QueryResultsAllocator allocates QueryResults types.
@@ -292,6 +304,11 @@ type AggregateResultsOptions struct {
 	// be present for an aggregated term to be returned.
 	RestrictByQuery *Query
 
+	// IncludeDocsCount tracks the number of documents matching each
+	// aggregated term, documents present in multiple segments of a block
+	// are counted once and the counts are summed across blocks.
+	IncludeDocsCount bool
+
 	// AggregateUsageMetrics are aggregate usage metrics that track field
 	// and term counts for aggregate queries.
 	AggregateUsageMetrics AggregateUsageMetrics
@@ -346,6 +363,9 @@ type AggregateValuesPool interface {
 type AggregateResultsEntry struct {
 	Field ident.ID
 	Terms []ident.ID
+	// DocsCounts is the number of documents matching each of the terms,
+	// only set when documents counts are requested.
+	DocsCounts []int64
 }
 
 // Block represents a collection of segments. Each `Block` is a complete reverse
@@ -389,6 +409,10 @@ type Block interface {
 	// AddResults adds bootstrap results to the block.
 	AddResults(resultsByVolumeType result.IndexBlockByVolumeType) error
 
+	// RemoveDocuments removes the documents with the provided IDs from the
+	// segments added to the block.
+	RemoveDocuments(ids [][]byte) error
+
 	// Tick does internal house keeping operations.
 	Tick(c context.Cancellable) (BlockTickResult, error)
 
@@ -921,6 +945,10 @@ type AggregateIterator interface {
 	// Current returns the current (field, term).
 	Current() (field, term []byte)
 
+	// CurrentDocsCount returns the number of documents matching the current
+	// (field, term), only tracked when documents counts are requested.
+	CurrentDocsCount() int
+
 	fieldsAndTermsIteratorOpts() fieldsAndTermsIteratorOpts
 }
 
@@ -957,6 +985,10 @@ type fieldsAndTermsIterator interface {
 	// NB: the element returned is only valid until the subsequent call to Next().
 	Current() (field, term []byte)
 
+	// CurrentDocsCount returns the number of documents matching the current
+	// element, only tracked when the iterator is configured to count documents.
+	CurrentDocsCount() int
+
 	// Err returns any errors encountered during iteration.
 	Err() error
 
@@ -440,3 +440,35 @@ func TestNamespaceIndexInsertAggregateQuery(t *testing.T) {
 	require.Equal(t, 1, vMap.Len())
 	assert.True(t, vMap.Contains(ident.StringID("value")))
 }
+
+func TestNamespaceIndexInsertAggregateQueryExcludeQuery(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+	defer leaktest.CheckTimeout(t, 2*time.Second)()
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	now := xtime.Now()
+	idx := setupIndex(t, ctrl, now, true)
+	defer idx.Close()
+
+	reQuery, err := m3ninxidx.NewRegexpQuery([]byte("name"), []byte("val.*"))
+	require.NoError(t, err)
+	res, err := idx.AggregateQuery(ctx, index.Query{Query: reQuery},
+		index.AggregationOptions{
+			QueryOptions: index.QueryOptions{
+				StartInclusive: now.Add(-1 * time.Minute),
+				EndExclusive:   now.Add(1 * time.Minute),
+			},
+			ExcludeQuery: &index.Query{
+				Query: m3ninxidx.NewTermQuery(doc.IDReservedFieldName, []byte("foo")),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The only series holding name=value is excluded, so no terms remain.
+	assert.True(t, res.Exhaustive)
+	assert.Equal(t, 0, res.Results.Map().Len())
+}
@@ -491,6 +491,41 @@ func TestNamespaceIndexCleanupExpiredFilesetsWithBlocks(t *testing.T) {
 	require.NoError(t, idx.CleanupExpiredFileSets(now))
 }
 
+func TestNamespaceIndexRemoveSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	md := testNamespaceMetadata(time.Hour, time.Hour*8)
+	nsIdx, err := newNamespaceIndex(md,
+		namespace.NewRuntimeOptionsManager(md.ID().String()),
+		testShardSet, DefaultTestOptions())
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, nsIdx.Close())
+	}()
+
+	var (
+		now       = xtime.Now().Truncate(time.Hour)
+		removedAt = now.Add(-time.Minute)
+		idx       = nsIdx.(*nsIndex)
+	)
+
+	// Only the blocks that ended before the series were removed are rewritten.
+	ended := index.NewMockBlock(ctrl)
+	ended.EXPECT().EndTime().Return(now.Add(-time.Hour)).AnyTimes()
+	ended.EXPECT().RemoveDocuments([][]byte{[]byte("foo")}).Return(nil)
+	ended.EXPECT().Close().Return(nil)
+	idx.state.blocksByTime[now.Add(-2*time.Hour)] = ended
+
+	current := index.NewMockBlock(ctrl)
+	current.EXPECT().EndTime().Return(now).AnyTimes()
+	current.EXPECT().Close().Return(nil)
+	idx.state.blocksByTime[now.Add(-time.Hour)] = current
+
+	require.NoError(t, idx.RemoveSeries([]ident.ID{ident.StringID("foo")}, removedAt))
+}
+
 func TestNamespaceIndexCleanupCorruptedFilesets(t *testing.T) {
 	md := testNamespaceMetadata(time.Hour, time.Hour*24)
 	nsIdx, err := newNamespaceIndex(md,
@@ -655,6 +690,32 @@ func TestNamespaceIndexFlushShardStateNotSuccess(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNamespaceIndexRebuildBlockDataNotFlushed(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	test := newTestIndex(t, ctrl)
+
+	now := xtime.Now().Truncate(test.indexBlockSize)
+	idx := test.index.(*nsIndex)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	mockShard := NewMockdatabaseShard(ctrl)
+	mockShard.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	mockShard.EXPECT().FlushState(gomock.Any()).Return(fileOpState{WarmStatus: warmStatus{
+		DataFlushed: fileOpFailed,
+	}}, nil).AnyTimes()
+	shards := []databaseShard{mockShard}
+
+	mockFlush := persist.NewMockIndexFlush(ctrl)
+
+	err := idx.RebuildBlock(mockFlush, now.Add(-2*test.indexBlockSize), shards)
+	require.Equal(t, errDbIndexBlockNotFlushed, err)
+}
+
 func TestNamespaceIndexQueryNoMatchingBlocks(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
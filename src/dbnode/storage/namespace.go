@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
@@ -48,6 +49,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/ts/writes"
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/idx"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
@@ -61,9 +63,12 @@ import (
 )
 
 var (
-	errNamespaceAlreadyClosed    = errors.New("namespace already closed")
-	errNamespaceIndexingDisabled = errors.New("namespace indexing is disabled")
-	errNamespaceReadOnly         = errors.New("cannot write to a read only namespace")
+	errNamespaceAlreadyClosed     = errors.New("namespace already closed")
+	errNamespaceIndexingDisabled  = errors.New("namespace indexing is disabled")
+	errNamespaceReadOnly          = errors.New("cannot write to a read only namespace")
+	errDeleteSeriesInvalidRange   = errors.New("delete series start must be before end")
+	errDeleteSeriesNotExhaustive  = errors.New("delete series query was not exhaustive, retry to delete remaining series")
+	errFetchExemplarsInvalidRange = errors.New("fetch exemplars start must not be after end")
 )
 
 type commitLogWriter interface {
@@ -120,6 +125,7 @@ type dbNamespace struct {
 	metadata           namespace.Metadata
 	nopts              namespace.Options
 	seriesOpts         series.Options
+	retentionOverrides retentionOverrides
 	nowFn              clock.NowFn
 	snapshotFilesFn    snapshotFilesFn
 	log                *zap.Logger
@@ -139,6 +145,12 @@ type dbNamespace struct {
 	commitLogWriter commitLogWriter
 	reverseIndex    NamespaceIndex
 
+	// splitShards contains the shards being split off from a parent shard.
+	splitShards map[uint32]splitShard
+	// splitParents contains the parent shards of completed splits that still
+	// hold the series of the shards split off from them.
+	splitParents map[uint32]struct{}
+
 	createEmptyWarmIndexIfNotExistsFn createEmptyWarmIndexIfNotExistsFn
 
 	tickWorkers            xsync.WorkerPool
@@ -174,6 +186,8 @@ type databaseNamespaceMetrics struct {
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	deleteSeries        instrument.MethodMetrics
+	fetchExemplars      instrument.MethodMetrics
 
 	unfulfilled             tally.Counter
 	bootstrapStart          tally.Counter
@@ -263,6 +277,8 @@ func newDatabaseNamespaceMetrics(
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", opts),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", opts),
+		deleteSeries:        instrument.NewMethodMetrics(scope, "deleteSeries", opts),
+		fetchExemplars:      instrument.NewMethodMetrics(scope, "fetchExemplars", opts),
 
 		unfulfilled:             bootstrapScope.Counter("unfulfilled"),
 		bootstrapStart:          bootstrapScope.Counter("start"),
@@ -354,10 +370,14 @@ func newDatabaseNamespace(
 			metadata.ID().String(), err)
 	}
 
-	var (
-		index NamespaceIndex
-		err   error
-	)
+	retentionOverrides, err := newRetentionOverrides(nopts.RetentionOverrides(), seriesOpts)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to create namespace %v, invalid retention overrides: %v",
+			metadata.ID().String(), err)
+	}
+
+	var index NamespaceIndex
 	if metadata.Options().IndexOptions().Enabled() {
 		index, err = newNamespaceIndex(metadata, namespaceRuntimeOptsMgr,
 			shardSet, opts)
@@ -376,6 +396,7 @@ func newDatabaseNamespace(
 		metadata:               metadata,
 		nopts:                  nopts,
 		seriesOpts:             seriesOpts,
+		retentionOverrides:     retentionOverrides,
 		nowFn:                  opts.ClockOptions().NowFn(),
 		snapshotFilesFn:        fs.SnapshotFiles,
 		log:                    logger,
@@ -544,7 +565,7 @@ func (n *dbNamespace) assignShardSet(
 		// shard created for this shard ID.
 		n.shards[shard] = newDatabaseShard(metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			opts.needsBootstrap, n.opts, n.seriesOpts)
+			opts.needsBootstrap, n.opts, n.seriesOpts, n.retentionOverrides)
 		createdShardIds = append(createdShardIds, shard)
 		// NB(bodu): We only record shard add metrics for shards created in non
 		// initial assignments.
@@ -561,6 +582,8 @@ func (n *dbNamespace) assignShardSet(
 			zap.Bool("needsBootstrap", opts.needsBootstrap))
 	}
 
+	n.updateSplitShardsWithLock(shardSet)
+
 	if idx := n.reverseIndex; idx != nil {
 		idx.AssignShardSet(shardSet)
 	}
@@ -714,7 +737,7 @@ func (n *dbNamespace) Write(
 		return SeriesWrite{}, errNamespaceReadOnly
 	}
 
-	shard, nsCtx, err := n.shardFor(id)
+	shard, splitShard, nsCtx, err := n.writableShardsFor(id)
 	if err != nil {
 		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
 		return SeriesWrite{}, err
@@ -726,6 +749,10 @@ func (n *dbNamespace) Write(
 	}
 	seriesWrite, err := shard.Write(ctx, id, timestamp,
 		value, unit, annotation, opts)
+	if err == nil && splitShard != nil {
+		_, err = splitShard.Write(ctx, id, timestamp,
+			value, unit, annotation, opts)
+	}
 	if err == nil && len(annotation) == 0 {
 		n.metrics.writesWithoutAnnotation.Inc(1)
 	}
@@ -754,7 +781,7 @@ func (n *dbNamespace) WriteTagged(
 		return SeriesWrite{}, errNamespaceIndexingDisabled
 	}
 
-	shard, nsCtx, err := n.shardFor(id)
+	shard, splitShard, nsCtx, err := n.writableShardsFor(id)
 	if err != nil {
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
 		return SeriesWrite{}, err
@@ -766,6 +793,16 @@ func (n *dbNamespace) WriteTagged(
 	}
 	seriesWrite, err := shard.WriteTagged(ctx, id, tagResolver, timestamp,
 		value, unit, annotation, opts)
+	if err == nil && splitShard != nil {
+		var splitWrite SeriesWrite
+		splitWrite, err = splitShard.WriteTagged(ctx, id, tagResolver, timestamp,
+			value, unit, annotation, opts)
+		if err == nil && splitWrite.NeedsIndex {
+			err = n.reverseIndex.WritePending([]writes.PendingIndexInsert{
+				splitWrite.PendingIndexInsert,
+			})
+		}
+	}
 	if err == nil && len(annotation) == 0 {
 		n.metrics.writesWithoutAnnotation.Inc(1)
 	}
@@ -832,6 +869,16 @@ func (n *dbNamespace) QueryIDs(
 			xerrors.NewRetryableError(err)
 	}
 
+	if filter := n.deletedSeriesFilter(opts.StartInclusive, opts.EndExclusive); filter != nil {
+		if existing := opts.FilterID; existing != nil {
+			opts.FilterID = func(id ident.ID) bool {
+				return existing(id) && filter(id)
+			}
+		} else {
+			opts.FilterID = filter
+		}
+	}
+
 	res, err := n.reverseIndex.Query(ctx, query, opts)
 	if err != nil {
 		sp.LogFields(opentracinglog.Error(err))
@@ -840,6 +887,203 @@ func (n *dbNamespace) QueryIDs(
 	return res, err
 }
 
+// deletedSeriesFilter returns a filter that excludes series which have had
+// all of their data in [start, end) deleted, or nil if no series in the
+// namespace have been deleted.
+func (n *dbNamespace) deletedSeriesFilter(
+	start, end xtime.UnixNano,
+) func(id ident.ID) bool {
+	n.RLock()
+	shardSet := n.shardSet
+	shards := make(map[uint32]databaseShard)
+	for _, shardID := range shardSet.AllIDs() {
+		if shard := n.shards[shardID]; shard != nil && shard.HasDeletedSeries() {
+			shards[shardID] = shard
+		}
+	}
+	n.RUnlock()
+
+	if len(shards) == 0 {
+		return nil
+	}
+
+	return func(id ident.ID) bool {
+		shard, ok := shards[shardSet.Lookup(id)]
+		if !ok {
+			return true
+		}
+		return !shard.SeriesDeletedInRange(id, start, end)
+	}
+}
+
+// deletedSeriesExcludeQuery returns a query matching the series whose data
+// in [start, end) has been deleted but whose documents may still be indexed,
+// or nil if there are none. It is bounded by the tombstones limit of the
+// shards and mostly holds partially deleted series, the documents of the
+// series deleted entirely are removed from the index.
+func (n *dbNamespace) deletedSeriesExcludeQuery(
+	start, end xtime.UnixNano,
+) *index.Query {
+	n.RLock()
+	shards := make([]databaseShard, 0, len(n.shards))
+	for _, shard := range n.shards {
+		if shard != nil && shard.HasDeletedSeries() {
+			shards = append(shards, shard)
+		}
+	}
+	n.RUnlock()
+
+	var terms []idx.Query
+	for _, shard := range shards {
+		for _, id := range shard.DeletedSeriesInRange(start, end) {
+			terms = append(terms, idx.NewTermQuery(doc.IDReservedFieldName, id.Bytes()))
+		}
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+	return &index.Query{Query: idx.NewDisjunctionQuery(terms...)}
+}
+
+func (n *dbNamespace) DeleteSeries(
+	ctx context.Context,
+	query index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	callStart := n.nowFn()
+	if !start.Before(end) {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return 0, xerrors.NewInvalidParamsError(errDeleteSeriesInvalidRange)
+	}
+
+	// Only the data within retention that was written before the delete can
+	// be deleted, the data written after the delete stays visible.
+	var (
+		now      = xtime.ToUnixNano(callStart)
+		earliest = retention.FlushTimeStart(n.nopts.RetentionOptions(), now)
+	)
+	if start.Before(earliest) {
+		start = earliest
+	}
+	if end.After(now) {
+		end = now
+	}
+	if !start.Before(end) {
+		n.metrics.deleteSeries.ReportSuccess(n.nowFn().Sub(callStart))
+		return 0, nil
+	}
+
+	res, err := n.QueryIDs(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	var (
+		shards     = make(map[uint32]databaseShard)
+		idsByShard = make(map[uint32][]ident.ID)
+		removedIDs []ident.ID
+		removedAt  xtime.UnixNano
+		deleted    int64
+		multiErr   xerrors.MultiError
+	)
+	if start == earliest && end == now {
+		// All of the data of the series is deleted, so their documents are
+		// removed from the index rather than filtered from query results.
+		removedAt = now
+	}
+
+	for _, entry := range res.Results.Map().Iter() {
+		id := ident.BytesID(entry.Key())
+		shard, _, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		shards[shard.ID()] = shard
+		idsByShard[shard.ID()] = append(idsByShard[shard.ID()], id)
+	}
+
+	// The tombstones of a shard are persisted once per request.
+	r := xtime.Range{Start: start, End: end}
+	for shardID, ids := range idsByShard {
+		if err := shards[shardID].DeleteSeries(ids, r, removedAt); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		deleted += int64(len(ids))
+		removedIDs = append(removedIDs, ids...)
+	}
+
+	if removedAt != 0 && n.reverseIndex != nil && len(removedIDs) > 0 {
+		if err := n.reverseIndex.RemoveSeries(removedIDs, removedAt); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	if !res.Exhaustive {
+		multiErr = multiErr.Add(errDeleteSeriesNotExhaustive)
+	}
+
+	err = multiErr.FinalError()
+	n.metrics.deleteSeries.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return deleted, err
+}
+
+func (n *dbNamespace) FetchExemplars(
+	ctx context.Context,
+	query index.Query,
+	start, end xtime.UnixNano,
+	limit int,
+) ([]SeriesExemplars, error) {
+	callStart := n.nowFn()
+	if end.Before(start) {
+		n.metrics.fetchExemplars.ReportError(n.nowFn().Sub(callStart))
+		return nil, xerrors.NewInvalidParamsError(errFetchExemplarsInvalidRange)
+	}
+
+	// The index query range is end exclusive whereas exemplars are
+	// queried with an inclusive end.
+	res, err := n.QueryIDs(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end.Add(time.Nanosecond),
+		SeriesLimit:    limit,
+	})
+	if err != nil {
+		n.metrics.fetchExemplars.ReportError(n.nowFn().Sub(callStart))
+		return nil, err
+	}
+
+	var (
+		results  []SeriesExemplars
+		multiErr xerrors.MultiError
+	)
+	for _, entry := range res.Results.Map().Iter() {
+		id := ident.BytesID(entry.Key())
+		shard, _, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		exemplars := shard.FetchExemplars(id, start, end)
+		if len(exemplars) == 0 {
+			continue
+		}
+		results = append(results, SeriesExemplars{
+			ID:        id,
+			Document:  entry.Value(),
+			Exemplars: exemplars,
+		})
+	}
+
+	err = multiErr.FinalError()
+	n.metrics.fetchExemplars.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return results, err
+}
+
 func (n *dbNamespace) AggregateQuery(
 	ctx context.Context,
 	query index.Query,
@@ -858,6 +1102,11 @@ func (n *dbNamespace) AggregateQuery(
 			xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
 	}
 
+	// Exclude the label names and values only held by deleted series.
+	if exclude := n.deletedSeriesExcludeQuery(opts.StartInclusive, opts.EndExclusive); exclude != nil {
+		opts.ExcludeQuery = exclude
+	}
+
 	res, err := n.reverseIndex.AggregateQuery(ctx, query, opts)
 	n.metrics.aggregateQuery.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return res, err
@@ -1071,6 +1320,14 @@ func (n *dbNamespace) Bootstrap(
 			zap.Int("numIndexBlocks", len(indexResults)))
 		err := n.reverseIndex.Bootstrap(indexResults)
 		multiErr = multiErr.Add(err)
+
+		// The bootstrapped index segments may still hold the documents of the
+		// series removed since they were flushed.
+		for _, shard := range n.OwnedShards() {
+			for removedAt, ids := range shard.RemovedSeries() {
+				multiErr = multiErr.Add(n.reverseIndex.RemoveSeries(ids, removedAt))
+			}
+		}
 	}
 
 	markAnyUnfulfilled := func(
@@ -1149,6 +1406,12 @@ func (n *dbNamespace) WarmFlush(
 			continue
 		}
 
+		// Blocks of shards being split off from a parent shard that started before
+		// they received every write are split from the parent fileset below.
+		if n.isSplitShardBlock(shard, blockStart) {
+			continue
+		}
+
 		flushState, err := shard.FlushState(blockStart)
 		if err != nil {
 			return err
@@ -1167,6 +1430,10 @@ func (n *dbNamespace) WarmFlush(
 		}
 	}
 
+	if err := n.splitWarmFlushedBlocks(blockStart, shards); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
 	res := multiErr.FinalError()
 	n.metrics.flushWarmData.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
 	return res
@@ -1320,6 +1587,67 @@ func (n *dbNamespace) ColdFlush(flushPersist persist.FlushPreparer) error {
 	return res
 }
 
+func (n *dbNamespace) CompactBlocks(now xtime.UnixNano) error {
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		return errNamespaceNotBootstrapped
+	}
+	nsCtx := n.nsContextWithRLock()
+	repairsAny := n.repairsAny
+	n.RUnlock()
+
+	// Cold flushes write new volumes of the blocks they flush, so blocks are
+	// only compacted for namespaces that never cold flush. Blocks are also
+	// bootstrapped into memory from their filesets when caching all series,
+	// which requires filesets with the namespace block size.
+	if n.ReadOnly() || !n.nopts.FlushEnabled() || n.nopts.ColdWritesEnabled() || repairsAny ||
+		n.opts.SeriesCachePolicy() == series.CacheAll || len(n.opts.BlockCompactionTiers()) == 0 {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range n.OwnedShards() {
+		if !shard.IsBootstrapped() {
+			continue
+		}
+		if err := shard.CompactBlocks(now, nsCtx); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (n *dbNamespace) OffloadBlocks(now xtime.UnixNano) error {
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		return errNamespaceNotBootstrapped
+	}
+	repairsAny := n.repairsAny
+	n.RUnlock()
+
+	// Offloaded filesets are never written to again, so blocks are only
+	// offloaded for namespaces that never cold flush. Blocks are also
+	// bootstrapped into memory from their filesets when caching all series,
+	// which requires filesets on local disk.
+	if n.ReadOnly() || !n.nopts.FlushEnabled() || n.nopts.ColdWritesEnabled() || repairsAny ||
+		n.opts.SeriesCachePolicy() == series.CacheAll || n.opts.FileSetOffloadAge() <= 0 {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range n.OwnedShards() {
+		if !shard.IsBootstrapped() {
+			continue
+		}
+		if err := shard.OffloadBlocks(now); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (n *dbNamespace) FlushIndex(flush persist.IndexFlush) error {
 	callStart := n.nowFn()
 	n.RLock()
@@ -1403,6 +1731,17 @@ func (n *dbNamespace) Snapshot(
 	return res
 }
 
+func (n *dbNamespace) RemoveCompactedTombstones(snapshotStart xtime.UnixNano) error {
+	var multiErr xerrors.MultiError
+	for _, shard := range n.OwnedShards() {
+		if err := shard.RemoveCompactedTombstones(snapshotStart); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to remove compacted tombstones: %w", shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (n *dbNamespace) NeedsFlush(
 	alignedInclusiveStart xtime.UnixNano,
 	alignedInclusiveEnd xtime.UnixNano,
@@ -1634,6 +1973,23 @@ func (n *dbNamespace) shardFor(id ident.ID) (databaseShard, namespace.Context, e
 	return shard, nsCtx, err
 }
 
+// writableShardsFor returns the shard for the given identifier and the shard
+// being split off from it that the identifier also needs to be written to, if any.
+func (n *dbNamespace) writableShardsFor(
+	id ident.ID,
+) (databaseShard, databaseShard, namespace.Context, error) {
+	n.RLock()
+	nsCtx := n.nsContextWithRLock()
+	shardID := n.shardSet.Lookup(id)
+	shard, _, err := n.shardAtWithRLock(shardID)
+	var splitShard databaseShard
+	if err == nil {
+		splitShard, _ = n.splitShardForWithRLock(id, shardID)
+	}
+	n.RUnlock()
+	return shard, splitShard, nsCtx, err
+}
+
 func (n *dbNamespace) readableShardFor(id ident.ID) (databaseShard, namespace.Context, error) {
 	n.RLock()
 	nsCtx := n.nsContextWithRLock()
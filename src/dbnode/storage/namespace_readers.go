@@ -24,6 +24,7 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"sync"
+	"time"
 
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
@@ -58,10 +59,13 @@ const (
 )
 
 type databaseNamespaceReaderManager interface {
+	// filesetExistsAt returns whether a fileset holds the block and the block
+	// start of that fileset, which is the start of the larger block the block
+	// was compacted into if the block was compacted.
 	filesetExistsAt(
 		shard uint32,
 		blockStart xtime.UnixNano,
-	) (bool, error)
+	) (xtime.UnixNano, bool, error)
 
 	get(
 		shard uint32,
@@ -86,6 +90,15 @@ type fsFileSetExistsFn func(
 	volume int,
 ) (bool, error)
 
+type fsCompactedFileSetBlockStartFn func(
+	prefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart xtime.UnixNano,
+	volume int,
+	compactedBlockSizes []time.Duration,
+) (xtime.UnixNano, bool, error)
+
 type fsNewReaderFn func(
 	bytesPool pool.CheckedBytesPool,
 	opts fs.Options,
@@ -94,8 +107,10 @@ type fsNewReaderFn func(
 type namespaceReaderManager struct {
 	sync.Mutex
 
-	filesetExistsFn fsFileSetExistsFn
-	newReaderFn     fsNewReaderFn
+	filesetExistsFn              fsFileSetExistsFn
+	filesetOffloadedFn           fsFileSetExistsFn
+	compactedFileSetBlockStartFn fsCompactedFileSetBlockStartFn
+	newReaderFn                  fsNewReaderFn
 
 	namespace         namespace.Metadata
 	fsOpts            fs.Options
@@ -155,15 +170,17 @@ func newNamespaceReaderManager(
 ) databaseNamespaceReaderManager {
 	blm := opts.BlockLeaseManager()
 	mgr := &namespaceReaderManager{
-		filesetExistsFn:   fs.DataFileSetExists,
-		newReaderFn:       fs.NewReader,
-		namespace:         namespace,
-		fsOpts:            opts.CommitLogOptions().FilesystemOptions(),
-		blockLeaseManager: blm,
-		bytesPool:         opts.BytesPool(),
-		logger:            opts.InstrumentOptions().Logger(),
-		openReaders:       make(map[cachedOpenReaderKey]cachedReader),
-		metrics:           newNamespaceReaderManagerMetrics(namespaceScope),
+		filesetExistsFn:              fs.DataFileSetExists,
+		filesetOffloadedFn:           fs.DataFileSetOffloaded,
+		compactedFileSetBlockStartFn: fs.CompactedDataFileSetBlockStart,
+		newReaderFn:                  fs.NewReader,
+		namespace:                    namespace,
+		fsOpts:                       opts.CommitLogOptions().FilesystemOptions(),
+		blockLeaseManager:            blm,
+		bytesPool:                    opts.BytesPool(),
+		logger:                       opts.InstrumentOptions().Logger(),
+		openReaders:                  make(map[cachedOpenReaderKey]cachedReader),
+		metrics:                      newNamespaceReaderManagerMetrics(namespaceScope),
 	}
 
 	blm.RegisterLeaser(mgr)
@@ -190,14 +207,22 @@ func (m *namespaceReaderManager) latestVolume(
 func (m *namespaceReaderManager) filesetExistsAt(
 	shard uint32,
 	blockStart xtime.UnixNano,
-) (bool, error) {
+) (xtime.UnixNano, bool, error) {
 	latestVolume, err := m.latestVolume(shard, blockStart)
 	if err != nil {
-		return false, err
+		return 0, false, err
 	}
 
-	return m.filesetExistsFn(m.fsOpts.FilePathPrefix(),
+	exists, err := m.filesetExistsFn(m.fsOpts.FilePathPrefix(),
 		m.namespace.ID(), shard, blockStart, latestVolume)
+	if err != nil || exists {
+		return blockStart, exists, err
+	}
+
+	// The block may have been compacted into the fileset of a larger block,
+	// which then holds the block in the same volume.
+	return m.compactedFileSetBlockStartFn(m.fsOpts.FilePathPrefix(),
+		m.namespace.ID(), shard, blockStart, latestVolume, m.fsOpts.CompactedBlockSizes())
 }
 
 func (m *namespaceReaderManager) shardExistsWithLock(shardSet sharding.ShardSet, shard uint32) bool {
@@ -318,6 +343,22 @@ func (m *namespaceReaderManager) get(
 			VolumeIndex: latestVolume,
 		},
 	}
+	// Offloaded filesets are read from the remote fileset cache, which keeps
+	// the fileset cached until the reader has opened it.
+	if cache := m.fsOpts.RemoteFileSetCache(); cache != nil {
+		offloaded, err := m.filesetOffloadedFn(m.fsOpts.FilePathPrefix(),
+			m.namespace.ID(), shard, blockStart, latestVolume)
+		if err != nil {
+			return nil, err
+		}
+		if offloaded {
+			openOpts.FilePathPrefix, err = cache.FetchAndWait(openOpts.Identifier)
+			if err != nil {
+				return nil, err
+			}
+			defer cache.Release(openOpts.Identifier)
+		}
+	}
 	if err := reader.Open(openOpts); err != nil {
 		return nil, err
 	}
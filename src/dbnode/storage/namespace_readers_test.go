@@ -23,6 +23,7 @@ package storage
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -474,10 +475,131 @@ func TestNamespaceReadersFilesetExistsAt(t *testing.T) {
 	nsReaderMgr := newNamespaceReaderManager(metadata, tally.NoopScope,
 		DefaultTestOptions().SetBlockLeaseManager(mockBlockLeaseMgr))
 
-	exists, err := nsReaderMgr.filesetExistsAt(0, 0)
+	_, exists, err := nsReaderMgr.filesetExistsAt(0, 0)
 	require.NoError(t, err)
 	require.False(t, exists)
 
-	_, err = nsReaderMgr.filesetExistsAt(0, 0)
+	_, _, err = nsReaderMgr.filesetExistsAt(0, 0)
 	require.Error(t, err)
 }
+
+func TestNamespaceReadersFilesetExistsAtCompacted(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	metadata, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+
+	var (
+		blockSize          = metadata.Options().RetentionOptions().BlockSize()
+		compactedBlockSize = 2 * blockSize
+		compactedStart     = xtime.Now().Truncate(compactedBlockSize)
+		blockStart         = compactedStart.Add(blockSize)
+	)
+	mockBlockLeaseMgr := block.NewMockLeaseManager(ctrl)
+	mockBlockLeaseMgr.EXPECT().RegisterLeaser(gomock.Any()).Return(nil)
+	mockBlockLeaseMgr.EXPECT().OpenLatestLease(gomock.Any(), gomock.Any()).
+		Return(block.LeaseState{Volume: 1}, nil)
+	opts := DefaultTestOptions().SetBlockLeaseManager(mockBlockLeaseMgr)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(
+		opts.CommitLogOptions().FilesystemOptions().
+			SetCompactedBlockSizes([]time.Duration{compactedBlockSize})))
+	nsReaderMgr := newNamespaceReaderManager(metadata, tally.NoopScope, opts).(*namespaceReaderManager)
+	nsReaderMgr.filesetExistsFn = func(
+		_ string, _ ident.ID, _ uint32, _ xtime.UnixNano, _ int,
+	) (bool, error) {
+		return false, nil
+	}
+	nsReaderMgr.compactedFileSetBlockStartFn = func(
+		_ string, _ ident.ID, _ uint32, at xtime.UnixNano, volume int,
+		blockSizes []time.Duration,
+	) (xtime.UnixNano, bool, error) {
+		require.Equal(t, blockStart, at)
+		require.Equal(t, 1, volume)
+		require.Equal(t, []time.Duration{compactedBlockSize}, blockSizes)
+		return compactedStart, true, nil
+	}
+
+	fileSetStart, exists, err := nsReaderMgr.filesetExistsAt(0, blockStart)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, compactedStart, fileSetStart)
+}
+
+type testRemoteFileSetCache struct {
+	prefix  string
+	pinned  map[fs.FileSetFileIdentifier]int
+	fetched int
+}
+
+func (c *testRemoteFileSetCache) Fetch(fs.FileSetFileIdentifier) (string, error) {
+	return "", errors.New("not on the query path")
+}
+
+func (c *testRemoteFileSetCache) FetchAndWait(id fs.FileSetFileIdentifier) (string, error) {
+	c.pinned[id]++
+	c.fetched++
+	return c.prefix, nil
+}
+
+func (c *testRemoteFileSetCache) Release(id fs.FileSetFileIdentifier) {
+	c.pinned[id]--
+}
+
+func TestNamespaceReadersGetOffloaded(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	metadata, err := namespace.NewMetadata(defaultTestNs1ID, defaultTestNs1Opts)
+	require.NoError(t, err)
+	var (
+		shard     = uint32(0)
+		blockSize = metadata.Options().RetentionOptions().BlockSize()
+		start     = xtime.Now().Truncate(blockSize)
+		cache     = &testRemoteFileSetCache{
+			prefix: "/var/cache",
+			pinned: make(map[fs.FileSetFileIdentifier]int),
+		}
+	)
+
+	mockBlockLeaseMgr := block.NewMockLeaseManager(ctrl)
+	mockBlockLeaseMgr.EXPECT().RegisterLeaser(gomock.Any()).Return(nil)
+	mockBlockLeaseMgr.EXPECT().OpenLatestLease(gomock.Any(), gomock.Any()).
+		Return(block.LeaseState{Volume: 1}, nil)
+	opts := DefaultTestOptions().SetBlockLeaseManager(mockBlockLeaseMgr)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(
+		opts.CommitLogOptions().FilesystemOptions().SetRemoteFileSetCache(cache)))
+	nsReaderMgr := newNamespaceReaderManager(metadata, tally.NoopScope, opts).(*namespaceReaderManager)
+	nsReaderMgr.filesetOffloadedFn = func(
+		_ string, _ ident.ID, _ uint32, _ xtime.UnixNano, _ int,
+	) (bool, error) {
+		return true, nil
+	}
+
+	id := fs.FileSetFileIdentifier{
+		Namespace: nsReaderMgr.namespace.ID(), Shard: shard, BlockStart: start,
+		VolumeIndex: 1,
+	}
+	mockFSReader := fs.NewMockDataFileSetReader(ctrl)
+	mockFSReader.EXPECT().Open(fs.DataReaderOpenOptions{
+		Identifier:     id,
+		FilePathPrefix: cache.prefix,
+	}).DoAndReturn(func(fs.DataReaderOpenOptions) error {
+		// The fileset is pinned in the cache while the reader opens it.
+		require.Equal(t, 1, cache.pinned[id])
+		return nil
+	})
+	mockFSReader.EXPECT().ValidateMetadata().Return(nil)
+	nsReaderMgr.newReaderFn = func(
+		bytesPool pool.CheckedBytesPool,
+		opts fs.Options,
+	) (fs.DataFileSetReader, error) {
+		return mockFSReader, nil
+	}
+
+	reader, err := nsReaderMgr.get(shard, start, readerPosition{volume: 1})
+	require.NoError(t, err)
+	require.Equal(t, mockFSReader, reader)
+	require.Equal(t, 1, cache.fetched)
+	require.Equal(t, 0, cache.pinned[id])
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: A shard split off from a parent shard (see placement SplitShards) redirects
+// to its parent until it is marked available. While redirected, writes for the
+// series of the split shard are routed to the parent and also written to the split
+// shard, and blocks that started before the split shard received every write are
+// split from the flushed filesets of the parent instead of flushed by the split
+// shard itself.
+
+// splitShard is a shard being split off from a parent shard.
+type splitShard struct {
+	// parent is the ID of the parent shard.
+	parent uint32
+	// start is the time the shard started receiving writes.
+	start xtime.UnixNano
+}
+
+// updateSplitShardsWithLock tracks the time the shards being split off from
+// a parent shard started receiving writes, and the parent shards of the
+// completed splits.
+func (n *dbNamespace) updateSplitShardsWithLock(shardSet sharding.ShardSet) {
+	var splitShards map[uint32]splitShard
+	for _, s := range shardSet.All() {
+		if s.RedirectToShardID() == nil {
+			continue
+		}
+		if splitShards == nil {
+			splitShards = make(map[uint32]splitShard)
+		}
+		if split, ok := n.splitShards[s.ID()]; ok {
+			splitShards[s.ID()] = split
+			continue
+		}
+		splitShards[s.ID()] = splitShard{
+			parent: *s.RedirectToShardID(),
+			start:  xtime.ToUnixNano(n.nowFn()),
+		}
+	}
+
+	// A split completes once the shard stops redirecting to its parent while
+	// the node still owns both shards, the parent then still holds the series
+	// of the split shard until they are removed by RemoveSplitSeries.
+	for id, split := range n.splitShards {
+		if _, ok := splitShards[id]; ok {
+			continue
+		}
+		if _, err := shardSet.LookupShard(id); err != nil {
+			continue
+		}
+		if _, err := shardSet.LookupShard(split.parent); err != nil {
+			continue
+		}
+		if n.splitParents == nil {
+			n.splitParents = make(map[uint32]struct{})
+		}
+		n.splitParents[split.parent] = struct{}{}
+	}
+	for parent := range n.splitParents {
+		if _, err := shardSet.LookupShard(parent); err != nil {
+			delete(n.splitParents, parent)
+		}
+	}
+	n.splitShards = splitShards
+}
+
+// splitShardForWithRLock returns the shard being split off from the given
+// shard that the series hashes to, if any.
+func (n *dbNamespace) splitShardForWithRLock(
+	id ident.ID,
+	shardID uint32,
+) (databaseShard, bool) {
+	if len(n.splitShards) == 0 {
+		return nil, false
+	}
+	splitShardID := n.shardSet.HashFn()(id)
+	if splitShardID == shardID {
+		return nil, false
+	}
+	if _, ok := n.splitShards[splitShardID]; !ok {
+		return nil, false
+	}
+	shard, _, err := n.shardAtWithRLock(splitShardID)
+	if err != nil {
+		return nil, false
+	}
+	return shard, true
+}
+
+// isSplitBlockWithRLock returns whether the block of a shard being split off
+// from a parent shard needs to be split from the fileset of the parent shard.
+func (n *dbNamespace) isSplitBlockWithRLock(
+	shardID uint32,
+	blockStart xtime.UnixNano,
+) bool {
+	split, ok := n.splitShards[shardID]
+	if !ok {
+		return false
+	}
+	// Writes for a block are accepted from bufferFuture before the block starts,
+	// so the split shard only received every write for blocks starting at least
+	// bufferFuture after it started receiving writes.
+	bufferFuture := n.nopts.RetentionOptions().BufferFuture()
+	return blockStart.Before(split.start.Add(bufferFuture))
+}
+
+func (n *dbNamespace) isSplitShardBlock(
+	shard databaseShard,
+	blockStart xtime.UnixNano,
+) bool {
+	n.RLock()
+	defer n.RUnlock()
+	if len(n.splitShards) == 0 {
+		return false
+	}
+	return n.isSplitBlockWithRLock(shard.ID(), blockStart)
+}
+
+// splitWarmFlushedBlocks splits the flushed filesets of parent shards into the
+// shards being split off from them that need the block split.
+func (n *dbNamespace) splitWarmFlushedBlocks(
+	blockStart xtime.UnixNano,
+	shards []databaseShard,
+) error {
+	n.RLock()
+	if len(n.splitShards) == 0 {
+		n.RUnlock()
+		return nil
+	}
+	var (
+		hashFn      = n.shardSet.HashFn()
+		splitShards []databaseShard
+	)
+	for _, shard := range shards {
+		if n.isSplitBlockWithRLock(shard.ID(), blockStart) {
+			splitShards = append(splitShards, shard)
+		}
+	}
+	n.RUnlock()
+
+	if len(splitShards) == 0 {
+		return nil
+	}
+
+	parents := make(map[uint32]databaseShard, len(shards))
+	for _, shard := range shards {
+		parents[shard.ID()] = shard
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range splitShards {
+		if !shard.IsBootstrapped() {
+			continue
+		}
+		flushState, err := shard.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if flushState.WarmStatus.DataFlushed == fileOpSuccess {
+			continue
+		}
+
+		n.RLock()
+		s, err := n.shardSet.LookupShard(shard.ID())
+		n.RUnlock()
+		if err != nil || s.RedirectToShardID() == nil {
+			continue
+		}
+		parent, ok := parents[*s.RedirectToShardID()]
+		if !ok || !parent.IsBootstrapped() {
+			continue
+		}
+		parentFlushState, err := parent.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if parentFlushState.WarmStatus.DataFlushed != fileOpSuccess {
+			// Wait for the parent to flush the block.
+			continue
+		}
+
+		if err := n.splitBlock(parent.ID(), parentFlushState.ColdVersionRetrievable,
+			shard.ID(), flushState.ColdVersionRetrievable, hashFn, blockStart); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to split data from shard %d: %v",
+				shard.ID(), parent.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+			continue
+		}
+
+		shard.UpdateFlushStates()
+		n.log.Info("split shard block from parent shard",
+			zap.Stringer("namespace", n.id),
+			zap.Uint32("shard", shard.ID()),
+			zap.Uint32("parent", parent.ID()),
+			zap.Time("blockStart", blockStart.ToTime()))
+	}
+
+	return multiErr.FinalError()
+}
+
+func (n *dbNamespace) splitBlock(
+	parentID uint32,
+	parentVolumeIndex int,
+	shardID uint32,
+	shardVolumeIndex int,
+	hashFn sharding.HashFn,
+	blockStart xtime.UnixNano,
+) error {
+	fsOpts := n.opts.CommitLogOptions().FilesystemOptions()
+	writer, err := fs.NewStreamingWriter(fsOpts)
+	if err != nil {
+		return err
+	}
+	reader, err := fs.NewReader(n.opts.BytesPool(), fsOpts)
+	if err != nil {
+		return err
+	}
+
+	// Volume 0 is only written by warm flushes which are skipped for split blocks,
+	// otherwise write after any volume cold flushed by the split shard.
+	dstVolumeIndex := 0
+	if shardVolumeIndex > 0 {
+		dstVolumeIndex = shardVolumeIndex + 1
+	}
+	return fs.SplitFileSet(reader, map[uint32]fs.StreamingWriter{shardID: writer}, fs.SplitFileSetOptions{
+		NamespaceID:    n.id,
+		Shard:          parentID,
+		BlockStart:     blockStart,
+		VolumeIndex:    parentVolumeIndex,
+		DstVolumeIndex: dstVolumeIndex,
+		HashFn:         hashFn,
+	})
+}
+
+func (n *dbNamespace) ShardSplitComplete(shardID uint32) bool {
+	n.RLock()
+	split, ok := n.splitShards[shardID]
+	shard, _, err := n.shardAtWithRLock(shardID)
+	n.RUnlock()
+	if !ok {
+		return true
+	}
+	if err != nil || !shard.IsBootstrapped() {
+		return false
+	}
+
+	var (
+		now          = xtime.ToUnixNano(n.nowFn())
+		rOpts        = n.nopts.RetentionOptions()
+		blockSize    = rOpts.BlockSize()
+		lastSplit    = split.start.Add(rOpts.BufferFuture()).Truncate(blockSize)
+		flushableEnd = retention.FlushTimeEnd(rOpts, now)
+	)
+	if lastSplit.After(flushableEnd) {
+		// The last block to split is not flushable yet.
+		return false
+	}
+
+	for blockStart := retention.FlushTimeStart(rOpts, now); !blockStart.After(lastSplit); blockStart = blockStart.Add(blockSize) {
+		flushState, err := shard.FlushState(blockStart)
+		if err != nil || flushState.WarmStatus.DataFlushed != fileOpSuccess {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *dbNamespace) RemoveSplitSeries() error {
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		return errNamespaceNotBootstrapped
+	}
+	if len(n.splitParents) == 0 {
+		n.RUnlock()
+		return nil
+	}
+	var (
+		nsCtx   = n.nsContextWithRLock()
+		hashFn  = n.shardSet.HashFn()
+		parents = make([]databaseShard, 0, len(n.splitParents))
+	)
+	for parent := range n.splitParents {
+		if n.isSplitParentWithRLock(parent) {
+			// The parent still holds the series of shards being split off
+			// from it.
+			continue
+		}
+		shard, _, err := n.shardAtWithRLock(parent)
+		if err != nil || !shard.IsBootstrapped() {
+			continue
+		}
+		parents = append(parents, shard)
+	}
+	n.RUnlock()
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range parents {
+		if err := shard.RemoveSplitSeries(hashFn, nsCtx); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to remove split series: %v",
+				shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+			continue
+		}
+
+		n.Lock()
+		delete(n.splitParents, shard.ID())
+		n.Unlock()
+		n.log.Info("removed series of split shards from parent shard",
+			zap.Stringer("namespace", n.id),
+			zap.Uint32("shard", shard.ID()))
+	}
+	return multiErr.FinalError()
+}
+
+func (n *dbNamespace) isSplitParentWithRLock(shardID uint32) bool {
+	for _, split := range n.splitShards {
+		if split.parent == shardID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/runtime"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestSplitNamespace(t *testing.T, now xtime.UnixNano) (*dbNamespace, closerFn) {
+	parent := uint32(0)
+	shards := []shard.Shard{
+		shard.NewShard(0).SetState(shard.Available),
+		shard.NewShard(1).SetState(shard.Initializing).SetRedirectToShardID(&parent),
+	}
+	// Every series hashes to the shard being split off from shard 0.
+	hashFn := func(identifier ident.ID) uint32 { return 1 }
+	shardSet, err := sharding.NewShardSet(shards, hashFn)
+	require.NoError(t, err)
+
+	metadata := newTestNamespaceMetadata(t)
+	dopts := DefaultTestOptions().SetRuntimeOptionsManager(runtime.NewOptionsManager())
+	dopts = dopts.SetClockOptions(dopts.ClockOptions().SetNowFn(now.ToTime))
+	ns, err := newDatabaseNamespace(metadata,
+		namespace.NewRuntimeOptionsManager(metadata.ID().String()),
+		shardSet, nil, nil, nil, dopts)
+	require.NoError(t, err)
+	return ns.(*dbNamespace), dopts.RuntimeOptionsManager().Close
+}
+
+// setTestSplitNamespaceShards replaces the shards of the namespace with the
+// given shards, closing the shards it replaces.
+func setTestSplitNamespaceShards(t *testing.T, ns *dbNamespace, shards ...databaseShard) {
+	for i, shard := range shards {
+		if existing := ns.shards[i]; existing != nil {
+			if _, ok := existing.(*MockdatabaseShard); !ok {
+				require.NoError(t, existing.Close())
+			}
+		}
+		ns.shards[i] = shard
+	}
+}
+
+func closeTestSplitNamespace(t *testing.T, ns *dbNamespace) {
+	ns.Lock()
+	ns.shards = nil
+	ns.Unlock()
+	require.NoError(t, ns.Close())
+}
+
+func TestNamespaceWriteSplitShard(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	var (
+		id   = ident.StringID("foo")
+		now  = xtime.Now()
+		val  = 1.0
+		unit = xtime.Second
+	)
+
+	ns, closer := newTestSplitNamespace(t, now)
+	defer closer()
+
+	opts := series.WriteOptions{TruncateType: ns.opts.TruncateType()}
+
+	parent := NewMockdatabaseShard(ctrl)
+	parent.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	parent.EXPECT().Write(ctx, id, now, val, unit, nil, opts).
+		Return(SeriesWrite{WasWritten: true}, nil)
+	split := NewMockdatabaseShard(ctrl)
+	split.EXPECT().ID().Return(uint32(1)).AnyTimes()
+	split.EXPECT().Write(ctx, id, now, val, unit, nil, opts).
+		Return(SeriesWrite{WasWritten: true}, nil)
+	setTestSplitNamespaceShards(t, ns, parent, split)
+	defer closeTestSplitNamespace(t, ns)
+
+	seriesWrite, err := ns.Write(ctx, id, now, val, unit, nil)
+	require.NoError(t, err)
+	require.True(t, seriesWrite.WasWritten)
+
+	// Once the shard stops redirecting writes only go to the shard itself.
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0, 1}, shard.Available), ns.shardSet.HashFn())
+	require.NoError(t, err)
+	ns.assignShardSet(shardSet, assignShardSetOptions{})
+
+	split.EXPECT().Write(ctx, id, now, val, unit, nil, opts).
+		Return(SeriesWrite{WasWritten: true}, nil)
+	_, err = ns.Write(ctx, id, now, val, unit, nil)
+	require.NoError(t, err)
+}
+
+func TestNamespaceShardSplitComplete(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		rOpts     = defaultTestNs1Opts.RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		now       = xtime.Now().Truncate(blockSize)
+	)
+	ns, closer := newTestSplitNamespace(t, now)
+	defer closer()
+
+	require.True(t, ns.ShardSplitComplete(0))
+
+	// Blocks starting before the split shard received every write are split.
+	ns.RLock()
+	require.True(t, ns.isSplitBlockWithRLock(1, now))
+	require.False(t, ns.isSplitBlockWithRLock(0, now))
+	require.False(t, ns.isSplitBlockWithRLock(1, now.Add(rOpts.BufferFuture()+blockSize)))
+	ns.RUnlock()
+
+	split := NewMockdatabaseShard(ctrl)
+	split.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	setTestSplitNamespaceShards(t, ns, nil, split)
+	defer closeTestSplitNamespace(t, ns)
+
+	// The block the split started in is not flushable yet.
+	require.False(t, ns.ShardSplitComplete(1))
+
+	later := now.Add(2*blockSize + rOpts.BufferPast())
+	ns.nowFn = later.ToTime
+	split.EXPECT().FlushState(gomock.Any()).Return(fileOpState{
+		WarmStatus: warmStatus{DataFlushed: fileOpSuccess},
+	}, nil).AnyTimes()
+	require.True(t, ns.ShardSplitComplete(1))
+}
+
+func TestNamespaceRemoveSplitSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ns, closer := newTestSplitNamespace(t, xtime.Now())
+	defer closer()
+	ns.bootstrapState = Bootstrapped
+
+	parent := NewMockdatabaseShard(ctrl)
+	parent.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	parent.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	split := NewMockdatabaseShard(ctrl)
+	split.EXPECT().ID().Return(uint32(1)).AnyTimes()
+	setTestSplitNamespaceShards(t, ns, parent, split)
+	defer closeTestSplitNamespace(t, ns)
+
+	// The parent keeps the series of the split shard while it is redirected.
+	require.NoError(t, ns.RemoveSplitSeries())
+
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0, 1}, shard.Available), ns.shardSet.HashFn())
+	require.NoError(t, err)
+	ns.assignShardSet(shardSet, assignShardSetOptions{})
+
+	// The removal is retried until it succeeds once the split completes.
+	fakeErr := errors.New("fake error while removing split series")
+	parent.EXPECT().RemoveSplitSeries(gomock.Any(), gomock.Any()).Return(fakeErr)
+	require.Error(t, ns.RemoveSplitSeries())
+	parent.EXPECT().RemoveSplitSeries(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, ns.RemoveSplitSeries())
+	require.NoError(t, ns.RemoveSplitSeries())
+}
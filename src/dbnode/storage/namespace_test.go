@@ -51,6 +51,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	xidx "github.com/m3db/m3/src/m3ninx/idx"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/context"
@@ -570,6 +571,9 @@ func testNamespaceBootstrapUnfulfilledShards(
 		if !contains(unfulfilledShardIDs, id) {
 			shard.EXPECT().Bootstrap(gomock.Any(), gomock.Any()).Return(nil)
 		}
+		if withIndex {
+			shard.EXPECT().RemovedSeries().Return(nil)
+		}
 		ns.shards[id] = shard
 	}
 
@@ -1495,6 +1499,125 @@ func TestNamespaceAggregateQuery(t *testing.T) {
 	require.NoError(t, ns.Close())
 }
 
+func TestNamespaceDeleteSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().Bootstrapped().Return(true).AnyTimes()
+
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+
+	var (
+		ropts     = ns.Options().RetentionOptions()
+		blockSize = ropts.BlockSize()
+		now       = xtime.Now().Truncate(blockSize).Add(time.Minute)
+		earliest  = retention.FlushTimeStart(ropts, now)
+		query     = index.Query{Query: xidx.NewTermQuery([]byte("foo"), []byte("bar"))}
+		iopts     = ns.opts.IndexOptions()
+		results   = index.NewQueryResults(ns.ID(), index.QueryResultsOptions{}, iopts)
+	)
+	ns.nowFn = func() time.Time { return now.ToTime() }
+	_, _, err := results.AddDocuments([]doc.Document{
+		doc.NewDocumentFromMetadata(doc.Metadata{ID: []byte("a")}),
+		doc.NewDocumentFromMetadata(doc.Metadata{ID: []byte("b")}),
+	})
+	require.NoError(t, err)
+
+	// Route every series to the same shard to check the tombstones of a
+	// shard are added once per request.
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	shard.EXPECT().HasDeletedSeries().Return(false).AnyTimes()
+	ns.Lock()
+	shards := ns.shards
+	ns.shards = make([]databaseShard, len(shards))
+	for i := range ns.shards {
+		ns.shards[i] = shard
+	}
+	ns.Unlock()
+	defer func() {
+		ns.Lock()
+		ns.shards = shards
+		ns.Unlock()
+	}()
+
+	// The range is clamped to the retention and to the time of the delete.
+	ctx := context.NewBackground()
+	defer ctx.Close()
+	idx.EXPECT().Query(gomock.Any(), query, index.QueryOptions{
+		StartInclusive: earliest,
+		EndExclusive:   now,
+	}).Return(index.QueryResult{Results: results, Exhaustive: true}, nil)
+	// All of the data of the series is deleted, so their documents are
+	// removed from the index.
+	shard.EXPECT().DeleteSeries(gomock.Len(2), xtime.Range{Start: earliest, End: now}, now).Return(nil)
+	idx.EXPECT().RemoveSeries(gomock.Len(2), now).Return(nil)
+	deleted, err := ns.DeleteSeries(ctx, query, 0, now.Add(blockSize))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	// A partial delete only tombstones the exact range.
+	partial := xtime.Range{Start: earliest.Add(time.Minute), End: now.Add(-time.Minute)}
+	idx.EXPECT().Query(gomock.Any(), query, index.QueryOptions{
+		StartInclusive: partial.Start,
+		EndExclusive:   partial.End,
+	}).Return(index.QueryResult{Results: results, Exhaustive: true}, nil)
+	shard.EXPECT().DeleteSeries(gomock.Len(2), partial, xtime.UnixNano(0)).Return(nil)
+	deleted, err = ns.DeleteSeries(ctx, query, partial.Start, partial.End)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	// Nothing is deleted outside of the retention.
+	deleted, err = ns.DeleteSeries(ctx, query, 0, earliest)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), deleted)
+}
+
+func TestNamespaceAggregateQueryExcludesDeletedSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMockNamespaceIndex(ctrl)
+	idx.EXPECT().Bootstrapped().Return(true)
+
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+
+	var (
+		start = xtime.Now()
+		end   = start.Add(time.Hour)
+	)
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().HasDeletedSeries().Return(true)
+	shard.EXPECT().DeletedSeriesInRange(start, end).Return([]ident.ID{ident.StringID("a")})
+	ns.Lock()
+	shards := ns.shards
+	ns.shards = []databaseShard{shard}
+	ns.Unlock()
+	defer func() {
+		ns.Lock()
+		ns.shards = shards
+		ns.Unlock()
+	}()
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+	query := index.Query{Query: xidx.NewTermQuery([]byte("foo"), []byte("bar"))}
+	aggOpts := index.AggregationOptions{
+		QueryOptions: index.QueryOptions{StartInclusive: start, EndExclusive: end},
+	}
+	exclude := index.Query{Query: xidx.NewDisjunctionQuery(
+		xidx.NewTermQuery(doc.IDReservedFieldName, []byte("a")))}
+	expected := aggOpts
+	expected.ExcludeQuery = &exclude
+
+	idx.EXPECT().AggregateQuery(ctx, query, expected)
+	_, err := ns.AggregateQuery(ctx, query, aggOpts)
+	require.NoError(t, err)
+}
+
 func TestNamespaceTicksIndex(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -49,6 +49,7 @@ import (
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	xsync "github.com/m3db/m3/src/x/sync"
 )
@@ -77,6 +78,10 @@ const (
 	defaultNumLoadedBytesLimit = 2 << 30
 
 	defaultMediatorTickInterval = 5 * time.Second
+
+	// defaultMaxTombstonesPerShard is the default maximum number of deleted
+	// ranges of series held by a shard.
+	defaultMaxTombstonesPerShard = 100000
 )
 
 var (
@@ -96,6 +101,10 @@ var (
 	errBlockLeaserNotSet          = errors.New("block leaser is not set")
 	errOnColdFlushNotSet          = errors.New("on cold flush is not set, requires at least a no-op implementation")
 	errLimitsOptionsNotSet        = errors.New("limits options are not set")
+	errBlockCompactionTierInvalid = errors.New("block compaction tiers must have positive ages and block sizes")
+	errBlockCompactionTiersOrder  = errors.New("block compaction tiers must be ordered by increasing age and block size")
+	errFileSetOffloadAgeNegative  = errors.New("fileset offload age must not be negative")
+	errFileSetScrubInvalid        = errors.New("fileset scrub interval must be positive and throughput limit must not be negative")
 )
 
 // NewSeriesOptionsFromOptions creates a new set of database series options from provided options.
@@ -162,6 +171,13 @@ type options struct {
 	blockLeaseManager               block.LeaseManager
 	onColdFlush                     OnColdFlush
 	forceColdWritesEnabled          bool
+	maxExemplarsPerShard            int
+	maxTombstonesPerShard           int
+	blockCompactionTiers            []BlockCompactionTier
+	fileSetOffloadAge               time.Duration
+	fileSetScrubOptions             FileSetScrubOptions
+	backupStore                     objectstore.Store
+	backupMetadataFn                BackupMetadataFn
 	sourceLoggerBuilder             limits.SourceLoggerBuilder
 	iterationOptions                index.IterationOptions
 	memoryTracker                   MemoryTracker
@@ -246,6 +262,7 @@ func newOptions(poolOpts pool.ObjectPoolOptions) Options {
 		memoryTracker:                   NewMemoryTracker(NewMemoryTrackerOptions(defaultNumLoadedBytesLimit)),
 		namespaceRuntimeOptsMgrRegistry: namespace.NewRuntimeOptionsManagerRegistry(),
 		mediatorTickInterval:            defaultMediatorTickInterval,
+		maxTombstonesPerShard:           defaultMaxTombstonesPerShard,
 		namespaceHooks:                  &noopNamespaceHooks{},
 		tileAggregator:                  &noopTileAggregator{},
 		permitsOptions:                  permits.NewOptions(),
@@ -317,6 +334,29 @@ func (o *options) Validate() error {
 		return errLimitsOptionsNotSet
 	}
 
+	// validate block compaction tiers
+	for i, tier := range o.blockCompactionTiers {
+		if tier.Age <= 0 || tier.BlockSize <= 0 {
+			return errBlockCompactionTierInvalid
+		}
+		if i == 0 {
+			continue
+		}
+		prev := o.blockCompactionTiers[i-1]
+		if tier.Age <= prev.Age || tier.BlockSize <= prev.BlockSize {
+			return errBlockCompactionTiersOrder
+		}
+	}
+
+	if o.fileSetOffloadAge < 0 {
+		return errFileSetOffloadAgeNegative
+	}
+
+	if scrubOpts := o.fileSetScrubOptions; scrubOpts.Enabled &&
+		(scrubOpts.Interval <= 0 || scrubOpts.ThroughputLimitMbps < 0) {
+		return errFileSetScrubInvalid
+	}
+
 	return nil
 }
 
@@ -790,6 +830,76 @@ func (o *options) ForceColdWritesEnabled() bool {
 	return o.forceColdWritesEnabled
 }
 
+func (o *options) SetMaxExemplarsPerShard(value int) Options {
+	opts := *o
+	opts.maxExemplarsPerShard = value
+	return &opts
+}
+
+func (o *options) MaxExemplarsPerShard() int {
+	return o.maxExemplarsPerShard
+}
+
+func (o *options) SetMaxTombstonesPerShard(value int) Options {
+	opts := *o
+	opts.maxTombstonesPerShard = value
+	return &opts
+}
+
+func (o *options) MaxTombstonesPerShard() int {
+	return o.maxTombstonesPerShard
+}
+
+func (o *options) SetBlockCompactionTiers(value []BlockCompactionTier) Options {
+	opts := *o
+	opts.blockCompactionTiers = value
+	return &opts
+}
+
+func (o *options) BlockCompactionTiers() []BlockCompactionTier {
+	return o.blockCompactionTiers
+}
+
+func (o *options) SetFileSetOffloadAge(value time.Duration) Options {
+	opts := *o
+	opts.fileSetOffloadAge = value
+	return &opts
+}
+
+func (o *options) FileSetOffloadAge() time.Duration {
+	return o.fileSetOffloadAge
+}
+
+func (o *options) SetFileSetScrubOptions(value FileSetScrubOptions) Options {
+	opts := *o
+	opts.fileSetScrubOptions = value
+	return &opts
+}
+
+func (o *options) FileSetScrubOptions() FileSetScrubOptions {
+	return o.fileSetScrubOptions
+}
+
+func (o *options) SetBackupStore(value objectstore.Store) Options {
+	opts := *o
+	opts.backupStore = value
+	return &opts
+}
+
+func (o *options) BackupStore() objectstore.Store {
+	return o.backupStore
+}
+
+func (o *options) SetBackupMetadataFn(value BackupMetadataFn) Options {
+	opts := *o
+	opts.backupMetadataFn = value
+	return &opts
+}
+
+func (o *options) BackupMetadataFn() BackupMetadataFn {
+	return o.backupMetadataFn
+}
+
 func (o *options) SetSourceLoggerBuilder(value limits.SourceLoggerBuilder) Options {
 	opts := *o
 	opts.sourceLoggerBuilder = value
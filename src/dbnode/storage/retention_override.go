@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/metrics/filters"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// retentionOverrides matches series to the retention overrides of a
+// namespace, the first override whose filter matches the tags of a series
+// applies to it.
+type retentionOverrides []retentionOverride
+
+type retentionOverride struct {
+	tagFilters      []retentionOverrideTagFilter
+	retentionPeriod time.Duration
+	// seriesOpts are the options of the series matching the override, which
+	// only differ from the namespace series options by their retention period.
+	seriesOpts series.Options
+}
+
+type retentionOverrideTagFilter struct {
+	name   []byte
+	filter filters.Filter
+}
+
+// newRetentionOverrides compiles the retention overrides of a namespace, the
+// series options of the overrides are derived from seriesOpts when set.
+func newRetentionOverrides(
+	overrides []namespace.RetentionOverride,
+	seriesOpts series.Options,
+) (retentionOverrides, error) {
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	result := make(retentionOverrides, 0, len(overrides))
+	for _, override := range overrides {
+		values, err := filters.ValidateTagsFilter(override.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		tagFilters := make([]retentionOverrideTagFilter, 0, len(values))
+		for name, value := range values {
+			filter, err := filters.NewFilterFromFilterValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retention override filter %s: %w",
+					override.Filter, err)
+			}
+			tagFilters = append(tagFilters, retentionOverrideTagFilter{
+				name:   []byte(name),
+				filter: filter,
+			})
+		}
+		compiled := retentionOverride{
+			tagFilters:      tagFilters,
+			retentionPeriod: override.RetentionPeriod,
+		}
+		if seriesOpts != nil {
+			ropts := seriesOpts.RetentionOptions().
+				SetRetentionPeriod(override.RetentionPeriod)
+			compiled.seriesOpts = seriesOpts.SetRetentionOptions(ropts)
+		}
+		result = append(result, compiled)
+	}
+	return result, nil
+}
+
+// match returns the retention override applying to a series, or nil if the
+// namespace retention applies to it.
+func (r retentionOverrides) match(metadata doc.Metadata) *retentionOverride {
+	for i := range r {
+		if r[i].matches(metadata) {
+			return &r[i]
+		}
+	}
+	return nil
+}
+
+// seriesOptions returns the series options of a series, which are the
+// options of its retention override if any or defaultOpts otherwise.
+func (r retentionOverrides) seriesOptions(
+	metadata doc.Metadata,
+	defaultOpts series.Options,
+) series.Options {
+	if override := r.match(metadata); override != nil && override.seriesOpts != nil {
+		return override.seriesOpts
+	}
+	return defaultOpts
+}
+
+// expired returns whether a series matches a retention override with a
+// retention period of at most the given expired retention period.
+func (r retentionOverrides) expired(metadata doc.Metadata, expiredPeriod time.Duration) bool {
+	override := r.match(metadata)
+	return override != nil && override.retentionPeriod <= expiredPeriod
+}
+
+// minRetentionPeriod returns the shortest retention period of the overrides.
+func (r retentionOverrides) minRetentionPeriod() time.Duration {
+	var min time.Duration
+	for _, override := range r {
+		if min == 0 || override.retentionPeriod < min {
+			min = override.retentionPeriod
+		}
+	}
+	return min
+}
+
+// expiredRetentionPeriod returns the longest retention period of the
+// overrides whose series have expired from all the blocks before end, or
+// zero if none have.
+func (r retentionOverrides) expiredRetentionPeriod(
+	end xtime.UnixNano,
+	blockSize time.Duration,
+	now xtime.UnixNano,
+) time.Duration {
+	var expired time.Duration
+	for _, override := range r {
+		earliest := retention.FlushTimeStartForRetentionPeriod(
+			override.retentionPeriod, blockSize, now)
+		if !end.After(earliest) && override.retentionPeriod > expired {
+			expired = override.retentionPeriod
+		}
+	}
+	return expired
+}
+
+// queryFilter returns a filter excluding the series matching a retention
+// override whose data has all expired before the end of a query.
+func (r retentionOverrides) queryFilter(
+	ropts retention.Options,
+	queryEnd xtime.UnixNano,
+	now xtime.UnixNano,
+) func(d doc.Metadata) bool {
+	return func(d doc.Metadata) bool {
+		override := r.match(d)
+		if override == nil {
+			return true
+		}
+		earliest := retention.FlushTimeStartForRetentionPeriod(
+			override.retentionPeriod, ropts.BlockSize(), now)
+		return queryEnd.After(earliest)
+	}
+}
+
+func (o *retentionOverride) matches(metadata doc.Metadata) bool {
+	// A series matches when all the tags of the filter are present and
+	// their values match, the same as the tags filters of rules.
+	for _, tagFilter := range o.tagFilters {
+		value, ok := metadataTagValue(metadata, tagFilter.name)
+		if !ok || !tagFilter.filter.Matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func metadataTagValue(metadata doc.Metadata, name []byte) ([]byte, bool) {
+	for _, field := range metadata.Fields {
+		if bytes.Equal(field.Name, name) {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
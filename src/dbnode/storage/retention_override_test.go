@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func testRetentionOverrideMetadata(tags ...string) doc.Metadata {
+	var fields []doc.Field
+	for i := 0; i < len(tags); i += 2 {
+		fields = append(fields, doc.Field{Name: []byte(tags[i]), Value: []byte(tags[i+1])})
+	}
+	return doc.Metadata{ID: []byte("foo"), Fields: fields}
+}
+
+func TestRetentionOverridesMatch(t *testing.T) {
+	overrides, err := newRetentionOverrides([]namespace.RetentionOverride{
+		{Filter: "app:debug* env:dev", RetentionPeriod: 2 * time.Hour},
+		{Filter: "app:debug*", RetentionPeriod: 4 * time.Hour},
+		{Filter: "app:!slo", RetentionPeriod: 6 * time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		metadata doc.Metadata
+		expected time.Duration
+	}{
+		{metadata: testRetentionOverrideMetadata("app", "debug-api", "env", "dev"), expected: 2 * time.Hour},
+		{metadata: testRetentionOverrideMetadata("app", "debug-api", "env", "prod"), expected: 4 * time.Hour},
+		{metadata: testRetentionOverrideMetadata("env", "dev"), expected: 0},
+		{metadata: testRetentionOverrideMetadata("app", "api"), expected: 6 * time.Hour},
+		{metadata: testRetentionOverrideMetadata("app", "slo"), expected: 0},
+	}
+	for _, test := range tests {
+		var period time.Duration
+		if override := overrides.match(test.metadata); override != nil {
+			period = override.retentionPeriod
+		}
+		require.Equal(t, test.expected, period, "metadata %s", test.metadata.String())
+	}
+
+	_, err = newRetentionOverrides([]namespace.RetentionOverride{
+		{Filter: "app", RetentionPeriod: time.Hour},
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestRetentionOverridesSeriesOptions(t *testing.T) {
+	seriesOpts := NewSeriesOptionsFromOptions(DefaultTestOptions(), defaultTestRetentionOpts)
+	overrides, err := newRetentionOverrides([]namespace.RetentionOverride{
+		{Filter: "app:debug", RetentionPeriod: 4 * time.Hour},
+	}, seriesOpts)
+	require.NoError(t, err)
+
+	opts := overrides.seriesOptions(testRetentionOverrideMetadata("app", "debug"), seriesOpts)
+	require.Equal(t, 4*time.Hour, opts.RetentionOptions().RetentionPeriod())
+	require.Equal(t, defaultTestRetentionOpts.BlockSize(), opts.RetentionOptions().BlockSize())
+
+	opts = overrides.seriesOptions(testRetentionOverrideMetadata("app", "slo"), seriesOpts)
+	require.True(t, opts == seriesOpts)
+}
+
+func TestRetentionOverridesExpiry(t *testing.T) {
+	var (
+		blockSize = 2 * time.Hour
+		now       = xtime.Now().Truncate(blockSize)
+		debug     = testRetentionOverrideMetadata("app", "debug")
+		test      = testRetentionOverrideMetadata("app", "test")
+		slo       = testRetentionOverrideMetadata("app", "slo")
+	)
+	overrides, err := newRetentionOverrides([]namespace.RetentionOverride{
+		{Filter: "app:debug", RetentionPeriod: 2 * blockSize},
+		{Filter: "app:test", RetentionPeriod: 4 * blockSize},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2*blockSize, overrides.minRetentionPeriod())
+
+	require.Equal(t, time.Duration(0),
+		overrides.expiredRetentionPeriod(now.Add(-blockSize), blockSize, now))
+	require.Equal(t, 2*blockSize,
+		overrides.expiredRetentionPeriod(now.Add(-2*blockSize), blockSize, now))
+	require.Equal(t, 4*blockSize,
+		overrides.expiredRetentionPeriod(now.Add(-4*blockSize), blockSize, now))
+
+	require.True(t, overrides.expired(debug, 2*blockSize))
+	require.False(t, overrides.expired(test, 2*blockSize))
+	require.True(t, overrides.expired(test, 4*blockSize))
+	require.False(t, overrides.expired(slo, 4*blockSize))
+
+	filter := overrides.queryFilter(defaultTestRetentionOpts, now.Add(-3*blockSize), now)
+	require.False(t, filter(debug))
+	require.True(t, filter(test))
+	require.True(t, filter(slo))
+}
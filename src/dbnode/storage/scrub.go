@@ -0,0 +1,688 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const bytesPerMegabit = 1024 * 1024 / 8
+
+var errScrubInProgress = errors.New("scrub is already in progress")
+
+// NB: The scrubber periodically reads every flushed data and index fileset
+// volume and verifies it against the digests it was written with. Corrupt
+// volumes are moved to the quarantine directory while the file operations
+// are disabled, so that they are never read or merged again, and the blocks
+// the quarantined data filesets were read from are fetched from peers and
+// written to new volumes. The index blocks of the quarantined index filesets
+// are rebuilt from the data filesets once the data of the block is restored.
+// Restores and rebuilds that fail are retried on the following scrubs, and a
+// marker is written next to the quarantined volume once it is restored so
+// that the volumes that are still pending are found again after a restart.
+
+type fileOpsController interface {
+	DisableFileOpsAndWait()
+	EnableFileOps()
+}
+
+type scrubRestoreKey struct {
+	namespace    string
+	shard        uint32
+	fileSetStart xtime.UnixNano
+	volume       int
+}
+
+type scrubRestore struct {
+	// quarantined is the quarantined volume.
+	quarantined fs.FileSetFile
+	blockSize   time.Duration
+}
+
+type scrubRebuildKey struct {
+	namespace  string
+	blockStart xtime.UnixNano
+	volume     int
+}
+
+type scrubberMetrics struct {
+	verified    tally.Counter
+	corrupt     tally.Counter
+	quarantined tally.Counter
+	restored    tally.Counter
+	restoreErrs tally.Counter
+	rebuilt     tally.Counter
+	rebuildErrs tally.Counter
+	errors      tally.Counter
+	bytesRead   tally.Counter
+	pending     tally.Gauge
+	pendingIdx  tally.Gauge
+	duration    tally.Timer
+	status      tally.Gauge
+}
+
+func newScrubberMetrics(scope tally.Scope) scrubberMetrics {
+	return scrubberMetrics{
+		verified:    scope.Counter("verified"),
+		corrupt:     scope.Counter("corrupt"),
+		quarantined: scope.Counter("quarantined"),
+		restored:    scope.Counter("restored"),
+		restoreErrs: scope.Counter("restore-errors"),
+		rebuilt:     scope.Counter("index-rebuilt"),
+		rebuildErrs: scope.Counter("index-rebuild-errors"),
+		errors:      scope.Counter("errors"),
+		bytesRead:   scope.Counter("bytes-read"),
+		pending:     scope.Gauge("pending-restores"),
+		pendingIdx:  scope.Gauge("pending-index-rebuilds"),
+		duration:    scope.Timer("duration"),
+		status:      scope.Gauge("scrub"),
+	}
+}
+
+type dbScrubber struct {
+	database database
+	fileOps  fileOpsController
+	opts     Options
+	sopts    FileSetScrubOptions
+	nowFn    clock.NowFn
+	sleepFn  func(time.Duration)
+	logger   *zap.Logger
+	metrics  scrubberMetrics
+
+	// The restores and throttling state are only accessed by the scrub
+	// that is running.
+	pendingRestores map[scrubRestoreKey]scrubRestore
+	pendingRebuilds map[scrubRebuildKey]fs.FileSetFile
+	rediscovered    bool
+	start           time.Time
+	bytesRead       int64
+
+	closedLock sync.Mutex
+	running    int32
+	closed     bool
+}
+
+func newDatabaseScrubber(
+	database database,
+	fileOps fileOpsController,
+	opts Options,
+) BackgroundProcess {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("scrub")
+	return &dbScrubber{
+		database:        database,
+		fileOps:         fileOps,
+		opts:            opts,
+		sopts:           opts.FileSetScrubOptions(),
+		nowFn:           opts.ClockOptions().NowFn(),
+		sleepFn:         time.Sleep,
+		logger:          opts.InstrumentOptions().Logger(),
+		metrics:         newScrubberMetrics(scope),
+		pendingRestores: make(map[scrubRestoreKey]scrubRestore),
+		pendingRebuilds: make(map[scrubRebuildKey]fs.FileSetFile),
+	}
+}
+
+func (s *dbScrubber) Start() {
+	go s.run()
+}
+
+func (s *dbScrubber) Stop() {
+	s.closedLock.Lock()
+	s.closed = true
+	s.closedLock.Unlock()
+}
+
+func (s *dbScrubber) Report() {
+	if atomic.LoadInt32(&s.running) == 1 {
+		s.metrics.status.Update(1)
+	} else {
+		s.metrics.status.Update(0)
+	}
+}
+
+func (s *dbScrubber) isClosed() bool {
+	s.closedLock.Lock()
+	defer s.closedLock.Unlock()
+	return s.closed
+}
+
+func (s *dbScrubber) run() {
+	for !s.isClosed() {
+		s.sleepFn(s.sopts.Interval)
+
+		if err := s.Scrub(); err != nil {
+			s.logger.Error("error scrubbing filesets", zap.Error(err))
+		}
+	}
+}
+
+// Scrub verifies all the flushed filesets of the owned shards, quarantines
+// the corrupt ones and restores the blocks they were read from.
+func (s *dbScrubber) Scrub() error {
+	// Don't scrub if the database is not bootstrapped yet.
+	if !s.database.IsBootstrapped() {
+		return nil
+	}
+
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return errScrubInProgress
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	namespaces, err := s.database.OwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	s.start = s.nowFn()
+	s.bytesRead = 0
+	defer func() {
+		s.metrics.duration.Record(s.nowFn().Sub(s.start))
+	}()
+
+	multiErr := xerrors.NewMultiError()
+	if !s.rediscovered {
+		// Find the quarantined volumes that were not restored before the
+		// process restarted.
+		if err := s.rediscoverQuarantined(namespaces); err != nil {
+			multiErr = multiErr.Add(err)
+		} else {
+			s.rediscovered = true
+		}
+	}
+	for _, n := range namespaces {
+		if s.isClosed() {
+			break
+		}
+		if err := s.scrubNamespace(n); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to scrub: %v", n.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	if err := s.restoreBlocks(namespaces); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := s.rebuildIndexBlocks(namespaces); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	s.metrics.pending.Update(float64(len(s.pendingRestores)))
+	s.metrics.pendingIdx.Update(float64(len(s.pendingRebuilds)))
+
+	return multiErr.FinalError()
+}
+
+func (s *dbScrubber) scrubOptions(throttle bool) fs.ScrubOptions {
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	opts := fs.ScrubOptions{
+		FilePathPrefix:        fsOpts.FilePathPrefix(),
+		EncryptionKeyProvider: fsOpts.EncryptionKeyProvider(),
+	}
+	if throttle && s.sopts.ThroughputLimitMbps > 0 {
+		opts.ThrottleFn = s.throttle
+	}
+	return opts
+}
+
+// throttle sleeps for as long as is needed to keep the rate filesets are
+// read at under the throughput limit.
+func (s *dbScrubber) throttle(bytesRead int) {
+	s.bytesRead += int64(bytesRead)
+	target := time.Duration(float64(time.Second) * float64(s.bytesRead) /
+		(s.sopts.ThroughputLimitMbps * bytesPerMegabit))
+	if elapsed := s.nowFn().Sub(s.start); elapsed < target {
+		s.sleepFn(target - elapsed)
+	}
+}
+
+func (s *dbScrubber) scrubNamespace(n databaseNamespace) error {
+	var (
+		fsOpts         = s.opts.CommitLogOptions().FilesystemOptions()
+		filePathPrefix = fsOpts.FilePathPrefix()
+		multiErr       = xerrors.NewMultiError()
+	)
+	for _, shard := range n.OwnedShards() {
+		if s.isClosed() {
+			return nil
+		}
+		if !shard.IsBootstrapped() {
+			continue
+		}
+
+		fileSets, err := fs.DataFiles(filePathPrefix, n.ID(), shard.ID())
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		for _, fileSet := range fileSets {
+			if !fileSet.HasCompleteCheckpointFile() {
+				continue
+			}
+			state, err := shard.FlushState(fileSet.ID.BlockStart)
+			if err != nil || state.Offloaded {
+				// Offloaded filesets only have their info and digests on disk.
+				continue
+			}
+			if err := s.scrubDataFileSet(n, shard, fileSet); err != nil {
+				multiErr = multiErr.Add(err)
+			}
+		}
+	}
+
+	if !n.Options().IndexOptions().Enabled() {
+		return multiErr.FinalError()
+	}
+	fileSets, err := fs.IndexFiles(filePathPrefix, n.ID())
+	if err != nil {
+		return multiErr.Add(err).FinalError()
+	}
+	for _, fileSet := range fileSets {
+		if s.isClosed() {
+			break
+		}
+		if !fileSet.HasCompleteCheckpointFile() {
+			continue
+		}
+		if err := s.scrubIndexFileSet(n, fileSet); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbScrubber) scrubDataFileSet(
+	n databaseNamespace,
+	shard databaseShard,
+	fileSet fs.FileSetFile,
+) error {
+	scrubFn := func(throttle bool) (int64, error) {
+		return fs.ScrubDataFileSet(fileSet.ID, s.scrubOptions(throttle))
+	}
+	quarantined, corrupt, err := s.scrubFileSet(fileSet, scrubFn)
+	if err != nil || !corrupt {
+		return err
+	}
+
+	var (
+		blockStart = fileSet.ID.BlockStart
+		blockSize  = n.Options().RetentionOptions().BlockSize()
+	)
+	state, err := shard.FlushState(blockStart)
+	if err != nil {
+		return err
+	}
+	if state.ColdVersionRetrievable != fileSet.ID.VolumeIndex {
+		// The blocks are not read from this volume, which is about to be
+		// removed by the cleanup.
+		return s.markRestored(quarantined)
+	}
+	if state.CompactedBlockSize > 0 {
+		blockSize = state.CompactedBlockSize
+	}
+	s.pendingRestores[scrubRestoreKey{
+		namespace:    n.ID().String(),
+		shard:        shard.ID(),
+		fileSetStart: blockStart,
+		volume:       fileSet.ID.VolumeIndex,
+	}] = scrubRestore{
+		quarantined: quarantined,
+		blockSize:   blockSize,
+	}
+	return nil
+}
+
+func (s *dbScrubber) scrubIndexFileSet(
+	n databaseNamespace,
+	fileSet fs.FileSetFile,
+) error {
+	scrubFn := func(throttle bool) (int64, error) {
+		return fs.ScrubIndexFileSet(fileSet.ID, s.scrubOptions(throttle))
+	}
+	quarantined, corrupt, err := s.scrubFileSet(fileSet, scrubFn)
+	if err != nil || !corrupt {
+		return err
+	}
+
+	s.pendingRebuilds[scrubRebuildKey{
+		namespace:  n.ID().String(),
+		blockStart: fileSet.ID.BlockStart,
+		volume:     fileSet.ID.VolumeIndex,
+	}] = quarantined
+	return nil
+}
+
+// scrubFileSet scrubs the fileset and quarantines it if it is corrupt,
+// returning the quarantined volume and whether it was quarantined.
+func (s *dbScrubber) scrubFileSet(
+	fileSet fs.FileSetFile,
+	scrubFn func(throttle bool) (int64, error),
+) (fs.FileSetFile, bool, error) {
+	bytesRead, scrubErr := scrubFn(true)
+	s.metrics.bytesRead.Inc(bytesRead)
+	if scrubErr == nil {
+		s.metrics.verified.Inc(1)
+		return fs.FileSetFile{}, false, nil
+	}
+	if !fs.IsCorruptFileSetError(scrubErr) {
+		if scrubErr == fs.ErrCheckpointFileNotFound || os.IsNotExist(scrubErr) {
+			// Removed by the cleanup while being scrubbed.
+			return fs.FileSetFile{}, false, nil
+		}
+		s.metrics.errors.Inc(1)
+		return fs.FileSetFile{}, false, scrubErr
+	}
+	s.metrics.corrupt.Inc(1)
+
+	// Verify the fileset again while the file operations are disabled, so
+	// that a fileset that was being removed is not quarantined.
+	s.fileOps.DisableFileOpsAndWait()
+	defer s.fileOps.EnableFileOps()
+	if _, err := scrubFn(false); !fs.IsCorruptFileSetError(err) {
+		return fs.FileSetFile{}, false, nil
+	}
+
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	quarantined, err := fs.QuarantineFileSet(fsOpts.FilePathPrefix(), fileSet, fsOpts.NewDirectoryMode())
+	if err != nil {
+		s.metrics.errors.Inc(1)
+		return fs.FileSetFile{}, false, fmt.Errorf("could not quarantine corrupt fileset: %v", err)
+	}
+	s.metrics.quarantined.Inc(1)
+
+	s.logger.Error("quarantined corrupt fileset",
+		zap.Stringer("namespace", fileSet.ID.Namespace),
+		zap.Uint32("shard", fileSet.ID.Shard),
+		zap.Time("blockStart", fileSet.ID.BlockStart.ToTime()),
+		zap.Int("volume", fileSet.ID.VolumeIndex),
+		zap.Error(scrubErr))
+
+	return quarantined, true, nil
+}
+
+// markRestored records that a quarantined volume no longer needs to be
+// restored.
+func (s *dbScrubber) markRestored(quarantined fs.FileSetFile) error {
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	if err := fs.MarkQuarantinedFileSetRestored(quarantined, fsOpts.NewFileMode()); err != nil {
+		return fmt.Errorf("could not mark quarantined fileset as restored: %v", err)
+	}
+	return nil
+}
+
+// rediscoverQuarantined finds the quarantined volumes of the owned shards
+// that are yet to be restored.
+func (s *dbScrubber) rediscoverQuarantined(namespaces []databaseNamespace) error {
+	var (
+		fsOpts         = s.opts.CommitLogOptions().FilesystemOptions()
+		quarantinePath = fs.QuarantineDirPath(fsOpts.FilePathPrefix())
+		now            = xtime.ToUnixNano(s.nowFn())
+		multiErr       = xerrors.NewMultiError()
+	)
+	for _, n := range namespaces {
+		var (
+			retentionOpts = n.Options().RetentionOptions()
+			earliest      = retention.FlushTimeStart(retentionOpts, now)
+		)
+		for _, shard := range n.OwnedShards() {
+			fileSets, err := fs.DataFiles(quarantinePath, n.ID(), shard.ID())
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+
+			// Compacted filesets span several blocks, their block size is
+			// read from their info file.
+			blockSizes := make(map[scrubRestoreKey]time.Duration)
+			infoFiles := fs.ReadInfoFiles(quarantinePath, n.ID(), shard.ID(),
+				fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions(), persist.FileSetFlushType)
+			for _, infoFile := range infoFiles {
+				if infoFile.Err.Error() != nil {
+					continue
+				}
+				blockSizes[scrubRestoreKey{
+					fileSetStart: xtime.UnixNano(infoFile.Info.BlockStart),
+					volume:       infoFile.Info.VolumeIndex,
+				}] = time.Duration(infoFile.Info.BlockSize)
+			}
+
+			for _, fileSet := range fileSets {
+				if !fileSet.HasCompleteCheckpointFile() || fs.IsQuarantinedFileSetRestored(fileSet) {
+					continue
+				}
+				blockSize, ok := blockSizes[scrubRestoreKey{
+					fileSetStart: fileSet.ID.BlockStart,
+					volume:       fileSet.ID.VolumeIndex,
+				}]
+				if !ok || blockSize <= 0 {
+					blockSize = retentionOpts.BlockSize()
+				}
+				if !fileSet.ID.BlockStart.Add(blockSize).After(earliest) {
+					// Out of retention, nothing to restore.
+					multiErr = multiErr.Add(s.markRestored(fileSet))
+					continue
+				}
+				s.pendingRestores[scrubRestoreKey{
+					namespace:    n.ID().String(),
+					shard:        shard.ID(),
+					fileSetStart: fileSet.ID.BlockStart,
+					volume:       fileSet.ID.VolumeIndex,
+				}] = scrubRestore{
+					quarantined: fileSet,
+					blockSize:   blockSize,
+				}
+			}
+		}
+
+		if !n.Options().IndexOptions().Enabled() {
+			continue
+		}
+		fileSets, err := fs.IndexFiles(quarantinePath, n.ID())
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		for _, fileSet := range fileSets {
+			if !fileSet.HasCompleteCheckpointFile() || fs.IsQuarantinedFileSetRestored(fileSet) {
+				continue
+			}
+			s.pendingRebuilds[scrubRebuildKey{
+				namespace:  n.ID().String(),
+				blockStart: fileSet.ID.BlockStart,
+				volume:     fileSet.ID.VolumeIndex,
+			}] = fileSet
+		}
+	}
+
+	if len(s.pendingRestores) > 0 || len(s.pendingRebuilds) > 0 {
+		s.logger.Info("found quarantined filesets pending restore",
+			zap.Int("numPendingRestores", len(s.pendingRestores)),
+			zap.Int("numPendingIndexRebuilds", len(s.pendingRebuilds)))
+	}
+
+	return multiErr.FinalError()
+}
+
+// restoreBlocks fetches the blocks of the quarantined data filesets from
+// peers and writes them to new volumes.
+func (s *dbScrubber) restoreBlocks(namespaces []databaseNamespace) error {
+	if len(s.pendingRestores) == 0 {
+		return nil
+	}
+	if s.sopts.AdminClient == nil {
+		s.logger.Warn("not restoring quarantined filesets, no client to fetch blocks from peers",
+			zap.Int("numPending", len(s.pendingRestores)))
+		return nil
+	}
+	session, err := s.sopts.AdminClient.DefaultAdminSession()
+	if err != nil {
+		return fmt.Errorf("error obtaining default admin session: %v", err)
+	}
+
+	namespacesByID := make(map[string]databaseNamespace, len(namespaces))
+	for _, n := range namespaces {
+		namespacesByID[n.ID().String()] = n
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for key, restore := range s.pendingRestores {
+		if s.isClosed() {
+			break
+		}
+		n, ok := namespacesByID[key.namespace]
+		if !ok {
+			// No longer owned.
+			delete(s.pendingRestores, key)
+			continue
+		}
+		shard, _, err := n.ReadableShardAt(key.shard)
+		if err != nil {
+			// No longer owned or not bootstrapped yet.
+			continue
+		}
+
+		fetched, err := session.FetchBootstrapBlocksFromPeers(n.Metadata(), key.shard,
+			key.fileSetStart, key.fileSetStart.Add(restore.blockSize), s.sopts.ResultOptions)
+		if err == nil {
+			s.fileOps.DisableFileOpsAndWait()
+			err = shard.RestoreBlocks(key.fileSetStart, restore.blockSize, fetched)
+			s.fileOps.EnableFileOps()
+		}
+		if err != nil {
+			s.metrics.restoreErrs.Inc(1)
+			detailedErr := fmt.Errorf("shard %d failed to restore block %s of namespace %s: %v",
+				key.shard, key.fileSetStart.ToTime(), key.namespace, err)
+			multiErr = multiErr.Add(detailedErr)
+			continue
+		}
+
+		s.metrics.restored.Inc(1)
+		delete(s.pendingRestores, key)
+		multiErr = multiErr.Add(s.markRestored(restore.quarantined))
+	}
+
+	return multiErr.FinalError()
+}
+
+// rebuildIndexBlocks writes new volumes for the index blocks of the
+// quarantined index filesets from the data filesets.
+func (s *dbScrubber) rebuildIndexBlocks(namespaces []databaseNamespace) error {
+	if len(s.pendingRebuilds) == 0 {
+		return nil
+	}
+
+	namespacesByID := make(map[string]databaseNamespace, len(namespaces))
+	for _, n := range namespaces {
+		namespacesByID[n.ID().String()] = n
+	}
+
+	var (
+		flush    persist.IndexFlush
+		multiErr = xerrors.NewMultiError()
+	)
+	for key, quarantined := range s.pendingRebuilds {
+		if s.isClosed() {
+			break
+		}
+		n, ok := namespacesByID[key.namespace]
+		if !ok {
+			// No longer owned.
+			delete(s.pendingRebuilds, key)
+			continue
+		}
+		blockSize := n.Options().IndexOptions().BlockSize()
+		if s.hasPendingRestores(key.namespace, key.blockStart, blockSize) {
+			// The index is rebuilt from the data filesets, wait for the
+			// data of the block to be restored.
+			continue
+		}
+		idx, err := n.Index()
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		if flush == nil {
+			// Persisting the index needs the flushes to be paused.
+			s.fileOps.DisableFileOpsAndWait()
+			flush, err = s.opts.PersistManager().StartIndexPersist()
+			if err != nil {
+				s.fileOps.EnableFileOps()
+				return multiErr.Add(err).FinalError()
+			}
+		}
+
+		if err := idx.RebuildBlock(flush, key.blockStart, n.OwnedShards()); err != nil {
+			s.metrics.rebuildErrs.Inc(1)
+			detailedErr := fmt.Errorf("failed to rebuild index block %s of namespace %s: %v",
+				key.blockStart.ToTime(), key.namespace, err)
+			multiErr = multiErr.Add(detailedErr)
+			continue
+		}
+
+		s.metrics.rebuilt.Inc(1)
+		delete(s.pendingRebuilds, key)
+		multiErr = multiErr.Add(s.markRestored(quarantined))
+
+		s.logger.Info("rebuilt quarantined index block",
+			zap.String("namespace", key.namespace),
+			zap.Time("blockStart", key.blockStart.ToTime()))
+	}
+
+	if flush != nil {
+		multiErr = multiErr.Add(flush.DoneIndex())
+		s.fileOps.EnableFileOps()
+	}
+
+	return multiErr.FinalError()
+}
+
+// hasPendingRestores returns whether blocks of the namespace within the
+// time range are yet to be restored.
+func (s *dbScrubber) hasPendingRestores(
+	namespace string,
+	start xtime.UnixNano,
+	size time.Duration,
+) bool {
+	end := start.Add(size)
+	for key, restore := range s.pendingRestores {
+		if key.namespace == namespace && key.fileSetStart.Before(end) &&
+			key.fileSetStart.Add(restore.blockSize).After(start) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testFileOpsController struct {
+	disabled int
+	enabled  int
+}
+
+func (c *testFileOpsController) DisableFileOpsAndWait() { c.disabled++ }
+func (c *testFileOpsController) EnableFileOps()         { c.enabled++ }
+
+func TestScrubberQuarantinesAndRestoresCorruptFileSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize   = 2 * time.Hour
+		now         = xtime.Now().Truncate(blockSize)
+		start       = now.Add(-3 * blockSize)
+		blockStarts = []xtime.UnixNano{start, start.Add(blockSize)}
+		opts        = DefaultTestOptions()
+		fsOpts      = opts.CommitLogOptions().FilesystemOptions().
+				SetFilePathPrefix(dir)
+		nsCtx = namespace.Context{ID: defaultTestNs1ID}
+		data  = []byte{1, 2, 3}
+	)
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for _, blockStart := range blockStarts {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  defaultTestNs1ID,
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+			ident.Tags{}, persist.MetadataOptions{})
+		require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+		require.NoError(t, writer.Close())
+	}
+
+	// Corrupt the data file of the first block.
+	fileSets, err := fs.DataFiles(dir, defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 2)
+	corrupted := false
+	for _, filePath := range fileSets[0].AbsoluteFilePaths {
+		if strings.HasSuffix(filePath, "-data.db") {
+			require.NoError(t, ioutil.WriteFile(filePath, []byte{1, 2, 4}, 0666))
+			corrupted = true
+		}
+	}
+	require.True(t, corrupted)
+
+	// The block is fetched from peers and restored.
+	fetched := result.NewShardResult(result.NewOptions())
+	segment := ts.NewSegment(checked.NewBytes(data, nil), nil, 0, ts.FinalizeNone)
+	fetched.AddBlock(ident.StringID("foo"), ident.Tags{},
+		block.NewDatabaseBlock(start, blockSize, segment, opts.DatabaseBlockOptions(), nsCtx))
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().
+		FetchBootstrapBlocksFromPeers(gomock.Any(), uint32(0), start, start.Add(blockSize), gomock.Any()).
+		Return(fetched, nil)
+	adminClient := client.NewMockAdminClient(ctrl)
+	adminClient.EXPECT().DefaultAdminSession().Return(session, nil)
+
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetFileSetScrubOptions(FileSetScrubOptions{
+			Enabled:     true,
+			Interval:    time.Hour,
+			AdminClient: adminClient,
+		})
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	ns.EXPECT().Options().Return(defaultTestNs1Opts).AnyTimes()
+	ns.EXPECT().Metadata().Return(s.namespace).AnyTimes()
+	ns.EXPECT().OwnedShards().Return([]databaseShard{s}).AnyTimes()
+	ns.EXPECT().ReadableShardAt(uint32(0)).Return(s, nsCtx, nil).AnyTimes()
+	db := NewMockdatabase(ctrl)
+	db.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns}, nil).AnyTimes()
+
+	fileOps := &testFileOpsController{}
+	scrubber := newDatabaseScrubber(db, fileOps, opts).(*dbScrubber)
+	require.NoError(t, scrubber.Scrub())
+	require.Empty(t, scrubber.pendingRestores)
+	require.Equal(t, fileOps.disabled, fileOps.enabled)
+
+	// The corrupt volume is quarantined.
+	quarantined, err := fs.DataFiles(fs.QuarantineDirPath(dir), defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	require.Equal(t, start, quarantined[0].ID.BlockStart)
+
+	// The block is read from the restored volume.
+	state, err := s.FlushState(start)
+	require.NoError(t, err)
+	require.Equal(t, 1, state.ColdVersionRetrievable)
+	fileSets, err = fs.DataFiles(dir, defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 2)
+	for _, fileSet := range fileSets {
+		_, err := fs.ScrubDataFileSet(fileSet.ID, fs.ScrubOptions{FilePathPrefix: dir})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, fileSets[0].ID.VolumeIndex)
+
+	// Scrubbing again finds no corrupt volume.
+	require.NoError(t, scrubber.Scrub())
+	quarantined, err = fs.DataFiles(fs.QuarantineDirPath(dir), defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	require.True(t, fs.IsQuarantinedFileSetRestored(quarantined[0]))
+}
+
+func TestScrubberRediscoversQuarantinedFileSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize).Add(-3 * blockSize)
+		opts      = DefaultTestOptions()
+		fsOpts    = opts.CommitLogOptions().FilesystemOptions().
+				SetFilePathPrefix(dir)
+		nsCtx = namespace.Context{ID: defaultTestNs1ID}
+		data  = []byte{1, 2, 3}
+	)
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  defaultTestNs1ID,
+			BlockStart: start,
+		},
+		BlockSize: blockSize,
+	}))
+	bytes := checked.NewBytes(data, nil)
+	bytes.IncRef()
+	meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+		ident.Tags{}, persist.MetadataOptions{})
+	require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+	require.NoError(t, writer.Close())
+
+	// Quarantine the volume as a scrub that did not get to restore it before
+	// the process restarted would have.
+	fileSets, err := fs.DataFiles(dir, defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 1)
+	_, err = fs.QuarantineFileSet(dir, fileSets[0], fsOpts.NewDirectoryMode())
+	require.NoError(t, err)
+
+	// The block is fetched from peers and restored once.
+	fetched := result.NewShardResult(result.NewOptions())
+	segment := ts.NewSegment(checked.NewBytes(data, nil), nil, 0, ts.FinalizeNone)
+	fetched.AddBlock(ident.StringID("foo"), ident.Tags{},
+		block.NewDatabaseBlock(start, blockSize, segment, opts.DatabaseBlockOptions(), nsCtx))
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().
+		FetchBootstrapBlocksFromPeers(gomock.Any(), uint32(0), start, start.Add(blockSize), gomock.Any()).
+		Return(fetched, nil)
+	adminClient := client.NewMockAdminClient(ctrl)
+	adminClient.EXPECT().DefaultAdminSession().Return(session, nil)
+
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetFileSetScrubOptions(FileSetScrubOptions{
+			Enabled:     true,
+			Interval:    time.Hour,
+			AdminClient: adminClient,
+		})
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	ns.EXPECT().Options().Return(defaultTestNs1Opts).AnyTimes()
+	ns.EXPECT().Metadata().Return(s.namespace).AnyTimes()
+	ns.EXPECT().OwnedShards().Return([]databaseShard{s}).AnyTimes()
+	ns.EXPECT().ReadableShardAt(uint32(0)).Return(s, nsCtx, nil).AnyTimes()
+	db := NewMockdatabase(ctrl)
+	db.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns}, nil).AnyTimes()
+
+	scrubber := newDatabaseScrubber(db, &testFileOpsController{}, opts).(*dbScrubber)
+	require.NoError(t, scrubber.Scrub())
+	require.Empty(t, scrubber.pendingRestores)
+
+	fileSets, err = fs.DataFiles(dir, defaultTestNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, fileSets, 1)
+	require.Equal(t, start, fileSets[0].ID.BlockStart)
+
+	// A restarted scrubber does not restore the volume again.
+	scrubber = newDatabaseScrubber(db, &testFileOpsController{}, opts).(*dbScrubber)
+	require.NoError(t, scrubber.Scrub())
+	require.Empty(t, scrubber.pendingRestores)
+}
+
+func TestScrubberRebuildsQuarantinedIndexBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		indexBlockSize = defaultTestNs1Opts.IndexOptions().BlockSize()
+		blockStart     = xtime.Now().Truncate(indexBlockSize).Add(-2 * indexBlockSize)
+		checkpointPath = filepath.Join(dir, "fileset-1-0-checkpoint.db")
+		quarantined    = fs.FileSetFile{AbsoluteFilePaths: []string{checkpointPath}}
+	)
+	require.NoError(t, ioutil.WriteFile(checkpointPath, nil, 0666))
+
+	flush := persist.NewMockIndexFlush(ctrl)
+	pm := persist.NewMockManager(ctrl)
+	opts := DefaultTestOptions().SetPersistManager(pm)
+
+	shards := []databaseShard{NewMockdatabaseShard(ctrl)}
+	idx := NewMockNamespaceIndex(ctrl)
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	ns.EXPECT().Options().Return(defaultTestNs1Opts).AnyTimes()
+	ns.EXPECT().OwnedShards().Return(shards).AnyTimes()
+	ns.EXPECT().Index().Return(idx, nil).AnyTimes()
+
+	fileOps := &testFileOpsController{}
+	scrubber := newDatabaseScrubber(nil, fileOps, opts).(*dbScrubber)
+	key := scrubRebuildKey{
+		namespace:  defaultTestNs1ID.String(),
+		blockStart: blockStart,
+	}
+	scrubber.pendingRebuilds[key] = quarantined
+
+	// The index block is not rebuilt while its data is being restored.
+	restoreKey := scrubRestoreKey{
+		namespace:    defaultTestNs1ID.String(),
+		fileSetStart: blockStart,
+	}
+	scrubber.pendingRestores[restoreKey] = scrubRestore{blockSize: 2 * time.Hour}
+	require.NoError(t, scrubber.rebuildIndexBlocks([]databaseNamespace{ns}))
+	require.Len(t, scrubber.pendingRebuilds, 1)
+	require.Equal(t, 0, fileOps.disabled)
+
+	delete(scrubber.pendingRestores, restoreKey)
+	pm.EXPECT().StartIndexPersist().Return(flush, nil)
+	idx.EXPECT().RebuildBlock(flush, blockStart, shards).Return(nil)
+	flush.EXPECT().DoneIndex().Return(nil)
+	require.NoError(t, scrubber.rebuildIndexBlocks([]databaseNamespace{ns}))
+	require.Empty(t, scrubber.pendingRebuilds)
+	require.Equal(t, 1, fileOps.disabled)
+	require.Equal(t, 1, fileOps.enabled)
+
+	_, err = os.Stat(filepath.Join(dir, "fileset-1-0-restored.db"))
+	require.NoError(t, err)
+}
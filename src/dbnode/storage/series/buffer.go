@@ -143,6 +143,8 @@ type databaseBuffer interface {
 
 	Load(bl block.DatabaseBlock, writeType WriteType)
 
+	RemoveRange(r xtime.Range, nsCtx namespace.Context) error
+
 	Reset(opts databaseBufferResetOptions)
 }
 
@@ -536,6 +538,33 @@ func (b *dbBuffer) Load(bl block.DatabaseBlock, writeType WriteType) {
 	bucket.loadedBlocks = append(bucket.loadedBlocks, bl)
 }
 
+func (b *dbBuffer) RemoveRange(r xtime.Range, nsCtx namespace.Context) error {
+	var (
+		blockSize = b.opts.RetentionOptions().BlockSize()
+		multiErr  xerrors.MultiError
+	)
+	for blockStart := r.Start.Truncate(blockSize); blockStart.Before(r.End); blockStart = blockStart.Add(blockSize) {
+		buckets, exists := b.bucketVersionsAt(blockStart)
+		if !exists {
+			continue
+		}
+		if !r.Contains(xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}) {
+			if err := buckets.removeRange(r, nsCtx); err != nil {
+				multiErr = multiErr.Add(err)
+			}
+			if len(buckets.buckets) > 0 {
+				continue
+			}
+		}
+		// Buckets get reset before use, releasing their encoders and loaded blocks.
+		for _, bucket := range buckets.buckets {
+			buckets.bucketPool.Put(bucket)
+		}
+		b.removeBucketVersionsAt(blockStart)
+	}
+	return multiErr.FinalError()
+}
+
 func (b *dbBuffer) Snapshot(
 	ctx context.Context,
 	blockStart xtime.UnixNano,
@@ -665,7 +694,7 @@ func (b *dbBuffer) WarmFlush(
 		// there be buckets for previous versions. In this case, we need to try
 		// to flush them again, so we merge them together to one stream and
 		// persist it.
-		encoder, _, err := mergeStreamsToEncoder(blockStart, streams, b.opts, nsCtx)
+		encoder, _, err := mergeStreamsToEncoder(blockStart, streams, xtime.Range{}, b.opts, nsCtx)
 		if err != nil {
 			return FlushOutcomeErr, err
 		}
@@ -1143,6 +1172,29 @@ func (b *BufferBucketVersions) removeBucketsUpToVersion(
 	b.buckets = nonEvictedBuckets
 }
 
+// removeRange drops the datapoints within the range from every bucket,
+// removing the buckets left without datapoints.
+func (b *BufferBucketVersions) removeRange(r xtime.Range, nsCtx namespace.Context) error {
+	var (
+		remaining = make([]*BufferBucket, 0, len(b.buckets))
+		multiErr  xerrors.MultiError
+	)
+	for _, bucket := range b.buckets {
+		empty, err := bucket.removeRange(r, nsCtx)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+		if empty {
+			// Bucket gets reset before use.
+			b.bucketPool.Put(bucket)
+			continue
+		}
+		remaining = append(remaining, bucket)
+	}
+	b.buckets = remaining
+	return multiErr.FinalError()
+}
+
 func (b *BufferBucketVersions) setLastRead(value time.Time) {
 	atomic.StoreInt64(&b.lastReadUnixNanos, value.UnixNano())
 }
@@ -1521,7 +1573,7 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 		}
 	}
 
-	encoder, lastWriteAt, err := mergeStreamsToEncoder(start, readers, b.opts, nsCtx)
+	encoder, lastWriteAt, err := mergeStreamsToEncoder(start, readers, xtime.Range{}, b.opts, nsCtx)
 	if err != nil {
 		return 0, err
 	}
@@ -1537,12 +1589,61 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 	return merges, nil
 }
 
-// mergeStreamsToEncoder merges streams to an encoder and returns the last
-// write time. It is the responsibility of the caller to close the returned
-// encoder when appropriate.
+// removeRange re-encodes the bucket without the datapoints within the range
+// and returns true if the bucket is left without datapoints.
+func (b *BufferBucket) removeRange(r xtime.Range, nsCtx namespace.Context) (bool, error) {
+	var (
+		readers = make([]xio.SegmentReader, 0, len(b.encoders)+len(b.loadedBlocks))
+		streams = make([]xio.SegmentReader, 0, len(b.encoders))
+		ctx     = b.opts.ContextPool().Get()
+	)
+	defer func() {
+		ctx.Close()
+		for _, stream := range streams {
+			stream.Finalize()
+		}
+	}()
+
+	for i := range b.loadedBlocks {
+		block, err := b.loadedBlocks[i].Stream(ctx)
+		if err == nil && block.SegmentReader != nil {
+			readers = append(readers, block.SegmentReader)
+		}
+	}
+
+	for i := range b.encoders {
+		if s, ok := b.encoders[i].encoder.Stream(ctx); ok {
+			readers = append(readers, s)
+			streams = append(streams, s)
+		}
+	}
+
+	encoder, lastWriteAt, err := mergeStreamsToEncoder(b.start, readers, r, b.opts, nsCtx)
+	if err != nil {
+		return false, err
+	}
+
+	b.resetEncoders()
+	b.resetLoadedBlocks()
+
+	if encoder.NumEncoded() == 0 {
+		encoder.Close()
+		return true, nil
+	}
+	b.encoders = append(b.encoders, inOrderEncoder{
+		encoder:     encoder,
+		lastWriteAt: lastWriteAt,
+	})
+	return false, nil
+}
+
+// mergeStreamsToEncoder merges streams to an encoder, dropping the datapoints
+// within the excluded range, and returns the last write time. It is the
+// responsibility of the caller to close the returned encoder when appropriate.
 func mergeStreamsToEncoder(
 	blockStart xtime.UnixNano,
 	streams []xio.SegmentReader,
+	exclude xtime.Range,
 	opts Options,
 	nsCtx namespace.Context,
 ) (encoding.Encoder, xtime.UnixNano, error) {
@@ -1556,6 +1657,9 @@ func mergeStreamsToEncoder(
 	iter.Reset(streams, blockStart, opts.RetentionOptions().BlockSize(), nsCtx.Schema)
 	for iter.Next() {
 		dp, unit, annotation := iter.Current()
+		if !dp.TimestampNanos.Before(exclude.Start) && dp.TimestampNanos.Before(exclude.End) {
+			continue
+		}
 		if err := encoder.Encode(dp, unit, annotation); err != nil {
 			encoder.Close()
 			return nil, 0, err
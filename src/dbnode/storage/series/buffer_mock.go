@@ -186,6 +186,20 @@ func (mr *MockdatabaseBufferMockRecorder) ReadEncoded(ctx, start, end, nsCtx int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEncoded", reflect.TypeOf((*MockdatabaseBuffer)(nil).ReadEncoded), ctx, start, end, nsCtx)
 }
 
+// RemoveRange mocks base method.
+func (m *MockdatabaseBuffer) RemoveRange(r time.Range, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRange", r, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRange indicates an expected call of RemoveRange.
+func (mr *MockdatabaseBufferMockRecorder) RemoveRange(r, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRange", reflect.TypeOf((*MockdatabaseBuffer)(nil).RemoveRange), r, nsCtx)
+}
+
 // Reset mocks base method.
 func (m *MockdatabaseBuffer) Reset(opts databaseBufferResetOptions) {
 	m.ctrl.T.Helper()
@@ -1066,6 +1066,50 @@ func TestBufferRemoveBucket(t *testing.T) {
 	assert.True(t, buffer.IsEmpty())
 }
 
+func TestBufferRemoveRange(t *testing.T) {
+	opts := newBufferTestOptions()
+	rops := opts.RetentionOptions()
+	curr := xtime.Now().Truncate(rops.BlockSize())
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr.ToTime()
+	}))
+	buffer := newDatabaseBuffer().(*dbBuffer)
+	buffer.Reset(databaseBufferResetOptions{
+		Options: opts,
+	})
+
+	// Perform out of order writes that will create two in order encoders.
+	data := []DecodedTestValue{
+		{curr, 1, xtime.Second, nil},
+		{curr.Add(mins(0.5)), 2, xtime.Second, nil},
+		{curr.Add(mins(0.5)).Add(-5 * time.Second), 3, xtime.Second, nil},
+		{curr.Add(mins(1.0)), 4, xtime.Second, nil},
+	}
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToBufferSuccess(t, testID, buffer, v, nil)
+	}
+
+	// Only the datapoints within the range are removed.
+	removed := xtime.Range{Start: start.Add(secs(1)), End: start.Add(mins(1.0))}
+	require.NoError(t, buffer.RemoveRange(removed, namespace.Context{}))
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	results, err := buffer.ReadEncoded(ctx, 0, timeDistantFuture, namespace.Context{})
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, []DecodedTestValue{data[0], data[3]}, results, opts, namespace.Context{})
+
+	// The buckets of the block are removed once all of its datapoints are.
+	removed = xtime.Range{Start: start, End: start.Add(mins(2.0))}
+	require.NoError(t, buffer.RemoveRange(removed, namespace.Context{}))
+	_, exists := buffer.bucketVersionsAt(start)
+	require.False(t, exists)
+	assert.True(t, buffer.IsEmpty())
+}
+
 func TestBuffertoStream(t *testing.T) {
 	opts := newBufferTestOptions()
 
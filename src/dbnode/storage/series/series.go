@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/m3ninx/doc"
@@ -306,6 +307,38 @@ func (s *dbSeries) NumActiveBlocks() int {
 	return value
 }
 
+func (s *dbSeries) DeleteRange(r xtime.Range, nsCtx namespace.Context) error {
+	s.Lock()
+	defer s.Unlock()
+
+	err := s.buffer.RemoveRange(r, nsCtx)
+
+	var (
+		blockSize   = s.opts.RetentionOptions().BlockSize()
+		cachePolicy = s.opts.CachePolicy()
+	)
+	for blockStart := r.Start.Truncate(blockSize); blockStart.Before(r.End); blockStart = blockStart.Add(blockSize) {
+		// Cached blocks that are only partially deleted are kept, the deleted
+		// data is filtered out when they are read.
+		if !r.Contains(xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}) {
+			continue
+		}
+		b, ok := s.cachedBlocks.BlockAt(blockStart)
+		if !ok {
+			continue
+		}
+		s.cachedBlocks.RemoveBlockAt(blockStart)
+		// Blocks retrieved from disk while using the LRU policy are owned
+		// by the WiredList which will close them once evicted, see
+		// updateBlocksWithLock for details.
+		if cachePolicy == CacheLRU && b.WasRetrievedFromDisk() {
+			continue
+		}
+		b.Close()
+	}
+	return err
+}
+
 func (s *dbSeries) Write(
 	ctx context.Context,
 	timestamp xtime.UnixNano,
@@ -407,8 +440,15 @@ func (s *dbSeries) FetchBlocksForColdFlush(
 	// to be modified.
 	s.Lock()
 	result, err := s.buffer.FetchBlocksForColdFlush(ctx, start, version, nsCtx)
+	expired := s.blockExpiredWithLock(start)
 	s.Unlock()
 
+	if err == nil && expired {
+		// The buckets are still marked as flushed so that they are evicted
+		// from the buffer, but the data past the retention of the series
+		// is not persisted.
+		return block.FetchBlockResult{}, nil
+	}
 	return result, err
 }
 
@@ -606,10 +646,21 @@ func (s *dbSeries) WarmFlush(
 	// Need a write lock because the buffer WarmFlush method mutates
 	// state (by performing a pro-active merge).
 	s.Lock()
-	outcome, err := s.buffer.WarmFlush(ctx, blockStart,
+	defer s.Unlock()
+
+	if s.blockExpiredWithLock(blockStart) {
+		// The buckets are evicted from the buffer once the block is flushed.
+		return FlushOutcomeBlockDoesNotExist, nil
+	}
+	return s.buffer.WarmFlush(ctx, blockStart,
 		persist.NewMetadata(s.metadata), persistFn, nsCtx)
-	s.Unlock()
-	return outcome, err
+}
+
+// blockExpiredWithLock returns whether a block is past the retention of the
+// series, which is shorter than the retention of the namespace when the
+// series matches a retention override of the namespace.
+func (s *dbSeries) blockExpiredWithLock(blockStart xtime.UnixNano) bool {
+	return blockStart.Before(retention.FlushTimeStart(s.opts.RetentionOptions(), s.now()))
 }
 
 func (s *dbSeries) Snapshot(
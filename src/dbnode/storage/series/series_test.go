@@ -555,6 +555,46 @@ func TestSeriesFlush(t *testing.T) {
 	}
 }
 
+func TestSeriesFlushExpiredBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	curr := xtime.FromSeconds(7200)
+	opts := newSeriesTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr.ToTime()
+	}))
+	ropts := opts.RetentionOptions()
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().
+		IsBlockRetrievable(gomock.Any()).
+		Return(false, nil).
+		AnyTimes()
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		BlockRetriever: blockRetriever,
+		Options:        opts,
+	}).(*dbSeries)
+
+	blockStart := curr.Truncate(ropts.BlockSize())
+	ctx := context.NewBackground()
+	series.buffer.Write(ctx, testID, curr, 1234, xtime.Second, nil, WriteOptions{})
+	ctx.BlockingClose()
+
+	// Data past the retention of the series is not flushed.
+	curr = curr.Add(ropts.RetentionPeriod() + ropts.BlockSize())
+	persistFn := func(_ persist.Metadata, _ ts.Segment, _ uint32) error {
+		require.FailNow(t, "expired block flushed")
+		return nil
+	}
+	ctx = context.NewBackground()
+	outcome, err := series.WarmFlush(ctx, blockStart, persistFn, namespace.Context{})
+	ctx.BlockingClose()
+	require.NoError(t, err)
+	require.Equal(t, FlushOutcomeBlockDoesNotExist, outcome)
+}
+
 func TestSeriesTickEmptySeries(t *testing.T) {
 	opts := newSeriesTestOptions()
 	series := NewDatabaseSeries(DatabaseSeriesOptions{
@@ -118,6 +118,10 @@ type DatabaseSeries interface {
 	// NumActiveBlocks returns the number of active blocks the series currently holds.
 	NumActiveBlocks() int
 
+	// DeleteRange drops the buffered data the series holds within the range
+	// and the cached blocks the range covers entirely.
+	DeleteRange(r xtime.Range, nsCtx namespace.Context) error
+
 	// LoadBlock loads a single block into the series.
 	LoadBlock(
 		block block.DatabaseBlock,
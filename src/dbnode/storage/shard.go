@@ -117,6 +117,7 @@ type dbShard struct {
 	block.DatabaseBlockRetriever
 	opts                  Options
 	seriesOpts            series.Options
+	retentionOverrides    retentionOverrides
 	nowFn                 clock.NowFn
 	namespace             namespace.Metadata
 	seriesBlockRetriever  series.QueryableBlockRetriever
@@ -145,6 +146,8 @@ type dbShard struct {
 	identifierPool           ident.Pool
 	contextPool              context.Pool
 	flushState               shardFlushState
+	tombstones               *shardTombstones
+	exemplars                *shardExemplars
 	tickWg                   *sync.WaitGroup
 	runtimeOptsListenClosers []xresource.SimpleCloser
 	currRuntimeOptions       dbShardRuntimeOptions
@@ -257,13 +260,18 @@ func newDatabaseShard(
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
+	retentionOverrides retentionOverrides,
 ) databaseShard {
 	scope := opts.InstrumentOptions().MetricsScope().
 		SubScope("dbshard")
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	logger := opts.InstrumentOptions().Logger()
+	blockSize := namespaceMetadata.Options().RetentionOptions().BlockSize()
 
 	s := &dbShard{
 		opts:                 opts,
 		seriesOpts:           seriesOpts,
+		retentionOverrides:   retentionOverrides,
 		nowFn:                opts.ClockOptions().NowFn(),
 		state:                dbShardStateOpen,
 		namespace:            namespaceMetadata,
@@ -285,13 +293,21 @@ func newDatabaseShard(
 		identifierPool:       opts.IdentifierPool(),
 		contextPool:          opts.ContextPool(),
 		flushState:           newShardFlushState(),
-		tickWg:               &sync.WaitGroup{},
-		coldWritesEnabled:    namespaceMetadata.Options().ColdWritesEnabled(),
-		indexEnabled:         namespaceMetadata.Options().IndexOptions().Enabled(),
-		logger:               opts.InstrumentOptions().Logger(),
-		metrics:              newDatabaseShardMetrics(shard, scope),
-		tileAggregator:       opts.TileAggregator(),
-		entryMetrics:         NewEntryMetrics(scope.SubScope("entries")),
+		tombstones: newShardTombstones(fsOpts, namespaceMetadata.ID(), shard, blockSize,
+			opts.MaxTombstonesPerShard(), logger),
+		tickWg:            &sync.WaitGroup{},
+		coldWritesEnabled: namespaceMetadata.Options().ColdWritesEnabled(),
+		indexEnabled:      namespaceMetadata.Options().IndexOptions().Enabled(),
+		logger:            opts.InstrumentOptions().Logger(),
+		metrics:           newDatabaseShardMetrics(shard, scope),
+		tileAggregator:    opts.TileAggregator(),
+		entryMetrics:      NewEntryMetrics(scope.SubScope("entries")),
+	}
+	// NB: annotations of proto namespaces are proto encoded values rather
+	// than payloads so they never carry exemplars.
+	_, protoEnabled := namespaceMetadata.Options().SchemaHistory().GetLatest()
+	if maxExemplars := opts.MaxExemplarsPerShard(); maxExemplars > 0 && !protoEnabled {
+		s.exemplars = newShardExemplars(maxExemplars, fsOpts, namespaceMetadata.ID(), shard, logger)
 	}
 	s.insertQueue = newDatabaseShardInsertQueue(s.insertSeriesBatch,
 		s.nowFn, opts.CoreFn(), scope, opts.InstrumentOptions().Logger())
@@ -650,6 +666,8 @@ func (s *dbShard) Close() error {
 	// any active ticks.
 	s.tickWg.Wait()
 
+	s.persistExemplars()
+
 	// NB(r): Asynchronously we purge expired series to ensure pressure on the
 	// GC is not placed all at one time.  If the deadline is too low and still
 	// causes the GC to impact performance when closing shards the deadline
@@ -904,6 +922,13 @@ func (s *dbShard) writeAndIndex(
 	wOpts series.WriteOptions,
 	shouldReverseIndex bool,
 ) (SeriesWrite, error) {
+	// Exemplars are stored separately from the series so they are stripped
+	// from the annotation before it is written.
+	var exemplars []ts.Exemplar
+	if s.exemplars != nil {
+		annotation, exemplars = s.exemplars.Extract(annotation)
+	}
+
 	// Prepare write
 	entry, opts, err := s.TryRetrieveSeriesAndIncrementReaderWriterCount(id)
 	if err != nil {
@@ -1020,6 +1045,10 @@ func (s *dbShard) writeAndIndex(
 		commitLogSeriesUniqueIndex = result.entry.Index
 	}
 
+	if s.exemplars != nil {
+		s.exemplars.Add(id, exemplars)
+	}
+
 	// Return metadata useful for writing to commit log and indexing.
 	return SeriesWrite{
 		Series: ts.Series{
@@ -1108,15 +1137,24 @@ func (s *dbShard) ReadEncoded(
 		return nil, err
 	}
 
+	var iter series.BlockReaderIter
 	if entry != nil {
-		return entry.Series.ReadEncoded(ctx, start, end, nsCtx)
+		iter, err = entry.Series.ReadEncoded(ctx, start, end, nsCtx)
+	} else {
+		retriever := s.seriesBlockRetriever
+		onRetrieve := s.seriesOnRetrieveBlock
+		opts := s.seriesOpts
+		reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, nil, opts)
+		iter, err = reader.ReadEncoded(ctx, start, end, nsCtx)
+	}
+	if err != nil || iter == nil {
+		return iter, err
 	}
 
-	retriever := s.seriesBlockRetriever
-	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
-	reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, nil, opts)
-	return reader.ReadEncoded(ctx, start, end, nsCtx)
+	if deleted := s.tombstones.Deleted(id); deleted != nil && len(deleted.blocks) > 0 {
+		iter = newTombstonedBlockReaderIter(iter, deleted, s.opts, nsCtx)
+	}
+	return iter, nil
 }
 
 // lookupEntryWithLock returns the entry for a given id while holding a read lock or a write lock.
@@ -1226,7 +1264,7 @@ func (s *dbShard) newShardEntry(
 		BlockRetriever:         s.seriesBlockRetriever,
 		OnRetrieveBlock:        s.seriesOnRetrieveBlock,
 		OnEvictedFromWiredList: s,
-		Options:                s.seriesOpts,
+		Options:                s.retentionOverrides.seriesOptions(seriesMetadata, s.seriesOpts),
 	})
 	return NewEntry(NewEntryOptions{
 		Shard:        s,
@@ -1613,18 +1651,28 @@ func (s *dbShard) FetchBlocks(
 		return nil, err
 	}
 
+	var results []block.FetchBlockResult
 	if entry != nil {
-		return entry.Series.FetchBlocks(ctx, starts, nsCtx)
+		results, err = entry.Series.FetchBlocks(ctx, starts, nsCtx)
+	} else {
+		retriever := s.seriesBlockRetriever
+		onRetrieve := s.seriesOnRetrieveBlock
+		opts := s.seriesOpts
+		// Nil for onRead callback because we don't want peer bootstrapping to impact
+		// the behavior of the LRU
+		var onReadCb block.OnReadBlock
+		reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, onReadCb, opts)
+		results, err = reader.FetchBlocks(ctx, starts, nsCtx)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	retriever := s.seriesBlockRetriever
-	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
-	// Nil for onRead callback because we don't want peer bootstrapping to impact
-	// the behavior of the LRU
-	var onReadCb block.OnReadBlock
-	reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, onReadCb, opts)
-	return reader.FetchBlocks(ctx, starts, nsCtx)
+	if deleted := s.tombstones.Deleted(id); deleted != nil && len(deleted.blocks) > 0 {
+		blockSize := s.namespace.Options().RetentionOptions().BlockSize()
+		return filterTombstonedFetchBlockResults(ctx, results, deleted, blockSize, s.opts, nsCtx)
+	}
+	return results, nil
 }
 
 func (s *dbShard) FetchBlocksForColdFlush(
@@ -1654,6 +1702,7 @@ func (s *dbShard) fetchActiveBlocksMetadata(
 	var (
 		res             = s.opts.FetchBlocksMetadataResultsPool().Get()
 		fetchCtx        = s.contextPool.Get()
+		blockSize       = s.namespace.Options().RetentionOptions().BlockSize()
 		nextIndexCursor *int64
 	)
 
@@ -1684,6 +1733,11 @@ func (s *dbShard) fetchActiveBlocksMetadata(
 			return false
 		}
 
+		if deleted := s.tombstones.Deleted(metadata.ID); deleted != nil && len(deleted.blocks) > 0 {
+			metadata.Blocks = filterTombstonedFetchBlockMetadataResults(metadata.Blocks,
+				deleted, blockSize, s.opts.FetchBlockMetadataResultsPool())
+		}
+
 		// If the blocksMetadata is empty, the series have no data within the specified
 		// time range so we don't return it to the client
 		if len(metadata.Blocks.Results()) == 0 {
@@ -1802,7 +1856,7 @@ func (s *dbShard) FetchBlocksMetadataV2(
 	// Work backwards while in requested range and not before retention.
 	for !blockStart.Before(start) &&
 		!blockStart.Before(retention.FlushTimeStart(ropts, now)) {
-		exists, err := s.namespaceReaderMgr.filesetExistsAt(s.shard, blockStart)
+		fileSetStart, exists, err := s.namespaceReaderMgr.filesetExistsAt(s.shard, blockStart)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1812,6 +1866,12 @@ func (s *dbShard) FetchBlocksMetadataV2(
 			continue
 		}
 
+		// Blocks compacted into the fileset of a larger block are sliced out
+		// of the data of the compacted fileset, so the series of compacted
+		// filesets are read with their data rather than only their metadata.
+		compacted := fileSetStart != blockStart ||
+			s.flushStateNoBootstrapCheck(blockStart).CompactedBlockSize > 0
+
 		var pos readerPosition
 		if !tokenBlockStart.IsZero() {
 			// Was previously seeking through a previous block, need to validate
@@ -1826,18 +1886,33 @@ func (s *dbShard) FetchBlocksMetadataV2(
 			// the token's block start on next iteration.
 			tokenBlockStart = 0
 
-			pos.metadataIdx = int(flushedPhase.CurrBlockEntryIdx)
+			if compacted {
+				pos.dataIdx = int(flushedPhase.CurrBlockEntryIdx)
+			} else {
+				pos.metadataIdx = int(flushedPhase.CurrBlockEntryIdx)
+			}
 			pos.volume = int(flushedPhase.Volume)
 		}
 
 		// Open a reader at this position, potentially from cache.
-		reader, err := s.namespaceReaderMgr.get(s.shard, blockStart, pos)
+		reader, err := s.namespaceReaderMgr.get(s.shard, fileSetStart, pos)
 		if err != nil {
 			return nil, nil, err
 		}
 
 		for numResults < limit {
-			id, tags, size, checksum, err := reader.ReadMetadata()
+			var (
+				id       ident.ID
+				tags     ident.TagIterator
+				size     int
+				checksum uint32
+				err      error
+			)
+			if compacted {
+				id, tags, size, checksum, err = s.readCompactedMetadata(reader, blockStart)
+			} else {
+				id, tags, size, checksum, err = reader.ReadMetadata()
+			}
 			if err == io.EOF {
 				// Clean end of volume, we can break now.
 				if err := reader.Close(); err != nil {
@@ -1857,6 +1932,15 @@ func (s *dbShard) FetchBlocksMetadataV2(
 					blockStart, err)
 			}
 
+			if s.tombstones.Deleted(id).blockDeleted(blockStart, blockSize) ||
+				(compacted && size == 0) {
+				// Do not expose tombstoned blocks to peers, nor the series of
+				// compacted filesets without datapoints in the block.
+				id.Finalize()
+				tags.Close()
+				continue
+			}
+
 			blockResult := s.opts.FetchBlockMetadataResultsPool().Get()
 			value := block.FetchBlockMetadataResult{
 				Start: blockStart,
@@ -1876,6 +1960,9 @@ func (s *dbShard) FetchBlocksMetadataV2(
 		}
 
 		endPos := int64(reader.MetadataRead())
+		if compacted {
+			endPos = int64(reader.EntriesRead())
+		}
 		// This volume may be different from the one initially requested,
 		// e.g. if there was a compaction between the last call and this
 		// one, so be sure to update the state of the pageToken. If this is not
@@ -1915,6 +2002,111 @@ func (s *dbShard) FetchBlocksMetadataV2(
 	return result, nil, nil
 }
 
+// readCompactedMetadata reads the next series of a compacted fileset and
+// returns the size and checksum of the data of the series within the block,
+// which is sliced out of the compacted data the same way the block retriever
+// does so that they match the data of the block streamed to peers.
+func (s *dbShard) readCompactedMetadata(
+	reader fs.DataFileSetReader,
+	blockStart xtime.UnixNano,
+) (ident.ID, ident.TagIterator, int, uint32, error) {
+	id, tags, data, checksum, err := reader.Read()
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	var (
+		nsCtx     = namespace.NewContextFrom(s.namespace)
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		segment   = ts.NewSegment(data, nil, checksum, ts.FinalizeHead)
+	)
+	sliced, err := fs.SliceCompactedSegment(segment, blockStart, blockSize,
+		nsCtx.Schema, s.opts.MultiReaderIteratorPool(), s.opts.EncoderPool())
+	segment.Finalize()
+	if err != nil {
+		id.Finalize()
+		tags.Close()
+		return nil, nil, 0, 0, err
+	}
+
+	size, checksum := sliced.Len(), sliced.CalculateChecksum()
+	sliced.Finalize()
+	return id, tags, size, checksum, nil
+}
+
+func (s *dbShard) FetchExemplars(
+	id ident.ID,
+	start, end xtime.UnixNano,
+) []ts.Exemplar {
+	if s.exemplars == nil {
+		return nil
+	}
+	if s.tombstones.DeletedInRange(id, start, end.Add(time.Nanosecond)) {
+		return nil
+	}
+	return s.exemplars.Exemplars(id, start, end)
+}
+
+func (s *dbShard) DeleteSeries(
+	ids []ident.ID,
+	r xtime.Range,
+	removedAt xtime.UnixNano,
+) error {
+	// Persist the tombstones first so that the deletion is durable even if
+	// the in-memory data was to be reloaded from disk or the commit log.
+	if err := s.tombstones.Add(ids, r, xtime.ToUnixNano(s.nowFn()), removedAt); err != nil {
+		return err
+	}
+
+	var (
+		nsCtx    = namespace.NewContextFrom(s.namespace)
+		multiErr = xerrors.NewMultiError()
+	)
+	for _, id := range ids {
+		s.RLock()
+		entry, err := s.lookupEntryWithLock(id)
+		if entry != nil {
+			entry.IncrementReaderWriterCount()
+		}
+		s.RUnlock()
+
+		if err == errShardEntryNotFound {
+			continue
+		}
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		if err := entry.Series.DeleteRange(r, nsCtx); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+		entry.DecrementReaderWriterCount()
+	}
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) HasDeletedSeries() bool {
+	return !s.tombstones.Empty()
+}
+
+func (s *dbShard) SeriesDeletedInRange(id ident.ID, start, end xtime.UnixNano) bool {
+	return s.tombstones.DeletedInRange(id, start, end)
+}
+
+func (s *dbShard) DeletedSeriesInRange(start, end xtime.UnixNano) []ident.ID {
+	indexBlockSize := s.namespace.Options().IndexOptions().BlockSize()
+	return s.tombstones.DeletedSeriesInRange(start, end, indexBlockSize)
+}
+
+func (s *dbShard) RemovedSeries() map[xtime.UnixNano][]ident.ID {
+	return s.tombstones.RemovedSeries()
+}
+
+func (s *dbShard) RemoveCompactedTombstones(snapshotStart xtime.UnixNano) error {
+	return s.tombstones.RemoveCompacted(snapshotStart)
+}
+
 func (s *dbShard) PrepareBootstrap(ctx context.Context) error {
 	ctx, span, sampled := ctx.StartSampledTraceSpan(tracepoint.ShardPrepareBootstrap)
 	defer span.Finish()
@@ -1929,7 +2121,17 @@ func (s *dbShard) PrepareBootstrap(ctx context.Context) error {
 	// needs to ask the shard whether certain time windows have been flushed or
 	// not.
 	s.initializeFlushStates()
-	return nil
+
+	if s.exemplars != nil {
+		if err := s.exemplars.Load(); err != nil {
+			s.logger.Error("unable to load shard exemplars",
+				zap.Uint32("shard", s.ID()), zap.Error(err))
+		}
+	}
+
+	// Tombstones must be known before any bootstrapped data is loaded so that
+	// deleted blocks are not resurrected.
+	return s.tombstones.load()
 }
 
 func (s *dbShard) initializeFlushStates() {
@@ -1951,6 +2153,7 @@ func (s *dbShard) initializeFlushStates() {
 }
 
 func (s *dbShard) UpdateFlushStates() {
+	blockSize := s.namespace.Options().RetentionOptions().BlockSize()
 	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
 	readInfoFilesResults := fs.ReadInfoFiles(fsOpts.FilePathPrefix(), s.namespace.ID(), s.shard,
 		fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions(), persist.FileSetFlushType)
@@ -1984,6 +2187,33 @@ func (s *dbShard) UpdateFlushStates() {
 			s.setFlushStateColdVersionRetrievable(at, info.VolumeIndex)
 			s.setFlushStateColdVersionFlushed(at, info.VolumeIndex)
 		}
+
+		// Filesets written by block compaction cover all the blocks within
+		// their larger block size.
+		if compactedBlockSize := time.Duration(info.BlockSize); compactedBlockSize > blockSize &&
+			currState.ColdVersionRetrievable <= info.VolumeIndex {
+			s.markBlocksCompacted(at, compactedBlockSize, info.VolumeIndex)
+		}
+
+		// Offloaded filesets are only known locally by their info, digest
+		// and checkpoint files.
+		if fsOpts.ObjectStore() != nil && currState.ColdVersionRetrievable <= info.VolumeIndex {
+			offloaded, err := fs.DataFileSetOffloaded(fsOpts.FilePathPrefix(), s.namespace.ID(),
+				s.shard, at, info.VolumeIndex)
+			if err != nil {
+				s.logger.Error("unable to check if fileset is offloaded in shard bootstrap",
+					zap.Uint32("shard", s.ID()),
+					zap.Stringer("namespace", s.namespace.ID()),
+					zap.Time("blockStart", at.ToTime()),
+					zap.Error(err))
+			} else if offloaded {
+				offloadedBlockSize := blockSize
+				if compactedBlockSize := time.Duration(info.BlockSize); compactedBlockSize > blockSize {
+					offloadedBlockSize = compactedBlockSize
+				}
+				s.markBlocksOffloaded(at, offloadedBlockSize)
+			}
+		}
 	}
 
 	// Populate index flush state only if enabled.
@@ -1991,7 +2221,6 @@ func (s *dbShard) UpdateFlushStates() {
 		return
 	}
 
-	blockSize := s.namespace.Options().RetentionOptions().BlockSize()
 	indexBlockSize := s.namespace.Options().IndexOptions().BlockSize()
 
 	indexFlushedBlockStarts := s.reverseIndex.WarmFlushBlockStarts()
@@ -2083,14 +2312,37 @@ func (s *dbShard) LoadBlocks(
 		return ErrDatabaseLoadLimitHit
 	}
 
-	multiErr := xerrors.NewMultiError()
+	var (
+		nsCtx    = namespace.NewContextFrom(s.namespace)
+		multiErr = xerrors.NewMultiError()
+	)
 	for _, elem := range seriesToLoad.Iter() {
 		dbBlocks := elem.Value()
 		id := dbBlocks.ID
 		tags := dbBlocks.Tags
 
+		deleted := s.tombstones.Deleted(id)
 		canFinalizeTagsAll := true
-		for _, block := range dbBlocks.Blocks.AllBlocks() {
+		for blockStart, block := range dbBlocks.Blocks.AllBlocks() {
+			if ranges := deleted.blockRanges(blockStart); len(ranges) > 0 {
+				// Drop the data of blocks that was deleted before the blocks
+				// were bootstrapped.
+				if deleted.blockDeleted(blockStart, block.BlockSize()) {
+					block.Close()
+					continue
+				}
+				trimmed, err := trimTombstonedBlock(block, ranges, s.opts, nsCtx)
+				block.Close()
+				if err != nil {
+					multiErr = multiErr.Add(err)
+					continue
+				}
+				if trimmed == nil {
+					continue
+				}
+				block = trimmed
+			}
+
 			result, err := s.loadBlock(id, tags, block)
 			if err != nil {
 				multiErr = multiErr.Add(err)
@@ -2234,10 +2486,14 @@ func (s *dbShard) WarmFlush(
 		DeleteIfExists: false,
 		FileSetType:    persist.FileSetFlushType,
 	}
+	// The tombstones of the block that existed when the flush started are
+	// applied by the flush.
+	startedAt := xtime.ToUnixNano(s.nowFn())
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
 		return err
 	}
+	persistFn := tombstonedDataFn(prepared.Persist, blockStart, s.tombstones, s.opts, nsCtx)
 
 	var multiErr xerrors.MultiError
 	flushCtx := s.contextPool.Get() // From pool so finalizers are from pool.
@@ -2248,7 +2504,7 @@ func (s *dbShard) WarmFlush(
 		// Use a temporary context here so the stream readers can be returned to
 		// the pool after we finish fetching flushing the series.
 		flushCtx.Reset()
-		flushOutcome, err := curr.WarmFlush(flushCtx, blockStart, prepared.Persist, nsCtx)
+		flushOutcome, err := curr.WarmFlush(flushCtx, blockStart, persistFn, nsCtx)
 		// Use BlockingCloseReset so context doesn't get returned to the pool.
 		flushCtx.BlockingCloseReset()
 
@@ -2270,9 +2526,26 @@ func (s *dbShard) WarmFlush(
 		multiErr = multiErr.Add(err)
 	}
 
+	if multiErr.Empty() {
+		s.persistExemplars()
+		s.tombstones.MarkCompacted(blockStart, startedAt, xtime.ToUnixNano(s.nowFn()))
+	}
+
 	return s.markWarmDataFlushStateSuccessOrError(blockStart, multiErr.FinalError())
 }
 
+// persistExemplars writes the exemplars retained by the shard to disk, they
+// are best effort so failures are logged rather than failing the caller.
+func (s *dbShard) persistExemplars() {
+	if s.exemplars == nil {
+		return
+	}
+	if err := s.exemplars.Persist(); err != nil {
+		s.logger.Error("unable to persist shard exemplars",
+			zap.Uint32("shard", s.ID()), zap.Error(err))
+	}
+}
+
 func (s *dbShard) ColdFlush(
 	flushPreparer persist.FlushPreparer,
 	resources coldFlushReusableResources,
@@ -2347,7 +2620,27 @@ func (s *dbShard) ColdFlush(
 		return shardColdFlush{}, loopErr
 	}
 
-	if dirtySeries.Len() == 0 {
+	// Blocks with tombstones that no rewrite has applied yet are merged even
+	// without cold writes so that their deleted data is dropped from disk.
+	compactTombstones := false
+	for _, blockStart := range s.tombstones.BlocksToCompact() {
+		hasWarmFlushed, err := s.hasWarmFlushed(blockStart)
+		if err != nil {
+			return shardColdFlush{}, err
+		}
+		state := s.flushStateNoBootstrapCheck(blockStart)
+		if !hasWarmFlushed || state.CompactedBlockSize > 0 || state.Offloaded {
+			// Compacted and offloaded filesets are not rewritten by cold
+			// flushes, their tombstones remain until they expire.
+			continue
+		}
+		if dirtySeriesToWrite[blockStart] == nil {
+			dirtySeriesToWrite[blockStart] = newIDList(idElementPool)
+		}
+		compactTombstones = true
+	}
+
+	if dirtySeries.Len() == 0 && !compactTombstones {
 		// Early exit if there is nothing dirty to merge. dirtySeriesToWrite
 		// may be non-empty when dirtySeries is empty because we purposely
 		// leave empty seriesLists in the dirtySeriesToWrite map to avoid having
@@ -2355,6 +2648,16 @@ func (s *dbShard) ColdFlush(
 		return shardColdFlush{}, nil
 	}
 
+	// The tombstones of the merged blocks that existed when the merge started
+	// are applied by the merge.
+	startedAt := xtime.ToUnixNano(s.nowFn())
+	flushPreparer = tombstonedFlushPreparer{
+		FlushPreparer: flushPreparer,
+		tombstones:    s.tombstones,
+		opts:          s.opts,
+		nsCtx:         nsCtx,
+	}
+
 	flush := shardColdFlush{
 		shard:   s,
 		doneFns: make([]shardColdFlushDone, 0, len(dirtySeriesToWrite)),
@@ -2390,6 +2693,7 @@ func (s *dbShard) ColdFlush(
 		}
 		flush.doneFns = append(flush.doneFns, shardColdFlushDone{
 			startTime:   startTime,
+			startedAt:   startedAt,
 			nextVersion: nextVersion,
 			close:       close,
 		})
@@ -2618,6 +2922,30 @@ func (s *dbShard) setFlushStateColdVersionFlushed(blockStart xtime.UnixNano, ver
 	s.flushState.Unlock()
 }
 
+func (s *dbShard) setFlushStateCompactedBlockSize(blockStart xtime.UnixNano, blockSize time.Duration) {
+	s.flushState.Lock()
+	state := s.flushState.statesByTime[blockStart]
+	state.CompactedBlockSize = blockSize
+	s.flushState.statesByTime[blockStart] = state
+	s.flushState.Unlock()
+}
+
+func (s *dbShard) setFlushStateOffloaded(blockStart xtime.UnixNano) {
+	s.flushState.Lock()
+	state := s.flushState.statesByTime[blockStart]
+	state.Offloaded = true
+	s.flushState.statesByTime[blockStart] = state
+	s.flushState.Unlock()
+}
+
+func (s *dbShard) setFlushStateExpiredRetentionPeriod(blockStart xtime.UnixNano, period time.Duration) {
+	s.flushState.Lock()
+	state := s.flushState.statesByTime[blockStart]
+	state.ExpiredRetentionPeriod = period
+	s.flushState.statesByTime[blockStart] = state
+	s.flushState.Unlock()
+}
+
 func (s *dbShard) removeAnyFlushStatesTooEarly(startTime xtime.UnixNano) {
 	s.flushState.Lock()
 	earliestFlush := retention.FlushTimeStart(s.namespace.Options().RetentionOptions(), startTime)
@@ -2630,14 +2958,36 @@ func (s *dbShard) removeAnyFlushStatesTooEarly(startTime xtime.UnixNano) {
 }
 
 func (s *dbShard) CleanupExpiredFileSets(earliestToRetain xtime.UnixNano) error {
-	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
-	expired, err := s.filesetPathsBeforeFn(filePathPrefix, s.namespace.ID(), s.ID(), earliestToRetain)
+	// Keep a compacted fileset until all of the blocks it covers expire.
+	earliestFileSetToRetain := earliestToRetain
+	if state := s.flushStateNoBootstrapCheck(earliestToRetain); state.CompactedBlockSize > 0 {
+		earliestFileSetToRetain = earliestToRetain.Truncate(state.CompactedBlockSize)
+	}
+
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	filePathPrefix := fsOpts.FilePathPrefix()
+	expired, err := s.filesetPathsBeforeFn(filePathPrefix, s.namespace.ID(), s.ID(), earliestFileSetToRetain)
 	if err != nil {
 		return fmt.Errorf("encountered errors when getting fileset files for prefix %s namespace %s shard %d: %v",
 			filePathPrefix, s.namespace.ID(), s.ID(), err)
 	}
 
-	return s.deleteFilesFn(expired)
+	if err := s.deleteFilesFn(expired); err != nil {
+		return err
+	}
+
+	if store := fsOpts.ObjectStore(); store != nil {
+		if err := fs.DeleteOffloadedDataFileSetsBefore(store, filePathPrefix,
+			s.namespace.ID(), s.ID(), earliestFileSetToRetain); err != nil {
+			return fmt.Errorf("encountered errors when deleting offloaded filesets for namespace %s shard %d: %v",
+				s.namespace.ID(), s.ID(), err)
+		}
+	}
+
+	// Removed series are filtered from the index block they were removed in
+	// until that block expires.
+	indexBlockSize := s.namespace.Options().IndexOptions().BlockSize()
+	return s.tombstones.RemoveBefore(earliestToRetain, earliestToRetain.Truncate(indexBlockSize))
 }
 
 func (s *dbShard) CleanupCompactedFileSets() error {
@@ -2831,6 +3181,7 @@ func (s *dbShard) finishWriting(
 
 type shardColdFlushDone struct {
 	startTime   xtime.UnixNano
+	startedAt   xtime.UnixNano
 	nextVersion int
 	close       persist.DataCloser
 }
@@ -2854,7 +3205,10 @@ func (s shardColdFlush) Done() error {
 		err := s.shard.finishWriting(startTime, nextVersion, false)
 		if err != nil {
 			multiErr = multiErr.Add(err)
+			continue
 		}
+		s.shard.tombstones.MarkCompacted(startTime, done.startedAt,
+			xtime.ToUnixNano(s.shard.nowFn()))
 	}
 	return multiErr.FinalError()
 }
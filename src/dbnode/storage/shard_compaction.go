@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: Block compaction merges the flushed filesets of the blocks within a
+// larger compacted block into a single fileset, written at the start of the
+// compacted block with a volume index higher than the volumes of all the
+// blocks it covers. The flush state of every covered block is moved to that
+// volume so that the seeker manager reads from the compacted fileset and the
+// filesets it replaces are removed by the compacted filesets cleanup.
+
+// CompactBlocks compacts the flushed filesets of blocks older than the
+// configured block compaction tiers into filesets with larger block sizes.
+func (s *dbShard) CompactBlocks(now xtime.UnixNano, nsCtx namespace.Context) error {
+	tiers := s.opts.BlockCompactionTiers()
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	var (
+		rOpts     = s.namespace.Options().RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		earliest  = retention.FlushTimeStart(rOpts, now)
+		multiErr  xerrors.MultiError
+	)
+	for _, tier := range tiers {
+		if tier.BlockSize <= blockSize || tier.BlockSize%blockSize != 0 {
+			continue
+		}
+
+		// Only compact blocks entirely within retention and older than the
+		// tier's age.
+		start := earliest.Truncate(tier.BlockSize)
+		if start.Before(earliest) {
+			start = start.Add(tier.BlockSize)
+		}
+		end := now.Add(-tier.Age)
+		for blockStart := start; !blockStart.Add(tier.BlockSize).After(end); blockStart = blockStart.Add(tier.BlockSize) {
+			if err := s.compactBlock(blockStart, tier.BlockSize, nsCtx); err != nil {
+				detailedErr := fmt.Errorf("shard %d failed to compact block %s: %v",
+					s.ID(), blockStart.ToTime(), err)
+				multiErr = multiErr.Add(detailedErr)
+			}
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) compactBlock(
+	blockStart xtime.UnixNano,
+	compactedBlockSize time.Duration,
+	nsCtx namespace.Context,
+) error {
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		blockEnd  = blockStart.Add(compactedBlockSize)
+		fileSets  []fs.FileSetFileIdentifier
+		volume    int
+	)
+	for at := blockStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		state, err := s.FlushState(at)
+		if err != nil {
+			return err
+		}
+		if state.WarmStatus.DataFlushed != fileOpSuccess ||
+			state.CompactedBlockSize >= compactedBlockSize || state.Offloaded {
+			// Either not flushed yet, already compacted or offloaded.
+			return nil
+		}
+		if state.ColdVersionFlushed >= volume {
+			volume = state.ColdVersionFlushed + 1
+		}
+
+		fileSetStart := at
+		if state.CompactedBlockSize > 0 {
+			fileSetStart = at.Truncate(state.CompactedBlockSize)
+		}
+		if n := len(fileSets); n > 0 && fileSets[n-1].BlockStart == fileSetStart {
+			// Already compacted into the previous fileset.
+			continue
+		}
+		fileSets = append(fileSets, fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  fileSetStart,
+			VolumeIndex: state.ColdVersionRetrievable,
+		})
+	}
+
+	// The tombstones of the blocks that existed when the compaction started
+	// are applied by the compaction.
+	startedAt := xtime.ToUnixNano(s.nowFn())
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	readers := make([]fs.DataFileSetReader, 0, len(fileSets))
+	for range fileSets {
+		reader, err := s.newReaderFn(s.opts.BytesPool(), fsOpts)
+		if err != nil {
+			return err
+		}
+		readers = append(readers, reader)
+	}
+	writer, err := fs.NewStreamingWriter(fsOpts)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.CompactFileSets(readers, writer, fs.CompactFileSetsOptions{
+		NamespaceID:    s.namespace.ID(),
+		Shard:          s.ID(),
+		BlockStart:     blockStart,
+		BlockSize:      compactedBlockSize,
+		VolumeIndex:    volume,
+		FileSets:       fileSets,
+		BlockAllocSize: s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+		DeletedRanges: func(id ident.BytesID) []xtime.Range {
+			return s.tombstones.Deleted(id).rangesWithin(blockStart, blockEnd, blockSize)
+		},
+		Schema:                  nsCtx.Schema,
+		MultiReaderIteratorPool: s.opts.MultiReaderIteratorPool(),
+		EncoderPool:             s.opts.EncoderPool(),
+	}); err != nil {
+		return err
+	}
+	completedAt := xtime.ToUnixNano(s.nowFn())
+
+	var multiErr xerrors.MultiError
+	for at := blockStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		s.setFlushStateCompactedBlockSize(at, compactedBlockSize)
+		// Notify all block leasers that the block is now readable from the
+		// compacted fileset.
+		if err := s.finishWriting(at, volume, false); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		s.tombstones.MarkCompacted(at, startedAt, completedAt)
+	}
+
+	s.logger.Debug("compacted shard block",
+		zap.Stringer("namespace", s.namespace.ID()),
+		zap.Uint32("shard", s.ID()),
+		zap.Time("blockStart", blockStart.ToTime()),
+		zap.Duration("blockSize", compactedBlockSize),
+		zap.Int("volume", volume),
+		zap.Int("numFileSets", len(fileSets)))
+
+	return multiErr.FinalError()
+}
+
+// markBlocksCompacted marks the blocks covered by a compacted fileset as
+// flushed and readable from the compacted fileset.
+func (s *dbShard) markBlocksCompacted(
+	blockStart xtime.UnixNano,
+	compactedBlockSize time.Duration,
+	volume int,
+) {
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		blockEnd  = blockStart.Add(compactedBlockSize)
+	)
+	for at := blockStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		state := s.flushStateNoBootstrapCheck(at)
+		if state.ColdVersionRetrievable > volume {
+			continue
+		}
+		if state.WarmStatus.DataFlushed != fileOpSuccess {
+			s.markWarmDataFlushStateSuccess(at)
+		}
+		s.setFlushStateColdVersionRetrievable(at, volume)
+		s.setFlushStateColdVersionFlushed(at, volume)
+		s.setFlushStateCompactedBlockSize(at, compactedBlockSize)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestShardCompactBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize          = 2 * time.Hour
+		compactedBlockSize = 2 * blockSize
+		now                = xtime.Now().Truncate(compactedBlockSize)
+		start              = now.Add(-2 * compactedBlockSize)
+		blockStarts        = []xtime.UnixNano{start, start.Add(blockSize)}
+		opts               = DefaultTestOptions()
+		fsOpts             = opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+		nsCtx              = namespace.Context{ID: defaultTestNs1ID}
+	)
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetBlockCompactionTiers([]BlockCompactionTier{
+			{Age: time.Hour, BlockSize: compactedBlockSize},
+		})
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for i, blockStart := range blockStarts {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  defaultTestNs1ID,
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+		data := []byte{byte(i), 1, 2, 3}
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		meta := persist.NewMetadataFromIDAndTags(ident.StringID(fmt.Sprintf("foo%d", i)),
+			ident.Tags{}, persist.MetadataOptions{})
+		require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+		require.NoError(t, writer.Close())
+	}
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	requireCompacted := func(s *dbShard) {
+		for _, blockStart := range blockStarts {
+			flushState, err := s.FlushState(blockStart)
+			require.NoError(t, err)
+			require.Equal(t, fileOpSuccess, flushState.WarmStatus.DataFlushed)
+			require.Equal(t, 1, flushState.ColdVersionFlushed)
+			require.Equal(t, 1, flushState.ColdVersionRetrievable)
+			require.Equal(t, compactedBlockSize, flushState.CompactedBlockSize)
+		}
+	}
+
+	require.NoError(t, s.CompactBlocks(now, nsCtx))
+	requireCompacted(s)
+
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, reader.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   defaultTestNs1ID,
+			BlockStart:  start,
+			VolumeIndex: 1,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}))
+	require.Equal(t, compactedBlockSize, reader.Status().BlockSize)
+	require.Equal(t, len(blockStarts), reader.Entries())
+	require.NoError(t, reader.Close())
+
+	// Compacting again is a no-op since the blocks are already compacted.
+	require.NoError(t, s.CompactBlocks(now, nsCtx))
+	exists, err := fs.DataFileSetExists(dir, defaultTestNs1ID, s.ID(), start, 2)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The flush states are recovered from the compacted fileset on bootstrap.
+	bootstrapped := testDatabaseShard(t, opts)
+	defer bootstrapped.Close()
+	require.NoError(t, bootstrapped.Bootstrap(ctx, nsCtx))
+	requireCompacted(bootstrapped)
+
+	// The compacted fileset is retained until all the blocks it covers expire.
+	var earliestToRetain xtime.UnixNano
+	s.filesetPathsBeforeFn = func(
+		_ string, _ ident.ID,
+		_ uint32, t xtime.UnixNano,
+	) ([]string, error) {
+		earliestToRetain = t
+		return nil, nil
+	}
+	require.NoError(t, s.CleanupExpiredFileSets(blockStarts[1]))
+	require.Equal(t, start, earliestToRetain)
+}
+
+func TestShardFetchBlocksMetadataV2Compacted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize          = 2 * time.Hour
+		compactedBlockSize = 2 * blockSize
+		now                = xtime.Now().Truncate(compactedBlockSize)
+		start              = now.Add(-2 * compactedBlockSize)
+		blockStarts        = []xtime.UnixNano{start, start.Add(blockSize)}
+		opts               = DefaultTestOptions()
+		fsOpts             = opts.CommitLogOptions().FilesystemOptions().
+					SetFilePathPrefix(dir).
+					SetCompactedBlockSizes([]time.Duration{compactedBlockSize})
+		nsCtx = namespace.Context{ID: defaultTestNs1ID}
+	)
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetBlockCompactionTiers([]BlockCompactionTier{
+			{Age: time.Hour, BlockSize: compactedBlockSize},
+		})
+
+	// The first series only has data in the first block, the last series
+	// only in the second block.
+	expected := make(map[string]fetchBlockMetadataResultByStart)
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for i, blockStart := range blockStarts {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  defaultTestNs1ID,
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+		for j := i; j < i+2; j++ {
+			id := fmt.Sprintf("foo%d", j)
+			encoder := opts.EncoderPool().Get()
+			encoder.Reset(blockStart, 0, nil)
+			for k := 0; k < 10; k++ {
+				dp := ts.Datapoint{
+					TimestampNanos: blockStart.Add(time.Duration(k) * time.Minute),
+					Value:          float64(j*10 + k),
+				}
+				require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+			}
+			segment := encoder.Discard()
+			data := append([]byte(nil), segment.Head.Bytes()...)
+			if segment.Tail != nil {
+				data = append(data, segment.Tail.Bytes()...)
+			}
+			segment.Finalize()
+
+			checksum := digest.Checksum(data)
+			bytes := checked.NewBytes(data, nil)
+			bytes.IncRef()
+			meta := persist.NewMetadataFromIDAndTags(ident.StringID(id),
+				ident.Tags{}, persist.MetadataOptions{})
+			require.NoError(t, writer.Write(meta, bytes, checksum))
+			expected[id] = append(expected[id], block.NewFetchBlockMetadataResult(blockStart,
+				int64(len(data)), &checksum, 0, nil))
+		}
+		require.NoError(t, writer.Close())
+	}
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+	require.NoError(t, s.CompactBlocks(now, nsCtx))
+
+	// Page through the metadata one series at a time, the sizes and checksums
+	// of the blocks sliced out of the compacted fileset match the ones of the
+	// blocks that were compacted.
+	var (
+		fetchOpts = block.FetchBlocksMetadataOptions{
+			IncludeSizes:     true,
+			IncludeChecksums: true,
+			OnlyDisk:         true,
+		}
+		actual    = make(map[string]fetchBlockMetadataResultByStart)
+		pageToken PageToken
+	)
+	for {
+		res, nextPageToken, err := s.FetchBlocksMetadataV2(ctx, start,
+			start.Add(compactedBlockSize), 1, pageToken, fetchOpts)
+		require.NoError(t, err)
+		for _, elem := range res.Results() {
+			actual[elem.ID.String()] = append(actual[elem.ID.String()], elem.Blocks.Results()...)
+		}
+		if nextPageToken == nil {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	require.Equal(t, len(expected), len(actual))
+	for id, expectedResults := range expected {
+		actualResults := actual[id]
+		sort.Sort(expectedResults)
+		sort.Sort(actualResults)
+		require.Equal(t, len(expectedResults), len(actualResults), id)
+		for i, expectedBlock := range expectedResults {
+			require.Equal(t, expectedBlock.Start, actualResults[i].Start)
+			require.Equal(t, expectedBlock.Size, actualResults[i].Size)
+			require.Equal(t, *expectedBlock.Checksum, *actualResults[i].Checksum)
+		}
+	}
+}
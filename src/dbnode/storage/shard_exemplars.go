@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/serialize"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// shardExemplars retains the most recent exemplars written to a shard in a
+// fixed size circular buffer. The exemplars of a series are chained together
+// so that they can be read without scanning the whole buffer. The buffer is
+// persisted next to the shard's filesets when the shard is flushed or
+// closed and read back when the shard is bootstrapped.
+type shardExemplars struct {
+	sync.RWMutex
+
+	fsOpts      fs.Options
+	encoderPool serialize.TagEncoderPool
+	namespace   ident.ID
+	shard       uint32
+	logger      *zap.Logger
+
+	loaded  bool
+	entries []exemplarEntry
+	next    int
+	series  map[string]*seriesExemplarsRef
+}
+
+type exemplarEntry struct {
+	exemplar ts.Exemplar
+	// next is the position of the next newer exemplar of the same series,
+	// or -1 if this is the newest one.
+	next int
+	ref  *seriesExemplarsRef
+}
+
+type seriesExemplarsRef struct {
+	id     string
+	oldest int
+	newest int
+}
+
+func newShardExemplars(
+	maxExemplars int,
+	fsOpts fs.Options,
+	namespace ident.ID,
+	shard uint32,
+	logger *zap.Logger,
+) *shardExemplars {
+	return &shardExemplars{
+		fsOpts:      fsOpts,
+		encoderPool: fsOpts.TagEncoderPool(),
+		namespace:   namespace,
+		shard:       shard,
+		logger:      logger,
+		entries:     make([]exemplarEntry, maxExemplars),
+		series:      make(map[string]*seriesExemplarsRef),
+	}
+}
+
+// exemplarsFieldKey is the key of the exemplars field of an annotation
+// payload, i.e. field number 6 with the length delimited wire type.
+const exemplarsFieldKey = 6<<3 | 2
+
+// hasExemplarsField cheaply checks whether an annotation is a payload with
+// exemplars by walking its top level fields without decoding them, so that
+// the common case of annotations without exemplars is not unmarshalled on
+// the write path.
+func hasExemplarsField(ann ts.Annotation) bool {
+	for len(ann) > 0 {
+		key, n := binary.Uvarint(ann)
+		if n <= 0 {
+			return false
+		}
+		if key == exemplarsFieldKey {
+			return true
+		}
+		ann = ann[n:]
+
+		switch key & 0x7 {
+		case 0: // Varint.
+			_, n = binary.Uvarint(ann)
+			if n <= 0 {
+				return false
+			}
+		case 1: // Fixed 64.
+			n = 8
+		case 2: // Length delimited.
+			length, m := binary.Uvarint(ann)
+			if m <= 0 || length > uint64(len(ann)-m) {
+				return false
+			}
+			n = m + int(length)
+		case 5: // Fixed 32.
+			n = 4
+		default:
+			return false
+		}
+		if n > len(ann) {
+			return false
+		}
+		ann = ann[n:]
+	}
+	return false
+}
+
+// Extract removes the exemplars from an annotation, returning the remaining
+// annotation and the exemplars with their labels encoded. Annotations that
+// are not payloads or do not carry exemplars are returned unmodified.
+func (e *shardExemplars) Extract(ann ts.Annotation) (ts.Annotation, []ts.Exemplar) {
+	if !hasExemplarsField(ann) {
+		return ann, nil
+	}
+
+	var payload annotation.Payload
+	if err := payload.Unmarshal(ann); err != nil || len(payload.Exemplars) == 0 {
+		return ann, nil
+	}
+
+	enc := e.encoderPool.Get()
+	defer enc.Finalize()
+
+	exemplars := make([]ts.Exemplar, 0, len(payload.Exemplars))
+	for _, exemplar := range payload.Exemplars {
+		tags := make([]ident.Tag, 0, len(exemplar.Labels))
+		for _, label := range exemplar.Labels {
+			tags = append(tags, ident.Tag{
+				Name:  ident.BytesID(label.Name),
+				Value: ident.BytesID(label.Value),
+			})
+		}
+
+		enc.Reset()
+		if err := enc.Encode(ident.NewTagsIterator(ident.NewTags(tags...))); err != nil {
+			e.logger.Warn("dropping exemplar with invalid labels",
+				zap.Stringer("namespace", e.namespace),
+				zap.Error(err))
+			continue
+		}
+		var encoded ts.EncodedTags
+		if data, ok := enc.Data(); ok {
+			encoded = append(ts.EncodedTags(nil), data.Bytes()...)
+		}
+		exemplars = append(exemplars, ts.Exemplar{
+			TimestampNanos: xtime.UnixNano(exemplar.TimestampNanos),
+			Value:          exemplar.Value,
+			EncodedLabels:  encoded,
+		})
+	}
+
+	payload.Exemplars = nil
+	if payload.Size() == 0 {
+		return nil, exemplars
+	}
+	stripped, err := payload.Marshal()
+	if err != nil {
+		return ann, exemplars
+	}
+	return stripped, exemplars
+}
+
+// Add records exemplars for a series, evicting the oldest exemplars of the
+// shard once the buffer is full. Exemplars that are not newer than the
+// newest exemplar already recorded for the series are dropped.
+func (e *shardExemplars) Add(id ident.ID, exemplars []ts.Exemplar) {
+	if len(exemplars) == 0 {
+		return
+	}
+
+	e.Lock()
+	key := id.String()
+	for _, exemplar := range exemplars {
+		e.addWithLock(key, exemplar)
+	}
+	e.Unlock()
+}
+
+func (e *shardExemplars) addWithLock(key string, exemplar ts.Exemplar) {
+	ref := e.series[key]
+	if ref != nil && !exemplar.TimestampNanos.After(e.entries[ref.newest].exemplar.TimestampNanos) {
+		return
+	}
+
+	// Evict the exemplar currently occupying the slot, it is always the
+	// oldest exemplar of its series.
+	slot := &e.entries[e.next]
+	if evicted := slot.ref; evicted != nil {
+		if slot.next == -1 {
+			delete(e.series, evicted.id)
+			if evicted == ref {
+				ref = nil
+			}
+		} else {
+			evicted.oldest = slot.next
+		}
+	}
+
+	if ref == nil {
+		ref = &seriesExemplarsRef{id: key, oldest: e.next, newest: e.next}
+		e.series[key] = ref
+	} else {
+		e.entries[ref.newest].next = e.next
+		ref.newest = e.next
+	}
+	*slot = exemplarEntry{exemplar: exemplar, next: -1, ref: ref}
+	e.next = (e.next + 1) % len(e.entries)
+}
+
+// Exemplars returns the exemplars of a series with timestamps within the
+// range [start, end], ordered by timestamp.
+func (e *shardExemplars) Exemplars(id ident.ID, start, end xtime.UnixNano) []ts.Exemplar {
+	e.RLock()
+	defer e.RUnlock()
+
+	ref := e.series[string(id.Bytes())]
+	if ref == nil {
+		return nil
+	}
+
+	var result []ts.Exemplar
+	for i := ref.oldest; i != -1; i = e.entries[i].next {
+		exemplar := e.entries[i].exemplar
+		if exemplar.TimestampNanos.Before(start) {
+			continue
+		}
+		if exemplar.TimestampNanos.After(end) {
+			break
+		}
+		result = append(result, exemplar)
+	}
+	return result
+}
+
+// Load reads the persisted exemplars of the shard if they have not already
+// been read.
+func (e *shardExemplars) Load() error {
+	e.Lock()
+	defer e.Unlock()
+	if e.loaded {
+		return nil
+	}
+
+	entries, err := fs.ReadExemplars(e.fsOpts.FilePathPrefix(), e.namespace, e.shard)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		key := string(entry.ID)
+		for _, exemplar := range entry.Exemplars {
+			e.addWithLock(key, exemplar)
+		}
+	}
+	e.loaded = true
+	return nil
+}
+
+// Persist writes the exemplars currently retained by the shard to disk. It
+// is a no-op until the persisted exemplars have been loaded so that they are
+// never overwritten before being read.
+func (e *shardExemplars) Persist() error {
+	e.RLock()
+	if !e.loaded {
+		e.RUnlock()
+		return nil
+	}
+	entries := make([]fs.SeriesExemplars, 0, len(e.series))
+	for key, ref := range e.series {
+		var exemplars []ts.Exemplar
+		for i := ref.oldest; i != -1; i = e.entries[i].next {
+			exemplars = append(exemplars, e.entries[i].exemplar)
+		}
+		entries = append(entries, fs.SeriesExemplars{
+			ID:        []byte(key),
+			Exemplars: exemplars,
+		})
+	}
+	e.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].ID) < string(entries[j].ID)
+	})
+	return fs.WriteExemplars(e.fsOpts, e.namespace, e.shard, entries)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/serialize"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestExemplar(t xtime.UnixNano, v float64) ts.Exemplar {
+	return ts.Exemplar{TimestampNanos: t, Value: v}
+}
+
+func TestShardExemplarsRingEviction(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts = opts.CommitLogOptions().FilesystemOptions()
+		logger = opts.InstrumentOptions().Logger()
+		foo    = ident.StringID("foo")
+		bar    = ident.StringID("bar")
+	)
+
+	exemplars := newShardExemplars(3, fsOpts, defaultTestNs1ID, 0, logger)
+	exemplars.Add(foo, []ts.Exemplar{newTestExemplar(1, 1), newTestExemplar(2, 2)})
+	exemplars.Add(bar, []ts.Exemplar{newTestExemplar(1, 10)})
+
+	// Out of order and duplicate exemplars are dropped.
+	exemplars.Add(foo, []ts.Exemplar{newTestExemplar(2, 3), newTestExemplar(1, 4)})
+	require.Equal(t, []ts.Exemplar{newTestExemplar(1, 1), newTestExemplar(2, 2)},
+		exemplars.Exemplars(foo, 0, 10))
+
+	// The buffer is full so the oldest exemplar of the shard is evicted.
+	exemplars.Add(bar, []ts.Exemplar{newTestExemplar(3, 11)})
+	require.Equal(t, []ts.Exemplar{newTestExemplar(2, 2)}, exemplars.Exemplars(foo, 0, 10))
+	require.Equal(t, []ts.Exemplar{newTestExemplar(1, 10), newTestExemplar(3, 11)},
+		exemplars.Exemplars(bar, 0, 10))
+
+	// Evicting the last exemplar of a series removes the series.
+	exemplars.Add(bar, []ts.Exemplar{newTestExemplar(4, 12)})
+	require.Nil(t, exemplars.Exemplars(foo, 0, 10))
+	require.Len(t, exemplars.series, 1)
+
+	// Exemplars are filtered by the inclusive time range.
+	require.Equal(t, []ts.Exemplar{newTestExemplar(3, 11), newTestExemplar(4, 12)},
+		exemplars.Exemplars(bar, 2, 4))
+}
+
+func TestShardExemplarsPersistAndLoad(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts = opts.CommitLogOptions().FilesystemOptions()
+		logger = opts.InstrumentOptions().Logger()
+		foo    = ident.StringID("foo")
+	)
+
+	exemplars := newShardExemplars(4, fsOpts, defaultTestNs1ID, 0, logger)
+	exemplars.Add(foo, []ts.Exemplar{newTestExemplar(1, 1)})
+
+	// Exemplars are not persisted until the persisted state has been loaded.
+	require.NoError(t, exemplars.Persist())
+	exists, err := fs.FileExists(fs.ExemplarsFilePath(fsOpts.FilePathPrefix(), defaultTestNs1ID, 0))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, exemplars.Load())
+	exemplars.Add(foo, []ts.Exemplar{newTestExemplar(2, 2)})
+	require.NoError(t, exemplars.Persist())
+
+	reloaded := newShardExemplars(4, fsOpts, defaultTestNs1ID, 0, logger)
+	require.NoError(t, reloaded.Load())
+	require.Equal(t, []ts.Exemplar{newTestExemplar(1, 1), newTestExemplar(2, 2)},
+		reloaded.Exemplars(foo, 0, 10))
+}
+
+func TestShardExemplarsExtract(t *testing.T) {
+	opts := DefaultTestOptions()
+	exemplars := newShardExemplars(1, opts.CommitLogOptions().FilesystemOptions(),
+		defaultTestNs1ID, 0, opts.InstrumentOptions().Logger())
+
+	// Annotations without exemplars are returned as is.
+	ann, err := (&annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+	}).Marshal()
+	require.NoError(t, err)
+	result, extracted := exemplars.Extract(ann)
+	require.Equal(t, ts.Annotation(ann), result)
+	require.Nil(t, extracted)
+
+	result, extracted = exemplars.Extract(ts.Annotation("not a payload"))
+	require.Equal(t, ts.Annotation("not a payload"), result)
+	require.Nil(t, extracted)
+
+	ann, err = (&annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_COUNTER,
+		Exemplars: []*annotation.Exemplar{
+			{
+				Labels: []*annotation.ExemplarLabel{
+					{Name: []byte("trace_id"), Value: []byte("abc")},
+				},
+				Value:          1.5,
+				TimestampNanos: 1000,
+			},
+		},
+	}).Marshal()
+	require.NoError(t, err)
+
+	result, extracted = exemplars.Extract(ann)
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(result))
+	require.Equal(t, annotation.OpenMetricsFamilyType_COUNTER, payload.OpenMetricsFamilyType)
+	require.Empty(t, payload.Exemplars)
+
+	require.Len(t, extracted, 1)
+	require.Equal(t, xtime.UnixNano(1000), extracted[0].TimestampNanos)
+	require.Equal(t, 1.5, extracted[0].Value)
+
+	labels := serialize.NewTagDecoderPool(serialize.NewTagDecoderOptions(
+		serialize.TagDecoderOptionsConfig{}), nil)
+	labels.Init()
+	dec := labels.Get()
+	dec.Reset(checked.NewBytes(extracted[0].EncodedLabels, nil))
+	require.True(t, dec.Next())
+	require.Equal(t, "trace_id", dec.Current().Name.String())
+	require.Equal(t, "abc", dec.Current().Value.String())
+	require.False(t, dec.Next())
+	require.NoError(t, dec.Err())
+	dec.Close()
+
+	// Payloads with only exemplars are stripped entirely.
+	ann, err = (&annotation.Payload{
+		Exemplars: []*annotation.Exemplar{{Value: 1, TimestampNanos: 1}},
+	}).Marshal()
+	require.NoError(t, err)
+	result, extracted = exemplars.Extract(ann)
+	require.Nil(t, result)
+	require.Len(t, extracted, 1)
+}
+
+func TestHasExemplarsField(t *testing.T) {
+	ann, err := (&annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_HISTOGRAM,
+		NativeHistogram:       []byte("histogram"),
+		TimerSketch:           []byte("sketch"),
+	}).Marshal()
+	require.NoError(t, err)
+	require.False(t, hasExemplarsField(ann))
+
+	ann, err = (&annotation.Payload{
+		OpenMetricsFamilyType: annotation.OpenMetricsFamilyType_HISTOGRAM,
+		NativeHistogram:       []byte("histogram"),
+		Exemplars:             []*annotation.Exemplar{{Value: 1, TimestampNanos: 1}},
+	}).Marshal()
+	require.NoError(t, err)
+	require.True(t, hasExemplarsField(ann))
+
+	require.False(t, hasExemplarsField(nil))
+	require.False(t, hasExemplarsField(ts.Annotation("not a payload")))
+	// Truncated length delimited field.
+	require.False(t, hasExemplarsField(ts.Annotation{5<<3 | 2, 10, 1}))
+}
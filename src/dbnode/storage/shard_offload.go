@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: Offloading uploads the fileset a block is read from to the object
+// store and removes its index, summaries, bloom filter and data files from
+// local disk. The info, digest and checkpoint files are kept so that the
+// flush states of offloaded blocks survive restarts, and the seeker manager
+// reads offloaded filesets through the remote fileset cache.
+
+// OffloadBlocks offloads the filesets of flushed blocks older than the
+// configured fileset offload age to the object store.
+func (s *dbShard) OffloadBlocks(now xtime.UnixNano) error {
+	var (
+		age   = s.opts.FileSetOffloadAge()
+		store = s.opts.CommitLogOptions().FilesystemOptions().ObjectStore()
+	)
+	if age <= 0 || store == nil {
+		return nil
+	}
+
+	var (
+		rOpts     = s.namespace.Options().RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		earliest  = retention.FlushTimeStart(rOpts, now)
+		end       = now.Add(-age)
+		multiErr  xerrors.MultiError
+	)
+	for blockStart := earliest; !blockStart.Add(blockSize).After(end); blockStart = blockStart.Add(blockSize) {
+		state, err := s.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if state.WarmStatus.DataFlushed != fileOpSuccess || state.Offloaded {
+			continue
+		}
+
+		// Blocks compacted into a larger fileset are offloaded once the
+		// whole compacted fileset is older than the offload age.
+		fileSetStart, fileSetBlockSize := blockStart, blockSize
+		if state.CompactedBlockSize > 0 {
+			fileSetStart = blockStart.Truncate(state.CompactedBlockSize)
+			fileSetBlockSize = state.CompactedBlockSize
+		}
+		if fileSetStart.Add(fileSetBlockSize).After(end) {
+			continue
+		}
+
+		if err := s.offloadFileSet(fileSetStart, fileSetBlockSize, state.ColdVersionRetrievable); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to offload block %s: %v",
+				s.ID(), blockStart.ToTime(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) offloadFileSet(
+	fileSetStart xtime.UnixNano,
+	fileSetBlockSize time.Duration,
+	volume int,
+) error {
+	var (
+		fsOpts         = s.opts.CommitLogOptions().FilesystemOptions()
+		filePathPrefix = fsOpts.FilePathPrefix()
+	)
+	offloaded, err := fs.DataFileSetOffloaded(filePathPrefix, s.namespace.ID(),
+		s.ID(), fileSetStart, volume)
+	if err != nil {
+		return err
+	}
+	if !offloaded {
+		if err := fs.OffloadDataFileSet(fsOpts.ObjectStore(), filePathPrefix, fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  fileSetStart,
+			VolumeIndex: volume,
+		}); err != nil {
+			return err
+		}
+
+		s.logger.Debug("offloaded shard block",
+			zap.Stringer("namespace", s.namespace.ID()),
+			zap.Uint32("shard", s.ID()),
+			zap.Time("blockStart", fileSetStart.ToTime()),
+			zap.Duration("blockSize", fileSetBlockSize),
+			zap.Int("volume", volume))
+	}
+
+	s.markBlocksOffloaded(fileSetStart, fileSetBlockSize)
+	return nil
+}
+
+// markBlocksOffloaded marks the blocks covered by an offloaded fileset as
+// offloaded.
+func (s *dbShard) markBlocksOffloaded(
+	fileSetStart xtime.UnixNano,
+	fileSetBlockSize time.Duration,
+) {
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		blockEnd  = fileSetStart.Add(fileSetBlockSize)
+	)
+	for at := fileSetStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		s.setFlushStateOffloaded(at)
+	}
+}
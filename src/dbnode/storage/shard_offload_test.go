@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestShardOffloadBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize   = 2 * time.Hour
+		now         = xtime.Now().Truncate(blockSize)
+		start       = now.Add(-3 * blockSize)
+		blockStarts = []xtime.UnixNano{start, start.Add(blockSize), start.Add(2 * blockSize)}
+		store       = objectstore.NewMemoryStore()
+		opts        = DefaultTestOptions()
+		fsOpts      = opts.CommitLogOptions().FilesystemOptions().
+				SetFilePathPrefix(dir).
+				SetObjectStore(store)
+		nsCtx = namespace.Context{ID: defaultTestNs1ID}
+	)
+	opts = opts.
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts)).
+		SetFileSetOffloadAge(blockSize)
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for i, blockStart := range blockStarts {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  defaultTestNs1ID,
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+		data := []byte{byte(i), 1, 2, 3}
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		meta := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+			ident.Tags{}, persist.MetadataOptions{})
+		require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+		require.NoError(t, writer.Close())
+	}
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	// Only the blocks older than the offload age are offloaded.
+	requireOffloaded := func(s *dbShard) {
+		for i, blockStart := range blockStarts {
+			flushState, err := s.FlushState(blockStart)
+			require.NoError(t, err)
+			require.Equal(t, fileOpSuccess, flushState.WarmStatus.DataFlushed)
+			require.Equal(t, i < 2, flushState.Offloaded)
+
+			offloaded, err := fs.DataFileSetOffloaded(dir, defaultTestNs1ID, s.ID(), blockStart, 0)
+			require.NoError(t, err)
+			require.Equal(t, i < 2, offloaded)
+		}
+	}
+
+	require.NoError(t, s.OffloadBlocks(now))
+	requireOffloaded(s)
+
+	keys, err := store.List("")
+	require.NoError(t, err)
+	numKeys := len(keys)
+	require.True(t, numKeys > 0)
+
+	// The flush states are recovered from the offloaded filesets on bootstrap.
+	bootstrapped := testDatabaseShard(t, opts)
+	defer bootstrapped.Close()
+	require.NoError(t, bootstrapped.Bootstrap(ctx, nsCtx))
+	requireOffloaded(bootstrapped)
+
+	// Offloaded filesets are removed from the object store once expired.
+	s.filesetPathsBeforeFn = func(
+		_ string, _ ident.ID,
+		_ uint32, _ xtime.UnixNano,
+	) ([]string, error) {
+		return nil, nil
+	}
+	require.NoError(t, s.CleanupExpiredFileSets(blockStarts[1]))
+	keys, err = store.List("")
+	require.NoError(t, err)
+	require.Equal(t, numKeys/2, len(keys))
+}
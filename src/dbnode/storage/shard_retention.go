@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: Series matching a retention override of their namespace expire before
+// the namespace retention period. Their data is not flushed once expired and
+// the cleanup rewrites the flushed filesets of the blocks they expired from
+// without them. The rewritten fileset is written with a volume index higher
+// than the volume it replaces, the same as a cold flush, so that the seeker
+// manager reads from it and the replaced fileset is removed by the
+// compacted filesets cleanup.
+
+// CleanupExpiredSeries removes the series whose retention override expired
+// from the flushed filesets of the shard.
+func (s *dbShard) CleanupExpiredSeries(now xtime.UnixNano) error {
+	if len(s.retentionOverrides) == 0 {
+		return nil
+	}
+
+	var (
+		rOpts     = s.namespace.Options().RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		earliest  = retention.FlushTimeStart(rOpts, now)
+		// No series has expired from the blocks after the retention of the
+		// override with the shortest retention period.
+		end = retention.FlushTimeStartForRetentionPeriod(
+			s.retentionOverrides.minRetentionPeriod(), blockSize, now)
+		multiErr xerrors.MultiError
+	)
+	for blockStart := earliest; blockStart.Before(end); blockStart = blockStart.Add(blockSize) {
+		state, err := s.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if state.WarmStatus.DataFlushed != fileOpSuccess || state.Offloaded {
+			// Either not flushed yet or offloaded to the object store, which
+			// filesets are never written to again.
+			continue
+		}
+
+		// Blocks compacted into a larger fileset are cleaned up once the
+		// series have expired from the whole compacted fileset.
+		fileSetStart, fileSetBlockSize := blockStart, blockSize
+		if state.CompactedBlockSize > 0 {
+			fileSetStart = blockStart.Truncate(state.CompactedBlockSize)
+			fileSetBlockSize = state.CompactedBlockSize
+		}
+		if fileSetStart != blockStart && blockStart != earliest {
+			// Already visited at the first block of the fileset.
+			continue
+		}
+
+		expiredPeriod := s.retentionOverrides.expiredRetentionPeriod(
+			fileSetStart.Add(fileSetBlockSize), blockSize, now)
+		if expiredPeriod <= state.ExpiredRetentionPeriod {
+			continue
+		}
+
+		if err := s.cleanupExpiredSeriesFileSet(fileSetStart, fileSetBlockSize,
+			state.ColdVersionRetrievable, expiredPeriod); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to cleanup expired series of block %s: %v",
+				s.ID(), blockStart.ToTime(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) cleanupExpiredSeriesFileSet(
+	fileSetStart xtime.UnixNano,
+	fileSetBlockSize time.Duration,
+	volume int,
+	expiredPeriod time.Duration,
+) error {
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		blockEnd  = fileSetStart.Add(fileSetBlockSize)
+		fsOpts    = s.opts.CommitLogOptions().FilesystemOptions()
+		fileSet   = fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  fileSetStart,
+			VolumeIndex: volume,
+		}
+		filterFn = func(id ident.BytesID, encodedTags ts.EncodedTags) (bool, error) {
+			metadata, err := convert.FromSeriesIDAndEncodedTags(id, encodedTags)
+			if err != nil {
+				return false, err
+			}
+			return !s.retentionOverrides.expired(metadata, expiredPeriod), nil
+		}
+	)
+
+	// Only rewrite the fileset if it holds expired series, which avoids
+	// rewriting filesets again after a restart.
+	anyExpired, err := s.anyExpiredSeries(fileSet, filterFn)
+	if err != nil {
+		return err
+	}
+	if !anyExpired {
+		for at := fileSetStart; at.Before(blockEnd); at = at.Add(blockSize) {
+			s.setFlushStateExpiredRetentionPeriod(at, expiredPeriod)
+		}
+		return nil
+	}
+
+	nextVolume := volume + 1
+	for at := fileSetStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		state, err := s.FlushState(at)
+		if err != nil {
+			return err
+		}
+		if state.ColdVersionFlushed >= nextVolume {
+			nextVolume = state.ColdVersionFlushed + 1
+		}
+	}
+
+	reader, err := s.newReaderFn(s.opts.BytesPool(), fsOpts)
+	if err != nil {
+		return err
+	}
+	writer, err := fs.NewStreamingWriter(fsOpts)
+	if err != nil {
+		return err
+	}
+	if err := fs.CompactFileSets([]fs.DataFileSetReader{reader}, writer, fs.CompactFileSetsOptions{
+		NamespaceID:             s.namespace.ID(),
+		Shard:                   s.ID(),
+		BlockStart:              fileSetStart,
+		BlockSize:               fileSetBlockSize,
+		VolumeIndex:             nextVolume,
+		FileSets:                []fs.FileSetFileIdentifier{fileSet},
+		FilterSeries:            filterFn,
+		BlockAllocSize:          s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+		MultiReaderIteratorPool: s.opts.MultiReaderIteratorPool(),
+		EncoderPool:             s.opts.EncoderPool(),
+	}); err != nil {
+		return err
+	}
+
+	var multiErr xerrors.MultiError
+	for at := fileSetStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		s.setFlushStateExpiredRetentionPeriod(at, expiredPeriod)
+		// Notify all block leasers that the block is now readable from the
+		// rewritten fileset.
+		if err := s.finishWriting(at, nextVolume, false); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	s.logger.Debug("removed expired series from shard block",
+		zap.Stringer("namespace", s.namespace.ID()),
+		zap.Uint32("shard", s.ID()),
+		zap.Time("blockStart", fileSetStart.ToTime()),
+		zap.Duration("blockSize", fileSetBlockSize),
+		zap.Duration("expiredRetentionPeriod", expiredPeriod),
+		zap.Int("volume", nextVolume))
+
+	return multiErr.FinalError()
+}
+
+// anyExpiredSeries returns whether a fileset holds any series excluded by
+// the filter, reading only the metadata of the series.
+func (s *dbShard) anyExpiredSeries(
+	fileSet fs.FileSetFileIdentifier,
+	filterFn func(id ident.BytesID, encodedTags ts.EncodedTags) (bool, error),
+) (bool, error) {
+	reader, err := s.newReaderFn(s.opts.BytesPool(), s.opts.CommitLogOptions().FilesystemOptions())
+	if err != nil {
+		return false, err
+	}
+	if err := reader.Open(fs.DataReaderOpenOptions{
+		Identifier:       fileSet,
+		StreamingEnabled: true,
+	}); err != nil {
+		return false, err
+	}
+	defer reader.Close() // nolint: errcheck
+
+	for {
+		entry, err := reader.StreamingReadMetadata()
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		keep, err := filterFn(entry.ID, entry.EncodedTags)
+		if err != nil {
+			return false, err
+		}
+		if !keep {
+			return true, nil
+		}
+	}
+}
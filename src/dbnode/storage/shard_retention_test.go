@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestShardCleanupExpiredSeries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize    = 2 * time.Hour
+		now          = xtime.Now().Truncate(blockSize)
+		expiredStart = now.Add(-4 * blockSize)
+		recentStart  = now.Add(-2 * blockSize)
+		opts         = DefaultTestOptions()
+		fsOpts       = opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+		nsCtx        = namespace.Context{ID: defaultTestNs1ID}
+	)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for _, blockStart := range []xtime.UnixNano{expiredStart, recentStart} {
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  defaultTestNs1ID,
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+		for _, app := range []string{"debug", "slo"} {
+			data := []byte{1, 2, 3}
+			bytes := checked.NewBytes(data, nil)
+			bytes.IncRef()
+			meta := persist.NewMetadataFromIDAndTags(ident.StringID(app),
+				ident.NewTags(ident.StringTag("app", app)), persist.MetadataOptions{})
+			require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+		}
+		require.NoError(t, writer.Close())
+	}
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	s.retentionOverrides, err = newRetentionOverrides([]namespace.RetentionOverride{
+		{Filter: "app:debug", RetentionPeriod: 2 * blockSize},
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	require.NoError(t, s.CleanupExpiredSeries(now))
+
+	// The expired series is removed from a new volume of the expired block.
+	flushState, err := s.FlushState(expiredStart)
+	require.NoError(t, err)
+	require.Equal(t, 1, flushState.ColdVersionRetrievable)
+	require.Equal(t, 2*blockSize, flushState.ExpiredRetentionPeriod)
+	require.Equal(t, []string{"slo"}, readTestFileSetIDs(t, opts, fsOpts, expiredStart, 1))
+
+	// The series has not expired from the recent block yet.
+	flushState, err = s.FlushState(recentStart)
+	require.NoError(t, err)
+	require.Equal(t, 0, flushState.ColdVersionRetrievable)
+	require.Equal(t, time.Duration(0), flushState.ExpiredRetentionPeriod)
+
+	// Cleaning up again is a no-op since the series were already removed.
+	require.NoError(t, s.CleanupExpiredSeries(now))
+	exists, err := fs.DataFileSetExists(dir, defaultTestNs1ID, s.ID(), expiredStart, 2)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The series expire from the recent block later on.
+	later := now.Add(2 * blockSize)
+	require.NoError(t, s.CleanupExpiredSeries(later))
+	require.Equal(t, []string{"slo"}, readTestFileSetIDs(t, opts, fsOpts, recentStart, 1))
+}
+
+func readTestFileSetIDs(
+	t *testing.T,
+	opts Options,
+	fsOpts fs.Options,
+	blockStart xtime.UnixNano,
+	volume int,
+) []string {
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, reader.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   defaultTestNs1ID,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}))
+	defer reader.Close()
+
+	var ids []string
+	for i := 0; i < reader.Entries(); i++ {
+		id, tags, _, _, err := reader.ReadMetadata()
+		require.NoError(t, err)
+		ids = append(ids, id.String())
+		id.Finalize()
+		tags.Close()
+	}
+	return ids
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/checked"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: Restoring writes the blocks of a quarantined fileset, fetched from
+// peers, to a new volume of every block the fileset covered. The volume is
+// higher than the volumes of all the filesets of the block so that the
+// seeker manager reads from the restored fileset, and compacted blocks are
+// restored at the block size of the namespace.
+
+// RestoreBlocks writes the blocks of the series fetched from peers to new
+// fileset volumes, replacing the quarantined fileset the blocks were read
+// from.
+func (s *dbShard) RestoreBlocks(
+	fileSetStart xtime.UnixNano,
+	fileSetBlockSize time.Duration,
+	fetched result.ShardResult,
+) error {
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		blockEnd  = fileSetStart.Add(fileSetBlockSize)
+		multiErr  xerrors.MultiError
+	)
+	for at := fileSetStart; at.Before(blockEnd); at = at.Add(blockSize) {
+		if err := s.restoreBlock(at, fetched); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) restoreBlock(
+	blockStart xtime.UnixNano,
+	fetched result.ShardResult,
+) error {
+	state, err := s.FlushState(blockStart)
+	if err != nil {
+		return err
+	}
+
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		volume    = state.ColdVersionFlushed + 1
+		fsOpts    = s.opts.CommitLogOptions().FilesystemOptions()
+		numSeries int
+	)
+	writer, err := fs.NewWriter(fsOpts)
+	if err != nil {
+		return err
+	}
+	if err := writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		BlockSize:   blockSize,
+		FileSetType: persist.FileSetFlushType,
+	}); err != nil {
+		return err
+	}
+
+	var blockErr error
+	for _, entry := range fetched.AllSeries().Iter() {
+		series := entry.Value()
+		bl, ok := series.Blocks.BlockAt(blockStart)
+		if !ok {
+			continue
+		}
+
+		checksum, err := bl.Checksum()
+		if err != nil {
+			blockErr = err // Need to call writer.Close, avoid return
+			break
+		}
+
+		segment := bl.Discard()
+		series.Blocks.RemoveBlockAt(blockStart)
+
+		metadata := persist.NewMetadataFromIDAndTags(series.ID, series.Tags,
+			persist.MetadataOptions{})
+		err = writer.WriteAll(metadata, []checked.Bytes{segment.Head, segment.Tail}, checksum)
+		if err != nil {
+			blockErr = err // Need to call writer.Close, avoid return
+			break
+		}
+		numSeries++
+	}
+
+	// Always close before attempting to check if block error occurred.
+	err = writer.Close()
+	if blockErr != nil {
+		// A block error is more interesting to bubble up than a close error.
+		err = blockErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if state.WarmStatus.DataFlushed != fileOpSuccess {
+		s.markWarmDataFlushStateSuccess(blockStart)
+	}
+	s.setFlushStateCompactedBlockSize(blockStart, 0)
+	// Notify all block leasers that the block is now readable from the
+	// restored fileset.
+	if err := s.finishWriting(blockStart, volume, false); err != nil {
+		return err
+	}
+
+	s.logger.Info("restored shard block from peers",
+		zap.Stringer("namespace", s.namespace.ID()),
+		zap.Uint32("shard", s.ID()),
+		zap.Time("blockStart", blockStart.ToTime()),
+		zap.Int("volume", volume),
+		zap.Int("numSeries", numSeries))
+
+	return nil
+}
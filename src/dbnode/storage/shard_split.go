@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: Once the shards split off from a parent shard stop redirecting to it,
+// the series of the split shards are no longer read from the parent shard.
+// They are removed from the memory of the parent shard, and the flushed
+// filesets of the parent shard are rewritten without them with a volume index
+// higher than the volume they replace, so that the seeker manager reads from
+// the rewritten filesets and the replaced filesets are removed by the
+// compacted filesets cleanup.
+
+// RemoveSplitSeries removes the series that belong to other shards than the
+// shard with the given hash function from memory and from the flushed
+// filesets of the shard.
+func (s *dbShard) RemoveSplitSeries(hashFn sharding.HashFn, nsCtx namespace.Context) error {
+	var (
+		now       = xtime.ToUnixNano(s.nowFn())
+		rOpts     = s.namespace.Options().RetentionOptions()
+		blockSize = rOpts.BlockSize()
+		earliest  = retention.FlushTimeStart(rOpts, now)
+		latest    = retention.FlushTimeEnd(rOpts, now)
+		r         = xtime.Range{
+			Start: earliest,
+			End:   now.Add(rOpts.BufferFuture()).Truncate(blockSize).Add(blockSize),
+		}
+		entries  []*Entry
+		multiErr xerrors.MultiError
+	)
+	// Emptied series are purged from memory by the next tick.
+	s.forEachShardEntry(func(entry *Entry) bool {
+		if hashFn(entry.Series.ID()) != s.ID() {
+			entry.IncrementReaderWriterCount()
+			entries = append(entries, entry)
+		}
+		return true
+	})
+	for _, entry := range entries {
+		if err := entry.Series.DeleteRange(r, nsCtx); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+		entry.DecrementReaderWriterCount()
+	}
+
+	var rewrittenUntil xtime.UnixNano
+	for blockStart := earliest; !blockStart.After(latest); blockStart = blockStart.Add(blockSize) {
+		state, err := s.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		if state.WarmStatus.DataFlushed != fileOpSuccess || state.Offloaded {
+			// Offloaded filesets are never written to again, they keep the
+			// series of the split shards until they expire.
+			continue
+		}
+
+		fileSetStart, fileSetSize := blockStart, blockSize
+		if state.CompactedBlockSize > 0 {
+			fileSetStart, fileSetSize = blockStart.Truncate(state.CompactedBlockSize), state.CompactedBlockSize
+		}
+		if blockStart.Before(rewrittenUntil) {
+			// Already rewritten with the previous block of the fileset.
+			continue
+		}
+		rewrittenUntil = fileSetStart.Add(fileSetSize)
+
+		if err := s.removeSplitSeriesFromFileSet(hashFn, fileSetStart, fileSetSize,
+			earliest, state); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to remove split series from block %s: %v",
+				s.ID(), fileSetStart.ToTime(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) removeSplitSeriesFromFileSet(
+	hashFn sharding.HashFn,
+	fileSetStart xtime.UnixNano,
+	fileSetSize time.Duration,
+	earliest xtime.UnixNano,
+	state fileOpState,
+) error {
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	reader, err := s.newReaderFn(s.opts.BytesPool(), fsOpts)
+	if err != nil {
+		return err
+	}
+	writer, err := fs.NewStreamingWriter(fsOpts)
+	if err != nil {
+		return err
+	}
+
+	volume := state.ColdVersionFlushed + 1
+	if err := fs.SplitFileSet(reader, map[uint32]fs.StreamingWriter{s.ID(): writer}, fs.SplitFileSetOptions{
+		NamespaceID:    s.namespace.ID(),
+		Shard:          s.ID(),
+		BlockStart:     fileSetStart,
+		VolumeIndex:    state.ColdVersionRetrievable,
+		DstVolumeIndex: volume,
+		HashFn:         hashFn,
+	}); err != nil {
+		return err
+	}
+
+	var (
+		blockSize = s.namespace.Options().RetentionOptions().BlockSize()
+		multiErr  xerrors.MultiError
+	)
+	for at := fileSetStart; at.Before(fileSetStart.Add(fileSetSize)); at = at.Add(blockSize) {
+		if at.Before(earliest) {
+			continue
+		}
+		// Notify all block leasers that the block is now readable from the
+		// rewritten fileset.
+		if err := s.finishWriting(at, volume, false); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	s.logger.Debug("removed split series from shard block",
+		zap.Stringer("namespace", s.namespace.ID()),
+		zap.Uint32("shard", s.ID()),
+		zap.Time("blockStart", fileSetStart.ToTime()),
+		zap.Int("volume", volume))
+
+	return multiErr.FinalError()
+}
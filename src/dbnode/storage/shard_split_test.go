@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package storage
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestShardRemoveSplitSeries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts       = DefaultTestOptions()
+		fsOpts     = opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+		nsCtx      = namespace.Context{ID: defaultTestNs1ID}
+		blockSize  = defaultTestRetentionOpts.BlockSize()
+		now        = xtime.ToUnixNano(opts.ClockOptions().NowFn()())
+		blockStart = now.Truncate(blockSize).Add(-4 * blockSize)
+		// Series bar belongs to the shard split off from the shard.
+		hashFn = func(id ident.ID) uint32 {
+			if id.String() == "bar" {
+				return 1
+			}
+			return 0
+		}
+	)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  defaultTestNs1ID,
+			BlockStart: blockStart,
+		},
+		BlockSize: blockSize,
+	}))
+	for _, id := range []string{"bar", "foo"} {
+		data := []byte{1, 2, 3}
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		meta := persist.NewMetadataFromIDAndTags(ident.StringID(id),
+			ident.Tags{}, persist.MetadataOptions{})
+		require.NoError(t, writer.Write(meta, bytes, digest.Checksum(data)))
+	}
+	require.NoError(t, writer.Close())
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	s := testDatabaseShard(t, opts)
+	defer s.Close()
+	require.NoError(t, s.Bootstrap(ctx, nsCtx))
+
+	for _, id := range []string{"bar", "foo"} {
+		_, err := s.Write(ctx, ident.StringID(id), now, 1.0, xtime.Second, nil,
+			series.WriteOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, s.RemoveSplitSeries(hashFn, nsCtx))
+
+	// The series of the split shard are removed from memory.
+	bar, err := s.lookupEntryWithLock(ident.StringID("bar"))
+	require.NoError(t, err)
+	require.True(t, bar.Series.IsEmpty())
+	foo, err := s.lookupEntryWithLock(ident.StringID("foo"))
+	require.NoError(t, err)
+	require.False(t, foo.Series.IsEmpty())
+
+	// And the block is readable from a fileset without them.
+	flushState, err := s.FlushState(blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 1, flushState.ColdVersionFlushed)
+	require.Equal(t, 1, flushState.ColdVersionRetrievable)
+
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, reader.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   defaultTestNs1ID,
+			BlockStart:  blockStart,
+			VolumeIndex: 1,
+		},
+		FileSetType:      persist.FileSetFlushType,
+		StreamingEnabled: true,
+	}))
+	var ids []string
+	for {
+		entry, err := reader.StreamingRead()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ids = append(ids, entry.ID.String())
+	}
+	require.NoError(t, reader.Close())
+	require.Equal(t, []string{"foo"}, ids)
+}
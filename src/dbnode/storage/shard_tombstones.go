@@ -0,0 +1,863 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var errShardTombstonesLimitExceeded = errors.New("shard tombstones limit exceeded")
+
+// seriesTombstones holds the deleted ranges of a series by the start of the
+// blocks they fall in, the ranges of a block are sorted and disjoint. The
+// tombstones of a series are never mutated once visible to readers, they are
+// replaced instead.
+type seriesTombstones struct {
+	// deletedAt is the time of the latest delete of the series.
+	deletedAt xtime.UnixNano
+	// removedAt is the time all of the data of the series was deleted at,
+	// or zero if only some of its data was.
+	removedAt xtime.UnixNano
+	blocks    map[xtime.UnixNano][]xtime.Range
+}
+
+// blockRanges returns the deleted ranges of the block.
+func (t *seriesTombstones) blockRanges(blockStart xtime.UnixNano) []xtime.Range {
+	if t == nil {
+		return nil
+	}
+	return t.blocks[blockStart]
+}
+
+// blockDeleted returns true if all of the data of the block is deleted.
+func (t *seriesTombstones) blockDeleted(blockStart xtime.UnixNano, blockSize time.Duration) bool {
+	return rangesContain(t.blockRanges(blockStart),
+		xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)})
+}
+
+// deletedInRange returns true if all of the data of the series within the
+// range [start, end) is deleted.
+func (t *seriesTombstones) deletedInRange(start, end xtime.UnixNano, blockSize time.Duration) bool {
+	if t == nil || len(t.blocks) == 0 || !start.Before(end) {
+		return false
+	}
+	for blockStart := start.Truncate(blockSize); blockStart.Before(end); blockStart = blockStart.Add(blockSize) {
+		blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+		blockRange, _ = blockRange.Intersect(xtime.Range{Start: start, End: end})
+		if !rangesContain(t.blocks[blockStart], blockRange) {
+			return false
+		}
+	}
+	return true
+}
+
+// rangesWithin returns the sorted deleted ranges of the blocks within the
+// range [start, end).
+func (t *seriesTombstones) rangesWithin(
+	start, end xtime.UnixNano,
+	blockSize time.Duration,
+) []xtime.Range {
+	if t == nil || len(t.blocks) == 0 {
+		return nil
+	}
+	var ranges []xtime.Range
+	for blockStart := start; blockStart.Before(end); blockStart = blockStart.Add(blockSize) {
+		ranges = append(ranges, t.blocks[blockStart]...)
+	}
+	return ranges
+}
+
+// numTombstones returns the number of deleted ranges of the series, a series
+// that was removed counts as one more until it is forgotten.
+func (t *seriesTombstones) numTombstones() int {
+	n := 0
+	for _, ranges := range t.blocks {
+		n += len(ranges)
+	}
+	if t.removedAt != 0 {
+		n++
+	}
+	return n
+}
+
+func (t *seriesTombstones) clone() *seriesTombstones {
+	cloned := &seriesTombstones{
+		blocks: make(map[xtime.UnixNano][]xtime.Range),
+	}
+	if t == nil {
+		return cloned
+	}
+	cloned.deletedAt = t.deletedAt
+	cloned.removedAt = t.removedAt
+	for blockStart, ranges := range t.blocks {
+		cloned.blocks[blockStart] = ranges
+	}
+	return cloned
+}
+
+// rangesContain returns true if one of the sorted and disjoint ranges
+// contains r.
+func rangesContain(ranges []xtime.Range, r xtime.Range) bool {
+	for _, deleted := range ranges {
+		if deleted.Contains(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// addRange returns a copy of the sorted and disjoint ranges with r added,
+// the ranges that overlap or are adjacent to r are merged with it.
+func addRange(ranges []xtime.Range, r xtime.Range) []xtime.Range {
+	result := make([]xtime.Range, 0, len(ranges)+1)
+	for _, existing := range ranges {
+		if existing.End.Before(r.Start) || r.End.Before(existing.Start) {
+			result = append(result, existing)
+			continue
+		}
+		r = r.Merge(existing)
+	}
+	result = append(result, r)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+// tombstonesCompaction records that the fileset of a block was rewritten
+// without the data deleted before the rewrite started.
+type tombstonesCompaction struct {
+	blockStart  xtime.UnixNano
+	startedAt   xtime.UnixNano
+	completedAt xtime.UnixNano
+}
+
+// shardTombstones tracks the deleted data of the series of a shard.
+// Tombstones are persisted next to the shard's filesets and applied when
+// reading, flushing and merging, so deleted data stays hidden across
+// restarts. The tombstones of a block are dropped once its fileset has been
+// rewritten without the deleted data and a snapshot has since completed,
+// after which the deleted data can no longer be bootstrapped from the commit
+// log, or once the block falls out of retention.
+type shardTombstones struct {
+	sync.RWMutex
+
+	fsOpts        fs.Options
+	namespace     ident.ID
+	shard         uint32
+	blockSize     time.Duration
+	maxTombstones int
+	logger        *zap.Logger
+
+	loaded      bool
+	series      map[string]*seriesTombstones
+	compactions []tombstonesCompaction
+}
+
+func newShardTombstones(
+	fsOpts fs.Options,
+	namespace ident.ID,
+	shard uint32,
+	blockSize time.Duration,
+	maxTombstones int,
+	logger *zap.Logger,
+) *shardTombstones {
+	return &shardTombstones{
+		fsOpts:        fsOpts,
+		namespace:     namespace,
+		shard:         shard,
+		blockSize:     blockSize,
+		maxTombstones: maxTombstones,
+		logger:        logger,
+		series:        make(map[string]*seriesTombstones),
+	}
+}
+
+// load reads the persisted tombstones for the shard if they have not
+// already been read.
+func (t *shardTombstones) load() error {
+	t.RLock()
+	loaded := t.loaded
+	t.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	return t.loadWithLock()
+}
+
+func (t *shardTombstones) loadWithLock() error {
+	if t.loaded {
+		return nil
+	}
+
+	entries, err := fs.ReadTombstones(t.fsOpts.FilePathPrefix(), t.namespace, t.shard)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		tombstones := &seriesTombstones{
+			deletedAt: entry.DeletedAt,
+			removedAt: entry.RemovedAt,
+			blocks:    make(map[xtime.UnixNano][]xtime.Range, len(entry.Blocks)),
+		}
+		for _, b := range entry.Blocks {
+			tombstones.blocks[b.BlockStart] = addRange(tombstones.blocks[b.BlockStart],
+				xtime.Range{Start: b.From, End: b.Until})
+		}
+		t.series[string(entry.ID)] = tombstones
+	}
+	t.loaded = true
+	return nil
+}
+
+// ensureLoaded is used by read paths that cannot return an error, if the
+// tombstones cannot be read they are treated as empty until the next attempt.
+func (t *shardTombstones) ensureLoaded() {
+	if err := t.load(); err != nil {
+		t.logger.Error("unable to load shard tombstones",
+			zap.Stringer("namespace", t.namespace),
+			zap.Uint32("shard", t.shard),
+			zap.Error(err))
+	}
+}
+
+// Add tombstones the data of the series within the range, which is split
+// into the blocks it spans, and persists the result once before making it
+// visible to readers. A non-zero removedAt records that all of the data of
+// the series was deleted. Nothing is added if the shard would hold more
+// tombstones than its limit.
+func (t *shardTombstones) Add(
+	ids []ident.ID,
+	r xtime.Range,
+	deletedAt xtime.UnixNano,
+	removedAt xtime.UnixNano,
+) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if err := t.loadWithLock(); err != nil {
+		return err
+	}
+
+	previous := make(map[string]*seriesTombstones, len(ids))
+	for _, id := range ids {
+		key := id.String()
+		if _, ok := previous[key]; ok {
+			continue
+		}
+
+		existing := t.series[key]
+		updated := existing.clone()
+		updated.deletedAt = deletedAt
+		if removedAt != 0 {
+			updated.removedAt = removedAt
+		}
+		for blockStart := r.Start.Truncate(t.blockSize); blockStart.Before(r.End); blockStart = blockStart.Add(t.blockSize) {
+			blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(t.blockSize)}
+			blockRange, _ = blockRange.Intersect(r)
+			updated.blocks[blockStart] = addRange(updated.blocks[blockStart], blockRange)
+		}
+
+		previous[key] = existing
+		t.series[key] = updated
+	}
+	if len(previous) == 0 {
+		// Nothing new to persist.
+		return nil
+	}
+
+	revert := func() {
+		for key, existing := range previous {
+			if existing == nil {
+				delete(t.series, key)
+			} else {
+				t.series[key] = existing
+			}
+		}
+	}
+	if n := t.numTombstonesWithLock(); t.maxTombstones > 0 && n > t.maxTombstones {
+		revert()
+		return xerrors.NewInvalidParamsError(fmt.Errorf(
+			"%w: shard %d would hold %d tombstones, limit is %d",
+			errShardTombstonesLimitExceeded, t.shard, n, t.maxTombstones))
+	}
+	if err := t.persistWithLock(); err != nil {
+		revert()
+		return err
+	}
+	return nil
+}
+
+func (t *shardTombstones) numTombstonesWithLock() int {
+	n := 0
+	for _, tombstones := range t.series {
+		n += tombstones.numTombstones()
+	}
+	return n
+}
+
+// RemoveBefore drops the tombstones of blocks that start before
+// earliestToRetain, these blocks have expired and no longer need to be
+// masked, and forgets the series removed before earliestRemovedToRetain,
+// whose documents no longer need to be removed from the index.
+func (t *shardTombstones) RemoveBefore(
+	earliestToRetain xtime.UnixNano,
+	earliestRemovedToRetain xtime.UnixNano,
+) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if err := t.loadWithLock(); err != nil {
+		return err
+	}
+
+	compactions := t.compactions[:0]
+	for _, c := range t.compactions {
+		if !c.blockStart.Before(earliestToRetain) {
+			compactions = append(compactions, c)
+		}
+	}
+	t.compactions = compactions
+
+	return t.removeWithLock(func(tombstones *seriesTombstones) *seriesTombstones {
+		var retained *seriesTombstones
+		for blockStart := range tombstones.blocks {
+			if !blockStart.Before(earliestToRetain) {
+				continue
+			}
+			if retained == nil {
+				retained = tombstones.clone()
+			}
+			delete(retained.blocks, blockStart)
+		}
+		if tombstones.removedAt != 0 && tombstones.removedAt.Before(earliestRemovedToRetain) {
+			if retained == nil {
+				retained = tombstones.clone()
+			}
+			retained.removedAt = 0
+		}
+		return retained
+	})
+}
+
+// removeWithLock replaces the tombstones of every series for which the
+// function returns new tombstones, readers may hold the previous tombstones
+// so they are replaced rather than mutated. Series left without tombstones
+// are dropped.
+func (t *shardTombstones) removeWithLock(
+	fn func(tombstones *seriesTombstones) *seriesTombstones,
+) error {
+	removed := false
+	for key, tombstones := range t.series {
+		retained := fn(tombstones)
+		if retained == nil {
+			continue
+		}
+		removed = true
+		if len(retained.blocks) == 0 && retained.removedAt == 0 {
+			delete(t.series, key)
+		} else {
+			t.series[key] = retained
+		}
+	}
+	if !removed {
+		return nil
+	}
+	return t.persistWithLock()
+}
+
+// BlocksToCompact returns the block starts with tombstones that no rewrite
+// of the block has applied yet.
+func (t *shardTombstones) BlocksToCompact() []xtime.UnixNano {
+	t.ensureLoaded()
+	t.RLock()
+	defer t.RUnlock()
+
+	blocks := make(map[xtime.UnixNano]struct{})
+	for _, tombstones := range t.series {
+		for blockStart := range tombstones.blocks {
+			if _, ok := blocks[blockStart]; ok {
+				continue
+			}
+			if !t.compactedWithRLock(tombstones, blockStart) {
+				blocks[blockStart] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]xtime.UnixNano, 0, len(blocks))
+	for blockStart := range blocks {
+		result = append(result, blockStart)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+	return result
+}
+
+func (t *shardTombstones) compactedWithRLock(
+	tombstones *seriesTombstones,
+	blockStart xtime.UnixNano,
+) bool {
+	for _, c := range t.compactions {
+		if c.blockStart == blockStart && tombstones.deletedAt.Before(c.startedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkCompacted records that the fileset of the block was rewritten, from
+// startedAt until completedAt, without the data deleted before the rewrite
+// started.
+func (t *shardTombstones) MarkCompacted(
+	blockStart xtime.UnixNano,
+	startedAt xtime.UnixNano,
+	completedAt xtime.UnixNano,
+) {
+	t.Lock()
+	t.compactions = append(t.compactions, tombstonesCompaction{
+		blockStart:  blockStart,
+		startedAt:   startedAt,
+		completedAt: completedAt,
+	})
+	t.Unlock()
+}
+
+// RemoveCompacted drops the tombstones applied by the rewrites of blocks that
+// completed before a snapshot that started at snapshotStart, the commit logs
+// holding the deleted data are no longer bootstrapped from once the snapshot
+// has succeeded.
+func (t *shardTombstones) RemoveCompacted(snapshotStart xtime.UnixNano) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if err := t.loadWithLock(); err != nil {
+		return err
+	}
+
+	var (
+		applied     []tombstonesCompaction
+		compactions = t.compactions[:0]
+	)
+	for _, c := range t.compactions {
+		if c.completedAt.Before(snapshotStart) {
+			applied = append(applied, c)
+		} else {
+			compactions = append(compactions, c)
+		}
+	}
+	t.compactions = compactions
+	if len(applied) == 0 {
+		return nil
+	}
+
+	return t.removeWithLock(func(tombstones *seriesTombstones) *seriesTombstones {
+		var retained *seriesTombstones
+		for _, c := range applied {
+			if _, ok := tombstones.blocks[c.blockStart]; !ok ||
+				!tombstones.deletedAt.Before(c.startedAt) {
+				continue
+			}
+			if retained == nil {
+				retained = tombstones.clone()
+			}
+			delete(retained.blocks, c.blockStart)
+		}
+		return retained
+	})
+}
+
+func (t *shardTombstones) persistWithLock() error {
+	entries := make([]fs.SeriesTombstone, 0, len(t.series))
+	for key, tombstones := range t.series {
+		entry := fs.SeriesTombstone{
+			ID:        []byte(key),
+			DeletedAt: tombstones.deletedAt,
+			RemovedAt: tombstones.removedAt,
+			Blocks:    make([]fs.BlockTombstone, 0, len(tombstones.blocks)),
+		}
+		for blockStart, ranges := range tombstones.blocks {
+			for _, r := range ranges {
+				entry.Blocks = append(entry.Blocks, fs.BlockTombstone{
+					BlockStart: blockStart,
+					From:       r.Start,
+					Until:      r.End,
+				})
+			}
+		}
+		sort.Slice(entry.Blocks, func(i, j int) bool {
+			return entry.Blocks[i].From < entry.Blocks[j].From
+		})
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].ID) < string(entries[j].ID)
+	})
+	return fs.WriteTombstones(t.fsOpts, t.namespace, t.shard, entries)
+}
+
+// Empty returns true if no series in the shard has deleted data.
+func (t *shardTombstones) Empty() bool {
+	t.ensureLoaded()
+	t.RLock()
+	empty := len(t.series) == 0
+	t.RUnlock()
+	return empty
+}
+
+// Deleted returns the tombstones of a series, or nil if the series has none.
+// The returned tombstones must not be modified.
+func (t *shardTombstones) Deleted(id ident.ID) *seriesTombstones {
+	t.ensureLoaded()
+	t.RLock()
+	defer t.RUnlock()
+	if len(t.series) == 0 {
+		return nil
+	}
+	return t.series[string(id.Bytes())]
+}
+
+// DeletedInRange returns true if all of the data of the series within the
+// range [start, end) is deleted.
+func (t *shardTombstones) DeletedInRange(id ident.ID, start, end xtime.UnixNano) bool {
+	return t.Deleted(id).deletedInRange(start, end, t.blockSize)
+}
+
+// DeletedSeriesInRange returns the IDs of the series whose data within the
+// range [start, end) is deleted. The documents of removed series are removed
+// from the index blocks that ended before their removal, so removed series
+// are only returned when the range overlaps the index block they were
+// removed in.
+func (t *shardTombstones) DeletedSeriesInRange(
+	start, end xtime.UnixNano,
+	indexBlockSize time.Duration,
+) []ident.ID {
+	t.ensureLoaded()
+	t.RLock()
+	defer t.RUnlock()
+
+	var ids []ident.ID
+	for key, tombstones := range t.series {
+		if tombstones.removedAt != 0 && !end.After(tombstones.removedAt.Truncate(indexBlockSize)) {
+			continue
+		}
+		if tombstones.deletedInRange(start, end, t.blockSize) {
+			ids = append(ids, ident.StringID(key))
+		}
+	}
+	return ids
+}
+
+// RemovedSeries returns the IDs of the series that were removed by the time
+// they were removed at.
+func (t *shardTombstones) RemovedSeries() map[xtime.UnixNano][]ident.ID {
+	t.ensureLoaded()
+	t.RLock()
+	defer t.RUnlock()
+
+	removed := make(map[xtime.UnixNano][]ident.ID)
+	for key, tombstones := range t.series {
+		if tombstones.removedAt != 0 {
+			removed[tombstones.removedAt] = append(removed[tombstones.removedAt], ident.StringID(key))
+		}
+	}
+	return removed
+}
+
+// removeDeletedData returns a block reader with the data of the readers of a
+// block that is not within any of the deleted ranges, or false if all of
+// their data is deleted. The reader is finalized when the context closes.
+func removeDeletedData(
+	ctx context.Context,
+	readers []xio.BlockReader,
+	deleted []xtime.Range,
+	opts Options,
+	nsCtx namespace.Context,
+) (xio.BlockReader, bool, error) {
+	var (
+		blockStart     = readers[0].Start
+		blockSize      = readers[0].BlockSize
+		segmentReaders = make([]xio.SegmentReader, 0, len(readers))
+	)
+	for _, reader := range readers {
+		segmentReaders = append(segmentReaders, reader.SegmentReader)
+	}
+	segment, err := fs.RemoveDeletedDatapoints(segmentReaders, blockStart, blockSize, deleted,
+		opts.DatabaseBlockOptions().DatabaseBlockAllocSize(), nsCtx.Schema,
+		opts.MultiReaderIteratorPool(), opts.EncoderPool())
+	if err != nil {
+		return xio.BlockReader{}, false, err
+	}
+	if segment.Len() == 0 {
+		segment.Finalize()
+		return xio.BlockReader{}, false, nil
+	}
+
+	segmentReader := xio.NewSegmentReader(segment)
+	ctx.RegisterFinalizer(segmentReader)
+	return xio.BlockReader{
+		SegmentReader: segmentReader,
+		Start:         blockStart,
+		BlockSize:     blockSize,
+	}, true, nil
+}
+
+// tombstonedBlockReaderIter skips over the blocks of a series that have
+// been deleted entirely and drops the deleted data of the blocks that have
+// been partially deleted.
+type tombstonedBlockReaderIter struct {
+	series.BlockReaderIter
+	deleted *seriesTombstones
+	opts    Options
+	nsCtx   namespace.Context
+
+	current []xio.BlockReader
+	err     error
+}
+
+func newTombstonedBlockReaderIter(
+	iter series.BlockReaderIter,
+	deleted *seriesTombstones,
+	opts Options,
+	nsCtx namespace.Context,
+) series.BlockReaderIter {
+	return &tombstonedBlockReaderIter{
+		BlockReaderIter: iter,
+		deleted:         deleted,
+		opts:            opts,
+		nsCtx:           nsCtx,
+	}
+}
+
+func (i *tombstonedBlockReaderIter) Next(ctx context.Context) bool {
+	if i.err != nil {
+		return false
+	}
+	for i.BlockReaderIter.Next(ctx) {
+		// All readers in the current set share the same block.
+		current := i.BlockReaderIter.Current()
+		deleted := i.deleted.blockRanges(current[0].Start)
+		if len(deleted) == 0 {
+			i.current = current
+			return true
+		}
+		if i.deleted.blockDeleted(current[0].Start, current[0].BlockSize) {
+			continue
+		}
+
+		reader, ok, err := removeDeletedData(ctx, current, deleted, i.opts, i.nsCtx)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		if !ok {
+			continue
+		}
+		i.current = []xio.BlockReader{reader}
+		return true
+	}
+	return false
+}
+
+func (i *tombstonedBlockReaderIter) Current() []xio.BlockReader {
+	return i.current
+}
+
+func (i *tombstonedBlockReaderIter) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.BlockReaderIter.Err()
+}
+
+func (i *tombstonedBlockReaderIter) ToSlices(ctx context.Context) ([][]xio.BlockReader, error) {
+	var results [][]xio.BlockReader
+	for i.Next(ctx) {
+		results = append(results, i.Current())
+	}
+	if err := i.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func filterTombstonedFetchBlockResults(
+	ctx context.Context,
+	results []block.FetchBlockResult,
+	deleted *seriesTombstones,
+	blockSize time.Duration,
+	opts Options,
+	nsCtx namespace.Context,
+) ([]block.FetchBlockResult, error) {
+	filtered := results[:0]
+	for _, result := range results {
+		ranges := deleted.blockRanges(result.Start)
+		if len(ranges) == 0 {
+			filtered = append(filtered, result)
+			continue
+		}
+		if deleted.blockDeleted(result.Start, blockSize) {
+			continue
+		}
+		if result.Err != nil || len(result.Blocks) == 0 {
+			filtered = append(filtered, result)
+			continue
+		}
+
+		reader, ok, err := removeDeletedData(ctx, result.Blocks, ranges, opts, nsCtx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		result.Blocks = []xio.BlockReader{reader}
+		filtered = append(filtered, result)
+	}
+	return filtered, nil
+}
+
+func filterTombstonedFetchBlockMetadataResults(
+	results block.FetchBlockMetadataResults,
+	deleted *seriesTombstones,
+	blockSize time.Duration,
+	pool block.FetchBlockMetadataResultsPool,
+) block.FetchBlockMetadataResults {
+	filtered := pool.Get()
+	for _, result := range results.Results() {
+		if deleted.blockDeleted(result.Start, blockSize) {
+			continue
+		}
+		filtered.Add(result)
+	}
+	results.Close()
+	return filtered
+}
+
+// tombstonedDataFn returns a persist function that drops the deleted data of
+// the series of a block before persisting them with persistFn.
+func tombstonedDataFn(
+	persistFn persist.DataFn,
+	blockStart xtime.UnixNano,
+	tombstones *shardTombstones,
+	opts Options,
+	nsCtx namespace.Context,
+) persist.DataFn {
+	blockSize := tombstones.blockSize
+	return func(metadata persist.Metadata, segment ts.Segment, checksum uint32) error {
+		deleted := tombstones.Deleted(ident.BytesID(metadata.BytesID())).blockRanges(blockStart)
+		if len(deleted) == 0 {
+			return persistFn(metadata, segment, checksum)
+		}
+
+		segmentReaders := []xio.SegmentReader{xio.NewSegmentReader(segment)}
+		filtered, err := fs.RemoveDeletedDatapoints(segmentReaders, blockStart, blockSize, deleted,
+			opts.DatabaseBlockOptions().DatabaseBlockAllocSize(), nsCtx.Schema,
+			opts.MultiReaderIteratorPool(), opts.EncoderPool())
+		if err != nil {
+			return err
+		}
+		// Series left without data are skipped by the writer.
+		err = persistFn(metadata, filtered, filtered.CalculateChecksum())
+		filtered.Finalize()
+		return err
+	}
+}
+
+// tombstonedFlushPreparer drops the deleted data of the series persisted
+// with the data it prepares.
+type tombstonedFlushPreparer struct {
+	persist.FlushPreparer
+
+	tombstones *shardTombstones
+	opts       Options
+	nsCtx      namespace.Context
+}
+
+func (p tombstonedFlushPreparer) PrepareData(
+	opts persist.DataPrepareOptions,
+) (persist.PreparedDataPersist, error) {
+	prepared, err := p.FlushPreparer.PrepareData(opts)
+	if err != nil {
+		return prepared, err
+	}
+	prepared.Persist = tombstonedDataFn(prepared.Persist, opts.BlockStart,
+		p.tombstones, p.opts, p.nsCtx)
+	return prepared, nil
+}
+
+// trimTombstonedBlock returns a block holding the datapoints of the block
+// that are not within any of the deleted ranges, or nil if there are none.
+// It is used to drop the deleted data of partially deleted blocks that are
+// bootstrapped, the caller remains responsible for closing the block.
+func trimTombstonedBlock(
+	bl block.DatabaseBlock,
+	deleted []xtime.Range,
+	opts Options,
+	nsCtx namespace.Context,
+) (block.DatabaseBlock, error) {
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	stream, err := bl.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blockStart = bl.StartTime()
+		blockSize  = bl.BlockSize()
+		bopts      = opts.DatabaseBlockOptions()
+	)
+	segment, err := fs.RemoveDeletedDatapoints([]xio.SegmentReader{stream}, blockStart, blockSize,
+		deleted, bopts.DatabaseBlockAllocSize(), nsCtx.Schema,
+		opts.MultiReaderIteratorPool(), opts.EncoderPool())
+	if err != nil {
+		return nil, err
+	}
+	if segment.Len() == 0 {
+		segment.Finalize()
+		return nil, nil
+	}
+	return block.NewDatabaseBlock(blockStart, blockSize, segment, bopts, nsCtx), nil
+}
@@ -0,0 +1,385 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestShardTombstonesOptions(t *testing.T) (Options, func()) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+
+	opts := DefaultTestOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+		SetFilesystemOptions(fsOpts))
+	return opts, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestShardTombstonesAddAndReload(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts    = opts.CommitLogOptions().FilesystemOptions()
+		logger    = opts.InstrumentOptions().Logger()
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		deletedAt = start.Add(3 * blockSize)
+		id        = ident.StringID("foo")
+	)
+
+	tombstones := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.True(t, tombstones.Empty())
+	require.NoError(t, tombstones.Add([]ident.ID{id},
+		xtime.Range{Start: start, End: start.Add(2 * blockSize)}, deletedAt, 0))
+	require.False(t, tombstones.Empty())
+
+	require.True(t, tombstones.DeletedInRange(id, start, start.Add(2*blockSize)))
+	require.False(t, tombstones.DeletedInRange(id, start, start.Add(3*blockSize)))
+	require.False(t, tombstones.DeletedInRange(ident.StringID("bar"), start, start.Add(blockSize)))
+
+	// Tombstones must survive a restart.
+	reloaded := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.NoError(t, reloaded.load())
+	deleted := reloaded.Deleted(id)
+	require.Equal(t, &seriesTombstones{
+		deletedAt: deletedAt,
+		blocks: map[xtime.UnixNano][]xtime.Range{
+			start:                {{Start: start, End: start.Add(blockSize)}},
+			start.Add(blockSize): {{Start: start.Add(blockSize), End: start.Add(2 * blockSize)}},
+		},
+	}, deleted)
+
+	// Expired tombstones are dropped without modifying the tombstones
+	// handed to readers.
+	require.NoError(t, reloaded.RemoveBefore(start.Add(blockSize), 0))
+	require.Nil(t, reloaded.Deleted(id).blockRanges(start))
+	require.Len(t, reloaded.Deleted(id).blockRanges(start.Add(blockSize)), 1)
+	require.Len(t, deleted.blocks, 2)
+	require.NoError(t, reloaded.RemoveBefore(start.Add(2*blockSize), 0))
+	require.True(t, reloaded.Empty())
+
+	empty := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.True(t, empty.Empty())
+}
+
+func TestShardTombstonesPartialRanges(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts    = opts.CommitLogOptions().FilesystemOptions()
+		logger    = opts.InstrumentOptions().Logger()
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		from      = start.Add(30 * time.Minute)
+		until     = start.Add(time.Hour)
+		id        = ident.StringID("foo")
+		other     = ident.StringID("bar")
+	)
+
+	tombstones := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.NoError(t, tombstones.Add([]ident.ID{id, other},
+		xtime.Range{Start: from, End: until}, until, 0))
+
+	// Only the data within the deleted range is tombstoned.
+	deleted := tombstones.Deleted(id)
+	require.Equal(t, []xtime.Range{{Start: from, End: until}}, deleted.blockRanges(start))
+	require.False(t, deleted.blockDeleted(start, blockSize))
+	require.True(t, tombstones.DeletedInRange(id, from, until))
+	require.False(t, tombstones.DeletedInRange(id, start, until))
+	require.False(t, tombstones.DeletedInRange(id, from, until.Add(time.Minute)))
+	require.Len(t, tombstones.DeletedSeriesInRange(from, until, blockSize), 2)
+	require.Empty(t, tombstones.DeletedSeriesInRange(start, start.Add(blockSize), blockSize))
+
+	// A later delete of an adjacent range is merged with the tombstone.
+	require.NoError(t, tombstones.Add([]ident.ID{id},
+		xtime.Range{Start: start, End: from}, until.Add(time.Minute), 0))
+	require.Equal(t, []xtime.Range{{Start: start, End: until}},
+		tombstones.Deleted(id).blockRanges(start))
+	require.Equal(t, []xtime.Range{{Start: from, End: until}}, deleted.blockRanges(start))
+}
+
+func TestShardTombstonesLimit(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts    = opts.CommitLogOptions().FilesystemOptions()
+		logger    = opts.InstrumentOptions().Logger()
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		r         = xtime.Range{Start: start, End: start.Add(time.Hour)}
+	)
+
+	tombstones := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 2, logger)
+	require.NoError(t, tombstones.Add([]ident.ID{ident.StringID("foo")}, r, r.End, 0))
+
+	err := tombstones.Add([]ident.ID{ident.StringID("bar"), ident.StringID("baz")}, r, r.End, 0)
+	require.Error(t, err)
+	require.True(t, errors.Is(xerrors.GetInnerInvalidParamsError(err), errShardTombstonesLimitExceeded))
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.Nil(t, tombstones.Deleted(ident.StringID("bar")))
+
+	// Nothing of the rejected delete is persisted.
+	reloaded := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 2, logger)
+	require.NoError(t, reloaded.load())
+	require.Nil(t, reloaded.Deleted(ident.StringID("baz")))
+	require.NotNil(t, reloaded.Deleted(ident.StringID("foo")))
+}
+
+func TestShardTombstonesRemoveCompacted(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts    = opts.CommitLogOptions().FilesystemOptions()
+		logger    = opts.InstrumentOptions().Logger()
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		deletedAt = start.Add(3 * blockSize)
+		id        = ident.StringID("foo")
+	)
+
+	tombstones := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.NoError(t, tombstones.Add([]ident.ID{id},
+		xtime.Range{Start: start, End: start.Add(blockSize)}, deletedAt, 0))
+	require.Equal(t, []xtime.UnixNano{start}, tombstones.BlocksToCompact())
+
+	// A rewrite that started before the delete does not apply it.
+	tombstones.MarkCompacted(start, deletedAt.Add(-time.Second), deletedAt.Add(time.Second))
+	require.Equal(t, []xtime.UnixNano{start}, tombstones.BlocksToCompact())
+
+	tombstones.MarkCompacted(start, deletedAt.Add(time.Second), deletedAt.Add(time.Minute))
+	require.Empty(t, tombstones.BlocksToCompact())
+
+	// The tombstones are kept until a snapshot starts after the rewrite.
+	require.NoError(t, tombstones.RemoveCompacted(deletedAt.Add(time.Minute)))
+	require.False(t, tombstones.Empty())
+	require.NoError(t, tombstones.RemoveCompacted(deletedAt.Add(time.Hour)))
+	require.True(t, tombstones.Empty())
+}
+
+func TestShardTombstonesRemovedSeries(t *testing.T) {
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	var (
+		fsOpts         = opts.CommitLogOptions().FilesystemOptions()
+		logger         = opts.InstrumentOptions().Logger()
+		blockSize      = 2 * time.Hour
+		indexBlockSize = 4 * time.Hour
+		start          = xtime.Now().Truncate(indexBlockSize)
+		removedAt      = start.Add(indexBlockSize + time.Hour)
+		id             = ident.StringID("foo")
+	)
+
+	tombstones := newShardTombstones(fsOpts, defaultTestNs1ID, 0, blockSize, 0, logger)
+	require.NoError(t, tombstones.Add([]ident.ID{id},
+		xtime.Range{Start: start, End: removedAt}, removedAt, removedAt))
+	require.Equal(t, map[xtime.UnixNano][]ident.ID{removedAt: {id}}, tombstones.RemovedSeries())
+
+	// The documents of removed series are only filtered from the index block
+	// they were removed in.
+	require.Empty(t, tombstones.DeletedSeriesInRange(start, start.Add(indexBlockSize), indexBlockSize))
+	require.Len(t, tombstones.DeletedSeriesInRange(start, removedAt, indexBlockSize), 1)
+
+	require.NoError(t, tombstones.RemoveBefore(start, start.Add(2*indexBlockSize)))
+	require.Empty(t, tombstones.RemovedSeries())
+	require.True(t, tombstones.DeletedInRange(id, start, removedAt))
+}
+
+func TestTrimTombstonedBlock(t *testing.T) {
+	var (
+		opts      = DefaultTestOptions()
+		blockSize = 2 * time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		until     = start.Add(time.Hour)
+		nsCtx     = namespace.Context{ID: defaultTestNs1ID}
+		values    = []ts.Datapoint{
+			{TimestampNanos: start, Value: 1},
+			{TimestampNanos: until.Add(-time.Second), Value: 2},
+			{TimestampNanos: until, Value: 3},
+			{TimestampNanos: until.Add(time.Minute), Value: 4},
+		}
+	)
+
+	bl := block.NewDatabaseBlock(start, blockSize, encodeTestDatapoints(t, opts, start, values),
+		opts.DatabaseBlockOptions(), nsCtx)
+	defer bl.Close()
+
+	trimmed, err := trimTombstonedBlock(bl, []xtime.Range{
+		{Start: start, End: until},
+		{Start: until.Add(time.Minute), End: until.Add(2 * time.Minute)},
+	}, opts, nsCtx)
+	require.NoError(t, err)
+	require.NotNil(t, trimmed)
+	defer trimmed.Close()
+
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+	stream, err := trimmed.Stream(ctx)
+	require.NoError(t, err)
+	require.Equal(t, values[2:3], decodeTestDatapoints(t, opts, stream))
+
+	// Nothing is left of a block that was deleted entirely.
+	trimmed, err = trimTombstonedBlock(bl, []xtime.Range{
+		{Start: start, End: start.Add(blockSize)},
+	}, opts, nsCtx)
+	require.NoError(t, err)
+	require.Nil(t, trimmed)
+}
+
+func TestShardDeleteSeriesFiltersFetchBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	var (
+		blockSize = shard.namespace.Options().RetentionOptions().BlockSize()
+		start     = xtime.Now().Truncate(blockSize)
+		starts    = []xtime.UnixNano{start, start.Add(blockSize)}
+		r         = xtime.Range{Start: start, End: start.Add(blockSize)}
+		id        = ident.StringID("foo")
+	)
+	series := addMockSeries(ctrl, shard, id, ident.Tags{}, 0)
+	series.EXPECT().DeleteRange(r, gomock.Any()).Return(nil)
+	require.NoError(t, shard.DeleteSeries([]ident.ID{id}, r, 0))
+	require.True(t, shard.HasDeletedSeries())
+	require.True(t, shard.SeriesDeletedInRange(id, start, start.Add(blockSize)))
+	require.False(t, shard.SeriesDeletedInRange(id, start, start.Add(2*blockSize)))
+
+	series.EXPECT().FetchBlocks(ctx, starts, gomock.Any()).Return([]block.FetchBlockResult{
+		block.NewFetchBlockResult(start, nil, nil),
+		block.NewFetchBlockResult(start.Add(blockSize), nil, nil),
+	}, nil)
+	res, err := shard.FetchBlocks(ctx, id, starts, namespace.Context{})
+	require.NoError(t, err)
+	require.Equal(t, []block.FetchBlockResult{
+		block.NewFetchBlockResult(start.Add(blockSize), nil, nil),
+	}, res)
+}
+
+func TestShardDeleteSeriesFiltersPartiallyDeletedBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts, cleanup := newTestShardTombstonesOptions(t)
+	defer cleanup()
+
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	var (
+		blockSize = shard.namespace.Options().RetentionOptions().BlockSize()
+		start     = xtime.Now().Truncate(blockSize)
+		r         = xtime.Range{Start: start.Add(time.Minute), End: start.Add(time.Hour)}
+		id        = ident.StringID("foo")
+		values    = []ts.Datapoint{
+			{TimestampNanos: start, Value: 1},
+			{TimestampNanos: start.Add(30 * time.Minute), Value: 2},
+			{TimestampNanos: start.Add(time.Hour), Value: 3},
+		}
+	)
+	series := addMockSeries(ctrl, shard, id, ident.Tags{}, 0)
+	series.EXPECT().DeleteRange(r, gomock.Any()).Return(nil)
+	require.NoError(t, shard.DeleteSeries([]ident.ID{id}, r, 0))
+	require.False(t, shard.SeriesDeletedInRange(id, start, start.Add(blockSize)))
+	require.True(t, shard.SeriesDeletedInRange(id, r.Start, r.End))
+
+	segment := encodeTestDatapoints(t, opts, start, values)
+	series.EXPECT().FetchBlocks(ctx, []xtime.UnixNano{start}, gomock.Any()).Return([]block.FetchBlockResult{
+		block.NewFetchBlockResult(start, []xio.BlockReader{{
+			SegmentReader: xio.NewSegmentReader(segment),
+			Start:         start,
+			BlockSize:     blockSize,
+		}}, nil),
+	}, nil)
+	res, err := shard.FetchBlocks(ctx, id, []xtime.UnixNano{start}, namespace.Context{})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Len(t, res[0].Blocks, 1)
+	require.Equal(t, []ts.Datapoint{values[0], values[2]},
+		decodeTestDatapoints(t, opts, res[0].Blocks[0].SegmentReader))
+}
+
+func encodeTestDatapoints(
+	t *testing.T,
+	opts Options,
+	start xtime.UnixNano,
+	values []ts.Datapoint,
+) ts.Segment {
+	encoder := opts.EncoderPool().Get()
+	encoder.Reset(start, 0, nil)
+	for _, dp := range values {
+		require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+	}
+	return encoder.Discard()
+}
+
+func decodeTestDatapoints(
+	t *testing.T,
+	opts Options,
+	reader xio.SegmentReader,
+) []ts.Datapoint {
+	iter := opts.ReaderIteratorPool().Get()
+	defer iter.Close()
+	iter.Reset(reader, nil)
+	var read []ts.Datapoint
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		read = append(read, ts.Datapoint{TimestampNanos: dp.TimestampNanos, Value: dp.Value})
+	}
+	require.NoError(t, iter.Err())
+	return read
+}
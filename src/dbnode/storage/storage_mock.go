@@ -46,6 +46,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/limits/permits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/ts/writes"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
@@ -55,6 +56,7 @@ import (
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	sync0 "github.com/m3db/m3/src/x/sync"
 	time0 "github.com/m3db/m3/src/x/time"
@@ -162,6 +164,21 @@ func (mr *MockDatabaseMockRecorder) AssignShardSet(shardSet interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShardSet", reflect.TypeOf((*MockDatabase)(nil).AssignShardSet), shardSet)
 }
 
+// Backup mocks base method.
+func (m *MockDatabase) Backup(namespace ident.ID, opts BackupOptions) (BackupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", namespace, opts)
+	ret0, _ := ret[0].(BackupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockDatabaseMockRecorder) Backup(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockDatabase)(nil).Backup), namespace, opts)
+}
+
 // BatchWriter mocks base method.
 func (m *MockDatabase) BatchWriter(namespace ident.ID, batchSize int) (writes.BatchWriter, error) {
 	m.ctrl.T.Helper()
@@ -219,6 +236,21 @@ func (mr *MockDatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDatabase)(nil).Close))
 }
 
+// DeleteSeries mocks base method.
+func (m *MockDatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockDatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockDatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *MockDatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +282,21 @@ func (mr *MockDatabaseMockRecorder) FetchBlocksMetadataV2(ctx, namespace, shard,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataV2", reflect.TypeOf((*MockDatabase)(nil).FetchBlocksMetadataV2), ctx, namespace, shard, start, end, limit, pageToken, opts)
 }
 
+// FetchExemplars mocks base method.
+func (m *MockDatabase) FetchExemplars(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano, limit int) ([]SeriesExemplars, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", ctx, namespace, query, start, end, limit)
+	ret0, _ := ret[0].([]SeriesExemplars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockDatabaseMockRecorder) FetchExemplars(ctx, namespace, query, start, end, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*MockDatabase)(nil).FetchExemplars), ctx, namespace, query, start, end, limit)
+}
+
 // FlushState mocks base method.
 func (m *MockDatabase) FlushState(namespace ident.ID, shardID uint32, blockStart time0.UnixNano) (fileOpState, error) {
 	m.ctrl.T.Helper()
@@ -572,6 +619,21 @@ func (mr *MockdatabaseMockRecorder) AssignShardSet(shardSet interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShardSet", reflect.TypeOf((*Mockdatabase)(nil).AssignShardSet), shardSet)
 }
 
+// Backup mocks base method.
+func (m *Mockdatabase) Backup(namespace ident.ID, opts BackupOptions) (BackupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", namespace, opts)
+	ret0, _ := ret[0].(BackupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockdatabaseMockRecorder) Backup(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*Mockdatabase)(nil).Backup), namespace, opts)
+}
+
 // BatchWriter mocks base method.
 func (m *Mockdatabase) BatchWriter(namespace ident.ID, batchSize int) (writes.BatchWriter, error) {
 	m.ctrl.T.Helper()
@@ -629,6 +691,21 @@ func (mr *MockdatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*Mockdatabase)(nil).Close))
 }
 
+// DeleteSeries mocks base method.
+func (m *Mockdatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*Mockdatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *Mockdatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -660,6 +737,21 @@ func (mr *MockdatabaseMockRecorder) FetchBlocksMetadataV2(ctx, namespace, shard,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataV2", reflect.TypeOf((*Mockdatabase)(nil).FetchBlocksMetadataV2), ctx, namespace, shard, start, end, limit, pageToken, opts)
 }
 
+// FetchExemplars mocks base method.
+func (m *Mockdatabase) FetchExemplars(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano, limit int) ([]SeriesExemplars, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", ctx, namespace, query, start, end, limit)
+	ret0, _ := ret[0].([]SeriesExemplars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockdatabaseMockRecorder) FetchExemplars(ctx, namespace, query, start, end, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*Mockdatabase)(nil).FetchExemplars), ctx, namespace, query, start, end, limit)
+}
+
 // FlushState mocks base method.
 func (m *Mockdatabase) FlushState(namespace ident.ID, shardID uint32, blockStart time0.UnixNano) (fileOpState, error) {
 	m.ctrl.T.Helper()
@@ -1126,6 +1218,20 @@ func (mr *MockNamespaceMockRecorder) SetReadOnly(value interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadOnly", reflect.TypeOf((*MockNamespace)(nil).SetReadOnly), value)
 }
 
+// ShardSplitComplete mocks base method.
+func (m *MockNamespace) ShardSplitComplete(shardID uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardSplitComplete", shardID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShardSplitComplete indicates an expected call of ShardSplitComplete.
+func (mr *MockNamespaceMockRecorder) ShardSplitComplete(shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardSplitComplete", reflect.TypeOf((*MockNamespace)(nil).ShardSplitComplete), shardID)
+}
+
 // Shards mocks base method.
 func (m *MockNamespace) Shards() []Shard {
 	m.ctrl.T.Helper()
@@ -1275,6 +1381,35 @@ func (mr *MockdatabaseNamespaceMockRecorder) ColdFlush(flush interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlush), flush)
 }
 
+// CompactBlocks mocks base method.
+func (m *MockdatabaseNamespace) CompactBlocks(now time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactBlocks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompactBlocks indicates an expected call of CompactBlocks.
+func (mr *MockdatabaseNamespaceMockRecorder) CompactBlocks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlocks", reflect.TypeOf((*MockdatabaseNamespace)(nil).CompactBlocks), now)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockdatabaseNamespace) DeleteSeries(ctx context.Context, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseNamespaceMockRecorder) DeleteSeries(ctx, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseNamespace)(nil).DeleteSeries), ctx, query, start, end)
+}
+
 // DocRef mocks base method.
 func (m *MockdatabaseNamespace) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	m.ctrl.T.Helper()
@@ -1322,6 +1457,21 @@ func (mr *MockdatabaseNamespaceMockRecorder) FetchBlocksMetadataV2(ctx, shardID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataV2", reflect.TypeOf((*MockdatabaseNamespace)(nil).FetchBlocksMetadataV2), ctx, shardID, start, end, limit, pageToken, opts)
 }
 
+// FetchExemplars mocks base method.
+func (m *MockdatabaseNamespace) FetchExemplars(ctx context.Context, query index.Query, start, end time0.UnixNano, limit int) ([]SeriesExemplars, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", ctx, query, start, end, limit)
+	ret0, _ := ret[0].([]SeriesExemplars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockdatabaseNamespaceMockRecorder) FetchExemplars(ctx, query, start, end, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*MockdatabaseNamespace)(nil).FetchExemplars), ctx, query, start, end, limit)
+}
+
 // FlushIndex mocks base method.
 func (m *MockdatabaseNamespace) FlushIndex(flush persist.IndexFlush) error {
 	m.ctrl.T.Helper()
@@ -1423,6 +1573,20 @@ func (mr *MockdatabaseNamespaceMockRecorder) NumSeries() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumSeries", reflect.TypeOf((*MockdatabaseNamespace)(nil).NumSeries))
 }
 
+// OffloadBlocks mocks base method.
+func (m *MockdatabaseNamespace) OffloadBlocks(now time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadBlocks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffloadBlocks indicates an expected call of OffloadBlocks.
+func (mr *MockdatabaseNamespaceMockRecorder) OffloadBlocks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlocks", reflect.TypeOf((*MockdatabaseNamespace)(nil).OffloadBlocks), now)
+}
+
 // Options mocks base method.
 func (m *MockdatabaseNamespace) Options() namespace.Options {
 	m.ctrl.T.Helper()
@@ -1526,6 +1690,34 @@ func (mr *MockdatabaseNamespaceMockRecorder) ReadableShardAt(shardID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadableShardAt", reflect.TypeOf((*MockdatabaseNamespace)(nil).ReadableShardAt), shardID)
 }
 
+// RemoveCompactedTombstones mocks base method.
+func (m *MockdatabaseNamespace) RemoveCompactedTombstones(snapshotStart time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCompactedTombstones", snapshotStart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCompactedTombstones indicates an expected call of RemoveCompactedTombstones.
+func (mr *MockdatabaseNamespaceMockRecorder) RemoveCompactedTombstones(snapshotStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCompactedTombstones", reflect.TypeOf((*MockdatabaseNamespace)(nil).RemoveCompactedTombstones), snapshotStart)
+}
+
+// RemoveSplitSeries mocks base method.
+func (m *MockdatabaseNamespace) RemoveSplitSeries() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSplitSeries")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSplitSeries indicates an expected call of RemoveSplitSeries.
+func (mr *MockdatabaseNamespaceMockRecorder) RemoveSplitSeries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSplitSeries", reflect.TypeOf((*MockdatabaseNamespace)(nil).RemoveSplitSeries))
+}
+
 // Repair mocks base method.
 func (m *MockdatabaseNamespace) Repair(repairer databaseShardRepairer, tr time0.Range, opts NamespaceRepairOptions) error {
 	m.ctrl.T.Helper()
@@ -1610,6 +1802,20 @@ func (mr *MockdatabaseNamespaceMockRecorder) ShardBootstrapState() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardBootstrapState", reflect.TypeOf((*MockdatabaseNamespace)(nil).ShardBootstrapState))
 }
 
+// ShardSplitComplete mocks base method.
+func (m *MockdatabaseNamespace) ShardSplitComplete(shardID uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardSplitComplete", shardID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShardSplitComplete indicates an expected call of ShardSplitComplete.
+func (mr *MockdatabaseNamespaceMockRecorder) ShardSplitComplete(shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardSplitComplete", reflect.TypeOf((*MockdatabaseNamespace)(nil).ShardSplitComplete), shardID)
+}
+
 // Shards mocks base method.
 func (m *MockdatabaseNamespace) Shards() []Shard {
 	m.ctrl.T.Helper()
@@ -1971,6 +2177,20 @@ func (mr *MockdatabaseShardMockRecorder) CleanupExpiredFileSets(earliestToRetain
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredFileSets", reflect.TypeOf((*MockdatabaseShard)(nil).CleanupExpiredFileSets), earliestToRetain)
 }
 
+// CleanupExpiredSeries mocks base method.
+func (m *MockdatabaseShard) CleanupExpiredSeries(now time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupExpiredSeries", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanupExpiredSeries indicates an expected call of CleanupExpiredSeries.
+func (mr *MockdatabaseShardMockRecorder) CleanupExpiredSeries(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredSeries", reflect.TypeOf((*MockdatabaseShard)(nil).CleanupExpiredSeries), now)
+}
+
 // Close mocks base method.
 func (m *MockdatabaseShard) Close() error {
 	m.ctrl.T.Helper()
@@ -2014,6 +2234,48 @@ func (mr *MockdatabaseShardMockRecorder) ColdFlush(flush, resources, nsCtx, onFl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).ColdFlush), flush, resources, nsCtx, onFlush)
 }
 
+// CompactBlocks mocks base method.
+func (m *MockdatabaseShard) CompactBlocks(now time0.UnixNano, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactBlocks", now, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompactBlocks indicates an expected call of CompactBlocks.
+func (mr *MockdatabaseShardMockRecorder) CompactBlocks(now, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlocks", reflect.TypeOf((*MockdatabaseShard)(nil).CompactBlocks), now, nsCtx)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockdatabaseShard) DeleteSeries(ids []ident.ID, r time0.Range, removedAt time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ids, r, removedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseShardMockRecorder) DeleteSeries(ids, r, removedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseShard)(nil).DeleteSeries), ids, r, removedAt)
+}
+
+// DeletedSeriesInRange mocks base method.
+func (m *MockdatabaseShard) DeletedSeriesInRange(start, end time0.UnixNano) []ident.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedSeriesInRange", start, end)
+	ret0, _ := ret[0].([]ident.ID)
+	return ret0
+}
+
+// DeletedSeriesInRange indicates an expected call of DeletedSeriesInRange.
+func (mr *MockdatabaseShardMockRecorder) DeletedSeriesInRange(start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedSeriesInRange", reflect.TypeOf((*MockdatabaseShard)(nil).DeletedSeriesInRange), start, end)
+}
+
 // DocRef mocks base method.
 func (m *MockdatabaseShard) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	m.ctrl.T.Helper()
@@ -2076,6 +2338,20 @@ func (mr *MockdatabaseShardMockRecorder) FetchBlocksMetadataV2(ctx, start, end,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataV2", reflect.TypeOf((*MockdatabaseShard)(nil).FetchBlocksMetadataV2), ctx, start, end, limit, pageToken, opts)
 }
 
+// FetchExemplars mocks base method.
+func (m *MockdatabaseShard) FetchExemplars(id ident.ID, start, end time0.UnixNano) []ts.Exemplar {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExemplars", id, start, end)
+	ret0, _ := ret[0].([]ts.Exemplar)
+	return ret0
+}
+
+// FetchExemplars indicates an expected call of FetchExemplars.
+func (mr *MockdatabaseShardMockRecorder) FetchExemplars(id, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExemplars", reflect.TypeOf((*MockdatabaseShard)(nil).FetchExemplars), id, start, end)
+}
+
 // FilterBlocksNeedSnapshot mocks base method.
 func (m *MockdatabaseShard) FilterBlocksNeedSnapshot(blockStarts []time0.UnixNano) []time0.UnixNano {
 	m.ctrl.T.Helper()
@@ -2105,6 +2381,20 @@ func (mr *MockdatabaseShardMockRecorder) FlushState(blockStart interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushState", reflect.TypeOf((*MockdatabaseShard)(nil).FlushState), blockStart)
 }
 
+// HasDeletedSeries mocks base method.
+func (m *MockdatabaseShard) HasDeletedSeries() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeletedSeries")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasDeletedSeries indicates an expected call of HasDeletedSeries.
+func (mr *MockdatabaseShardMockRecorder) HasDeletedSeries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeletedSeries", reflect.TypeOf((*MockdatabaseShard)(nil).HasDeletedSeries))
+}
+
 // ID mocks base method.
 func (m *MockdatabaseShard) ID() uint32 {
 	m.ctrl.T.Helper()
@@ -2188,6 +2478,20 @@ func (mr *MockdatabaseShardMockRecorder) NumSeries() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumSeries", reflect.TypeOf((*MockdatabaseShard)(nil).NumSeries))
 }
 
+// OffloadBlocks mocks base method.
+func (m *MockdatabaseShard) OffloadBlocks(now time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadBlocks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffloadBlocks indicates an expected call of OffloadBlocks.
+func (mr *MockdatabaseShardMockRecorder) OffloadBlocks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlocks", reflect.TypeOf((*MockdatabaseShard)(nil).OffloadBlocks), now)
+}
+
 // OnEvictedFromWiredList mocks base method.
 func (m *MockdatabaseShard) OnEvictedFromWiredList(id ident.ID, blockStart time0.UnixNano) {
 	m.ctrl.T.Helper()
@@ -2244,6 +2548,48 @@ func (mr *MockdatabaseShardMockRecorder) ReadEncoded(ctx, id, start, end, nsCtx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEncoded", reflect.TypeOf((*MockdatabaseShard)(nil).ReadEncoded), ctx, id, start, end, nsCtx)
 }
 
+// RemoveCompactedTombstones mocks base method.
+func (m *MockdatabaseShard) RemoveCompactedTombstones(snapshotStart time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCompactedTombstones", snapshotStart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCompactedTombstones indicates an expected call of RemoveCompactedTombstones.
+func (mr *MockdatabaseShardMockRecorder) RemoveCompactedTombstones(snapshotStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCompactedTombstones", reflect.TypeOf((*MockdatabaseShard)(nil).RemoveCompactedTombstones), snapshotStart)
+}
+
+// RemoveSplitSeries mocks base method.
+func (m *MockdatabaseShard) RemoveSplitSeries(hashFn sharding.HashFn, nsCtx namespace.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSplitSeries", hashFn, nsCtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSplitSeries indicates an expected call of RemoveSplitSeries.
+func (mr *MockdatabaseShardMockRecorder) RemoveSplitSeries(hashFn, nsCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSplitSeries", reflect.TypeOf((*MockdatabaseShard)(nil).RemoveSplitSeries), hashFn, nsCtx)
+}
+
+// RemovedSeries mocks base method.
+func (m *MockdatabaseShard) RemovedSeries() map[time0.UnixNano][]ident.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovedSeries")
+	ret0, _ := ret[0].(map[time0.UnixNano][]ident.ID)
+	return ret0
+}
+
+// RemovedSeries indicates an expected call of RemovedSeries.
+func (mr *MockdatabaseShardMockRecorder) RemovedSeries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovedSeries", reflect.TypeOf((*MockdatabaseShard)(nil).RemovedSeries))
+}
+
 // Repair mocks base method.
 func (m *MockdatabaseShard) Repair(ctx context.Context, nsCtx namespace.Context, nsMeta namespace.Metadata, tr time0.Range, repairer databaseShardRepairer) (repair.MetadataComparisonResult, error) {
 	m.ctrl.T.Helper()
@@ -2259,6 +2605,34 @@ func (mr *MockdatabaseShardMockRecorder) Repair(ctx, nsCtx, nsMeta, tr, repairer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockdatabaseShard)(nil).Repair), ctx, nsCtx, nsMeta, tr, repairer)
 }
 
+// RestoreBlocks mocks base method.
+func (m *MockdatabaseShard) RestoreBlocks(fileSetStart time0.UnixNano, fileSetBlockSize time.Duration, fetched result.ShardResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBlocks", fileSetStart, fileSetBlockSize, fetched)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreBlocks indicates an expected call of RestoreBlocks.
+func (mr *MockdatabaseShardMockRecorder) RestoreBlocks(fileSetStart, fileSetBlockSize, fetched interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBlocks", reflect.TypeOf((*MockdatabaseShard)(nil).RestoreBlocks), fileSetStart, fileSetBlockSize, fetched)
+}
+
+// SeriesDeletedInRange mocks base method.
+func (m *MockdatabaseShard) SeriesDeletedInRange(id ident.ID, start, end time0.UnixNano) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesDeletedInRange", id, start, end)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SeriesDeletedInRange indicates an expected call of SeriesDeletedInRange.
+func (mr *MockdatabaseShardMockRecorder) SeriesDeletedInRange(id, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesDeletedInRange", reflect.TypeOf((*MockdatabaseShard)(nil).SeriesDeletedInRange), id, start, end)
+}
+
 // SeriesRefResolver mocks base method.
 func (m *MockdatabaseShard) SeriesRefResolver(id ident.ID, tags ident.TagIterator) (bootstrap.SeriesRefResolver, error) {
 	m.ctrl.T.Helper()
@@ -2632,6 +3006,34 @@ func (mr *MockNamespaceIndexMockRecorder) Query(ctx, query, opts interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockNamespaceIndex)(nil).Query), ctx, query, opts)
 }
 
+// RebuildBlock mocks base method.
+func (m *MockNamespaceIndex) RebuildBlock(flush persist.IndexFlush, blockStart time0.UnixNano, shards []databaseShard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildBlock", flush, blockStart, shards)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildBlock indicates an expected call of RebuildBlock.
+func (mr *MockNamespaceIndexMockRecorder) RebuildBlock(flush, blockStart, shards interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildBlock", reflect.TypeOf((*MockNamespaceIndex)(nil).RebuildBlock), flush, blockStart, shards)
+}
+
+// RemoveSeries mocks base method.
+func (m *MockNamespaceIndex) RemoveSeries(ids []ident.ID, removedAt time0.UnixNano) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSeries", ids, removedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSeries indicates an expected call of RemoveSeries.
+func (mr *MockNamespaceIndexMockRecorder) RemoveSeries(ids, removedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSeries", reflect.TypeOf((*MockNamespaceIndex)(nil).RemoveSeries), ids, removedAt)
+}
+
 // Tick mocks base method.
 func (m *MockNamespaceIndex) Tick(c context.Cancellable, startTime time0.UnixNano) (namespaceIndexTickResult, error) {
 	m.ctrl.T.Helper()
@@ -3902,6 +4304,48 @@ func (mr *MockOptionsMockRecorder) BackgroundProcessFns() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackgroundProcessFns", reflect.TypeOf((*MockOptions)(nil).BackgroundProcessFns))
 }
 
+// BackupMetadataFn mocks base method.
+func (m *MockOptions) BackupMetadataFn() BackupMetadataFn {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupMetadataFn")
+	ret0, _ := ret[0].(BackupMetadataFn)
+	return ret0
+}
+
+// BackupMetadataFn indicates an expected call of BackupMetadataFn.
+func (mr *MockOptionsMockRecorder) BackupMetadataFn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupMetadataFn", reflect.TypeOf((*MockOptions)(nil).BackupMetadataFn))
+}
+
+// BackupStore mocks base method.
+func (m *MockOptions) BackupStore() objectstore.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupStore")
+	ret0, _ := ret[0].(objectstore.Store)
+	return ret0
+}
+
+// BackupStore indicates an expected call of BackupStore.
+func (mr *MockOptionsMockRecorder) BackupStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupStore", reflect.TypeOf((*MockOptions)(nil).BackupStore))
+}
+
+// BlockCompactionTiers mocks base method.
+func (m *MockOptions) BlockCompactionTiers() []BlockCompactionTier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockCompactionTiers")
+	ret0, _ := ret[0].([]BlockCompactionTier)
+	return ret0
+}
+
+// BlockCompactionTiers indicates an expected call of BlockCompactionTiers.
+func (mr *MockOptionsMockRecorder) BlockCompactionTiers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockCompactionTiers", reflect.TypeOf((*MockOptions)(nil).BlockCompactionTiers))
+}
+
 // BlockLeaseManager mocks base method.
 func (m *MockOptions) BlockLeaseManager() block.LeaseManager {
 	m.ctrl.T.Helper()
@@ -4168,6 +4612,34 @@ func (mr *MockOptionsMockRecorder) FetchBlocksMetadataResultsPool() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataResultsPool", reflect.TypeOf((*MockOptions)(nil).FetchBlocksMetadataResultsPool))
 }
 
+// FileSetOffloadAge mocks base method.
+func (m *MockOptions) FileSetOffloadAge() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSetOffloadAge")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// FileSetOffloadAge indicates an expected call of FileSetOffloadAge.
+func (mr *MockOptionsMockRecorder) FileSetOffloadAge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSetOffloadAge", reflect.TypeOf((*MockOptions)(nil).FileSetOffloadAge))
+}
+
+// FileSetScrubOptions mocks base method.
+func (m *MockOptions) FileSetScrubOptions() FileSetScrubOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileSetScrubOptions")
+	ret0, _ := ret[0].(FileSetScrubOptions)
+	return ret0
+}
+
+// FileSetScrubOptions indicates an expected call of FileSetScrubOptions.
+func (mr *MockOptionsMockRecorder) FileSetScrubOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSetScrubOptions", reflect.TypeOf((*MockOptions)(nil).FileSetScrubOptions))
+}
+
 // ForceColdWritesEnabled mocks base method.
 func (m *MockOptions) ForceColdWritesEnabled() bool {
 	m.ctrl.T.Helper()
@@ -4266,6 +4738,34 @@ func (mr *MockOptionsMockRecorder) LimitsOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitsOptions", reflect.TypeOf((*MockOptions)(nil).LimitsOptions))
 }
 
+// MaxExemplarsPerShard mocks base method.
+func (m *MockOptions) MaxExemplarsPerShard() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxExemplarsPerShard")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxExemplarsPerShard indicates an expected call of MaxExemplarsPerShard.
+func (mr *MockOptionsMockRecorder) MaxExemplarsPerShard() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxExemplarsPerShard", reflect.TypeOf((*MockOptions)(nil).MaxExemplarsPerShard))
+}
+
+// MaxTombstonesPerShard mocks base method.
+func (m *MockOptions) MaxTombstonesPerShard() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxTombstonesPerShard")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxTombstonesPerShard indicates an expected call of MaxTombstonesPerShard.
+func (mr *MockOptionsMockRecorder) MaxTombstonesPerShard() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxTombstonesPerShard", reflect.TypeOf((*MockOptions)(nil).MaxTombstonesPerShard))
+}
+
 // MediatorTickInterval mocks base method.
 func (m *MockOptions) MediatorTickInterval() time.Duration {
 	m.ctrl.T.Helper()
@@ -4560,6 +5060,48 @@ func (mr *MockOptionsMockRecorder) SetBackgroundProcessFns(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackgroundProcessFns", reflect.TypeOf((*MockOptions)(nil).SetBackgroundProcessFns), arg0)
 }
 
+// SetBackupMetadataFn mocks base method.
+func (m *MockOptions) SetBackupMetadataFn(value BackupMetadataFn) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackupMetadataFn", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackupMetadataFn indicates an expected call of SetBackupMetadataFn.
+func (mr *MockOptionsMockRecorder) SetBackupMetadataFn(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackupMetadataFn", reflect.TypeOf((*MockOptions)(nil).SetBackupMetadataFn), value)
+}
+
+// SetBackupStore mocks base method.
+func (m *MockOptions) SetBackupStore(value objectstore.Store) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBackupStore", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBackupStore indicates an expected call of SetBackupStore.
+func (mr *MockOptionsMockRecorder) SetBackupStore(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackupStore", reflect.TypeOf((*MockOptions)(nil).SetBackupStore), value)
+}
+
+// SetBlockCompactionTiers mocks base method.
+func (m *MockOptions) SetBlockCompactionTiers(value []BlockCompactionTier) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockCompactionTiers", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetBlockCompactionTiers indicates an expected call of SetBlockCompactionTiers.
+func (mr *MockOptionsMockRecorder) SetBlockCompactionTiers(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockCompactionTiers", reflect.TypeOf((*MockOptions)(nil).SetBlockCompactionTiers), value)
+}
+
 // SetBlockLeaseManager mocks base method.
 func (m *MockOptions) SetBlockLeaseManager(leaseMgr block.LeaseManager) Options {
 	m.ctrl.T.Helper()
@@ -4840,6 +5382,34 @@ func (mr *MockOptionsMockRecorder) SetFetchBlocksMetadataResultsPool(value inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBlocksMetadataResultsPool", reflect.TypeOf((*MockOptions)(nil).SetFetchBlocksMetadataResultsPool), value)
 }
 
+// SetFileSetOffloadAge mocks base method.
+func (m *MockOptions) SetFileSetOffloadAge(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFileSetOffloadAge", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFileSetOffloadAge indicates an expected call of SetFileSetOffloadAge.
+func (mr *MockOptionsMockRecorder) SetFileSetOffloadAge(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileSetOffloadAge", reflect.TypeOf((*MockOptions)(nil).SetFileSetOffloadAge), value)
+}
+
+// SetFileSetScrubOptions mocks base method.
+func (m *MockOptions) SetFileSetScrubOptions(value FileSetScrubOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFileSetScrubOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFileSetScrubOptions indicates an expected call of SetFileSetScrubOptions.
+func (mr *MockOptionsMockRecorder) SetFileSetScrubOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileSetScrubOptions", reflect.TypeOf((*MockOptions)(nil).SetFileSetScrubOptions), value)
+}
+
 // SetForceColdWritesEnabled mocks base method.
 func (m *MockOptions) SetForceColdWritesEnabled(value bool) Options {
 	m.ctrl.T.Helper()
@@ -4938,6 +5508,34 @@ func (mr *MockOptionsMockRecorder) SetLimitsOptions(value interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimitsOptions", reflect.TypeOf((*MockOptions)(nil).SetLimitsOptions), value)
 }
 
+// SetMaxExemplarsPerShard mocks base method.
+func (m *MockOptions) SetMaxExemplarsPerShard(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxExemplarsPerShard", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMaxExemplarsPerShard indicates an expected call of SetMaxExemplarsPerShard.
+func (mr *MockOptionsMockRecorder) SetMaxExemplarsPerShard(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxExemplarsPerShard", reflect.TypeOf((*MockOptions)(nil).SetMaxExemplarsPerShard), value)
+}
+
+// SetMaxTombstonesPerShard mocks base method.
+func (m *MockOptions) SetMaxTombstonesPerShard(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxTombstonesPerShard", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMaxTombstonesPerShard indicates an expected call of SetMaxTombstonesPerShard.
+func (mr *MockOptionsMockRecorder) SetMaxTombstonesPerShard(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxTombstonesPerShard", reflect.TypeOf((*MockOptions)(nil).SetMaxTombstonesPerShard), value)
+}
+
 // SetMediatorTickInterval mocks base method.
 func (m *MockOptions) SetMediatorTickInterval(value time.Duration) Options {
 	m.ctrl.T.Helper()
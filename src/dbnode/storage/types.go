@@ -54,6 +54,7 @@ import (
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	xsync "github.com/m3db/m3/src/x/sync"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -218,6 +219,27 @@ type Database interface {
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteSeries deletes the data within [start, end) of all series
+	// matching the query in the given namespace, returning the number of
+	// series deleted.
+	DeleteSeries(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
+	// FetchExemplars returns the exemplars within [start, end] of up to limit
+	// series matching the query in the given namespace, a limit of zero
+	// means no limit.
+	FetchExemplars(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end xtime.UnixNano,
+		limit int,
+	) ([]SeriesExemplars, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -227,6 +249,10 @@ type Database interface {
 
 	// AggregateTiles does large tile aggregation from source namespace to target namespace.
 	AggregateTiles(ctx context.Context, sourceNsID, targetNsID ident.ID, opts AggregateTilesOptions) (int64, error)
+
+	// Backup takes a consistent copy of the filesets of a namespace and
+	// uploads it to the backup store.
+	Backup(namespace ident.ID, opts BackupOptions) (BackupResult, error)
 }
 
 // database is the internal database interface.
@@ -260,6 +286,11 @@ type Namespace interface {
 	// Shards returns the shard description.
 	Shards() []Shard
 
+	// ShardSplitComplete returns whether a shard being split off from a parent
+	// shard holds all of its data and can stop redirecting to the parent shard,
+	// it returns true for shards that are not being split.
+	ShardSplitComplete(shardID uint32) bool
+
 	// ReadableShardAt returns a readable (bootstrapped) shard by id.
 	ReadableShardAt(shardID uint32) (databaseShard, namespace.Context, error)
 
@@ -392,9 +423,26 @@ type databaseNamespace interface {
 	// ColdFlush flushes unflushed in-memory ColdWrites.
 	ColdFlush(flush persist.FlushPreparer) error
 
+	// RemoveSplitSeries removes the series of the shards split off from a
+	// parent shard from the parent shard once the split completes.
+	RemoveSplitSeries() error
+
+	// CompactBlocks compacts flushed blocks older than the configured block
+	// compaction tiers into filesets with the larger block sizes of the tiers.
+	CompactBlocks(now xtime.UnixNano) error
+
+	// OffloadBlocks offloads the filesets of flushed blocks older than the
+	// configured fileset offload age to the object store.
+	OffloadBlocks(now xtime.UnixNano) error
+
 	// Snapshot snapshots unflushed in-memory warm and cold writes.
 	Snapshot(blockStarts []xtime.UnixNano, snapshotTime xtime.UnixNano, flush persist.SnapshotPreparer) error
 
+	// RemoveCompactedTombstones drops the tombstones of the data removed from
+	// the filesets rewritten before a snapshot that started at snapshotStart
+	// and has succeeded.
+	RemoveCompactedTombstones(snapshotStart xtime.UnixNano) error
+
 	// NeedsFlush returns true if the namespace needs a flush for the
 	// period: [start, end] (both inclusive).
 	// NB: The start/end times are assumed to be aligned to block size boundary.
@@ -403,6 +451,23 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteSeries deletes the data within [start, end) of all series
+	// matching the query, returning the number of series deleted.
+	DeleteSeries(
+		ctx context.Context,
+		query index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
+	// FetchExemplars returns the exemplars within [start, end] of up to limit
+	// series matching the query, a limit of zero means no limit.
+	FetchExemplars(
+		ctx context.Context,
+		query index.Query,
+		start, end xtime.UnixNano,
+		limit int,
+	) ([]SeriesExemplars, error)
+
 	// Repair repairs the namespace data for a given time range.
 	Repair(repairer databaseShardRepairer, tr xtime.Range, opts NamespaceRepairOptions) error
 
@@ -540,6 +605,36 @@ type databaseShard interface {
 		opts block.FetchBlocksMetadataOptions,
 	) (block.FetchBlocksMetadataResults, PageToken, error)
 
+	// DeleteSeries tombstones the data of the series within the range and
+	// drops their in-memory data within it. A non-zero removedAt records
+	// that all of the data of the series was deleted at that time.
+	DeleteSeries(ids []ident.ID, r xtime.Range, removedAt xtime.UnixNano) error
+
+	// FetchExemplars returns the exemplars of a series retained by the shard
+	// with timestamps within [start, end].
+	FetchExemplars(id ident.ID, start, end xtime.UnixNano) []ts.Exemplar
+
+	// HasDeletedSeries returns true if any series in the shard has
+	// tombstoned data.
+	HasDeletedSeries() bool
+
+	// SeriesDeletedInRange returns true if all of the data of the series
+	// within [start, end) is deleted.
+	SeriesDeletedInRange(id ident.ID, start, end xtime.UnixNano) bool
+
+	// DeletedSeriesInRange returns the IDs of the series whose data within
+	// [start, end) is deleted and whose documents may still be indexed.
+	DeletedSeriesInRange(start, end xtime.UnixNano) []ident.ID
+
+	// RemovedSeries returns the IDs of the series all of whose data was
+	// deleted by the time they were removed at.
+	RemovedSeries() map[xtime.UnixNano][]ident.ID
+
+	// RemoveCompactedTombstones drops the tombstones of the data removed from
+	// the filesets rewritten before a snapshot that started at snapshotStart
+	// and has succeeded.
+	RemoveCompactedTombstones(snapshotStart xtime.UnixNano) error
+
 	// PrepareBootstrap prepares the shard for bootstrapping by ensuring
 	// it knows which flushed files reside on disk.
 	PrepareBootstrap(ctx context.Context) error
@@ -590,6 +685,28 @@ type databaseShard interface {
 	// FlushState returns the flush state for this shard at block start.
 	FlushState(blockStart xtime.UnixNano) (fileOpState, error)
 
+	// RemoveSplitSeries removes the series that belong to other shards than
+	// the shard with the given hash function from memory and from the flushed
+	// filesets of the shard.
+	RemoveSplitSeries(hashFn sharding.HashFn, nsCtx namespace.Context) error
+
+	// CompactBlocks compacts the flushed filesets of blocks older than the
+	// configured block compaction tiers into filesets with larger block sizes.
+	CompactBlocks(now xtime.UnixNano, nsCtx namespace.Context) error
+
+	// OffloadBlocks offloads the filesets of flushed blocks older than the
+	// configured fileset offload age to the object store.
+	OffloadBlocks(now xtime.UnixNano) error
+
+	// RestoreBlocks writes the blocks of the series fetched from peers to
+	// new fileset volumes, replacing the quarantined fileset the blocks were
+	// read from.
+	RestoreBlocks(
+		fileSetStart xtime.UnixNano,
+		fileSetBlockSize time.Duration,
+		fetched result.ShardResult,
+	) error
+
 	// CleanupExpiredFileSets removes expired fileset files.
 	CleanupExpiredFileSets(earliestToRetain xtime.UnixNano) error
 
@@ -598,6 +715,10 @@ type databaseShard interface {
 	// fileset for that block.
 	CleanupCompactedFileSets() error
 
+	// CleanupExpiredSeries removes the series whose retention override
+	// expired from the flushed filesets of the shard.
+	CleanupExpiredSeries(now xtime.UnixNano) error
+
 	// Repair repairs the shard data for a given time.
 	Repair(
 		ctx context.Context,
@@ -688,6 +809,10 @@ type NamespaceIndex interface {
 		bootstrapResults result.IndexResults,
 	) error
 
+	// RemoveSeries removes the documents of the series from the flushed and
+	// bootstrapped segments of the blocks that end before they were removed.
+	RemoveSeries(ids []ident.ID, removedAt xtime.UnixNano) error
+
 	// Bootstrapped is true if the bootstrap has completed.
 	Bootstrapped() bool
 
@@ -715,6 +840,14 @@ type NamespaceIndex interface {
 	// WarmFlushBlockStarts returns all index blockStarts which have been flushed to disk.
 	WarmFlushBlockStarts() []xtime.UnixNano
 
+	// RebuildBlock writes a new index volume for the block start from the
+	// data filesets of the owned shards of the database.
+	RebuildBlock(
+		flush persist.IndexFlush,
+		blockStart xtime.UnixNano,
+		shards []databaseShard,
+	) error
+
 	// ColdFlush performs any cold flushes that the index has outstanding using
 	// the owned shards of the database. Also returns a callback to be called when
 	// cold flushing completes to perform houskeeping.
@@ -995,6 +1128,34 @@ type ColdFlushNsOpts interface {
 	ReuseResources() bool
 }
 
+// BlockCompactionTier describes a tier of flushed blocks that are compacted
+// into filesets with a larger block size once they are older than the age.
+type BlockCompactionTier struct {
+	// Age is the age after which the blocks are compacted, measured from the
+	// end of the compacted block.
+	Age time.Duration
+	// BlockSize is the block size of the compacted filesets, which must be
+	// a multiple of the block size of the namespaces.
+	BlockSize time.Duration
+}
+
+// FileSetScrubOptions are the options of the background scrubber, which
+// periodically verifies the flushed filesets against their digests.
+type FileSetScrubOptions struct {
+	// Enabled enables the scrubber.
+	Enabled bool
+	// Interval is the interval between two scrubs of all the filesets.
+	Interval time.Duration
+	// ThroughputLimitMbps limits the rate filesets are read at, zero
+	// disables the limit.
+	ThroughputLimitMbps float64
+	// AdminClient fetches the blocks of quarantined filesets from peers,
+	// the blocks are not restored if it is not set.
+	AdminClient client.AdminClient
+	// ResultOptions are the options of the blocks fetched from peers.
+	ResultOptions result.Options
+}
+
 // OnColdFlush can perform work each time a series is flushed.
 type OnColdFlush interface {
 	ColdFlushNamespace(ns Namespace, opts ColdFlushNsOpts) (OnColdFlushNamespace, error)
@@ -1265,6 +1426,62 @@ type Options interface {
 	// ForceColdWritesEnabled returns options for forcing cold writes.
 	ForceColdWritesEnabled() bool
 
+	// SetMaxExemplarsPerShard sets the maximum number of exemplars retained
+	// per shard, zero disables exemplar storage.
+	SetMaxExemplarsPerShard(value int) Options
+
+	// MaxExemplarsPerShard returns the maximum number of exemplars retained
+	// per shard, zero disables exemplar storage.
+	MaxExemplarsPerShard() int
+
+	// SetMaxTombstonesPerShard sets the maximum number of deleted ranges of
+	// series held by a shard, deletes that would exceed it fail. Zero
+	// disables the limit.
+	SetMaxTombstonesPerShard(value int) Options
+
+	// MaxTombstonesPerShard returns the maximum number of deleted ranges of
+	// series held by a shard, deletes that would exceed it fail. Zero
+	// disables the limit.
+	MaxTombstonesPerShard() int
+
+	// SetBlockCompactionTiers sets the tiers used to compact older flushed
+	// blocks into filesets with larger block sizes.
+	SetBlockCompactionTiers(value []BlockCompactionTier) Options
+
+	// BlockCompactionTiers returns the tiers used to compact older flushed
+	// blocks into filesets with larger block sizes.
+	BlockCompactionTiers() []BlockCompactionTier
+
+	// SetFileSetOffloadAge sets the age after which the filesets of flushed
+	// blocks are offloaded to the object store, zero disables offloading.
+	SetFileSetOffloadAge(value time.Duration) Options
+
+	// SetFileSetScrubOptions sets the options of the background fileset
+	// scrubber.
+	SetFileSetScrubOptions(value FileSetScrubOptions) Options
+
+	// FileSetScrubOptions returns the options of the background fileset
+	// scrubber.
+	FileSetScrubOptions() FileSetScrubOptions
+
+	// FileSetOffloadAge returns the age after which the filesets of flushed
+	// blocks are offloaded to the object store, zero disables offloading.
+	FileSetOffloadAge() time.Duration
+
+	// SetBackupStore sets the object store namespaces are backed up to.
+	SetBackupStore(value objectstore.Store) Options
+
+	// BackupStore returns the object store namespaces are backed up to.
+	BackupStore() objectstore.Store
+
+	// SetBackupMetadataFn sets the function returning the metadata that is
+	// included in backups.
+	SetBackupMetadataFn(value BackupMetadataFn) Options
+
+	// BackupMetadataFn returns the function returning the metadata that is
+	// included in backups.
+	BackupMetadataFn() BackupMetadataFn
+
 	// SetSourceLoggerBuilder sets the limit source logger builder.
 	SetSourceLoggerBuilder(value limits.SourceLoggerBuilder) Options
 
@@ -1458,6 +1675,17 @@ type AggregateTilesOptions struct {
 	MetricTypeByName map[string]annotation.Payload
 }
 
+// SeriesExemplars are the exemplars retained for a series.
+type SeriesExemplars struct {
+	// ID is the series ID.
+	ID ident.ID
+	// Document is the index document of the series, it is only valid for
+	// the lifetime of the context used to fetch the exemplars.
+	Document doc.Document
+	// Exemplars are the exemplars of the series ordered by timestamp.
+	Exemplars []ts.Exemplar
+}
+
 // TileAggregator is the interface for AggregateTiles.
 type TileAggregator interface {
 	// AggregateTiles does tile aggregation.
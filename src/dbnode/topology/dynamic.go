@@ -231,9 +231,13 @@ func getStaticOptions(service services.Service, hashGen sharding.HashGen) (Stati
 		return nil, err
 	}
 
+	redirects := shardRedirects(instances)
 	allShards := make([]shard.Shard, len(allShardIDs))
 	for i, id := range allShardIDs {
 		allShards[i] = shard.NewShard(id).SetState(shard.Available)
+		if redirectTo, ok := redirects[id]; ok {
+			allShards[i] = allShards[i].SetRedirectToShardID(&redirectTo)
+		}
 	}
 
 	fn := hashGen(numShards)
@@ -257,6 +261,30 @@ func getStaticOptions(service services.Service, hashGen sharding.HashGen) (Stati
 		SetHostShardSets(hostShardSets), nil
 }
 
+// shardRedirects returns the shards that are being split off from a parent
+// shard. A shard keeps redirecting to its parent until no replica redirects
+// it anymore, so that clients only route to a split shard once it is
+// available on every replica.
+func shardRedirects(instances []services.ServiceInstance) map[uint32]uint32 {
+	var redirects map[uint32]uint32
+	for _, i := range instances {
+		if i.Shards() == nil {
+			continue
+		}
+		for _, s := range i.Shards().All() {
+			redirectTo := s.RedirectToShardID()
+			if redirectTo == nil {
+				continue
+			}
+			if redirects == nil {
+				redirects = make(map[uint32]uint32)
+			}
+			redirects[s.ID()] = *redirectTo
+		}
+	}
+	return redirects
+}
+
 func validateInstances(
 	instances []services.ServiceInstance,
 	replicas, numShards int,
@@ -174,6 +174,37 @@ func TestGetUniqueShardsAndReplicas(t *testing.T) {
 	assert.Equal(t, errMissingShard, err)
 }
 
+func TestGetStaticOptionsShardRedirects(t *testing.T) {
+	parent := uint32(0)
+	instances := []services.ServiceInstance{
+		services.NewServiceInstance().SetShards(shard.NewShards([]shard.Shard{
+			shard.NewShard(0).SetState(shard.Available),
+			shard.NewShard(1).SetState(shard.Initializing).SetRedirectToShardID(&parent),
+		})).SetInstanceID("h1").SetEndpoint("h1:9000"),
+		services.NewServiceInstance().SetShards(shard.NewShards([]shard.Shard{
+			shard.NewShard(0).SetState(shard.Available),
+			shard.NewShard(1).SetState(shard.Available),
+		})).SetInstanceID("h2").SetEndpoint("h2:9000"),
+	}
+	service := services.NewService().
+		SetReplication(services.NewServiceReplication().SetReplicas(2)).
+		SetSharding(services.NewServiceSharding().SetNumShards(2)).
+		SetInstances(instances)
+
+	opts, err := getStaticOptions(service, sharding.DefaultHashFn)
+	require.NoError(t, err)
+
+	// Shard 1 keeps redirecting to its parent until every replica has it available.
+	s, err := opts.ShardSet().LookupShard(1)
+	require.NoError(t, err)
+	require.NotNil(t, s.RedirectToShardID())
+	require.Equal(t, parent, *s.RedirectToShardID())
+
+	s, err = opts.ShardSet().LookupShard(0)
+	require.NoError(t, err)
+	require.Nil(t, s.RedirectToShardID())
+}
+
 func TestDynamicInitializerFailsToInitialize(t *testing.T) {
 	tests := []struct {
 		name string
@@ -65,3 +65,13 @@ const OptimizedAnnotationLen = 16
 
 // Annotation represents information used to annotate datapoints.
 type Annotation []byte
+
+// Exemplar is a sample attached to a datapoint that references an external
+// source of information, typically a trace ID.
+type Exemplar struct {
+	TimestampNanos xtime.UnixNano
+	Value          float64
+	// EncodedLabels are the exemplar labels, encoded the same way as
+	// series tags.
+	EncodedLabels EncodedTags
+}
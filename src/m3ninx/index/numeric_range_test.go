@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericRangeContains(t *testing.T) {
+	r := NumericRange{Min: 200, Max: 300, MinInclusive: true}
+	require.True(t, r.Contains([]byte("200")))
+	require.True(t, r.Contains([]byte("204")))
+	require.True(t, r.Contains([]byte("299.5")))
+	require.False(t, r.Contains([]byte("300")))
+	require.False(t, r.Contains([]byte("199")))
+	require.False(t, r.Contains([]byte("2xx")))
+	require.False(t, r.Contains([]byte("")))
+
+	r = NumericRange{Min: math.Inf(-1), Max: 0.5, MaxInclusive: true}
+	require.True(t, r.Contains([]byte("-1e9")))
+	require.True(t, r.Contains([]byte("0.5")))
+	require.False(t, r.Contains([]byte("+Inf")))
+	require.False(t, r.Contains([]byte("NaN")))
+}
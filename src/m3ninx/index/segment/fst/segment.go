@@ -36,6 +36,7 @@ import (
 	sgmt "github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst/encoding"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst/encoding/docs"
+	fstregexp "github.com/m3db/m3/src/m3ninx/index/segment/fst/regexp"
 	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/postings/pilosa"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
@@ -605,6 +606,95 @@ func (r *fsSegment) matchRegexpNotClosedMaybeFinalizedWithRLock(
 	return pl, nil
 }
 
+func (r *fsSegment) matchPrefixNotClosedMaybeFinalizedWithRLock(
+	field, prefix []byte,
+) (postings.List, error) {
+	// The prefix bounds the terms iterated to exactly the terms with the prefix.
+	return r.matchTermsNotClosedMaybeFinalizedWithRLock(field,
+		prefix, fstregexp.IncrementBytes(prefix), nil)
+}
+
+func (r *fsSegment) matchRangeNotClosedMaybeFinalizedWithRLock(
+	field []byte,
+	numericRange index.NumericRange,
+) (postings.List, error) {
+	// NB: Terms are ordered lexicographically rather than numerically so all
+	// terms of the field need to be checked against the range.
+	return r.matchTermsNotClosedMaybeFinalizedWithRLock(field,
+		nil, nil, numericRange.Contains)
+}
+
+// matchTermsNotClosedMaybeFinalizedWithRLock returns the union of the postings
+// lists of the terms of a field between start inclusive and end exclusive, a
+// nil start or end leaves that side unbounded. If filter is set only the terms
+// that it accepts are included.
+func (r *fsSegment) matchTermsNotClosedMaybeFinalizedWithRLock(
+	field, start, end []byte,
+	filter func(term []byte) bool,
+) (postings.List, error) {
+	// NB(r): Not closed, but could be finalized (i.e. closed segment reader)
+	// calling match field after this segment is finalized.
+	if r.finalized {
+		return nil, errReaderFinalized
+	}
+
+	termsFST, exists, err := r.retrieveTermsFSTWithRLock(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// i.e. we don't know anything about the field, so can early return an empty postings list
+		return r.opts.PostingsListPool().Get(), nil
+	}
+
+	var (
+		fstCloser     = x.NewSafeCloser(termsFST)
+		iter, iterErr = termsFST.Iterator(start, end)
+		iterCloser    = x.NewSafeCloser(iter)
+		pls           []postings.List
+	)
+	defer func() {
+		iterCloser.Close()
+		fstCloser.Close()
+	}()
+
+	for {
+		if iterErr == vellum.ErrIteratorDone {
+			break
+		}
+
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		term, postingsOffset := iter.Current()
+		if filter == nil || filter(term) {
+			nextPl, err := r.retrievePostingsListWithRLock(postingsOffset)
+			if err != nil {
+				return nil, err
+			}
+			pls = append(pls, nextPl)
+		}
+		iterErr = iter.Next()
+	}
+
+	pl, err := roaring.Union(pls)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iterCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := fstCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func (r *fsSegment) matchAllNotClosedMaybeFinalizedWithRLock() (postings.MutableList, error) {
 	// NB(r): Not closed, but could be finalized (i.e. closed segment reader)
 	// calling match field after this segment is finalized.
@@ -986,6 +1076,33 @@ func (sr *fsSegmentReader) MatchRegexp(
 	return pl, err
 }
 
+func (sr *fsSegmentReader) MatchPrefix(field, prefix []byte) (postings.List, error) {
+	if sr.closed {
+		return nil, errReaderClosed
+	}
+	// NB(r): We are allowed to call match field after Close called on
+	// the segment but not after it is finalized.
+	sr.fsSegment.RLock()
+	pl, err := sr.fsSegment.matchPrefixNotClosedMaybeFinalizedWithRLock(field, prefix)
+	sr.fsSegment.RUnlock()
+	return pl, err
+}
+
+func (sr *fsSegmentReader) MatchRange(
+	field []byte,
+	numericRange index.NumericRange,
+) (postings.List, error) {
+	if sr.closed {
+		return nil, errReaderClosed
+	}
+	// NB(r): We are allowed to call match field after Close called on
+	// the segment but not after it is finalized.
+	sr.fsSegment.RLock()
+	pl, err := sr.fsSegment.matchRangeNotClosedMaybeFinalizedWithRLock(field, numericRange)
+	sr.fsSegment.RUnlock()
+	return pl, err
+}
+
 func (sr *fsSegmentReader) MatchAll() (postings.List, error) {
 	if sr.closed {
 		return nil, errReaderClosed
@@ -23,6 +23,7 @@ package fst
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"testing"
@@ -455,6 +456,145 @@ func TestPostingsListRegexAll(t *testing.T) {
 	}
 }
 
+func TestPostingsListEqualForMatchPrefix(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			for _, tc := range newTestCases(t, test.docs) {
+				t.Run(tc.name, func(t *testing.T) {
+					expSeg, obsSeg := tc.expected, tc.observed
+					expReader, err := expSeg.Reader()
+					require.NoError(t, err)
+					obsReader, err := obsSeg.Reader()
+					require.NoError(t, err)
+
+					fieldsIter, err := expSeg.FieldsIterable().Fields()
+					require.NoError(t, err)
+					fields := toSlice(t, fieldsIter)
+					for _, f := range fields {
+						termsIter, err := expSeg.TermsIterable().Terms(f)
+						require.NoError(t, err)
+						terms := toTermPostings(t, termsIter)
+
+						prefixes := map[string]struct{}{"": {}}
+						for term := range terms {
+							for i := 1; i <= 2 && i <= len(term); i++ {
+								prefixes[term[:i]] = struct{}{}
+							}
+						}
+
+						for prefix := range prefixes {
+							expPl, err := expReader.MatchPrefix(f, []byte(prefix))
+							require.NoError(t, err)
+							obsPl, err := obsReader.MatchPrefix(f, []byte(prefix))
+							require.NoError(t, err)
+							require.True(t, expPl.Equal(obsPl),
+								fmt.Sprintf("%s:%s - [%v] != [%v]", string(f), prefix, pprintIter(expPl), pprintIter(obsPl)))
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestMatchPrefix(t *testing.T) {
+	for _, tc := range newTestCases(t, fewTestDocuments) {
+		t.Run(tc.name, func(t *testing.T) {
+			reader, err := tc.observed.Reader()
+			require.NoError(t, err)
+
+			pl, err := reader.MatchPrefix([]byte("fruit"), []byte("ap"))
+			require.NoError(t, err)
+			require.Equal(t, []postings.ID{1}, toPostingsIDs(t, pl))
+
+			pl, err = reader.MatchPrefix([]byte("color"), []byte("ye"))
+			require.NoError(t, err)
+			require.Equal(t, []postings.ID{0, 2}, toPostingsIDs(t, pl))
+
+			pl, err = reader.MatchPrefix([]byte("fruit"), []byte("kiwi"))
+			require.NoError(t, err)
+			require.Equal(t, 0, pl.Len())
+
+			pl, err = reader.MatchPrefix([]byte("vegetable"), []byte("ca"))
+			require.NoError(t, err)
+			require.Equal(t, 0, pl.Len())
+		})
+	}
+}
+
+func TestMatchRange(t *testing.T) {
+	var docs []doc.Metadata
+	for _, status := range []string{"200", "204", "301", "404", "500", "5xx", "1e3"} {
+		docs = append(docs, doc.Metadata{
+			ID: []byte("status=" + status),
+			Fields: []doc.Field{
+				{Name: []byte("status"), Value: []byte(status)},
+			},
+		})
+	}
+
+	tests := []struct {
+		name     string
+		r        index.NumericRange
+		expected []postings.ID
+	}{
+		{
+			name:     "successful statuses",
+			r:        index.NumericRange{Min: 200, Max: 300, MinInclusive: true},
+			expected: []postings.ID{0, 1},
+		},
+		{
+			name:     "exclusive bounds",
+			r:        index.NumericRange{Min: 204, Max: 500},
+			expected: []postings.ID{2, 3},
+		},
+		{
+			name:     "inclusive bounds",
+			r:        index.NumericRange{Min: 204, Max: 500, MinInclusive: true, MaxInclusive: true},
+			expected: []postings.ID{1, 2, 3, 4},
+		},
+		{
+			name:     "unbounded",
+			r:        index.NumericRange{Min: math.Inf(-1), Max: math.Inf(1)},
+			expected: []postings.ID{0, 1, 2, 3, 4, 6},
+		},
+		{
+			name: "empty",
+			r:    index.NumericRange{Min: 600, Max: 700},
+		},
+	}
+
+	for _, tc := range newTestCases(t, docs) {
+		t.Run(tc.name, func(t *testing.T) {
+			expReader, err := tc.expected.Reader()
+			require.NoError(t, err)
+			obsReader, err := tc.observed.Reader()
+			require.NoError(t, err)
+
+			for _, test := range tests {
+				expPl, err := expReader.MatchRange([]byte("status"), test.r)
+				require.NoError(t, err)
+				obsPl, err := obsReader.MatchRange([]byte("status"), test.r)
+				require.NoError(t, err)
+
+				require.Equal(t, test.expected, toPostingsIDs(t, expPl), test.name)
+				require.Equal(t, test.expected, toPostingsIDs(t, obsPl), test.name)
+			}
+		})
+	}
+}
+
+func toPostingsIDs(t *testing.T, pl postings.List) []postings.ID {
+	var ids []postings.ID
+	iter := pl.Iterator()
+	for iter.Next() {
+		ids = append(ids, iter.Current())
+	}
+	require.NoError(t, iter.Err())
+	require.NoError(t, iter.Close())
+	return ids
+}
+
 func TestSegmentDocs(t *testing.T) {
 	for _, test := range testDocuments {
 		t.Run(test.name, func(t *testing.T) {
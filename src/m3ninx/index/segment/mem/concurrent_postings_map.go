@@ -101,6 +101,12 @@ func (m *concurrentPostingsMap) Get(key []byte) (postings.List, bool) {
 // GetRegex returns the union of the postings lists whose keys match the
 // provided regexp.
 func (m *concurrentPostingsMap) GetRegex(re *regexp.Regexp) (postings.List, bool) {
+	return m.GetMatching(re.Match)
+}
+
+// GetMatching returns the union of the postings lists whose keys are accepted
+// by the provided match function.
+func (m *concurrentPostingsMap) GetMatching(match func(key []byte) bool) (postings.List, bool) {
 	lists := make([]postings.List, 0, m.postingsMap.Len())
 
 	m.RLock()
@@ -108,7 +114,7 @@ func (m *concurrentPostingsMap) GetRegex(re *regexp.Regexp) (postings.List, bool
 		// TODO: Evaluate lock contention caused by holding on to the read lock while
 		// evaluating this predicate.
 		// TODO: Evaluate if performing a prefix match would speed up the common case.
-		if re.Match(mapEntry.Key()) {
+		if match(mapEntry.Key()) {
 			lists = append(lists, mapEntry.Value())
 		}
 	}
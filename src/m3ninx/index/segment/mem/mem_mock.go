@@ -29,6 +29,7 @@ import (
 	"regexp"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/postings"
 
@@ -133,6 +134,36 @@ func (mr *MockReadableSegmentMockRecorder) getDoc(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getDoc", reflect.TypeOf((*MockReadableSegment)(nil).getDoc), arg0)
 }
 
+// matchPrefix mocks base method.
+func (m *MockReadableSegment) matchPrefix(arg0, arg1 []byte) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "matchPrefix", arg0, arg1)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// matchPrefix indicates an expected call of matchPrefix.
+func (mr *MockReadableSegmentMockRecorder) matchPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "matchPrefix", reflect.TypeOf((*MockReadableSegment)(nil).matchPrefix), arg0, arg1)
+}
+
+// matchRange mocks base method.
+func (m *MockReadableSegment) matchRange(arg0 []byte, arg1 index.NumericRange) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "matchRange", arg0, arg1)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// matchRange indicates an expected call of matchRange.
+func (mr *MockReadableSegmentMockRecorder) matchRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "matchRange", reflect.TypeOf((*MockReadableSegment)(nil).matchRange), arg0, arg1)
+}
+
 // matchRegexp mocks base method.
 func (m *MockReadableSegment) matchRegexp(arg0 []byte, arg1 *regexp.Regexp) (postings.List, error) {
 	m.ctrl.T.Helper()
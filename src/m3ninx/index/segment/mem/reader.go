@@ -113,6 +113,26 @@ func (r *reader) MatchRegexp(field []byte, compiled index.CompiledRegex) (postin
 	return r.segment.matchRegexp(field, compileRE)
 }
 
+func (r *reader) MatchPrefix(field, prefix []byte) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	return r.segment.matchPrefix(field, prefix)
+}
+
+func (r *reader) MatchRange(field []byte, numericRange index.NumericRange) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	return r.segment.matchRange(field, numericRange)
+}
+
 func (r *reader) MatchAll() (postings.List, error) {
 	r.RLock()
 	defer r.RUnlock()
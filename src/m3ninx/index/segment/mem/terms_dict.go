@@ -21,10 +21,12 @@
 package mem
 
 import (
+	"bytes"
 	re "regexp"
 	"sync"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
 	sgmt "github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
@@ -138,13 +140,27 @@ func (d *termsDict) MatchRegexp(
 	field []byte,
 	compiled *re.Regexp,
 ) postings.List {
+	return d.matchTerms(field, compiled.Match)
+}
+
+func (d *termsDict) MatchPrefix(field, prefix []byte) postings.List {
+	return d.matchTerms(field, func(term []byte) bool {
+		return bytes.HasPrefix(term, prefix)
+	})
+}
+
+func (d *termsDict) MatchRange(field []byte, r index.NumericRange) postings.List {
+	return d.matchTerms(field, r.Contains)
+}
+
+func (d *termsDict) matchTerms(field []byte, match func(term []byte) bool) postings.List {
 	d.fields.RLock()
 	postingsMap, ok := d.fields.Get(field)
 	d.fields.RUnlock()
 	if !ok {
 		return d.opts.PostingsListPool().Get()
 	}
-	pl, ok := postingsMap.GetRegex(compiled)
+	pl, ok := postingsMap.GetMatching(match)
 	if !ok {
 		return d.opts.PostingsListPool().Get()
 	}
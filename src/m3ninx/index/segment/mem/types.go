@@ -24,6 +24,7 @@ import (
 	re "regexp"
 
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index"
 	sgmt "github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/postings"
 )
@@ -49,6 +50,14 @@ type termsDictionary interface {
 	// given egular expression.
 	MatchRegexp(field []byte, compiled *re.Regexp) postings.List
 
+	// MatchPrefix returns the postings list corresponding to documents which have
+	// a term for the given field starting with the given prefix.
+	MatchPrefix(field, prefix []byte) postings.List
+
+	// MatchRange returns the postings list corresponding to documents which have
+	// a numeric term for the given field within the given range.
+	MatchRange(field []byte, r index.NumericRange) postings.List
+
 	// Fields returns the known fields.
 	Fields() sgmt.FieldsIterator
 
@@ -75,5 +84,7 @@ type ReadableSegment interface {
 	FieldsPostingsList() (sgmt.FieldsPostingsListIterator, error)
 	matchTerm(field, term []byte) (postings.List, error)
 	matchRegexp(field []byte, compiled *re.Regexp) (postings.List, error)
+	matchPrefix(field, prefix []byte) (postings.List, error)
+	matchRange(field []byte, r index.NumericRange) (postings.List, error)
 	getDoc(id postings.ID) (doc.Metadata, error)
 }
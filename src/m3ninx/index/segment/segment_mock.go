@@ -315,6 +315,36 @@ func (mr *MockReaderMockRecorder) MatchField(field interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchField", reflect.TypeOf((*MockReader)(nil).MatchField), field)
 }
 
+// MatchPrefix mocks base method.
+func (m *MockReader) MatchPrefix(field, prefix []byte) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchPrefix", field, prefix)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchPrefix indicates an expected call of MatchPrefix.
+func (mr *MockReaderMockRecorder) MatchPrefix(field, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchPrefix", reflect.TypeOf((*MockReader)(nil).MatchPrefix), field, prefix)
+}
+
+// MatchRange mocks base method.
+func (m *MockReader) MatchRange(field []byte, r index.NumericRange) (postings.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchRange", field, r)
+	ret0, _ := ret[0].(postings.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchRange indicates an expected call of MatchRange.
+func (mr *MockReaderMockRecorder) MatchRange(field, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchRange", reflect.TypeOf((*MockReader)(nil).MatchRange), field, r)
+}
+
 // MatchRegexp mocks base method.
 func (m *MockReader) MatchRegexp(field []byte, c index.CompiledRegex) (postings.List, error) {
 	m.ctrl.T.Helper()
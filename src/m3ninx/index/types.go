@@ -74,6 +74,14 @@ type Readable interface {
 	// regular expression.
 	MatchRegexp(field []byte, c CompiledRegex) (postings.List, error)
 
+	// MatchPrefix returns a postings list over all documents which have a term for
+	// the given field starting with the given prefix.
+	MatchPrefix(field, prefix []byte) (postings.List, error)
+
+	// MatchRange returns a postings list over all documents which have a numeric
+	// term for the given field within the given range.
+	MatchRange(field []byte, r NumericRange) (postings.List, error)
+
 	// MatchAll returns a postings list for all documents known to the Reader.
 	MatchAll() (postings.List, error)
 
@@ -99,6 +107,16 @@ type CompiledRegex struct {
 	PrefixEnd   []byte
 }
 
+// NumericRange is a range of numeric term values, terms which do not parse
+// as numbers are never contained in a range. Use infinite bounds for ranges
+// that are unbounded on either side.
+type NumericRange struct {
+	Min          float64
+	Max          float64
+	MinInclusive bool
+	MaxInclusive bool
+}
+
 // MetadataRetriever returns the metadata associated with a postings ID. It returns
 // ErrDocNotFound if there is no metadata corresponding to the given postings ID.
 type MetadataRetriever interface {
@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/search"
 )
 
@@ -64,6 +65,17 @@ func UnmarshalProto(q *querypb.Query) (search.Query, error) {
 	case *querypb.Query_Regexp:
 		return NewRegexpQuery(q.Regexp.Field, q.Regexp.Regexp)
 
+	case *querypb.Query_Prefix:
+		return NewPrefixQuery(q.Prefix.Field, q.Prefix.Prefix), nil
+
+	case *querypb.Query_Range:
+		return NewRangeQuery(q.Range.Field, index.NumericRange{
+			Min:          q.Range.Min,
+			Max:          q.Range.Max,
+			MinInclusive: q.Range.MinInclusive,
+			MaxInclusive: q.Range.MaxInclusive,
+		}), nil
+
 	case *querypb.Query_Negation:
 		inner, err := UnmarshalProto(q.Negation.Query)
 		if err != nil {
@@ -21,10 +21,12 @@
 package query
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/search"
 )
 
@@ -49,6 +51,18 @@ func TestMarshal(t *testing.T) {
 			name:  "regexp query",
 			query: MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
 		},
+		{
+			name:  "prefix query",
+			query: NewPrefixQuery([]byte("fruit"), []byte("app")),
+		},
+		{
+			name: "range query",
+			query: NewRangeQuery([]byte("status"), index.NumericRange{
+				Min:          200,
+				Max:          math.Inf(1),
+				MinInclusive: true,
+			}),
+		},
 		{
 			name:  "negation query",
 			query: NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("apple"))),
@@ -79,6 +93,8 @@ func TestMarshal(t *testing.T) {
 					NewTermQuery([]byte("fruit"), []byte("orange")),
 				}),
 				NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("pear"))),
+				NewPrefixQuery([]byte("fruit"), []byte("gr")),
+				NewRangeQuery([]byte("weight"), index.NumericRange{Min: 1, Max: 2}),
 			}),
 		},
 	}
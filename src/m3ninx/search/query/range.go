@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/search"
+	"github.com/m3db/m3/src/m3ninx/search/searcher"
+)
+
+// RangeQuery finds documents which have a numeric term within the given range.
+type RangeQuery struct {
+	str          string
+	field        []byte
+	numericRange index.NumericRange
+}
+
+// NewRangeQuery constructs a new RangeQuery for the given field and range.
+func NewRangeQuery(field []byte, r index.NumericRange) search.Query {
+	q := &RangeQuery{
+		field:        field,
+		numericRange: r,
+	}
+	// NB(r): Calculate string value up front so
+	// not allocated every time String() is called to determine
+	// the cache key.
+	q.str = q.string()
+	return q
+}
+
+// Searcher returns a searcher over the provided readers.
+func (q *RangeQuery) Searcher() (search.Searcher, error) {
+	return searcher.NewRangeSearcher(q.field, q.numericRange), nil
+}
+
+// Equal reports whether q is equivalent to o.
+func (q *RangeQuery) Equal(o search.Query) bool {
+	o, ok := singular(o)
+	if !ok {
+		return false
+	}
+
+	inner, ok := o.(*RangeQuery)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(q.field, inner.field) && q.numericRange == inner.numericRange
+}
+
+// ToProto returns the Protobuf query struct corresponding to the range query.
+func (q *RangeQuery) ToProto() *querypb.Query {
+	rng := querypb.RangeQuery{
+		Field:        q.field,
+		Min:          q.numericRange.Min,
+		Max:          q.numericRange.Max,
+		MinInclusive: q.numericRange.MinInclusive,
+		MaxInclusive: q.numericRange.MaxInclusive,
+	}
+
+	return &querypb.Query{
+		Query: &querypb.Query_Range{Range: &rng},
+	}
+}
+
+func (q *RangeQuery) String() string {
+	return q.str
+}
+
+func (q *RangeQuery) string() string {
+	var str strings.Builder
+	str.WriteString("range(")
+	str.Write(q.field)
+	str.WriteRune(',')
+	if q.numericRange.MinInclusive {
+		str.WriteRune('[')
+	} else {
+		str.WriteRune('(')
+	}
+	str.WriteString(strconv.FormatFloat(q.numericRange.Min, 'g', -1, 64))
+	str.WriteRune(',')
+	str.WriteString(strconv.FormatFloat(q.numericRange.Max, 'g', -1, 64))
+	if q.numericRange.MaxInclusive {
+		str.WriteRune(']')
+	} else {
+		str.WriteRune(')')
+	}
+	str.WriteRune(')')
+	return str.String()
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/search"
+)
+
+func TestRangeQuery(t *testing.T) {
+	q := NewRangeQuery([]byte("status"), index.NumericRange{
+		Min:          200,
+		Max:          300,
+		MinInclusive: true,
+	})
+	_, err := q.Searcher()
+	require.NoError(t, err)
+	require.Equal(t, "range(status,[200,300))", q.String())
+
+	q = NewRangeQuery([]byte("le"), index.NumericRange{
+		Min:          math.Inf(-1),
+		Max:          0.5,
+		MaxInclusive: true,
+	})
+	require.Equal(t, "range(le,(-Inf,0.5])", q.String())
+}
+
+func TestRangeQueryEqual(t *testing.T) {
+	r := index.NumericRange{Min: 200, Max: 300, MinInclusive: true}
+	tests := []struct {
+		name        string
+		left, right search.Query
+		expected    bool
+	}{
+		{
+			name:     "same field and range",
+			left:     NewRangeQuery([]byte("status"), r),
+			right:    NewRangeQuery([]byte("status"), r),
+			expected: true,
+		},
+		{
+			name: "singular disjunction query",
+			left: NewRangeQuery([]byte("status"), r),
+			right: NewDisjunctionQuery([]search.Query{
+				NewRangeQuery([]byte("status"), r),
+			}),
+			expected: true,
+		},
+		{
+			name:     "different field",
+			left:     NewRangeQuery([]byte("status"), r),
+			right:    NewRangeQuery([]byte("code"), r),
+			expected: false,
+		},
+		{
+			name: "different inclusivity",
+			left: NewRangeQuery([]byte("status"), r),
+			right: NewRangeQuery([]byte("status"), index.NumericRange{
+				Min: 200,
+				Max: 300,
+			}),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.left.Equal(test.right))
+		})
+	}
+}
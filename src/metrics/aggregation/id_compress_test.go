@@ -67,7 +67,7 @@ func TestIDDecompressError(t *testing.T) {
 	require.Error(t, err)
 
 	max, err := compressor.Compress(
-		[]Type{Last, Min, Max, Mean, Median, Count, Sum, SumSq, Stdev, P95, P99, P999, P9999, P25, P75})
+		[]Type{Last, Min, Max, Mean, Median, Count, Sum, SumSq, Stdev, P95, P99, P999, P9999, P25, P75, CountDistinct})
 	require.NoError(t, err)
 
 	max[0] = max[0] << 1
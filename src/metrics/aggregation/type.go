@@ -55,6 +55,7 @@ const (
 	P9999
 	P25
 	P75
+	CountDistinct
 
 	nextTypeID = iota
 )
@@ -102,6 +103,8 @@ var (
 		P99:    emptyStruct,
 		P999:   emptyStruct,
 		P9999:  emptyStruct,
+
+		CountDistinct: emptyStruct,
 	}
 
 	typeStringMap map[string]Type
@@ -131,6 +134,8 @@ var (
 		P99:    []byte("p99"),
 		P999:   []byte("p999"),
 		P9999:  []byte("p9999"),
+
+		CountDistinct: []byte("count_distinct"),
 	}
 
 	typeQuantileBytes = map[Type][]byte{
@@ -178,7 +183,7 @@ func (a Type) IsValid() bool {
 // IsValidForGauge if an Type is valid for Gauge.
 func (a Type) IsValidForGauge() bool {
 	switch a {
-	case Last, Min, Max, Mean, Count, Sum, SumSq, Stdev:
+	case Last, Min, Max, Mean, Count, Sum, SumSq, Stdev, CountDistinct:
 		return true
 	default:
 		return false
@@ -188,7 +193,7 @@ func (a Type) IsValidForGauge() bool {
 // IsValidForCounter if an Type is valid for Counter.
 func (a Type) IsValidForCounter() bool {
 	switch a {
-	case Min, Max, Mean, Count, Sum, SumSq, Stdev:
+	case Min, Max, Mean, Count, Sum, SumSq, Stdev, CountDistinct:
 		return true
 	default:
 		return false
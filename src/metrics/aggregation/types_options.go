@@ -151,6 +151,8 @@ var (
 		Count:  []byte("count"),
 		Stdev:  []byte("stdev"),
 		Median: []byte("median"),
+
+		CountDistinct: []byte("count_distinct"),
 	}
 )
 
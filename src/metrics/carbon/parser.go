@@ -22,6 +22,7 @@ package carbon
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -52,9 +53,20 @@ const (
 )
 
 var (
-	errInvalidLine = errors.New("invalid line")
-	errNotUTF8     = errors.New("not valid UTF8 string")
-	mathNan        = math.NaN()
+	errInvalidLine      = errors.New("invalid line")
+	errNotUTF8          = errors.New("not valid UTF8 string")
+	errInvalidTag       = errors.New("invalid tag")
+	errReservedTag      = errors.New("tag name is reserved")
+	errTaggedNameNoPath = errors.New("tagged name has no path")
+	mathNan             = math.NaN()
+
+	// reservedTagName is the tag that holds the path of tagged metrics.
+	reservedTagName = []byte("name")
+)
+
+const (
+	tagSeparator      = ';'
+	tagValueSeparator = '='
 )
 
 // Metric represents a carbon metric.
@@ -226,6 +238,72 @@ func Parse(line []byte) (name []byte, timestamp time.Time, value float64, err er
 	return
 }
 
+// Tag is a tag of a tagged carbon metric.
+type Tag struct {
+	Name  []byte
+	Value []byte
+}
+
+// IsTaggedName returns true if the metric name has tags, i.e. it is of the
+// form path;tag1=value1;tag2=value2.
+func IsTaggedName(name []byte) bool {
+	return bytes.IndexByte(name, tagSeparator) >= 0
+}
+
+// ParseTaggedName splits a tagged metric name of the form
+// path;tag1=value1;tag2=value2 into its path and tags, the tags are appended
+// to the provided slice and reference the name. Names without tags are
+// returned as the path with no tags.
+func ParseTaggedName(name []byte, tags []Tag) ([]byte, []Tag, error) {
+	idx := bytes.IndexByte(name, tagSeparator)
+	if idx < 0 {
+		return name, tags, nil
+	}
+
+	path := name[:idx]
+	if len(path) == 0 {
+		return nil, tags, errTaggedNameNoPath
+	}
+
+	rest := name[idx+1:]
+	for {
+		part := rest
+		next := bytes.IndexByte(rest, tagSeparator)
+		if next >= 0 {
+			part = rest[:next]
+		}
+
+		tag, err := parseTag(part)
+		if err != nil {
+			return nil, tags, err
+		}
+		tags = append(tags, tag)
+
+		if next < 0 {
+			return path, tags, nil
+		}
+		rest = rest[next+1:]
+	}
+}
+
+// parseTag parses a tag of the form name=value, following Graphite the name
+// must not contain any of ;!^= and the value must not start with ~.
+func parseTag(b []byte) (Tag, error) {
+	idx := bytes.IndexByte(b, tagValueSeparator)
+	if idx <= 0 || idx == len(b)-1 {
+		return Tag{}, errInvalidTag
+	}
+
+	name, value := b[:idx], b[idx+1:]
+	if bytes.ContainsAny(name, "!^") || value[0] == '~' {
+		return Tag{}, errInvalidTag
+	}
+	if bytes.Equal(name, reservedTagName) {
+		return Tag{}, errReservedTag
+	}
+	return Tag{Name: name, Value: value}, nil
+}
+
 // A Scanner is used to scan carbon lines from an underlying io.Reader.
 type Scanner struct {
 	scanner   *bufio.Scanner
@@ -140,6 +140,36 @@ func TestParseErrors(t *testing.T) {
 	assertParseError(t, "foo 4384 1428951394 1428951394 bar")
 }
 
+func TestParseTaggedName(t *testing.T) {
+	path, tags, err := ParseTaggedName([]byte("disk.used;dc=dc1;host=a=b"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "disk.used", string(path))
+	assert.Equal(t, []Tag{
+		{Name: []byte("dc"), Value: []byte("dc1")},
+		{Name: []byte("host"), Value: []byte("a=b")},
+	}, tags)
+
+	path, tags, err = ParseTaggedName([]byte("foo.bar.baz"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "foo.bar.baz", string(path))
+	assert.Len(t, tags, 0)
+
+	for _, name := range []string{
+		";dc=dc1",
+		"foo;",
+		"foo;dc",
+		"foo;dc=",
+		"foo;=dc1",
+		"foo;dc=dc1;",
+		"foo;d!c=dc1",
+		"foo;dc=~dc1",
+		"foo;name=bar",
+	} {
+		_, _, err := ParseTaggedName([]byte(name), nil)
+		assert.Error(t, err, name)
+	}
+}
+
 func TestParsePacket(t *testing.T) {
 	mets, malformed := ParsePacket([]byte(`
 foo.bar.zed 45565.02 1428951394
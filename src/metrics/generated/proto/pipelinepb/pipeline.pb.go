@@ -170,6 +170,7 @@ type RollupOp struct {
 	Tags             []string                        `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
 	AggregationTypes []aggregationpb.AggregationType `protobuf:"varint,3,rep,packed,name=aggregation_types,json=aggregationTypes,enum=aggregationpb.AggregationType" json:"aggregation_types,omitempty"`
 	Type             RollupOp_Type                   `protobuf:"varint,4,opt,name=type,proto3,enum=pipelinepb.RollupOp_Type" json:"type,omitempty"`
+	DistinctTag      string                          `protobuf:"bytes,5,opt,name=distinct_tag,json=distinctTag,proto3" json:"distinct_tag,omitempty"`
 }
 
 func (m *RollupOp) Reset()                    { *m = RollupOp{} }
@@ -205,6 +206,13 @@ func (m *RollupOp) GetType() RollupOp_Type {
 	return RollupOp_GROUP_BY
 }
 
+func (m *RollupOp) GetDistinctTag() string {
+	if m != nil {
+		return m.DistinctTag
+	}
+	return ""
+}
+
 type PipelineOp struct {
 	Type           PipelineOp_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=pipelinepb.PipelineOp_Type" json:"type,omitempty"`
 	Aggregation    *AggregationOp    `protobuf:"bytes,2,opt,name=aggregation" json:"aggregation,omitempty"`
@@ -456,6 +464,12 @@ func (m *RollupOp) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPipeline(dAtA, i, uint64(m.Type))
 	}
+	if len(m.DistinctTag) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.DistinctTag)))
+		i += copy(dAtA[i:], m.DistinctTag)
+	}
 	return i, nil
 }
 
@@ -693,6 +707,10 @@ func (m *RollupOp) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovPipeline(uint64(m.Type))
 	}
+	l = len(m.DistinctTag)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
 	return n
 }
 
@@ -1085,6 +1103,35 @@ func (m *RollupOp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistinctTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistinctTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -1705,46 +1752,46 @@ func init() {
 }
 
 var fileDescriptorPipeline = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x4e, 0xdb, 0x40,
-	0x14, 0x86, 0x63, 0x3b, 0x82, 0x70, 0x02, 0xc1, 0x8c, 0xaa, 0xca, 0x5c, 0x1a, 0x22, 0x8b, 0x45,
-	0x16, 0xc5, 0x96, 0x12, 0xb5, 0x2a, 0x74, 0x15, 0x08, 0x4d, 0x53, 0x52, 0x1b, 0x4d, 0x13, 0xf5,
-	0xb2, 0x41, 0x36, 0x1e, 0x5c, 0x4b, 0xb1, 0x3d, 0xb2, 0x8d, 0x10, 0x4f, 0xd0, 0x2d, 0xaf, 0xd0,
-	0x77, 0xe8, 0x43, 0xb0, 0xec, 0xbe, 0x52, 0x55, 0xd1, 0x17, 0xa9, 0x62, 0x3b, 0xc9, 0x4c, 0x48,
-	0x2f, 0x74, 0x37, 0x33, 0x3e, 0xe7, 0x3f, 0xff, 0xfc, 0xdf, 0xc8, 0xf0, 0xd2, 0xf5, 0x92, 0x8f,
-	0x17, 0xb6, 0x76, 0x16, 0xfa, 0xba, 0xdf, 0x74, 0x6c, 0xdd, 0x6f, 0xea, 0x71, 0x74, 0xa6, 0xfb,
-	0x24, 0x89, 0xbc, 0xb3, 0x58, 0x77, 0x49, 0x40, 0x22, 0x2b, 0x21, 0x8e, 0x4e, 0xa3, 0x30, 0x09,
-	0x75, 0xea, 0x51, 0x32, 0xf4, 0x02, 0x42, 0xed, 0xc9, 0x52, 0x4b, 0xbf, 0x20, 0x98, 0x7e, 0xda,
-	0xd8, 0x65, 0x54, 0xdd, 0xd0, 0x0d, 0xb3, 0x66, 0xfb, 0xe2, 0x3c, 0xdd, 0x65, 0x4a, 0xa3, 0x55,
-	0xd6, 0xba, 0x61, 0xdc, 0xd3, 0x84, 0xe5, 0xba, 0x11, 0x71, 0xad, 0xc4, 0x0b, 0x03, 0x6a, 0xb3,
-	0xbb, 0x5c, 0xaf, 0x7f, 0x4f, 0xbd, 0x24, 0xb2, 0x82, 0xf8, 0x3c, 0x8c, 0xfc, 0xb1, 0x24, 0x7f,
-	0x90, 0xa9, 0xaa, 0x87, 0xb0, 0xd2, 0x9a, 0x8e, 0x32, 0x29, 0x6a, 0x40, 0x31, 0xb9, 0xa2, 0x44,
-	0x11, 0x6a, 0x42, 0xbd, 0xd2, 0xa8, 0x6a, 0x9c, 0x2d, 0x8d, 0xa9, 0xed, 0x5f, 0x51, 0x82, 0xd3,
-	0x5a, 0xb5, 0x07, 0x72, 0x9f, 0x13, 0x37, 0x29, 0x7a, 0xc6, 0xe9, 0xec, 0x68, 0xb3, 0x76, 0x34,
-	0xbe, 0x83, 0x51, 0xfb, 0x26, 0x40, 0x09, 0x87, 0xc3, 0xe1, 0x05, 0x35, 0x29, 0x5a, 0x87, 0x52,
-	0x40, 0x2e, 0x4f, 0x03, 0xcb, 0xcf, 0xa4, 0x96, 0xf0, 0x62, 0x40, 0x2e, 0x0d, 0xcb, 0x27, 0x08,
-	0x41, 0x31, 0xb1, 0xdc, 0x58, 0x11, 0x6b, 0x52, 0x7d, 0x09, 0xa7, 0x6b, 0x74, 0x0c, 0x6b, 0x8c,
-	0xe1, 0xd3, 0x91, 0x5e, 0xac, 0x48, 0x35, 0xe9, 0x1f, 0xae, 0x22, 0x5b, 0xfc, 0x41, 0x8c, 0x76,
-	0xf3, 0x2b, 0x14, 0xd3, 0x2b, 0xac, 0x6b, 0xd3, 0xb7, 0xa0, 0x8d, 0xfd, 0x69, 0x8c, 0xef, 0x1d,
-	0x28, 0x8e, 0x76, 0x68, 0x19, 0x4a, 0x1d, 0x6c, 0x0e, 0x4e, 0x4e, 0x0f, 0xde, 0xcb, 0x05, 0x54,
-	0x01, 0x38, 0x7a, 0x77, 0xd8, 0x1b, 0xb4, 0x8f, 0x46, 0x7b, 0x41, 0xfd, 0x22, 0x02, 0x9c, 0xe4,
-	0x42, 0x26, 0x45, 0x3a, 0x17, 0xd3, 0x26, 0x3b, 0x63, 0x5a, 0xc5, 0x4c, 0x41, 0xcf, 0xa1, 0xcc,
-	0x18, 0x55, 0xc4, 0x9a, 0x50, 0x2f, 0xf3, 0xde, 0x38, 0x9e, 0x98, 0xad, 0x46, 0x6d, 0xa8, 0xf0,
-	0x1c, 0x14, 0x29, 0xed, 0xdf, 0x62, 0xfb, 0x67, 0x51, 0xe2, 0x99, 0x1e, 0xf4, 0x18, 0x16, 0xa2,
-	0xf4, 0xfe, 0x69, 0x32, 0xe5, 0xc6, 0x83, 0x79, 0xc9, 0xe0, 0xbc, 0x46, 0x6d, 0xe7, 0xb1, 0x94,
-	0x61, 0x71, 0x60, 0x1c, 0x1b, 0xe6, 0x5b, 0x43, 0x2e, 0xa0, 0x55, 0x28, 0xb7, 0x3a, 0x1d, 0x7c,
-	0xd4, 0x69, 0xf5, 0xbb, 0xa6, 0x21, 0x0b, 0x08, 0x41, 0xa5, 0x8f, 0x5b, 0xc6, 0x9b, 0x17, 0x26,
-	0x7e, 0x9d, 0x9d, 0x89, 0x08, 0x60, 0x01, 0x9b, 0xbd, 0xde, 0xe0, 0x44, 0x96, 0xd4, 0x7d, 0x28,
-	0x8d, 0xf3, 0x40, 0x1a, 0x48, 0x21, 0x8d, 0x15, 0xa1, 0x26, 0xd5, 0xcb, 0x8d, 0x87, 0xf3, 0x23,
-	0x3b, 0x28, 0xde, 0x7c, 0xdf, 0x2e, 0xe0, 0x51, 0xa1, 0x3a, 0x84, 0xd5, 0x16, 0xa5, 0x43, 0x8f,
-	0x38, 0x93, 0x67, 0x55, 0x01, 0xd1, 0x73, 0xd2, 0xd0, 0x97, 0xb1, 0xe8, 0x39, 0xa8, 0x0b, 0x15,
-	0xf6, 0xdd, 0x78, 0x4e, 0x1e, 0xec, 0xd6, 0xef, 0x1f, 0x4d, 0xb7, 0x9d, 0xcf, 0x58, 0x61, 0x4a,
-	0xba, 0x8e, 0xfa, 0x49, 0x84, 0xb5, 0x7c, 0x1c, 0xc3, 0xf9, 0x29, 0xc7, 0x59, 0xe5, 0x78, 0xcd,
-	0x16, 0xb3, 0xb8, 0x5f, 0xdd, 0x21, 0x26, 0xfe, 0x9d, 0x58, 0x6e, 0x6c, 0x96, 0xdb, 0xde, 0x84,
-	0x5b, 0x46, 0x7d, 0x73, 0x8e, 0x8b, 0x71, 0x42, 0xb9, 0xc4, 0x18, 0x62, 0x73, 0x1e, 0xc4, 0xbb,
-	0xcc, 0x04, 0x86, 0x99, 0xa8, 0x1a, 0x93, 0xdc, 0x27, 0xe8, 0x9e, 0xb0, 0xe8, 0x1e, 0xfd, 0x31,
-	0x05, 0x86, 0xe0, 0x7e, 0xf1, 0xfa, 0xf3, 0x76, 0xe1, 0xe0, 0xf8, 0xe6, 0xb6, 0x2a, 0x7c, 0xbd,
-	0xad, 0x0a, 0x3f, 0x6e, 0xab, 0xc2, 0xf5, 0xcf, 0x6a, 0xe1, 0xc3, 0xde, 0x7f, 0xff, 0xe8, 0xed,
-	0x85, 0xf4, 0xa4, 0xf9, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x82, 0xb2, 0x32, 0x0e, 0x2c, 0x06, 0x00,
-	0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x55, 0xcb, 0x6e, 0xda, 0x40,
+	0x14, 0xc5, 0x86, 0x12, 0x7a, 0x4d, 0x08, 0x19, 0x55, 0x15, 0x49, 0x5a, 0x92, 0x5a, 0x59, 0x64,
+	0xd1, 0xd8, 0x12, 0xa8, 0x55, 0x93, 0xae, 0x20, 0x50, 0x4a, 0xa1, 0x36, 0x9a, 0x18, 0xf5, 0xb1,
+	0x41, 0x06, 0x3b, 0xae, 0x25, 0xb0, 0x2d, 0xdb, 0xa8, 0xea, 0x17, 0x74, 0x9b, 0x5f, 0xe8, 0x3f,
+	0xf4, 0x23, 0xb2, 0xec, 0x17, 0x54, 0x55, 0xfb, 0x1f, 0x55, 0xc7, 0x2f, 0x18, 0x13, 0xda, 0x28,
+	0x5d, 0x18, 0xcd, 0xdc, 0x39, 0xf7, 0xdc, 0x33, 0xe7, 0x8c, 0x04, 0xbc, 0x34, 0x4c, 0xff, 0xc3,
+	0x7c, 0x2c, 0x4c, 0xec, 0x99, 0x38, 0xab, 0x6b, 0x63, 0xf2, 0x23, 0x7a, 0xee, 0x44, 0x9c, 0xe9,
+	0xbe, 0x6b, 0x4e, 0x3c, 0xd1, 0xd0, 0x2d, 0xdd, 0x55, 0x7d, 0x5d, 0x13, 0x1d, 0xd7, 0xf6, 0x6d,
+	0xd1, 0x31, 0x1d, 0x7d, 0x6a, 0x5a, 0xba, 0x33, 0x5e, 0x2c, 0x85, 0xf0, 0x04, 0xc1, 0xf2, 0x68,
+	0xf7, 0x98, 0x62, 0x35, 0x6c, 0xc3, 0x8e, 0x9a, 0xc7, 0xf3, 0x8b, 0x70, 0x17, 0x31, 0x05, 0xab,
+	0xa8, 0x75, 0x57, 0xba, 0xa5, 0x08, 0xd5, 0x30, 0x5c, 0xdd, 0x50, 0x7d, 0xd3, 0xb6, 0x88, 0x0e,
+	0x6a, 0x17, 0xf3, 0x29, 0xb7, 0xe4, 0xf3, 0x5d, 0xd5, 0xf2, 0x2e, 0x6c, 0x77, 0x96, 0x50, 0xa6,
+	0x0b, 0x11, 0x2b, 0x7f, 0x06, 0x9b, 0x8d, 0xe5, 0x28, 0xd9, 0x41, 0x35, 0xc8, 0xf9, 0x9f, 0x1c,
+	0xbd, 0xc2, 0x1c, 0x30, 0x47, 0xa5, 0x5a, 0x55, 0x48, 0xc9, 0x12, 0x28, 0xac, 0x42, 0x50, 0x38,
+	0xc4, 0xf2, 0x7d, 0x28, 0x2b, 0x29, 0x72, 0xc2, 0xf3, 0x2c, 0xc5, 0x73, 0x28, 0xac, 0xca, 0x11,
+	0xd2, 0x1d, 0x14, 0xdb, 0x6f, 0x06, 0x0a, 0xd8, 0x9e, 0x4e, 0xe7, 0x0e, 0xa1, 0xd9, 0x81, 0x82,
+	0xa5, 0x7f, 0x1c, 0x59, 0xea, 0x2c, 0xa2, 0xba, 0x8b, 0x37, 0xc8, 0x5e, 0x22, 0x5b, 0x84, 0xc8,
+	0x04, 0xd5, 0xf0, 0x2a, 0xec, 0x41, 0x96, 0x94, 0xc3, 0x35, 0xea, 0xc1, 0x36, 0x25, 0x78, 0x14,
+	0xf0, 0x79, 0x95, 0x2c, 0x01, 0xdc, 0x7c, 0x95, 0xb2, 0x9a, 0x2e, 0x78, 0xe8, 0x38, 0xbe, 0x42,
+	0x2e, 0xbc, 0xc2, 0x8e, 0xb0, 0x7c, 0x0b, 0x42, 0xa2, 0x4f, 0x58, 0xea, 0x46, 0x8f, 0xa0, 0xa8,
+	0x99, 0x9e, 0x6f, 0x5a, 0x13, 0x7f, 0x44, 0xc4, 0x54, 0xee, 0x84, 0x72, 0xb9, 0xa4, 0xa6, 0xa8,
+	0x06, 0x7f, 0x08, 0xb9, 0xa0, 0x01, 0x15, 0xa1, 0xd0, 0xc1, 0xf2, 0x70, 0x30, 0x6a, 0xbe, 0x2b,
+	0x67, 0x50, 0x09, 0xa0, 0xfd, 0xf6, 0xac, 0x3f, 0x6c, 0xb5, 0x83, 0x3d, 0xc3, 0x7f, 0x65, 0x01,
+	0x06, 0xf1, 0x2c, 0x62, 0x81, 0x98, 0x72, 0x72, 0x8f, 0x96, 0xb1, 0x44, 0xd1, 0x42, 0x9e, 0x03,
+	0x47, 0xdd, 0x85, 0xf8, 0xc3, 0x1c, 0x71, 0x69, 0xf9, 0xa9, 0xc8, 0x31, 0x8d, 0x46, 0x2d, 0x28,
+	0xa5, 0xa3, 0x22, 0xf6, 0x05, 0xfd, 0x0f, 0xe8, 0xfe, 0xd5, 0xb4, 0xf1, 0x4a, 0x0f, 0x7a, 0x0c,
+	0x79, 0x37, 0xb4, 0x28, 0x34, 0x8f, 0xab, 0xdd, 0x5b, 0x67, 0x1e, 0x8e, 0x31, 0x7c, 0x2b, 0xb6,
+	0x85, 0x83, 0x8d, 0xa1, 0xd4, 0x93, 0xe4, 0x37, 0x12, 0x71, 0x65, 0x0b, 0xb8, 0x46, 0xa7, 0x83,
+	0xdb, 0x9d, 0x86, 0xd2, 0x95, 0xa5, 0x32, 0x43, 0xf2, 0x2e, 0x29, 0xb8, 0x21, 0x9d, 0xbf, 0x90,
+	0xf1, 0xeb, 0xa8, 0xc6, 0x22, 0x80, 0x3c, 0x96, 0xfb, 0xfd, 0xe1, 0xa0, 0x9c, 0xe5, 0x4f, 0xa1,
+	0x90, 0xf8, 0x81, 0x04, 0xc8, 0xda, 0x8e, 0x47, 0x2c, 0xcb, 0x92, 0xe1, 0xf7, 0xd7, 0x5b, 0xd6,
+	0xcc, 0x5d, 0x7d, 0xdf, 0xcf, 0xe0, 0x00, 0xc8, 0x4f, 0x61, 0xab, 0xe1, 0x38, 0x53, 0x53, 0xd7,
+	0x16, 0x2f, 0xaf, 0x04, 0xac, 0xa9, 0x85, 0xa6, 0x17, 0x31, 0x59, 0xa1, 0x2e, 0x94, 0xe8, 0xa7,
+	0x45, 0xce, 0xd8, 0xd8, 0x98, 0xbf, 0xbe, 0xab, 0x6e, 0x2b, 0x9e, 0xb1, 0x49, 0x41, 0xba, 0x1a,
+	0xff, 0x99, 0x85, 0xed, 0x78, 0x1c, 0x95, 0xf3, 0xd3, 0x54, 0xce, 0x7c, 0x2a, 0xaf, 0x55, 0x30,
+	0x1d, 0xf7, 0xab, 0x6b, 0x89, 0xb1, 0x37, 0x27, 0x16, 0x0b, 0x5b, 0xcd, 0xed, 0x64, 0x91, 0x5b,
+	0x94, 0xfa, 0xde, 0x1a, 0x15, 0x89, 0x43, 0x31, 0x45, 0x12, 0x62, 0x7d, 0x5d, 0x88, 0xd7, 0x33,
+	0x63, 0xa8, 0xcc, 0x58, 0x5e, 0x5a, 0xf8, 0xbe, 0x88, 0xee, 0x09, 0x1d, 0xdd, 0xc3, 0x7f, 0xba,
+	0x40, 0x25, 0x78, 0x9a, 0xbb, 0xfc, 0xb2, 0x9f, 0x69, 0xf6, 0xae, 0x7e, 0x56, 0x99, 0x6f, 0xe4,
+	0xfb, 0x41, 0xbe, 0xcb, 0x5f, 0xd5, 0xcc, 0xfb, 0x93, 0xff, 0xfe, 0x2f, 0x18, 0xe7, 0xc3, 0x4a,
+	0xfd, 0x0f, 0x08, 0xa1, 0x85, 0x18, 0x4f, 0x06, 0x00, 0x00,
 }
@@ -36,6 +36,7 @@ const (
 	CounterType
 	TimerType
 	GaugeType
+	HistogramType
 )
 
 // ValidTypes is a list of valid metric types.
@@ -43,12 +44,14 @@ var ValidTypes = []Type{
 	CounterType,
 	TimerType,
 	GaugeType,
+	HistogramType,
 }
 
 var (
-	M3CounterValue = []byte("counter")
-	M3GaugeValue   = []byte("gauge")
-	M3TimerValue   = []byte("timer")
+	M3CounterValue   = []byte("counter")
+	M3GaugeValue     = []byte("gauge")
+	M3TimerValue     = []byte("timer")
+	M3HistogramValue = []byte("histogram")
 
 	PromUnknownValue        = []byte("unknown")
 	PromCounterValue        = []byte("counter")
@@ -81,6 +84,8 @@ func (t Type) String() string {
 		return "timer"
 	case GaugeType:
 		return "gauge"
+	case HistogramType:
+		return "histogram"
 	default:
 		return fmt.Sprintf("unknown type: %d", t)
 	}
@@ -97,6 +102,8 @@ func (t Type) ToProto(pb *metricpb.MetricType) error {
 		*pb = metricpb.MetricType_TIMER
 	case GaugeType:
 		*pb = metricpb.MetricType_GAUGE
+	case HistogramType:
+		*pb = metricpb.MetricType_HISTOGRAM
 	default:
 		return fmt.Errorf("unknown metric type: %v", t)
 	}
@@ -114,6 +121,8 @@ func (t *Type) FromProto(pb metricpb.MetricType) error {
 		*t = TimerType
 	case metricpb.MetricType_GAUGE:
 		*t = GaugeType
+	case metricpb.MetricType_HISTOGRAM:
+		*t = HistogramType
 	default:
 		return fmt.Errorf("unknown metric type in proto: %v", pb)
 	}
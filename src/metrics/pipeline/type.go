@@ -200,7 +200,9 @@ type RollupOp struct {
 	// Type is the rollup type.
 	Type RollupType
 	// Types of aggregation performed within each unique dimension combination.
-	AggregationID    aggregation.ID
+	AggregationID aggregation.ID
+	// Tag whose distinct values are counted by the CountDistinct aggregation.
+	DistinctTag      []byte
 	newNameTemplated bool
 }
 
@@ -217,7 +219,7 @@ func NewRollupOpFromProto(pb *pipelinepb.RollupOp) (RollupOp, error) {
 		return rollup, err
 	}
 
-	return NewRollupOp(RollupType(pb.Type), pb.NewName, pb.Tags, aggregationID)
+	return NewRollupOpWithDistinctTag(RollupType(pb.Type), pb.NewName, pb.Tags, aggregationID, pb.DistinctTag)
 }
 
 // NewRollupOp creates a new rollup op.
@@ -269,6 +271,44 @@ func NewRollupOp(
 	}, nil
 }
 
+// NewRollupOpWithDistinctTag creates a new rollup op whose CountDistinct
+// aggregation counts the distinct values of the given tag, the tag must not
+// be one of the tags the rollup is grouped by or, when the rollup excludes
+// tags, must be one of the excluded tags. An empty distinct tag creates a
+// rollup op with no distinct tag.
+func NewRollupOpWithDistinctTag(
+	rollupType RollupType,
+	rollupNewName string,
+	rollupTags []string,
+	rollupAggregationID aggregation.ID,
+	distinctTag string,
+) (RollupOp, error) {
+	rollup, err := NewRollupOp(rollupType, rollupNewName, rollupTags, rollupAggregationID)
+	if err != nil || distinctTag == "" {
+		return rollup, err
+	}
+
+	if !rollupAggregationID.Contains(aggregation.CountDistinct) {
+		return RollupOp{}, fmt.Errorf(
+			"rollup distinct tag %s requires the %v aggregation", distinctTag, aggregation.CountDistinct)
+	}
+	isRollupTag := false
+	for _, tag := range rollupTags {
+		if tag == distinctTag {
+			isRollupTag = true
+			break
+		}
+	}
+	if rollupType == GroupByRollupType && isRollupTag {
+		return RollupOp{}, fmt.Errorf("rollup distinct tag %s is a rollup tag", distinctTag)
+	}
+	if rollupType == ExcludeByRollupType && !isRollupTag {
+		return RollupOp{}, fmt.Errorf("rollup distinct tag %s is not an excluded tag", distinctTag)
+	}
+	rollup.DistinctTag = []byte(distinctTag)
+	return rollup, nil
+}
+
 // NewName returns the new rollup name based on an existing name if
 // the new name uses a template, or otherwise the literal new name.
 func (op RollupOp) NewName(currName []byte) []byte {
@@ -319,6 +359,9 @@ func (op RollupOp) Equal(other RollupOp) bool {
 	if op.Type != other.Type {
 		return false
 	}
+	if !bytes.Equal(op.DistinctTag, other.DistinctTag) {
+		return false
+	}
 	return op.SameTransform(other)
 }
 
@@ -326,10 +369,15 @@ func (op RollupOp) Equal(other RollupOp) bool {
 func (op RollupOp) Clone() RollupOp {
 	newName := make([]byte, len(op.newName))
 	copy(newName, op.newName)
+	var distinctTag []byte
+	if op.DistinctTag != nil {
+		distinctTag = append([]byte(nil), op.DistinctTag...)
+	}
 	return RollupOp{
 		Type:             op.Type,
 		Tags:             xbytes.ArrayCopy(op.Tags),
 		AggregationID:    op.AggregationID,
+		DistinctTag:      distinctTag,
 		newName:          newName,
 		newNameTemplated: op.newNameTemplated,
 	}
@@ -350,6 +398,7 @@ func (op RollupOp) Proto() (*pipelinepb.RollupOp, error) {
 		NewName:          string(op.newName),
 		Tags:             xbytes.ArraysToStringArray(op.Tags),
 		AggregationTypes: pbAggTypes,
+		DistinctTag:      string(op.DistinctTag),
 	}, nil
 }
 
@@ -366,6 +415,9 @@ func (op RollupOp) String() string {
 		}
 	}
 	b.WriteString("], ")
+	if len(op.DistinctTag) > 0 {
+		fmt.Fprintf(&b, "distinctTag: %s, ", op.DistinctTag)
+	}
 	fmt.Fprintf(&b, "aggregation: %v", op.AggregationID)
 	b.WriteString("}")
 	return b.String()
@@ -408,6 +460,7 @@ type rollupMarshaler struct {
 	NewName       string         `json:"newName" yaml:"newName"`
 	Tags          []string       `json:"tags" yaml:"tags"`
 	AggregationID aggregation.ID `json:"aggregation,omitempty" yaml:"aggregation"`
+	DistinctTag   string         `json:"distinctTag,omitempty" yaml:"distinctTag,omitempty"`
 }
 
 func newRollupMarshaler(op RollupOp) rollupMarshaler {
@@ -416,11 +469,12 @@ func newRollupMarshaler(op RollupOp) rollupMarshaler {
 		NewName:       string(op.newName),
 		Tags:          xbytes.ArraysToStringArray(op.Tags),
 		AggregationID: op.AggregationID,
+		DistinctTag:   string(op.DistinctTag),
 	}
 }
 
 func (m rollupMarshaler) RollupOp() (RollupOp, error) {
-	return NewRollupOp(m.Type, m.NewName, m.Tags, m.AggregationID)
+	return NewRollupOpWithDistinctTag(m.Type, m.NewName, m.Tags, m.AggregationID, m.DistinctTag)
 }
 
 // OpUnion is a union of different types of operation.
@@ -240,6 +240,11 @@ func TestRollupOpEqual(t *testing.T) {
 			a2:       RollupOp{Type: ExcludeByRollupType},
 			expected: false,
 		},
+		{
+			a1:       RollupOp{Type: GroupByRollupType, DistinctTag: b("user")},
+			a2:       RollupOp{Type: GroupByRollupType},
+			expected: false,
+		},
 	}
 
 	for _, input := range inputs {
@@ -248,6 +253,63 @@ func TestRollupOpEqual(t *testing.T) {
 	}
 }
 
+func TestNewRollupOpWithDistinctTag(t *testing.T) {
+	countDistinct := aggregation.MustCompressTypes(aggregation.Sum, aggregation.CountDistinct)
+	inputs := []struct {
+		rollupType  RollupType
+		tags        []string
+		aggID       aggregation.ID
+		distinctTag string
+		expectedErr bool
+	}{
+		{rollupType: GroupByRollupType, tags: []string{"endpoint"}, aggID: countDistinct, distinctTag: "user"},
+		{rollupType: ExcludeByRollupType, tags: []string{"user"}, aggID: countDistinct, distinctTag: "user"},
+		{rollupType: GroupByRollupType, tags: []string{"endpoint"}, aggID: aggregation.DefaultID},
+		{
+			rollupType:  GroupByRollupType,
+			tags:        []string{"endpoint"},
+			aggID:       aggregation.MustCompressTypes(aggregation.Sum),
+			distinctTag: "user",
+			expectedErr: true,
+		},
+		{
+			rollupType:  GroupByRollupType,
+			tags:        []string{"endpoint", "user"},
+			aggID:       countDistinct,
+			distinctTag: "user",
+			expectedErr: true,
+		},
+		{
+			rollupType:  ExcludeByRollupType,
+			tags:        []string{"endpoint"},
+			aggID:       countDistinct,
+			distinctTag: "user",
+			expectedErr: true,
+		},
+	}
+	for _, input := range inputs {
+		op, err := NewRollupOpWithDistinctTag(input.rollupType, "foo", input.tags, input.aggID, input.distinctTag)
+		if input.expectedErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		if input.distinctTag == "" {
+			require.Nil(t, op.DistinctTag)
+		} else {
+			require.Equal(t, b(input.distinctTag), op.DistinctTag)
+		}
+
+		pb, err := op.Proto()
+		require.NoError(t, err)
+		require.Equal(t, input.distinctTag, pb.DistinctTag)
+		fromProto, err := NewRollupOpFromProto(pb)
+		require.NoError(t, err)
+		require.True(t, op.Equal(fromProto))
+		require.True(t, op.Equal(op.Clone()))
+	}
+}
+
 func TestRollupOpSameTransform(t *testing.T) {
 	rollupOp := RollupOp{
 		newName: b("foo"),
@@ -464,6 +526,13 @@ func TestPipelineUnmarshalYAML(t *testing.T) {
     tags:
       - tag3
       - tag4
+- rollup:
+    newName: testRollup3
+    tags:
+      - tag5
+    aggregation:
+      - CountDistinct
+    distinctTag: tag6
 `
 
 	var pipeline Pipeline
@@ -494,6 +563,15 @@ func TestPipelineUnmarshalYAML(t *testing.T) {
 				AggregationID: aggregation.DefaultID,
 			},
 		},
+		{
+			Type: RollupOpType,
+			Rollup: RollupOp{
+				newName:       b("testRollup3"),
+				Tags:          bs("tag5"),
+				AggregationID: aggregation.MustCompressTypes(aggregation.CountDistinct),
+				DistinctTag:   b("tag6"),
+			},
+		},
 	})
 	require.Equal(t, expected, pipeline)
 }
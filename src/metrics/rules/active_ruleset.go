@@ -251,8 +251,9 @@ func (as *activeRuleSet) forwardMatchAt(
 	for _, idWithMatchResult := range rollupResults.forNewRollupIDs {
 		stagedMetadata := idWithMatchResult.matchResults.unique().toStagedMetadata()
 		newIDWithMetadatas := IDWithMetadatas{
-			ID:        idWithMatchResult.id,
-			Metadatas: metadata.StagedMetadatas{stagedMetadata},
+			ID:            idWithMatchResult.id,
+			DistinctValue: idWithMatchResult.distinctValue,
+			Metadatas:     metadata.StagedMetadatas{stagedMetadata},
 		}
 		forNewRollupIDs = append(forNewRollupIDs, newIDWithMetadatas)
 	}
@@ -435,6 +436,7 @@ func (as *activeRuleSet) toRollupResults(
 		var (
 			aggregationID aggregation.ID
 			rollupID      []byte
+			distinctValue []byte
 			numSteps      = pipeline.Len()
 			firstOp       = pipeline.At(0)
 			toApply       mpipeline.Pipeline
@@ -469,6 +471,17 @@ func (as *activeRuleSet) toRollupResults(
 				// The incoming metric ID did not match the rollup target.
 				continue
 			}
+			if distinctTag := firstOp.Rollup.DistinctTag; len(distinctTag) > 0 {
+				distinctValue, matched, err = as.tagValue(sortedTagPairBytes, distinctTag, matchOpts)
+				if err != nil {
+					multiErr = multiErr.Add(err)
+					continue
+				}
+				if !matched {
+					// The incoming metric ID has no value to count for the rollup target.
+					continue
+				}
+			}
 			aggregationID = firstOp.Rollup.AggregationID
 			toApply = pipeline.SubPipeline(1, numSteps)
 		default:
@@ -501,7 +514,11 @@ func (as *activeRuleSet) toRollupResults(
 				cutoverNanos: cutoverNanos,
 				pipelines:    []metadata.PipelineMetadata{newPipeline},
 			}
-			newRollupIDResult := idWithMatchResults{id: rollupID, matchResults: matchResults}
+			newRollupIDResult := idWithMatchResults{
+				id:            rollupID,
+				distinctValue: distinctValue,
+				matchResults:  matchResults,
+			}
 			newRollupIDResults = append(newRollupIDResults, newRollupIDResult)
 		}
 	}
@@ -652,6 +669,25 @@ func (as *activeRuleSet) matchRollupTarget(
 	return as.newRollupIDFn(newName, tagPairs), true, nil
 }
 
+// tagValue returns a copy of the value of a tag of an incoming metric ID, and
+// false if the metric ID does not have the tag.
+func (as *activeRuleSet) tagValue(
+	sortedTagPairBytes []byte,
+	tagName []byte,
+	matchOpts MatchOptions,
+) ([]byte, bool, error) {
+	sortedTagIter := matchOpts.SortedTagIteratorFn(sortedTagPairBytes)
+	for sortedTagIter.Next() {
+		name, value := sortedTagIter.Current()
+		if res := bytes.Compare(name, tagName); res == 0 {
+			return append([]byte(nil), value...), true, nil
+		} else if res > 0 {
+			break
+		}
+	}
+	return nil, false, sortedTagIter.Err()
+}
+
 func (as *activeRuleSet) applyIDToPipeline(
 	sortedTagPairBytes []byte,
 	pipeline mpipeline.Pipeline,
@@ -955,6 +991,9 @@ func mergeResultsForNewRollupIDs(
 		// to the end of the metadata list.
 		if compareResult == 0 {
 			currResults[currIdx].Metadatas = append(currResults[currIdx].Metadatas, nextResults[nextIdx].Metadatas[0])
+			if currResults[currIdx].DistinctValue == nil {
+				currResults[currIdx].DistinctValue = nextResults[nextIdx].DistinctValue
+			}
 			currIdx++
 			nextIdx++
 			continue
@@ -1047,8 +1086,9 @@ func (res *ruleMatchResults) resolvedPipelines() []metadata.PipelineMetadata {
 }
 
 type idWithMatchResults struct {
-	id           []byte
-	matchResults ruleMatchResults
+	id            []byte
+	distinctValue []byte
+	matchResults  ruleMatchResults
 }
 
 type mappingResults struct {
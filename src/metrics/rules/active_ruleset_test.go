@@ -629,6 +629,84 @@ func TestActiveRuleSetForwardMatchWithAnyKeepOriginal(t *testing.T) {
 	}
 }
 
+func TestActiveRuleSetForwardMatchWithDistinctTag(t *testing.T) {
+	filter, err := filters.NewTagsFilter(
+		filters.TagFilterValueMap{
+			"rtagName1": filters.FilterValue{Pattern: "rtagValue1"},
+		},
+		filters.Conjunction,
+		testTagsFilterOptions(),
+	)
+	require.NoError(t, err)
+	rr, err := pipeline.NewRollupOpWithDistinctTag(
+		pipeline.GroupByRollupType,
+		"rName1",
+		[]string{"rtagName1"},
+		aggregation.MustCompressTypes(aggregation.CountDistinct),
+		"rtagName2",
+	)
+	require.NoError(t, err)
+	rollupRules := []*rollupRule{
+		{
+			uuid: "rollupRule1",
+			snapshots: []*rollupRuleSnapshot{
+				{
+					name:   "rollupRule1.snapshot",
+					filter: filter,
+					targets: []rollupTarget{
+						{
+							Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+								{
+									Type:   pipeline.RollupOpType,
+									Rollup: rr,
+								},
+							}),
+							StoragePolicies: policy.StoragePolicies{
+								policy.NewStoragePolicy(10*time.Second, xtime.Second, 24*time.Hour),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	as := newActiveRuleSet(
+		0,
+		nil,
+		rollupRules,
+		testTagsFilterOptions(),
+		mockNewID,
+		nil,
+		testIncludeTagKeys(),
+	)
+
+	inputs := []struct {
+		id            string
+		distinctValue []byte
+	}{
+		{id: "rtagName1=rtagValue1,rtagName2=rtagValue2,rtagName3=rtagValue3", distinctValue: []byte("rtagValue2")},
+		{id: "rtagName1=rtagValue1,rtagName2=rtagValue4", distinctValue: []byte("rtagValue4")},
+		// Metrics without the distinct tag have no value to count.
+		{id: "rtagName1=rtagValue1,rtagName3=rtagValue3"},
+	}
+	for _, input := range inputs {
+		input := input
+		t.Run(input.id, func(t *testing.T) {
+			matchInput := testMatchInput{id: input.id, matchFrom: 0, matchTo: 1}
+			res, err := as.ForwardMatch(matchInput.ID(), matchInput.matchFrom, matchInput.matchTo, testMatchOptions())
+			require.NoError(t, err)
+			if input.distinctValue == nil {
+				require.Equal(t, 0, res.NumNewRollupIDs())
+				return
+			}
+			require.Equal(t, 1, res.NumNewRollupIDs())
+			rollup := res.ForNewRollupIDsAt(0, 0)
+			require.Equal(t, "rName1|rtagName1=rtagValue1", string(rollup.ID))
+			require.Equal(t, input.distinctValue, rollup.DistinctValue)
+		})
+	}
+}
+
 //nolint:dupl
 func TestActiveRuleSetForwardMatchWithRollupRules(t *testing.T) {
 	inputs := []testMatchInput{
@@ -41,8 +41,12 @@ var (
 
 // IDWithMetadatas is a pair of metric ID and the associated staged metadatas.
 type IDWithMetadatas struct {
-	ID        []byte
-	Metadatas metadata.StagedMetadatas
+	ID []byte
+	// DistinctValue is the value of the distinct tag of the rollup in the
+	// incoming metric ID, set when the rollup counts the distinct values of
+	// a tag.
+	DistinctValue []byte
+	Metadatas     metadata.StagedMetadatas
 }
 
 // IDWithMetadatasByIDAsc sorts a list of ID with metadatas by metric ID in ascending order.
@@ -115,7 +119,11 @@ func (r *MatchResult) ForExistingIDAt(timeNanos int64) metadata.StagedMetadatas
 func (r *MatchResult) ForNewRollupIDsAt(idx int, timeNanos int64) IDWithMetadatas {
 	forNewRollupID := r.forNewRollupIDs[idx]
 	metadatas := activeStagedMetadatasAt(forNewRollupID.Metadatas, timeNanos)
-	return IDWithMetadatas{ID: forNewRollupID.ID, Metadatas: metadatas}
+	return IDWithMetadatas{
+		ID:            forNewRollupID.ID,
+		DistinctValue: forNewRollupID.DistinctValue,
+		Metadatas:     metadatas,
+	}
 }
 
 // KeepOriginal returns true if the original source metric for a rollup rule
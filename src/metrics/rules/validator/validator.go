@@ -42,6 +42,8 @@ var (
 	errMoreThanOneAggregationOpInPipeline = errors.New("more than one aggregation operation in pipeline")
 	errAggregationOpNotFirstInPipeline    = errors.New("aggregation operation is not the first operation in pipeline")
 	errNoRollupOpInPipeline               = errors.New("no rollup operation in pipeline")
+	errDistinctTagNotFirstInPipeline      = errors.New("rollup operation with a distinct tag is not the first operation in pipeline")
+	errCountDistinctWithoutDistinctTag    = errors.New("count distinct aggregation without a distinct tag")
 )
 
 type validator struct {
@@ -294,6 +296,7 @@ func (v *validator) validatePipeline(pipeline mpipeline.Pipeline, types []metric
 		transformationDerivativeOrder int
 		numRollupOps                  int
 		previousRollupTags            map[string]struct{}
+		hasDistinctTag                bool
 		numPipelineOps                = pipeline.Len()
 	)
 	for i := 0; i < numPipelineOps; i++ {
@@ -310,6 +313,9 @@ func (v *validator) validatePipeline(pipeline mpipeline.Pipeline, types []metric
 			if err := v.validateAggregationOp(pipelineOp.Aggregation, types); err != nil {
 				return fmt.Errorf("invalid aggregation operation at index %d: %v", i, err)
 			}
+			if pipelineOp.Aggregation.Type == aggregation.CountDistinct {
+				return fmt.Errorf("invalid aggregation operation at index %d: %v", i, errCountDistinctWithoutDistinctTag)
+			}
 		case mpipeline.TransformationOpType:
 			transformOp := pipelineOp.Transformation
 			if transformOp.Type.IsBinaryTransform() {
@@ -333,6 +339,18 @@ func (v *validator) validatePipeline(pipeline mpipeline.Pipeline, types []metric
 			if err := v.validateRollupOp(pipelineOp.Rollup, i, types, previousRollupTags); err != nil {
 				return fmt.Errorf("invalid rollup operation at index %d: %v", i, err)
 			}
+			// The distinct values are only known when the metric is matched, i.e. when
+			// applying the first operation of the pipeline, and are then carried by the
+			// aggregations of the rollup operations that follow it.
+			if len(pipelineOp.Rollup.DistinctTag) > 0 {
+				if i != 0 {
+					return fmt.Errorf("invalid rollup operation at index %d: %v", i, errDistinctTagNotFirstInPipeline)
+				}
+				hasDistinctTag = true
+			}
+			if !hasDistinctTag && pipelineOp.Rollup.AggregationID.Contains(aggregation.CountDistinct) {
+				return fmt.Errorf("invalid rollup operation at index %d: %v", i, errCountDistinctWithoutDistinctTag)
+			}
 			previousRollupTags = make(map[string]struct{}, len(pipelineOp.Rollup.Tags))
 			for _, tag := range pipelineOp.Rollup.Tags {
 				previousRollupTags[string(tag)] = struct{}{}
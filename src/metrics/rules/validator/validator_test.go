@@ -959,6 +959,101 @@ func TestValidatorValidateRollupRulePipelineMultiLevelRollup(t *testing.T) {
 	require.NoError(t, validator.ValidateSnapshot(view))
 }
 
+func TestValidatorValidateRollupRulePipelineCountDistinct(t *testing.T) {
+	countDistinct := aggregation.MustCompressTypes(aggregation.CountDistinct)
+	distinctRollup, err := pipeline.NewRollupOpWithDistinctTag(
+		pipeline.GroupByRollupType,
+		"rName1",
+		[]string{"rtagName1", "rtagName2"},
+		countDistinct,
+		"rtagName3",
+	)
+	require.NoError(t, err)
+	mergeRollup, err := pipeline.NewRollupOp(
+		pipeline.GroupByRollupType,
+		"rName2",
+		[]string{"rtagName1"},
+		countDistinct,
+	)
+	require.NoError(t, err)
+	nonFirstDistinctRollup, err := pipeline.NewRollupOpWithDistinctTag(
+		pipeline.GroupByRollupType,
+		"rName2",
+		[]string{"rtagName1"},
+		countDistinct,
+		"rtagName2",
+	)
+	require.NoError(t, err)
+
+	rollupOp := func(rollup pipeline.RollupOp) pipeline.OpUnion {
+		return pipeline.OpUnion{Type: pipeline.RollupOpType, Rollup: rollup}
+	}
+	tests := []struct {
+		name        string
+		ops         []pipeline.OpUnion
+		expectedErr error
+	}{
+		{
+			name: "distinct rollup",
+			ops:  []pipeline.OpUnion{rollupOp(distinctRollup)},
+		},
+		{
+			name: "merged by next rollup",
+			ops:  []pipeline.OpUnion{rollupOp(distinctRollup), rollupOp(mergeRollup)},
+		},
+		{
+			name:        "without distinct tag",
+			ops:         []pipeline.OpUnion{rollupOp(mergeRollup)},
+			expectedErr: errCountDistinctWithoutDistinctTag,
+		},
+		{
+			name:        "distinct tag not first",
+			ops:         []pipeline.OpUnion{rollupOp(distinctRollup), rollupOp(nonFirstDistinctRollup)},
+			expectedErr: errDistinctTagNotFirstInPipeline,
+		},
+		{
+			name: "aggregation operation",
+			ops: []pipeline.OpUnion{
+				{
+					Type:        pipeline.AggregationOpType,
+					Aggregation: pipeline.AggregationOp{Type: aggregation.CountDistinct},
+				},
+				rollupOp(mergeRollup),
+			},
+			expectedErr: errCountDistinctWithoutDistinctTag,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			view := view.RuleSet{
+				RollupRules: []view.RollupRule{
+					{
+						Name:   "snapshot1",
+						Filter: testTypeTag + ":" + testCounterType,
+						Targets: []view.RollupTarget{
+							{
+								Pipeline:        pipeline.NewPipeline(test.ops),
+								StoragePolicies: testStoragePolicies(),
+							},
+						},
+					},
+				},
+			}
+			opts := testValidatorOptions().
+				SetMaxRollupLevels(2).
+				SetDefaultAllowedFirstLevelAggregationTypes(aggregation.Types{aggregation.CountDistinct}).
+				SetDefaultAllowedNonFirstLevelAggregationTypes(aggregation.Types{aggregation.CountDistinct})
+			err := NewValidator(opts).ValidateSnapshot(view)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), test.expectedErr.Error()), err.Error())
+		})
+	}
+}
+
 func TestValidatorValidateRollupRuleRollupOpDuplicateRollupTag(t *testing.T) {
 	rr1, err := pipeline.NewRollupOp(
 		pipeline.GroupByRollupType,
@@ -0,0 +1,778 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/msg/producer"
+)
+
+type durableBufferMetrics struct {
+	messageTooLarge     tally.Counter
+	messagePersisted    tally.Counter
+	messageSpilled      tally.Counter
+	messageReplayed     tally.Counter
+	messageRecovered    tally.Counter
+	messageDropped      tally.Counter
+	byteDropped         tally.Counter
+	diskFull            tally.Counter
+	writeErrors         tally.Counter
+	readErrors          tally.Counter
+	syncErrors          tally.Counter
+	corruptSegments     tally.Counter
+	segmentsRemoved     tally.Counter
+	byteOnDisk          tally.Gauge
+	segmentsOnDisk      tally.Gauge
+	byteBuffered        tally.Gauge
+	replayPendingBytes  tally.Gauge
+	recoveredBytes      tally.Gauge
+	replayProgressRatio tally.Gauge
+}
+
+func newDurableBufferMetrics(scope tally.Scope) durableBufferMetrics {
+	return durableBufferMetrics{
+		messageTooLarge:     scope.Counter("message-too-large"),
+		messagePersisted:    scope.Counter("message-persisted"),
+		messageSpilled:      scope.Counter("message-spilled"),
+		messageReplayed:     scope.Counter("message-replayed"),
+		messageRecovered:    scope.Counter("message-recovered"),
+		messageDropped:      scope.Counter("message-dropped"),
+		byteDropped:         scope.Counter("byte-dropped"),
+		diskFull:            scope.Counter("disk-full"),
+		writeErrors:         scope.Counter("write-errors"),
+		readErrors:          scope.Counter("read-errors"),
+		syncErrors:          scope.Counter("sync-errors"),
+		corruptSegments:     scope.Counter("corrupt-segments"),
+		segmentsRemoved:     scope.Counter("segments-removed"),
+		byteOnDisk:          scope.Gauge("byte-on-disk"),
+		segmentsOnDisk:      scope.Gauge("segments-on-disk"),
+		byteBuffered:        scope.Gauge("byte-buffered"),
+		replayPendingBytes:  scope.Gauge("replay-pending-bytes"),
+		recoveredBytes:      scope.Gauge("recovered-bytes"),
+		replayProgressRatio: scope.Gauge("replay-progress-ratio"),
+	}
+}
+
+// segment is a segment file of the durable buffer.
+type segment struct {
+	seq  uint64
+	path string
+	// size is the size of the segment file.
+	size int64
+	// sealed is true once no more records are appended to the segment.
+	sealed bool
+	// retained is true if the segment holds messages dropped when the buffer
+	// was closed, which must be replayed after a restart.
+	retained bool
+	// loaded are the messages of the segment loaded in memory and not yet
+	// finalized.
+	loaded map[*producer.RefCountedMessage]struct{}
+}
+
+// durableMessage is a message of the durable buffer, it tracks the segment
+// holding the message so that the segment is removed once all its messages
+// are finalized.
+type durableMessage struct {
+	producer.Message
+
+	buffer  *durableBuffer
+	segment *segment
+	rm      *producer.RefCountedMessage
+}
+
+func (m *durableMessage) Finalize(r producer.FinalizeReason) {
+	m.buffer.onFinalize(m, r)
+	m.Message.Finalize(r)
+}
+
+// nolint: maligned
+type durableBuffer struct {
+	sync.Mutex
+
+	opts           DurableOptions
+	bufferOpts     Options
+	logger         *zap.Logger
+	maxMemorySize  uint64
+	maxMessageSize int
+	maxDiskSize    int64
+	maxSegmentSize int64
+	m              durableBufferMetrics
+
+	// segments are the segment files from the oldest to the newest, the newest
+	// is the active segment records are appended to.
+	segments []*segment
+	activeFd *os.File
+	nextSeq  uint64
+	dirty    bool
+	// The cursor is the position of the oldest record not yet loaded in memory,
+	// the records from the cursor onwards are the replay backlog.
+	cursor       *segment
+	cursorOffset int64
+	cursorFd     *os.File
+	backlogSize  int64
+	// recoveredSize is the size of the records recovered on startup.
+	recoveredSize int64
+	diskSize      int64
+	memorySize    uint64
+	recordBuf     []byte
+	readBuf       []byte
+
+	writeFn   producer.WriteFn
+	isClosed  bool
+	replayCh  chan struct{}
+	doneCh    chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewDurableBuffer returns a new buffer persisting the messages to segment
+// files, the messages not consumed before the buffer was closed are recovered
+// from the segment files in the directory of the buffer.
+func NewDurableBuffer(opts DurableOptions) (producer.DurableBuffer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	bufferOpts := opts.BufferOptions()
+	iOpts := bufferOpts.InstrumentOptions()
+	b := &durableBuffer{
+		opts:           opts,
+		bufferOpts:     bufferOpts,
+		logger:         iOpts.Logger(),
+		maxMemorySize:  uint64(bufferOpts.MaxBufferSize()),
+		maxMessageSize: bufferOpts.MaxMessageSize(),
+		maxDiskSize:    opts.MaxDiskSize(),
+		maxSegmentSize: opts.MaxSegmentSize(),
+		m:              newDurableBufferMetrics(iOpts.MetricsScope()),
+		replayCh:       make(chan struct{}, 1),
+		doneCh:         make(chan struct{}),
+	}
+	if err := b.recover(); err != nil {
+		return nil, err
+	}
+	if err := b.rollSegmentWithLock(); err != nil {
+		return nil, err
+	}
+	b.updateGaugesWithLock()
+	return b, nil
+}
+
+// recover recovers the segment files written before a restart, all their
+// records make up the replay backlog.
+func (b *durableBuffer) recover() error {
+	dir := b.opts.Path()
+	if err := os.MkdirAll(dir, b.opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+	seqs, err := listSegmentFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, seq := range seqs {
+		b.nextSeq = seq + 1
+		path := segmentFilePath(dir, seq)
+		size, numRecords, err := b.recoverSegmentFile(path)
+		if err != nil {
+			return err
+		}
+		if numRecords == 0 {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		seg := &segment{
+			seq:    seq,
+			path:   path,
+			size:   size,
+			sealed: true,
+			loaded: make(map[*producer.RefCountedMessage]struct{}),
+		}
+		b.segments = append(b.segments, seg)
+		b.diskSize += size
+		b.backlogSize += size - int64(segmentHeaderLen)
+		b.m.messageRecovered.Inc(int64(numRecords))
+	}
+	b.recoveredSize = b.backlogSize
+	if len(b.segments) > 0 {
+		b.cursor = b.segments[0]
+		b.cursorOffset = int64(segmentHeaderLen)
+		b.logger.Info("recovered durable buffer segments",
+			zap.Int("segments", len(b.segments)),
+			zap.Int64("bytes", b.backlogSize))
+	}
+	return nil
+}
+
+// recoverSegmentFile validates a segment file, truncating it after its last
+// valid record. It returns the size of the segment file and its number of
+// records.
+func (b *durableBuffer) recoverSegmentFile(path string) (int64, int, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, b.opts.NewFileMode())
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close() // nolint: errcheck
+
+	size, numRecords, err := scanSegmentFile(f, b.maxMessageSize)
+	if err == nil {
+		return size, numRecords, nil
+	}
+	if !isSegmentCorruption(err) {
+		return 0, 0, err
+	}
+	b.m.corruptSegments.Inc(1)
+	b.logger.Warn("truncating corrupt durable buffer segment",
+		zap.String("path", path),
+		zap.Int64("validSize", size),
+		zap.Int("validRecords", numRecords),
+		zap.Error(err))
+	if size == 0 {
+		// The header of the segment is invalid, none of its records are recovered.
+		return 0, 0, nil
+	}
+	if err := f.Truncate(size); err != nil {
+		return 0, 0, err
+	}
+	return size, numRecords, f.Sync()
+}
+
+func isSegmentCorruption(err error) bool {
+	return errors.Is(err, errSegmentInvalidHeader) ||
+		errors.Is(err, errSegmentChecksumMismatch) ||
+		errors.Is(err, errSegmentRecordTruncated) ||
+		errors.Is(err, errSegmentRecordInvalidSize)
+}
+
+// rollSegmentWithLock seals the active segment and starts a new one.
+func (b *durableBuffer) rollSegmentWithLock() error {
+	seg := &segment{
+		seq:    b.nextSeq,
+		path:   segmentFilePath(b.opts.Path(), b.nextSeq),
+		size:   int64(segmentHeaderLen),
+		loaded: make(map[*producer.RefCountedMessage]struct{}),
+	}
+	fd, err := os.OpenFile(seg.path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, b.opts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(appendSegmentHeader(nil)); err != nil {
+		_ = fd.Close()
+		_ = os.Remove(seg.path)
+		return err
+	}
+	b.nextSeq++
+
+	if b.activeFd != nil {
+		if err := b.activeFd.Sync(); err != nil {
+			b.m.syncErrors.Inc(1)
+		}
+		if err := b.activeFd.Close(); err != nil {
+			b.m.writeErrors.Inc(1)
+		}
+		active := b.segments[len(b.segments)-1]
+		active.sealed = true
+		defer b.maybeRemoveSegmentWithLock(active)
+	}
+	b.activeFd = fd
+	b.dirty = true
+	b.segments = append(b.segments, seg)
+	b.diskSize += seg.size
+	if b.backlogSize == 0 {
+		b.moveCursorWithLock(seg, seg.size)
+	}
+	return nil
+}
+
+func (b *durableBuffer) activeSegment() *segment {
+	return b.segments[len(b.segments)-1]
+}
+
+func (b *durableBuffer) moveCursorWithLock(seg *segment, offset int64) {
+	if b.cursor != seg && b.cursorFd != nil {
+		_ = b.cursorFd.Close()
+		b.cursorFd = nil
+	}
+	b.cursor = seg
+	b.cursorOffset = offset
+}
+
+func (b *durableBuffer) Add(m producer.Message) (*producer.RefCountedMessage, error) {
+	s := m.Size()
+	if s > b.maxMessageSize {
+		b.m.messageTooLarge.Inc(1)
+		return nil, errMessageTooLarge
+	}
+	b.Lock()
+	rm, dropped, err := b.addWithLock(m)
+	b.Unlock()
+
+	// The messages dropped to make room on disk are dropped once the lock is
+	// released, as they are finalized by the buffer.
+	for _, rm := range dropped {
+		if rm.Drop() {
+			b.m.messageDropped.Inc(1)
+			b.m.byteDropped.Inc(int64(rm.Size()))
+		}
+	}
+	return rm, err
+}
+
+func (b *durableBuffer) addWithLock(
+	m producer.Message,
+) (*producer.RefCountedMessage, []*producer.RefCountedMessage, error) {
+	if b.isClosed {
+		return nil, nil, errBufferClosed
+	}
+	value := m.Bytes()
+	recordLen := segmentRecordLen(value)
+	dropped, err := b.makeRoomWithLock(recordLen)
+	if err != nil {
+		return nil, dropped, err
+	}
+	active := b.activeSegment()
+	if b.needsRollWithLock(recordLen) {
+		if err := b.rollSegmentWithLock(); err != nil {
+			b.m.writeErrors.Inc(1)
+			return nil, dropped, err
+		}
+		active = b.activeSegment()
+	}
+
+	b.recordBuf = appendSegmentRecord(b.recordBuf[:0], m.Shard(), value)
+	if _, err := b.activeFd.Write(b.recordBuf); err != nil {
+		// The record may have been partially written, the segment is sealed
+		// so that no record is appended after it.
+		b.m.writeErrors.Inc(1)
+		if rollErr := b.rollSegmentWithLock(); rollErr != nil {
+			b.logger.Error("could not roll durable buffer segment", zap.Error(rollErr))
+		}
+		return nil, dropped, err
+	}
+	b.m.messagePersisted.Inc(1)
+	active.size += recordLen
+	b.diskSize += recordLen
+	b.dirty = true
+	if b.opts.SyncInterval() == 0 {
+		b.syncWithLock()
+	}
+
+	if b.backlogSize > 0 || b.memorySize+uint64(m.Size()) > b.maxMemorySize {
+		// The message is replayed once the messages before it are replayed
+		// and there is room for it in memory.
+		b.backlogSize += recordLen
+		b.m.messageSpilled.Inc(1)
+		b.signalReplay()
+		return nil, dropped, nil
+	}
+	if prev := b.cursor; prev != active {
+		b.moveCursorWithLock(active, active.size)
+		b.maybeRemoveSegmentWithLock(prev)
+	} else {
+		b.cursorOffset = active.size
+	}
+	return b.loadWithLock(m, active), dropped, nil
+}
+
+// makeRoomWithLock makes room on disk for a record, according to the on full
+// strategy of the buffer. It returns the messages loaded in memory that must
+// be dropped.
+func (b *durableBuffer) makeRoomWithLock(recordLen int64) ([]*producer.RefCountedMessage, error) {
+	var dropped []*producer.RefCountedMessage
+	for {
+		needed := recordLen
+		if b.needsRollWithLock(recordLen) {
+			needed += int64(segmentHeaderLen)
+		}
+		if b.diskSize+needed <= b.maxDiskSize {
+			break
+		}
+		if b.bufferOpts.OnFullStrategy() == ReturnError {
+			b.m.diskFull.Inc(1)
+			return dropped, ErrBufferFull
+		}
+		if len(b.segments) == 1 {
+			// The oldest segment is the active segment, a new segment is
+			// started so that the oldest one can be dropped.
+			if err := b.rollSegmentWithLock(); err != nil {
+				b.m.writeErrors.Inc(1)
+				return dropped, err
+			}
+		}
+		dropped = b.dropOldestSegmentWithLock(dropped)
+	}
+	return dropped, nil
+}
+
+// needsRollWithLock returns true if a new segment must be started before
+// appending a record.
+func (b *durableBuffer) needsRollWithLock(recordLen int64) bool {
+	active := b.activeSegment()
+	return active.size+recordLen > b.maxSegmentSize && active.size > int64(segmentHeaderLen)
+}
+
+// dropOldestSegmentWithLock removes the oldest segment, dropping the records
+// not loaded in memory and appending the messages loaded in memory to the
+// messages to drop.
+func (b *durableBuffer) dropOldestSegmentWithLock(
+	dropped []*producer.RefCountedMessage,
+) []*producer.RefCountedMessage {
+	oldest := b.segments[0]
+	for rm := range oldest.loaded {
+		dropped = append(dropped, rm)
+	}
+	if b.cursor == oldest {
+		notLoaded := oldest.size - b.cursorOffset
+		if notLoaded > 0 {
+			b.m.byteDropped.Inc(notLoaded)
+		}
+		b.backlogSize -= notLoaded
+		b.moveCursorWithLock(b.segments[1], int64(segmentHeaderLen))
+	}
+	// The messages of the segment are no longer tracked once it is removed.
+	oldest.loaded = nil
+	b.removeSegmentWithLock(oldest)
+	return dropped
+}
+
+func (b *durableBuffer) loadWithLock(m producer.Message, seg *segment) *producer.RefCountedMessage {
+	dm := &durableMessage{
+		Message: m,
+		buffer:  b,
+		segment: seg,
+	}
+	rm := producer.NewRefCountedMessage(dm, nil)
+	dm.rm = rm
+	seg.loaded[rm] = struct{}{}
+	b.memorySize += rm.Size()
+	return rm
+}
+
+func (b *durableBuffer) onFinalize(m *durableMessage, r producer.FinalizeReason) {
+	b.Lock()
+	b.memorySize -= m.rm.Size()
+	seg := m.segment
+	if seg.loaded != nil {
+		delete(seg.loaded, m.rm)
+		if b.isClosed && r == producer.Dropped {
+			// The message was not consumed before the buffer was closed, it
+			// is replayed after a restart.
+			seg.retained = true
+		}
+		b.maybeRemoveSegmentWithLock(seg)
+	}
+	b.Unlock()
+	b.signalReplay()
+}
+
+// maybeRemoveSegmentWithLock removes the segment if all its messages were
+// loaded in memory and finalized, which is the case once the cursor is past
+// the segment and none of its loaded messages remain.
+func (b *durableBuffer) maybeRemoveSegmentWithLock(seg *segment) {
+	if !seg.sealed || seg.retained || len(seg.loaded) > 0 || seg.seq >= b.cursor.seq {
+		return
+	}
+	for _, s := range b.segments {
+		if s == seg {
+			b.removeSegmentWithLock(seg)
+			return
+		}
+	}
+}
+
+func (b *durableBuffer) removeSegmentWithLock(seg *segment) {
+	for i, s := range b.segments {
+		if s == seg {
+			b.segments = append(b.segments[:i], b.segments[i+1:]...)
+			break
+		}
+	}
+	b.diskSize -= seg.size
+	if err := os.Remove(seg.path); err != nil {
+		b.logger.Error("could not remove durable buffer segment",
+			zap.String("path", seg.path), zap.Error(err))
+		return
+	}
+	b.m.segmentsRemoved.Inc(1)
+}
+
+func (b *durableBuffer) signalReplay() {
+	select {
+	case b.replayCh <- struct{}{}:
+	default:
+	}
+}
+
+func (b *durableBuffer) Init() {
+	b.wg.Add(1)
+	go func() {
+		b.syncUntilClose()
+		b.wg.Done()
+	}()
+}
+
+func (b *durableBuffer) syncUntilClose() {
+	interval := b.opts.SyncInterval()
+	if interval == 0 {
+		// Segments are synced on every write, gauges are still updated periodically.
+		interval = defaultSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.Lock()
+			b.syncWithLock()
+			b.updateGaugesWithLock()
+			b.Unlock()
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+func (b *durableBuffer) syncWithLock() {
+	if !b.dirty || b.activeFd == nil {
+		return
+	}
+	if err := b.activeFd.Sync(); err != nil {
+		b.m.syncErrors.Inc(1)
+		return
+	}
+	b.dirty = false
+}
+
+func (b *durableBuffer) updateGaugesWithLock() {
+	b.m.byteOnDisk.Update(float64(b.diskSize))
+	b.m.segmentsOnDisk.Update(float64(len(b.segments)))
+	b.m.byteBuffered.Update(float64(b.memorySize))
+	b.m.replayPendingBytes.Update(float64(b.backlogSize))
+	b.m.recoveredBytes.Update(float64(b.recoveredSize))
+	progress := 1.0
+	if b.recoveredSize > 0 && b.backlogSize < b.recoveredSize {
+		progress = 1 - float64(b.backlogSize)/float64(b.recoveredSize)
+	} else if b.recoveredSize > 0 {
+		progress = 0
+	}
+	b.m.replayProgressRatio.Update(progress)
+}
+
+func (b *durableBuffer) Replay(fn producer.WriteFn) {
+	b.Lock()
+	if b.isClosed || b.writeFn != nil {
+		b.Unlock()
+		return
+	}
+	b.writeFn = fn
+	b.Unlock()
+
+	b.wg.Add(1)
+	go func() {
+		b.replayUntilClose()
+		b.wg.Done()
+	}()
+	b.signalReplay()
+}
+
+func (b *durableBuffer) replayUntilClose() {
+	for {
+		select {
+		case <-b.replayCh:
+			b.replay()
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+// replay loads the records of the backlog in memory and writes them out until
+// the backlog is empty or there is no more room in memory.
+func (b *durableBuffer) replay() {
+	for {
+		rm, ok := b.nextReplayed()
+		if !ok {
+			return
+		}
+		b.m.messageReplayed.Inc(1)
+		if err := b.writeFn(rm); err != nil {
+			b.logger.Error("could not write replayed message", zap.Error(err))
+		}
+	}
+}
+
+func (b *durableBuffer) nextReplayed() (*producer.RefCountedMessage, bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	for !b.isClosed && b.backlogSize > 0 {
+		seg := b.cursor
+		if b.cursorOffset >= seg.size {
+			if seg == b.activeSegment() {
+				// There are no more records to replay.
+				b.backlogSize = 0
+				break
+			}
+			b.advanceCursorWithLock()
+			continue
+		}
+		if b.cursorFd == nil {
+			fd, err := os.Open(seg.path)
+			if err != nil {
+				b.m.readErrors.Inc(1)
+				b.logger.Error("could not open durable buffer segment",
+					zap.String("path", seg.path), zap.Error(err))
+				b.skipCursorSegmentWithLock()
+				continue
+			}
+			b.cursorFd = fd
+		}
+		var (
+			shard uint32
+			value []byte
+			err   error
+		)
+		shard, value, b.readBuf, err = readSegmentRecord(b.cursorFd, b.cursorOffset, seg.size, b.readBuf)
+		if err != nil {
+			b.m.readErrors.Inc(1)
+			b.logger.Error("could not read durable buffer segment",
+				zap.String("path", seg.path), zap.Error(err))
+			b.skipCursorSegmentWithLock()
+			continue
+		}
+		if b.memorySize > 0 && b.memorySize+uint64(len(value)) > b.maxMemorySize {
+			return nil, false
+		}
+		m := &recoveredMessage{
+			shard: shard,
+			value: append([]byte(nil), value...),
+		}
+		recordLen := segmentRecordLen(value)
+		b.cursorOffset += recordLen
+		b.backlogSize -= recordLen
+		rm := b.loadWithLock(m, seg)
+		if b.cursorOffset >= seg.size && seg != b.activeSegment() {
+			b.advanceCursorWithLock()
+		}
+		return rm, true
+	}
+	return nil, false
+}
+
+// advanceCursorWithLock moves the cursor to the next segment, removing the
+// segment it leaves if all its messages were finalized.
+func (b *durableBuffer) advanceCursorWithLock() {
+	seg := b.cursor
+	for i, s := range b.segments {
+		if s == seg && i+1 < len(b.segments) {
+			b.moveCursorWithLock(b.segments[i+1], int64(segmentHeaderLen))
+			break
+		}
+	}
+	b.maybeRemoveSegmentWithLock(seg)
+}
+
+// skipCursorSegmentWithLock skips the records of the cursor segment that
+// cannot be read.
+func (b *durableBuffer) skipCursorSegmentWithLock() {
+	seg := b.cursor
+	b.backlogSize -= seg.size - b.cursorOffset
+	b.cursorOffset = seg.size
+	if seg != b.activeSegment() {
+		b.advanceCursorWithLock()
+	}
+}
+
+func (b *durableBuffer) Close(ct producer.CloseType) {
+	b.closeOnce.Do(func() {
+		b.close(ct)
+	})
+}
+
+func (b *durableBuffer) close(ct producer.CloseType) {
+	// Stop taking writes and replaying messages right away, the messages
+	// not loaded in memory are replayed after a restart.
+	b.Lock()
+	b.isClosed = true
+	b.Unlock()
+
+	if ct == producer.DropEverything {
+		b.dropLoaded()
+	}
+	b.waitUntilAllLoadedConsumed()
+	close(b.doneCh)
+	b.wg.Wait()
+
+	b.Lock()
+	b.syncWithLock()
+	b.updateGaugesWithLock()
+	if err := b.activeFd.Close(); err != nil {
+		b.m.writeErrors.Inc(1)
+	}
+	b.activeFd = nil
+	if b.cursorFd != nil {
+		_ = b.cursorFd.Close()
+		b.cursorFd = nil
+	}
+	b.Unlock()
+}
+
+func (b *durableBuffer) dropLoaded() {
+	b.Lock()
+	var loaded []*producer.RefCountedMessage
+	for _, seg := range b.segments {
+		for rm := range seg.loaded {
+			loaded = append(loaded, rm)
+		}
+	}
+	b.Unlock()
+	for _, rm := range loaded {
+		rm.Drop()
+	}
+}
+
+func (b *durableBuffer) waitUntilAllLoadedConsumed() {
+	if b.loadedSize() == 0 {
+		return
+	}
+	ticker := time.NewTicker(b.bufferOpts.CloseCheckInterval())
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if b.loadedSize() == 0 {
+			return
+		}
+	}
+}
+
+func (b *durableBuffer) loadedSize() uint64 {
+	b.Lock()
+	size := b.memorySize
+	b.Unlock()
+	return size
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	defaultMaxDiskSize      = 10 * 1024 * 1024 * 1024 // 10GB.
+	defaultMaxSegmentSize   = 64 * 1024 * 1024        // 64MB.
+	defaultSyncInterval     = time.Second
+	defaultNewFileMode      = os.FileMode(0o644)
+	defaultNewDirectoryMode = os.ModeDir | os.FileMode(0o755)
+)
+
+var (
+	errNoDurablePath          = errors.New("no durable buffer path")
+	errInvalidMaxSegmentSize  = errors.New("invalid max segment size")
+	errInvalidMaxDiskSize     = errors.New("max disk size smaller than two segments")
+	errNegativeSyncInterval   = errors.New("negative sync interval")
+	errMaxSegmentSizeTooSmall = errors.New("max segment size smaller than max message size")
+)
+
+type durableOptions struct {
+	bufferOpts       Options
+	path             string
+	maxDiskSize      int64
+	maxSegmentSize   int64
+	syncInterval     time.Duration
+	newFileMode      os.FileMode
+	newDirectoryMode os.FileMode
+}
+
+// NewDurableOptions creates DurableOptions.
+func NewDurableOptions() DurableOptions {
+	return &durableOptions{
+		bufferOpts:       NewOptions(),
+		maxDiskSize:      defaultMaxDiskSize,
+		maxSegmentSize:   defaultMaxSegmentSize,
+		syncInterval:     defaultSyncInterval,
+		newFileMode:      defaultNewFileMode,
+		newDirectoryMode: defaultNewDirectoryMode,
+	}
+}
+
+func (opts *durableOptions) BufferOptions() Options {
+	return opts.bufferOpts
+}
+
+func (opts *durableOptions) SetBufferOptions(value Options) DurableOptions {
+	o := *opts
+	o.bufferOpts = value
+	return &o
+}
+
+func (opts *durableOptions) Path() string {
+	return opts.path
+}
+
+func (opts *durableOptions) SetPath(value string) DurableOptions {
+	o := *opts
+	o.path = value
+	return &o
+}
+
+func (opts *durableOptions) MaxDiskSize() int64 {
+	return opts.maxDiskSize
+}
+
+func (opts *durableOptions) SetMaxDiskSize(value int64) DurableOptions {
+	o := *opts
+	o.maxDiskSize = value
+	return &o
+}
+
+func (opts *durableOptions) MaxSegmentSize() int64 {
+	return opts.maxSegmentSize
+}
+
+func (opts *durableOptions) SetMaxSegmentSize(value int64) DurableOptions {
+	o := *opts
+	o.maxSegmentSize = value
+	return &o
+}
+
+func (opts *durableOptions) SyncInterval() time.Duration {
+	return opts.syncInterval
+}
+
+func (opts *durableOptions) SetSyncInterval(value time.Duration) DurableOptions {
+	o := *opts
+	o.syncInterval = value
+	return &o
+}
+
+func (opts *durableOptions) NewFileMode() os.FileMode {
+	return opts.newFileMode
+}
+
+func (opts *durableOptions) SetNewFileMode(value os.FileMode) DurableOptions {
+	o := *opts
+	o.newFileMode = value
+	return &o
+}
+
+func (opts *durableOptions) NewDirectoryMode() os.FileMode {
+	return opts.newDirectoryMode
+}
+
+func (opts *durableOptions) SetNewDirectoryMode(value os.FileMode) DurableOptions {
+	o := *opts
+	o.newDirectoryMode = value
+	return &o
+}
+
+func (opts *durableOptions) Validate() error {
+	if err := opts.BufferOptions().Validate(); err != nil {
+		return err
+	}
+	if opts.Path() == "" {
+		return errNoDurablePath
+	}
+	if opts.MaxSegmentSize() <= 0 {
+		return errInvalidMaxSegmentSize
+	}
+	if opts.MaxSegmentSize() < int64(opts.BufferOptions().MaxMessageSize()) {
+		return errMaxSegmentSizeTooSmall
+	}
+	if opts.MaxDiskSize() < 2*opts.MaxSegmentSize() {
+		// The oldest segment can only be dropped once a newer one is written to.
+		return errInvalidMaxDiskSize
+	}
+	if opts.SyncInterval() < 0 {
+		return errNegativeSyncInterval
+	}
+	return nil
+}
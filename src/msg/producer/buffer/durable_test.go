@@ -0,0 +1,354 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fortytw2/leaktest"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/msg/producer"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+type testMessage struct {
+	shard     uint32
+	value     []byte
+	finalized []producer.FinalizeReason
+}
+
+func newTestMessage(i int) *testMessage {
+	return &testMessage{
+		shard: uint32(i % 4),
+		value: []byte(fmt.Sprintf("message-%06d", i)),
+	}
+}
+
+func (m *testMessage) Shard() uint32 { return m.shard }
+
+func (m *testMessage) Bytes() []byte { return m.value }
+
+func (m *testMessage) Size() int { return len(m.value) }
+
+func (m *testMessage) Finalize(r producer.FinalizeReason) {
+	m.finalized = append(m.finalized, r)
+}
+
+// testReplayWriter collects the messages replayed by a durable buffer.
+type testReplayWriter struct {
+	sync.Mutex
+	written []*producer.RefCountedMessage
+}
+
+func (w *testReplayWriter) Write(rm *producer.RefCountedMessage) error {
+	w.Lock()
+	w.written = append(w.written, rm)
+	w.Unlock()
+	return nil
+}
+
+func (w *testReplayWriter) take() []*producer.RefCountedMessage {
+	w.Lock()
+	defer w.Unlock()
+	written := w.written
+	w.written = nil
+	return written
+}
+
+func consume(rm *producer.RefCountedMessage) {
+	rm.IncRef()
+	rm.DecRef()
+}
+
+func testDurableOptions(t *testing.T, scope tally.Scope) DurableOptions {
+	return NewDurableOptions().
+		SetPath(t.TempDir()).
+		SetMaxDiskSize(4096).
+		SetMaxSegmentSize(256).
+		SetSyncInterval(0).
+		SetBufferOptions(testOptions().
+			SetMaxMessageSize(64).
+			SetMaxBufferSize(100).
+			SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)))
+}
+
+func mustNewDurableBuffer(t *testing.T, opts DurableOptions) *durableBuffer {
+	b, err := NewDurableBuffer(opts)
+	require.NoError(t, err)
+	return b.(*durableBuffer)
+}
+
+func requireSegmentFiles(t *testing.T, dir string, expected int) {
+	seqs, err := listSegmentFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, seqs, expected)
+}
+
+func TestDurableOptionsValidation(t *testing.T) {
+	opts := NewDurableOptions()
+	require.Equal(t, errNoDurablePath, opts.Validate())
+
+	opts = opts.SetPath(t.TempDir())
+	require.NoError(t, opts.Validate())
+
+	require.Equal(t, errInvalidMaxSegmentSize, opts.SetMaxSegmentSize(0).Validate())
+	require.Equal(t, errMaxSegmentSizeTooSmall, opts.SetMaxSegmentSize(1024).Validate())
+	require.Equal(t, errInvalidMaxDiskSize, opts.SetMaxDiskSize(opts.MaxSegmentSize()).Validate())
+	require.Equal(t, errNegativeSyncInterval, opts.SetSyncInterval(-time.Second).Validate())
+	require.Equal(t, errInvalidScanBatchSize,
+		opts.SetBufferOptions(NewOptions().SetScanBatchSize(0)).Validate())
+}
+
+func TestDurableBufferFileModes(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "buffer")
+	opts := testDurableOptions(t, tally.NoopScope).
+		SetPath(dir).
+		SetNewFileMode(0o600).
+		SetNewDirectoryMode(os.ModeDir | 0o700)
+	b := mustNewDurableBuffer(t, opts)
+	b.Init()
+	defer b.Close(producer.DropEverything)
+
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeDir|os.FileMode(0o700), info.Mode())
+	seqs, err := listSegmentFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, seqs, 1)
+	info, err = os.Stat(segmentFilePath(dir, seqs[0]))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode())
+}
+
+func TestDurableBufferAddAndConsume(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	opts := testDurableOptions(t, tally.NoopScope)
+	b := mustNewDurableBuffer(t, opts)
+	b.Init()
+
+	var messages []*testMessage
+	for i := 0; i < 20; i++ {
+		m := newTestMessage(i)
+		messages = append(messages, m)
+		rm, err := b.Add(m)
+		require.NoError(t, err)
+		require.NotNil(t, rm)
+		require.Equal(t, m.value, rm.Bytes())
+		require.Equal(t, m.shard, rm.Shard())
+		consume(rm)
+	}
+	for _, m := range messages {
+		require.Equal(t, []producer.FinalizeReason{producer.Consumed}, m.finalized)
+	}
+	require.Equal(t, uint64(0), b.memorySize)
+
+	// The segments whose messages were all consumed are removed, only the
+	// active segment remains.
+	requireSegmentFiles(t, opts.Path(), 1)
+	require.Equal(t, b.activeSegment().size, b.diskSize)
+
+	b.Close(producer.WaitForConsumption)
+	_, err := b.Add(newTestMessage(0))
+	require.Equal(t, errBufferClosed, err)
+}
+
+func TestDurableBufferAddMessageTooLarge(t *testing.T) {
+	b := mustNewDurableBuffer(t, testDurableOptions(t, tally.NoopScope))
+	_, err := b.Add(&testMessage{value: make([]byte, 65)})
+	require.Equal(t, errMessageTooLarge, err)
+	b.Close(producer.DropEverything)
+}
+
+func TestDurableBufferSpillAndReplay(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	b := mustNewDurableBuffer(t, testDurableOptions(t, tally.NoopScope))
+	b.Init()
+
+	// Messages are 14 bytes, seven of them fit in memory.
+	var loaded []*producer.RefCountedMessage
+	for i := 0; i < 30; i++ {
+		rm, err := b.Add(newTestMessage(i))
+		require.NoError(t, err)
+		if i < 7 {
+			require.NotNil(t, rm)
+			loaded = append(loaded, rm)
+		} else {
+			require.Nil(t, rm)
+		}
+	}
+	require.Equal(t, int64(23*(segmentRecordHeaderLen+14)), b.backlogSize)
+
+	w := &testReplayWriter{}
+	b.Replay(w.Write)
+	for _, rm := range loaded {
+		consume(rm)
+	}
+
+	// The messages are replayed in order as the replayed messages are consumed.
+	next := 7
+	for next < 30 {
+		for _, rm := range w.take() {
+			require.Equal(t, newTestMessage(next).value, rm.Bytes())
+			require.Equal(t, newTestMessage(next).shard, rm.Shard())
+			next++
+			consume(rm)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	require.True(t, clock.WaitUntil(func() bool {
+		b.Lock()
+		defer b.Unlock()
+		return b.backlogSize == 0 && b.memorySize == 0 && len(b.segments) == 1
+	}, 5*time.Second))
+
+	// Messages are no longer spilled once the backlog is replayed.
+	rm, err := b.Add(newTestMessage(30))
+	require.NoError(t, err)
+	require.NotNil(t, rm)
+	consume(rm)
+	b.Close(producer.WaitForConsumption)
+}
+
+func TestDurableBufferRecovery(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	opts := testDurableOptions(t, tally.NoopScope)
+	b := mustNewDurableBuffer(t, opts.SetBufferOptions(opts.BufferOptions().SetMaxBufferSize(1000)))
+	b.Init()
+	for i := 0; i < 20; i++ {
+		rm, err := b.Add(newTestMessage(i))
+		require.NoError(t, err)
+		// The first segment holds the first nine messages, it is removed once
+		// they are all consumed.
+		if i < 9 {
+			consume(rm)
+		}
+	}
+	requireSegmentFiles(t, opts.Path(), 2)
+	// The messages not consumed are kept on disk when dropped on close.
+	b.Close(producer.DropEverything)
+
+	scope := tally.NewTestScope("", nil)
+	b = mustNewDurableBuffer(t, testDurableOptions(t, scope).SetPath(opts.Path()))
+	b.Init()
+	w := &testReplayWriter{}
+	b.Replay(w.Write)
+
+	var replayed []string
+	for len(replayed) < 11 {
+		for _, rm := range w.take() {
+			replayed = append(replayed, string(rm.Bytes()))
+			consume(rm)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	var expected []string
+	for i := 9; i < 20; i++ {
+		expected = append(expected, string(newTestMessage(i).value))
+	}
+	require.Equal(t, expected, replayed)
+	require.True(t, clock.WaitUntil(func() bool {
+		b.Lock()
+		defer b.Unlock()
+		return len(b.segments) == 1
+	}, 5*time.Second))
+	b.Close(producer.WaitForConsumption)
+
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(11), snapshot.Counters()["message-recovered+"].Value())
+	require.Equal(t, int64(11), snapshot.Counters()["message-replayed+"].Value())
+	require.Equal(t, 1.0, snapshot.Gauges()["replay-progress-ratio+"].Value())
+	requireSegmentFiles(t, opts.Path(), 1)
+}
+
+func TestDurableBufferRecoveryTruncatesCorruptRecords(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	opts := testDurableOptions(t, tally.NoopScope)
+	b := mustNewDurableBuffer(t, opts)
+	for i := 0; i < 3; i++ {
+		_, err := b.Add(newTestMessage(i))
+		require.NoError(t, err)
+	}
+	path := b.activeSegment().path
+	b.Close(producer.DropEverything)
+
+	// Simulate a torn write of the last record.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.Write(appendSegmentRecord(nil, 1, []byte("torn"))[:10])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	scope := tally.NewTestScope("", nil)
+	b = mustNewDurableBuffer(t, testDurableOptions(t, scope).SetPath(opts.Path()))
+	require.Equal(t, int64(3*(segmentRecordHeaderLen+14)), b.backlogSize)
+	require.Equal(t, int64(1), scope.Snapshot().Counters()["corrupt-segments+"].Value())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(segmentHeaderLen+3*(segmentRecordHeaderLen+14)), info.Size())
+	b.Close(producer.DropEverything)
+}
+
+func TestDurableBufferDiskFullReturnError(t *testing.T) {
+	opts := testDurableOptions(t, tally.NoopScope)
+	opts = opts.SetBufferOptions(opts.BufferOptions().SetOnFullStrategy(ReturnError)).
+		SetMaxDiskSize(512)
+	b := mustNewDurableBuffer(t, opts)
+
+	var err error
+	for i := 0; err == nil; i++ {
+		_, err = b.Add(newTestMessage(i))
+		require.True(t, b.diskSize <= 512)
+	}
+	require.Equal(t, ErrBufferFull, err)
+	b.Close(producer.DropEverything)
+}
+
+func TestDurableBufferDiskFullDropOldest(t *testing.T) {
+	opts := testDurableOptions(t, tally.NoopScope).SetMaxDiskSize(512)
+	b := mustNewDurableBuffer(t, opts)
+
+	var messages []*testMessage
+	for i := 0; i < 100; i++ {
+		m := newTestMessage(i)
+		messages = append(messages, m)
+		_, err := b.Add(m)
+		require.NoError(t, err)
+		require.True(t, b.diskSize <= 512)
+	}
+	// The oldest messages loaded in memory were dropped along with their segment.
+	for _, m := range messages[:7] {
+		require.Equal(t, []producer.FinalizeReason{producer.Dropped}, m.finalized)
+	}
+	require.Equal(t, uint64(0), b.memorySize)
+	b.Close(producer.DropEverything)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/m3db/m3/src/msg/producer"
+)
+
+// A segment file starts with a header made of the segment magic and the
+// segment version. It is followed by one record per message, each made of
+// the length of the message bytes, the CRC32 checksum of the rest of the
+// record, the shard of the message and the message bytes.
+const (
+	segmentFilePrefix      = "segment-"
+	segmentFileSuffix      = ".wal"
+	segmentMagic           = "m3msgwal"
+	segmentVersion         = 1
+	segmentHeaderLen       = len(segmentMagic) + 1
+	segmentRecordHeaderLen = 12
+)
+
+var (
+	errSegmentInvalidHeader     = errors.New("invalid segment header")
+	errSegmentChecksumMismatch  = errors.New("segment record checksum mismatch")
+	errSegmentRecordTruncated   = errors.New("segment record is truncated")
+	errSegmentRecordInvalidSize = errors.New("segment record has an invalid size")
+
+	segmentCRCTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+func segmentFilePath(dir string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%020d%s", segmentFilePrefix, seq, segmentFileSuffix))
+}
+
+// listSegmentFiles returns the sequence numbers of the segment files in the
+// directory, in increasing order.
+func listSegmentFiles(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, segmentFilePrefix) ||
+			!strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+		var seq uint64
+		if _, err := fmt.Sscanf(
+			strings.TrimSuffix(strings.TrimPrefix(name, segmentFilePrefix), segmentFileSuffix),
+			"%d", &seq,
+		); err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+func appendSegmentHeader(buf []byte) []byte {
+	buf = append(buf, segmentMagic...)
+	return append(buf, segmentVersion)
+}
+
+func appendSegmentRecord(buf []byte, shard uint32, value []byte) []byte {
+	start := len(buf)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(value)))
+	buf = binary.LittleEndian.AppendUint32(buf, 0)
+	buf = binary.LittleEndian.AppendUint32(buf, shard)
+	buf = append(buf, value...)
+	checksum := crc32.Checksum(buf[start+8:], segmentCRCTable)
+	binary.LittleEndian.PutUint32(buf[start+4:], checksum)
+	return buf
+}
+
+func segmentRecordLen(value []byte) int64 {
+	return int64(segmentRecordHeaderLen + len(value))
+}
+
+// readSegmentRecord reads the record at the given offset of the segment file
+// whose size is given, reusing the buffer where possible. It returns the
+// shard of the message, the message bytes and the buffer.
+func readSegmentRecord(
+	r io.ReaderAt,
+	offset int64,
+	size int64,
+	buf []byte,
+) (uint32, []byte, []byte, error) {
+	var header [segmentRecordHeaderLen]byte
+	if size-offset < segmentRecordHeaderLen {
+		return 0, nil, buf, errSegmentRecordTruncated
+	}
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return 0, nil, buf, err
+	}
+	valueLen := int64(binary.LittleEndian.Uint32(header[0:]))
+	if valueLen > size-offset-segmentRecordHeaderLen {
+		return 0, nil, buf, errSegmentRecordTruncated
+	}
+	if int64(cap(buf)) < valueLen {
+		buf = make([]byte, valueLen)
+	}
+	value := buf[:valueLen]
+	if _, err := r.ReadAt(value, offset+segmentRecordHeaderLen); err != nil {
+		return 0, nil, buf, err
+	}
+	checksum := crc32.Update(crc32.Checksum(header[8:], segmentCRCTable), segmentCRCTable, value)
+	if checksum != binary.LittleEndian.Uint32(header[4:]) {
+		return 0, nil, buf, errSegmentChecksumMismatch
+	}
+	return binary.LittleEndian.Uint32(header[8:]), value, buf, nil
+}
+
+// scanSegmentFile validates the records of a segment file, returning the
+// length of the valid prefix of the file and the number of valid records.
+// A torn write of the last record of a segment is expected after a crash,
+// the records following an invalid record are never recovered.
+func scanSegmentFile(f *os.File, maxMessageSize int) (int64, int, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := info.Size()
+	header := make([]byte, segmentHeaderLen)
+	if size < int64(segmentHeaderLen) {
+		return 0, 0, errSegmentInvalidHeader
+	}
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return 0, 0, err
+	}
+	if string(header[:len(segmentMagic)]) != segmentMagic || header[len(segmentMagic)] != segmentVersion {
+		return 0, 0, errSegmentInvalidHeader
+	}
+
+	var (
+		offset     = int64(segmentHeaderLen)
+		numRecords int
+		buf        []byte
+	)
+	for offset < size {
+		var value []byte
+		_, value, buf, err = readSegmentRecord(f, offset, size, buf)
+		if err == nil && len(value) > maxMessageSize {
+			err = errSegmentRecordInvalidSize
+		}
+		if err != nil {
+			return offset, numRecords, err
+		}
+		offset += segmentRecordLen(value)
+		numRecords++
+	}
+	return offset, numRecords, nil
+}
+
+// recoveredMessage is a message recovered from a segment file.
+type recoveredMessage struct {
+	shard uint32
+	value []byte
+}
+
+func (m *recoveredMessage) Shard() uint32 { return m.shard }
+
+func (m *recoveredMessage) Bytes() []byte { return m.value }
+
+func (m *recoveredMessage) Size() int { return len(m.value) }
+
+func (m *recoveredMessage) Finalize(producer.FinalizeReason) {}
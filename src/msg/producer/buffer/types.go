@@ -21,6 +21,7 @@
 package buffer
 
 import (
+	"os"
 	"time"
 
 	"github.com/m3db/m3/src/x/instrument"
@@ -105,3 +106,57 @@ type Options interface {
 	// Validate validates the options.
 	Validate() error
 }
+
+// DurableOptions configs the durable buffer.
+type DurableOptions interface {
+	// BufferOptions returns the buffer options, the max buffer size bounds
+	// the size of the messages held in memory and the on full strategy applies
+	// when the max disk size is reached.
+	BufferOptions() Options
+
+	// SetBufferOptions sets the buffer options.
+	SetBufferOptions(value Options) DurableOptions
+
+	// Path returns the directory of the segment files.
+	Path() string
+
+	// SetPath sets the directory of the segment files.
+	SetPath(value string) DurableOptions
+
+	// MaxDiskSize returns the max size of the segment files.
+	MaxDiskSize() int64
+
+	// SetMaxDiskSize sets the max size of the segment files.
+	SetMaxDiskSize(value int64) DurableOptions
+
+	// MaxSegmentSize returns the size past which a new segment file is started.
+	MaxSegmentSize() int64
+
+	// SetMaxSegmentSize sets the size past which a new segment file is started.
+	SetMaxSegmentSize(value int64) DurableOptions
+
+	// SyncInterval returns the interval to sync the segment file written to
+	// disk, segment files are synced on every write when it is zero.
+	SyncInterval() time.Duration
+
+	// SetSyncInterval sets the interval to sync the segment file written to
+	// disk, segment files are synced on every write when it is zero.
+	SetSyncInterval(value time.Duration) DurableOptions
+
+	// NewFileMode returns the new file mode of the segment files.
+	NewFileMode() os.FileMode
+
+	// SetNewFileMode sets the new file mode of the segment files.
+	SetNewFileMode(value os.FileMode) DurableOptions
+
+	// NewDirectoryMode returns the new directory mode of the directory of
+	// the segment files.
+	NewDirectoryMode() os.FileMode
+
+	// SetNewDirectoryMode sets the new directory mode of the directory of
+	// the segment files.
+	SetNewDirectoryMode(value os.FileMode) DurableOptions
+
+	// Validate validates the options.
+	Validate() error
+}
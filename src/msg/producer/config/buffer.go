@@ -23,6 +23,7 @@ package config
 import (
 	"time"
 
+	"github.com/m3db/m3/src/msg/producer"
 	"github.com/m3db/m3/src/msg/producer/buffer"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/retry"
@@ -38,6 +39,43 @@ type BufferConfiguration struct {
 	ScanBatchSize         *int                   `yaml:"scanBatchSize"`
 	AllowedSpilloverRatio *float64               `yaml:"allowedSpilloverRatio"`
 	CleanupRetry          *retry.Configuration   `yaml:"cleanupRetry"`
+
+	// Durable persists the buffered messages to disk when set.
+	Durable *DurableBufferConfiguration `yaml:"durable"`
+}
+
+// DurableBufferConfiguration configs the durable buffer.
+type DurableBufferConfiguration struct {
+	Path           string         `yaml:"path" validate:"nonzero"`
+	MaxDiskSize    *int64         `yaml:"maxDiskSize"`
+	MaxSegmentSize *int64         `yaml:"maxSegmentSize"`
+	SyncInterval   *time.Duration `yaml:"syncInterval"`
+}
+
+// NewOptions creates new durable buffer options.
+func (c *DurableBufferConfiguration) NewOptions(bufferOpts buffer.Options) buffer.DurableOptions {
+	opts := buffer.NewDurableOptions().
+		SetBufferOptions(bufferOpts).
+		SetPath(c.Path)
+	if c.MaxDiskSize != nil {
+		opts = opts.SetMaxDiskSize(*c.MaxDiskSize)
+	}
+	if c.MaxSegmentSize != nil {
+		opts = opts.SetMaxSegmentSize(*c.MaxSegmentSize)
+	}
+	if c.SyncInterval != nil {
+		opts = opts.SetSyncInterval(*c.SyncInterval)
+	}
+	return opts
+}
+
+// NewBuffer creates a new buffer, which is durable if configured.
+func (c *BufferConfiguration) NewBuffer(iOpts instrument.Options) (producer.Buffer, error) {
+	opts := c.NewOptions(iOpts)
+	if c.Durable == nil {
+		return buffer.NewBuffer(opts)
+	}
+	return buffer.NewDurableBuffer(c.Durable.NewOptions(opts))
 }
 
 // NewOptions creates new buffer options.
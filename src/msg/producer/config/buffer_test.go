@@ -70,3 +70,27 @@ func TestEmptyBufferConfiguration(t *testing.T) {
 		cfg.NewOptions(iopts).SetCleanupRetryOptions(rOpts),
 	)
 }
+
+func TestDurableBufferConfiguration(t *testing.T) {
+	str := `
+maxBufferSize: 100
+maxMessageSize: 16
+durable:
+  path: /var/lib/m3/buffer
+  maxDiskSize: 1024
+  maxSegmentSize: 256
+  syncInterval: 100ms
+`
+
+	var cfg BufferConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	bOpts := cfg.NewOptions(instrument.NewOptions())
+	dOpts := cfg.Durable.NewOptions(bOpts)
+	require.Equal(t, bOpts, dOpts.BufferOptions())
+	require.Equal(t, "/var/lib/m3/buffer", dOpts.Path())
+	require.Equal(t, int64(1024), dOpts.MaxDiskSize())
+	require.Equal(t, int64(256), dOpts.MaxSegmentSize())
+	require.Equal(t, 100*time.Millisecond, dOpts.SyncInterval())
+	require.NoError(t, dOpts.Validate())
+}
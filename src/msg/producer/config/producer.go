@@ -23,7 +23,6 @@ package config
 import (
 	"github.com/m3db/m3/src/cluster/client"
 	"github.com/m3db/m3/src/msg/producer"
-	"github.com/m3db/m3/src/msg/producer/buffer"
 	"github.com/m3db/m3/src/msg/producer/writer"
 	"github.com/m3db/m3/src/x/instrument"
 	xio "github.com/m3db/m3/src/x/io"
@@ -44,7 +43,7 @@ func (c *ProducerConfiguration) newOptions(
 	if err != nil {
 		return nil, err
 	}
-	b, err := buffer.NewBuffer(c.Buffer.NewOptions(iOpts))
+	b, err := c.Buffer.NewBuffer(iOpts)
 	if err != nil {
 		return nil, err
 	}
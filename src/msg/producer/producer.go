@@ -35,7 +35,13 @@ func NewProducer(opts Options) Producer {
 
 func (p *producer) Init() error {
 	p.Buffer.Init()
-	return p.Writer.Init()
+	if err := p.Writer.Init(); err != nil {
+		return err
+	}
+	if b, ok := p.Buffer.(DurableBuffer); ok {
+		b.Replay(p.Writer.Write)
+	}
+	return nil
 }
 
 func (p *producer) Produce(m Message) error {
@@ -43,6 +49,11 @@ func (p *producer) Produce(m Message) error {
 	if err != nil {
 		return err
 	}
+	if rm == nil {
+		// The message is persisted by a durable buffer that writes it out
+		// once there is room for it in memory.
+		return nil
+	}
 	return p.Writer.Write(rm)
 }
 
@@ -106,6 +106,8 @@ type Options interface {
 // Buffer buffers all the messages in the producer.
 type Buffer interface {
 	// Add adds message to the buffer and returns a reference counted message.
+	// A durable buffer returns a nil reference counted message for the messages
+	// it only persists, which it writes out itself once replayed.
 	Add(m Message) (*RefCountedMessage, error)
 
 	// Init initializes the buffer.
@@ -117,6 +119,21 @@ type Buffer interface {
 	Close(ct CloseType)
 }
 
+// WriteFn writes a reference counted message out.
+type WriteFn func(rm *RefCountedMessage) error
+
+// DurableBuffer is a buffer that persists the messages it buffers to disk. It
+// may hold more messages on disk than in memory, the messages it only holds on
+// disk, including the messages recovered after a restart, are written out with
+// the replay write function once there is room for them in memory.
+type DurableBuffer interface {
+	Buffer
+
+	// Replay starts writing out the messages held on disk with the write
+	// function, it is called once the writer is initialized.
+	Replay(fn WriteFn)
+}
+
 // Writer writes all the messages out to the consumer services.
 type Writer interface {
 	// Write writes a reference counted message out.
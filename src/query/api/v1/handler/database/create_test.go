@@ -177,6 +177,7 @@ func testLocalType(t *testing.T, providedType string, placementExists bool) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -353,6 +354,7 @@ func TestLocalTypeWithNumShards(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -482,6 +484,7 @@ func TestLocalWithBlockSizeNanos(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -616,6 +619,7 @@ func TestLocalWithBlockSizeExpectedSeriesDatapointsPerHour(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -880,6 +884,7 @@ func testClusterTypeHosts(t *testing.T, placementExists bool) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -1037,6 +1042,7 @@ func TestClusterTypeHostsWithIsolationGroup(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -1256,6 +1262,7 @@ func TestLocalTypeWithAggregatedNamespace(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -1298,6 +1305,7 @@ func TestLocalTypeWithAggregatedNamespace(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos": "0"
 						},
+						"retentionOverrides": [],
 						"snapshotEnabled": true,
 						"indexOptions": {
 							"enabled": true,
@@ -0,0 +1,365 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	graphitestorage "github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// TagsAutoCompleteTagsURL is the url for auto completing graphite tag
+	// names.
+	TagsAutoCompleteTagsURL = route.Prefix + "/graphite/tags/autoComplete/tags"
+
+	// TagsAutoCompleteValuesURL is the url for auto completing graphite tag
+	// values.
+	TagsAutoCompleteValuesURL = route.Prefix + "/graphite/tags/autoComplete/values"
+
+	// TagsFindSeriesURL is the url for finding graphite tagged series.
+	TagsFindSeriesURL = route.Prefix + "/graphite/tags/findSeries"
+
+	defaultTagsAutoCompleteLimit = 100
+)
+
+// TagsHTTPMethods are the HTTP methods for the tags handlers.
+var TagsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+var errNoTagParam = errors.New("no tag specified")
+
+type graphiteTagsHandler struct {
+	storage             graphitestorage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+}
+
+func newGraphiteTagsHandler(opts options.HandlerOptions) graphiteTagsHandler {
+	wrappedStore := graphitestorage.NewM3WrappedStorage(opts.Storage(),
+		opts.M3DBOptions(), opts.InstrumentOpts(), opts.GraphiteStorageOptions())
+	return graphiteTagsHandler{
+		storage:             wrappedStore,
+		fetchOptionsBuilder: opts.GraphiteFindFetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+	}
+}
+
+type graphiteTagsAutoCompleteHandler struct {
+	graphiteTagsHandler
+	completeValues bool
+}
+
+// NewTagsAutoCompleteTagsHandler returns a new instance of a handler that
+// auto completes graphite tag names.
+func NewTagsAutoCompleteTagsHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteTagsAutoCompleteHandler{
+		graphiteTagsHandler: newGraphiteTagsHandler(opts),
+	}
+}
+
+// NewTagsAutoCompleteValuesHandler returns a new instance of a handler that
+// auto completes graphite tag values.
+func NewTagsAutoCompleteValuesHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteTagsAutoCompleteHandler{
+		graphiteTagsHandler: newGraphiteTagsHandler(opts),
+		completeValues:      true,
+	}
+}
+
+func (h *graphiteTagsAutoCompleteHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx, opts, err := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	params, err := parseTagsAutoCompleteParams(r, h.completeValues)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	result, err := h.storage.CompleteTags(ctx, params.query, opts)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var completed []string
+	if h.completeValues {
+		completed = tagValuesResults(result.CompletedTags, params)
+	} else {
+		completed = tagNamesResults(result.CompletedTags, params)
+	}
+
+	err = handleroptions.AddDBResultResponseHeaders(w, result.Metadata, opts)
+	if err != nil {
+		logger.Error("unable to render tags header", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if err := stringsResultsJSON(w, completed); err != nil {
+		logger.Error("unable to render tags results", zap.Error(err))
+	}
+}
+
+type graphiteTagsFindSeriesHandler struct {
+	graphiteTagsHandler
+}
+
+// NewTagsFindSeriesHandler returns a new instance of a handler that finds
+// graphite tagged series matching a set of tag expressions.
+func NewTagsFindSeriesHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteTagsFindSeriesHandler{
+		graphiteTagsHandler: newGraphiteTagsHandler(opts),
+	}
+}
+
+func (h *graphiteTagsFindSeriesHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx, opts, err := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	query, err := parseTagsFindSeriesQuery(r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	result, err := h.storage.SearchSeries(ctx, query, opts)
+	if err != nil {
+		logger.Error("unable to find series", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	names := make([]string, 0, len(result.Metrics))
+	for _, metric := range result.Metrics {
+		names = append(names, string(metric.ID))
+	}
+	sort.Strings(names)
+
+	err = handleroptions.AddDBResultResponseHeaders(w, result.Metadata, opts)
+	if err != nil {
+		logger.Error("unable to render find series header", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if err := stringsResultsJSON(w, names); err != nil {
+		logger.Error("unable to render find series results", zap.Error(err))
+	}
+}
+
+type tagsAutoCompleteParams struct {
+	query  *storage.CompleteTagsQuery
+	exprs  []string
+	prefix string
+	limit  int
+}
+
+// parseTagsAutoCompleteParams parses an auto complete request, completing
+// tag values when a tag is given and tag names otherwise. Completion is
+// restricted to the tagged series matching the optional expr params.
+func parseTagsAutoCompleteParams(
+	r *http.Request,
+	completeValues bool,
+) (tagsAutoCompleteParams, error) {
+	if err := r.ParseForm(); err != nil {
+		return tagsAutoCompleteParams{}, xerrors.NewInvalidParamsError(err)
+	}
+
+	from, until, err := parseFromUntil(r)
+	if err != nil {
+		return tagsAutoCompleteParams{}, err
+	}
+
+	params := tagsAutoCompleteParams{
+		exprs: r.Form["expr"],
+		limit: defaultTagsAutoCompleteLimit,
+	}
+	if limit := r.FormValue("limit"); limit != "" {
+		params.limit, err = strconv.Atoi(limit)
+		if err != nil || params.limit <= 0 {
+			return tagsAutoCompleteParams{}, xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid 'limit': %s", limit))
+		}
+	}
+
+	matchers := models.Matchers{
+		{Type: models.MatchField, Name: graphite.TaggedNameTag},
+	}
+	if len(params.exprs) > 0 {
+		matchers, err = graphitestorage.TranslateTagExpressionsToMatchers(params.exprs)
+		if err != nil {
+			return tagsAutoCompleteParams{}, err
+		}
+	}
+
+	params.query = &storage.CompleteTagsQuery{
+		CompleteNameOnly: !completeValues,
+		TagMatchers:      matchers,
+		Start:            xtime.ToUnixNano(from),
+		End:              xtime.ToUnixNano(until),
+	}
+	if !completeValues {
+		params.prefix = r.FormValue("tagPrefix")
+		return params, nil
+	}
+
+	tag := r.FormValue("tag")
+	if tag == "" {
+		return tagsAutoCompleteParams{}, xerrors.NewInvalidParamsError(errNoTagParam)
+	}
+
+	params.prefix = r.FormValue("valuePrefix")
+	params.query.FilterNameTags = [][]byte{[]byte(tag)}
+	return params, nil
+}
+
+// parseTagsFindSeriesQuery parses a find series request to a query for the
+// tagged series matching all of the expr params.
+func parseTagsFindSeriesQuery(r *http.Request) (*storage.FetchQuery, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	from, until, err := parseFromUntil(r)
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := r.Form["expr"]
+	matchers, err := graphitestorage.TranslateTagExpressionsToMatchers(exprs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.FetchQuery{
+		Raw:         strings.Join(exprs, ","),
+		TagMatchers: matchers,
+		Start:       from,
+		End:         until,
+	}, nil
+}
+
+// tagNamesResults returns the sorted tag names of tagged series, excluding
+// tags already used in the expressions and internal tags.
+func tagNamesResults(
+	tags []consolidators.CompletedTag,
+	params tagsAutoCompleteParams,
+) []string {
+	used := make(map[string]struct{}, len(params.exprs))
+	for _, expr := range params.exprs {
+		if idx := strings.IndexAny(expr, "!="); idx > 0 {
+			used[expr[:idx]] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if bytes.HasPrefix(tag.Name, []byte("__")) {
+			continue
+		}
+		name := string(tag.Name)
+		if _, ok := used[name]; ok {
+			continue
+		}
+		if !strings.HasPrefix(name, params.prefix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return sortedAndLimited(names, params.limit)
+}
+
+// tagValuesResults returns the sorted values of the completed tag.
+func tagValuesResults(
+	tags []consolidators.CompletedTag,
+	params tagsAutoCompleteParams,
+) []string {
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		for _, value := range tag.Values {
+			if !bytes.HasPrefix(value, []byte(params.prefix)) {
+				continue
+			}
+			values = append(values, string(value))
+		}
+	}
+	return sortedAndLimited(values, params.limit)
+}
+
+func sortedAndLimited(results []string, limit int) []string {
+	sort.Strings(results)
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func stringsResultsJSON(w io.Writer, results []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+	for _, result := range results {
+		jw.WriteString(result)
+	}
+	jw.EndArray()
+	return jw.Close()
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xtest "github.com/m3db/m3/src/x/test"
+)
+
+func newTestTagsHandlerOptions(
+	t *testing.T,
+	store storage.Storage,
+) options.HandlerOptions {
+	builder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{
+			Timeout: 15 * time.Second,
+		})
+	require.NoError(t, err)
+
+	return options.EmptyHandlerOptions().
+		SetGraphiteFindFetchOptionsBuilder(builder).
+		SetStorage(store)
+}
+
+func serveTagsRequest(
+	t *testing.T,
+	h http.Handler,
+	params url.Values,
+) []string {
+	w := &writer{}
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{RawQuery: params.Encode()},
+	}
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, 1, len(w.results))
+	var results []string
+	decoder := json.NewDecoder(bytes.NewBufferString(w.results[0]))
+	require.NoError(t, decoder.Decode(&results))
+	return results
+}
+
+func TestTagsAutoCompleteTags(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			require.True(t, query.CompleteNameOnly)
+			require.Equal(t, models.Matchers{
+				{Type: models.MatchField, Name: b("name")},
+				{Type: models.MatchEqual, Name: b("name"), Value: b("disk.used")},
+			}, query.TagMatchers)
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("__internal__")},
+					{Name: b("dc")},
+					{Name: b("host")},
+					{Name: b("hostgroup")},
+					{Name: b("name")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsAutoCompleteTagsHandler(newTestTagsHandlerOptions(t, store))
+	params := make(url.Values)
+	params.Add("expr", "name=disk.used")
+	params.Set("tagPrefix", "ho")
+	params.Set("limit", "1")
+
+	results := serveTagsRequest(t, h, params)
+	require.Equal(t, []string{"host"}, results)
+}
+
+func TestTagsAutoCompleteValues(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			require.False(t, query.CompleteNameOnly)
+			require.Equal(t, bs("host"), query.FilterNameTags)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("host"), Values: bs("b", "a", "c", "other")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsAutoCompleteValuesHandler(newTestTagsHandlerOptions(t, store))
+	params := make(url.Values)
+	params.Set("tag", "host")
+	params.Set("valuePrefix", "")
+
+	results := serveTagsRequest(t, h, params)
+	require.Equal(t, []string{"a", "b", "c", "other"}, results)
+}
+
+func TestTagsAutoCompleteValuesNoTag(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	h := NewTagsAutoCompleteValuesHandler(newTestTagsHandlerOptions(t, store))
+
+	w := &writer{}
+	h.ServeHTTP(w, &http.Request{Method: http.MethodGet, URL: &url.URL{}})
+	require.Equal(t, 1, len(w.results))
+	require.Contains(t, w.results[0], errNoTagParam.Error())
+}
+
+func TestTagsFindSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.FetchQuery,
+			_ *storage.FetchOptions,
+		) (*storage.SearchResults, error) {
+			require.Equal(t, "name=disk.used,dc=~us.*", query.Raw)
+			require.Equal(t, models.Matchers{
+				{Type: models.MatchField, Name: b("name")},
+				{Type: models.MatchEqual, Name: b("name"), Value: b("disk.used")},
+				{Type: models.MatchRegexp, Name: b("dc"), Value: b("(?:us.*).*")},
+			}, query.TagMatchers)
+			return &storage.SearchResults{
+				Metrics: models.Metrics{
+					{ID: b("disk.used;dc=us-west;host=b")},
+					{ID: b("disk.used;dc=us-east;host=a")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsFindSeriesHandler(newTestTagsHandlerOptions(t, store))
+	params := make(url.Values)
+	params.Add("expr", "name=disk.used")
+	params.Add("expr", "dc=~us.*")
+
+	results := serveTagsRequest(t, h, params)
+	require.Equal(t, []string{
+		"disk.used;dc=us-east;host=a",
+		"disk.used;dc=us-west;host=b",
+	}, results)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/api/v1/route"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/query/ts"
@@ -90,6 +91,20 @@ func (h *WriteJSONHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			zap.String("remoteAddr", r.RemoteAddr),
 			zap.Error(err))
 		xhttp.WriteError(w, err)
+		return
+	}
+
+	// NB: this endpoint writes to storage directly rather than through the
+	// downsampler and writer, so the tenant write quota is enforced here.
+	if enforcer := h.opts.QuotaEnforcer(); enforcer != nil {
+		_, err := enforcer.CheckWrite(enforcer.Tenant(r.Header), []quota.SeriesWrite{{
+			SeriesHash: writeQuery.Tags().HashedID(),
+			NumSamples: 1,
+		}})
+		if err != nil {
+			xhttp.WriteError(w, err)
+			return
+		}
 	}
 
 	if err := h.store.Write(r.Context(), writeQuery); err != nil {
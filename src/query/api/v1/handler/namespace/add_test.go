@@ -142,8 +142,9 @@ func TestNamespaceAddHandler(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "300000000000",
 							"futureRetentionPeriodNanos":               "0",
 						},
-						"snapshotEnabled": true,
-						"stagingState":    xjson.Map{"status": "INITIALIZING"},
+						"retentionOverrides": xjson.Array{},
+						"snapshotEnabled":    true,
+						"stagingState":       xjson.Map{"status": "INITIALIZING"},
 						"indexOptions": xjson.Map{
 							"enabled":        true,
 							"blockSizeNanos": "7200000000000",
@@ -139,12 +139,13 @@ func TestNamespaceGetHandler(t *testing.T) {
 							"futureRetentionPeriodNanos":               "0",
 							"retentionPeriodNanos":                     "172800000000000",
 						},
-						"runtimeOptions":    nil,
-						"schemaOptions":     nil,
-						"snapshotEnabled":   true,
-						"stagingState":      xjson.Map{"status": "READY"},
-						"writesToCommitLog": true,
-						"extendedOptions":   xtest.NewTestExtendedOptionsJSON("foo"),
+						"retentionOverrides": xjson.Array{},
+						"runtimeOptions":     nil,
+						"schemaOptions":      nil,
+						"snapshotEnabled":    true,
+						"stagingState":       xjson.Map{"status": "READY"},
+						"writesToCommitLog":  true,
+						"extendedOptions":    xtest.NewTestExtendedOptionsJSON("foo"),
 					},
 				},
 			},
@@ -224,12 +225,13 @@ func TestNamespaceGetHandlerWithDebug(t *testing.T) {
 							"futureRetentionPeriodDuration":               "0s",
 							"retentionPeriodDuration":                     "48h0m0s",
 						},
-						"runtimeOptions":    nil,
-						"schemaOptions":     nil,
-						"stagingState":      xjson.Map{"status": "UNKNOWN"},
-						"snapshotEnabled":   true,
-						"writesToCommitLog": true,
-						"extendedOptions":   nil,
+						"retentionOverrides": xjson.Array{},
+						"runtimeOptions":     nil,
+						"schemaOptions":      nil,
+						"stagingState":       xjson.Map{"status": "UNKNOWN"},
+						"snapshotEnabled":    true,
+						"writesToCommitLog":  true,
+						"extendedOptions":    nil,
 					},
 				},
 			},
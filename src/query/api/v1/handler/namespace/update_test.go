@@ -190,7 +190,8 @@ func TestNamespaceUpdateHandler(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "3600000000000",
 							"futureRetentionPeriodNanos":               "0",
 						},
-						"snapshotEnabled": true,
+						"retentionOverrides": xjson.Array{},
+						"snapshotEnabled":    true,
 						"indexOptions": xjson.Map{
 							"enabled":        false,
 							"blockSizeNanos": "7200000000000",
@@ -251,7 +252,8 @@ func TestNamespaceUpdateHandler(t *testing.T) {
 							"blockDataExpiryAfterNotAccessPeriodNanos": "3600000000000",
 							"futureRetentionPeriodNanos":               "0",
 						},
-						"snapshotEnabled": true,
+						"retentionOverrides": xjson.Array{},
+						"snapshotEnabled":    true,
 						"indexOptions": xjson.Map{
 							"enabled":        false,
 							"blockSizeNanos": "7200000000000",
@@ -0,0 +1,611 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/histogram"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	bucketSuffix  = "_bucket"
+	sumSuffix     = "_sum"
+	countSuffix   = "_count"
+	bucketLabel   = "le"
+	quantileLabel = "quantile"
+
+	// cumulativeSweepInterval is how often series that have not received a
+	// delta point within the TTL are forgotten.
+	cumulativeSweepInterval = time.Minute
+)
+
+var (
+	errHistogramBucketsMismatch = errors.New("histogram bucket counts do not match explicit bounds")
+	errMetricNameEmpty          = errors.New("metric name must not be empty")
+)
+
+// seriesValue is a single datapoint of a series converted from OTLP.
+type seriesValue struct {
+	tags       models.Tags
+	datapoint  ts.Datapoint
+	attributes ts.SeriesAttributes
+	annotation []byte
+}
+
+// converter maps OTLP metrics to M3 series following the Prometheus
+// conventions: resource and data point attributes become tags, histograms
+// and summaries are split into _bucket, _sum and _count series and
+// exponential histograms become native histograms. Points with delta
+// temporality are accumulated so that only cumulative values are written,
+// which are then handled the same way as Prometheus remote write counters.
+type converter struct {
+	tagOpts    models.TagOptions
+	cumulative *cumulativeState
+}
+
+func newConverter(tagOpts models.TagOptions, deltaTTL time.Duration) *converter {
+	return &converter{
+		tagOpts:    tagOpts,
+		cumulative: newCumulativeState(deltaTTL, time.Now),
+	}
+}
+
+// convert converts the OTLP resource metrics to series values, points that
+// can not be converted are skipped and returned as an invalid params error.
+func (c *converter) convert(resourceMetrics []*metricspb.ResourceMetrics) ([]seriesValue, error) {
+	var (
+		result   []seriesValue
+		multiErr = xerrors.NewMultiError()
+	)
+	for _, rm := range resourceMetrics {
+		var resourceAttrs []*commonpb.KeyValue
+		if rm.Resource != nil {
+			resourceAttrs = rm.Resource.Attributes
+		}
+
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			for _, metric := range ilm.Metrics {
+				var err error
+				result, err = c.convertMetric(result, resourceAttrs, metric)
+				if err != nil {
+					multiErr = multiErr.Add(fmt.Errorf("metric %s: %w", metric.Name, err))
+				}
+			}
+		}
+	}
+
+	if err := multiErr.FinalError(); err != nil {
+		return result, xerrors.NewInvalidParamsError(err)
+	}
+	return result, nil
+}
+
+func (c *converter) convertMetric(
+	result []seriesValue,
+	resourceAttrs []*commonpb.KeyValue,
+	metric *metricspb.Metric,
+) ([]seriesValue, error) {
+	name := sanitizeName(metric.Name)
+	if name == "" {
+		return result, errMetricNameEmpty
+	}
+
+	switch data := metric.Data.(type) {
+	case *metricspb.Metric_Gauge:
+		attrs := ts.SeriesAttributes{
+			M3Type:   ts.M3MetricTypeGauge,
+			PromType: ts.PromMetricTypeGauge,
+		}
+		for _, p := range data.Gauge.DataPoints {
+			if noRecordedValue(p.Flags) {
+				continue
+			}
+			tags := c.tags(name, resourceAttrs, p.Attributes)
+			result = append(result, newSeriesValue(tags, p.TimeUnixNano,
+				numberValue(p), attrs))
+		}
+
+	case *metricspb.Metric_Sum:
+		attrs := ts.SeriesAttributes{
+			M3Type:   ts.M3MetricTypeGauge,
+			PromType: ts.PromMetricTypeGauge,
+		}
+		if data.Sum.IsMonotonic {
+			attrs = ts.SeriesAttributes{
+				M3Type:            ts.M3MetricTypeGauge,
+				PromType:          ts.PromMetricTypeCounter,
+				HandleValueResets: true,
+			}
+		}
+		delta := isDelta(data.Sum.AggregationTemporality)
+		for _, p := range data.Sum.DataPoints {
+			if noRecordedValue(p.Flags) {
+				continue
+			}
+			tags := c.tags(name, resourceAttrs, p.Attributes)
+			value := numberValue(p)
+			if delta {
+				var ok bool
+				value, ok = c.cumulative.addSum(string(tags.ID()), p.TimeUnixNano, value)
+				if !ok {
+					continue
+				}
+			}
+			result = append(result, newSeriesValue(tags, p.TimeUnixNano, value, attrs))
+		}
+
+	case *metricspb.Metric_Histogram:
+		delta := isDelta(data.Histogram.AggregationTemporality)
+		for _, p := range data.Histogram.DataPoints {
+			if noRecordedValue(p.Flags) {
+				continue
+			}
+			var err error
+			result, err = c.convertHistogram(result, name, resourceAttrs, p, delta)
+			if err != nil {
+				return result, err
+			}
+		}
+
+	case *metricspb.Metric_ExponentialHistogram:
+		delta := isDelta(data.ExponentialHistogram.AggregationTemporality)
+		for _, p := range data.ExponentialHistogram.DataPoints {
+			if noRecordedValue(p.Flags) {
+				continue
+			}
+			var err error
+			result, err = c.convertExponentialHistogram(result, name, resourceAttrs, p, delta)
+			if err != nil {
+				return result, err
+			}
+		}
+
+	case *metricspb.Metric_Summary:
+		attrs := ts.SeriesAttributes{
+			M3Type:   ts.M3MetricTypeGauge,
+			PromType: ts.PromMetricTypeSummary,
+		}
+		// The sum and count of a summary are cumulative.
+		cumulativeAttrs := attrs
+		cumulativeAttrs.HandleValueResets = true
+		for _, p := range data.Summary.DataPoints {
+			if noRecordedValue(p.Flags) {
+				continue
+			}
+			for _, q := range p.QuantileValues {
+				tags := c.tags(name, resourceAttrs, p.Attributes).
+					AddOrUpdateTag(models.Tag{
+						Name:  []byte(quantileLabel),
+						Value: []byte(formatFloat(q.Quantile)),
+					})
+				result = append(result, newSeriesValue(tags, p.TimeUnixNano, q.Value, attrs))
+			}
+			result = append(result,
+				newSeriesValue(c.tags(name+sumSuffix, resourceAttrs, p.Attributes),
+					p.TimeUnixNano, p.Sum, cumulativeAttrs),
+				newSeriesValue(c.tags(name+countSuffix, resourceAttrs, p.Attributes),
+					p.TimeUnixNano, float64(p.Count), cumulativeAttrs))
+		}
+
+	default:
+		return result, fmt.Errorf("unsupported metric type %T", metric.Data)
+	}
+
+	return result, nil
+}
+
+func (c *converter) convertHistogram(
+	result []seriesValue,
+	name string,
+	resourceAttrs []*commonpb.KeyValue,
+	p *metricspb.HistogramDataPoint,
+	delta bool,
+) ([]seriesValue, error) {
+	// There is one more bucket than bounds, the last bucket is +Inf.
+	if len(p.BucketCounts) > 0 && len(p.BucketCounts) != len(p.ExplicitBounds)+1 {
+		return result, errHistogramBucketsMismatch
+	}
+
+	var (
+		count   = float64(p.Count)
+		sum     = p.Sum
+		buckets = make([]float64, 0, len(p.BucketCounts))
+		attrs   = ts.SeriesAttributes{
+			M3Type:            ts.M3MetricTypeGauge,
+			PromType:          ts.PromMetricTypeHistogram,
+			HandleValueResets: true,
+		}
+	)
+	for _, bucket := range p.BucketCounts {
+		buckets = append(buckets, float64(bucket))
+	}
+
+	if delta {
+		id := string(c.tags(name, resourceAttrs, p.Attributes).ID())
+		var ok bool
+		count, sum, buckets, ok = c.cumulative.addHistogram(id, p.TimeUnixNano,
+			count, sum, p.ExplicitBounds, buckets)
+		if !ok {
+			return result, nil
+		}
+	}
+
+	var cumulative float64
+	for i, bucket := range buckets {
+		cumulative += bucket
+		bound := math.Inf(1)
+		if i < len(p.ExplicitBounds) {
+			bound = p.ExplicitBounds[i]
+		}
+		tags := c.tags(name+bucketSuffix, resourceAttrs, p.Attributes).
+			AddOrUpdateTag(models.Tag{
+				Name:  []byte(bucketLabel),
+				Value: []byte(formatFloat(bound)),
+			})
+		result = append(result, newSeriesValue(tags, p.TimeUnixNano, cumulative, attrs))
+	}
+
+	result = append(result,
+		newSeriesValue(c.tags(name+sumSuffix, resourceAttrs, p.Attributes),
+			p.TimeUnixNano, sum, attrs),
+		newSeriesValue(c.tags(name+countSuffix, resourceAttrs, p.Attributes),
+			p.TimeUnixNano, count, attrs))
+	return result, nil
+}
+
+func (c *converter) convertExponentialHistogram(
+	result []seriesValue,
+	name string,
+	resourceAttrs []*commonpb.KeyValue,
+	p *metricspb.ExponentialHistogramDataPoint,
+	delta bool,
+) ([]seriesValue, error) {
+	h, err := exponentialHistogramToM3(p)
+	if err != nil {
+		return result, err
+	}
+
+	tags := c.tags(name, resourceAttrs, p.Attributes)
+	if delta {
+		var ok bool
+		h, ok = c.cumulative.addExponentialHistogram(string(tags.ID()), p.TimeUnixNano, h)
+		if !ok {
+			return result, nil
+		}
+	}
+
+	payload := annotation.Payload{NativeHistogram: h.Marshal(nil)}
+	encoded, err := payload.Marshal()
+	if err != nil {
+		return result, err
+	}
+
+	value := newSeriesValue(tags, p.TimeUnixNano, h.Count, ts.SeriesAttributes{
+		M3Type:   ts.M3MetricTypeHistogram,
+		PromType: ts.PromMetricTypeHistogram,
+	})
+	value.annotation = encoded
+	return append(result, value), nil
+}
+
+// exponentialHistogramToM3 converts an OTLP exponential histogram to a
+// native histogram. The OTLP scale is equivalent to the native histogram
+// schema, however an OTLP bucket with index i covers (base^i, base^(i+1)]
+// so bucket offsets are shifted by one.
+func exponentialHistogramToM3(p *metricspb.ExponentialHistogramDataPoint) (*histogram.Histogram, error) {
+	if p.Scale < histogram.MinSchema {
+		return nil, fmt.Errorf("exponential histogram scale %d below minimum %d",
+			p.Scale, histogram.MinSchema)
+	}
+
+	h := &histogram.Histogram{
+		Schema:    p.Scale,
+		ZeroCount: float64(p.ZeroCount),
+		Count:     float64(p.Count),
+		Sum:       p.Sum,
+	}
+	h.PositiveSpans, h.PositiveBuckets = exponentialBucketsToM3(p.Positive)
+	h.NegativeSpans, h.NegativeBuckets = exponentialBucketsToM3(p.Negative)
+	h.ReduceResolution(histogram.MaxSchema)
+
+	if err := h.Validate(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func exponentialBucketsToM3(
+	buckets *metricspb.ExponentialHistogramDataPoint_Buckets,
+) ([]histogram.Span, []float64) {
+	if buckets == nil || len(buckets.BucketCounts) == 0 {
+		return nil, nil
+	}
+
+	counts := make([]float64, 0, len(buckets.BucketCounts))
+	for _, count := range buckets.BucketCounts {
+		counts = append(counts, float64(count))
+	}
+	spans := []histogram.Span{{
+		Offset: buckets.Offset + 1,
+		Length: uint32(len(counts)),
+	}}
+	return spans, counts
+}
+
+func (c *converter) tags(
+	name string,
+	resourceAttrs []*commonpb.KeyValue,
+	pointAttrs []*commonpb.KeyValue,
+) models.Tags {
+	tags := models.NewTags(len(resourceAttrs)+len(pointAttrs)+1, c.tagOpts)
+	// Data point attributes take precedence over resource attributes.
+	for _, attrs := range [][]*commonpb.KeyValue{resourceAttrs, pointAttrs} {
+		for _, kv := range attrs {
+			value, ok := attributeValue(kv.Value)
+			if !ok || value == "" {
+				continue
+			}
+			tags = tags.AddOrUpdateTag(models.Tag{
+				Name:  []byte(sanitizeLabelName(kv.Key)),
+				Value: []byte(value),
+			})
+		}
+	}
+	return tags.SetName([]byte(name))
+}
+
+func newSeriesValue(
+	tags models.Tags,
+	timeUnixNano uint64,
+	value float64,
+	attrs ts.SeriesAttributes,
+) seriesValue {
+	return seriesValue{
+		tags: tags,
+		datapoint: ts.Datapoint{
+			Timestamp: xtime.UnixNano(timeUnixNano),
+			Value:     value,
+		},
+		attributes: attrs,
+	}
+}
+
+func numberValue(p *metricspb.NumberDataPoint) float64 {
+	switch v := p.Value.(type) {
+	case *metricspb.NumberDataPoint_AsDouble:
+		return v.AsDouble
+	case *metricspb.NumberDataPoint_AsInt:
+		return float64(v.AsInt)
+	}
+	return 0
+}
+
+func attributeValue(v *commonpb.AnyValue) (string, bool) {
+	if v == nil {
+		return "", false
+	}
+	switch value := v.Value.(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue, true
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(value.BoolValue), true
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(value.IntValue, 10), true
+	case *commonpb.AnyValue_DoubleValue:
+		return formatFloat(value.DoubleValue), true
+	}
+	// Arrays, maps and bytes have no sensible tag representation.
+	return "", false
+}
+
+func isDelta(temporality metricspb.AggregationTemporality) bool {
+	return temporality == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+}
+
+func noRecordedValue(flags uint32) bool {
+	return flags&uint32(metricspb.DataPointFlags_FLAG_NO_RECORDED_VALUE) != 0
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// sanitizeName replaces characters that are not valid in a Prometheus metric
+// name with underscores.
+func sanitizeName(name string) string {
+	return sanitize(name, true)
+}
+
+// sanitizeLabelName replaces characters that are not valid in a Prometheus
+// label name with underscores.
+func sanitizeLabelName(name string) string {
+	return sanitize(name, false)
+}
+
+func sanitize(name string, allowColon bool) string {
+	if name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	if name[0] >= '0' && name[0] <= '9' {
+		b.WriteByte('_')
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		valid := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+			(c >= '0' && c <= '9') || c == '_' || (allowColon && c == ':')
+		if !valid {
+			c = '_'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// cumulativeState accumulates points with delta temporality into cumulative
+// values per series, series that stop receiving points are forgotten after
+// the TTL.
+type cumulativeState struct {
+	sync.Mutex
+
+	ttl       time.Duration
+	nowFn     func() time.Time
+	series    map[string]*cumulativeSeries
+	lastSweep time.Time
+}
+
+type cumulativeSeries struct {
+	lastSeen     time.Time
+	lastUnixNano uint64
+
+	value     float64
+	count     float64
+	sum       float64
+	bounds    []float64
+	buckets   []float64
+	histogram *histogram.Histogram
+}
+
+func newCumulativeState(ttl time.Duration, nowFn func() time.Time) *cumulativeState {
+	return &cumulativeState{
+		ttl:       ttl,
+		nowFn:     nowFn,
+		series:    make(map[string]*cumulativeSeries),
+		lastSweep: nowFn(),
+	}
+}
+
+// seriesWithLock returns the state of the series, ok is false if the point
+// is not newer than the last point of the series.
+func (s *cumulativeState) seriesWithLock(id string, timeUnixNano uint64) (*cumulativeSeries, bool) {
+	now := s.nowFn()
+	if now.Sub(s.lastSweep) >= cumulativeSweepInterval {
+		for k, series := range s.series {
+			if now.Sub(series.lastSeen) > s.ttl {
+				delete(s.series, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	series, ok := s.series[id]
+	if !ok {
+		series = &cumulativeSeries{}
+		s.series[id] = series
+	} else if timeUnixNano <= series.lastUnixNano {
+		return nil, false
+	}
+
+	series.lastSeen = now
+	series.lastUnixNano = timeUnixNano
+	return series, true
+}
+
+func (s *cumulativeState) addSum(id string, timeUnixNano uint64, delta float64) (float64, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	series, ok := s.seriesWithLock(id, timeUnixNano)
+	if !ok {
+		return 0, false
+	}
+	series.value += delta
+	return series.value, true
+}
+
+func (s *cumulativeState) addHistogram(
+	id string,
+	timeUnixNano uint64,
+	count, sum float64,
+	bounds, buckets []float64,
+) (float64, float64, []float64, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	series, ok := s.seriesWithLock(id, timeUnixNano)
+	if !ok {
+		return 0, 0, nil, false
+	}
+
+	// Restart accumulating if the bucket layout changes.
+	if !floatsEqual(series.bounds, bounds) || len(series.buckets) != len(buckets) {
+		series.bounds = append(series.bounds[:0], bounds...)
+		series.buckets = make([]float64, len(buckets))
+		series.count, series.sum = 0, 0
+	}
+
+	series.count += count
+	series.sum += sum
+	for i, bucket := range buckets {
+		series.buckets[i] += bucket
+	}
+	return series.count, series.sum, append([]float64(nil), series.buckets...), true
+}
+
+func (s *cumulativeState) addExponentialHistogram(
+	id string,
+	timeUnixNano uint64,
+	h *histogram.Histogram,
+) (*histogram.Histogram, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	series, ok := s.seriesWithLock(id, timeUnixNano)
+	if !ok {
+		return nil, false
+	}
+
+	if series.histogram == nil {
+		series.histogram = h.Copy()
+	} else {
+		series.histogram.Add(h)
+	}
+	return series.histogram.Copy(), true
+}
+
+func floatsEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/histogram"
+)
+
+const testTimeUnixNano = uint64(1600000000000000000)
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func resourceMetrics(metrics ...*metricspb.Metric) []*metricspb.ResourceMetrics {
+	return []*metricspb.ResourceMetrics{
+		{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{
+					stringAttr("service.name", "api"),
+					stringAttr("region", "us-east"),
+				},
+			},
+			InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{
+				{Metrics: metrics},
+			},
+		},
+	}
+}
+
+func sumMetric(
+	name string,
+	temporality metricspb.AggregationTemporality,
+	timeUnixNano uint64,
+	value float64,
+) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name: name,
+		Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			AggregationTemporality: temporality,
+			IsMonotonic:            true,
+			DataPoints: []*metricspb.NumberDataPoint{
+				{
+					TimeUnixNano: timeUnixNano,
+					Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+				},
+			},
+		}},
+	}
+}
+
+func tagsMap(tags models.Tags) map[string]string {
+	result := make(map[string]string, tags.Len())
+	for _, tag := range tags.Tags {
+		result[string(tag.Name)] = string(tag.Value)
+	}
+	return result
+}
+
+func TestConvertGauge(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	values, err := c.convert(resourceMetrics(&metricspb.Metric{
+		Name: "http.server.active_requests",
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{
+				{
+					Attributes:   []*commonpb.KeyValue{stringAttr("region", "eu-west")},
+					TimeUnixNano: testTimeUnixNano,
+					Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 42},
+				},
+				{
+					TimeUnixNano: testTimeUnixNano,
+					Flags:        uint32(metricspb.DataPointFlags_FLAG_NO_RECORDED_VALUE),
+				},
+			},
+		}},
+	}))
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	assert.Equal(t, map[string]string{
+		"__name__":     "http_server_active_requests",
+		"service_name": "api",
+		"region":       "eu-west",
+	}, tagsMap(values[0].tags))
+	assert.Equal(t, 42.0, values[0].datapoint.Value)
+	assert.Equal(t, int64(testTimeUnixNano), int64(values[0].datapoint.Timestamp))
+	assert.Equal(t, ts.PromMetricTypeGauge, values[0].attributes.PromType)
+}
+
+func TestConvertDeltaSum(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	delta := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+
+	var result []float64
+	for i, v := range []float64{1, 2, 3} {
+		values, err := c.convert(resourceMetrics(
+			sumMetric("requests", delta, testTimeUnixNano+uint64(i), v)))
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+		result = append(result, values[0].datapoint.Value)
+
+		attrs := values[0].attributes
+		assert.Equal(t, ts.PromMetricTypeCounter, attrs.PromType)
+		assert.Equal(t, ts.M3MetricTypeGauge, attrs.M3Type)
+		assert.True(t, attrs.HandleValueResets)
+	}
+	assert.Equal(t, []float64{1, 3, 6}, result)
+
+	// Points that are not newer than the last point are dropped.
+	values, err := c.convert(resourceMetrics(
+		sumMetric("requests", delta, testTimeUnixNano, 10)))
+	require.NoError(t, err)
+	assert.Len(t, values, 0)
+}
+
+func TestConvertDeltaSumExpires(t *testing.T) {
+	now := time.Unix(0, int64(testTimeUnixNano))
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	c.cumulative = newCumulativeState(time.Hour, func() time.Time { return now })
+	delta := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+
+	values, err := c.convert(resourceMetrics(
+		sumMetric("requests", delta, testTimeUnixNano, 5)))
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	now = now.Add(2 * time.Hour)
+	values, err = c.convert(resourceMetrics(
+		sumMetric("requests", delta, testTimeUnixNano+1, 2)))
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	assert.Equal(t, 2.0, values[0].datapoint.Value)
+}
+
+func TestConvertHistogram(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	values, err := c.convert(resourceMetrics(&metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.HistogramDataPoint{
+				{
+					TimeUnixNano:   testTimeUnixNano,
+					Count:          6,
+					Sum:            12.5,
+					ExplicitBounds: []float64{0.5, 1},
+					BucketCounts:   []uint64{1, 2, 3},
+				},
+			},
+		}},
+	}))
+	require.NoError(t, err)
+
+	type series struct {
+		name  string
+		le    string
+		value float64
+	}
+	var actual []series
+	for _, v := range values {
+		tags := tagsMap(v.tags)
+		actual = append(actual, series{name: tags["__name__"], le: tags["le"], value: v.datapoint.Value})
+		assert.Equal(t, ts.PromMetricTypeHistogram, v.attributes.PromType)
+	}
+	assert.Equal(t, []series{
+		{name: "latency_bucket", le: "0.5", value: 1},
+		{name: "latency_bucket", le: "1", value: 3},
+		{name: "latency_bucket", le: "+Inf", value: 6},
+		{name: "latency_sum", value: 12.5},
+		{name: "latency_count", value: 6},
+	}, actual)
+}
+
+func TestConvertHistogramBucketsMismatch(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	_, err := c.convert(resourceMetrics(&metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			DataPoints: []*metricspb.HistogramDataPoint{
+				{
+					TimeUnixNano:   testTimeUnixNano,
+					ExplicitBounds: []float64{0.5, 1},
+					BucketCounts:   []uint64{1, 2},
+				},
+			},
+		}},
+	}))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestConvertExponentialHistogram(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	values, err := c.convert(resourceMetrics(&metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: &metricspb.ExponentialHistogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.ExponentialHistogramDataPoint{
+				{
+					TimeUnixNano: testTimeUnixNano,
+					Scale:        0,
+					Count:        6,
+					Sum:          20,
+					ZeroCount:    1,
+					Positive: &metricspb.ExponentialHistogramDataPoint_Buckets{
+						Offset:       1,
+						BucketCounts: []uint64{2, 3},
+					},
+				},
+			},
+		}},
+	}))
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	v := values[0]
+	assert.Equal(t, "latency", tagsMap(v.tags)["__name__"])
+	assert.Equal(t, 6.0, v.datapoint.Value)
+	assert.Equal(t, ts.M3MetricTypeHistogram, v.attributes.M3Type)
+
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(v.annotation))
+	h, err := histogram.Unmarshal(payload.NativeHistogram)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), h.Schema)
+	assert.Equal(t, 1.0, h.ZeroCount)
+	// OTLP bucket 1 covers (2, 4] which is native histogram bucket 2.
+	assert.Equal(t, []histogram.Span{{Offset: 2, Length: 2}}, h.PositiveSpans)
+	assert.Equal(t, []float64{2, 3}, h.PositiveBuckets)
+}
+
+func TestConvertSummary(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	values, err := c.convert(resourceMetrics(&metricspb.Metric{
+		Name: "rpc.duration",
+		Data: &metricspb.Metric_Summary{Summary: &metricspb.Summary{
+			DataPoints: []*metricspb.SummaryDataPoint{
+				{
+					TimeUnixNano: testTimeUnixNano,
+					Count:        10,
+					Sum:          4,
+					QuantileValues: []*metricspb.SummaryDataPoint_ValueAtQuantile{
+						{Quantile: 0.99, Value: 0.8},
+					},
+				},
+			},
+		}},
+	}))
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+
+	assert.Equal(t, map[string]string{
+		"__name__":     "rpc_duration",
+		"service_name": "api",
+		"region":       "us-east",
+		"quantile":     "0.99",
+	}, tagsMap(values[0].tags))
+	assert.False(t, values[0].attributes.HandleValueResets)
+	assert.Equal(t, "rpc_duration_sum", tagsMap(values[1].tags)["__name__"])
+	assert.Equal(t, "rpc_duration_count", tagsMap(values[2].tags)["__name__"])
+	assert.Equal(t, 10.0, values[2].datapoint.Value)
+	assert.True(t, values[2].attributes.HandleValueResets)
+}
+
+func TestConvertInvalidMetric(t *testing.T) {
+	c := newConverter(models.NewTagOptions(), time.Hour)
+	values, err := c.convert(resourceMetrics(
+		&metricspb.Metric{Name: "empty"},
+		sumMetric("", metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			testTimeUnixNano, 1),
+		sumMetric("valid", metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			testTimeUnixNano, 1),
+	))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+	require.Len(t, values, 1)
+	assert.Equal(t, "valid", tagsMap(values[0].tags)["__name__"])
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeName("http.server.duration"))
+	assert.Equal(t, "ns:requests_total", sanitizeName("ns:requests-total"))
+	assert.Equal(t, "_2xx", sanitizeName("2xx"))
+	assert.Equal(t, "ns_requests", sanitizeLabelName("ns:requests"))
+	assert.Equal(t, "+Inf", formatFloat(math.Inf(1)))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"context"
+
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	xerrors "github.com/m3db/m3/src/x/errors"
+)
+
+type metricsService struct {
+	collectormetricspb.UnimplementedMetricsServiceServer
+
+	writer options.OTLPWriter
+}
+
+// RegisterMetricsService registers the OTLP/gRPC metrics service with the
+// gRPC server.
+func RegisterMetricsService(server *grpc.Server, writer options.OTLPWriter) {
+	collectormetricspb.RegisterMetricsServiceServer(server, &metricsService{
+		writer: writer,
+	})
+}
+
+func (s *metricsService) Export(
+	ctx context.Context,
+	req *collectormetricspb.ExportMetricsServiceRequest,
+) (*collectormetricspb.ExportMetricsServiceResponse, error) {
+	if err := s.writer.Write(ctx, req); err != nil {
+		// NB: OTLP exporters retry requests that fail with unavailable but
+		// not those that fail with invalid argument.
+		if xerrors.IsInvalidParams(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &collectormetricspb.ExportMetricsServiceResponse{}, nil
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"time"
+
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// WriteURL is the OTLP/HTTP metrics write handler URL.
+	WriteURL = route.Prefix + "/otlp/v1/metrics"
+
+	// WriteHTTPMethod is the HTTP method used with this resource.
+	WriteHTTPMethod = http.MethodPost
+
+	// DefaultDeltaTemporalityTTL is the default duration after which the
+	// accumulated value of a series with delta temporality is forgotten if
+	// no new points are received for it.
+	DefaultDeltaTemporalityTTL = time.Hour
+
+	protobufContentType = "application/x-protobuf"
+)
+
+var defaultValue = ingest.IterValue{
+	Tags:       models.EmptyTags(),
+	Attributes: ts.DefaultSeriesAttributes(),
+	Metadata:   ts.Metadata{},
+}
+
+// Writer converts OTLP metric export requests to M3 series and writes them
+// through the downsampler and writer so that mapping and rollup rules apply.
+type Writer struct {
+	handlerOpts options.HandlerOptions
+	converter   *converter
+}
+
+// NewWriter returns a new OTLP metrics writer.
+func NewWriter(opts options.HandlerOptions, deltaTemporalityTTL time.Duration) *Writer {
+	if deltaTemporalityTTL <= 0 {
+		deltaTemporalityTTL = DefaultDeltaTemporalityTTL
+	}
+	return &Writer{
+		handlerOpts: opts,
+		converter:   newConverter(opts.TagOptions(), deltaTemporalityTTL),
+	}
+}
+
+// Write converts and writes the metrics of the export request, the returned
+// error is an invalid params error if it was caused only by bad input.
+func (w *Writer) Write(
+	ctx context.Context,
+	req *collectormetricspb.ExportMetricsServiceRequest,
+) error {
+	values, convertErr := w.converter.convert(req.ResourceMetrics)
+
+	var batchErr ingest.BatchError
+	if len(values) > 0 {
+		iter := &ingestIterator{values: values}
+		batchErr = w.handlerOpts.DownsamplerAndWriter().
+			WriteBatch(ctx, iter, ingest.WriteOptions{})
+	}
+
+	var errs []error
+	if convertErr != nil {
+		errs = append(errs, convertErr)
+	}
+	if batchErr != nil {
+		errs = append(errs, batchErr.Errors()...)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	var (
+		lastRegularErr    string
+		lastBadRequestErr string
+		numRegular        int
+		numBadRequest     int
+	)
+	for _, err := range errs {
+		switch {
+		case client.IsBadRequestError(err):
+			numBadRequest++
+			lastBadRequestErr = err.Error()
+		case xerrors.IsInvalidParams(err):
+			numBadRequest++
+			lastBadRequestErr = err.Error()
+		default:
+			numRegular++
+			lastRegularErr = err.Error()
+		}
+	}
+
+	logger := logging.WithContext(ctx, w.handlerOpts.InstrumentOpts())
+	logger.Error("otlp write error",
+		zap.Int("numRegularErrors", numRegular),
+		zap.Int("numBadRequestErrors", numBadRequest),
+		zap.String("lastRegularError", lastRegularErr),
+		zap.String("lastBadRequestErr", lastBadRequestErr))
+
+	var resultErr string
+	if lastRegularErr != "" {
+		resultErr = fmt.Sprintf("retryable_errors: count=%d, last=%s",
+			numRegular, lastRegularErr)
+	}
+	if lastBadRequestErr != "" {
+		var sep string
+		if lastRegularErr != "" {
+			sep = ", "
+		}
+		resultErr = fmt.Sprintf("%s%sbad_request_errors: count=%d, last=%s",
+			resultErr, sep, numBadRequest, lastBadRequestErr)
+	}
+
+	err := errors.New(resultErr)
+	if numRegular == 0 {
+		return xerrors.NewInvalidParamsError(err)
+	}
+	return err
+}
+
+type ingestIterator struct {
+	values    []seriesValue
+	metadatas []ts.Metadata
+	idx       int
+}
+
+func (ii *ingestIterator) Next() bool {
+	ii.idx++
+	return ii.idx <= len(ii.values)
+}
+
+func (ii *ingestIterator) Current() ingest.IterValue {
+	if ii.idx < 1 || ii.idx > len(ii.values) {
+		return defaultValue
+	}
+
+	v := ii.values[ii.idx-1]
+	value := ingest.IterValue{
+		Tags:       v.tags,
+		Datapoints: []ts.Datapoint{v.datapoint},
+		Attributes: v.attributes,
+		Unit:       xtime.Nanosecond,
+		Annotation: v.annotation,
+	}
+	if ii.idx-1 < len(ii.metadatas) {
+		value.Metadata = ii.metadatas[ii.idx-1]
+	}
+	return value
+}
+
+func (ii *ingestIterator) Reset() error {
+	ii.idx = 0
+	return nil
+}
+
+func (ii *ingestIterator) Error() error {
+	return nil
+}
+
+func (ii *ingestIterator) SetCurrentMetadata(metadata ts.Metadata) {
+	if len(ii.metadatas) == 0 {
+		ii.metadatas = make([]ts.Metadata, len(ii.values))
+	}
+	if ii.idx >= 1 && ii.idx <= len(ii.values) {
+		ii.metadatas[ii.idx-1] = metadata
+	}
+}
+
+type writeHandler struct {
+	writer options.OTLPWriter
+}
+
+// NewWriteHandler returns a new OTLP/HTTP metrics write handler, only the
+// binary protobuf encoding is supported.
+func NewWriteHandler(writer options.OTLPWriter) http.Handler {
+	return &writeHandler{writer: writer}
+}
+
+func (h *writeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Body == http.NoBody {
+		xhttp.WriteError(w, xhttp.NewError(errors.New("empty request body"), http.StatusBadRequest))
+		return
+	}
+
+	if contentType := r.Header.Get(xhttp.HeaderContentType); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != protobufContentType {
+			err := fmt.Errorf("unsupported content type %q, expected %s",
+				contentType, protobufContentType)
+			xhttp.WriteError(w, xhttp.NewError(err, http.StatusUnsupportedMediaType))
+			return
+		}
+	}
+
+	var (
+		reader io.ReadCloser
+		err    error
+	)
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		reader, err = gzip.NewReader(r.Body)
+		if err != nil {
+			xhttp.WriteError(w, xhttp.NewError(err, http.StatusBadRequest))
+			return
+		}
+	} else {
+		reader = r.Body
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if err := reader.Close(); err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var req collectormetricspb.ExportMetricsServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		xhttp.WriteError(w, xhttp.NewError(err, http.StatusBadRequest))
+		return
+	}
+
+	if err := h.writer.Write(r.Context(), &req); err != nil {
+		status := http.StatusInternalServerError
+		if xerrors.IsInvalidParams(err) {
+			status = http.StatusBadRequest
+		}
+		xhttp.WriteError(w, xhttp.NewError(err, status))
+		return
+	}
+
+	resp, err := proto.Marshal(&collectormetricspb.ExportMetricsServiceResponse{})
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set(xhttp.HeaderContentType, protobufContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp) // nolint:errcheck
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func makeOptions(ds ingest.DownsamplerAndWriter) options.HandlerOptions {
+	return options.EmptyHandlerOptions().
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions())
+}
+
+func makeExportRequest(t *testing.T, isGzipped bool) io.Reader {
+	t.Helper()
+	req := &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: resourceMetrics(sumMetric("requests",
+			metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			testTimeUnixNano, 3)),
+	}
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+
+	if !isGzipped {
+		return bytes.NewReader(data)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return &buf
+}
+
+func TestWriteHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           io.Reader
+		requestHeaders map[string]string
+		writeErr       error
+		expectWrite    bool
+		expectedStatus int
+	}{
+		{
+			name:           "protobuf",
+			body:           makeExportRequest(t, false),
+			requestHeaders: map[string]string{xhttp.HeaderContentType: protobufContentType},
+			expectWrite:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "gzip encoded protobuf",
+			body: makeExportRequest(t, true),
+			requestHeaders: map[string]string{
+				xhttp.HeaderContentType: protobufContentType,
+				"Content-Encoding":      "gzip",
+			},
+			expectWrite:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "json",
+			body:           bytes.NewReader([]byte("{}")),
+			requestHeaders: map[string]string{xhttp.HeaderContentType: xhttp.ContentTypeJSON},
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:           "invalid protobuf",
+			body:           bytes.NewReader([]byte("invalid")),
+			requestHeaders: map[string]string{xhttp.HeaderContentType: protobufContentType},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "write error",
+			body:           makeExportRequest(t, false),
+			requestHeaders: map[string]string{xhttp.HeaderContentType: protobufContentType},
+			writeErr:       errors.New("write failed"),
+			expectWrite:    true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := xtest.NewController(t)
+			defer ctrl.Finish()
+
+			mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+			if tt.expectWrite {
+				mockDownsamplerAndWriter.
+					EXPECT().
+					WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						iter ingest.DownsampleAndWriteIter,
+						_ ingest.WriteOptions,
+					) ingest.BatchError {
+						require.True(t, iter.Next())
+						value := iter.Current()
+						assert.Equal(t, "requests", tagsMap(value.Tags)["__name__"])
+						assert.Equal(t, 3.0, value.Datapoints[0].Value)
+						assert.Equal(t, xtime.Nanosecond, value.Unit)
+						require.False(t, iter.Next())
+
+						if tt.writeErr != nil {
+							return xerrors.NewMultiError().Add(tt.writeErr)
+						}
+						return nil
+					})
+			}
+
+			handler := NewWriteHandler(NewWriter(makeOptions(mockDownsamplerAndWriter), 0))
+			req := httptest.NewRequest(WriteHTTPMethod, WriteURL, tt.body)
+			for header, value := range tt.requestHeaders {
+				req.Header.Set(header, value)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			resp := recorder.Result()
+			defer resp.Body.Close() // nolint:errcheck
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, protobufContentType, resp.Header.Get(xhttp.HeaderContentType))
+			}
+		})
+	}
+}
+
+func TestMetricsServiceExport(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	service := &metricsService{
+		writer: NewWriter(makeOptions(mockDownsamplerAndWriter), 0),
+	}
+
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	resp, err := service.Export(context.Background(), &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: resourceMetrics(sumMetric("requests",
+			metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			testTimeUnixNano, 3)),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	// Metrics that can not be converted are not retried.
+	_, err = service.Export(context.Background(), &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: resourceMetrics(&metricspb.Metric{Name: "empty"}),
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(errors.New("write failed")))
+	_, err = service.Export(context.Background(), &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: resourceMetrics(sumMetric("requests",
+			metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			testTimeUnixNano, 3)),
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
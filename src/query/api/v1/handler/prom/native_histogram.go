@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"errors"
+
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/m3db/m3/src/query/functions/linear"
+)
+
+var errHistogramSumUnsupported = errors.New(
+	"histogram_sum is only supported by the M3 query engine")
+
+func init() {
+	// NB: the vendored Prometheus engine predates native histograms. Series
+	// read through the Prometheus engine hold the count of observations of
+	// native histograms, so histogram_count can be evaluated while
+	// histogram_sum cannot.
+	if _, ok := promql.FunctionCalls[linear.HistogramCountType]; !ok {
+		promql.FunctionCalls[linear.HistogramCountType] = funcHistogramCount
+	}
+
+	if _, ok := promql.FunctionCalls[linear.HistogramSumType]; !ok {
+		promql.FunctionCalls[linear.HistogramSumType] = funcHistogramSum
+	}
+}
+
+func funcHistogramCount(
+	vals []parser.Value,
+	_ parser.Expressions,
+	enh *promql.EvalNodeHelper,
+) promql.Vector {
+	for _, el := range vals[0].(promql.Vector) {
+		enh.Out = append(enh.Out, promql.Sample{
+			Metric: enh.DropMetricName(el.Metric),
+			Point:  promql.Point{V: el.V},
+		})
+	}
+
+	return enh.Out
+}
+
+func funcHistogramSum(
+	[]parser.Value,
+	parser.Expressions,
+	*promql.EvalNodeHelper,
+) promql.Vector {
+	// NB: the engine recovers errors panicked by functions and returns them
+	// as query errors.
+	panic(errHistogramSumUnsupported)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusEngineNativeHistogramFunctions(t *testing.T) {
+	test, err := promql.NewTest(t, `
+load 1m
+	foo{a="b"} 1 2 3
+`)
+	require.NoError(t, err)
+	defer test.Close()
+	require.NoError(t, test.Run())
+
+	ts := time.Unix(120, 0)
+	q, err := test.QueryEngine().NewInstantQuery(test.Queryable(),
+		"histogram_count(foo)", ts)
+	require.NoError(t, err)
+	res := q.Exec(context.Background())
+	require.NoError(t, res.Err)
+
+	vector, err := res.Vector()
+	require.NoError(t, err)
+	require.Len(t, vector, 1)
+	require.Equal(t, `{a="b"}`, vector[0].Metric.String())
+	require.Equal(t, 3.0, vector[0].V)
+
+	q, err = test.QueryEngine().NewInstantQuery(test.Queryable(),
+		"histogram_sum(foo)", ts)
+	require.NoError(t, err)
+	res = q.Exec(context.Background())
+	require.Equal(t, errHistogramSumUnsupported, res.Err)
+}
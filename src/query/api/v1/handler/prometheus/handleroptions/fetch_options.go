@@ -34,6 +34,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/query/util"
@@ -78,6 +79,8 @@ type FetchOptionsBuilderOptions struct {
 	Limits        FetchOptionsBuilderLimitsOptions
 	RestrictByTag *storage.RestrictByTag
 	Timeout       time.Duration
+	// Quotas optionally caps the series limit by the tenant's quota.
+	Quotas quota.Enforcer
 }
 
 // Validate validates the fetch options builder options.
@@ -374,6 +377,16 @@ func (b fetchOptionsBuilder) newFetchOptions(
 
 	fetchOpts.RequireExhaustive = requireExhaustive
 
+	if b.opts.Quotas != nil {
+		tenant := b.opts.Quotas.Tenant(req.Header)
+		quotaLimit := b.opts.Quotas.QuerySeriesLimit(tenant)
+		if quotaLimit > 0 && (fetchOpts.SeriesLimit <= 0 || fetchOpts.SeriesLimit > quotaLimit) {
+			// Fail rather than truncate queries exceeding the tenant's quota.
+			fetchOpts.SeriesLimit = quotaLimit
+			fetchOpts.RequireExhaustive = true
+		}
+	}
+
 	requireNoWait, err := ParseRequireNoWait(req)
 	if err != nil {
 		return nil, nil, err
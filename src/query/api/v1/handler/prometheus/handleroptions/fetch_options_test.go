@@ -40,6 +40,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -528,6 +529,49 @@ func TestFetchOptionsWithHeader(t *testing.T) {
 	require.Equal(t, encoding.IterateLowestValue, *opts.IterateEqualTimestampStrategy)
 }
 
+func TestFetchOptionsWithQuota(t *testing.T) {
+	enforcer := quota.NewEnforcer(quota.Options{})
+	enforcer.Update(quota.Quotas{
+		Tenants: map[string]quota.Quota{
+			"foo": {QuerySeriesFetched: 10},
+		},
+	})
+
+	builder, err := NewFetchOptionsBuilder(FetchOptionsBuilderOptions{
+		Limits: FetchOptionsBuilderLimitsOptions{
+			SeriesLimit: 100,
+		},
+		Timeout: 10 * time.Second,
+		Quotas:  enforcer,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		tenant             string
+		limit              string
+		expectedLimit      int
+		expectedExhaustive bool
+	}{
+		{tenant: "foo", expectedLimit: 10, expectedExhaustive: true},
+		{tenant: "foo", limit: "5", expectedLimit: 5},
+		{tenant: "foo", limit: "0", expectedLimit: 10, expectedExhaustive: true},
+		{tenant: "bar", expectedLimit: 100},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set(headers.TenantHeader, tt.tenant)
+		if tt.limit != "" {
+			req.Header.Set(headers.LimitMaxSeriesHeader, tt.limit)
+		}
+
+		_, opts, err := builder.NewFetchOptions(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, tt.expectedLimit, opts.SeriesLimit)
+		require.Equal(t, tt.expectedExhaustive, opts.RequireExhaustive)
+	}
+}
+
 func stripSpace(str string) string {
 	return regexp.MustCompile(`\s+`).ReplaceAllString(str, "")
 }
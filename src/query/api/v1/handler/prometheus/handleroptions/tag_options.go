@@ -43,6 +43,10 @@ func matchByName(name string) (models.MatchType, error) {
 		t = models.MatchField
 	case "NOTEXISTS":
 		t = models.MatchNotField
+	case "RANGE":
+		t = models.MatchRange
+	case "NOTRANGE":
+		t = models.MatchNotRange
 	case "ALL":
 		return t, errors.New("ALL type not supported as a tag matcher restriction")
 	default:
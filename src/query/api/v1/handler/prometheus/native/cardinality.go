@@ -0,0 +1,384 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/block"
+	queryerrors "github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// CardinalityURL is the url for the series cardinality endpoint.
+	CardinalityURL = route.StatusTSDBURL
+
+	cardinalityLimitParam     = "limit"
+	cardinalityBlockSizeParam = "blockSize"
+
+	defaultCardinalityLimit     = 10
+	defaultCardinalityBlockSize = 2 * time.Hour
+	defaultCardinalityBlocks    = 6
+	maxCardinalityBlocks        = 48
+)
+
+// CardinalityHTTPMethods are the HTTP methods for this handler.
+var CardinalityHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+// CardinalityHandler reports the metric names and labels responsible for
+// the most series, in the same format as the Prometheus TSDB status API.
+// Series counts come from the per term documents counts of the index
+// aggregate queries; top-N statistics are computed over the most recent
+// index block of the queried range, and the series count of every index
+// block in the range is reported to show series growth.
+type CardinalityHandler struct {
+	storage             storage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	parseOpts           promql.ParseOptions
+	instrumentOpts      instrument.Options
+	tagOpts             models.TagOptions
+	nowFn               func() time.Time
+}
+
+// NewCardinalityHandler returns a new instance of handler.
+func NewCardinalityHandler(opts options.HandlerOptions) http.Handler {
+	return &CardinalityHandler{
+		storage:             opts.Storage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		parseOpts:           promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		instrumentOpts:      opts.InstrumentOpts(),
+		tagOpts:             opts.TagOptions(),
+		nowFn:               opts.NowFn(),
+	}
+}
+
+type cardinalityResponse struct {
+	Status string          `json:"status"`
+	Data   cardinalityData `json:"data"`
+}
+
+type cardinalityData struct {
+	HeadStats                   cardinalityHeadStats    `json:"headStats"`
+	SeriesCountByMetricName     []cardinalityStat       `json:"seriesCountByMetricName"`
+	LabelValueCountByLabelName  []cardinalityStat       `json:"labelValueCountByLabelName"`
+	SeriesCountByLabelValuePair []cardinalityStat       `json:"seriesCountByLabelValuePair"`
+	SeriesCountByIndexBlock     []cardinalityBlockStats `json:"seriesCountByIndexBlock"`
+}
+
+type cardinalityHeadStats struct {
+	NumSeries     int64 `json:"numSeries"`
+	NumLabelPairs int64 `json:"numLabelPairs"`
+	MinTime       int64 `json:"minTime"`
+	MaxTime       int64 `json:"maxTime"`
+}
+
+type cardinalityStat struct {
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+type cardinalityBlockStats struct {
+	BlockStart  time.Time `json:"blockStart"`
+	SeriesCount int64     `json:"seriesCount"`
+	Growth      int64     `json:"growth"`
+}
+
+type cardinalityParams struct {
+	start, end  time.Time
+	blockSize   time.Duration
+	limit       int
+	tagMatchers models.Matchers
+}
+
+func (h *CardinalityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	ctx, opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if rErr != nil {
+		xhttp.WriteError(w, rErr)
+		return
+	}
+
+	params, err := h.parseParams(r)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	if err := applyCardinalityRangeLimit(&params, opts); err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	var (
+		metricName = string(h.tagOpts.MetricName())
+		meta       = block.NewResultMetadata()
+		blocks     = make([]cardinalityBlockStats, 0, defaultCardinalityBlocks)
+	)
+	blockStart := params.start.Truncate(params.blockSize)
+	lastBlockStart := params.end.Add(-1).Truncate(params.blockSize)
+	for ; blockStart.Before(lastBlockStart); blockStart = blockStart.Add(params.blockSize) {
+		result, err := h.storage.CompleteTags(ctx, &storage.CompleteTagsQuery{
+			FilterNameTags:   [][]byte{[]byte(metricName)},
+			TagMatchers:      params.tagMatchers,
+			Start:            xtime.ToUnixNano(blockStart),
+			End:              xtime.ToUnixNano(blockStart.Add(params.blockSize)),
+			IncludeDocsCount: true,
+		}, opts)
+		if err != nil {
+			h.writeError(w, logger, err)
+			return
+		}
+
+		meta = meta.CombineMetadata(result.Metadata)
+		var seriesCount int64
+		for _, tag := range result.CompletedTags {
+			if string(tag.Name) == metricName {
+				seriesCount += sumDocsCounts(tag.DocsCounts)
+			}
+		}
+		blocks = append(blocks, cardinalityBlockStats{
+			BlockStart:  blockStart,
+			SeriesCount: seriesCount,
+		})
+	}
+
+	// Compute the detailed statistics for the most recent index block.
+	result, err := h.storage.CompleteTags(ctx, &storage.CompleteTagsQuery{
+		TagMatchers:      params.tagMatchers,
+		Start:            xtime.ToUnixNano(lastBlockStart),
+		End:              xtime.ToUnixNano(params.end),
+		IncludeDocsCount: true,
+	}, opts)
+	if err != nil {
+		h.writeError(w, logger, err)
+		return
+	}
+	meta = meta.CombineMetadata(result.Metadata)
+
+	var (
+		data = cardinalityData{
+			HeadStats: cardinalityHeadStats{
+				MinTime: lastBlockStart.UnixNano() / int64(time.Millisecond),
+				MaxTime: params.end.UnixNano() / int64(time.Millisecond),
+			},
+		}
+		metricNames    []cardinalityStat
+		labelNames     []cardinalityStat
+		labelPairs     []cardinalityStat
+		lastBlockCount int64
+	)
+	for _, tag := range result.CompletedTags {
+		name := string(tag.Name)
+		labelNames = append(labelNames, cardinalityStat{
+			Name:  name,
+			Value: int64(len(tag.Values)),
+		})
+		for i, value := range tag.Values {
+			var docs int64
+			if i < len(tag.DocsCounts) {
+				docs = tag.DocsCounts[i]
+			}
+			data.HeadStats.NumLabelPairs++
+			labelPairs = append(labelPairs, cardinalityStat{
+				Name:  name + "=" + string(value),
+				Value: docs,
+			})
+			if name == metricName {
+				lastBlockCount += docs
+				metricNames = append(metricNames, cardinalityStat{
+					Name:  string(value),
+					Value: docs,
+				})
+			}
+		}
+	}
+
+	data.HeadStats.NumSeries = lastBlockCount
+	data.SeriesCountByMetricName = topCardinalityStats(metricNames, params.limit)
+	data.LabelValueCountByLabelName = topCardinalityStats(labelNames, params.limit)
+	data.SeriesCountByLabelValuePair = topCardinalityStats(labelPairs, params.limit)
+
+	blocks = append(blocks, cardinalityBlockStats{
+		BlockStart:  lastBlockStart,
+		SeriesCount: lastBlockCount,
+	})
+	for i := 1; i < len(blocks); i++ {
+		blocks[i].Growth = blocks[i].SeriesCount - blocks[i-1].SeriesCount
+	}
+	data.SeriesCountByIndexBlock = blocks
+
+	if err := handleroptions.AddDBResultResponseHeaders(w, meta, opts); err != nil {
+		logger.Error("error writing database limit headers", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, cardinalityResponse{
+		Status: "success",
+		Data:   data,
+	}, logger)
+}
+
+func (h *CardinalityHandler) parseParams(r *http.Request) (cardinalityParams, error) {
+	if err := r.ParseForm(); err != nil {
+		return cardinalityParams{}, err
+	}
+
+	params := cardinalityParams{
+		blockSize:   defaultCardinalityBlockSize,
+		limit:       defaultCardinalityLimit,
+		tagMatchers: models.Matchers{{Type: models.MatchAll}},
+	}
+	if v := r.FormValue(cardinalityBlockSizeParam); v != "" {
+		blockSize, err := util.ParseDurationString(v)
+		if err != nil {
+			return cardinalityParams{}, fmt.Errorf("invalid %s: %w", cardinalityBlockSizeParam, err)
+		}
+		if blockSize <= 0 {
+			return cardinalityParams{}, fmt.Errorf("%s must be positive", cardinalityBlockSizeParam)
+		}
+		params.blockSize = blockSize
+	}
+
+	if v := r.FormValue(cardinalityLimitParam); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return cardinalityParams{}, fmt.Errorf("invalid %s: %w", cardinalityLimitParam, err)
+		}
+		if limit <= 0 {
+			return cardinalityParams{}, fmt.Errorf("%s must be positive", cardinalityLimitParam)
+		}
+		params.limit = limit
+	}
+
+	end, err := util.ParseTimeStringWithDefault(r.FormValue("end"), h.nowFn())
+	if err != nil {
+		return cardinalityParams{}, err
+	}
+	defaultStart := end.Add(-defaultCardinalityBlocks * params.blockSize)
+	start, err := util.ParseTimeStringWithDefault(r.FormValue("start"), defaultStart)
+	if err != nil {
+		return cardinalityParams{}, err
+	}
+	if !start.Before(end) {
+		return cardinalityParams{}, fmt.Errorf("start %v must be before end %v", start, end)
+	}
+	if blocks := numCardinalityBlocks(start, end, params.blockSize); blocks > maxCardinalityBlocks {
+		return cardinalityParams{}, fmt.Errorf(
+			"range spans %d blocks of size %v, at most %d blocks allowed",
+			blocks, params.blockSize, maxCardinalityBlocks)
+	}
+	params.start, params.end = start, end
+
+	matchers, ok, err := prometheus.ParseMatch(r, h.parseOpts, h.tagOpts)
+	if err != nil {
+		return cardinalityParams{}, err
+	}
+	if ok {
+		if n := len(matchers); n != 1 {
+			return cardinalityParams{}, fmt.Errorf("only single tag matcher allowed: actual=%d", n)
+		}
+		params.tagMatchers = matchers[0].Matchers
+	}
+
+	return params, nil
+}
+
+// applyCardinalityRangeLimit truncates the start of the queried range to the
+// range limit, or fails if results are required to be exhaustive.
+func applyCardinalityRangeLimit(params *cardinalityParams, opts *storage.FetchOptions) error {
+	if opts.RangeLimit <= 0 || params.end.Sub(params.start) <= opts.RangeLimit {
+		return nil
+	}
+	if opts.RequireExhaustive {
+		msg := fmt.Sprintf("query exceeded limit: require_exhaustive=%v, "+
+			"range_limit=%s, range_matched=%s", opts.RequireExhaustive,
+			opts.RangeLimit.String(), params.end.Sub(params.start).String())
+		return xerrors.NewInvalidParamsError(consolidators.NewLimitError(msg))
+	}
+	params.start = params.end.Add(-opts.RangeLimit)
+	return nil
+}
+
+func numCardinalityBlocks(start, end time.Time, blockSize time.Duration) int64 {
+	first := start.Truncate(blockSize)
+	last := end.Add(-1).Truncate(blockSize)
+	return int64(last.Sub(first)/blockSize) + 1
+}
+
+func (h *CardinalityHandler) writeError(
+	w http.ResponseWriter,
+	logger *zap.Logger,
+	err error,
+) {
+	logger.Error("unable to query series cardinality", zap.Error(err))
+	if queryerrors.IsTimeout(err) {
+		err = queryerrors.NewErrQueryTimeout(err)
+	}
+	xhttp.WriteError(w, err)
+}
+
+func topCardinalityStats(stats []cardinalityStat, limit int) []cardinalityStat {
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Value != stats[j].Value {
+			return stats[i].Value > stats[j].Value
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	if len(stats) > limit {
+		stats = stats[:limit]
+	}
+	if stats == nil {
+		return []cardinalityStat{}
+	}
+	return stats
+}
+
+func sumDocsCounts(values []int64) int64 {
+	var total int64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/headers"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newCardinalityTestHandler(t *testing.T, store storage.Storage, now time.Time) http.Handler {
+	fb, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+	opts := options.EmptyHandlerOptions().
+		SetStorage(store).
+		SetFetchOptionsBuilder(fb).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(func() time.Time { return now })
+	return NewCardinalityHandler(opts)
+}
+
+func TestCardinalityHandler(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		store      = storage.NewMockStorage(ctrl)
+		blockStart = time.Date(2021, 1, 1, 6, 0, 0, 0, time.UTC)
+		lastBlock  = blockStart.Add(2 * time.Hour)
+		end        = lastBlock.Add(time.Hour)
+	)
+
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.True(t, q.IncludeDocsCount)
+			assert.Equal(t, xtime.ToUnixNano(blockStart), q.Start)
+			assert.Equal(t, xtime.ToUnixNano(lastBlock), q.End)
+			assert.Equal(t, [][]byte{[]byte("__name__")}, q.FilterNameTags)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{
+						Name:       []byte("__name__"),
+						Values:     [][]byte{[]byte("up"), []byte("requests")},
+						DocsCounts: []int64{2, 3},
+					},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.True(t, q.IncludeDocsCount)
+			assert.Equal(t, xtime.ToUnixNano(lastBlock), q.Start)
+			assert.Equal(t, xtime.ToUnixNano(end), q.End)
+			assert.Empty(t, q.FilterNameTags)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{
+						Name:       []byte("__name__"),
+						Values:     [][]byte{[]byte("requests"), []byte("up")},
+						DocsCounts: []int64{10, 2},
+					},
+					{
+						Name:       []byte("instance"),
+						Values:     [][]byte{[]byte("a"), []byte("b"), []byte("c")},
+						DocsCounts: []int64{4, 4, 4},
+					},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := newCardinalityTestHandler(t, store, end)
+
+	params := url.Values{}
+	params.Set("start", blockStart.Format(time.RFC3339))
+	params.Set("limit", "2")
+	req := httptest.NewRequest(http.MethodGet, CardinalityURL+"?"+params.Encode(), nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp cardinalityResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "success", resp.Status)
+
+	data := resp.Data
+	assert.Equal(t, int64(12), data.HeadStats.NumSeries)
+	assert.Equal(t, int64(5), data.HeadStats.NumLabelPairs)
+	assert.Equal(t, []cardinalityStat{
+		{Name: "requests", Value: 10},
+		{Name: "up", Value: 2},
+	}, data.SeriesCountByMetricName)
+	assert.Equal(t, []cardinalityStat{
+		{Name: "instance", Value: 3},
+		{Name: "__name__", Value: 2},
+	}, data.LabelValueCountByLabelName)
+	assert.Equal(t, []cardinalityStat{
+		{Name: "__name__=requests", Value: 10},
+		{Name: "instance=a", Value: 4},
+	}, data.SeriesCountByLabelValuePair)
+
+	require.Equal(t, 2, len(data.SeriesCountByIndexBlock))
+	assert.True(t, blockStart.Equal(data.SeriesCountByIndexBlock[0].BlockStart))
+	assert.Equal(t, int64(5), data.SeriesCountByIndexBlock[0].SeriesCount)
+	assert.Equal(t, int64(0), data.SeriesCountByIndexBlock[0].Growth)
+	assert.True(t, lastBlock.Equal(data.SeriesCountByIndexBlock[1].BlockStart))
+	assert.Equal(t, int64(12), data.SeriesCountByIndexBlock[1].SeriesCount)
+	assert.Equal(t, int64(7), data.SeriesCountByIndexBlock[1].Growth)
+}
+
+func TestCardinalityHandlerInvalidParams(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	h := newCardinalityTestHandler(t, storage.NewMockStorage(ctrl), time.Now())
+	for _, query := range []string{
+		"limit=-1", "limit=foo", "blockSize=0s", "start=2&end=1",
+		"blockSize=1m&start=0&end=3600",
+	} {
+		req := httptest.NewRequest(http.MethodGet, CardinalityURL+"?"+query, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestCardinalityHandlerRangeLimit(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		store = storage.NewMockStorage(ctrl)
+		end   = time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+	fb, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{
+			Limits:  handleroptions.FetchOptionsBuilderLimitsOptions{RangeLimit: time.Hour},
+			Timeout: 15 * time.Second,
+		})
+	require.NoError(t, err)
+	h := NewCardinalityHandler(options.EmptyHandlerOptions().
+		SetStorage(store).
+		SetFetchOptionsBuilder(fb).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(func() time.Time { return end }))
+
+	// The default range of six blocks is truncated to the last hour.
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.Equal(t, xtime.ToUnixNano(end.Add(-2*time.Hour)), q.Start)
+			assert.Equal(t, xtime.ToUnixNano(end), q.End)
+			return &consolidators.CompleteTagsResult{
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, CardinalityURL, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Exhaustive queries exceeding the range limit fail.
+	req = httptest.NewRequest(http.MethodGet, CardinalityURL, nil)
+	req.Header.Set(headers.LimitRequireExhaustiveHeader, "true")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
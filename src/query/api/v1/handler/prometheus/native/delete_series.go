@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// DeleteSeriesURL is the url for deleting series.
+	DeleteSeriesURL = route.DeleteSeriesURL
+)
+
+var (
+	// DeleteSeriesHTTPMethods are the HTTP methods for this handler.
+	DeleteSeriesHTTPMethods = []string{http.MethodPost, http.MethodPut}
+
+	errDeleteSeriesNoClusters = errors.New("coordinator is not connected to dbnodes, cannot delete series")
+	errDeleteSeriesNoMatchers = errors.New("no match[] parameter provided")
+)
+
+// DeleteSeriesHandler deletes the data of all series matching a set of
+// matchers within a time range from every namespace of the cluster.
+type DeleteSeriesHandler struct {
+	clusters       m3.Clusters
+	parseOpts      promql.ParseOptions
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewDeleteSeriesHandler returns a new instance of handler.
+func NewDeleteSeriesHandler(opts options.HandlerOptions) http.Handler {
+	return &DeleteSeriesHandler{
+		clusters:       opts.Clusters(),
+		parseOpts:      promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		tagOpts:        opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *DeleteSeriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	if h.clusters == nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(errDeleteSeriesNoClusters))
+		return
+	}
+
+	start, end, err := prometheus.ParseStartAndEnd(r, h.parseOpts)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	matches, ok, err := prometheus.ParseMatch(r, h.parseOpts, h.tagOpts)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+	if !ok {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(errDeleteSeriesNoMatchers))
+		return
+	}
+
+	var (
+		rangeStart = xtime.ToUnixNano(start)
+		rangeEnd   = xtime.ToUnixNano(end)
+		fetchOpts  = storage.NewFetchOptions()
+		deleted    int64
+	)
+	for _, match := range matches {
+		query, err := storage.FetchQueryToM3Query(&storage.FetchQuery{
+			TagMatchers: match.Matchers,
+			Start:       start,
+			End:         end,
+		}, fetchOpts)
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+			return
+		}
+
+		for _, ns := range h.clusters.ClusterNamespaces() {
+			session, ok := ns.Session().(client.AdminSession)
+			if !ok {
+				err := fmt.Errorf("session for namespace %s does not support deleting series",
+					ns.NamespaceID().String())
+				xhttp.WriteError(w, err)
+				return
+			}
+
+			n, err := session.DeleteSeries(ns.NamespaceID(), query, rangeStart, rangeEnd)
+			if err != nil {
+				logger.Error("unable to delete series",
+					zap.String("match", match.Match),
+					zap.Stringer("namespace", ns.NamespaceID()),
+					zap.Error(err))
+				xhttp.WriteError(w, err)
+				return
+			}
+			deleted += n
+		}
+	}
+
+	logger.Info("deleted series",
+		zap.Int("matchers", len(matches)),
+		zap.Time("start", start),
+		zap.Time("end", end),
+		zap.Int64("numSeries", deleted))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestDeleteSeriesHandler(
+	ctrl *gomock.Controller,
+	sessions map[string]client.AdminSession,
+) http.Handler {
+	namespaces := make(m3.ClusterNamespaces, 0, len(sessions))
+	for name, session := range sessions {
+		ns := m3.NewMockClusterNamespace(ctrl)
+		ns.EXPECT().NamespaceID().Return(ident.StringID(name)).AnyTimes()
+		ns.EXPECT().Session().Return(session).AnyTimes()
+		namespaces = append(namespaces, ns)
+	}
+
+	clusters := m3.NewMockClusters(ctrl)
+	clusters.EXPECT().ClusterNamespaces().Return(namespaces).AnyTimes()
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(time.Now)
+	return NewDeleteSeriesHandler(opts)
+}
+
+func newTestDeleteSeriesRequest(form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL,
+		strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestDeleteSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		session = client.NewMockAdminSession(ctrl)
+		h       = newTestDeleteSeriesHandler(ctrl, map[string]client.AdminSession{
+			"default": session,
+		})
+		start = xtime.FromSeconds(3600)
+		end   = xtime.FromSeconds(7200)
+	)
+
+	session.EXPECT().
+		DeleteSeries(ident.NewIDMatcher("default"), gomock.Any(), start, end).
+		DoAndReturn(func(
+			_ ident.ID,
+			q index.Query,
+			_, _ xtime.UnixNano,
+		) (int64, error) {
+			expected := idx.NewConjunctionQuery(
+				idx.NewTermQuery([]byte("__name__"), []byte("foo")),
+				idx.NewTermQuery([]byte("bar"), []byte("baz")))
+			require.Equal(t, expected.String(), q.Query.String())
+			return 2, nil
+		})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestDeleteSeriesRequest(url.Values{
+		"match[]": []string{`foo{bar="baz"}`},
+		"start":   []string{"3600"},
+		"end":     []string{"7200"},
+	}))
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestDeleteSeriesRequiresMatch(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	h := newTestDeleteSeriesHandler(ctrl, nil)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestDeleteSeriesRequest(url.Values{
+		"start": []string{"3600"},
+		"end":   []string{"7200"},
+	}))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeleteSeriesError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockAdminSession(ctrl)
+	h := newTestDeleteSeriesHandler(ctrl, map[string]client.AdminSession{
+		"default": session,
+	})
+
+	session.EXPECT().
+		DeleteSeries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(int64(0), errors.New("boom"))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestDeleteSeriesRequest(url.Values{
+		"match[]": []string{`foo`},
+	}))
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	pql "github.com/prometheus/prometheus/promql/parser"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	idxconvert "github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// QueryExemplarsURL is the url for querying exemplars.
+	QueryExemplarsURL = route.QueryExemplarsURL
+
+	queryExemplarsQueryParam = "query"
+)
+
+var (
+	// QueryExemplarsHTTPMethods are the HTTP methods for this handler.
+	QueryExemplarsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+	errQueryExemplarsNoClusters  = errors.New("coordinator is not connected to dbnodes, cannot query exemplars")
+	errQueryExemplarsNoNamespace = errors.New("unaggregated namespace is not available, cannot query exemplars")
+	errQueryExemplarsNoQuery     = errors.New("no query parameter provided")
+)
+
+// QueryExemplarsHandler returns the exemplars of the series selected by a
+// PromQL expression within a time range. Exemplars are only retained for raw
+// writes so they are read from the unaggregated namespace.
+type QueryExemplarsHandler struct {
+	clusters       m3.Clusters
+	parseOpts      promql.ParseOptions
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewQueryExemplarsHandler returns a new instance of handler.
+func NewQueryExemplarsHandler(opts options.HandlerOptions) http.Handler {
+	return &QueryExemplarsHandler{
+		clusters:       opts.Clusters(),
+		parseOpts:      promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		tagOpts:        opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+type queryExemplarsResponse struct {
+	Status string                `json:"status"`
+	Data   []seriesExemplarsJSON `json:"data"`
+}
+
+type seriesExemplarsJSON struct {
+	SeriesLabels map[string]string `json:"seriesLabels"`
+	Exemplars    []exemplarJSON    `json:"exemplars"`
+}
+
+type exemplarJSON struct {
+	Labels    map[string]string `json:"labels"`
+	Value     string            `json:"value"`
+	Timestamp float64           `json:"timestamp"`
+}
+
+func (h *QueryExemplarsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	if h.clusters == nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(errQueryExemplarsNoClusters))
+		return
+	}
+
+	start, end, err := prometheus.ParseStartAndEnd(r, h.parseOpts)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	query := r.FormValue(queryExemplarsQueryParam)
+	if query == "" {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(errQueryExemplarsNoQuery))
+		return
+	}
+
+	expr, err := h.parseOpts.ParseFn()(query)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	ns, ok := h.clusters.UnaggregatedClusterNamespace()
+	if !ok {
+		xhttp.WriteError(w, errQueryExemplarsNoNamespace)
+		return
+	}
+	session, ok := ns.Session().(client.AdminSession)
+	if !ok {
+		err := fmt.Errorf("session for namespace %s does not support querying exemplars",
+			ns.NamespaceID().String())
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var (
+		rangeStart = xtime.ToUnixNano(start)
+		rangeEnd   = xtime.ToUnixNano(end)
+		fetchOpts  = storage.NewFetchOptions()
+		seen       = make(map[string]struct{})
+		response   = queryExemplarsResponse{
+			Status: "success",
+			Data:   []seriesExemplarsJSON{},
+		}
+	)
+	for _, selector := range pql.ExtractSelectors(expr) {
+		matchers, err := promql.LabelMatchersToModelMatcher(selector, h.tagOpts)
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+			return
+		}
+
+		m3Query, err := storage.FetchQueryToM3Query(&storage.FetchQuery{
+			TagMatchers: matchers,
+			Start:       start,
+			End:         end,
+		}, fetchOpts)
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+			return
+		}
+
+		results, err := session.FetchExemplars(ns.NamespaceID(), m3Query, rangeStart, rangeEnd, 0)
+		if err != nil {
+			logger.Error("unable to fetch exemplars",
+				zap.String("query", query),
+				zap.Stringer("namespace", ns.NamespaceID()),
+				zap.Error(err))
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		for _, result := range results {
+			// The same series can be selected more than once by an expression.
+			if _, ok := seen[result.ID.String()]; ok {
+				continue
+			}
+			seen[result.ID.String()] = struct{}{}
+
+			series, err := toSeriesExemplarsJSON(result)
+			if err != nil {
+				xhttp.WriteError(w, err)
+				return
+			}
+			response.Data = append(response.Data, series)
+		}
+	}
+
+	xhttp.WriteJSONResponse(w, response, logger)
+}
+
+func toSeriesExemplarsJSON(result client.SeriesExemplars) (seriesExemplarsJSON, error) {
+	seriesLabels, err := decodeExemplarLabels(result.EncodedTags)
+	if err != nil {
+		return seriesExemplarsJSON{}, err
+	}
+
+	series := seriesExemplarsJSON{
+		SeriesLabels: seriesLabels,
+		Exemplars:    make([]exemplarJSON, 0, len(result.Exemplars)),
+	}
+	for _, exemplar := range result.Exemplars {
+		labels, err := decodeExemplarLabels(exemplar.EncodedLabels)
+		if err != nil {
+			return seriesExemplarsJSON{}, err
+		}
+		series.Exemplars = append(series.Exemplars, exemplarJSON{
+			Labels:    labels,
+			Value:     strconv.FormatFloat(exemplar.Value, 'f', -1, 64),
+			Timestamp: float64(exemplar.TimestampNanos) / 1e9,
+		})
+	}
+	return series, nil
+}
+
+func decodeExemplarLabels(encoded ts.EncodedTags) (map[string]string, error) {
+	metadata, err := idxconvert.FromSeriesIDAndEncodedTags(ident.BytesID(nil), encoded)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(metadata.Fields))
+	for _, field := range metadata.Fields {
+		labels[string(field.Name)] = string(field.Value)
+	}
+	return labels, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/serialize"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestQueryExemplarsHandler(
+	ctrl *gomock.Controller,
+	session client.AdminSession,
+) http.Handler {
+	ns := m3.NewMockClusterNamespace(ctrl)
+	ns.EXPECT().NamespaceID().Return(ident.StringID("default")).AnyTimes()
+	ns.EXPECT().Session().Return(session).AnyTimes()
+
+	clusters := m3.NewMockClusters(ctrl)
+	clusters.EXPECT().UnaggregatedClusterNamespace().Return(ns, true).AnyTimes()
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(time.Now)
+	return NewQueryExemplarsHandler(opts)
+}
+
+func encodeTestTags(t *testing.T, tags ...string) ts.EncodedTags {
+	pool := serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(), nil)
+	pool.Init()
+	enc := pool.Get()
+	defer enc.Finalize()
+
+	require.NoError(t, enc.Encode(ident.MustNewTagStringsIterator(tags...)))
+	data, ok := enc.Data()
+	require.True(t, ok)
+	return append(ts.EncodedTags(nil), data.Bytes()...)
+}
+
+func TestQueryExemplars(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		session = client.NewMockAdminSession(ctrl)
+		h       = newTestQueryExemplarsHandler(ctrl, session)
+		start   = xtime.FromSeconds(3600)
+		end     = xtime.FromSeconds(7200)
+	)
+
+	session.EXPECT().
+		FetchExemplars(ident.NewIDMatcher("default"), gomock.Any(), start, end, 0).
+		DoAndReturn(func(
+			_ ident.ID,
+			q index.Query,
+			_, _ xtime.UnixNano,
+			_ int,
+		) ([]client.SeriesExemplars, error) {
+			expected := idx.NewConjunctionQuery(
+				idx.NewTermQuery([]byte("__name__"), []byte("foo")),
+				idx.NewTermQuery([]byte("bar"), []byte("baz")))
+			require.Equal(t, expected.String(), q.Query.String())
+			return []client.SeriesExemplars{
+				{
+					ID:          ident.StringID(`foo{bar="baz"}`),
+					EncodedTags: encodeTestTags(t, "__name__", "foo", "bar", "baz"),
+					Exemplars: []ts.Exemplar{
+						{
+							TimestampNanos: xtime.FromSeconds(3700),
+							Value:          1.5,
+							EncodedLabels:  encodeTestTags(t, "trace_id", "abc"),
+						},
+					},
+				},
+			}, nil
+		}).
+		Times(2)
+
+	req := httptest.NewRequest(http.MethodGet, QueryExemplarsURL+"?"+url.Values{
+		"query": []string{`rate(foo{bar="baz"}[1m]) / rate(foo{bar="baz"}[5m])`},
+		"start": []string{"3600"},
+		"end":   []string{"7200"},
+	}.Encode(), nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response queryExemplarsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, queryExemplarsResponse{
+		Status: "success",
+		Data: []seriesExemplarsJSON{
+			{
+				SeriesLabels: map[string]string{"__name__": "foo", "bar": "baz"},
+				Exemplars: []exemplarJSON{
+					{
+						Labels:    map[string]string{"trace_id": "abc"},
+						Value:     "1.5",
+						Timestamp: 3700,
+					},
+				},
+			},
+		},
+	}, response)
+}
+
+func TestQueryExemplarsRequiresQuery(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	h := newTestQueryExemplarsHandler(ctrl, client.NewMockAdminSession(ctrl))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, QueryExemplarsURL, nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/rules"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// RulesURL is the url for listing recording and alerting rules.
+	RulesURL = route.RulesURL
+
+	// AlertsURL is the url for listing active alerts.
+	AlertsURL = route.AlertsURL
+
+	rulesTypeParam     = "type"
+	rulesTypeAlert     = "alert"
+	rulesTypeRecording = "record"
+)
+
+var (
+	// RulesHTTPMethods are the HTTP methods for the rules handler.
+	RulesHTTPMethods = []string{http.MethodGet}
+
+	// AlertsHTTPMethods are the HTTP methods for the alerts handler.
+	AlertsHTTPMethods = []string{http.MethodGet}
+)
+
+// RulesHandler lists the recording and alerting rule groups in the same
+// format as the Prometheus rules API.
+type RulesHandler struct {
+	manager        rules.Manager
+	instrumentOpts instrument.Options
+}
+
+// NewRulesHandler returns a new instance of handler.
+func NewRulesHandler(opts options.HandlerOptions) http.Handler {
+	return &RulesHandler{
+		manager:        opts.RulesManager(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+type rulesResponse struct {
+	Status string            `json:"status"`
+	Data   rulesResponseData `json:"data"`
+}
+
+type rulesResponseData struct {
+	Groups []ruleGroupJSON `json:"groups"`
+}
+
+type ruleGroupJSON struct {
+	Name           string        `json:"name"`
+	File           string        `json:"file"`
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+type alertingRuleJSON struct {
+	State          rules.AlertState  `json:"state"`
+	Name           string            `json:"name"`
+	Query          string            `json:"query"`
+	Duration       float64           `json:"duration"`
+	Labels         map[string]string `json:"labels"`
+	Annotations    map[string]string `json:"annotations"`
+	Alerts         []alertJSON       `json:"alerts"`
+	Health         rules.RuleHealth  `json:"health"`
+	LastError      string            `json:"lastError,omitempty"`
+	EvaluationTime float64           `json:"evaluationTime"`
+	LastEvaluation time.Time         `json:"lastEvaluation"`
+	Type           rules.RuleType    `json:"type"`
+}
+
+type recordingRuleJSON struct {
+	Name           string            `json:"name"`
+	Query          string            `json:"query"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Health         rules.RuleHealth  `json:"health"`
+	LastError      string            `json:"lastError,omitempty"`
+	EvaluationTime float64           `json:"evaluationTime"`
+	LastEvaluation time.Time         `json:"lastEvaluation"`
+	Type           rules.RuleType    `json:"type"`
+}
+
+type alertJSON struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	State       rules.AlertState  `json:"state"`
+	ActiveAt    *time.Time        `json:"activeAt,omitempty"`
+	Value       string            `json:"value"`
+}
+
+func (h *RulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	ruleType := r.FormValue(rulesTypeParam)
+	if ruleType != "" && ruleType != rulesTypeAlert && ruleType != rulesTypeRecording {
+		err := fmt.Errorf("invalid rule type %q, must be %q or %q",
+			ruleType, rulesTypeAlert, rulesTypeRecording)
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	response := rulesResponse{
+		Status: "success",
+		Data:   rulesResponseData{Groups: []ruleGroupJSON{}},
+	}
+	if h.manager == nil {
+		xhttp.WriteJSONResponse(w, response, logger)
+		return
+	}
+
+	for _, group := range h.manager.Groups() {
+		groupJSON := ruleGroupJSON{
+			Name:           group.Name,
+			File:           group.File,
+			Rules:          []interface{}{},
+			Interval:       group.Interval.Seconds(),
+			EvaluationTime: group.EvaluationTime.Seconds(),
+			LastEvaluation: group.LastEvaluation,
+		}
+
+		for _, rule := range group.Rules {
+			switch rule.Type {
+			case rules.AlertingRuleType:
+				if ruleType == rulesTypeRecording {
+					continue
+				}
+				groupJSON.Rules = append(groupJSON.Rules, alertingRuleJSON{
+					State:          rule.State,
+					Name:           rule.Name,
+					Query:          rule.Query,
+					Duration:       rule.Duration.Seconds(),
+					Labels:         rule.Labels.Map(),
+					Annotations:    rule.Annotations.Map(),
+					Alerts:         toAlertsJSON(rule.Alerts),
+					Health:         rule.Health,
+					LastError:      errorString(rule.LastError),
+					EvaluationTime: rule.EvaluationTime.Seconds(),
+					LastEvaluation: rule.LastEvaluation,
+					Type:           rule.Type,
+				})
+			case rules.RecordingRuleType:
+				if ruleType == rulesTypeAlert {
+					continue
+				}
+				groupJSON.Rules = append(groupJSON.Rules, recordingRuleJSON{
+					Name:           rule.Name,
+					Query:          rule.Query,
+					Labels:         labelsMapOrNil(rule.Labels),
+					Health:         rule.Health,
+					LastError:      errorString(rule.LastError),
+					EvaluationTime: rule.EvaluationTime.Seconds(),
+					LastEvaluation: rule.LastEvaluation,
+					Type:           rule.Type,
+				})
+			}
+		}
+
+		response.Data.Groups = append(response.Data.Groups, groupJSON)
+	}
+
+	xhttp.WriteJSONResponse(w, response, logger)
+}
+
+// AlertsHandler lists the pending and firing alerts in the same format as
+// the Prometheus alerts API.
+type AlertsHandler struct {
+	manager        rules.Manager
+	instrumentOpts instrument.Options
+}
+
+// NewAlertsHandler returns a new instance of handler.
+func NewAlertsHandler(opts options.HandlerOptions) http.Handler {
+	return &AlertsHandler{
+		manager:        opts.RulesManager(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+type alertsResponse struct {
+	Status string             `json:"status"`
+	Data   alertsResponseData `json:"data"`
+}
+
+type alertsResponseData struct {
+	Alerts []alertJSON `json:"alerts"`
+}
+
+func (h *AlertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	response := alertsResponse{
+		Status: "success",
+		Data:   alertsResponseData{Alerts: []alertJSON{}},
+	}
+	if h.manager != nil {
+		response.Data.Alerts = toAlertsJSON(h.manager.Alerts())
+	}
+
+	xhttp.WriteJSONResponse(w, response, logger)
+}
+
+func toAlertsJSON(alerts []rules.Alert) []alertJSON {
+	result := make([]alertJSON, 0, len(alerts))
+	for _, alert := range alerts {
+		activeAt := alert.ActiveAt
+		result = append(result, alertJSON{
+			Labels:      alert.Labels.Map(),
+			Annotations: alert.Annotations.Map(),
+			State:       alert.State,
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(alert.Value, 'e', -1, 64),
+		})
+	}
+	return result
+}
+
+func labelsMapOrNil(lbls labels.Labels) map[string]string {
+	if len(lbls) == 0 {
+		return nil
+	}
+	return lbls.Map()
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/rules"
+)
+
+type testRulesManager struct {
+	groups []rules.GroupState
+	alerts []rules.Alert
+}
+
+func (m *testRulesManager) Start() error               { return nil }
+func (m *testRulesManager) Close() error               { return nil }
+func (m *testRulesManager) Groups() []rules.GroupState { return m.groups }
+func (m *testRulesManager) Alerts() []rules.Alert      { return m.alerts }
+
+func newTestRulesManager(now time.Time) *testRulesManager {
+	alert := rules.Alert{
+		State:       rules.StateFiring,
+		Labels:      labels.FromStrings(labels.AlertName, "InstanceDown", "instance", "a"),
+		Annotations: labels.FromStrings("summary", "a is down"),
+		Value:       0,
+		ActiveAt:    now,
+	}
+	return &testRulesManager{
+		groups: []rules.GroupState{
+			{
+				Name:           "example",
+				File:           "rules.yml",
+				Interval:       time.Minute,
+				LastEvaluation: now,
+				EvaluationTime: time.Second,
+				Rules: []rules.RuleState{
+					{
+						Type:           rules.RecordingRuleType,
+						Name:           "job:up:sum",
+						Query:          "sum by (job) (up)",
+						Health:         rules.HealthGood,
+						LastEvaluation: now,
+					},
+					{
+						Type:           rules.AlertingRuleType,
+						Name:           "InstanceDown",
+						Query:          "up == 0",
+						Duration:       5 * time.Minute,
+						Health:         rules.HealthGood,
+						LastEvaluation: now,
+						State:          rules.StateFiring,
+						Alerts:         []rules.Alert{alert},
+					},
+				},
+			},
+		},
+		alerts: []rules.Alert{alert},
+	}
+}
+
+func TestRulesHandler(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	opts := options.EmptyHandlerOptions().
+		SetRulesManager(newTestRulesManager(now))
+	handler := NewRulesHandler(opts)
+
+	req := httptest.NewRequest(http.MethodGet, RulesURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Status string `json:"status"`
+		Data   struct {
+			Groups []struct {
+				Name     string                   `json:"name"`
+				File     string                   `json:"file"`
+				Interval float64                  `json:"interval"`
+				Rules    []map[string]interface{} `json:"rules"`
+			} `json:"groups"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "success", response.Status)
+	require.Len(t, response.Data.Groups, 1)
+
+	group := response.Data.Groups[0]
+	assert.Equal(t, "example", group.Name)
+	assert.Equal(t, "rules.yml", group.File)
+	assert.Equal(t, float64(60), group.Interval)
+	require.Len(t, group.Rules, 2)
+	assert.Equal(t, "recording", group.Rules[0]["type"])
+	assert.Equal(t, "job:up:sum", group.Rules[0]["name"])
+	assert.Equal(t, "alerting", group.Rules[1]["type"])
+	assert.Equal(t, "firing", group.Rules[1]["state"])
+	assert.Equal(t, float64(300), group.Rules[1]["duration"])
+	assert.Len(t, group.Rules[1]["alerts"], 1)
+
+	// Rules can be filtered by type.
+	req = httptest.NewRequest(http.MethodGet, RulesURL+"?type=alert", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Len(t, response.Data.Groups[0].Rules, 1)
+	assert.Equal(t, "alerting", response.Data.Groups[0].Rules[0]["type"])
+
+	req = httptest.NewRequest(http.MethodGet, RulesURL+"?type=foo", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestRulesHandlerNoManager(t *testing.T) {
+	handler := NewRulesHandler(options.EmptyHandlerOptions())
+
+	req := httptest.NewRequest(http.MethodGet, RulesURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"success","data":{"groups":[]}}`,
+		recorder.Body.String())
+}
+
+func TestAlertsHandler(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	opts := options.EmptyHandlerOptions().
+		SetRulesManager(newTestRulesManager(now))
+	handler := NewAlertsHandler(opts)
+
+	req := httptest.NewRequest(http.MethodGet, AlertsURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{
+		"status": "success",
+		"data": {
+			"alerts": [
+				{
+					"labels": {"alertname": "InstanceDown", "instance": "a"},
+					"annotations": {"summary": "a is down"},
+					"state": "firing",
+					"activeAt": "1970-01-01T00:16:40Z",
+					"value": "0e+00"
+				}
+			]
+		}
+	}`, recorder.Body.String())
+}
@@ -42,6 +42,7 @@ import (
 
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
@@ -730,6 +731,8 @@ func newPromTSIter(
 		tags             = make([]models.Tags, 0, len(timeseries))
 		datapoints       = make([]ts.Datapoints, 0, len(timeseries))
 		seriesAttributes = make([]ts.SeriesAttributes, 0, len(timeseries))
+		histograms       [][]byte
+		exemplars        [][]*annotation.Exemplar
 	)
 
 	graphiteTagOpts := tagOpts.SetIDSchemeType(models.TypeGraphite)
@@ -745,9 +748,45 @@ func newPromTSIter(
 			opts = graphiteTagOpts
 		}
 
-		seriesAttributes = append(seriesAttributes, attributes)
-		tags = append(tags, storage.PromLabelsToM3Tags(promTS.Labels, opts))
-		datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(promTS.Samples))
+		seriesTags := storage.PromLabelsToM3Tags(promTS.Labels, opts)
+		firstIdx := len(tags)
+		if len(promTS.Samples) > 0 || len(promTS.Histograms) == 0 {
+			seriesAttributes = append(seriesAttributes, attributes)
+			tags = append(tags, seriesTags)
+			datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(promTS.Samples))
+			if histograms != nil {
+				histograms = append(histograms, nil)
+			}
+		}
+
+		// Each native histogram sample is written on its own since the
+		// histogram is carried in the annotation of the datapoint.
+		for _, promHistogram := range promTS.Histograms {
+			h, err := storage.PromHistogramToM3(promHistogram)
+			if err != nil {
+				return nil, xerrors.NewInvalidParamsError(err)
+			}
+
+			if histograms == nil {
+				histograms = make([][]byte, len(tags), cap(tags))
+			}
+			histogramAttributes := attributes
+			histogramAttributes.M3Type = ts.M3MetricTypeHistogram
+			seriesAttributes = append(seriesAttributes, histogramAttributes)
+			tags = append(tags, seriesTags)
+			datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(
+				[]prompb.Sample{{Timestamp: promHistogram.Timestamp, Value: h.Count}}))
+			histograms = append(histograms, h.Marshal(nil))
+		}
+
+		// Exemplars are carried in the annotation of the first entry of the
+		// series, they are dropped if the series has no samples to write.
+		if len(promTS.Exemplars) > 0 && (len(promTS.Samples) > 0 || len(promTS.Histograms) > 0) {
+			for len(exemplars) < firstIdx {
+				exemplars = append(exemplars, nil)
+			}
+			exemplars = append(exemplars, storage.PromExemplarsToAnnotation(promTS.Exemplars))
+		}
 	}
 
 	return &promTSIter{
@@ -755,6 +794,8 @@ func newPromTSIter(
 		idx:              -1,
 		tags:             tags,
 		datapoints:       datapoints,
+		histograms:       histograms,
+		exemplars:        exemplars,
 		storeMetricsType: storeMetricsType,
 	}, nil
 }
@@ -765,6 +806,8 @@ type promTSIter struct {
 	attributes []ts.SeriesAttributes
 	tags       []models.Tags
 	datapoints []ts.Datapoints
+	histograms [][]byte
+	exemplars  [][]*annotation.Exemplar
 	metadatas  []ts.Metadata
 	annotation []byte
 
@@ -781,15 +824,34 @@ func (i *promTSIter) Next() bool {
 		return false
 	}
 
-	if !i.storeMetricsType {
+	var histogram []byte
+	if i.idx < len(i.histograms) {
+		histogram = i.histograms[i.idx]
+	}
+
+	var exemplars []*annotation.Exemplar
+	if i.idx < len(i.exemplars) {
+		exemplars = i.exemplars[i.idx]
+	}
+
+	if !i.storeMetricsType && histogram == nil && len(exemplars) == 0 {
+		i.annotation = nil
 		return true
 	}
 
-	annotationPayload, err := storage.SeriesAttributesToAnnotationPayload(i.attributes[i.idx])
-	if err != nil {
-		i.err = err
-		return false
+	var (
+		annotationPayload annotation.Payload
+		err               error
+	)
+	if i.storeMetricsType {
+		annotationPayload, err = storage.SeriesAttributesToAnnotationPayload(i.attributes[i.idx])
+		if err != nil {
+			i.err = err
+			return false
+		}
 	}
+	annotationPayload.NativeHistogram = histogram
+	annotationPayload.Exemplars = exemplars
 
 	i.annotation, err = annotationPayload.Marshal()
 	if err != nil {
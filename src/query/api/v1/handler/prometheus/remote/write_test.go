@@ -48,12 +48,16 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/ts"
 	xclock "github.com/m3db/m3/src/x/clock"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/headers"
+	"github.com/m3db/m3/src/x/histogram"
 	"github.com/m3db/m3/src/x/instrument"
 	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 func makeOptions(ds ingest.DownsamplerAndWriter) options.HandlerOptions {
@@ -142,6 +146,47 @@ func TestPromWriteError(t *testing.T) {
 	require.True(t, bytes.Contains(body, []byte(batchErr.Error())))
 }
 
+func TestPromWriteQuotaExceeded(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	enforcer := quota.NewEnforcer(quota.Options{})
+	enforcer.Update(quota.Quotas{
+		Tenants: map[string]quota.Quota{
+			"limited": {ActiveSeries: 1},
+		},
+	})
+	opts := makeOptions(quota.NewDownsamplerAndWriter(enforcer,
+		mockDownsamplerAndWriter))
+	promWriteHandler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+	handler := quota.NewWriteHandler(enforcer, promWriteHandler)
+
+	promReq := test.GeneratePromWriteRequest()
+	require.True(t, len(promReq.Timeseries) > 1)
+
+	for _, tt := range []struct {
+		tenant string
+		status int
+	}{
+		{tenant: "limited", status: http.StatusTooManyRequests},
+		{tenant: "other", status: http.StatusOK},
+	} {
+		req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL,
+			test.GeneratePromWriteRequestBody(t, promReq))
+		req.Header.Set(headers.TenantHeader, tt.tenant)
+
+		writer := httptest.NewRecorder()
+		handler.ServeHTTP(writer, req)
+		require.Equal(t, tt.status, writer.Result().StatusCode, tt.tenant)
+	}
+}
+
 func TestWriteErrorMetricCount(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -420,6 +465,166 @@ func TestPromWriteDisabledMetricsTypes(t *testing.T) {
 	require.NoError(t, capturedIter.Error())
 }
 
+func TestPromWriteNativeHistograms(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var capturedIter ingest.DownsampleAndWriteIter
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, iter ingest.DownsampleAndWriteIter, _ ingest.WriteOptions) ingest.BatchError {
+			capturedIter = iter
+			return nil
+		})
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetStoreMetricsType(false)
+
+	promReq := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+				Histograms: []prompb.Histogram{
+					{
+						CountInt:      6,
+						Sum:           12,
+						Schema:        0,
+						ZeroThreshold: 0.001,
+						ZeroCountInt:  1,
+						PositiveSpans: []prompb.BucketSpan{{Offset: 1, Length: 2}},
+						// Deltas encode absolute counts of 2 and 3.
+						PositiveDeltas: []int64{2, 1},
+						Timestamp:      1000,
+					},
+					{
+						CountFloat:     2.5,
+						Sum:            4,
+						PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 1}},
+						PositiveCounts: []float64{2.5},
+						Timestamp:      2000,
+					},
+				},
+			},
+			{
+				Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("bar")}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}
+
+	executeWriteRequest(t, opts, promReq)
+
+	expected := []histogram.Histogram{
+		{
+			Count:           6,
+			Sum:             12,
+			ZeroThreshold:   0.001,
+			ZeroCount:       1,
+			PositiveSpans:   []histogram.Span{{Offset: 1, Length: 2}},
+			PositiveBuckets: []float64{2, 3},
+		},
+		{
+			Count:           2.5,
+			Sum:             4,
+			PositiveSpans:   []histogram.Span{{Offset: 0, Length: 1}},
+			PositiveBuckets: []float64{2.5},
+		},
+	}
+	for i, expectedHistogram := range expected {
+		require.True(t, capturedIter.Next())
+		value := capturedIter.Current()
+		assert.Equal(t, "foo", string(value.Tags.Tags[0].Value))
+		assert.Equal(t, ts.M3MetricTypeHistogram, value.Attributes.M3Type)
+		require.Len(t, value.Datapoints, 1)
+		assert.Equal(t, xtime.UnixNano(int64(i+1)*int64(time.Second)), value.Datapoints[0].Timestamp)
+		assert.Equal(t, expectedHistogram.Count, value.Datapoints[0].Value)
+
+		payload := unmarshalAnnotation(t, value.Annotation)
+		actual, err := histogram.Unmarshal(payload.NativeHistogram)
+		require.NoError(t, err)
+		assert.True(t, expectedHistogram.Equal(actual))
+	}
+
+	require.True(t, capturedIter.Next())
+	value := capturedIter.Current()
+	assert.Equal(t, "bar", string(value.Tags.Tags[0].Value))
+	assert.Nil(t, value.Annotation)
+
+	require.False(t, capturedIter.Next())
+	require.NoError(t, capturedIter.Error())
+}
+
+func TestPromWriteExemplars(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var capturedIter ingest.DownsampleAndWriteIter
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, iter ingest.DownsampleAndWriteIter, _ ingest.WriteOptions) ingest.BatchError {
+			capturedIter = iter
+			return nil
+		})
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetStoreMetricsType(false)
+
+	exemplar := prompb.Exemplar{
+		Labels:    []prompb.Label{{Name: []byte("trace_id"), Value: []byte("abc")}},
+		Value:     0.5,
+		Timestamp: 1500,
+	}
+	promReq := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("bar")}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+			},
+			{
+				Labels:    []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+				Samples:   []prompb.Sample{{Value: 2, Timestamp: 2000}},
+				Exemplars: []prompb.Exemplar{exemplar},
+			},
+			{
+				// Exemplars without samples are dropped.
+				Labels:    []prompb.Label{{Name: []byte("__name__"), Value: []byte("baz")}},
+				Exemplars: []prompb.Exemplar{exemplar},
+			},
+		},
+	}
+
+	executeWriteRequest(t, opts, promReq)
+
+	require.True(t, capturedIter.Next())
+	value := capturedIter.Current()
+	assert.Equal(t, "bar", string(value.Tags.Tags[0].Value))
+	assert.Nil(t, value.Annotation)
+
+	require.True(t, capturedIter.Next())
+	value = capturedIter.Current()
+	assert.Equal(t, "foo", string(value.Tags.Tags[0].Value))
+	payload := unmarshalAnnotation(t, value.Annotation)
+	assert.Equal(t, []*annotation.Exemplar{
+		{
+			Labels: []*annotation.ExemplarLabel{
+				{Name: []byte("trace_id"), Value: []byte("abc")},
+			},
+			Value:          0.5,
+			TimestampNanos: int64(1500 * time.Millisecond),
+		},
+	}, payload.Exemplars)
+
+	require.True(t, capturedIter.Next())
+	value = capturedIter.Current()
+	assert.Equal(t, "baz", string(value.Tags.Tags[0].Value))
+	assert.Nil(t, value.Annotation)
+
+	require.False(t, capturedIter.Next())
+	require.NoError(t, capturedIter.Error())
+}
+
 func TestPromWriteLiteralIsTooLongError(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
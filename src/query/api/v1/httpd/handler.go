@@ -42,6 +42,7 @@ import (
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
+	"github.com/m3db/m3/src/query/api/v1/handler/otlp"
 	"github.com/m3db/m3/src/query/api/v1/handler/prom"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/native"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote"
@@ -50,6 +51,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/api/v1/route"
 	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/util/queryhttp"
 	xdebug "github.com/m3db/m3/src/x/debug"
 	xhttp "github.com/m3db/m3/src/x/net/http"
@@ -133,7 +135,7 @@ func (h *Handler) RegisterRoutes() error {
 			Tagged(v1APIGroup),
 		))
 
-	promRemoteReadHandler := remote.NewPromReadHandler(remoteSourceOpts)
+	promRemoteReadHandler := h.withQueryQuota(remote.NewPromReadHandler(remoteSourceOpts))
 	promRemoteWriteHandler, err := remote.NewPromWriteHandler(remoteSourceOpts)
 	if err != nil {
 		return err
@@ -155,8 +157,10 @@ func (h *Handler) RegisterRoutes() error {
 	if err != nil {
 		return err
 	}
-	nativePromReadHandler := native.NewPromReadHandler(nativeSourceOpts)
-	nativePromReadInstantHandler := native.NewPromReadInstantHandler(nativeSourceOpts)
+	promqlQueryHandler = h.withQueryQuota(promqlQueryHandler)
+	promqlInstantQueryHandler = h.withQueryQuota(promqlInstantQueryHandler)
+	nativePromReadHandler := h.withQueryQuota(native.NewPromReadHandler(nativeSourceOpts))
+	nativePromReadInstantHandler := h.withQueryQuota(native.NewPromReadInstantHandler(nativeSourceOpts))
 
 	h.options.QueryRouter().Setup(options.QueryRouterOptions{
 		DefaultQueryEngine: h.options.DefaultQueryEngine(),
@@ -234,7 +238,7 @@ func (h *Handler) RegisterRoutes() error {
 	}
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    remote.PromWriteURL,
-		Handler: promRemoteWriteHandler,
+		Handler: h.withWriteQuota(promRemoteWriteHandler),
 		Methods: methods(remote.PromWriteHTTPMethod),
 		// Register with no response logging for write calls since so frequent.
 		MiddlewareOverride: middleware.WithNoResponseLogging,
@@ -245,7 +249,7 @@ func (h *Handler) RegisterRoutes() error {
 	// InfluxDB write endpoint.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    influxdb.InfluxWriteURL,
-		Handler: influxdb.NewInfluxWriterHandler(h.options),
+		Handler: h.withWriteQuota(influxdb.NewInfluxWriterHandler(h.options)),
 		Methods: methods(influxdb.InfluxWriteHTTPMethod),
 		// Register with no response logging for write calls since so frequent.
 		MiddlewareOverride: middleware.WithNoResponseLogging,
@@ -253,6 +257,23 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// OTLP/HTTP metrics write endpoint, shares the writer with the OTLP/gRPC
+	// server when one is running.
+	otlpWriter := h.options.OTLPWriter()
+	if otlpWriter == nil {
+		otlpWriter = otlp.NewWriter(h.options,
+			h.options.Config().OTLP.DeltaTemporalityTTLOrDefault())
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    otlp.WriteURL,
+		Handler: h.withWriteQuota(otlp.NewWriteHandler(otlpWriter)),
+		Methods: methods(otlp.WriteHTTPMethod),
+		// Register with no response logging for write calls since so frequent.
+		MiddlewareOverride: middleware.WithNoResponseLogging,
+	}); err != nil {
+		return err
+	}
+
 	// Native M3 search and write endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    handler.SearchURL,
@@ -306,6 +327,16 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// Series cardinality endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:               native.CardinalityURL,
+		Handler:            native.NewCardinalityHandler(h.options),
+		Methods:            native.CardinalityHTTPMethods,
+		MiddlewareOverride: native.WithQueryParams,
+	}); err != nil {
+		return err
+	}
+
 	// Query parse endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    native.PromParseURL,
@@ -332,6 +363,41 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// Exemplar query endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:               native.QueryExemplarsURL,
+		Handler:            native.NewQueryExemplarsHandler(h.options),
+		Methods:            native.QueryExemplarsHTTPMethods,
+		MiddlewareOverride: native.WithQueryParams,
+	}); err != nil {
+		return err
+	}
+
+	// Recording and alerting rules endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.RulesURL,
+		Handler: native.NewRulesHandler(h.options),
+		Methods: native.RulesHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.AlertsURL,
+		Handler: native.NewAlertsHandler(h.options),
+		Methods: native.AlertsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Series deletion endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.DeleteSeriesURL,
+		Handler: native.NewDeleteSeriesHandler(h.options),
+		Methods: native.DeleteSeriesHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
 	// Graphite routable endpoints.
 	h.options.GraphiteRenderRouter().Setup(options.GraphiteRenderRouterOptions{
 		RenderHandler: graphite.NewRenderHandler(h.options).ServeHTTP,
@@ -353,6 +419,27 @@ func (h *Handler) RegisterRoutes() error {
 	}); err != nil {
 		return err
 	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsAutoCompleteTagsURL,
+		Handler: graphite.NewTagsAutoCompleteTagsHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsAutoCompleteValuesURL,
+		Handler: graphite.NewTagsAutoCompleteValuesHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsFindSeriesURL,
+		Handler: graphite.NewTagsFindSeriesHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
 
 	placementOpts, err := h.placementOpts()
 	if err != nil {
@@ -511,6 +598,25 @@ func (h *Handler) RegisterRoutes() error {
 	return nil
 }
 
+// withQueryQuota enforces the tenant concurrent queries quota on a query
+// handler when quotas are enabled.
+func (h *Handler) withQueryQuota(handler http.Handler) http.Handler {
+	if enforcer := h.options.QuotaEnforcer(); enforcer != nil {
+		return quota.NewQueryHandler(enforcer, handler)
+	}
+	return handler
+}
+
+// withWriteQuota attributes the writes of a write handler to the request's
+// tenant when quotas are enabled, the quotas themselves are enforced by the
+// downsampler and writer shared by all ingestion protocols.
+func (h *Handler) withWriteQuota(handler http.Handler) http.Handler {
+	if enforcer := h.options.QuotaEnforcer(); enforcer != nil {
+		return quota.NewWriteHandler(enforcer, handler)
+	}
+	return handler
+}
+
 func (h *Handler) placementOpts() (placementhandler.HandlerOptions, error) {
 	return placementhandler.NewHandlerOptions(
 		h.options.ClusterClient(),
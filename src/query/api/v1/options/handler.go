@@ -22,12 +22,14 @@
 package options
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/prometheus/prometheus/promql"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	clusterclient "github.com/m3db/m3/src/cluster/client"
@@ -43,6 +45,8 @@ import (
 	"github.com/m3db/m3/src/query/executor"
 	graphite "github.com/m3db/m3/src/query/graphite/storage"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/ts"
@@ -93,6 +97,13 @@ type CustomHandler interface {
 	MiddlewareOverride() middleware.OverrideOptions
 }
 
+// OTLPWriter writes OTLP metric export requests, a single instance is shared
+// by the OTLP/HTTP and OTLP/gRPC endpoints so that the state used to convert
+// delta temporality series to cumulative is shared between transports.
+type OTLPWriter interface {
+	Write(ctx context.Context, req *collectormetricspb.ExportMetricsServiceRequest) error
+}
+
 // QueryRouter is responsible for routing queries between promql and m3query.
 type QueryRouter interface {
 	Setup(opts QueryRouterOptions)
@@ -283,6 +294,23 @@ type HandlerOptions interface {
 	DefaultLookback() time.Duration
 	// SetDefaultLookback sets the default value of lookback duration.
 	SetDefaultLookback(value time.Duration) HandlerOptions
+
+	// RulesManager returns the recording and alerting rules manager.
+	RulesManager() rules.Manager
+	// SetRulesManager sets the recording and alerting rules manager.
+	SetRulesManager(value rules.Manager) HandlerOptions
+
+	// QuotaEnforcer returns the per-tenant quota enforcer, nil if quotas
+	// are not enforced.
+	QuotaEnforcer() quota.Enforcer
+	// SetQuotaEnforcer sets the per-tenant quota enforcer.
+	SetQuotaEnforcer(value quota.Enforcer) HandlerOptions
+
+	// OTLPWriter returns the OTLP metrics writer, nil if one should be
+	// created when registering the OTLP/HTTP endpoint.
+	OTLPWriter() OTLPWriter
+	// SetOTLPWriter sets the OTLP metrics writer.
+	SetOTLPWriter(value OTLPWriter) HandlerOptions
 }
 
 // HandlerOptions represents handler options.
@@ -317,6 +345,9 @@ type handlerOptions struct {
 	graphiteRenderRouter              GraphiteRenderRouter
 	graphiteFindRouter                GraphiteFindRouter
 	defaultLookback                   time.Duration
+	rulesManager                      rules.Manager
+	quotaEnforcer                     quota.Enforcer
+	otlpWriter                        OTLPWriter
 }
 
 // EmptyHandlerOptions returns  default handler options.
@@ -714,5 +745,35 @@ func (o *handlerOptions) SetDefaultLookback(value time.Duration) HandlerOptions
 	return &opts
 }
 
+func (o *handlerOptions) RulesManager() rules.Manager {
+	return o.rulesManager
+}
+
+func (o *handlerOptions) SetRulesManager(value rules.Manager) HandlerOptions {
+	opts := *o
+	opts.rulesManager = value
+	return &opts
+}
+
+func (o *handlerOptions) QuotaEnforcer() quota.Enforcer {
+	return o.quotaEnforcer
+}
+
+func (o *handlerOptions) SetQuotaEnforcer(value quota.Enforcer) HandlerOptions {
+	opts := *o
+	opts.quotaEnforcer = value
+	return &opts
+}
+
+func (o *handlerOptions) OTLPWriter() OTLPWriter {
+	return o.otlpWriter
+}
+
+func (o *handlerOptions) SetOTLPWriter(value OTLPWriter) HandlerOptions {
+	opts := *o
+	opts.otlpWriter = value
+	return &opts
+}
+
 // KVStoreProtoParser parses protobuf messages based off specific keys.
 type KVStoreProtoParser func(key string) (protoiface.MessageV1, error)
@@ -36,6 +36,21 @@ const (
 	// QueryURL return the url for the query endpoint.
 	QueryURL = Prefix + "/query"
 
+	// QueryExemplarsURL is the url for the exemplars query endpoint.
+	QueryExemplarsURL = Prefix + "/query_exemplars"
+
+	// RulesURL is the url for the recording and alerting rules endpoint.
+	RulesURL = Prefix + "/rules"
+
+	// AlertsURL is the url for the active alerts endpoint.
+	AlertsURL = Prefix + "/alerts"
+
 	// SeriesMatchURL is the url for remote prom series matcher handler.
 	SeriesMatchURL = Prefix + "/series"
+
+	// DeleteSeriesURL is the url for the series deletion admin endpoint.
+	DeleteSeriesURL = Prefix + "/admin/tsdb/delete_series"
+
+	// StatusTSDBURL is the url for the series cardinality status endpoint.
+	StatusTSDBURL = Prefix + "/status/tsdb"
 )
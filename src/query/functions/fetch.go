@@ -46,6 +46,9 @@ type FetchOp struct {
 	Range    time.Duration
 	Offset   time.Duration
 	Matchers models.Matchers
+	// NativeHistogramProjection projects native histogram samples to float
+	// values when fetching.
+	NativeHistogramProjection storage.NativeHistogramProjection
 }
 
 // FetchNode is a fetch execution node.
@@ -114,6 +117,7 @@ func (n *FetchNode) fetch(queryCtx *models.QueryContext) (block.Result, error) {
 		return block.Result{}, err
 	}
 
+	opts.NativeHistogramProjection = n.op.NativeHistogramProjection
 	offset := n.op.Offset
 	return n.storage.FetchBlocks(ctx, &storage.FetchQuery{
 		Start:       startTime.Add(-1 * offset).ToTime(),
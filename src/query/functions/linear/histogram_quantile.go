@@ -39,7 +39,8 @@ const (
 	//
 	// NB: each sample must contain a tag with a bucket name (given by tag
 	// options) that denotes the upper bound of that bucket; series without this
-	// tag are ignored.
+	// tag are ignored, unless the quantile is also projected from native
+	// histograms, in which case they are passed through as is.
 	HistogramQuantileType = "histogram_quantile"
 	initIndexBucketLength = 10
 )
@@ -66,10 +67,25 @@ func NewHistogramQuantileOp(
 	return newHistogramQuantileOp(q, opType), nil
 }
 
+// WithNativeHistograms marks a histogram quantile operation as also
+// receiving series whose native histogram samples have already been projected
+// to the quantile; these series are passed through instead of being dropped.
+// Operations of any other type are returned unchanged.
+func WithNativeHistograms(params parser.Params) parser.Params {
+	op, ok := params.(histogramQuantileOp)
+	if !ok {
+		return params
+	}
+
+	op.nativeHistograms = true
+	return op
+}
+
 // histogramQuantileOp stores required properties for histogram quantile ops.
 type histogramQuantileOp struct {
-	q      float64
-	opType string
+	q                float64
+	opType           string
+	nativeHistograms bool
 }
 
 // OpType for the operator.
@@ -133,6 +149,29 @@ func (b indexedBuckets) Less(i, j int) bool {
 
 type bucketedSeries map[string]indexedBuckets
 
+// nativeSeries is a series without a bucket tag whose values are quantiles
+// projected from native histograms.
+type nativeSeries struct {
+	idx  int
+	tags models.Tags
+}
+
+func gatherNativeSeries(metas []block.SeriesMeta) []nativeSeries {
+	var series []nativeSeries
+	for i, meta := range metas {
+		if _, found := meta.Tags.Bucket(); found {
+			continue
+		}
+
+		series = append(series, nativeSeries{
+			idx:  i,
+			tags: meta.Tags.WithoutName(),
+		})
+	}
+
+	return series
+}
+
 type validSeriesBuckets []indexedBuckets
 
 func (b validSeriesBuckets) Len() int      { return len(b) }
@@ -282,28 +321,42 @@ func (n *histogramQuantileNode) ProcessBlock(
 	seriesMetas := utils.FlattenMetadata(meta, stepIter.SeriesMeta())
 	seriesBuckets := gatherSeriesToBuckets(seriesMetas)
 
+	var natives []nativeSeries
+	if n.op.nativeHistograms {
+		natives = gatherNativeSeries(seriesMetas)
+	}
+
 	q := n.op.q
 	if q < 0 || q > 1 {
-		return processInvalidQuantile(queryCtx, q, seriesBuckets, meta, stepIter, n.controller)
+		return processInvalidQuantile(queryCtx, q, seriesBuckets, natives,
+			meta, stepIter, n.controller)
 	}
 
-	return processValidQuantile(queryCtx, q, seriesBuckets, meta, stepIter, n.controller)
+	return processValidQuantile(queryCtx, q, seriesBuckets, natives,
+		meta, stepIter, n.controller)
 }
 
 func setupBuilder(
 	queryCtx *models.QueryContext,
 	seriesBuckets validSeriesBuckets,
+	natives []nativeSeries,
 	meta block.Metadata,
 	stepIter block.StepIter,
 	controller *transform.Controller,
 ) (block.Builder, error) {
-	metas := make([]block.SeriesMeta, 0, len(seriesBuckets))
+	metas := make([]block.SeriesMeta, 0, len(seriesBuckets)+len(natives))
 	for _, v := range seriesBuckets {
 		metas = append(metas, block.SeriesMeta{
 			Tags: v.tags,
 		})
 	}
 
+	for _, v := range natives {
+		metas = append(metas, block.SeriesMeta{
+			Tags: v.tags,
+		})
+	}
+
 	builder, err := controller.BlockBuilder(queryCtx, meta, metas)
 	if err != nil {
 		return nil, err
@@ -334,11 +387,13 @@ func processValidQuantile(
 	queryCtx *models.QueryContext,
 	q float64,
 	seriesBuckets validSeriesBuckets,
+	natives []nativeSeries,
 	meta block.Metadata,
 	stepIter block.StepIter,
 	controller *transform.Controller,
 ) (block.Block, error) {
-	builder, err := setupBuilder(queryCtx, seriesBuckets, meta, stepIter, controller)
+	builder, err := setupBuilder(queryCtx, seriesBuckets, natives,
+		meta, stepIter, controller)
 	if err != nil {
 		return nil, err
 	}
@@ -348,7 +403,7 @@ func processValidQuantile(
 		values := step.Values()
 		bucketValues := make([]bucketValue, 0, initIndexBucketLength)
 
-		aggregatedValues := make([]float64, 0, len(seriesBuckets))
+		aggregatedValues := make([]float64, 0, len(seriesBuckets)+len(natives))
 		for _, b := range seriesBuckets {
 			buckets := b.buckets
 			// clear previous bucket values.
@@ -371,6 +426,10 @@ func processValidQuantile(
 			aggregatedValues = append(aggregatedValues, bucketQuantile(q, bucketValues))
 		}
 
+		for _, v := range natives {
+			aggregatedValues = append(aggregatedValues, values[v.idx])
+		}
+
 		if err := builder.AppendValues(index, aggregatedValues); err != nil {
 			return nil, err
 		}
@@ -387,11 +446,13 @@ func processInvalidQuantile(
 	queryCtx *models.QueryContext,
 	q float64,
 	seriesBuckets validSeriesBuckets,
+	natives []nativeSeries,
 	meta block.Metadata,
 	stepIter block.StepIter,
 	controller *transform.Controller,
 ) (block.Block, error) {
-	builder, err := setupBuilder(queryCtx, seriesBuckets, meta, stepIter, controller)
+	builder, err := setupBuilder(queryCtx, seriesBuckets, natives,
+		meta, stepIter, controller)
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +465,7 @@ func processInvalidQuantile(
 	}
 
 	setValue := math.Inf(sign)
-	outValues := make([]float64, len(seriesBuckets))
+	outValues := make([]float64, len(seriesBuckets)+len(natives))
 	util.Memset(outValues, setValue)
 	for index := 0; stepIter.Next(); index++ {
 		if err := builder.AppendValues(index, outValues); err != nil {
@@ -442,3 +442,54 @@ func TestQuantileFunctionForMultipleBuckets(t *testing.T) {
 		compare.EqualsWithNansWithDelta(t, expected, actual, 0.00001)
 	}
 }
+
+func TestQuantileFunctionWithNativeHistograms(t *testing.T) {
+	op, err := NewHistogramQuantileOp([]interface{}{0.8}, HistogramQuantileType)
+	require.NoError(t, err)
+	op = WithNativeHistograms(op)
+
+	tagOpts := models.NewTagOptions().
+		SetIDSchemeType(models.TypeQuoted).
+		SetMetricName([]byte("name")).
+		SetBucketName([]byte("bucket"))
+
+	tags := models.NewTags(3, tagOpts).SetName([]byte("foo")).AddTag(models.Tag{
+		Name:  []byte("bar"),
+		Value: []byte("baz"),
+	})
+
+	seriesMetas := []block.SeriesMeta{
+		{Tags: tags.Clone().SetBucket([]byte("1"))},
+		{Tags: tags.Clone().SetBucket([]byte("Inf"))},
+		// this series holds quantiles projected from native histograms.
+		{Tags: tags.Clone().SetName([]byte("native"))},
+	}
+
+	v := [][]float64{
+		{1, 2, math.NaN()},
+		{2, 2, math.NaN()},
+		{0.5, math.NaN(), 4},
+	}
+
+	bounds := models.Bounds{
+		Start:    xtime.Now(),
+		Duration: time.Minute * 3,
+		StepSize: time.Minute,
+	}
+
+	bl := test.NewBlockFromValuesWithSeriesMeta(bounds, seriesMetas, v)
+	c, sink := executor.NewControllerWithSink(parser.NodeID(rune(1)))
+	node := op.(histogramQuantileOp).Node(c, transform.Options{})
+	err = node.Process(models.NoopQueryContext(), parser.NodeID(rune(0)), bl)
+	require.NoError(t, err)
+
+	expected := [][]float64{
+		{1, 0.8, math.NaN()},
+		{0.5, math.NaN(), 4},
+	}
+	compare.EqualsWithNansWithDelta(t, expected, sink.Values, 0.00001)
+
+	require.Len(t, sink.Metas, 2)
+	_, hasName := sink.Metas[1].Tags.Name()
+	assert.False(t, hasName)
+}
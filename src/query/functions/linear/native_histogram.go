@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package linear
+
+import (
+	"fmt"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/functions/lazy"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+const (
+	// HistogramCountType returns the count of observations of native
+	// histogram samples.
+	//
+	// NB: the count and sum of observations are projected from native
+	// histograms when fetching, this operation only removes the metric name.
+	HistogramCountType = "histogram_count"
+
+	// HistogramSumType returns the sum of observations of native histogram
+	// samples.
+	HistogramSumType = "histogram_sum"
+)
+
+// NewNativeHistogramOp creates a new native histogram op based on the type.
+func NewNativeHistogramOp(opType string) (parser.Params, error) {
+	if opType != HistogramCountType && opType != HistogramSumType {
+		return nil, fmt.Errorf("unknown native histogram type: %s", opType)
+	}
+
+	lazyOpts := block.NewLazyOptions().SetSeriesMetaTransform(removeName)
+	return lazy.NewLazyOp(opType, lazyOpts)
+}
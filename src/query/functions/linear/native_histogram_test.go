@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package linear
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/test/compare"
+	"github.com/m3db/m3/src/query/test/executor"
+)
+
+func TestNativeHistogramOp(t *testing.T) {
+	for _, opType := range []string{HistogramCountType, HistogramSumType} {
+		t.Run(opType, func(t *testing.T) {
+			values, bounds := test.GenerateValuesAndBounds(nil, nil)
+			block := test.NewBlockFromValues(bounds, values)
+			c, sink := executor.NewControllerWithSink(parser.NodeID(rune(1)))
+			nativeOp, err := NewNativeHistogramOp(opType)
+			require.NoError(t, err)
+
+			op, ok := nativeOp.(transform.Params)
+			require.True(t, ok)
+
+			node := op.Node(c, transform.Options{})
+			err = node.Process(models.NoopQueryContext(), parser.NodeID(rune(0)), block)
+			require.NoError(t, err)
+			assert.Len(t, sink.Values, 2)
+			compare.EqualsWithNans(t, values, sink.Values)
+		})
+	}
+}
+
+func TestNativeHistogramOpInvalidType(t *testing.T) {
+	_, err := NewNativeHistogramOp(HistogramQuantileType)
+	require.Error(t, err)
+}
@@ -151,6 +151,33 @@ func (x LabelMatcher_Type) String() string {
 }
 func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4, 0} }
 
+type Histogram_ResetHint int32
+
+const (
+	Histogram_UNKNOWN Histogram_ResetHint = 0
+	Histogram_YES     Histogram_ResetHint = 1
+	Histogram_NO      Histogram_ResetHint = 2
+	Histogram_GAUGE   Histogram_ResetHint = 3
+)
+
+var Histogram_ResetHint_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "YES",
+	2: "NO",
+	3: "GAUGE",
+}
+var Histogram_ResetHint_value = map[string]int32{
+	"UNKNOWN": 0,
+	"YES":     1,
+	"NO":      2,
+	"GAUGE":   3,
+}
+
+func (x Histogram_ResetHint) String() string {
+	return proto.EnumName(Histogram_ResetHint_name, int32(x))
+}
+func (Histogram_ResetHint) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5, 0} }
+
 type Sample struct {
 	Value     float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -178,6 +205,10 @@ func (m *Sample) GetTimestamp() int64 {
 type TimeSeries struct {
 	Labels  []Label  `protobuf:"bytes,1,rep,name=labels" json:"labels"`
 	Samples []Sample `protobuf:"bytes,2,rep,name=samples" json:"samples"`
+	// Exemplars of the series.
+	Exemplars []Exemplar `protobuf:"bytes,3,rep,name=exemplars" json:"exemplars"`
+	// Native histogram samples of the series.
+	Histograms []Histogram `protobuf:"bytes,4,rep,name=histograms" json:"histograms"`
 	// NB: These are custom fields that M3 uses. They start at 101 so that they
 	// should never clash with prometheus fields.
 	M3Type M3Type     `protobuf:"varint,101,opt,name=m3_type,json=m3Type,proto3,enum=m3prometheus.M3Type" json:"m3_type,omitempty"`
@@ -204,6 +235,20 @@ func (m *TimeSeries) GetSamples() []Sample {
 	return nil
 }
 
+func (m *TimeSeries) GetExemplars() []Exemplar {
+	if m != nil {
+		return m.Exemplars
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetHistograms() []Histogram {
+	if m != nil {
+		return m.Histograms
+	}
+	return nil
+}
+
 func (m *TimeSeries) GetM3Type() M3Type {
 	if m != nil {
 		return m.M3Type
@@ -298,16 +343,224 @@ func (m *LabelMatcher) GetValue() []byte {
 	return nil
 }
 
+// Histogram is a native histogram sample, it is wire compatible with the
+// Prometheus remote write histogram with the oneof fields flattened.
+type Histogram struct {
+	CountInt   uint64  `protobuf:"varint,1,opt,name=count_int,json=countInt,proto3" json:"count_int,omitempty"`
+	CountFloat float64 `protobuf:"fixed64,2,opt,name=count_float,json=countFloat,proto3" json:"count_float,omitempty"`
+	// Sum of observations in the histogram.
+	Sum float64 `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	// The schema defines the bucket schema. Currently, valid numbers
+	// are -4 <= n <= 8.
+	Schema         int32   `protobuf:"zigzag32,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ZeroThreshold  float64 `protobuf:"fixed64,5,opt,name=zero_threshold,json=zeroThreshold,proto3" json:"zero_threshold,omitempty"`
+	ZeroCountInt   uint64  `protobuf:"varint,6,opt,name=zero_count_int,json=zeroCountInt,proto3" json:"zero_count_int,omitempty"`
+	ZeroCountFloat float64 `protobuf:"fixed64,7,opt,name=zero_count_float,json=zeroCountFloat,proto3" json:"zero_count_float,omitempty"`
+	// Negative buckets for the native histogram.
+	NegativeSpans []BucketSpan `protobuf:"bytes,8,rep,name=negative_spans,json=negativeSpans" json:"negative_spans"`
+	// Use either "negative_deltas" or "negative_counts", the former for
+	// regular histograms with integer counts, the latter for float
+	// histograms.
+	NegativeDeltas []int64   `protobuf:"zigzag64,9,rep,packed,name=negative_deltas,json=negativeDeltas" json:"negative_deltas,omitempty"`
+	NegativeCounts []float64 `protobuf:"fixed64,10,rep,packed,name=negative_counts,json=negativeCounts" json:"negative_counts,omitempty"`
+	// Positive buckets for the native histogram.
+	PositiveSpans  []BucketSpan        `protobuf:"bytes,11,rep,name=positive_spans,json=positiveSpans" json:"positive_spans"`
+	PositiveDeltas []int64             `protobuf:"zigzag64,12,rep,packed,name=positive_deltas,json=positiveDeltas" json:"positive_deltas,omitempty"`
+	PositiveCounts []float64           `protobuf:"fixed64,13,rep,packed,name=positive_counts,json=positiveCounts" json:"positive_counts,omitempty"`
+	ResetHint      Histogram_ResetHint `protobuf:"varint,14,opt,name=reset_hint,json=resetHint,proto3,enum=m3prometheus.Histogram_ResetHint" json:"reset_hint,omitempty"`
+	// timestamp is in ms format.
+	Timestamp int64 `protobuf:"varint,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Histogram) Reset()                    { *m = Histogram{} }
+func (m *Histogram) String() string            { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()               {}
+func (*Histogram) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5} }
+
+func (m *Histogram) GetCountInt() uint64 {
+	if m != nil {
+		return m.CountInt
+	}
+	return 0
+}
+
+func (m *Histogram) GetCountFloat() float64 {
+	if m != nil {
+		return m.CountFloat
+	}
+	return 0
+}
+
+func (m *Histogram) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *Histogram) GetSchema() int32 {
+	if m != nil {
+		return m.Schema
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroThreshold() float64 {
+	if m != nil {
+		return m.ZeroThreshold
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroCountInt() uint64 {
+	if m != nil {
+		return m.ZeroCountInt
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroCountFloat() float64 {
+	if m != nil {
+		return m.ZeroCountFloat
+	}
+	return 0
+}
+
+func (m *Histogram) GetNegativeSpans() []BucketSpan {
+	if m != nil {
+		return m.NegativeSpans
+	}
+	return nil
+}
+
+func (m *Histogram) GetNegativeDeltas() []int64 {
+	if m != nil {
+		return m.NegativeDeltas
+	}
+	return nil
+}
+
+func (m *Histogram) GetNegativeCounts() []float64 {
+	if m != nil {
+		return m.NegativeCounts
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveSpans() []BucketSpan {
+	if m != nil {
+		return m.PositiveSpans
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveDeltas() []int64 {
+	if m != nil {
+		return m.PositiveDeltas
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveCounts() []float64 {
+	if m != nil {
+		return m.PositiveCounts
+	}
+	return nil
+}
+
+func (m *Histogram) GetResetHint() Histogram_ResetHint {
+	if m != nil {
+		return m.ResetHint
+	}
+	return Histogram_UNKNOWN
+}
+
+func (m *Histogram) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// BucketSpan defines a number of consecutive buckets with their offset.
+// Logically, it would be more straightforward to include the bucket counts
+// in the Span. However, the protobuf representation is more compact in the
+// way the data is structured here (with all the buckets in a single array
+// separate from the Spans).
+type BucketSpan struct {
+	Offset int32  `protobuf:"zigzag32,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *BucketSpan) Reset()                    { *m = BucketSpan{} }
+func (m *BucketSpan) String() string            { return proto.CompactTextString(m) }
+func (*BucketSpan) ProtoMessage()               {}
+func (*BucketSpan) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{6} }
+
+func (m *BucketSpan) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BucketSpan) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// Exemplar is a sample attached to a series that references an external
+// source of information, typically a trace ID.
+type Exemplar struct {
+	// Optional, can be empty.
+	Labels []Label `protobuf:"bytes,1,rep,name=labels" json:"labels"`
+	Value  float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// timestamp is in ms format.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Exemplar) Reset()                    { *m = Exemplar{} }
+func (m *Exemplar) String() string            { return proto.CompactTextString(m) }
+func (*Exemplar) ProtoMessage()               {}
+func (*Exemplar) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+
+func (m *Exemplar) GetLabels() []Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Exemplar) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Exemplar) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Sample)(nil), "m3prometheus.Sample")
 	proto.RegisterType((*TimeSeries)(nil), "m3prometheus.TimeSeries")
 	proto.RegisterType((*Label)(nil), "m3prometheus.Label")
 	proto.RegisterType((*Labels)(nil), "m3prometheus.Labels")
 	proto.RegisterType((*LabelMatcher)(nil), "m3prometheus.LabelMatcher")
+	proto.RegisterType((*Histogram)(nil), "m3prometheus.Histogram")
+	proto.RegisterType((*BucketSpan)(nil), "m3prometheus.BucketSpan")
+	proto.RegisterType((*Exemplar)(nil), "m3prometheus.Exemplar")
 	proto.RegisterEnum("m3prometheus.MetricType", MetricType_name, MetricType_value)
 	proto.RegisterEnum("m3prometheus.M3Type", M3Type_name, M3Type_value)
 	proto.RegisterEnum("m3prometheus.Source", Source_name, Source_value)
 	proto.RegisterEnum("m3prometheus.LabelMatcher_Type", LabelMatcher_Type_name, LabelMatcher_Type_value)
+	proto.RegisterEnum("m3prometheus.Histogram_ResetHint", Histogram_ResetHint_name, Histogram_ResetHint_value)
 }
 func (m *Sample) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -377,6 +630,30 @@ func (m *TimeSeries) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Exemplars) > 0 {
+		for _, msg := range m.Exemplars {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Histograms) > 0 {
+		for _, msg := range m.Histograms {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.M3Type != 0 {
 		dAtA[i] = 0xa8
 		i++
@@ -496,74 +773,302 @@ func (m *LabelMatcher) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Sample) Size() (n int) {
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 9
+	if m.CountInt != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.CountInt))
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTypes(uint64(m.Timestamp))
+	if m.CountFloat != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CountFloat))))
+		i += 8
 	}
-	return n
-}
-
-func (m *TimeSeries) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Sum != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i += 8
+	}
+	if m.Schema != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64((uint32(m.Schema)<<1)^uint32((m.Schema>>31))))
+	}
+	if m.ZeroThreshold != 0 {
+		dAtA[i] = 0x29
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZeroThreshold))))
+		i += 8
+	}
+	if m.ZeroCountInt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ZeroCountInt))
+	}
+	if m.ZeroCountFloat != 0 {
+		dAtA[i] = 0x39
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZeroCountFloat))))
+		i += 8
+	}
+	if len(m.NegativeSpans) > 0 {
+		for _, msg := range m.NegativeSpans {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if len(m.Samples) > 0 {
-		for _, e := range m.Samples {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.NegativeDeltas) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		var j1 int
+		dAtA3 := make([]byte, len(m.NegativeDeltas)*10)
+		for _, num := range m.NegativeDeltas {
+			x2 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x2 >= 1<<7 {
+				dAtA3[j1] = uint8(uint64(x2)&0x7f | 0x80)
+				j1++
+				x2 >>= 7
+			}
+			dAtA3[j1] = uint8(x2)
+			j1++
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA3[:j1])
 	}
-	if m.M3Type != 0 {
-		n += 2 + sovTypes(uint64(m.M3Type))
+	if len(m.NegativeCounts) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NegativeCounts)*8))
+		for _, num := range m.NegativeCounts {
+			f4 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f4))
+			i += 8
+		}
 	}
-	if m.Source != 0 {
-		n += 2 + sovTypes(uint64(m.Source))
+	if len(m.PositiveSpans) > 0 {
+		for _, msg := range m.PositiveSpans {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Type != 0 {
-		n += 2 + sovTypes(uint64(m.Type))
+	if len(m.PositiveDeltas) > 0 {
+		dAtA[i] = 0x62
+		i++
+		var j5 int
+		dAtA7 := make([]byte, len(m.PositiveDeltas)*10)
+		for _, num := range m.PositiveDeltas {
+			x6 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x6 >= 1<<7 {
+				dAtA7[j5] = uint8(uint64(x6)&0x7f | 0x80)
+				j5++
+				x6 >>= 7
+			}
+			dAtA7[j5] = uint8(x6)
+			j5++
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA7[:j5])
 	}
-	return n
-}
-
-func (m *Label) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.PositiveCounts) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PositiveCounts)*8))
+		for _, num := range m.PositiveCounts {
+			f8 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f8))
+			i += 8
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ResetHint != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResetHint))
 	}
-	return n
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
 }
 
-func (m *Labels) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
+func (m *BucketSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketSpan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64((uint32(m.Offset)<<1)^uint32((m.Offset>>31))))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *Exemplar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exemplar) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i += 8
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Sample) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *TimeSeries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Exemplars) > 0 {
+		for _, e := range m.Exemplars {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Histograms) > 0 {
+		for _, e := range m.Histograms {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.M3Type != 0 {
+		n += 2 + sovTypes(uint64(m.M3Type))
+	}
+	if m.Source != 0 {
+		n += 2 + sovTypes(uint64(m.Source))
+	}
+	if m.Type != 0 {
+		n += 2 + sovTypes(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *Label) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Labels) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
@@ -587,6 +1092,101 @@ func (m *LabelMatcher) Size() (n int) {
 	return n
 }
 
+func (m *Histogram) Size() (n int) {
+	var l int
+	_ = l
+	if m.CountInt != 0 {
+		n += 1 + sovTypes(uint64(m.CountInt))
+	}
+	if m.CountFloat != 0 {
+		n += 9
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.Schema != 0 {
+		n += 1 + sozTypes(uint64(m.Schema))
+	}
+	if m.ZeroThreshold != 0 {
+		n += 9
+	}
+	if m.ZeroCountInt != 0 {
+		n += 1 + sovTypes(uint64(m.ZeroCountInt))
+	}
+	if m.ZeroCountFloat != 0 {
+		n += 9
+	}
+	if len(m.NegativeSpans) > 0 {
+		for _, e := range m.NegativeSpans {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.NegativeDeltas) > 0 {
+		l = 0
+		for _, e := range m.NegativeDeltas {
+			l += sozTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.NegativeCounts) > 0 {
+		n += 1 + sovTypes(uint64(len(m.NegativeCounts)*8)) + len(m.NegativeCounts)*8
+	}
+	if len(m.PositiveSpans) > 0 {
+		for _, e := range m.PositiveSpans {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PositiveDeltas) > 0 {
+		l = 0
+		for _, e := range m.PositiveDeltas {
+			l += sozTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.PositiveCounts) > 0 {
+		n += 1 + sovTypes(uint64(len(m.PositiveCounts)*8)) + len(m.PositiveCounts)*8
+	}
+	if m.ResetHint != 0 {
+		n += 1 + sovTypes(uint64(m.ResetHint))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BucketSpan) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sozTypes(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *Exemplar) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -771,6 +1371,68 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemplars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exemplars = append(m.Exemplars, Exemplar{})
+			if err := m.Exemplars[len(m.Exemplars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histograms = append(m.Histograms, Histogram{})
+			if err := m.Histograms[len(m.Histograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 101:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field M3Type", wireType)
@@ -1173,6 +1835,680 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountInt", wireType)
+			}
+			m.CountInt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountInt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountFloat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CountFloat = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Schema = v
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZeroThreshold = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCountInt", wireType)
+			}
+			m.ZeroCountInt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroCountInt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCountFloat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZeroCountFloat = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeSpans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NegativeSpans = append(m.NegativeSpans, BucketSpan{})
+			if err := m.NegativeSpans[len(m.NegativeSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.NegativeDeltas = append(m.NegativeDeltas, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.NegativeDeltas = append(m.NegativeDeltas, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeDeltas", wireType)
+			}
+		case 10:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.NegativeCounts = append(m.NegativeCounts, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.NegativeCounts = append(m.NegativeCounts, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeCounts", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveSpans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositiveSpans = append(m.PositiveSpans, BucketSpan{})
+			if err := m.PositiveSpans[len(m.PositiveSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PositiveDeltas = append(m.PositiveDeltas, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.PositiveDeltas = append(m.PositiveDeltas, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveDeltas", wireType)
+			}
+		case 13:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PositiveCounts = append(m.PositiveCounts, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PositiveCounts = append(m.PositiveCounts, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveCounts", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHint", wireType)
+			}
+			m.ResetHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHint |= (Histogram_ResetHint(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Offset = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Exemplar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exemplar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exemplar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, Label{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1283,42 +2619,65 @@ func init() {
 }
 
 var fileDescriptorTypes = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0x38, 0x89, 0xd3, 0xde, 0xe6, 0xeb, 0x37, 0x9a, 0x76, 0x61, 0x21, 0x94, 0x46, 0x59,
-	0x45, 0x15, 0x8d, 0x55, 0xdc, 0x05, 0x12, 0x48, 0x28, 0xad, 0x86, 0x34, 0xa2, 0xb6, 0xdb, 0xb1,
-	0x23, 0x04, 0x9b, 0xc8, 0x4e, 0xa7, 0x69, 0xa4, 0x4c, 0x6d, 0xfc, 0x83, 0x54, 0x76, 0xbc, 0x01,
-	0x3b, 0x5e, 0xa9, 0x4b, 0x9e, 0x00, 0xa1, 0xb2, 0xe2, 0x2d, 0xd0, 0xcc, 0x04, 0x5c, 0xaa, 0x6e,
-	0xd8, 0xd8, 0x73, 0xcf, 0x3d, 0xe7, 0xce, 0x99, 0x33, 0x1a, 0x78, 0x39, 0x5f, 0x14, 0x97, 0x65,
-	0x3c, 0x98, 0x25, 0xc2, 0x16, 0xce, 0x79, 0x6c, 0x0b, 0xc7, 0xce, 0xb3, 0x99, 0xfd, 0xbe, 0xe4,
-	0xd9, 0xb5, 0x3d, 0xe7, 0x57, 0x3c, 0x8b, 0x0a, 0x7e, 0x6e, 0xa7, 0x59, 0x52, 0x24, 0xf2, 0x2b,
-	0xd2, 0xd8, 0x2e, 0xae, 0x53, 0x9e, 0x0f, 0x14, 0x44, 0xda, 0xc2, 0x91, 0x28, 0x2f, 0x2e, 0x79,
-	0x99, 0x3f, 0xda, 0xbb, 0x33, 0x6e, 0x9e, 0xcc, 0x13, 0xad, 0x8b, 0xcb, 0x0b, 0x55, 0xe9, 0x21,
-	0x72, 0xa5, 0xc5, 0xbd, 0x17, 0x60, 0x06, 0x91, 0x48, 0x97, 0x9c, 0x6c, 0x43, 0xf3, 0x43, 0xb4,
-	0x2c, 0xb9, 0x85, 0xba, 0xa8, 0x8f, 0x98, 0x2e, 0xc8, 0x63, 0x58, 0x2f, 0x16, 0x82, 0xe7, 0x45,
-	0x24, 0x52, 0xcb, 0xe8, 0xa2, 0x7e, 0x9d, 0x55, 0x40, 0xef, 0x93, 0x01, 0x10, 0x2e, 0x04, 0x0f,
-	0x78, 0xb6, 0xe0, 0x39, 0xd9, 0x07, 0x73, 0x19, 0xc5, 0x7c, 0x99, 0x5b, 0xa8, 0x5b, 0xef, 0x6f,
-	0x3c, 0xdd, 0x1a, 0xdc, 0xb5, 0x36, 0x38, 0x91, 0xbd, 0xc3, 0xc6, 0xcd, 0xb7, 0x9d, 0x1a, 0x5b,
-	0x11, 0xc9, 0x01, 0xb4, 0x72, 0xb5, 0x7f, 0x6e, 0x19, 0x4a, 0xb3, 0xfd, 0xb7, 0x46, 0x9b, 0x5b,
-	0x89, 0x7e, 0x53, 0xc9, 0x1e, 0xb4, 0x84, 0x33, 0x95, 0x21, 0x58, 0xbc, 0x8b, 0xfa, 0x9b, 0xf7,
-	0x55, 0xae, 0x13, 0x5e, 0xa7, 0x9c, 0x99, 0x42, 0xfd, 0xc9, 0x13, 0x30, 0xf3, 0xa4, 0xcc, 0x66,
-	0xdc, 0xba, 0x78, 0x88, 0x1d, 0xa8, 0x1e, 0x5b, 0x71, 0xc8, 0x1e, 0x34, 0xd4, 0xe4, 0x9f, 0x2d,
-	0x45, 0xb6, 0xee, 0x8d, 0xe6, 0x45, 0xb6, 0x98, 0xa9, 0xf1, 0x8a, 0xd6, 0xdb, 0x87, 0xa6, 0x3a,
-	0x18, 0x21, 0xd0, 0xb8, 0x8a, 0x84, 0xce, 0xaf, 0xcd, 0xd4, 0xba, 0x0a, 0xd5, 0x50, 0xa0, 0x2e,
-	0x7a, 0xcf, 0xc1, 0x3c, 0xd1, 0xc7, 0xff, 0xf7, 0xc4, 0x7a, 0x5f, 0x10, 0xb4, 0x15, 0xee, 0x46,
-	0xc5, 0xec, 0x92, 0x67, 0xc4, 0x59, 0xf9, 0x45, 0xca, 0xee, 0xce, 0x03, 0x13, 0x56, 0xcc, 0x41,
-	0xe5, 0xfa, 0x8f, 0x59, 0xe3, 0x21, 0xb3, 0xf5, 0xbb, 0x66, 0xfb, 0xd0, 0x50, 0x21, 0x9a, 0x60,
-	0xd0, 0x33, 0x5c, 0x23, 0x2d, 0xa8, 0x7b, 0xf4, 0x0c, 0x23, 0x09, 0x30, 0x8a, 0x0d, 0x05, 0x30,
-	0x8a, 0xeb, 0xbb, 0x1f, 0x01, 0xaa, 0x74, 0xc8, 0x06, 0xb4, 0x26, 0xde, 0x6b, 0xcf, 0x7f, 0xe3,
-	0xe1, 0x9a, 0x2c, 0x8e, 0xfc, 0x89, 0x17, 0x52, 0x86, 0x11, 0x59, 0x87, 0xe6, 0x68, 0x38, 0x19,
-	0x49, 0xed, 0x7f, 0xb0, 0x7e, 0x3c, 0x0e, 0x42, 0x7f, 0xc4, 0x86, 0x2e, 0xae, 0x93, 0x2d, 0xf8,
-	0x5f, 0x75, 0xa6, 0x15, 0xd8, 0x90, 0xda, 0x60, 0xe2, 0xba, 0x43, 0xf6, 0x16, 0x37, 0xc9, 0x1a,
-	0x34, 0xc6, 0xde, 0x2b, 0x1f, 0x9b, 0xa4, 0x0d, 0x6b, 0x41, 0x38, 0x0c, 0x69, 0x40, 0x43, 0xdc,
-	0xda, 0x3d, 0x00, 0x53, 0x5f, 0xba, 0xc4, 0x5d, 0x67, 0xaa, 0x37, 0xa8, 0x91, 0x4d, 0x00, 0xd7,
-	0x99, 0x56, 0x7b, 0xeb, 0x6e, 0x38, 0x76, 0x29, 0xc3, 0xc6, 0xee, 0x33, 0x30, 0xf5, 0xe5, 0x4b,
-	0xde, 0x29, 0xf3, 0x5d, 0x1a, 0x1e, 0xd3, 0x49, 0x80, 0x6b, 0x92, 0x37, 0x62, 0xc3, 0xd3, 0xe3,
-	0x71, 0x48, 0x31, 0x22, 0x18, 0xda, 0xfe, 0x29, 0xf5, 0xa6, 0x2e, 0x0d, 0xd9, 0xf8, 0x28, 0xc0,
-	0xc6, 0xa1, 0x75, 0x73, 0xdb, 0x41, 0x5f, 0x6f, 0x3b, 0xe8, 0xfb, 0x6d, 0x07, 0x7d, 0xfe, 0xd1,
-	0xa9, 0xbd, 0x33, 0xf5, 0xd3, 0x8c, 0x4d, 0xf5, 0xb0, 0x9c, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x08, 0xf9, 0x5a, 0x0c, 0xd8, 0x03, 0x00, 0x00,
+	// 954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x6b, 0x3b, 0x71, 0x9a, 0x93, 0x3f, 0xf5, 0xce, 0xae, 0x16, 0x0b, 0x50, 0x1b, 0x22,
+	0x10, 0x51, 0x45, 0x13, 0x2d, 0xe9, 0x05, 0x82, 0x45, 0x90, 0x16, 0x6f, 0x13, 0xb1, 0x4e, 0xba,
+	0x63, 0x47, 0x68, 0xb9, 0x89, 0x9c, 0x74, 0x92, 0x58, 0xc4, 0x7f, 0xd6, 0x33, 0x59, 0xd1, 0x7d,
+	0x0a, 0xee, 0x78, 0x0f, 0x9e, 0x62, 0x2f, 0x79, 0x02, 0x84, 0xca, 0x15, 0xef, 0xc0, 0x05, 0x9a,
+	0x19, 0x3b, 0x4e, 0xa2, 0x22, 0x01, 0x37, 0xed, 0xcc, 0x37, 0xdf, 0x77, 0xfc, 0xf3, 0xcc, 0xe4,
+	0x18, 0xbe, 0x5a, 0xf8, 0x6c, 0xb9, 0x9e, 0xb6, 0x67, 0x51, 0xd0, 0x09, 0xba, 0x37, 0xd3, 0x4e,
+	0xd0, 0xed, 0xd0, 0x64, 0xd6, 0x79, 0xb5, 0x26, 0xc9, 0x6d, 0x67, 0x41, 0x42, 0x92, 0x78, 0x8c,
+	0xdc, 0x74, 0xe2, 0x24, 0x62, 0x11, 0xff, 0x1b, 0xc4, 0xd3, 0x0e, 0xbb, 0x8d, 0x09, 0x6d, 0x0b,
+	0x09, 0x55, 0x83, 0x2e, 0x57, 0x09, 0x5b, 0x92, 0x35, 0x7d, 0xf7, 0x6c, 0xab, 0xdc, 0x22, 0x5a,
+	0x44, 0x32, 0x37, 0x5d, 0xcf, 0xc5, 0x4c, 0x16, 0xe1, 0x23, 0x19, 0x6e, 0x3e, 0x05, 0xdd, 0xf1,
+	0x82, 0x78, 0x45, 0xd0, 0x23, 0x28, 0xbe, 0xf6, 0x56, 0x6b, 0x62, 0x2a, 0x0d, 0xa5, 0xa5, 0x60,
+	0x39, 0x41, 0xef, 0x43, 0x99, 0xf9, 0x01, 0xa1, 0xcc, 0x0b, 0x62, 0x53, 0x6d, 0x28, 0x2d, 0x0d,
+	0xe7, 0x42, 0xf3, 0x2f, 0x15, 0xc0, 0xf5, 0x03, 0xe2, 0x90, 0xc4, 0x27, 0x14, 0x3d, 0x01, 0x7d,
+	0xe5, 0x4d, 0xc9, 0x8a, 0x9a, 0x4a, 0x43, 0x6b, 0x55, 0x3e, 0x7d, 0xd8, 0xde, 0x46, 0x6b, 0x3f,
+	0xe7, 0x6b, 0x17, 0x85, 0xb7, 0xbf, 0x9d, 0x1c, 0xe0, 0xd4, 0x88, 0xce, 0xa1, 0x44, 0xc5, 0xf3,
+	0xa9, 0xa9, 0x8a, 0xcc, 0xa3, 0xdd, 0x8c, 0x84, 0x4b, 0x43, 0x99, 0x15, 0x7d, 0x0e, 0x65, 0xf2,
+	0x23, 0x09, 0xe2, 0x95, 0x97, 0x50, 0x53, 0x13, 0xb9, 0xc7, 0xbb, 0x39, 0x2b, 0x5d, 0x4e, 0x93,
+	0xb9, 0x1d, 0x7d, 0x09, 0xb0, 0xf4, 0x29, 0x8b, 0x16, 0x89, 0x17, 0x50, 0xb3, 0x20, 0xc2, 0xef,
+	0xec, 0x86, 0xfb, 0xd9, 0x7a, 0x9a, 0xde, 0x0a, 0xa0, 0x33, 0x28, 0x05, 0xdd, 0x09, 0xdf, 0x7f,
+	0x93, 0x34, 0x94, 0x56, 0x7d, 0x1f, 0xd8, 0xee, 0xba, 0xb7, 0x31, 0xc1, 0x7a, 0x20, 0xfe, 0xa3,
+	0x4f, 0x40, 0xa7, 0xd1, 0x3a, 0x99, 0x11, 0x73, 0x7e, 0x9f, 0xdb, 0x11, 0x6b, 0x38, 0xf5, 0xa0,
+	0x33, 0x28, 0x88, 0xca, 0x7f, 0x96, 0x84, 0xd9, 0xdc, 0x2b, 0x4d, 0x58, 0xe2, 0xcf, 0x44, 0x79,
+	0x61, 0x6b, 0x3e, 0x81, 0xa2, 0xd8, 0x53, 0x84, 0xa0, 0x10, 0x7a, 0x81, 0x3c, 0xba, 0x2a, 0x16,
+	0xe3, 0xfc, 0x3c, 0x55, 0x21, 0xca, 0x49, 0xf3, 0x0b, 0xd0, 0x9f, 0xcb, 0x9d, 0xff, 0xef, 0x87,
+	0xd5, 0xfc, 0x59, 0x81, 0xaa, 0xd0, 0x6d, 0x8f, 0xcd, 0x96, 0x24, 0x41, 0xdd, 0x94, 0x57, 0x11,
+	0xb8, 0x27, 0xf7, 0x54, 0x48, 0x9d, 0xed, 0x9c, 0x7a, 0x03, 0xab, 0xde, 0x07, 0xab, 0x6d, 0xc3,
+	0xb6, 0xa0, 0x20, 0x36, 0x51, 0x07, 0xd5, 0x7a, 0x61, 0x1c, 0xa0, 0x12, 0x68, 0x43, 0xeb, 0x85,
+	0xa1, 0x70, 0x01, 0x5b, 0x86, 0x2a, 0x04, 0x6c, 0x19, 0x5a, 0xf3, 0x97, 0x22, 0x94, 0x37, 0xa7,
+	0x86, 0xde, 0x83, 0xf2, 0x2c, 0x5a, 0x87, 0x6c, 0xe2, 0x87, 0x4c, 0xb0, 0x15, 0xf0, 0xa1, 0x10,
+	0x06, 0x21, 0x43, 0x27, 0x50, 0x91, 0x8b, 0xf3, 0x55, 0xe4, 0x31, 0x41, 0xa1, 0x60, 0x10, 0xd2,
+	0x33, 0xae, 0x20, 0x03, 0x34, 0xba, 0x0e, 0x04, 0x89, 0x82, 0xf9, 0x10, 0x3d, 0x06, 0x9d, 0xce,
+	0x96, 0x24, 0xf0, 0xcc, 0x42, 0x43, 0x69, 0x3d, 0xc0, 0xe9, 0x0c, 0x7d, 0x04, 0xf5, 0x37, 0x24,
+	0x89, 0x26, 0x6c, 0x99, 0x10, 0xba, 0x8c, 0x56, 0x37, 0x66, 0x51, 0x84, 0x6a, 0x5c, 0x75, 0x33,
+	0x11, 0x7d, 0x98, 0xda, 0x72, 0x26, 0x5d, 0x30, 0x55, 0xb9, 0x7a, 0x99, 0x71, 0xb5, 0xc0, 0xd8,
+	0x72, 0x49, 0xb8, 0x92, 0x28, 0x57, 0xdf, 0xf8, 0x24, 0xa0, 0x05, 0xf5, 0x90, 0x2c, 0x3c, 0xe6,
+	0xbf, 0x26, 0x13, 0x1a, 0x7b, 0x21, 0x35, 0x0f, 0xc5, 0x09, 0xee, 0x5d, 0x97, 0x8b, 0xf5, 0xec,
+	0x07, 0xc2, 0x9c, 0xd8, 0x0b, 0xd3, 0x63, 0xac, 0x65, 0x29, 0xae, 0x51, 0xf4, 0x31, 0x1c, 0x6d,
+	0xca, 0xdc, 0x90, 0x15, 0xf3, 0xa8, 0x59, 0x6e, 0x68, 0x2d, 0x84, 0x37, 0xd5, 0xbf, 0x11, 0xea,
+	0x8e, 0x51, 0xd0, 0x51, 0x13, 0x1a, 0x1a, 0x07, 0xcb, 0x64, 0x01, 0x47, 0x39, 0x58, 0x1c, 0x51,
+	0x7f, 0x0b, 0xac, 0xf2, 0xef, 0xc0, 0xb2, 0xd4, 0x06, 0x6c, 0x53, 0x26, 0x05, 0xab, 0x4a, 0xb0,
+	0x4c, 0xce, 0xc1, 0x36, 0xc6, 0x14, 0xac, 0x26, 0xc1, 0x32, 0x39, 0x05, 0xfb, 0x1a, 0x20, 0x21,
+	0x94, 0xb0, 0xc9, 0x92, 0xef, 0x7e, 0x5d, 0xdc, 0xd6, 0x0f, 0xfe, 0xe1, 0x37, 0xdf, 0xc6, 0xdc,
+	0xd9, 0xf7, 0x43, 0x86, 0xcb, 0x49, 0x36, 0xdc, 0xed, 0x83, 0x47, 0xfb, 0x7d, 0xf0, 0x1c, 0xca,
+	0x9b, 0x14, 0xaa, 0x40, 0x69, 0x3c, 0xfc, 0x76, 0x38, 0xfa, 0x6e, 0x28, 0xaf, 0xec, 0x4b, 0xcb,
+	0x91, 0x57, 0x76, 0x38, 0x32, 0x54, 0x54, 0x86, 0xe2, 0x55, 0x6f, 0x7c, 0xc5, 0x2f, 0xed, 0x53,
+	0x80, 0x7c, 0x2b, 0xf8, 0x25, 0x8b, 0xe6, 0x73, 0x4a, 0xe4, 0x8d, 0x7d, 0x80, 0xd3, 0x19, 0xd7,
+	0x57, 0x24, 0x5c, 0xb0, 0xa5, 0xb8, 0xaa, 0x35, 0x9c, 0xce, 0x9a, 0xaf, 0xe0, 0x30, 0x6b, 0x72,
+	0xff, 0xa7, 0xf1, 0xee, 0xb4, 0x87, 0xfb, 0xdb, 0xbd, 0xb6, 0xf7, 0x9a, 0xa7, 0x6f, 0x00, 0xf2,
+	0x1e, 0xb4, 0xfb, 0x9e, 0x15, 0x28, 0x5d, 0x8e, 0xc6, 0x43, 0xd7, 0xc2, 0x86, 0x92, 0xbf, 0xa3,
+	0x8a, 0x6a, 0x50, 0xee, 0x0f, 0x1c, 0x77, 0x74, 0x85, 0x7b, 0xb6, 0xa1, 0xa1, 0x87, 0x70, 0x24,
+	0x56, 0x26, 0xb9, 0x58, 0xe0, 0x59, 0x67, 0x6c, 0xdb, 0x3d, 0xfc, 0xd2, 0x28, 0xa2, 0x43, 0x28,
+	0x0c, 0x86, 0xcf, 0x46, 0x86, 0x8e, 0xaa, 0x70, 0xe8, 0xb8, 0x3d, 0xd7, 0x72, 0x2c, 0xd7, 0x28,
+	0x9d, 0x9e, 0x83, 0x2e, 0x5b, 0x2b, 0xd7, 0xed, 0xee, 0x44, 0x3e, 0xe0, 0x00, 0xd5, 0x01, 0xec,
+	0xee, 0x24, 0x7f, 0xb6, 0x5c, 0x75, 0x07, 0xb6, 0x85, 0x0d, 0xf5, 0xf4, 0x33, 0xd0, 0x65, 0x8b,
+	0xe5, 0xbe, 0x6b, 0x3c, 0xb2, 0x2d, 0xb7, 0x6f, 0x8d, 0x1d, 0xe3, 0x80, 0xfb, 0xae, 0x70, 0xef,
+	0xba, 0x3f, 0x70, 0x2d, 0x43, 0x41, 0x06, 0x54, 0x47, 0xd7, 0xd6, 0x70, 0x62, 0x5b, 0x2e, 0x1e,
+	0x5c, 0x3a, 0x86, 0x7a, 0x61, 0xbe, 0xbd, 0x3b, 0x56, 0x7e, 0xbd, 0x3b, 0x56, 0x7e, 0xbf, 0x3b,
+	0x56, 0x7e, 0xfa, 0xe3, 0xf8, 0xe0, 0x7b, 0x5d, 0x7e, 0x7b, 0xa7, 0xba, 0xf8, 0x72, 0x76, 0xff,
+	0x1e, 0x00, 0x30, 0x9a, 0xbd, 0xdd, 0xb9, 0x07, 0x00, 0x00,
 }
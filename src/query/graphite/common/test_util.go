@@ -241,6 +241,15 @@ func (s *MovingFunctionStorage) fetchByIDs(
 	return storage.NewFetchResult(ctx, seriesList, block.NewResultMetadata()), nil
 }
 
+// FetchByTags implements the storage interface.
+func (s *MovingFunctionStorage) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	opts storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 // CompleteTags implements the storage interface.
 func (s *MovingFunctionStorage) CompleteTags(
 	ctx stdcontext.Context,
@@ -249,3 +258,12 @@ func (s *MovingFunctionStorage) CompleteTags(
 ) (*consolidators.CompleteTagsResult, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+
+// SearchSeries implements the storage interface.
+func (s *MovingFunctionStorage) SearchSeries(
+	ctx stdcontext.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	return nil, fmt.Errorf("not implemented")
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// TaggedSeparator separates the path of a tagged series name from its
+	// tags and the tags from each other, i.e. path;tag1=value1;tag2=value2.
+	TaggedSeparator = ';'
+
+	// TaggedValueSeparator separates a tag name from its value in a tagged
+	// series name.
+	TaggedValueSeparator = '='
+
+	// TaggedNameTagName is the name of the tag that holds the path of a
+	// tagged series, tagged series are stored without __gN__ path tags.
+	TaggedNameTagName = "name"
+)
+
+// TaggedNameTag is the tag that holds the path of a tagged series.
+var TaggedNameTag = []byte(TaggedNameTagName)
+
+// Tag is a tag of a tagged series.
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// IsTaggedName returns true if the series name is a tagged series name.
+func IsTaggedName(name string) bool {
+	return strings.IndexByte(name, TaggedSeparator) >= 0
+}
+
+// ParseTaggedName splits a tagged series name into its path and tags, the
+// name tag is not included in the returned tags. Series names without tags
+// are returned as the path with no tags.
+func ParseTaggedName(name string) (string, []Tag, error) {
+	idx := strings.IndexByte(name, TaggedSeparator)
+	if idx < 0 {
+		return name, nil, nil
+	}
+
+	path := name[:idx]
+	if len(path) == 0 {
+		return "", nil, fmt.Errorf("tagged series name has no path: %s", name)
+	}
+
+	parts := strings.Split(name[idx+1:], string(TaggedSeparator))
+	tags := make([]Tag, 0, len(parts))
+	for _, part := range parts {
+		valueIdx := strings.IndexByte(part, TaggedValueSeparator)
+		if valueIdx <= 0 || valueIdx == len(part)-1 {
+			return "", nil, fmt.Errorf("invalid tag %q in tagged series name: %s",
+				part, name)
+		}
+		tags = append(tags, Tag{
+			Name:  part[:valueIdx],
+			Value: part[valueIdx+1:],
+		})
+	}
+	return path, tags, nil
+}
+
+// TaggedName returns the name of a tagged series, the tags are sorted by
+// name so that the same series always has the same name.
+func TaggedName(path string, tags []Tag) string {
+	if len(tags) == 0 {
+		return path
+	}
+
+	sorted := make([]Tag, len(tags))
+	copy(sorted, tags)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	var b strings.Builder
+	b.WriteString(path)
+	for _, tag := range sorted {
+		b.WriteByte(TaggedSeparator)
+		b.WriteString(tag.Name)
+		b.WriteByte(TaggedValueSeparator)
+		b.WriteString(tag.Value)
+	}
+	return b.String()
+}
+
+// TaggedNameTagValue returns the value of a tag of a series name, the name
+// tag returns the path of the series.
+func TaggedNameTagValue(name string, tag string) (string, bool) {
+	path, tags, err := ParseTaggedName(name)
+	if err != nil {
+		return "", false
+	}
+	if tag == TaggedNameTagName {
+		return path, true
+	}
+	for _, t := range tags {
+		if t.Name == tag {
+			return t.Value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaggedName(t *testing.T) {
+	path, tags, err := ParseTaggedName("disk.used;host=a;dc=us-east")
+	require.NoError(t, err)
+	assert.Equal(t, "disk.used", path)
+	assert.Equal(t, []Tag{
+		{Name: "host", Value: "a"},
+		{Name: "dc", Value: "us-east"},
+	}, tags)
+
+	path, tags, err = ParseTaggedName("disk.used")
+	require.NoError(t, err)
+	assert.Equal(t, "disk.used", path)
+	assert.Empty(t, tags)
+
+	for _, name := range []string{
+		";host=a",
+		"disk.used;host",
+		"disk.used;=a",
+		"disk.used;host=",
+	} {
+		_, _, err := ParseTaggedName(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestTaggedName(t *testing.T) {
+	assert.Equal(t, "disk.used", TaggedName("disk.used", nil))
+	assert.Equal(t, "disk.used;dc=us-east;host=a", TaggedName("disk.used", []Tag{
+		{Name: "host", Value: "a"},
+		{Name: "dc", Value: "us-east"},
+	}))
+	assert.True(t, IsTaggedName("disk.used;host=a"))
+	assert.False(t, IsTaggedName("disk.used"))
+}
+
+func TestTaggedNameTagValue(t *testing.T) {
+	name := "disk.used;dc=us-east;host=a"
+	tests := []struct {
+		tag   string
+		value string
+		ok    bool
+	}{
+		{tag: "name", value: "disk.used", ok: true},
+		{tag: "host", value: "a", ok: true},
+		{tag: "dc", value: "us-east", ok: true},
+		{tag: "rack", ok: false},
+	}
+
+	for _, test := range tests {
+		value, ok := TaggedNameTagValue(name, test.tag)
+		assert.Equal(t, test.ok, ok, test.tag)
+		assert.Equal(t, test.value, value, test.tag)
+	}
+}
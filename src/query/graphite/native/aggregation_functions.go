@@ -21,6 +21,7 @@
 package native
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	xerrors "github.com/m3db/m3/src/x/errors"
 )
@@ -702,6 +704,41 @@ func groupByNodes(ctx *common.Context, seriesList singlePathSpec, fname string,
 	return applyFnToMetaSeries(ctx, seriesList, metaSeries, fname)
 }
 
+// groupByTags takes a list of tagged series and groups them by the values of
+// the given tags, each group is aggregated with the given function and named
+// as a tagged series with the group tags. The name of each group is the
+// function name unless the name tag is one of the group tags.
+func groupByTags(ctx *common.Context, seriesList singlePathSpec, fname string, tags ...string) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(
+			errors.New("groupByTags requires at least one tag"))
+	}
+
+	metaSeries := make(map[string][]*ts.Series)
+	for _, s := range seriesList.Values {
+		var (
+			name      = fname
+			groupTags = make([]graphite.Tag, 0, len(tags))
+		)
+		for _, tag := range tags {
+			value, ok := graphite.TaggedNameTagValue(s.Name(), tag)
+			if !ok || value == "" {
+				continue
+			}
+			if tag == graphite.TaggedNameTagName {
+				name = value
+				continue
+			}
+			groupTags = append(groupTags, graphite.Tag{Name: tag, Value: value})
+		}
+
+		key := graphite.TaggedName(name, groupTags)
+		metaSeries[key] = append(metaSeries[key], s)
+	}
+
+	return applyFnToMetaSeries(ctx, seriesList, metaSeries, fname)
+}
+
 func applyFnToMetaSeries(ctx *common.Context, series singlePathSpec, metaSeries map[string][]*ts.Series, fname string) (ts.SeriesList, error) {
 	newSeries := make([]*ts.Series, 0, len(metaSeries))
 	for key, metaSeries := range metaSeries {
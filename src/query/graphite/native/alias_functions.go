@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -69,6 +70,23 @@ func aliasByNode(ctx *common.Context, seriesList singlePathSpec, nodes ...int) (
 	return ts.SeriesList(seriesList), nil
 }
 
+// aliasByTags renames a tagged time series result according to the values
+// of the given tags, the name tag refers to the path of the series.
+func aliasByTags(ctx *common.Context, seriesList singlePathSpec, tags ...string) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, ts.SeriesList(seriesList).Len())
+	for _, series := range seriesList.Values {
+		values := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if value, ok := graphite.TaggedNameTagValue(series.Name(), tag); ok {
+				values = append(values, value)
+			}
+		}
+		renamed = append(renamed, series.RenamedTo(strings.Join(values, ".")))
+	}
+	seriesList.Values = renamed
+	return ts.SeriesList(seriesList), nil
+}
+
 // aliasSub runs series names through a regex search/replace.
 func aliasSub(ctx *common.Context, input singlePathSpec, search, replace string) (ts.SeriesList, error) {
 	return common.AliasSub(ctx, ts.SeriesList(input), search, replace)
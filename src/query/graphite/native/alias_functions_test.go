@@ -193,6 +193,27 @@ func TestAliasByNode(t *testing.T) {
 	assert.Equal(t, "P75", results.Values[2].Name())
 }
 
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "disk.used;dc=dc1;host=a", now, values),
+		ts.NewSeries(ctx, "disk.used;host=b", now, values),
+	}
+
+	results, err := aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "host", "dc", "name")
+	require.NoError(t, err)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "a.dc1.disk.used", results.Values[0].Name())
+	assert.Equal(t, "b.disk.used", results.Values[1].Name())
+}
+
 func TestAliasByNodeWithComposition(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
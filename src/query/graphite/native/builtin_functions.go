@@ -35,6 +35,7 @@ import (
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/graphite/storage"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/util"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -126,11 +127,91 @@ func sortByName(_ *common.Context, series singlePathSpec, natural, reverse bool)
 	return r, nil
 }
 
+// sortByTags sorts tagged timeseries results by the values of the given tags
+// in order, series with equal values are sorted by name.
+func sortByTags(_ *common.Context, series singlePathSpec, tags ...string) (ts.SeriesList, error) {
+	type sortable struct {
+		series *ts.Series
+		values []string
+	}
+
+	sorted := make([]sortable, 0, len(series.Values))
+	for _, s := range series.Values {
+		values := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			value, _ := graphite.TaggedNameTagValue(s.Name(), tag)
+			values = append(values, value)
+		}
+		sorted = append(sorted, sortable{series: s, values: values})
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for k := range tags {
+			if sorted[i].values[k] != sorted[j].values[k] {
+				return sorted[i].values[k] < sorted[j].values[k]
+			}
+		}
+		return sorted[i].series.Name() < sorted[j].series.Name()
+	})
+
+	r := ts.SeriesList(series)
+	r.Values = make([]*ts.Series, 0, len(sorted))
+	for _, s := range sorted {
+		r.Values = append(r.Values, s.series)
+	}
+	r.SortApplied = true
+	return r, nil
+}
+
 // sortByTotal sorts timeseries results by the sum of values.
 func sortByTotal(ctx *common.Context, series singlePathSpec) (ts.SeriesList, error) {
 	return highestSum(ctx, series, len(series.Values))
 }
 
+// seriesByTag returns the tagged timeseries matching all of the given tag
+// expressions, see storage.TranslateTagExpressionsToMatchers for the
+// supported expressions.
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	begin := time.Now()
+
+	opts := storage.FetchOptions{
+		StartTime: ctx.StartTime,
+		EndTime:   ctx.EndTime,
+		DataOptions: storage.DataOptions{
+			Timeout: ctx.Timeout,
+		},
+		QueryFetchOpts: ctx.FetchOpts,
+	}
+
+	result, err := ctx.Engine.Storage().FetchByTags(ctx, tagExpressions, opts)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	quoted := make([]string, 0, len(tagExpressions))
+	for _, expr := range tagExpressions {
+		quoted = append(quoted, "'"+expr+"'")
+	}
+	spec := fmt.Sprintf(wrappingFmt, "seriesByTag", strings.Join(quoted, ","))
+
+	if ctx.TracingEnabled() {
+		ctx.Trace(common.Trace{
+			ActivityName: fmt.Sprintf("fetch %s", spec),
+			Duration:     time.Since(begin),
+			Outputs:      common.TraceStats{NumSeries: len(result.SeriesList)},
+		})
+	}
+
+	for _, r := range result.SeriesList {
+		r.Specification = spec
+	}
+
+	return ts.SeriesList{
+		Values:   result.SeriesList,
+		Metadata: result.Metadata,
+	}, nil
+}
+
 // sortByMaxima sorts timeseries by the maximum value across the time period specified.
 func sortByMaxima(ctx *common.Context, series singlePathSpec) (ts.SeriesList, error) {
 	return highestMax(ctx, series, len(series.Values))
@@ -2847,6 +2928,7 @@ func init() {
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
+	MustRegisterFunction(aliasByTags)
 	MustRegisterFunction(aliasSub)
 	MustRegisterFunction(applyByNode).WithDefaultParams(map[uint8]interface{}{
 		4: "", // newName
@@ -2887,6 +2969,7 @@ func init() {
 		3: "average", // fname
 	})
 	MustRegisterFunction(groupByNodes)
+	MustRegisterFunction(groupByTags)
 	MustRegisterFunction(highest).WithDefaultParams(map[uint8]interface{}{
 		2: 1,         // n,
 		3: "average", // f
@@ -2992,6 +3075,7 @@ func init() {
 	})
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(seriesByTag)
 	MustRegisterFunction(sortBy).WithDefaultParams(map[uint8]interface{}{
 		2: "average", // fn
 		3: false,     // reverse
@@ -3002,6 +3086,7 @@ func init() {
 		2: false, // natural
 		3: false, // reverse
 	})
+	MustRegisterFunction(sortByTags)
 	MustRegisterFunction(sortByTotal)
 	MustRegisterFunction(squareRoot)
 	MustRegisterFunction(stdev).WithDefaultParams(map[uint8]interface{}{
@@ -3047,7 +3132,6 @@ func init() {
 
 	// alias functions - in alpha ordering
 	MustRegisterAliasedFunction("abs", absolute)
-	MustRegisterAliasedFunction("aliasByTags", aliasByNode)
 	MustRegisterAliasedFunction("avg", averageSeries)
 	MustRegisterAliasedFunction("log", logarithm)
 	MustRegisterAliasedFunction("max", maxSeries)
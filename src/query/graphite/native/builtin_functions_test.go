@@ -3457,6 +3457,14 @@ func (*mockStorage) FetchByQuery(
 	return storage.NewFetchResult(ctx, nil, block.NewResultMetadata()), nil
 }
 
+func (*mockStorage) FetchByTags(
+	ctx xctx.Context,
+	tagExpressions []string,
+	opts storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	return storage.NewFetchResult(ctx, nil, block.NewResultMetadata()), nil
+}
+
 func (*mockStorage) CompleteTags(
 	ctx context.Context,
 	query *querystorage.CompleteTagsQuery,
@@ -3465,6 +3473,14 @@ func (*mockStorage) CompleteTags(
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (*mockStorage) SearchSeries(
+	ctx context.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func TestHoltWintersForecast(t *testing.T) {
 	ctx := common.NewTestContext()
 	ctx.Engine = NewEngine(&mockStorage{}, CompileOptions{})
@@ -4693,3 +4709,71 @@ func TestFunctionsRegistered(t *testing.T) {
 		assert.NotNil(t, findFunction(fname), "could not find function: %s", fname)
 	}
 }
+
+func TestSeriesByTag(t *testing.T) {
+	ctrl := xgomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	engine := NewEngine(store, CompileOptions{})
+	ctx := common.NewContext(common.ContextOptions{
+		Start:  time.Now().Add(-1 * time.Hour),
+		End:    time.Now(),
+		Engine: engine,
+	})
+	defer func() { _ = ctx.Close() }()
+
+	stepSize := int((10 * time.Minute) / time.Millisecond)
+	store.EXPECT().
+		FetchByTags(gomock.Any(), []string{"name=disk.used", "dc=~us.*"}, gomock.Any()).
+		DoAndReturn(func(
+			_ xctx.Context,
+			_ []string,
+			opts storage.FetchOptions,
+		) (*storage.FetchResult, error) {
+			return &storage.FetchResult{SeriesList: []*ts.Series{
+				testSeries("disk.used;dc=us-east;host=a", stepSize, 1, opts),
+				testSeries("disk.used;dc=us-west;host=b", stepSize, 2, opts),
+			}}, nil
+		})
+
+	expr, err := engine.Compile("aliasByTags(seriesByTag('name=disk.used', 'dc=~us.*'), 'host', 'dc')")
+	require.NoError(t, err)
+
+	result, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Len())
+	assert.Equal(t, "a.us-east", result.Values[0].Name())
+	assert.Equal(t, "b.us-west", result.Values[1].Name())
+	assert.Equal(t, "seriesByTag('name=disk.used','dc=~us.*')", result.Values[0].Specification)
+}
+
+func TestSortByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "cpu;dc=dc2;host=a", now, values),
+		ts.NewSeries(ctx, "cpu;dc=dc1;host=b", now, values),
+		ts.NewSeries(ctx, "cpu;host=c", now, values),
+		ts.NewSeries(ctx, "cpu;dc=dc1;host=a", now, values),
+	}
+
+	result, err := sortByTags(ctx, singlePathSpec{Values: series}, "dc", "host")
+	require.NoError(t, err)
+	require.Equal(t, len(series), result.Len())
+	assert.True(t, result.SortApplied)
+
+	var names []string
+	for _, s := range result.Values {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{
+		"cpu;host=c",
+		"cpu;dc=dc1;host=a",
+		"cpu;dc=dc1;host=b",
+		"cpu;dc=dc2;host=a",
+	}, names)
+}
@@ -42,11 +42,17 @@ import (
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/instrument"
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
-var errSeriesNoResolution = errors.New("series has no resolution set")
+var (
+	errSeriesNoResolution      = errors.New("series has no resolution set")
+	errNoTagExpressions        = xerrors.NewInvalidParamsError(errors.New("no tag expressions"))
+	errNoPositiveTagExpression = xerrors.NewInvalidParamsError(errors.New(
+		"at least one tag expression must match a non-empty value"))
+)
 
 type m3WrappedStore struct {
 	m3             storage.Storage
@@ -193,6 +199,92 @@ func translateQuery(
 	}, nil
 }
 
+// TranslateTagExpressionsToMatchers converts Graphite tag expressions, as
+// used by seriesByTag, to tag matchers. The supported expressions are
+// tag=value, tag!=value, tag=~regex and tag!=~regex where regexes are
+// anchored at the start only, an empty value matches series without the tag.
+// At least one expression must match a non-empty value.
+func TranslateTagExpressionsToMatchers(exprs []string) (models.Matchers, error) {
+	if len(exprs) == 0 {
+		return nil, errNoTagExpressions
+	}
+
+	// Restrict to tagged Graphite series, which always have the name tag.
+	matchers := make(models.Matchers, 0, len(exprs)+1)
+	matchers = append(matchers, models.Matcher{
+		Type: models.MatchField,
+		Name: graphite.TaggedNameTag,
+	})
+
+	positive := false
+	for _, expr := range exprs {
+		m, err := translateTagExpression(expr)
+		if err != nil {
+			return nil, err
+		}
+		if (m.Type == models.MatchEqual || m.Type == models.MatchRegexp) &&
+			len(m.Value) > 0 {
+			positive = true
+		}
+		matchers = append(matchers, m)
+	}
+
+	if !positive {
+		return nil, errNoPositiveTagExpression
+	}
+	return matchers, nil
+}
+
+func translateTagExpression(expr string) (models.Matcher, error) {
+	idx := strings.IndexAny(expr, "!=")
+	if idx <= 0 {
+		return models.Matcher{}, xerrors.NewInvalidParamsError(
+			fmt.Errorf("invalid tag expression: %s", expr))
+	}
+
+	var (
+		name = expr[:idx]
+		op   = expr[idx:]
+		m    = models.Matcher{Name: []byte(name)}
+	)
+	switch {
+	case strings.HasPrefix(op, "!=~"):
+		m.Type = models.MatchNotRegexp
+		m.Value = []byte(op[3:])
+	case strings.HasPrefix(op, "=~"):
+		m.Type = models.MatchRegexp
+		m.Value = []byte(op[2:])
+	case strings.HasPrefix(op, "!="):
+		m.Type = models.MatchNotEqual
+		m.Value = []byte(op[2:])
+	case strings.HasPrefix(op, "="):
+		m.Type = models.MatchEqual
+		m.Value = []byte(op[1:])
+	default:
+		return models.Matcher{}, xerrors.NewInvalidParamsError(
+			fmt.Errorf("invalid tag expression: %s", expr))
+	}
+
+	switch m.Type {
+	case models.MatchEqual:
+		if len(m.Value) == 0 {
+			// Graphite matches series without the tag for empty values.
+			m.Type = models.MatchNotField
+			m.Value = nil
+		}
+	case models.MatchNotEqual:
+		if len(m.Value) == 0 {
+			m.Type = models.MatchField
+			m.Value = nil
+		}
+	case models.MatchRegexp, models.MatchNotRegexp:
+		// Graphite regexes are only anchored at the start while tag matcher
+		// regexes are anchored at both ends.
+		m.Value = []byte("(?:" + string(m.Value) + ").*")
+	}
+	return m, nil
+}
+
 type truncateBoundsToResolutionOptions struct {
 	shiftStepsStart                            int
 	shiftStepsEnd                              int
@@ -446,6 +538,30 @@ func (s *m3WrappedStore) FetchByQuery(
 		}, nil
 	}
 
+	return s.fetch(ctx, m3query, fetchOpts)
+}
+
+func (s *m3WrappedStore) FetchByTags(
+	ctx xctx.Context, tagExpressions []string, fetchOpts FetchOptions,
+) (*FetchResult, error) {
+	matchers, err := TranslateTagExpressionsToMatchers(tagExpressions)
+	if err != nil {
+		return nil, err
+	}
+
+	m3query := &storage.FetchQuery{
+		Raw:         strings.Join(tagExpressions, ","),
+		TagMatchers: matchers,
+		Start:       fetchOpts.StartTime.Add(s.opts.ShiftTimeStart),
+		End:         fetchOpts.EndTime.Add(s.opts.ShiftTimeEnd),
+		Interval:    time.Duration(0),
+	}
+	return s.fetch(ctx, m3query, fetchOpts)
+}
+
+func (s *m3WrappedStore) fetch(
+	ctx xctx.Context, m3query *storage.FetchQuery, fetchOpts FetchOptions,
+) (*FetchResult, error) {
 	m3ctx := ctx.RequestContext()
 	if _, ok := m3ctx.Deadline(); !ok {
 		var cancel context.CancelFunc
@@ -503,3 +619,13 @@ func (s *m3WrappedStore) CompleteTags(
 	opts.FanoutOptions = s.fanoutOptions()
 	return s.m3.CompleteTags(ctx, query, opts)
 }
+
+func (s *m3WrappedStore) SearchSeries(
+	ctx context.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	opts = opts.Clone() // Clone to avoid mutating input and cause data races.
+	opts.FanoutOptions = s.fanoutOptions()
+	return s.m3.SearchSeries(ctx, query, opts)
+}
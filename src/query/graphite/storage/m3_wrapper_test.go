@@ -462,3 +462,35 @@ func intRefValue(i int) *int {
 	value := i
 	return &value
 }
+
+func TestTranslateTagExpressionsToMatchers(t *testing.T) {
+	matchers, err := TranslateTagExpressionsToMatchers([]string{
+		"name=disk.used",
+		"dc=~us.*",
+		"host!=a",
+		"rack!=~r1",
+		"env=",
+		"role!=",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchField, Name: graphite.TaggedNameTag},
+		{Type: models.MatchEqual, Name: []byte("name"), Value: []byte("disk.used")},
+		{Type: models.MatchRegexp, Name: []byte("dc"), Value: []byte("(?:us.*).*")},
+		{Type: models.MatchNotEqual, Name: []byte("host"), Value: []byte("a")},
+		{Type: models.MatchNotRegexp, Name: []byte("rack"), Value: []byte("(?:r1).*")},
+		{Type: models.MatchNotField, Name: []byte("env")},
+		{Type: models.MatchField, Name: []byte("role")},
+	}, matchers)
+
+	for _, exprs := range [][]string{
+		nil,
+		{"host!=a"},
+		{"env="},
+		{"=a"},
+		{"host"},
+	} {
+		_, err := TranslateTagExpressionsToMatchers(exprs)
+		assert.Error(t, err, fmt.Sprint(exprs))
+	}
+}
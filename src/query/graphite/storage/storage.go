@@ -61,12 +61,27 @@ type Storage interface {
 		opts FetchOptions,
 	) (*FetchResult, error)
 
+	// FetchByTags fetches timeseries data of tagged series matching the
+	// Graphite tag expressions.
+	FetchByTags(
+		ctx context.Context,
+		tagExpressions []string,
+		opts FetchOptions,
+	) (*FetchResult, error)
+
 	// CompleteTags fetches tag data based on a request.
 	CompleteTags(
 		ctx stdcontext.Context,
 		query *querystorage.CompleteTagsQuery,
 		opts *querystorage.FetchOptions,
 	) (*consolidators.CompleteTagsResult, error)
+
+	// SearchSeries returns the series matching a query.
+	SearchSeries(
+		ctx stdcontext.Context,
+		query *querystorage.FetchQuery,
+		opts *querystorage.FetchOptions,
+	) (*querystorage.SearchResults, error)
 }
 
 // FetchResult provides a fetch result and meta information.
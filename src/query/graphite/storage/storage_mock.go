@@ -87,3 +87,33 @@ func (mr *MockStorageMockRecorder) FetchByQuery(arg0, arg1, arg2 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByQuery", reflect.TypeOf((*MockStorage)(nil).FetchByQuery), arg0, arg1, arg2)
 }
+
+// FetchByTags mocks base method.
+func (m *MockStorage) FetchByTags(arg0 context0.Context, arg1 []string, arg2 FetchOptions) (*FetchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*FetchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByTags indicates an expected call of FetchByTags.
+func (mr *MockStorageMockRecorder) FetchByTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByTags", reflect.TypeOf((*MockStorage)(nil).FetchByTags), arg0, arg1, arg2)
+}
+
+// SearchSeries mocks base method.
+func (m *MockStorage) SearchSeries(arg0 context.Context, arg1 *storage0.FetchQuery, arg2 *storage0.FetchOptions) (*storage0.SearchResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*storage0.SearchResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSeries indicates an expected call of SearchSeries.
+func (mr *MockStorageMockRecorder) SearchSeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSeries", reflect.TypeOf((*MockStorage)(nil).SearchSeries), arg0, arg1, arg2)
+}
@@ -24,7 +24,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -44,6 +46,10 @@ func (m MatchType) String() string {
 		return "!-"
 	case MatchAll:
 		return "*"
+	case MatchRange:
+		return "=<>"
+	case MatchNotRange:
+		return "!<>"
 	default:
 		return "unknown match type"
 	}
@@ -70,9 +76,82 @@ func NewMatcher(t MatchType, n, v []byte) (Matcher, error) {
 		m.re = re
 	}
 
+	if t == MatchRange || t == MatchNotRange {
+		if _, err := ParseNumericRange(v); err != nil {
+			return Matcher{}, err
+		}
+	}
+
 	return m, nil
 }
 
+// NumericRange is a range of numeric tag values, either bound is infinite
+// for ranges that are unbounded on that side.
+type NumericRange struct {
+	Min          float64
+	Max          float64
+	MinInclusive bool
+	MaxInclusive bool
+}
+
+// ParseNumericRange parses a numeric range in interval notation, such as
+// [200,300) or (0.5,], an empty bound leaves the range unbounded on that side.
+func ParseNumericRange(v []byte) (NumericRange, error) {
+	str := strings.TrimSpace(string(v))
+	if len(str) < 3 {
+		return NumericRange{}, fmt.Errorf("invalid numeric range: %q", str)
+	}
+
+	var r NumericRange
+	switch str[0] {
+	case '[':
+		r.MinInclusive = true
+	case '(':
+	default:
+		return NumericRange{}, fmt.Errorf("invalid numeric range start: %q", str)
+	}
+	switch str[len(str)-1] {
+	case ']':
+		r.MaxInclusive = true
+	case ')':
+	default:
+		return NumericRange{}, fmt.Errorf("invalid numeric range end: %q", str)
+	}
+
+	bounds := strings.Split(str[1:len(str)-1], ",")
+	if len(bounds) != 2 {
+		return NumericRange{}, fmt.Errorf("invalid numeric range bounds: %q", str)
+	}
+
+	var err error
+	r.Min, err = parseNumericRangeBound(bounds[0], math.Inf(-1))
+	if err != nil {
+		return NumericRange{}, err
+	}
+	r.Max, err = parseNumericRangeBound(bounds[1], math.Inf(1))
+	if err != nil {
+		return NumericRange{}, err
+	}
+	if r.Min > r.Max {
+		return NumericRange{}, fmt.Errorf("numeric range min greater than max: %q", str)
+	}
+
+	return r, nil
+}
+
+func parseNumericRangeBound(bound string, unbounded float64) (float64, error) {
+	bound = strings.TrimSpace(bound)
+	if bound == "" {
+		return unbounded, nil
+	}
+
+	v, err := strconv.ParseFloat(bound, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, fmt.Errorf("invalid numeric range bound: %q", bound)
+	}
+	return v, nil
+}
+
 func (m Matcher) String() string {
 	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
 }
@@ -21,6 +21,7 @@
 package models
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,3 +79,27 @@ func TestValidMatchersFromString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, m)
 }
+
+func TestParseNumericRange(t *testing.T) {
+	r, err := ParseNumericRange([]byte("[200,300)"))
+	require.NoError(t, err)
+	assert.Equal(t, NumericRange{Min: 200, Max: 300, MinInclusive: true}, r)
+
+	r, err = ParseNumericRange([]byte("(, 1.5]"))
+	require.NoError(t, err)
+	assert.Equal(t, NumericRange{Min: math.Inf(-1), Max: 1.5, MaxInclusive: true}, r)
+
+	for _, invalid := range []string{"", "200,300", "[200,300", "[a,300]", "[300,200]", "[1,2,3]"} {
+		_, err := ParseNumericRange([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNewRangeMatcher(t *testing.T) {
+	m, err := NewMatcher(MatchRange, []byte("status"), []byte("[500,600)"))
+	require.NoError(t, err)
+	assert.Equal(t, `status=<>"[500,600)"`, m.String())
+
+	_, err = NewMatcher(MatchNotRange, []byte("status"), []byte("500"))
+	require.Error(t, err)
+}
@@ -21,6 +21,10 @@
 package models
 
 import (
+	"bytes"
+	"sort"
+
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models/strconv"
 	"github.com/m3db/m3/src/query/util/writer"
 )
@@ -218,6 +222,10 @@ func idLenGraphite(t Tags) int {
 }
 
 func graphiteID(t Tags) []byte {
+	if nameIdx := graphiteTaggedNameIndex(t); nameIdx >= 0 {
+		return graphiteTaggedID(t, nameIdx)
+	}
+
 	// TODO: pool these bytes.
 	id := make([]byte, idLenGraphite(t))
 	idx := 0
@@ -231,3 +239,43 @@ func graphiteID(t Tags) []byte {
 	copy(id[idx:], t.Tags[lastIndex].Value)
 	return id
 }
+
+// graphiteTaggedNameIndex returns the index of the name tag if the tags are
+// of a tagged graphite series, otherwise -1.
+func graphiteTaggedNameIndex(t Tags) int {
+	for i, tag := range t.Tags {
+		if bytes.Equal(tag.Name, graphite.TaggedNameTag) {
+			return i
+		}
+	}
+	return -1
+}
+
+// graphiteTaggedID returns the ID of a tagged graphite series which is its
+// path followed by its tags sorted by name, i.e. path;tag1=value1;tag2=value2.
+func graphiteTaggedID(t Tags, nameIdx int) []byte {
+	var (
+		idLen = len(t.Tags[nameIdx].Value)
+		tags  = make([]Tag, 0, len(t.Tags)-1)
+	)
+	for i, tag := range t.Tags {
+		if i == nameIdx {
+			continue
+		}
+		idLen += len(tag.Name) + len(tag.Value) + 2 // account for separators
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return bytes.Compare(tags[i].Name, tags[j].Name) < 0
+	})
+
+	id := make([]byte, 0, idLen)
+	id = append(id, t.Tags[nameIdx].Value...)
+	for _, tag := range tags {
+		id = append(id, graphite.TaggedSeparator)
+		id = append(id, tag.Name...)
+		id = append(id, graphite.TaggedValueSeparator)
+		id = append(id, tag.Value...)
+	}
+	return id
+}
@@ -76,6 +76,19 @@ func TestLongTagNewIDOutOfOrderGraphite(t *testing.T) {
 	assert.Equal(t, []byte("v0.v1.v2.v3.v4.v5.v6.v7.v8.v9.v10.v11.v12"), actual)
 }
 
+func TestTaggedGraphiteID(t *testing.T) {
+	opts := NewTagOptions().SetIDSchemeType(TypeGraphite)
+	tags := NewTags(3, opts).AddTags([]Tag{
+		{Name: []byte("region"), Value: []byte("us-east")},
+		{Name: []byte("name"), Value: []byte("disk.used")},
+		{Name: []byte("dc"), Value: []byte("dc1")},
+		{Name: []byte("host"), Value: []byte("a")},
+	})
+
+	require.NoError(t, tags.Validate())
+	assert.Equal(t, []byte("disk.used;dc=dc1;host=a;region=us-east"), tags.ID())
+}
+
 func TestLongTagNewIDOutOfOrderQuotedWithEscape(t *testing.T) {
 	tags := testLongTagIDOutOfOrder(t, TypeQuoted)
 	tags = tags.AddTag(Tag{Name: []byte(`t5""`), Value: []byte(`v"5`)})
@@ -154,6 +154,12 @@ const (
 	MatchField
 	MatchNotField
 	MatchAll
+	// MatchRange matches tag values which parse as numbers within the range
+	// given in interval notation as the matcher value, e.g. [200,300).
+	MatchRange
+	// MatchNotRange matches tag values which are not numbers within the
+	// range given as the matcher value.
+	MatchNotRange
 )
 
 // Matcher models the matching of a label.
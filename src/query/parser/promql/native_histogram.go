@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	pql "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+)
+
+func init() {
+	// NB: the vendored Prometheus parser predates native histograms, register
+	// the native histogram functions so that queries using them parse.
+	for _, name := range []string{
+		linear.HistogramCountType,
+		linear.HistogramSumType,
+	} {
+		if _, ok := pql.Functions[name]; ok {
+			continue
+		}
+
+		pql.Functions[name] = &pql.Function{
+			Name:       name,
+			ArgTypes:   []pql.ValueType{pql.ValueTypeVector},
+			ReturnType: pql.ValueTypeVector,
+		}
+	}
+}
+
+func nativeHistogramProjection(
+	name string,
+	argValues []interface{},
+) (storage.NativeHistogramProjection, bool) {
+	switch name {
+	case linear.HistogramCountType:
+		return storage.NativeHistogramProjection{
+			Type: storage.NativeHistogramCountProjection,
+		}, true
+	case linear.HistogramSumType:
+		return storage.NativeHistogramProjection{
+			Type: storage.NativeHistogramSumProjection,
+		}, true
+	case linear.HistogramQuantileType:
+		if len(argValues) != 1 {
+			return storage.NativeHistogramProjection{}, false
+		}
+
+		q, ok := argValues[0].(float64)
+		if !ok {
+			return storage.NativeHistogramProjection{}, false
+		}
+
+		return storage.NativeHistogramProjection{
+			Type:     storage.NativeHistogramQuantileProjection,
+			Quantile: q,
+		}, true
+	default:
+		return storage.NativeHistogramProjection{}, false
+	}
+}
+
+// pushDownNativeHistogramProjection projects native histograms to the values
+// required by a native histogram function when fetching the series of the
+// vector selector at the given transform index.
+//
+// NB: native histograms are only projected when the function is applied
+// directly to a vector selector, see rejectNativeHistograms otherwise.
+func (p *parseState) pushDownNativeHistogramProjection(
+	name string,
+	argValues []interface{},
+	fetchIdx int,
+	op parser.Params,
+) parser.Params {
+	projection, ok := nativeHistogramProjection(name, argValues)
+	if !ok {
+		return op
+	}
+
+	fetchOp, ok := p.transforms[fetchIdx].Op.(functions.FetchOp)
+	if !ok {
+		return op
+	}
+
+	fetchOp.NativeHistogramProjection = projection
+	p.transforms[fetchIdx].Op = fetchOp
+	if name == linear.HistogramQuantileType {
+		return linear.WithNativeHistograms(op)
+	}
+
+	return op
+}
+
+// rejectNativeHistograms marks the fetches of the arguments of a native
+// histogram function, starting at the given transform index, to fail if
+// they return native histograms. Native histograms cannot be projected
+// through other expressions, such as range functions, and would otherwise
+// silently evaluate to their count of observations. Classic histograms
+// are not affected.
+func (p *parseState) rejectNativeHistograms(
+	name string,
+	argValues []interface{},
+	argsIdx int,
+) {
+	if _, ok := nativeHistogramProjection(name, argValues); !ok {
+		return
+	}
+
+	for i := argsIdx; i < len(p.transforms); i++ {
+		fetchOp, ok := p.transforms[i].Op.(functions.FetchOp)
+		if !ok || fetchOp.NativeHistogramProjection.Enabled() {
+			continue
+		}
+
+		fetchOp.NativeHistogramProjection = storage.NativeHistogramProjection{
+			Type: storage.NativeHistogramRejectProjection,
+		}
+		p.transforms[i].Op = fetchOp
+	}
+}
@@ -267,6 +267,12 @@ func (p *parseState) walk(node pql.Node) error {
 			numExpectedValues = argCount
 			variadic          = n.Func.Variadic
 			hasValue          = false
+			// vectorSelectorIdx is the transform index of a vector selector
+			// argument, if any.
+			vectorSelectorIdx = -1
+			// argsIdx is the transform index of the first argument that
+			// is an expression, if any.
+			argsIdx = -1
 		)
 
 		if variadic == 0 {
@@ -305,6 +311,14 @@ func (p *parseState) walk(node pql.Node) error {
 					argValues = append(argValues, e.Range)
 				}
 
+				if _, ok := expr.(*pql.VectorSelector); ok {
+					vectorSelectorIdx = p.transformLen()
+				}
+
+				if argsIdx < 0 {
+					argsIdx = p.transformLen()
+				}
+
 				if err := p.walk(expr); err != nil {
 					return err
 				}
@@ -338,6 +352,13 @@ func (p *parseState) walk(node pql.Node) error {
 			return nil
 		}
 
+		if vectorSelectorIdx >= 0 {
+			op = p.pushDownNativeHistogramProjection(n.Func.Name, argValues,
+				vectorSelectorIdx, op)
+		} else if argsIdx >= 0 {
+			p.rejectNativeHistograms(n.Func.Name, argValues, argsIdx)
+		}
+
 		opTransform := parser.NewTransformFromOperation(op, p.transformLen())
 		if op.OpType() != scalar.TimeType {
 			p.edges = append(p.edges, parser.Edge{
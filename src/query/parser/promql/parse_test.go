@@ -39,6 +39,7 @@ import (
 	"github.com/m3db/m3/src/query/functions/temporal"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
 )
 
 func TestDAGWithCountOp(t *testing.T) {
@@ -235,6 +236,8 @@ var linearParseTests = []struct {
 	{"year(up)", linear.YearType},
 
 	{"histogram_quantile(1,up)", linear.HistogramQuantileType},
+	{"histogram_count(up)", linear.HistogramCountType},
+	{"histogram_sum(up)", linear.HistogramSumType},
 }
 
 func TestLinearParses(t *testing.T) {
@@ -257,6 +260,66 @@ func TestLinearParses(t *testing.T) {
 	}
 }
 
+func TestNativeHistogramProjectionParses(t *testing.T) {
+	tests := []struct {
+		q          string
+		projection storage.NativeHistogramProjection
+	}{
+		{
+			q: "histogram_count(up)",
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramCountProjection,
+			},
+		},
+		{
+			q: "histogram_sum(up offset 1m)",
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramSumProjection,
+			},
+		},
+		{
+			q: "histogram_quantile(0.9, up)",
+			projection: storage.NativeHistogramProjection{
+				Type:     storage.NativeHistogramQuantileProjection,
+				Quantile: 0.9,
+			},
+		},
+		{
+			q: "histogram_quantile(0.9, sum(up) by (le))",
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramRejectProjection,
+			},
+		},
+		{
+			q: "histogram_quantile(0.9, rate(up[5m]))",
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramRejectProjection,
+			},
+		},
+		{
+			q: "histogram_count(rate(up[5m]))",
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramRejectProjection,
+			},
+		},
+		{
+			q: "abs(up)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.q, func(t *testing.T) {
+			p, err := Parse(tt.q, time.Second, models.NewTagOptions(), NewParseOptions())
+			require.NoError(t, err)
+			transforms, _, err := p.DAG()
+			require.NoError(t, err)
+			fetchOp, ok := transforms[0].Op.(functions.FetchOp)
+			require.True(t, ok)
+			assert.Equal(t, tt.projection, fetchOp.NativeHistogramProjection)
+		})
+	}
+}
+
 var variadicTests = []struct {
 	q            string
 	expectedType string
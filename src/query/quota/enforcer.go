@@ -0,0 +1,320 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/uber-go/tally"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/headers"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// activeSeriesExpiryFraction is the fraction of the active series window
+// after which expired series are swept from a tenant's active set.
+const activeSeriesExpiryFraction = 10
+
+type enforcer struct {
+	sync.RWMutex
+
+	opts    Options
+	scope   tally.Scope
+	quotas  Quotas
+	tenants map[string]*tenantState
+}
+
+// NewEnforcer returns a new quota enforcer, all tenants are unlimited until
+// quotas are set with Update.
+func NewEnforcer(opts Options) Enforcer {
+	if opts.TenantHeader == "" {
+		opts.TenantHeader = headers.TenantHeader
+	}
+	if opts.DefaultTenant == "" {
+		opts.DefaultTenant = DefaultTenant
+	}
+	if opts.ActiveSeriesWindow <= 0 {
+		opts.ActiveSeriesWindow = DefaultActiveSeriesWindow
+	}
+	if opts.InstrumentOptions == nil {
+		opts.InstrumentOptions = instrument.NewOptions()
+	}
+	if opts.NowFn == nil {
+		opts.NowFn = time.Now
+	}
+	return &enforcer{
+		opts:    opts,
+		scope:   opts.InstrumentOptions.MetricsScope().SubScope("quota"),
+		tenants: make(map[string]*tenantState),
+	}
+}
+
+func (e *enforcer) Tenant(header http.Header) string {
+	return e.resolveTenant(header.Get(e.opts.TenantHeader))
+}
+
+// resolveTenant returns the tenant if it has a configured quota and the
+// default tenant otherwise, so that the state and metrics tracked are bounded
+// by the configured tenants rather than by the tenant headers clients send.
+func (e *enforcer) resolveTenant(tenant string) string {
+	e.RLock()
+	_, ok := e.quotas.Tenants[tenant]
+	e.RUnlock()
+	if ok {
+		return tenant
+	}
+	return e.opts.DefaultTenant
+}
+
+func (e *enforcer) Quotas() Quotas {
+	e.RLock()
+	defer e.RUnlock()
+	return e.quotas
+}
+
+func (e *enforcer) Update(quotas Quotas) {
+	e.Lock()
+	defer e.Unlock()
+	e.quotas = quotas
+	for tenant, state := range e.tenants {
+		if _, ok := quotas.Tenants[tenant]; !ok && tenant != e.opts.DefaultTenant {
+			// NB: the tenant's quota was removed, its requests are now
+			// attributed to the default tenant.
+			delete(e.tenants, tenant)
+			continue
+		}
+		state.setQuota(quotas.QuotaFor(tenant))
+	}
+}
+
+func (e *enforcer) CheckWrite(
+	tenant string,
+	writes []SeriesWrite,
+) ([]int, error) {
+	tenant, state := e.state(tenant)
+	now := e.opts.NowFn()
+	state.Lock()
+	defer state.Unlock()
+
+	if second := now.Truncate(time.Second); !second.Equal(state.writeSecond) {
+		state.writeSecond = second
+		state.writeSamples = 0
+	}
+
+	var (
+		rateLimit       = state.quota.WriteSamplesPerSecond
+		seriesLimit     = state.quota.ActiveSeries
+		admitted        = make([]int, len(writes))
+		rateRejected    int64
+		seriesRejected  int64
+		samplesAdmitted int64
+	)
+	if seriesLimit > 0 &&
+		now.Sub(state.lastExpired) >= e.opts.ActiveSeriesWindow/activeSeriesExpiryFraction {
+		state.expireSeries(now.Add(-e.opts.ActiveSeriesWindow))
+		state.lastExpired = now
+	}
+
+	for i, write := range writes {
+		if write.NumSamples <= 0 {
+			continue
+		}
+
+		if seriesLimit > 0 {
+			_, active := state.series[write.SeriesHash]
+			if !active && int64(len(state.series)) >= seriesLimit {
+				// Only new series are rejected, writes to active series are
+				// still admitted.
+				seriesRejected += int64(write.NumSamples)
+				continue
+			}
+		}
+
+		n := int64(write.NumSamples)
+		if rateLimit > 0 {
+			if remaining := rateLimit - state.writeSamples; n > remaining {
+				if remaining < 0 {
+					remaining = 0
+				}
+				rateRejected += n - remaining
+				n = remaining
+			}
+			if n == 0 {
+				continue
+			}
+		}
+
+		if seriesLimit > 0 {
+			state.series[write.SeriesHash] = now
+		}
+		admitted[i] = int(n)
+		state.writeSamples += n
+		samplesAdmitted += n
+	}
+
+	if seriesLimit > 0 {
+		state.metrics.activeSeries.Update(float64(len(state.series)))
+	}
+	state.metrics.writeSamples.Inc(samplesAdmitted)
+
+	switch {
+	case seriesRejected > 0:
+		state.metrics.activeSeriesRejected.Inc(seriesRejected)
+		if rateRejected > 0 {
+			state.metrics.writeRateRejected.Inc(rateRejected)
+		}
+		return admitted, xerrors.NewResourceExhaustedError(fmt.Errorf(
+			"tenant %s exceeded active series quota of %d, rejected %d samples of new series",
+			tenant, seriesLimit, seriesRejected+rateRejected))
+	case rateRejected > 0:
+		state.metrics.writeRateRejected.Inc(rateRejected)
+		return admitted, xerrors.NewResourceExhaustedError(fmt.Errorf(
+			"tenant %s exceeded write quota of %d samples per second, rejected %d samples",
+			tenant, rateLimit, rateRejected))
+	}
+	return admitted, nil
+}
+
+func (e *enforcer) AcquireQuery(tenant string) (func(), error) {
+	tenant, state := e.state(tenant)
+	state.Lock()
+	defer state.Unlock()
+
+	if limit := state.quota.ConcurrentQueries; limit > 0 && state.queries >= limit {
+		state.metrics.queriesRejected.Inc(1)
+		return nil, xerrors.NewResourceExhaustedError(fmt.Errorf(
+			"tenant %s exceeded concurrent queries quota of %d", tenant, limit))
+	}
+
+	state.queries++
+	state.metrics.queries.Inc(1)
+	state.metrics.concurrentQueries.Update(float64(state.queries))
+
+	var once sync.Once
+	return func() {
+		once.Do(state.releaseQuery)
+	}, nil
+}
+
+func (e *enforcer) QuerySeriesLimit(tenant string) int {
+	e.RLock()
+	defer e.RUnlock()
+	if limit := e.quotas.QuotaFor(tenant).QuerySeriesFetched; limit > 0 {
+		return int(limit)
+	}
+	return 0
+}
+
+// state returns the resolved tenant and its state.
+func (e *enforcer) state(tenant string) (string, *tenantState) {
+	tenant = e.resolveTenant(tenant)
+
+	e.RLock()
+	state, ok := e.tenants[tenant]
+	e.RUnlock()
+	if ok {
+		return tenant, state
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	if state, ok := e.tenants[tenant]; ok {
+		return tenant, state
+	}
+	state = &tenantState{
+		metrics: newTenantMetrics(e.scope.Tagged(map[string]string{
+			"tenant": tenant,
+		})),
+	}
+	state.setQuota(e.quotas.QuotaFor(tenant))
+	e.tenants[tenant] = state
+	return tenant, state
+}
+
+type tenantState struct {
+	sync.Mutex
+
+	quota        Quota
+	writeSecond  time.Time
+	writeSamples int64
+	series       map[uint64]time.Time
+	lastExpired  time.Time
+	queries      int64
+	metrics      tenantMetrics
+}
+
+func (s *tenantState) setQuota(quota Quota) {
+	s.Lock()
+	defer s.Unlock()
+
+	if quota.ActiveSeries <= 0 {
+		s.series = nil
+		s.metrics.activeSeries.Update(0)
+	} else if s.series == nil {
+		s.series = make(map[uint64]time.Time)
+	}
+	s.quota = quota
+}
+
+func (s *tenantState) expireSeries(cutoff time.Time) {
+	for hash, lastWrite := range s.series {
+		if lastWrite.Before(cutoff) {
+			delete(s.series, hash)
+		}
+	}
+}
+
+func (s *tenantState) releaseQuery() {
+	s.Lock()
+	defer s.Unlock()
+	s.queries--
+	s.metrics.concurrentQueries.Update(float64(s.queries))
+}
+
+type tenantMetrics struct {
+	writeSamples         tally.Counter
+	writeRateRejected    tally.Counter
+	activeSeriesRejected tally.Counter
+	activeSeries         tally.Gauge
+	queries              tally.Counter
+	queriesRejected      tally.Counter
+	concurrentQueries    tally.Gauge
+}
+
+func newTenantMetrics(scope tally.Scope) tenantMetrics {
+	return tenantMetrics{
+		writeSamples: scope.Counter("write-samples"),
+		writeRateRejected: scope.Tagged(map[string]string{
+			"reason": "write-rate",
+		}).Counter("writes-rejected"),
+		activeSeriesRejected: scope.Tagged(map[string]string{
+			"reason": "active-series",
+		}).Counter("writes-rejected"),
+		activeSeries:      scope.Gauge("active-series"),
+		queries:           scope.Counter("queries"),
+		queriesRejected:   scope.Counter("queries-rejected"),
+		concurrentQueries: scope.Gauge("concurrent-queries"),
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/headers"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func newTestEnforcer(now *time.Time) (Enforcer, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	return NewEnforcer(Options{
+		ActiveSeriesWindow: time.Minute,
+		InstrumentOptions:  instrument.NewOptions().SetMetricsScope(scope),
+		NowFn: func() time.Time {
+			return *now
+		},
+	}), scope
+}
+
+// seriesWrites returns writes of the given number of samples to each series.
+func seriesWrites(numSamples int, hashes ...uint64) []SeriesWrite {
+	writes := make([]SeriesWrite, 0, len(hashes))
+	for _, hash := range hashes {
+		writes = append(writes, SeriesWrite{SeriesHash: hash, NumSamples: numSamples})
+	}
+	return writes
+}
+
+func checkWrite(e Enforcer, tenant string, writes []SeriesWrite) error {
+	_, err := e.CheckWrite(tenant, writes)
+	return err
+}
+
+func TestEnforcerTenant(t *testing.T) {
+	e := NewEnforcer(Options{})
+
+	header := make(http.Header)
+	assert.Equal(t, DefaultTenant, e.Tenant(header))
+
+	// Tenants without a configured quota belong to the default tenant.
+	header.Set(headers.TenantHeader, "foo")
+	assert.Equal(t, DefaultTenant, e.Tenant(header))
+	e.Update(Quotas{Tenants: map[string]Quota{"foo": {}}})
+	assert.Equal(t, "foo", e.Tenant(header))
+
+	e = NewEnforcer(Options{TenantHeader: "X-Org", DefaultTenant: "anonymous"})
+	e.Update(Quotas{Tenants: map[string]Quota{"bar": {}}})
+	assert.Equal(t, "anonymous", e.Tenant(header))
+	header.Set("X-Org", "bar")
+	assert.Equal(t, "bar", e.Tenant(header))
+}
+
+func TestEnforcerUnknownTenantsShareDefault(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, scope := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Default: Quota{WriteSamplesPerSecond: 10},
+	})
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(6, 1)))
+	err := checkWrite(e, "bar", seriesWrites(6, 1))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+
+	// Only the default tenant is tracked.
+	for name := range scope.Snapshot().Counters() {
+		assert.NotContains(t, name, "tenant=foo")
+		assert.NotContains(t, name, "tenant=bar")
+	}
+	_, ok := scope.Snapshot().Counters()["quota.write-samples+tenant=default"]
+	assert.True(t, ok)
+}
+
+func TestEnforcerUnlimited(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, _ := newTestEnforcer(&now)
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1000000, 1, 2, 3)))
+	release, err := e.AcquireQuery("foo")
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, 0, e.QuerySeriesLimit("foo"))
+}
+
+func TestEnforcerWriteSamplesPerSecond(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, scope := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"foo": {WriteSamplesPerSecond: 10},
+		},
+	})
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(6, 1)))
+
+	// Samples over the quota are rejected while the rest are admitted.
+	admitted, err := e.CheckWrite("foo", seriesWrites(3, 1, 2))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Equal(t, []int{3, 1}, admitted)
+	admitted, err = e.CheckWrite("foo", seriesWrites(1, 1))
+	require.Error(t, err)
+	assert.Equal(t, []int{0}, admitted)
+
+	// Other tenants share the unlimited default quota.
+	require.NoError(t, checkWrite(e, "bar", seriesWrites(100, 1)))
+
+	// Allowed again once the second rolls over.
+	now = now.Add(time.Second)
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(6, 1)))
+
+	counters := scope.Snapshot().Counters()
+	rejected, ok := counters["quota.writes-rejected+reason=write-rate,tenant=foo"]
+	require.True(t, ok)
+	assert.Equal(t, int64(3), rejected.Value())
+	samples, ok := counters["quota.write-samples+tenant=foo"]
+	require.True(t, ok)
+	assert.Equal(t, int64(16), samples.Value())
+}
+
+func TestEnforcerWriteSamplesPerSecondCapsLargeWrites(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, _ := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"foo": {WriteSamplesPerSecond: 10},
+		},
+	})
+
+	// A write larger than the quota is capped to the quota rather than
+	// being rejected every second.
+	for i := 0; i < 3; i++ {
+		admitted, err := e.CheckWrite("foo", []SeriesWrite{
+			{SeriesHash: 1, NumSamples: 8},
+			{SeriesHash: 2, NumSamples: 8},
+			{SeriesHash: 3, NumSamples: 8},
+		})
+		require.Error(t, err)
+		assert.True(t, xerrors.IsResourceExhausted(err))
+		assert.Equal(t, []int{8, 2, 0}, admitted)
+		now = now.Add(time.Second)
+	}
+
+	admitted, err := e.CheckWrite("foo", seriesWrites(25, 1))
+	require.Error(t, err)
+	assert.Equal(t, []int{10}, admitted)
+}
+
+func TestEnforcerActiveSeries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, scope := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Default: Quota{ActiveSeries: 3},
+	})
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 1, 2)))
+	// Writing to existing series does not count towards the quota.
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 1, 2)))
+
+	// Only the new series over the quota are rejected.
+	admitted, err := e.CheckWrite("foo", seriesWrites(1, 3, 4))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Equal(t, []int{1, 0}, admitted)
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 3)))
+
+	// Series expire after the active series window.
+	now = now.Add(30 * time.Second)
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 1)))
+	now = now.Add(45 * time.Second)
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 4, 5)))
+	require.Error(t, checkWrite(e, "foo", seriesWrites(1, 6)))
+
+	gauge, ok := scope.Snapshot().Gauges()["quota.active-series+tenant=default"]
+	require.True(t, ok)
+	assert.Equal(t, float64(3), gauge.Value())
+}
+
+func TestEnforcerActiveSeriesAdmitsExistingSeries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, scope := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"foo": {ActiveSeries: 2},
+		},
+	})
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 1, 2)))
+
+	// A batch with a new series over the quota still writes the samples of
+	// the series the tenant already has.
+	admitted, err := e.CheckWrite("foo", []SeriesWrite{
+		{SeriesHash: 1, NumSamples: 2},
+		{SeriesHash: 3, NumSamples: 5},
+		{SeriesHash: 2, NumSamples: 3},
+	})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Equal(t, []int{2, 0, 3}, admitted)
+
+	counters := scope.Snapshot().Counters()
+	rejected, ok := counters["quota.writes-rejected+reason=active-series,tenant=foo"]
+	require.True(t, ok)
+	assert.Equal(t, int64(5), rejected.Value())
+	samples, ok := counters["quota.write-samples+tenant=foo"]
+	require.True(t, ok)
+	assert.Equal(t, int64(7), samples.Value())
+}
+
+func TestEnforcerConcurrentQueries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, scope := newTestEnforcer(&now)
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"foo": {ConcurrentQueries: 2, QuerySeriesFetched: 100},
+		},
+	})
+
+	release1, err := e.AcquireQuery("foo")
+	require.NoError(t, err)
+	release2, err := e.AcquireQuery("foo")
+	require.NoError(t, err)
+
+	_, err = e.AcquireQuery("foo")
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+
+	// Releasing more than once only frees a single slot.
+	release1()
+	release1()
+	release3, err := e.AcquireQuery("foo")
+	require.NoError(t, err)
+	_, err = e.AcquireQuery("foo")
+	require.Error(t, err)
+
+	release2()
+	release3()
+
+	assert.Equal(t, 100, e.QuerySeriesLimit("foo"))
+	assert.Equal(t, 0, e.QuerySeriesLimit("bar"))
+
+	rejected, ok := scope.Snapshot().Counters()["quota.queries-rejected+tenant=foo"]
+	require.True(t, ok)
+	assert.Equal(t, int64(2), rejected.Value())
+}
+
+func TestEnforcerUpdateExistingTenant(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e, _ := newTestEnforcer(&now)
+
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(100, 1, 2, 3)))
+
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"foo": {WriteSamplesPerSecond: 10, ActiveSeries: 1},
+		},
+	})
+	now = now.Add(time.Second)
+	require.Error(t, checkWrite(e, "foo", seriesWrites(20, 1)))
+	now = now.Add(time.Second)
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(1, 1)))
+	require.Error(t, checkWrite(e, "foo", seriesWrites(1, 2)))
+
+	e.Update(Quotas{})
+	require.NoError(t, checkWrite(e, "foo", seriesWrites(100, 2, 3)))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"net/http"
+
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+type queryHandler struct {
+	enforcer Enforcer
+	next     http.Handler
+}
+
+// NewQueryHandler returns a handler that enforces the concurrent queries
+// quota of the request's tenant before serving the request with next.
+func NewQueryHandler(enforcer Enforcer, next http.Handler) http.Handler {
+	return &queryHandler{
+		enforcer: enforcer,
+		next:     next,
+	}
+}
+
+func (h *queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	release, err := h.enforcer.AcquireQuery(h.enforcer.Tenant(r.Header))
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+	defer release()
+
+	h.next.ServeHTTP(w, r)
+}
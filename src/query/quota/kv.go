@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/kvpb"
+	"github.com/m3db/m3/src/cluster/kv"
+)
+
+// NewQuotasFromProto returns the quotas stored in KV.
+func NewQuotasFromProto(pb *kvpb.TenantQuotas) Quotas {
+	quotas := Quotas{
+		Tenants: make(map[string]Quota, len(pb.GetTenants())),
+		Default: newQuotaFromProto(pb.GetDefaultQuota()),
+	}
+	for _, tenant := range pb.GetTenants() {
+		quotas.Tenants[tenant.GetTenant()] = newQuotaFromProto(tenant)
+	}
+	return quotas
+}
+
+func newQuotaFromProto(pb *kvpb.TenantQuota) Quota {
+	return Quota{
+		WriteSamplesPerSecond: pb.GetWriteSamplesPerSecond(),
+		ActiveSeries:          pb.GetActiveSeries(),
+		QuerySeriesFetched:    pb.GetQuerySeriesFetched(),
+		ConcurrentQueries:     pb.GetConcurrentQueries(),
+	}
+}
+
+// WatchKV sets the enforcer quotas from the value stored at key and keeps
+// them updated as the value changes.
+func WatchKV(
+	store kv.Store,
+	key string,
+	enforcer Enforcer,
+	logger *zap.Logger,
+) error {
+	value, err := store.Get(key)
+	if err == nil {
+		if err := updateFromKV(value, enforcer); err != nil {
+			logger.Warn("unable to parse tenant quotas", zap.Error(err))
+		}
+	} else if !errors.Is(err, kv.ErrNotFound) {
+		logger.Warn("error resolving tenant quotas", zap.Error(err))
+	}
+
+	watch, err := store.Watch(key)
+	if err != nil {
+		return fmt.Errorf("could not watch tenant quotas: %w", err)
+	}
+
+	go func() {
+		for range watch.C() {
+			value := watch.Get()
+			if value == nil {
+				continue
+			}
+			if err := updateFromKV(value, enforcer); err != nil {
+				logger.Warn("unable to parse new tenant quotas", zap.Error(err))
+				continue
+			}
+			logger.Info("updated tenant quotas", zap.Int("version", value.Version()))
+		}
+	}()
+
+	return nil
+}
+
+func updateFromKV(value kv.Value, enforcer Enforcer) error {
+	var pb kvpb.TenantQuotas
+	if err := value.Unmarshal(&pb); err != nil {
+		return err
+	}
+	enforcer.Update(NewQuotasFromProto(&pb))
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/kvpb"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
+	xclock "github.com/m3db/m3/src/x/clock"
+)
+
+func TestWatchKV(t *testing.T) {
+	store := mem.NewStore()
+	_, err := store.Set(kvconfig.TenantQuotas, &kvpb.TenantQuotas{
+		Tenants: []*kvpb.TenantQuota{
+			{Tenant: "foo", WriteSamplesPerSecond: 10, ConcurrentQueries: 2},
+		},
+		DefaultQuota: &kvpb.TenantQuota{QuerySeriesFetched: 1000},
+	})
+	require.NoError(t, err)
+
+	e := NewEnforcer(Options{})
+	require.NoError(t, WatchKV(store, kvconfig.TenantQuotas, e, zap.NewNop()))
+	assert.Equal(t, Quotas{
+		Tenants: map[string]Quota{
+			"foo": {WriteSamplesPerSecond: 10, ConcurrentQueries: 2},
+		},
+		Default: Quota{QuerySeriesFetched: 1000},
+	}, e.Quotas())
+
+	_, err = store.Set(kvconfig.TenantQuotas, &kvpb.TenantQuotas{
+		Tenants: []*kvpb.TenantQuota{
+			{Tenant: "bar", ActiveSeries: 5},
+		},
+	})
+	require.NoError(t, err)
+
+	updated := xclock.WaitUntil(func() bool {
+		_, ok := e.Quotas().Tenants["bar"]
+		return ok
+	}, 5*time.Second)
+	require.True(t, updated)
+	assert.Equal(t, Quota{ActiveSeries: 5}, e.Quotas().QuotaFor("bar"))
+	assert.Equal(t, Quota{}, e.Quotas().QuotaFor("foo"))
+}
+
+func TestWatchKVNotFound(t *testing.T) {
+	e := NewEnforcer(Options{})
+	require.NoError(t, WatchKV(mem.NewStore(), kvconfig.TenantQuotas, e, zap.NewNop()))
+	assert.Equal(t, Quotas{}, e.Quotas())
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package quota enforces per-tenant write and query quotas in the coordinator.
+package quota
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// DefaultTenant is the tenant requests are attributed to when they do
+	// not carry a tenant header.
+	DefaultTenant = "default"
+
+	// DefaultActiveSeriesWindow is the default duration a series is considered
+	// active for after its last write.
+	DefaultActiveSeriesWindow = 10 * time.Minute
+)
+
+// Quota is the set of limits applied to a single tenant, a zero or negative
+// limit means the limit is not enforced.
+type Quota struct {
+	// WriteSamplesPerSecond is the maximum samples written per second.
+	WriteSamplesPerSecond int64
+	// ActiveSeries is the maximum number of series written to within the
+	// active series window.
+	ActiveSeries int64
+	// QuerySeriesFetched is the maximum number of series a single query may fetch.
+	QuerySeriesFetched int64
+	// ConcurrentQueries is the maximum number of queries executing at once.
+	ConcurrentQueries int64
+}
+
+// Quotas is the set of quotas for all tenants.
+type Quotas struct {
+	// Tenants are the quotas for specific tenants.
+	Tenants map[string]Quota
+	// Default is the quota applied to tenants without a specific quota.
+	Default Quota
+}
+
+// QuotaFor returns the quota for a tenant.
+func (q Quotas) QuotaFor(tenant string) Quota {
+	if quota, ok := q.Tenants[tenant]; ok {
+		return quota
+	}
+	return q.Default
+}
+
+// SeriesWrite is a write of samples to a single series.
+type SeriesWrite struct {
+	// SeriesHash identifies the series written to.
+	SeriesHash uint64
+	// NumSamples is the number of samples written.
+	NumSamples int
+}
+
+// Enforcer enforces per-tenant write and query quotas.
+type Enforcer interface {
+	// Tenant returns the tenant a request belongs to, requests of tenants
+	// without a configured quota belong to the default tenant.
+	Tenant(header http.Header) string
+
+	// Quotas returns the quotas currently enforced.
+	Quotas() Quotas
+
+	// Update replaces the quotas enforced for all tenants.
+	Update(quotas Quotas)
+
+	// CheckWrite admits the writes within the tenant's quota and returns the
+	// number of samples admitted for each write. New series over the active
+	// series quota are rejected while writes to active series are admitted,
+	// and the samples of a write are capped to the samples left within the
+	// write rate quota. A resource exhausted error is returned if any sample
+	// is rejected. Tenants without a configured quota are checked against the
+	// default tenant's state.
+	CheckWrite(tenant string, writes []SeriesWrite) ([]int, error)
+
+	// AcquireQuery reserves a concurrent query slot for the tenant, returning
+	// a resource exhausted error if none is available. The returned function
+	// must be called to release the slot once the query is complete.
+	AcquireQuery(tenant string) (func(), error)
+
+	// QuerySeriesLimit returns the maximum number of series a single query
+	// for the tenant may fetch, zero if unlimited.
+	QuerySeriesLimit(tenant string) int
+}
+
+// Options are the options for an enforcer.
+type Options struct {
+	// TenantHeader is the header used to identify the tenant of a request.
+	TenantHeader string
+	// DefaultTenant is the tenant requests without a tenant header belong to.
+	DefaultTenant string
+	// ActiveSeriesWindow is the duration a series is considered active for
+	// after its last write.
+	ActiveSeriesWindow time.Duration
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+	// NowFn is the function used to get the current time.
+	NowFn clock.NowFn
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type tenantContextKey struct{}
+
+// NewContextWithTenant returns a context carrying the tenant of a request.
+func NewContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// tenantFromContext returns the tenant carried by the context, falling back
+// to the tenant header of the incoming gRPC metadata, and to the default
+// tenant otherwise.
+func tenantFromContext(ctx context.Context, enforcer Enforcer) string {
+	if tenant, ok := ctx.Value(tenantContextKey{}).(string); ok {
+		return tenant
+	}
+
+	header := make(http.Header)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range md {
+			header[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+	return enforcer.Tenant(header)
+}
+
+type writeHandler struct {
+	enforcer Enforcer
+	next     http.Handler
+}
+
+// NewWriteHandler returns a handler that attributes the writes of a request
+// to the request's tenant before serving it with next, the write quotas are
+// enforced by the downsampler and writer returned by NewDownsamplerAndWriter.
+func NewWriteHandler(enforcer Enforcer, next http.Handler) http.Handler {
+	return &writeHandler{
+		enforcer: enforcer,
+		next:     next,
+	}
+}
+
+func (h *writeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContextWithTenant(r.Context(), h.enforcer.Tenant(r.Header))
+	h.next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type downsamplerAndWriter struct {
+	ingest.DownsamplerAndWriter
+
+	enforcer Enforcer
+}
+
+// NewDownsamplerAndWriter returns a downsampler and writer that enforces the
+// write quotas of the tenant of each write before writing it with next, so
+// that the quotas apply to every ingestion protocol.
+func NewDownsamplerAndWriter(
+	enforcer Enforcer,
+	next ingest.DownsamplerAndWriter,
+) ingest.DownsamplerAndWriter {
+	return &downsamplerAndWriter{
+		DownsamplerAndWriter: next,
+		enforcer:             enforcer,
+	}
+}
+
+func (d *downsamplerAndWriter) Write(
+	ctx context.Context,
+	tags models.Tags,
+	datapoints ts.Datapoints,
+	unit xtime.Unit,
+	annotation []byte,
+	overrides ingest.WriteOptions,
+	source ts.SourceType,
+) error {
+	tenant := tenantFromContext(ctx, d.enforcer)
+	admitted, quotaErr := d.enforcer.CheckWrite(tenant, []SeriesWrite{{
+		SeriesHash: tags.HashedID(),
+		NumSamples: len(datapoints),
+	}})
+	if admitted[0] == 0 {
+		return quotaErr
+	}
+	err := d.DownsamplerAndWriter.Write(ctx, tags, datapoints[:admitted[0]],
+		unit, annotation, overrides, source)
+	if err != nil {
+		return err
+	}
+	return quotaErr
+}
+
+func (d *downsamplerAndWriter) WriteBatch(
+	ctx context.Context,
+	iter ingest.DownsampleAndWriteIter,
+	overrides ingest.WriteOptions,
+) ingest.BatchError {
+	var (
+		multiErr xerrors.MultiError
+		writes   []SeriesWrite
+	)
+	for iter.Next() {
+		value := iter.Current()
+		writes = append(writes, SeriesWrite{
+			SeriesHash: value.Tags.HashedID(),
+			NumSamples: len(value.Datapoints),
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return multiErr.Add(err)
+	}
+	if err := iter.Reset(); err != nil {
+		return multiErr.Add(err)
+	}
+
+	tenant := tenantFromContext(ctx, d.enforcer)
+	admitted, quotaErr := d.enforcer.CheckWrite(tenant, writes)
+	if quotaErr == nil {
+		return d.DownsamplerAndWriter.WriteBatch(ctx, iter, overrides)
+	}
+
+	multiErr = multiErr.Add(quotaErr)
+	batchErr := d.DownsamplerAndWriter.WriteBatch(ctx, &admittedIter{
+		DownsampleAndWriteIter: iter,
+		admitted:               admitted,
+		idx:                    -1,
+	}, overrides)
+	if batchErr != nil {
+		for _, err := range batchErr.Errors() {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr
+}
+
+// admittedIter iterates over the samples of a batch admitted by the quota
+// enforcer, skipping the rejected series and the rejected samples of the
+// admitted series.
+type admittedIter struct {
+	ingest.DownsampleAndWriteIter
+
+	admitted []int
+	idx      int
+}
+
+func (i *admittedIter) Next() bool {
+	for i.DownsampleAndWriteIter.Next() {
+		i.idx++
+		if i.idx < len(i.admitted) && i.admitted[i.idx] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *admittedIter) Current() ingest.IterValue {
+	value := i.DownsampleAndWriteIter.Current()
+	if n := i.admitted[i.idx]; n < len(value.Datapoints) {
+		value.Datapoints = value.Datapoints[:n]
+	}
+	return value
+}
+
+func (i *admittedIter) Reset() error {
+	i.idx = -1
+	return i.DownsampleAndWriteIter.Reset()
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/headers"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestDownsamplerAndWriterEnforcesQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(1000, 0)
+	e := NewEnforcer(Options{NowFn: func() time.Time { return now }})
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"limited": {WriteSamplesPerSecond: 1},
+		},
+	})
+
+	var written []int
+	next := ingest.NewMockDownsamplerAndWriter(ctrl)
+	next.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ models.Tags, datapoints ts.Datapoints,
+			_ xtime.Unit, _ []byte, _ ingest.WriteOptions, _ ts.SourceType) error {
+			written = append(written, len(datapoints))
+			return nil
+		}).
+		AnyTimes()
+	writer := NewDownsamplerAndWriter(e, next)
+
+	var (
+		tags = models.NewTags(1, nil).AddTag(models.Tag{
+			Name:  []byte("foo"),
+			Value: []byte("bar"),
+		})
+		datapoints = ts.Datapoints{
+			{Timestamp: xtime.Now(), Value: 1},
+			{Timestamp: xtime.Now(), Value: 2},
+		}
+		write = func(ctx context.Context) error {
+			return writer.Write(ctx, tags, datapoints, xtime.Second, nil,
+				ingest.WriteOptions{}, ts.SourceTypePrometheus)
+		}
+	)
+
+	// Tenants are read from the context set by the write handler, the
+	// samples within the quota are written.
+	err := write(NewContextWithTenant(context.Background(), "limited"))
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Equal(t, []int{1}, written)
+
+	// And from the tenant header of gRPC requests.
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(headers.TenantHeader, "limited"))
+	err = write(ctx)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Equal(t, []int{1}, written)
+
+	// Other tenants use the unlimited default quota.
+	require.NoError(t, write(context.Background()))
+	require.NoError(t, write(NewContextWithTenant(context.Background(), "other")))
+	assert.Equal(t, []int{1, 2, 2}, written)
+}
+
+func TestDownsamplerAndWriterWriteBatchWritesAdmittedSamples(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(1000, 0)
+	e := NewEnforcer(Options{NowFn: func() time.Time { return now }})
+	e.Update(Quotas{
+		Tenants: map[string]Quota{
+			"limited": {ActiveSeries: 2, WriteSamplesPerSecond: 4},
+		},
+	})
+	ctx := NewContextWithTenant(context.Background(), "limited")
+
+	var (
+		newTags = func(value string) models.Tags {
+			return models.NewTags(1, nil).AddTag(models.Tag{
+				Name:  []byte("foo"),
+				Value: []byte(value),
+			})
+		}
+		newDatapoints = func(n int) ts.Datapoints {
+			datapoints := make(ts.Datapoints, 0, n)
+			for i := 0; i < n; i++ {
+				datapoints = append(datapoints, ts.Datapoint{
+					Timestamp: xtime.ToUnixNano(now).Add(time.Duration(i) * time.Second),
+					Value:     float64(i),
+				})
+			}
+			return datapoints
+		}
+		written map[string]int
+	)
+	next := ingest.NewMockDownsamplerAndWriter(ctrl)
+	next.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions) ingest.BatchError {
+			written = make(map[string]int)
+			for iter.Next() {
+				value := iter.Current()
+				tag, _ := value.Tags.Get([]byte("foo"))
+				written[string(tag)] = len(value.Datapoints)
+			}
+			return nil
+		}).
+		Times(2)
+	writer := NewDownsamplerAndWriter(e, next)
+
+	require.Nil(t, writer.WriteBatch(ctx, &testIter{
+		idx: -1,
+		values: []ingest.IterValue{
+			{Tags: newTags("a"), Datapoints: newDatapoints(1)},
+			{Tags: newTags("b"), Datapoints: newDatapoints(1)},
+		},
+	}, ingest.WriteOptions{}))
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, written)
+
+	// The new series over the quota is dropped and the samples over the
+	// write rate are trimmed, the rest of the batch is written.
+	err := writer.WriteBatch(ctx, &testIter{
+		idx: -1,
+		values: []ingest.IterValue{
+			{Tags: newTags("c"), Datapoints: newDatapoints(1)},
+			{Tags: newTags("a"), Datapoints: newDatapoints(1)},
+			{Tags: newTags("b"), Datapoints: newDatapoints(3)},
+		},
+	}, ingest.WriteOptions{})
+	require.NotNil(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err.LastError()))
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, written)
+}
+
+type testIter struct {
+	idx    int
+	values []ingest.IterValue
+}
+
+func (i *testIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.values)
+}
+
+func (i *testIter) Current() ingest.IterValue {
+	return i.values[i.idx]
+}
+
+func (i *testIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *testIter) Error() error {
+	return nil
+}
+
+func (i *testIter) SetCurrentMetadata(metadata ts.Metadata) {
+	i.values[i.idx].Metadata = metadata
+}
+
+func TestWriteHandlerSetsTenant(t *testing.T) {
+	e := NewEnforcer(Options{})
+	e.Update(Quotas{Tenants: map[string]Quota{"foo": {}}})
+
+	var tenant string
+	handler := NewWriteHandler(e, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		tenant = tenantFromContext(r.Context(), e)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/write", nil)
+	req.Header.Set(headers.TenantHeader, "foo")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "foo", tenant)
+
+	req.Header.Set(headers.TenantHeader, "bar")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, DefaultTenant, tenant)
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+const (
+	// resolvedRetention is how long resolved alerts are kept around so that
+	// the resolution is sent to the notifier.
+	resolvedRetention = 15 * time.Minute
+
+	// templateDefs makes the alert labels and value available as variables
+	// in the same way as the Prometheus rule templates.
+	templateDefs = "{{$labels := .Labels}}{{$value := .Value}}"
+)
+
+// alertingRule evaluates an expression and keeps track of an alert for each
+// of the resulting series.
+type alertingRule struct {
+	name         string
+	expr         string
+	holdDuration time.Duration
+	labels       labels.Labels
+	annotations  labels.Labels
+	query        QueryFunc
+
+	sync.RWMutex
+	active         map[uint64]*Alert
+	health         RuleHealth
+	lastError      error
+	lastEvaluation time.Time
+	evaluationTime time.Duration
+}
+
+func newAlertingRule(r Rule, opts Options) *alertingRule {
+	return &alertingRule{
+		name:         r.Alert,
+		expr:         r.Expr,
+		holdDuration: time.Duration(r.For),
+		labels:       labels.FromMap(r.Labels),
+		annotations:  labels.FromMap(r.Annotations),
+		query:        opts.QueryFunc,
+		active:       make(map[uint64]*Alert),
+		health:       HealthUnknown,
+	}
+}
+
+func (r *alertingRule) Name() string {
+	return r.name
+}
+
+func (r *alertingRule) Eval(ctx context.Context, t time.Time) error {
+	start := time.Now()
+	err := r.eval(ctx, t)
+
+	r.Lock()
+	r.lastError = err
+	r.health = HealthGood
+	if err != nil {
+		r.health = HealthBad
+	}
+	r.lastEvaluation = t
+	r.evaluationTime = time.Since(start)
+	r.Unlock()
+
+	return err
+}
+
+func (r *alertingRule) eval(ctx context.Context, t time.Time) error {
+	vector, err := r.query(ctx, r.expr, t)
+	if err != nil {
+		return err
+	}
+
+	alerts := make(map[uint64]*Alert, len(vector))
+	for _, sample := range vector {
+		data := templateData{
+			Labels: sample.Labels.Map(),
+			Value:  sample.Value,
+		}
+
+		lb := labels.NewBuilder(sample.Labels).Del(labels.MetricName)
+		for _, l := range r.labels {
+			value, err := expandTemplate(r.name, l.Value, data)
+			if err != nil {
+				return err
+			}
+			lb.Set(l.Name, value)
+		}
+		lb.Set(labels.AlertName, r.name)
+
+		annotations := make(labels.Labels, 0, len(r.annotations))
+		for _, a := range r.annotations {
+			value, err := expandTemplate(r.name, a.Value, data)
+			if err != nil {
+				return err
+			}
+			annotations = append(annotations, labels.Label{Name: a.Name, Value: value})
+		}
+
+		lbls := lb.Labels()
+		hash := lbls.Hash()
+		if _, ok := alerts[hash]; ok {
+			return fmt.Errorf(
+				"vector contains metrics with the same labelset after applying alert labels: %s",
+				lbls.String())
+		}
+
+		alerts[hash] = &Alert{
+			State:       StatePending,
+			Labels:      lbls,
+			Annotations: annotations,
+			Value:       sample.Value,
+			ActiveAt:    t,
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	for hash, alert := range alerts {
+		// Keep the original state of alerts that are still active.
+		if existing, ok := r.active[hash]; ok && existing.State != StateInactive {
+			existing.Value = alert.Value
+			existing.Annotations = alert.Annotations
+			continue
+		}
+		r.active[hash] = alert
+	}
+
+	for hash, alert := range r.active {
+		if _, ok := alerts[hash]; !ok {
+			// Pending alerts are dropped straight away, firing alerts are
+			// resolved and kept for a while so the resolution gets sent.
+			if alert.State == StatePending ||
+				(!alert.ResolvedAt.IsZero() && t.Sub(alert.ResolvedAt) > resolvedRetention) {
+				delete(r.active, hash)
+			}
+			if alert.State != StateInactive {
+				alert.State = StateInactive
+				alert.ResolvedAt = t
+			}
+			continue
+		}
+
+		if alert.State == StatePending && t.Sub(alert.ActiveAt) >= r.holdDuration {
+			alert.State = StateFiring
+			alert.FiredAt = t
+		}
+	}
+
+	return nil
+}
+
+// alertsToSend returns the alerts that should be sent to the notifier and
+// marks them as sent.
+func (r *alertingRule) alertsToSend(
+	t time.Time,
+	resendDelay time.Duration,
+	interval time.Duration,
+) []Alert {
+	r.Lock()
+	defer r.Unlock()
+
+	var result []Alert
+	for _, alert := range r.active {
+		if !alert.needsSending(t, resendDelay) {
+			continue
+		}
+
+		delta := resendDelay
+		if interval > resendDelay {
+			delta = interval
+		}
+		alert.LastSentAt = t
+		alert.ValidUntil = t.Add(4 * delta)
+		result = append(result, *alert)
+	}
+
+	return result
+}
+
+func (a *Alert) needsSending(t time.Time, resendDelay time.Duration) bool {
+	if a.State == StatePending {
+		return false
+	}
+	// Resolved alerts are sent once straight away.
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return !a.LastSentAt.Add(resendDelay).After(t)
+}
+
+// Alerts returns the pending and firing alerts of the rule.
+func (r *alertingRule) Alerts() []Alert {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.alertsWithLock()
+}
+
+func (r *alertingRule) alertsWithLock() []Alert {
+	result := make([]Alert, 0, len(r.active))
+	for _, alert := range r.active {
+		if alert.State == StateInactive {
+			continue
+		}
+		result = append(result, *alert)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return labels.Compare(result[i].Labels, result[j].Labels) < 0
+	})
+	return result
+}
+
+func (r *alertingRule) State() RuleState {
+	r.RLock()
+	defer r.RUnlock()
+
+	var (
+		alerts = r.alertsWithLock()
+		state  = StateInactive
+	)
+	for _, alert := range alerts {
+		if alert.State == StateFiring {
+			state = StateFiring
+			break
+		}
+		state = StatePending
+	}
+
+	return RuleState{
+		Type:           AlertingRuleType,
+		Name:           r.name,
+		Query:          r.expr,
+		Duration:       r.holdDuration,
+		Labels:         r.labels,
+		Annotations:    r.annotations,
+		Health:         r.health,
+		LastError:      r.lastError,
+		LastEvaluation: r.lastEvaluation,
+		EvaluationTime: r.evaluationTime,
+		State:          state,
+		Alerts:         alerts,
+	}
+}
+
+type templateData struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// expandTemplate expands the $labels and $value variables in label and
+// annotation values of alerting rules.
+func expandTemplate(name, text string, data templateData) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	tmpl, err := template.New("__alert_" + name).
+		Option("missingkey=zero").
+		Parse(templateDefs + text)
+	if err != nil {
+		return "", fmt.Errorf("could not parse template of alert %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("could not expand template of alert %s: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
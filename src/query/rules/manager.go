@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+var (
+	errManagerAlreadyStarted = errors.New("rules manager already started")
+	errManagerNotStarted     = errors.New("rules manager not started")
+)
+
+type rule interface {
+	Name() string
+	Eval(ctx context.Context, t time.Time) error
+	State() RuleState
+}
+
+type managerMetrics struct {
+	evaluations        tally.Counter
+	evaluationErrors   tally.Counter
+	evaluationDuration tally.Timer
+	missedIterations   tally.Counter
+	notifications      tally.Counter
+	notificationErrors tally.Counter
+}
+
+func newManagerMetrics(scope tally.Scope) managerMetrics {
+	return managerMetrics{
+		evaluations:        scope.Counter("evaluations"),
+		evaluationErrors:   scope.Counter("evaluation-errors"),
+		evaluationDuration: scope.Timer("evaluation-duration"),
+		missedIterations:   scope.Counter("missed-iterations"),
+		notifications:      scope.Counter("notifications"),
+		notificationErrors: scope.Counter("notification-errors"),
+	}
+}
+
+type manager struct {
+	sync.Mutex
+
+	opts    Options
+	logger  *zap.Logger
+	metrics managerMetrics
+	groups  []*group
+
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewManager returns a manager that evaluates the given rule groups.
+func NewManager(groups []RuleGroup, opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := (RuleGroups{Groups: groups}).Validate(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.withDefaults()
+	scope := opts.InstrumentOptions.MetricsScope().SubScope("rules")
+	m := &manager{
+		opts:    opts,
+		logger:  opts.InstrumentOptions.Logger(),
+		metrics: newManagerMetrics(scope),
+		groups:  make([]*group, 0, len(groups)),
+	}
+	for _, g := range groups {
+		m.groups = append(m.groups, newGroup(g, opts, m.logger, m.metrics))
+	}
+
+	return m, nil
+}
+
+func (m *manager) Start() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.started {
+		return errManagerAlreadyStarted
+	}
+	m.started = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	for _, g := range m.groups {
+		g := g
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			g.run(ctx)
+		}()
+	}
+
+	m.logger.Info("started rules manager", zap.Int("groups", len(m.groups)))
+	return nil
+}
+
+func (m *manager) Close() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !m.started {
+		return errManagerNotStarted
+	}
+	m.started = false
+
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *manager) Groups() []GroupState {
+	result := make([]GroupState, 0, len(m.groups))
+	for _, g := range m.groups {
+		result = append(result, g.State())
+	}
+	return result
+}
+
+func (m *manager) Alerts() []Alert {
+	var result []Alert
+	for _, g := range m.groups {
+		for _, r := range g.rules {
+			if ar, ok := r.(*alertingRule); ok {
+				result = append(result, ar.Alerts()...)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return labels.Compare(result[i].Labels, result[j].Labels) < 0
+	})
+	return result
+}
+
+// group evaluates its rules sequentially at an interval.
+type group struct {
+	name     string
+	file     string
+	interval time.Duration
+	rules    []rule
+	opts     Options
+	logger   *zap.Logger
+	metrics  managerMetrics
+
+	sync.RWMutex
+	lastEvaluation time.Time
+	evaluationTime time.Duration
+}
+
+func newGroup(
+	g RuleGroup,
+	opts Options,
+	logger *zap.Logger,
+	metrics managerMetrics,
+) *group {
+	rules := make([]rule, 0, len(g.Rules))
+	for _, r := range g.Rules {
+		if r.Alert != "" {
+			rules = append(rules, newAlertingRule(r, opts))
+			continue
+		}
+		rules = append(rules, newRecordingRule(r, opts))
+	}
+
+	return &group{
+		name:     g.Name,
+		file:     g.File,
+		interval: g.intervalOrDefault(opts.EvaluationInterval),
+		rules:    rules,
+		opts:     opts,
+		logger:   logger.With(zap.String("group", g.Name)),
+		metrics:  metrics,
+	}
+}
+
+func (g *group) run(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		start := g.opts.NowFn()
+		g.eval(ctx, start)
+
+		if elapsed := g.opts.NowFn().Sub(start); elapsed > g.interval {
+			g.metrics.missedIterations.Inc(int64(elapsed / g.interval))
+		}
+	}
+}
+
+// eval evaluates all rules of the group at the given time and sends the
+// resulting alerts to the notifier.
+func (g *group) eval(ctx context.Context, t time.Time) {
+	start := time.Now()
+
+	var alerts []Alert
+	for _, r := range g.rules {
+		if ctx.Err() != nil {
+			return
+		}
+
+		g.metrics.evaluations.Inc(1)
+		if err := r.Eval(ctx, t); err != nil {
+			g.metrics.evaluationErrors.Inc(1)
+			g.logger.Warn("rule evaluation failed",
+				zap.String("rule", r.Name()), zap.Error(err))
+		}
+
+		if ar, ok := r.(*alertingRule); ok {
+			alerts = append(alerts,
+				ar.alertsToSend(t, g.opts.ResendDelay, g.interval)...)
+		}
+	}
+
+	elapsed := time.Since(start)
+	g.metrics.evaluationDuration.Record(elapsed)
+
+	g.Lock()
+	g.lastEvaluation = t
+	g.evaluationTime = elapsed
+	g.Unlock()
+
+	if len(alerts) == 0 || g.opts.Notifier == nil {
+		return
+	}
+
+	g.metrics.notifications.Inc(int64(len(alerts)))
+	if err := g.opts.Notifier.Notify(ctx, alerts); err != nil {
+		g.metrics.notificationErrors.Inc(1)
+		g.logger.Warn("could not send alerts", zap.Error(err))
+	}
+}
+
+func (g *group) State() GroupState {
+	g.RLock()
+	state := GroupState{
+		Name:           g.name,
+		File:           g.file,
+		Interval:       g.interval,
+		LastEvaluation: g.lastEvaluation,
+		EvaluationTime: g.evaluationTime,
+	}
+	g.RUnlock()
+
+	state.Rules = make([]RuleState, 0, len(g.rules))
+	for _, r := range g.rules {
+		state.Rules = append(state.Rules, r.State())
+	}
+	return state
+}
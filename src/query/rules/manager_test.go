@@ -0,0 +1,320 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testNotifier struct {
+	sync.Mutex
+	alerts []Alert
+}
+
+func (n *testNotifier) Notify(_ context.Context, alerts []Alert) error {
+	n.Lock()
+	defer n.Unlock()
+	n.alerts = append(n.alerts, alerts...)
+	return nil
+}
+
+func (n *testNotifier) reset() []Alert {
+	n.Lock()
+	defer n.Unlock()
+	alerts := n.alerts
+	n.alerts = nil
+	return alerts
+}
+
+func newTestOptions(
+	ctrl *gomock.Controller,
+	query QueryFunc,
+) (Options, *ingest.MockDownsamplerAndWriter) {
+	writer := ingest.NewMockDownsamplerAndWriter(ctrl)
+	return Options{
+		InstrumentOptions: instrument.NewOptions(),
+		QueryFunc:         query,
+		Writer:            writer,
+	}, writer
+}
+
+func TestRecordingRuleEval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := func(_ context.Context, q string, _ time.Time) (Vector, error) {
+		assert.Equal(t, "sum by (job) (up)", q)
+		return Vector{
+			{Labels: labels.FromStrings("job", "a"), Value: 1},
+			{Labels: labels.FromStrings("job", "b"), Value: 2},
+		}, nil
+	}
+	opts, writer := newTestOptions(ctrl, query)
+
+	now := time.Unix(1000, 0)
+	for _, job := range []string{"a", "b"} {
+		job := job
+		writer.EXPECT().
+			Write(gomock.Any(), gomock.Any(), gomock.Any(), xtime.Millisecond,
+				gomock.Nil(), ingest.WriteOptions{}, ts.SourceTypePrometheus).
+			DoAndReturn(func(
+				_ context.Context,
+				tags models.Tags,
+				datapoints ts.Datapoints,
+				_ xtime.Unit,
+				_ []byte,
+				_ ingest.WriteOptions,
+				_ ts.SourceType,
+			) error {
+				name, ok := tags.Name()
+				require.True(t, ok)
+				assert.Equal(t, "job:up:sum", string(name))
+
+				value, ok := tags.Get([]byte("job"))
+				require.True(t, ok)
+				if string(value) != job {
+					return errors.New("unexpected job")
+				}
+
+				value, ok = tags.Get([]byte("team"))
+				require.True(t, ok)
+				assert.Equal(t, "foo", string(value))
+
+				require.Len(t, datapoints, 1)
+				assert.Equal(t, xtime.ToUnixNano(now), datapoints[0].Timestamp)
+				return nil
+			})
+	}
+
+	rule := newRecordingRule(Rule{
+		Record: "job:up:sum",
+		Expr:   "sum by (job) (up)",
+		Labels: map[string]string{"team": "foo"},
+	}, opts.withDefaults())
+	assert.Equal(t, HealthUnknown, rule.State().Health)
+
+	require.NoError(t, rule.Eval(context.Background(), now))
+
+	state := rule.State()
+	assert.Equal(t, RecordingRuleType, state.Type)
+	assert.Equal(t, HealthGood, state.Health)
+	assert.Equal(t, now, state.LastEvaluation)
+}
+
+func TestRecordingRuleEvalDuplicateLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := func(context.Context, string, time.Time) (Vector, error) {
+		return Vector{
+			{Labels: labels.FromStrings(labels.MetricName, "a"), Value: 1},
+			{Labels: labels.FromStrings(labels.MetricName, "b"), Value: 2},
+		}, nil
+	}
+	opts, writer := newTestOptions(ctrl, query)
+	writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	rule := newRecordingRule(Rule{Record: "c", Expr: "a or b"}, opts.withDefaults())
+	require.Error(t, rule.Eval(context.Background(), time.Now()))
+	assert.Equal(t, HealthBad, rule.State().Health)
+}
+
+func TestAlertingRuleLifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		mu     sync.Mutex
+		result Vector
+	)
+	query := func(context.Context, string, time.Time) (Vector, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return result, nil
+	}
+	setResult := func(v Vector) {
+		mu.Lock()
+		defer mu.Unlock()
+		result = v
+	}
+
+	opts, _ := newTestOptions(ctrl, query)
+	notifier := &testNotifier{}
+	opts.Notifier = notifier
+
+	g := newGroup(RuleGroup{
+		Name:     "test",
+		Interval: model.Duration(time.Minute),
+		Rules: []Rule{
+			{
+				Alert:       "InstanceDown",
+				Expr:        "up == 0",
+				For:         model.Duration(2 * time.Minute),
+				Labels:      map[string]string{"severity": "page"},
+				Annotations: map[string]string{"summary": "{{ $labels.instance }} is down"},
+			},
+		},
+	}, opts.withDefaults(), instrument.NewOptions().Logger(),
+		newManagerMetrics(instrument.NewOptions().MetricsScope()))
+
+	var (
+		ctx   = context.Background()
+		start = time.Unix(1000, 0)
+		down  = Vector{{
+			Labels: labels.FromStrings(labels.MetricName, "up", "instance", "a"),
+			Value:  0,
+		}}
+		expectedLabels = labels.FromStrings(labels.AlertName, "InstanceDown",
+			"instance", "a", "severity", "page")
+	)
+
+	// Alert becomes pending, pending alerts are not sent.
+	setResult(down)
+	g.eval(ctx, start)
+
+	rule := g.rules[0].(*alertingRule)
+	state := rule.State()
+	assert.Equal(t, StatePending, state.State)
+	require.Len(t, state.Alerts, 1)
+	assert.Equal(t, expectedLabels, state.Alerts[0].Labels)
+	assert.Equal(t, labels.FromStrings("summary", "a is down"),
+		state.Alerts[0].Annotations)
+	assert.Empty(t, notifier.reset())
+
+	// Alert fires once it has been active for the hold duration.
+	g.eval(ctx, start.Add(time.Minute))
+	assert.Equal(t, StatePending, rule.State().State)
+
+	g.eval(ctx, start.Add(2*time.Minute))
+	assert.Equal(t, StateFiring, rule.State().State)
+
+	sent := notifier.reset()
+	require.Len(t, sent, 1)
+	assert.Equal(t, expectedLabels, sent[0].Labels)
+	assert.Equal(t, start, sent[0].ActiveAt)
+	assert.True(t, sent[0].ResolvedAt.IsZero())
+
+	// Firing alerts are only resent after the resend delay.
+	g.eval(ctx, start.Add(2*time.Minute+30*time.Second))
+	assert.Empty(t, notifier.reset())
+
+	alerts := (&manager{groups: []*group{g}}).Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, StateFiring, alerts[0].State)
+
+	// Alert is resolved once the expression no longer returns the series.
+	setResult(nil)
+	resolvedAt := start.Add(3 * time.Minute)
+	g.eval(ctx, resolvedAt)
+	assert.Equal(t, StateInactive, rule.State().State)
+	assert.Empty(t, rule.Alerts())
+
+	sent = notifier.reset()
+	require.Len(t, sent, 1)
+	assert.Equal(t, resolvedAt, sent[0].ResolvedAt)
+
+	// Resolved alerts are eventually forgotten.
+	g.eval(ctx, resolvedAt.Add(resolvedRetention+time.Minute))
+	rule.RLock()
+	assert.Empty(t, rule.active)
+	rule.RUnlock()
+}
+
+func TestAlertingRulePendingAlertDropped(t *testing.T) {
+	var result Vector
+	query := func(context.Context, string, time.Time) (Vector, error) {
+		return result, nil
+	}
+
+	rule := newAlertingRule(Rule{
+		Alert: "a",
+		Expr:  "b",
+		For:   model.Duration(time.Minute),
+	}, Options{QueryFunc: query})
+
+	now := time.Now()
+	result = Vector{{Labels: labels.FromStrings("foo", "bar"), Value: 1}}
+	require.NoError(t, rule.Eval(context.Background(), now))
+	require.Len(t, rule.Alerts(), 1)
+
+	result = nil
+	require.NoError(t, rule.Eval(context.Background(), now.Add(time.Second)))
+	assert.Empty(t, rule.Alerts())
+	assert.Empty(t, rule.active)
+}
+
+func TestManagerStartClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	evaluated := make(chan struct{}, 1)
+	query := func(context.Context, string, time.Time) (Vector, error) {
+		select {
+		case evaluated <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}
+	opts, _ := newTestOptions(ctrl, query)
+
+	m, err := NewManager([]RuleGroup{
+		{
+			Name:     "test",
+			Interval: model.Duration(10 * time.Millisecond),
+			Rules:    []Rule{{Record: "a", Expr: "b"}},
+		},
+	}, opts)
+	require.NoError(t, err)
+
+	require.Error(t, m.Close())
+	require.NoError(t, m.Start())
+	require.Error(t, m.Start())
+
+	select {
+	case <-evaluated:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "rule was not evaluated")
+	}
+	require.NoError(t, m.Close())
+
+	groups := m.Groups()
+	require.Len(t, groups, 1)
+	assert.Equal(t, 10*time.Millisecond, groups[0].Interval)
+	require.Len(t, groups[0].Rules, 1)
+	assert.Equal(t, "a", groups[0].Rules[0].Name)
+}
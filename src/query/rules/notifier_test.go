@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var received []postableAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	var (
+		activeAt   = time.Unix(1000, 0).UTC()
+		validUntil = activeAt.Add(4 * time.Minute)
+		resolvedAt = activeAt.Add(time.Minute)
+		notifier   = NewWebhookNotifier(server.URL, time.Second)
+	)
+	err := notifier.Notify(context.Background(), []Alert{
+		{
+			State:       StateFiring,
+			Labels:      labels.FromStrings(labels.AlertName, "a"),
+			Annotations: labels.FromStrings("summary", "foo"),
+			ActiveAt:    activeAt,
+			ValidUntil:  validUntil,
+		},
+		{
+			State:      StateInactive,
+			Labels:     labels.FromStrings(labels.AlertName, "b"),
+			ActiveAt:   activeAt,
+			ResolvedAt: resolvedAt,
+			ValidUntil: validUntil,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []postableAlert{
+		{
+			Labels:      map[string]string{labels.AlertName: "a"},
+			Annotations: map[string]string{"summary": "foo"},
+			StartsAt:    activeAt,
+			EndsAt:      validUntil,
+		},
+		{
+			Labels:   map[string]string{labels.AlertName: "b"},
+			StartsAt: activeAt,
+			EndsAt:   resolvedAt,
+		},
+	}, received)
+}
+
+func TestWebhookNotifierErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, time.Second)
+	require.Error(t, notifier.Notify(context.Background(), []Alert{
+		{Labels: labels.FromStrings(labels.AlertName, "a")},
+	}))
+}
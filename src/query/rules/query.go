@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// instantQueryStep is the step used to evaluate a query at a single instant.
+const instantQueryStep = time.Second
+
+// Sample is a single value of a series returned by a query.
+type Sample struct {
+	Labels labels.Labels
+	Value  float64
+}
+
+// Vector is the result of evaluating a query at an instant.
+type Vector []Sample
+
+// QueryFunc evaluates a PromQL expression at the given time.
+type QueryFunc func(ctx context.Context, query string, t time.Time) (Vector, error)
+
+// EngineQueryFunc returns a QueryFunc that evaluates expressions with the
+// given engine, series with no value at the evaluation time are omitted.
+func EngineQueryFunc(
+	engine executor.Engine,
+	tagOpts models.TagOptions,
+	queryCtxOpts models.QueryContextOptions,
+	timeout time.Duration,
+) QueryFunc {
+	return func(ctx context.Context, query string, t time.Time) (Vector, error) {
+		engineOpts := engine.Options()
+		parser, err := promql.Parse(query, instantQueryStep, tagOpts,
+			engineOpts.ParseOptions())
+		if err != nil {
+			return nil, err
+		}
+
+		fetchOpts := storage.NewFetchOptions()
+		fetchOpts.Timeout = timeout
+
+		now := xtime.ToUnixNano(t)
+		params := models.RequestParams{
+			Start:            now,
+			End:              now,
+			Now:              t,
+			Timeout:          timeout,
+			Step:             instantQueryStep,
+			Query:            query,
+			IncludeEnd:       true,
+			BlockType:        models.TypeSingleBlock,
+			LookbackDuration: engineOpts.LookbackDuration(),
+		}
+
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		queryOpts := &executor.QueryOptions{QueryContextOptions: queryCtxOpts}
+		bl, err := engine.ExecuteExpr(ctx, parser, queryOpts, fetchOpts, params)
+		if err != nil {
+			return nil, err
+		}
+		defer bl.Close() // nolint:errcheck
+
+		it, err := bl.StepIter()
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			seriesMeta = it.SeriesMeta()
+			values     = make([]float64, len(seriesMeta))
+		)
+		for i := range values {
+			values[i] = math.NaN()
+		}
+		for it.Next() {
+			copy(values, it.Current().Values())
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+
+		var (
+			blockTags = bl.Meta().Tags.Tags
+			result    = make(Vector, 0, len(seriesMeta))
+		)
+		for i, meta := range seriesMeta {
+			if math.IsNaN(values[i]) {
+				continue
+			}
+
+			tags := meta.Tags.AddTags(blockTags)
+			result = append(result, Sample{
+				Labels: tagsToLabels(tags),
+				Value:  values[i],
+			})
+		}
+
+		return result, nil
+	}
+}
+
+// tagsToLabels converts tags to labels, the metric name tag is renamed to the
+// Prometheus metric name label.
+func tagsToLabels(tags models.Tags) labels.Labels {
+	var (
+		metricName = tags.Opts.MetricName()
+		lbls       = make(labels.Labels, 0, tags.Len())
+	)
+	for _, tag := range tags.Tags {
+		name := string(tag.Name)
+		if bytes.Equal(tag.Name, metricName) {
+			name = labels.MetricName
+		}
+		lbls = append(lbls, labels.Label{Name: name, Value: string(tag.Value)})
+	}
+
+	return labels.New(lbls...)
+}
+
+// labelsToTags converts labels to tags, the Prometheus metric name label is
+// renamed to the metric name tag.
+func labelsToTags(lbls labels.Labels, tagOpts models.TagOptions) models.Tags {
+	tags := models.NewTags(len(lbls), tagOpts)
+	for _, l := range lbls {
+		name := []byte(l.Name)
+		if l.Name == labels.MetricName {
+			name = tagOpts.MetricName()
+		}
+		tags = tags.AddTagWithoutNormalizing(models.Tag{
+			Name:  name,
+			Value: []byte(l.Value),
+		})
+	}
+
+	return tags.Normalize()
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestEngineQueryFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Unix(1000, 0)
+		tagOpts = models.NewTagOptions()
+		engine  = executor.NewMockEngine(ctrl)
+		bounds  = models.Bounds{
+			Start:    xtime.ToUnixNano(now),
+			Duration: time.Second,
+			StepSize: time.Second,
+		}
+	)
+	engine.EXPECT().Options().Return(executor.NewEngineOptions()).AnyTimes()
+	engine.EXPECT().
+		ExecuteExpr(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ parser.Parser,
+			_ *executor.QueryOptions,
+			fetchOpts *storage.FetchOptions,
+			params models.RequestParams,
+		) (block.Block, error) {
+			assert.Equal(t, "up", params.Query)
+			assert.Equal(t, xtime.ToUnixNano(now), params.Start)
+			assert.Equal(t, xtime.ToUnixNano(now), params.End)
+			assert.Equal(t, time.Minute, fetchOpts.Timeout)
+			return test.NewBlockFromValuesWithSeriesMeta(bounds,
+				test.NewSeriesMeta("foo", 2),
+				[][]float64{{1}, {math.NaN()}}), nil
+		})
+
+	query := EngineQueryFunc(engine, tagOpts, models.QueryContextOptions{}, time.Minute)
+	result, err := query(context.Background(), "up", now)
+	require.NoError(t, err)
+
+	// Series without a value are omitted.
+	assert.Equal(t, Vector{
+		{
+			Labels: labels.FromStrings(labels.MetricName, "foo0", "foo0", "foo0"),
+			Value:  1,
+		},
+	}, result)
+}
+
+func TestLabelsToTags(t *testing.T) {
+	tagOpts := models.NewTagOptions().SetMetricName([]byte("name"))
+	tags := labelsToTags(labels.FromStrings(labels.MetricName, "foo", "bar", "baz"),
+		tagOpts)
+
+	name, ok := tags.Name()
+	require.True(t, ok)
+	assert.Equal(t, "foo", string(name))
+	assert.Equal(t, labels.FromStrings(labels.MetricName, "foo", "bar", "baz"),
+		tagsToLabels(tags))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// recordingRule evaluates an expression and writes the result back as new
+// series named after the rule.
+type recordingRule struct {
+	name    string
+	expr    string
+	labels  labels.Labels
+	query   QueryFunc
+	writer  ingest.DownsamplerAndWriter
+	tagOpts models.TagOptions
+
+	sync.RWMutex
+	health         RuleHealth
+	lastError      error
+	lastEvaluation time.Time
+	evaluationTime time.Duration
+}
+
+func newRecordingRule(r Rule, opts Options) *recordingRule {
+	return &recordingRule{
+		name:    r.Record,
+		expr:    r.Expr,
+		labels:  labels.FromMap(r.Labels),
+		query:   opts.QueryFunc,
+		writer:  opts.Writer,
+		tagOpts: opts.TagOptions,
+		health:  HealthUnknown,
+	}
+}
+
+func (r *recordingRule) Name() string {
+	return r.name
+}
+
+func (r *recordingRule) Eval(ctx context.Context, t time.Time) error {
+	start := time.Now()
+	err := r.eval(ctx, t)
+
+	r.Lock()
+	r.lastError = err
+	r.health = HealthGood
+	if err != nil {
+		r.health = HealthBad
+	}
+	r.lastEvaluation = t
+	r.evaluationTime = time.Since(start)
+	r.Unlock()
+
+	return err
+}
+
+func (r *recordingRule) eval(ctx context.Context, t time.Time) error {
+	vector, err := r.query(ctx, r.expr, t)
+	if err != nil {
+		return err
+	}
+
+	var (
+		seen      = make(map[uint64]struct{}, len(vector))
+		timestamp = xtime.ToUnixNano(t)
+	)
+	for _, sample := range vector {
+		lb := labels.NewBuilder(sample.Labels)
+		lb.Set(labels.MetricName, r.name)
+		for _, l := range r.labels {
+			lb.Set(l.Name, l.Value)
+		}
+
+		lbls := lb.Labels()
+		hash := lbls.Hash()
+		if _, ok := seen[hash]; ok {
+			return fmt.Errorf(
+				"vector contains metrics with the same labelset after applying rule labels: %s",
+				lbls.String())
+		}
+		seen[hash] = struct{}{}
+
+		datapoints := ts.Datapoints{{Timestamp: timestamp, Value: sample.Value}}
+		err := r.writer.Write(ctx, labelsToTags(lbls, r.tagOpts), datapoints,
+			xtime.Millisecond, nil, ingest.WriteOptions{}, ts.SourceTypePrometheus)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *recordingRule) State() RuleState {
+	r.RLock()
+	defer r.RUnlock()
+
+	return RuleState{
+		Type:           RecordingRuleType,
+		Name:           r.name,
+		Query:          r.expr,
+		Labels:         r.labels,
+		Health:         r.health,
+		LastError:      r.lastError,
+		LastEvaluation: r.lastEvaluation,
+		EvaluationTime: r.evaluationTime,
+	}
+}
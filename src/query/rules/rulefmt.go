@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errGroupNameEmpty       = errors.New("group name must not be empty")
+	errRuleNameAmbiguous    = errors.New("only one of record and alert must be set")
+	errRuleNameEmpty        = errors.New("one of record or alert must be set")
+	errRuleExprEmpty        = errors.New("expr must not be empty")
+	errRecordingRuleFor     = errors.New("for must not be set for recording rules")
+	errRecordingAnnotations = errors.New("annotations must not be set for recording rules")
+)
+
+// RuleGroups is a set of rule groups, the format is compatible with
+// Prometheus rule files.
+type RuleGroups struct {
+	Groups []RuleGroup `yaml:"groups"`
+}
+
+// RuleGroup is a named set of rules evaluated sequentially at an interval.
+type RuleGroup struct {
+	Name     string         `yaml:"name"`
+	Interval model.Duration `yaml:"interval,omitempty"`
+	Rules    []Rule         `yaml:"rules"`
+
+	// File is the file the group was loaded from.
+	File string `yaml:"-"`
+}
+
+// Rule is either a recording or an alerting rule.
+type Rule struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         model.Duration    `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Validate validates the rule groups.
+func (g RuleGroups) Validate() error {
+	seen := make(map[string]struct{}, len(g.Groups))
+	for _, group := range g.Groups {
+		if group.Name == "" {
+			return errGroupNameEmpty
+		}
+		if _, ok := seen[group.Name]; ok {
+			return fmt.Errorf("group name is not unique: %s", group.Name)
+		}
+		seen[group.Name] = struct{}{}
+
+		if group.Interval < 0 {
+			return fmt.Errorf("group %s: interval must not be negative", group.Name)
+		}
+
+		for i, rule := range group.Rules {
+			if err := rule.Validate(); err != nil {
+				return fmt.Errorf("group %s, rule %d: %w", group.Name, i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the rule.
+func (r Rule) Validate() error {
+	if r.Record != "" && r.Alert != "" {
+		return errRuleNameAmbiguous
+	}
+	if r.Record == "" && r.Alert == "" {
+		return errRuleNameEmpty
+	}
+	if r.Expr == "" {
+		return errRuleExprEmpty
+	}
+	if _, err := parser.ParseExpr(r.Expr); err != nil {
+		return fmt.Errorf("could not parse expr: %w", err)
+	}
+
+	if r.Record != "" {
+		if !model.IsValidMetricName(model.LabelValue(r.Record)) {
+			return fmt.Errorf("invalid recording rule name: %s", r.Record)
+		}
+		if r.For != 0 {
+			return errRecordingRuleFor
+		}
+		if len(r.Annotations) > 0 {
+			return errRecordingAnnotations
+		}
+	}
+
+	for name := range r.Labels {
+		if !model.LabelName(name).IsValid() || name == model.MetricNameLabel {
+			return fmt.Errorf("invalid label name: %s", name)
+		}
+	}
+	for name := range r.Annotations {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("invalid annotation name: %s", name)
+		}
+	}
+
+	return nil
+}
+
+// Parse parses and validates rule groups.
+func Parse(content []byte) (RuleGroups, error) {
+	var groups RuleGroups
+	if err := yaml.UnmarshalStrict(content, &groups); err != nil {
+		return RuleGroups{}, err
+	}
+	if err := groups.Validate(); err != nil {
+		return RuleGroups{}, err
+	}
+	return groups, nil
+}
+
+// LoadFiles loads the rule groups from all files matching the given glob
+// patterns, group names must be unique across all of the files.
+func LoadFiles(patterns []string) ([]RuleGroup, error) {
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule file pattern %s: %w", pattern, err)
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	var (
+		result []RuleGroup
+		seen   = make(map[string]string)
+	)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		groups, err := Parse(content)
+		if err != nil {
+			return nil, fmt.Errorf("could not load rule file %s: %w", file, err)
+		}
+
+		for _, group := range groups.Groups {
+			if other, ok := seen[group.Name]; ok {
+				return nil, fmt.Errorf("group %s in %s is already defined in %s",
+					group.Name, file, other)
+			}
+			seen[group.Name] = file
+
+			group.File = file
+			result = append(result, group)
+		}
+	}
+
+	return result, nil
+}
+
+func (g RuleGroup) intervalOrDefault(defaultInterval time.Duration) time.Duration {
+	if g.Interval > 0 {
+		return time.Duration(g.Interval)
+	}
+	return defaultInterval
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRuleGroups = `
+groups:
+  - name: example
+    interval: 30s
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+        labels:
+          team: foo
+      - alert: HighErrorRate
+        expr: job:http_errors:ratio5m > 0.5
+        for: 10m
+        labels:
+          severity: page
+        annotations:
+          summary: High error rate on {{ $labels.job }}
+`
+
+func TestParse(t *testing.T) {
+	groups, err := Parse([]byte(testRuleGroups))
+	require.NoError(t, err)
+	require.Len(t, groups.Groups, 1)
+
+	group := groups.Groups[0]
+	assert.Equal(t, "example", group.Name)
+	assert.Equal(t, model.Duration(30*time.Second), group.Interval)
+	require.Len(t, group.Rules, 2)
+
+	assert.Equal(t, Rule{
+		Record: "job:http_requests:rate5m",
+		Expr:   "sum by (job) (rate(http_requests_total[5m]))",
+		Labels: map[string]string{"team": "foo"},
+	}, group.Rules[0])
+	assert.Equal(t, Rule{
+		Alert:       "HighErrorRate",
+		Expr:        "job:http_errors:ratio5m > 0.5",
+		For:         model.Duration(10 * time.Minute),
+		Labels:      map[string]string{"severity": "page"},
+		Annotations: map[string]string{"summary": "High error rate on {{ $labels.job }}"},
+	}, group.Rules[1])
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "unknown field",
+			content: "groups:\n- name: a\n  foo: bar\n",
+		},
+		{
+			name:    "empty group name",
+			content: "groups:\n- rules:\n  - record: a\n    expr: b\n",
+		},
+		{
+			name:    "duplicate group name",
+			content: "groups:\n- name: a\n- name: a\n",
+		},
+		{
+			name:    "record and alert",
+			content: "groups:\n- name: a\n  rules:\n  - record: a\n    alert: a\n    expr: b\n",
+		},
+		{
+			name:    "no record or alert",
+			content: "groups:\n- name: a\n  rules:\n  - expr: b\n",
+		},
+		{
+			name:    "invalid expr",
+			content: "groups:\n- name: a\n  rules:\n  - record: a\n    expr: sum(\n",
+		},
+		{
+			name:    "invalid record name",
+			content: "groups:\n- name: a\n  rules:\n  - record: 1a\n    expr: b\n",
+		},
+		{
+			name:    "recording rule with for",
+			content: "groups:\n- name: a\n  rules:\n  - record: a\n    expr: b\n    for: 1m\n",
+		},
+		{
+			name:    "invalid label name",
+			content: "groups:\n- name: a\n  rules:\n  - alert: a\n    expr: b\n    labels:\n      1a: b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	first := filepath.Join(dir, "first.yml")
+	require.NoError(t, ioutil.WriteFile(first, []byte(testRuleGroups), 0600))
+
+	groups, err := LoadFiles([]string{filepath.Join(dir, "*.yml")})
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "example", groups[0].Name)
+	assert.Equal(t, first, groups[0].File)
+
+	// Group names must be unique across files.
+	second := filepath.Join(dir, "second.yml")
+	require.NoError(t, ioutil.WriteFile(second, []byte(testRuleGroups), 0600))
+
+	_, err = LoadFiles([]string{filepath.Join(dir, "*.yml")})
+	require.Error(t, err)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultEvaluationInterval = time.Minute
+	defaultResendDelay        = time.Minute
+)
+
+var (
+	errIOptsMustBeSet     = errors.New("rules manager options: instrument options must be set")
+	errQueryFuncMustBeSet = errors.New("rules manager options: query func must be set")
+	errWriterMustBeSet    = errors.New("rules manager options: writer must be set")
+)
+
+// AlertState is the state of an alert.
+type AlertState string
+
+const (
+	// StateInactive is the state of an alert that is neither pending nor firing.
+	StateInactive AlertState = "inactive"
+	// StatePending is the state of an alert that has been active for less
+	// than the rule's for duration.
+	StatePending AlertState = "pending"
+	// StateFiring is the state of an alert that has been active for longer
+	// than the rule's for duration.
+	StateFiring AlertState = "firing"
+)
+
+// RuleHealth describes the outcome of the last evaluation of a rule.
+type RuleHealth string
+
+const (
+	// HealthUnknown is the health of a rule that has not been evaluated yet.
+	HealthUnknown RuleHealth = "unknown"
+	// HealthGood is the health of a rule that evaluated successfully.
+	HealthGood RuleHealth = "ok"
+	// HealthBad is the health of a rule that failed to evaluate.
+	HealthBad RuleHealth = "err"
+)
+
+// RuleType is the type of a rule.
+type RuleType string
+
+const (
+	// RecordingRuleType is the type of recording rules.
+	RecordingRuleType RuleType = "recording"
+	// AlertingRuleType is the type of alerting rules.
+	AlertingRuleType RuleType = "alerting"
+)
+
+// Alert is an alert produced by an alerting rule.
+type Alert struct {
+	State       AlertState
+	Labels      labels.Labels
+	Annotations labels.Labels
+	Value       float64
+
+	ActiveAt   time.Time
+	FiredAt    time.Time
+	ResolvedAt time.Time
+	LastSentAt time.Time
+	ValidUntil time.Time
+}
+
+// GroupState is a snapshot of a rule group.
+type GroupState struct {
+	Name           string
+	File           string
+	Interval       time.Duration
+	Rules          []RuleState
+	LastEvaluation time.Time
+	EvaluationTime time.Duration
+}
+
+// RuleState is a snapshot of a rule.
+type RuleState struct {
+	Type           RuleType
+	Name           string
+	Query          string
+	Duration       time.Duration
+	Labels         labels.Labels
+	Annotations    labels.Labels
+	Health         RuleHealth
+	LastError      error
+	LastEvaluation time.Time
+	EvaluationTime time.Duration
+
+	// State and Alerts are only set for alerting rules, State is the most
+	// severe state of all of the alerts of the rule.
+	State  AlertState
+	Alerts []Alert
+}
+
+// Manager evaluates rule groups on schedule.
+type Manager interface {
+	// Start starts evaluating the rule groups.
+	Start() error
+
+	// Close stops evaluating the rule groups.
+	Close() error
+
+	// Groups returns a snapshot of the rule groups.
+	Groups() []GroupState
+
+	// Alerts returns the pending and firing alerts of all alerting rules.
+	Alerts() []Alert
+}
+
+// Notifier sends alerts to an alert receiver.
+type Notifier interface {
+	// Notify sends the alerts, resolved alerts have a non-zero ResolvedAt.
+	Notify(ctx context.Context, alerts []Alert) error
+}
+
+// Options configures the rules manager.
+type Options struct {
+	InstrumentOptions instrument.Options
+	// QueryFunc evaluates the rule expressions.
+	QueryFunc QueryFunc
+	// Writer writes the output of recording rules.
+	Writer ingest.DownsamplerAndWriter
+	// TagOptions are the tag options used for recording rule output.
+	TagOptions models.TagOptions
+	// Notifier if set receives the alerts of alerting rules.
+	Notifier Notifier
+	// EvaluationInterval is the interval for groups that do not set one.
+	EvaluationInterval time.Duration
+	// ResendDelay is the minimum delay before resending an alert.
+	ResendDelay time.Duration
+	// NowFn is the function used to determine the evaluation time.
+	NowFn clock.NowFn
+}
+
+// Validate validates the options struct.
+func (o *Options) Validate() error {
+	if o.InstrumentOptions == nil {
+		return errIOptsMustBeSet
+	}
+	if o.QueryFunc == nil {
+		return errQueryFuncMustBeSet
+	}
+	if o.Writer == nil {
+		return errWriterMustBeSet
+	}
+	return nil
+}
+
+func (o Options) withDefaults() Options {
+	if o.TagOptions == nil {
+		o.TagOptions = models.NewTagOptions()
+	}
+	if o.EvaluationInterval <= 0 {
+		o.EvaluationInterval = defaultEvaluationInterval
+	}
+	if o.ResendDelay <= 0 {
+		o.ResendDelay = defaultResendDelay
+	}
+	if o.NowFn == nil {
+		o.NowFn = time.Now
+	}
+	return o
+}
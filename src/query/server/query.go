@@ -57,6 +57,7 @@ import (
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/api/v1/handler/otlp"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/httpd"
 	"github.com/m3db/m3/src/query/api/v1/options"
@@ -68,7 +69,9 @@ import (
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/pools"
 	"github.com/m3db/m3/src/query/promqlengine"
+	"github.com/m3db/m3/src/query/quota"
 	tsdbremote "github.com/m3db/m3/src/query/remote"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/storage/m3"
@@ -343,12 +346,18 @@ func Run(runOpts RunOptions) RunResult {
 		timeout = *runOpts.DBConfig.Client.FetchTimeout
 	}
 
+	var quotaEnforcer quota.Enforcer
+	if cfg.Quotas != nil {
+		quotaEnforcer = newQuotaEnforcer(*cfg.Quotas, instrumentOptions)
+	}
+
 	fetchOptsBuilderLimitsOpts := cfg.Limits.PerQuery.AsFetchOptionsBuilderLimitsOptions()
 	fetchOptsBuilder, err := handleroptions.NewFetchOptionsBuilder(
 		handleroptions.FetchOptionsBuilderOptions{
 			Limits:        fetchOptsBuilderLimitsOpts,
 			RestrictByTag: storageRestrictByTags,
 			Timeout:       timeout,
+			Quotas:        quotaEnforcer,
 		})
 	if err != nil {
 		logger.Fatal("could not set fetch options parser", zap.Error(err))
@@ -513,7 +522,8 @@ func Run(runOpts RunOptions) RunResult {
 			logger.Fatal("unable to setup downsampler for m3db backend", zap.Error(err))
 		}
 	case config.PromRemoteStorageType:
-		opts, err := promremote.NewOptions(cfg.PrometheusRemoteBackend, scope, instrumentOptions.Logger())
+		opts, err := promremote.NewOptions(cfg.PrometheusRemoteBackend, tagOptions,
+			scope, instrumentOptions.Logger())
 		if err != nil {
 			logger.Fatal("invalid configuration", zap.Error(err))
 		}
@@ -572,6 +582,11 @@ func Run(runOpts RunOptions) RunResult {
 	if err != nil {
 		logger.Fatal("unable to create new downsampler and writer", zap.Error(err))
 	}
+	if quotaEnforcer != nil {
+		// NB: enforce the tenant write quotas for every ingestion protocol.
+		downsamplerAndWriter = quota.NewDownsamplerAndWriter(quotaEnforcer,
+			downsamplerAndWriter)
+	}
 
 	var serviceOptionDefaults []handleroptions3.ServiceOptionsDefault
 	if dbCfg := runOpts.DBConfig; dbCfg != nil {
@@ -692,6 +707,44 @@ func Run(runOpts RunOptions) RunResult {
 		handlerOptions = fn(handlerOptions)
 	}
 
+	if cfg.Rules != nil {
+		rulesManager, err := newRulesManager(*cfg.Rules, engine,
+			downsamplerAndWriter, tagOptions, queryCtxOpts, timeout,
+			instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to create rules manager", zap.Error(err))
+		}
+		if err := rulesManager.Start(); err != nil {
+			logger.Fatal("unable to start rules manager", zap.Error(err))
+		}
+		defer rulesManager.Close() // nolint:errcheck
+
+		handlerOptions = handlerOptions.SetRulesManager(rulesManager)
+	}
+
+	if quotaEnforcer != nil {
+		if clusterClient == nil {
+			logger.Fatal("tenant quotas require cluster management to be configured")
+		}
+		go watchTenantQuotas(*cfg.Quotas, clusterClient, quotaEnforcer, logger)
+
+		handlerOptions = handlerOptions.SetQuotaEnforcer(quotaEnforcer)
+	}
+
+	// NB: the OTLP/HTTP and OTLP/gRPC endpoints share a writer so that delta
+	// temporality series are accumulated once regardless of transport.
+	otlpWriter := otlp.NewWriter(handlerOptions,
+		cfg.OTLP.DeltaTemporalityTTLOrDefault())
+	handlerOptions = handlerOptions.SetOTLPWriter(otlpWriter)
+	if cfg.OTLP != nil && cfg.OTLP.GRPCListenAddress != "" {
+		otlpServer, err := startOTLPGRPCServer(*cfg.OTLP, otlpWriter,
+			instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to start OTLP gRPC server", zap.Error(err))
+		}
+		defer otlpServer.GracefulStop()
+	}
+
 	customHandlers := customHandlerOpts.CustomHandlers
 	handler := httpd.NewHandler(handlerOptions, cfg.Middleware, customHandlers...)
 	if err := handler.RegisterRoutes(); err != nil {
@@ -1134,6 +1187,25 @@ func newStorages(
 		}
 	}
 
+	if hasPromRemoteReadEndpoints(cfg.PrometheusRemoteBackend) {
+		// NB: federate reads to the Prometheus remote read endpoints, writes
+		// continue to only be sent locally unless the write filter says
+		// otherwise.
+		promRemoteOpts, err := promremote.NewOptions(cfg.PrometheusRemoteBackend,
+			opts.TagOptions(), instrumentOpts.MetricsScope(), logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		promRemoteStorage, err := promremote.NewStorage(promRemoteOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		logger.Info("prometheus remote read enabled")
+		stores = append(stores, promRemoteStorage)
+		remoteEnabled = true
+	}
+
 	readFilter := filter.LocalOnly
 	writeFilter := filter.LocalOnly
 	completeTagsFilter := filter.CompleteTagsLocalOnly
@@ -1182,6 +1254,18 @@ func newStorages(
 	return fanoutStorage, cleanup, nil
 }
 
+func hasPromRemoteReadEndpoints(cfg *config.PrometheusRemoteBackendConfiguration) bool {
+	if cfg == nil {
+		return false
+	}
+	for _, endpoint := range cfg.Endpoints {
+		if endpoint.ReadAddress != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func remoteZoneStorage(
 	zone config.Remote,
 	poolWrapper *pools.PoolWrapper,
@@ -1269,6 +1353,106 @@ func startGRPCServer(
 	return server, nil
 }
 
+func startOTLPGRPCServer(
+	cfg config.OTLPConfiguration,
+	otlpWriter options.OTLPWriter,
+	instrumentOpts instrument.Options,
+) (*grpc.Server, error) {
+	logger := instrumentOpts.Logger()
+
+	server := grpc.NewServer()
+	otlp.RegisterMetricsService(server, otlpWriter)
+
+	listener, err := net.Listen("tcp", cfg.GRPCListenAddress)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			logger.Error("error from serving OTLP gRPC server", zap.Error(err))
+		}
+	}()
+
+	logger.Info("started OTLP gRPC server",
+		zap.String("address", cfg.GRPCListenAddress))
+	return server, nil
+}
+
+func newRulesManager(
+	cfg config.RulesConfiguration,
+	engine executor.Engine,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	tagOptions models.TagOptions,
+	queryCtxOpts models.QueryContextOptions,
+	defaultTimeout time.Duration,
+	iOpts instrument.Options,
+) (rules.Manager, error) {
+	groups, err := rules.LoadFiles(cfg.RuleFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultTimeout
+	if cfg.QueryTimeout != nil {
+		timeout = *cfg.QueryTimeout
+	}
+
+	opts := rules.Options{
+		InstrumentOptions: iOpts,
+		QueryFunc: rules.EngineQueryFunc(engine, tagOptions,
+			queryCtxOpts, timeout),
+		Writer:     downsamplerAndWriter,
+		TagOptions: tagOptions,
+	}
+	if cfg.EvaluationInterval != nil {
+		opts.EvaluationInterval = *cfg.EvaluationInterval
+	}
+	if cfg.ResendDelay != nil {
+		opts.ResendDelay = *cfg.ResendDelay
+	}
+	if am := cfg.Alertmanager; am != nil {
+		var amTimeout time.Duration
+		if am.Timeout != nil {
+			amTimeout = *am.Timeout
+		}
+		opts.Notifier = rules.NewWebhookNotifier(am.URL, amTimeout)
+	}
+
+	iOpts.Logger().Info("loaded rule groups", zap.Int("groups", len(groups)))
+	return rules.NewManager(groups, opts)
+}
+
+func newQuotaEnforcer(
+	cfg config.QuotasConfiguration,
+	iOpts instrument.Options,
+) quota.Enforcer {
+	opts := quota.Options{
+		TenantHeader:      cfg.TenantHeader,
+		DefaultTenant:     cfg.DefaultTenant,
+		InstrumentOptions: iOpts,
+	}
+	if cfg.ActiveSeriesWindow != nil {
+		opts.ActiveSeriesWindow = *cfg.ActiveSeriesWindow
+	}
+	return quota.NewEnforcer(opts)
+}
+
+func watchTenantQuotas(
+	cfg config.QuotasConfiguration,
+	clusterClient clusterclient.Client,
+	enforcer quota.Enforcer,
+	logger *zap.Logger,
+) {
+	kvStore, err := clusterClient.KV()
+	if err != nil {
+		logger.Error("unable to get KV store for tenant quotas", zap.Error(err))
+		return
+	}
+	if err := quota.WatchKV(kvStore, cfg.KVKeyOrDefault(), enforcer, logger); err != nil {
+		logger.Error("unable to watch tenant quotas", zap.Error(err))
+	}
+}
+
 func startCarbonIngestion(
 	ingesterCfg config.CarbonIngesterConfiguration,
 	listenerOpts xnet.ListenerOptions,
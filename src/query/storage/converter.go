@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/histogram"
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
@@ -312,6 +313,87 @@ func PromSamplesToM3Datapoints(samples []prompb.Sample) ts.Datapoints {
 	return datapoints
 }
 
+// PromExemplarsToAnnotation converts Prometheus exemplars to the exemplars
+// carried in an annotation payload.
+func PromExemplarsToAnnotation(exemplars []prompb.Exemplar) []*annotation.Exemplar {
+	result := make([]*annotation.Exemplar, 0, len(exemplars))
+	for _, exemplar := range exemplars {
+		labels := make([]*annotation.ExemplarLabel, 0, len(exemplar.Labels))
+		for _, label := range exemplar.Labels {
+			labels = append(labels, &annotation.ExemplarLabel{
+				Name:  label.Name,
+				Value: label.Value,
+			})
+		}
+		result = append(result, &annotation.Exemplar{
+			Labels:         labels,
+			Value:          exemplar.Value,
+			TimestampNanos: int64(promTimestampToUnixNanos(exemplar.Timestamp)),
+		})
+	}
+	return result
+}
+
+// PromHistogramToM3 converts a Prometheus native histogram to an M3 native
+// histogram with absolute bucket counts.
+func PromHistogramToM3(h prompb.Histogram) (*histogram.Histogram, error) {
+	result := &histogram.Histogram{
+		Schema:        h.Schema,
+		ZeroThreshold: h.ZeroThreshold,
+		Sum:           h.Sum,
+	}
+
+	isFloat := h.CountFloat != 0 || h.ZeroCountFloat != 0 ||
+		len(h.PositiveCounts) > 0 || len(h.NegativeCounts) > 0
+	if isFloat {
+		result.Count = h.CountFloat
+		result.ZeroCount = h.ZeroCountFloat
+		result.PositiveBuckets = append([]float64(nil), h.PositiveCounts...)
+		result.NegativeBuckets = append([]float64(nil), h.NegativeCounts...)
+	} else {
+		result.Count = float64(h.CountInt)
+		result.ZeroCount = float64(h.ZeroCountInt)
+		result.PositiveBuckets = promDeltasToAbsolute(h.PositiveDeltas)
+		result.NegativeBuckets = promDeltasToAbsolute(h.NegativeDeltas)
+	}
+	result.PositiveSpans = promSpansToM3(h.PositiveSpans)
+	result.NegativeSpans = promSpansToM3(h.NegativeSpans)
+
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func promDeltasToAbsolute(deltas []int64) []float64 {
+	if len(deltas) == 0 {
+		return nil
+	}
+	var (
+		counts = make([]float64, 0, len(deltas))
+		curr   int64
+	)
+	for _, delta := range deltas {
+		curr += delta
+		counts = append(counts, float64(curr))
+	}
+	return counts
+}
+
+func promSpansToM3(spans []prompb.BucketSpan) []histogram.Span {
+	if len(spans) == 0 {
+		return nil
+	}
+	result := make([]histogram.Span, 0, len(spans))
+	for _, span := range spans {
+		result = append(result, histogram.Span{
+			Offset: span.Offset,
+			Length: span.Length,
+		})
+	}
+	return result
+}
+
 // PromReadQueryToM3 converts a prometheus read query to m3 read query
 func PromReadQueryToM3(query *prompb.Query) (*FetchQuery, error) {
 	tagMatchers, err := PromMatchersToM3(query.Matchers)
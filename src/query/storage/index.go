@@ -23,10 +23,12 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"regexp/syntax"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/m3ninx/idx"
+	m3ninxindex "github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -156,8 +158,9 @@ func FetchOptionsToAggregateOptions(
 			StartInclusive:    xtime.ToUnixNano(start),
 			EndExclusive:      xtime.ToUnixNano(end),
 		},
-		FieldFilter: tagQuery.FilterNameTags,
-		Type:        convertAggregateQueryType(tagQuery.CompleteNameOnly),
+		FieldFilter:      tagQuery.FilterNameTags,
+		Type:             convertAggregateQueryType(tagQuery.CompleteNameOnly),
+		IncludeDocsCount: tagQuery.IncludeDocsCount,
 	}, nil
 }
 
@@ -325,9 +328,13 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 			err   error
 		)
 
-		query, err = idx.NewRegexpQuery(matcher.Name, matcher.Value)
-		if err != nil {
-			return idx.Query{}, err
+		if prefix, ok := literalPrefix(matcher.Value); ok {
+			query = idx.NewPrefixQuery(matcher.Name, prefix)
+		} else {
+			query, err = idx.NewRegexpQuery(matcher.Name, matcher.Value)
+			if err != nil {
+				return idx.Query{}, err
+			}
 		}
 
 		if negate {
@@ -361,6 +368,28 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 
 		return query, nil
 
+	case models.MatchNotRange:
+		negate = true
+		fallthrough
+
+	case models.MatchRange:
+		r, err := models.ParseNumericRange(matcher.Value)
+		if err != nil {
+			return idx.Query{}, xerrors.NewInvalidParamsError(err)
+		}
+
+		query := idx.NewRangeQuery(matcher.Name, m3ninxindex.NumericRange{
+			Min:          r.Min,
+			Max:          r.Max,
+			MinInclusive: r.MinInclusive,
+			MaxInclusive: r.MaxInclusive,
+		})
+		if negate {
+			query = idx.NewNegationQuery(query)
+		}
+
+		return query, nil
+
 	case models.MatchAll:
 		return idx.NewAllQuery(), nil
 
@@ -369,6 +398,36 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 	}
 }
 
+// literalPrefix returns the literal prefix of regexps of the form prefix.*,
+// these are matched with a prefix query that walks the term dictionary
+// directly rather than compiling the regexp into an automaton.
+// NB: this treats '.' as matching newlines, which is fine since
+// label values containing newlines are not used in practice.
+func literalPrefix(value []byte) ([]byte, bool) {
+	parsed, err := syntax.Parse(string(value), syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+
+	parsed = parsed.Simplify()
+	if parsed.Op != syntax.OpConcat || len(parsed.Sub) != 2 {
+		return nil, false
+	}
+
+	literal, star := parsed.Sub[0], parsed.Sub[1]
+	if literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return nil, false
+	}
+	if star.Op != syntax.OpStar || len(star.Sub) != 1 {
+		return nil, false
+	}
+	if op := star.Sub[0].Op; op != syntax.OpAnyChar && op != syntax.OpAnyCharNotNL {
+		return nil, false
+	}
+
+	return []byte(string(literal.Rune)), true
+}
+
 func regexError(err error) error {
 	return xerrors.NewInvalidParamsError(xerrors.Wrap(err, "regex error"))
 }
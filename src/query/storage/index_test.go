@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	xtime "github.com/m3db/m3/src/x/time"
 )
@@ -112,6 +113,61 @@ func TestFetchQueryToM3Query(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "regexp match literal prefix -> prefix",
+			expected: "prefix(t1,v1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("v1.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match literal prefix negated",
+			expected: "negation(prefix(t1,v1))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("v1.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match case insensitive prefix -> regexp",
+			expected: "regexp(t1,(?i)v1.*)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("(?i)v1.*"),
+				},
+			},
+		},
+		{
+			name:     "range match",
+			expected: "range(t1,[200,300))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRange,
+					Name:  []byte("t1"),
+					Value: []byte("[200,300)"),
+				},
+			},
+		},
+		{
+			name:     "range match negated unbounded",
+			expected: "negation(range(t1,(0.5,+Inf]))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotRange,
+					Name:  []byte("t1"),
+					Value: []byte("(0.5,]"),
+				},
+			},
+		},
 		{
 			name:     "regexp match dot star -> all",
 			expected: "all()",
@@ -321,6 +377,26 @@ func TestFetchQueryToM3Query(t *testing.T) {
 	}
 }
 
+func TestFetchQueryToM3QueryInvalidRange(t *testing.T) {
+	fetchQuery := &FetchQuery{
+		Raw: "up",
+		TagMatchers: models.Matchers{
+			{
+				Type:  models.MatchRange,
+				Name:  []byte("t1"),
+				Value: []byte("[300,200]"),
+			},
+		},
+		Start:    now.Add(-5 * time.Minute),
+		End:      now,
+		Interval: 15 * time.Second,
+	}
+
+	_, err := FetchQueryToM3Query(fetchQuery, nil)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
+
 func TestFetchOptionsToAggregateOptions(t *testing.T) {
 	now := time.Now()
 
@@ -77,13 +77,9 @@ func (b *completeTagsResultBuilder) Add(tagResult *CompleteTagsResult) error {
 
 	completedTags = filterTags(completedTags, b.filters)
 	for _, tag := range completedTags {
-		if builder, exists := b.tagBuilders[string(tag.Name)]; exists {
-			builder.add(tag.Values)
-		} else {
-			builder := completedTagBuilder{}
-			builder.add(tag.Values)
-			b.tagBuilders[string(tag.Name)] = builder
-		}
+		builder := b.tagBuilders[string(tag.Name)]
+		builder.add(tag.Values, tag.DocsCounts)
+		b.tagBuilders[string(tag.Name)] = builder
 	}
 
 	return nil
@@ -120,10 +116,11 @@ func (b *completeTagsResultBuilder) Build() CompleteTagsResult {
 	}
 
 	for name, builder := range b.tagBuilders {
-		values := builder.build()
+		values, docsCounts := builder.build()
 		result = append(result, CompletedTag{
-			Name:   []byte(name),
-			Values: values,
+			Name:       []byte(name),
+			Values:     values,
+			DocsCounts: docsCounts,
 		})
 		b.metadata.FetchedMetadataCount += len(values)
 	}
@@ -137,16 +134,30 @@ func (b *completeTagsResultBuilder) Build() CompleteTagsResult {
 }
 
 type completedTagBuilder struct {
-	seenMap map[string]struct{}
+	seenMap       map[string]int64
+	hasDocsCounts bool
 }
 
-func (b *completedTagBuilder) add(values [][]byte) {
+func (b *completedTagBuilder) add(values [][]byte, docsCounts []int64) {
 	if b.seenMap == nil {
-		b.seenMap = make(map[string]struct{}, len(values))
+		b.seenMap = make(map[string]int64, len(values))
+	}
+
+	if len(docsCounts) > 0 {
+		b.hasDocsCounts = true
 	}
 
-	for _, val := range values {
-		b.seenMap[string(val)] = struct{}{}
+	for i, val := range values {
+		var docs int64
+		if i < len(docsCounts) {
+			docs = docsCounts[i]
+		}
+
+		// NB: the same series can be stored in multiple namespaces, so take
+		// the largest count seen rather than summing across results.
+		if existing, ok := b.seenMap[string(val)]; !ok || docs > existing {
+			b.seenMap[string(val)] = docs
+		}
 	}
 }
 
@@ -158,12 +169,21 @@ func (s tagValuesByName) Less(i, j int) bool {
 	return bytes.Compare(s[i], s[j]) == -1
 }
 
-func (b *completedTagBuilder) build() [][]byte {
+func (b *completedTagBuilder) build() ([][]byte, []int64) {
 	result := make([][]byte, 0, len(b.seenMap))
 	for v := range b.seenMap {
 		result = append(result, []byte(v))
 	}
 
 	sort.Sort(tagValuesByName(result))
-	return result
+	if !b.hasDocsCounts {
+		return result, nil
+	}
+
+	docsCounts := make([]int64, 0, len(result))
+	for _, v := range result {
+		docsCounts = append(docsCounts, b.seenMap[string(v)])
+	}
+
+	return result, docsCounts
 }
@@ -63,10 +63,11 @@ func TestFinalizeCompletedTag(t *testing.T) {
 	expected := []string{"a", "b", "z"}
 
 	builder := completedTagBuilder{}
-	builder.add(strsToBytes(initialVals))
-	actual := builder.build()
+	builder.add(strsToBytes(initialVals), nil)
+	actual, docsCounts := builder.build()
 
 	assert.Equal(t, strsToBytes(expected), actual)
+	assert.Nil(t, docsCounts)
 }
 
 func TestMergeCompletedTag(t *testing.T) {
@@ -76,14 +77,24 @@ func TestMergeCompletedTag(t *testing.T) {
 	expected := []string{"a", "ab", "b", "c", "d", "z"}
 
 	builder := completedTagBuilder{}
-	builder.add(strsToBytes(initialVals))
-	builder.add(strsToBytes(secondVals))
-	builder.add(strsToBytes(thirdVals))
-	actual := builder.build()
+	builder.add(strsToBytes(initialVals), nil)
+	builder.add(strsToBytes(secondVals), nil)
+	builder.add(strsToBytes(thirdVals), nil)
+	actual, _ := builder.build()
 
 	assert.Equal(t, strsToBytes(expected), actual)
 }
 
+func TestMergeCompletedTagDocsCounts(t *testing.T) {
+	builder := completedTagBuilder{}
+	builder.add(strsToBytes([]string{"a", "b"}), []int64{4, 2})
+	builder.add(strsToBytes([]string{"a", "c"}), []int64{3, 7})
+	actual, docsCounts := builder.build()
+
+	assert.Equal(t, strsToBytes([]string{"a", "b", "c"}), actual)
+	assert.Equal(t, []int64{4, 2, 7}, docsCounts)
+}
+
 func TestMergeCompletedTagResultDifferentNameTypes(t *testing.T) {
 	nameOnlyVals := []bool{true, false}
 	for _, nameOnly := range nameOnlyVals {
@@ -153,6 +153,9 @@ type CompletedTag struct {
 	// NB: if the parent CompleteTagsResult is set to CompleteNameOnly, this is
 	// expected to be empty.
 	Values [][]byte
+	// DocsCounts is the number of series matching each of the values, only
+	// set when the query requested documents counts.
+	DocsCounts []int64
 }
 
 // CompleteTagsResult represents a set of autocompleted tag names and values
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"errors"
+	"math"
+
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/histogram"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var errNativeHistogramProjectionMultiBlock = errors.New(
+	"native histogram functions are not supported with multi-block fetches")
+
+var errNativeHistogramNotProjected = errors.New("native histogram functions " +
+	"must be applied directly to a vector selector of native histograms")
+
+// projectNativeHistograms wraps the series iterators of the result so that
+// native histogram samples are projected to float values. Series with a
+// bucket tag are classic histogram buckets and are left untouched by
+// quantile projections.
+func projectNativeHistograms(
+	result consolidators.SeriesFetchResult,
+	projection storage.NativeHistogramProjection,
+	tagOpts models.TagOptions,
+) error {
+	iters := result.SeriesIterators()
+	for i := range iters {
+		_, tags, err := result.IterTagsAtIndex(i, tagOpts)
+		if err != nil {
+			return err
+		}
+
+		if projection.Type == storage.NativeHistogramQuantileProjection ||
+			projection.Type == storage.NativeHistogramRejectProjection {
+			if _, ok := tags.Bucket(); ok {
+				continue
+			}
+		}
+
+		iters[i] = &nativeHistogramSeriesIterator{
+			SeriesIterator: iters[i],
+			projection:     projection,
+		}
+	}
+
+	return nil
+}
+
+// nativeHistogramSeriesIterator projects the native histogram samples of a
+// series, or the timer sketches carried by the samples of an aggregated timer,
+// to float values.
+type nativeHistogramSeriesIterator struct {
+	encoding.SeriesIterator
+
+	projection storage.NativeHistogramProjection
+	payload    annotation.Payload
+	histogram  histogram.Histogram
+	sketch     ddsketch.Sketch
+	err        error
+}
+
+func (it *nativeHistogramSeriesIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	return it.SeriesIterator.Next()
+}
+
+func (it *nativeHistogramSeriesIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.SeriesIterator.Err()
+}
+
+func (it *nativeHistogramSeriesIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	dp, unit, annotationBytes := it.SeriesIterator.Current()
+	if it.projection.Type == storage.NativeHistogramRejectProjection {
+		it.rejectNativeHistogram(annotationBytes)
+		return dp, unit, annotationBytes
+	}
+
+	dp.Value = math.NaN()
+
+	it.payload.NativeHistogram = it.payload.NativeHistogram[:0]
+	it.payload.TimerSketch = it.payload.TimerSketch[:0]
+	if err := it.payload.Unmarshal(annotationBytes); err != nil {
+		return dp, unit, annotationBytes
+	}
+	if len(it.payload.NativeHistogram) == 0 {
+		if len(it.payload.TimerSketch) > 0 && it.sketch.Unmarshal(it.payload.TimerSketch) == nil {
+			dp.Value = it.projection.ProjectTimerSketch(&it.sketch)
+		}
+		return dp, unit, annotationBytes
+	}
+	if err := it.histogram.Unmarshal(it.payload.NativeHistogram); err != nil {
+		return dp, unit, annotationBytes
+	}
+
+	dp.Value = it.projection.Project(&it.histogram)
+	return dp, unit, annotationBytes
+}
+
+// rejectNativeHistogram fails the iterator if the sample is a native
+// histogram, other samples are left as is.
+func (it *nativeHistogramSeriesIterator) rejectNativeHistogram(annotationBytes ts.Annotation) {
+	if len(annotationBytes) == 0 {
+		return
+	}
+
+	it.payload.NativeHistogram = it.payload.NativeHistogram[:0]
+	if err := it.payload.Unmarshal(annotationBytes); err != nil {
+		return
+	}
+	if len(it.payload.NativeHistogram) > 0 {
+		it.err = errNativeHistogramNotProjected
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/histogram"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func testNativeHistogramAnnotation(t *testing.T) ts.Annotation {
+	h := &histogram.Histogram{
+		Schema:          0,
+		ZeroThreshold:   0.001,
+		ZeroCount:       2,
+		Count:           12,
+		Sum:             50,
+		PositiveSpans:   []histogram.Span{{Offset: 1, Length: 2}},
+		PositiveBuckets: []float64{4, 6},
+	}
+	require.NoError(t, h.Validate())
+
+	payload := annotation.Payload{NativeHistogram: h.Marshal(nil)}
+	annotationBytes, err := payload.Marshal()
+	require.NoError(t, err)
+	return annotationBytes
+}
+
+func testTimerSketchAnnotation(t *testing.T) ts.Annotation {
+	s := ddsketch.NewSketch(ddsketch.NewOptions())
+	for i := 1; i <= 100; i++ {
+		s.Add(float64(i))
+	}
+
+	payload := annotation.Payload{
+		GraphiteType: annotation.GraphiteType_GRAPHITE_TIMER,
+		TimerSketch:  s.Marshal(nil),
+	}
+	annotationBytes, err := payload.Marshal()
+	require.NoError(t, err)
+	return annotationBytes
+}
+
+func newTestNativeHistogramIter(
+	ctrl *gomock.Controller,
+	tags ident.TagIterator,
+	annotationBytes ts.Annotation,
+) *encoding.MockSeriesIterator {
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().Tags().Return(tags).AnyTimes()
+	iter.EXPECT().Current().Return(ts.Datapoint{Value: 12},
+		xtime.Second, annotationBytes).AnyTimes()
+	return iter
+}
+
+func TestProjectNativeHistograms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tagOpts := models.NewTagOptions()
+	annotationBytes := testNativeHistogramAnnotation(t)
+	nativeIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "bar"), annotationBytes)
+	floatIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "baz"), nil)
+	bucketIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "qux", "le", "+Inf"), nil)
+	sketchIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "quux"), testTimerSketchAnnotation(t))
+
+	tests := []struct {
+		projection storage.NativeHistogramProjection
+		expected   []float64
+	}{
+		{
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramCountProjection,
+			},
+			expected: []float64{12, math.NaN(), math.NaN(), 100},
+		},
+		{
+			projection: storage.NativeHistogramProjection{
+				Type: storage.NativeHistogramSumProjection,
+			},
+			expected: []float64{50, math.NaN(), math.NaN(), 5050},
+		},
+		{
+			projection: storage.NativeHistogramProjection{
+				Type:     storage.NativeHistogramQuantileProjection,
+				Quantile: 1,
+			},
+			// NB: classic histogram buckets are not projected.
+			expected: []float64{4, math.NaN(), 12, 100},
+		},
+	}
+
+	for _, tt := range tests {
+		result, err := consolidators.NewSeriesFetchResult(
+			encoding.NewSeriesIterators([]encoding.SeriesIterator{
+				nativeIter, floatIter, bucketIter, sketchIter,
+			}),
+			nil,
+			block.NewResultMetadata(),
+		)
+		require.NoError(t, err)
+
+		err = projectNativeHistograms(result, tt.projection, tagOpts)
+		require.NoError(t, err)
+
+		iters := result.SeriesIterators()
+		require.Len(t, iters, len(tt.expected))
+		for i, expected := range tt.expected {
+			dp, unit, annotation := iters[i].Current()
+			assert.Equal(t, xtime.Second, unit)
+			if i == 0 {
+				assert.Equal(t, annotationBytes, annotation)
+			}
+
+			if math.IsNaN(expected) {
+				assert.True(t, math.IsNaN(dp.Value))
+			} else {
+				assert.Equal(t, expected, dp.Value)
+			}
+		}
+	}
+}
+
+func TestProjectNativeHistogramsReject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nativeIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "bar"), testNativeHistogramAnnotation(t))
+	nativeIter.EXPECT().Next().Return(true)
+	floatIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "baz"), nil)
+	floatIter.EXPECT().Next().Return(true).Times(2)
+	floatIter.EXPECT().Err().Return(nil)
+	bucketIter := newTestNativeHistogramIter(ctrl,
+		ident.MustNewTagStringsIterator("foo", "qux", "le", "+Inf"), nil)
+
+	result, err := consolidators.NewSeriesFetchResult(
+		encoding.NewSeriesIterators([]encoding.SeriesIterator{
+			nativeIter, floatIter, bucketIter,
+		}),
+		nil,
+		block.NewResultMetadata(),
+	)
+	require.NoError(t, err)
+
+	err = projectNativeHistograms(result, storage.NativeHistogramProjection{
+		Type: storage.NativeHistogramRejectProjection,
+	}, models.NewTagOptions())
+	require.NoError(t, err)
+
+	iters := result.SeriesIterators()
+	require.Len(t, iters, 3)
+
+	// Native histogram samples fail the iterator.
+	require.True(t, iters[0].Next())
+	dp, _, _ := iters[0].Current()
+	assert.Equal(t, 12.0, dp.Value)
+	require.False(t, iters[0].Next())
+	require.Equal(t, errNativeHistogramNotProjected, iters[0].Err())
+
+	// Float samples are left as is.
+	for i := 0; i < 2; i++ {
+		require.True(t, iters[1].Next())
+		dp, _, _ = iters[1].Current()
+		assert.Equal(t, 12.0, dp.Value)
+	}
+	require.NoError(t, iters[1].Err())
+
+	// Classic histogram buckets are not wrapped.
+	assert.Equal(t, bucketIter, iters[2])
+}
@@ -247,6 +247,29 @@ func FetchResultToBlockResult(
 		StepSize: query.Interval,
 	}
 
+	projection := options.NativeHistogramProjection
+	if projection.Enabled() && options.BlockType == models.TypeMultiBlock {
+		if projection.Type != storage.NativeHistogramRejectProjection {
+			return block.Result{
+				Metadata: block.NewResultMetadata(),
+			}, errNativeHistogramProjectionMultiBlock
+		}
+
+		// NB: multi-block fetches split the series iterators by block so
+		// native histograms cannot be detected, skip rejecting them.
+		projection = storage.NativeHistogramProjection{}
+	}
+
+	if projection.Enabled() {
+
+		err := projectNativeHistograms(result, projection, opts.TagOptions())
+		if err != nil {
+			return block.Result{
+				Metadata: block.NewResultMetadata(),
+			}, err
+		}
+	}
+
 	blocks, err := ConvertM3DBSeriesIterators(
 		result,
 		bounds,
@@ -651,10 +674,14 @@ func (s *m3storage) CompleteTags(
 					return
 				}
 
-				completedTags = append(completedTags, consolidators.CompletedTag{
+				completedTag := consolidators.CompletedTag{
 					Name:   name.Bytes(),
 					Values: tagValues,
-				})
+				}
+				if query.IncludeDocsCount {
+					completedTag.DocsCounts = append([]int64(nil), aggTagIter.CurrentDocsCounts()...)
+				}
+				completedTags = append(completedTags, completedTag)
 			}
 
 			if err := aggTagIter.Err(); err != nil {
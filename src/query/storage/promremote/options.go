@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	xhttp "github.com/m3db/m3/src/x/net/http"
@@ -37,6 +38,7 @@ import (
 // NewOptions constructs Options based on the given config.
 func NewOptions(
 	cfg *config.PrometheusRemoteBackendConfiguration,
+	tagOptions models.TagOptions,
 	scope tally.Scope,
 	logger *zap.Logger,
 ) (Options, error) {
@@ -67,6 +69,7 @@ func NewOptions(
 		endpoints = append(endpoints, EndpointOptions{
 			name:              endpoint.Name,
 			address:           endpoint.Address,
+			readAddress:       endpoint.ReadAddress,
 			attributes:        attr,
 			downsampleOptions: downsampleOptions,
 		})
@@ -93,6 +96,7 @@ func NewOptions(
 	return Options{
 		endpoints:   endpoints,
 		httpOptions: clientOpts,
+		tagOptions:  tagOptions,
 		scope:       scope,
 		logger:      logger,
 	}, nil
@@ -146,8 +150,8 @@ func validateEndpointConfiguration(endpoint config.PrometheusRemoteBackendEndpoi
 			return errors.New("endpoint retention must be positive")
 		}
 	}
-	if strings.TrimSpace(endpoint.Address) == "" {
-		return errors.New("endpoint address must be set")
+	if strings.TrimSpace(endpoint.Address) == "" && strings.TrimSpace(endpoint.ReadAddress) == "" {
+		return errors.New("endpoint address must be set when no read address is set")
 	}
 	if strings.TrimSpace(endpoint.Name) == "" {
 		return errors.New("endpoint name must be set")
@@ -30,16 +30,19 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 )
 
 func TestNewFromConfiguration(t *testing.T) {
 	logger := zap.NewNop()
+	tagOptions := models.NewTagOptions().SetMetricName([]byte("name"))
 	opts, err := NewOptions(&config.PrometheusRemoteBackendConfiguration{
 		Endpoints: []config.PrometheusRemoteBackendEndpointConfiguration{{
-			Name:    "testEndpoint",
-			Address: "testAddress",
+			Name:        "testEndpoint",
+			Address:     "testAddress",
+			ReadAddress: "testReadAddress",
 			StoragePolicy: &config.PrometheusRemoteBackendStoragePolicyConfiguration{
 				Resolution: time.Second,
 				Retention:  time.Millisecond,
@@ -53,12 +56,13 @@ func TestNewFromConfiguration(t *testing.T) {
 		KeepAlive:       ptrDuration(time.Millisecond),
 		IdleConnTimeout: ptrDuration(time.Second),
 		MaxIdleConns:    ptrInt(1),
-	}, tally.NoopScope, logger)
+	}, tagOptions, tally.NoopScope, logger)
 	require.NoError(t, err)
 
 	assert.Equal(t, []EndpointOptions{{
-		name:    "testEndpoint",
-		address: "testAddress",
+		name:        "testEndpoint",
+		address:     "testAddress",
+		readAddress: "testReadAddress",
 		attributes: storagemetadata.Attributes{
 			MetricsType: storagemetadata.AggregatedMetricsType,
 			Resolution:  time.Second,
@@ -68,6 +72,7 @@ func TestNewFromConfiguration(t *testing.T) {
 			All: true,
 		},
 	}}, opts.endpoints)
+	assert.Equal(t, tagOptions, opts.tagOptions)
 	assert.Equal(t, tally.NoopScope, opts.scope)
 	assert.Equal(t, logger, opts.logger)
 	assert.Equal(t, time.Nanosecond, opts.httpOptions.RequestTimeout)
@@ -84,7 +89,7 @@ func TestUnaggregatedEndpoint(t *testing.T) {
 			Name:    "testEndpoint",
 			Address: "testAddress",
 		}},
-	}, tally.NoopScope, zap.NewNop())
+	}, models.NewTagOptions(), tally.NoopScope, zap.NewNop())
 	require.NoError(t, err)
 	assert.Equal(t, storagemetadata.UnaggregatedMetricsType, opts.endpoints[0].attributes.MetricsType)
 	assert.Equal(t, time.Duration(0), opts.endpoints[0].attributes.Retention)
@@ -102,7 +107,7 @@ func TestDefaultDownsampleAll(t *testing.T) {
 				Retention:  time.Millisecond,
 			},
 		}},
-	}, tally.NoopScope, zap.NewNop())
+	}, models.NewTagOptions(), tally.NoopScope, zap.NewNop())
 	require.NoError(t, err)
 	assert.NotNil(t, opts.endpoints[0].downsampleOptions)
 	assert.True(t, opts.endpoints[0].downsampleOptions.All)
@@ -111,7 +116,7 @@ func TestDefaultDownsampleAll(t *testing.T) {
 func TestHTTPDefaults(t *testing.T) {
 	cfg, err := NewOptions(&config.PrometheusRemoteBackendConfiguration{
 		Endpoints: []config.PrometheusRemoteBackendEndpointConfiguration{getValidEndpointConfiguration()},
-	}, tally.NoopScope, zap.NewNop())
+	}, models.NewTagOptions(), tally.NoopScope, zap.NewNop())
 	require.NoError(t, err)
 	opts := cfg.httpOptions
 
@@ -199,6 +204,13 @@ func TestValidateEndpoint(t *testing.T) {
 		assertEndpointValidationError(t, cfg, "endpoint address must be set")
 	})
 
+	t.Run("address optional for read only endpoints", func(t *testing.T) {
+		cfg := getValidEndpointConfiguration()
+		cfg.Address = ""
+		cfg.ReadAddress = "testReadAddress"
+		require.NoError(t, validateEndpointConfiguration(cfg))
+	})
+
 	t.Run("storage policy is optional", func(t *testing.T) {
 		cfg := getValidEndpointConfiguration()
 		cfg.StoragePolicy = nil
@@ -226,7 +238,7 @@ func TestValidateEndpoint(t *testing.T) {
 }
 
 func assertValidationError(t *testing.T, cfg *config.PrometheusRemoteBackendConfiguration, expectedMsg string) {
-	_, err := NewOptions(cfg, tally.NoopScope, zap.NewNop())
+	_, err := NewOptions(cfg, models.NewTagOptions(), tally.NoopScope, zap.NewNop())
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), expectedMsg)
 }
@@ -30,13 +30,18 @@ import (
 
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-// TestPromServer is a fake http server handling prometheus remote write. Intended for test usage.
+// TestPromServer is a fake http server handling prometheus remote write and
+// read. Intended for test usage.
 type TestPromServer struct {
 	mu               sync.Mutex
 	lastWriteRequest *prompb.WriteRequest
+	lastReadRequest  *prompb.ReadRequest
+	readSeries       []prompb.TimeSeries
 	respErr          *respErr
 	t                *testing.T
 	svr              *httptest.Server
@@ -53,6 +58,7 @@ func NewServer(t *testing.T) *TestPromServer {
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/write", testPromServer.handleWrite)
+	mux.HandleFunc("/read", testPromServer.handleRead)
 
 	testPromServer.svr = httptest.NewServer(mux)
 
@@ -77,6 +83,55 @@ func (s *TestPromServer) handleWrite(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *TestPromServer) handleRead(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Equal(s.t, r.Header.Get("content-encoding"), "snappy")
+	assert.Equal(s.t, r.Header.Get("content-type"), "application/x-protobuf")
+
+	req, err := remote.DecodeReadRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.lastReadRequest = req
+	if s.respErr != nil {
+		http.Error(w, s.respErr.error, s.respErr.status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse")
+	writer := remote.NewChunkedWriter(w, w.(http.Flusher))
+	for _, series := range s.readSeries {
+		chunk := chunkenc.NewXORChunk()
+		appender, err := chunk.Appender()
+		require.NoError(s.t, err)
+		for _, sample := range series.Samples {
+			appender.Append(sample.Timestamp, sample.Value)
+		}
+
+		var chunks []prompb.Chunk
+		if len(series.Samples) > 0 {
+			chunks = append(chunks, prompb.Chunk{
+				MinTimeMs: series.Samples[0].Timestamp,
+				MaxTimeMs: series.Samples[len(series.Samples)-1].Timestamp,
+				Type:      prompb.Chunk_XOR,
+				Data:      chunk.Bytes(),
+			})
+		}
+		resp := &prompb.ChunkedReadResponse{
+			ChunkedSeries: []*prompb.ChunkedSeries{{
+				Labels: series.Labels,
+				Chunks: chunks,
+			}},
+		}
+		data, err := resp.Marshal()
+		require.NoError(s.t, err)
+		_, err = writer.Write(data)
+		require.NoError(s.t, err)
+	}
+}
+
 // GetLastWriteRequest returns the last recorded write request.
 func (s *TestPromServer) GetLastWriteRequest() *prompb.WriteRequest {
 	s.mu.Lock()
@@ -84,6 +139,25 @@ func (s *TestPromServer) GetLastWriteRequest() *prompb.WriteRequest {
 	return s.lastWriteRequest
 }
 
+// GetLastReadRequest returns the last recorded read request.
+func (s *TestPromServer) GetLastReadRequest() *prompb.ReadRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastReadRequest
+}
+
+// SetReadSeries sets the series returned for all incoming read requests.
+func (s *TestPromServer) SetReadSeries(series []prompb.TimeSeries) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readSeries = series
+}
+
+// ReadAddr returns http address of a read endpoint.
+func (s *TestPromServer) ReadAddr() string {
+	return fmt.Sprintf("%s/read", s.svr.URL)
+}
+
 // WriteAddr returns http address of a write endpoint.
 func (s *TestPromServer) WriteAddr() string {
 	return fmt.Sprintf("%s/write", s.svr.URL)
@@ -102,6 +176,8 @@ func (s *TestPromServer) Reset() {
 	defer s.mu.Unlock()
 	s.respErr = nil
 	s.lastWriteRequest = nil
+	s.lastReadRequest = nil
+	s.readSeries = nil
 }
 
 // Close stops underlying http server.
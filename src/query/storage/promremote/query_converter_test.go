@@ -147,6 +147,57 @@ func TestEncodeWriteQuery(t *testing.T) {
 	assert.Contains(t, err.Error(), "received nil query")
 }
 
+func TestReadQueryConverter(t *testing.T) {
+	start := xtime.Now().Truncate(time.Hour)
+	end := start.Add(time.Hour)
+	hints := &prompb.ReadHints{Func: "series"}
+	req, err := convertReadQuery(&storage.FetchQuery{
+		TagMatchers: models.Matchers{
+			{Type: models.MatchEqual, Name: []byte("eq"), Value: []byte("a")},
+			{Type: models.MatchNotEqual, Name: []byte("neq"), Value: []byte("b")},
+			{Type: models.MatchRegexp, Name: []byte("re"), Value: []byte("c.*")},
+			{Type: models.MatchNotRegexp, Name: []byte("nre"), Value: []byte("d.*")},
+			{Type: models.MatchField, Name: []byte("field")},
+			{Type: models.MatchNotField, Name: []byte("notfield")},
+		},
+		Start: start.ToTime(),
+		End:   end.ToTime(),
+	}, hints)
+	require.NoError(t, err)
+
+	assert.Equal(t, &prompb.ReadRequest{
+		Queries: []*prompb.Query{{
+			StartTimestampMs: start.ToNormalizedTime(time.Millisecond),
+			EndTimestampMs:   end.ToNormalizedTime(time.Millisecond),
+			Matchers: []*prompb.LabelMatcher{
+				{Type: prompb.LabelMatcher_EQ, Name: "eq", Value: "a"},
+				{Type: prompb.LabelMatcher_NEQ, Name: "neq", Value: "b"},
+				{Type: prompb.LabelMatcher_RE, Name: "re", Value: "c.*"},
+				{Type: prompb.LabelMatcher_NRE, Name: "nre", Value: "d.*"},
+				{Type: prompb.LabelMatcher_NEQ, Name: "field", Value: ""},
+				{Type: prompb.LabelMatcher_EQ, Name: "notfield", Value: ""},
+			},
+			Hints: hints,
+		}},
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+			prompb.ReadRequest_SAMPLES,
+		},
+	}, req)
+
+	_, err = convertReadQuery(&storage.FetchQuery{
+		TagMatchers: models.Matchers{{Type: models.MatchAll}},
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestEncodeReadQuery(t *testing.T) {
+	data, err := convertAndEncodeReadQuery(nil, nil)
+	require.Error(t, err)
+	assert.Len(t, data, 0)
+	assert.Contains(t, err.Error(), "received nil query")
+}
+
 func promWriteRequest(ts prompb.TimeSeries) *prompb.WriteRequest {
 	return &prompb.WriteRequest{Timeseries: []prompb.TimeSeries{ts}}
 }
@@ -21,13 +21,16 @@
 package promremote
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
 	"github.com/prometheus/prometheus/prompb"
 
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 var errNilQuery = errors.New("received nil query")
@@ -75,3 +78,78 @@ func convertWriteQuery(query *storage.WriteQuery) *prompb.WriteRequest {
 		},
 	}
 }
+
+func convertAndEncodeReadQuery(
+	query *storage.FetchQuery,
+	hints *prompb.ReadHints,
+) ([]byte, error) {
+	if query == nil {
+		return nil, errNilQuery
+	}
+	promQuery, err := convertReadQuery(query, hints)
+	if err != nil {
+		return nil, err
+	}
+	data, err := promQuery.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, data), nil
+}
+
+func convertReadQuery(
+	query *storage.FetchQuery,
+	hints *prompb.ReadHints,
+) (*prompb.ReadRequest, error) {
+	matchers := make([]*prompb.LabelMatcher, 0, len(query.TagMatchers))
+	for _, matcher := range query.TagMatchers {
+		promMatcher, err := convertMatcher(matcher)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, promMatcher)
+	}
+
+	return &prompb.ReadRequest{
+		Queries: []*prompb.Query{
+			{
+				StartTimestampMs: storage.TimeToPromTimestamp(xtime.ToUnixNano(query.Start)),
+				EndTimestampMs:   storage.TimeToPromTimestamp(xtime.ToUnixNano(query.End)),
+				Matchers:         matchers,
+				Hints:            hints,
+			},
+		},
+		// NB: prefer streamed chunks, older servers only support samples.
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+			prompb.ReadRequest_SAMPLES,
+		},
+	}, nil
+}
+
+func convertMatcher(matcher models.Matcher) (*prompb.LabelMatcher, error) {
+	promMatcher := &prompb.LabelMatcher{
+		Name:  string(matcher.Name),
+		Value: string(matcher.Value),
+	}
+	switch matcher.Type {
+	case models.MatchEqual:
+		promMatcher.Type = prompb.LabelMatcher_EQ
+	case models.MatchNotEqual:
+		promMatcher.Type = prompb.LabelMatcher_NEQ
+	case models.MatchRegexp:
+		promMatcher.Type = prompb.LabelMatcher_RE
+	case models.MatchNotRegexp:
+		promMatcher.Type = prompb.LabelMatcher_NRE
+	case models.MatchField:
+		// NB: in Prometheus a label is present if it has a non empty value.
+		promMatcher.Type = prompb.LabelMatcher_NEQ
+		promMatcher.Value = ""
+	case models.MatchNotField:
+		promMatcher.Type = prompb.LabelMatcher_EQ
+		promMatcher.Value = ""
+	default:
+		return nil, fmt.Errorf("unsupported matcher type for remote read: %v", matcher.Type)
+	}
+	return promMatcher, nil
+}
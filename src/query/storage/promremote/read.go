@@ -0,0 +1,529 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promremote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	remoteReadVersion = "0.1.0"
+
+	// seriesHintFunc is the read hint Prometheus uses for series lookups,
+	// remotes backed by a TSDB return labels without chunks for it.
+	seriesHintFunc = "series"
+
+	streamedProtobufContentType = "application/x-streamed-protobuf"
+)
+
+var errNoReadEndpoints = errors.New("no endpoints are configured with a read address")
+
+type endpointReadResult struct {
+	endpoint EndpointOptions
+	series   []prompb.TimeSeries
+}
+
+func (p *promStorage) FetchProm(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (storage.PromResult, error) {
+	accumulator, err := p.FetchCompressed(ctx, query, options)
+	if err != nil {
+		return storage.PromResult{}, err
+	}
+
+	defer accumulator.Close()
+	result, attrs, err := accumulator.FinalResultWithAttrs()
+	if err != nil {
+		return storage.PromResult{}, err
+	}
+
+	resolutions := make([]time.Duration, 0, len(attrs))
+	for _, attr := range attrs {
+		resolutions = append(resolutions, attr.Resolution)
+	}
+
+	result.Metadata.Resolutions = resolutions
+	return storage.SeriesIteratorsToPromResult(ctx, result, nil,
+		p.tagOptions, storage.NewPromConvertOptions(), options)
+}
+
+func (p *promStorage) FetchCompressed(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (consolidators.MultiFetchResult, error) {
+	results, err := p.read(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		fanout    = consolidators.NamespaceCoversAllQueryRange
+		matchOpts = consolidators.MatchOptions{MatchType: consolidators.MatchIDs}
+		limitOpts = consolidators.LimitOptions{
+			Limit:             options.SeriesLimit,
+			RequireExhaustive: options.RequireExhaustive,
+		}
+
+		accumulator = consolidators.NewMultiFetchResult(fanout, matchOpts, p.tagOptions, limitOpts)
+		start       = xtime.ToUnixNano(query.Start)
+		end         = xtime.ToUnixNano(query.End)
+	)
+	for _, result := range results {
+		iters, err := p.toSeriesIterators(result, start, end)
+		if err != nil {
+			_ = accumulator.Close()
+			return nil, err
+		}
+		accumulator.Add(consolidators.MultiFetchResults{
+			SeriesIterators: iters,
+			Metadata:        block.NewResultMetadata(),
+			Attrs:           result.endpoint.attributes,
+		})
+	}
+	return accumulator, nil
+}
+
+func (p *promStorage) FetchBlocks(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (block.Result, error) {
+	accumulator, err := p.FetchCompressed(ctx, query, options)
+	if err != nil {
+		return block.Result{
+			Metadata: block.NewResultMetadata(),
+		}, err
+	}
+
+	// NB: the block result takes ownership of the series iterators so the
+	// accumulator is not closed, the same as the M3 storage.
+	result, err := accumulator.FinalResult()
+	if err != nil {
+		return block.Result{
+			Metadata: block.NewResultMetadata(),
+		}, err
+	}
+
+	opts := p.blockOpts.SetLookbackDuration(
+		options.LookbackDurationOrDefault(p.blockOpts.LookbackDuration()))
+	return m3.FetchResultToBlockResult(result, query, options, opts)
+}
+
+func (p *promStorage) SearchSeries(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) (*storage.SearchResults, error) {
+	results, err := p.read(ctx, query, seriesReadHints(query))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		metrics models.Metrics
+		seen    = make(map[string]struct{})
+	)
+	for _, result := range results {
+		for _, series := range result.series {
+			tags := p.toTags(series.Labels)
+			id := tags.ID()
+			if _, ok := seen[string(id)]; ok {
+				continue
+			}
+			seen[string(id)] = struct{}{}
+			metrics = append(metrics, models.Metric{ID: id, Tags: tags})
+		}
+	}
+
+	return &storage.SearchResults{
+		Metrics:  metrics,
+		Metadata: block.NewResultMetadata(),
+	}, nil
+}
+
+func (p *promStorage) CompleteTags(
+	ctx context.Context,
+	query *storage.CompleteTagsQuery,
+	_ *storage.FetchOptions,
+) (*consolidators.CompleteTagsResult, error) {
+	fetchQuery := &storage.FetchQuery{
+		TagMatchers: query.TagMatchers,
+		Start:       query.Start.ToTime(),
+		End:         query.End.ToTime(),
+	}
+	results, err := p.read(ctx, fetchQuery, seriesReadHints(fetchQuery))
+	if err != nil {
+		return nil, err
+	}
+
+	builder := consolidators.NewCompleteTagsResultBuilder(query.CompleteNameOnly, p.tagOptions)
+	for _, result := range results {
+		if err := builder.Add(toCompleteTagsResult(result.series, query)); err != nil {
+			return nil, err
+		}
+	}
+
+	completed := builder.Build()
+	return &completed, nil
+}
+
+func (p *promStorage) read(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	hints *prompb.ReadHints,
+) ([]endpointReadResult, error) {
+	encoded, err := convertAndEncodeReadQuery(query, hints)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg                        sync.WaitGroup
+		mu                        sync.Mutex
+		multiErr                  = xerrors.NewMultiError()
+		results                   []endpointReadResult
+		atLeastOneEndpointMatched = false
+	)
+	for _, endpoint := range p.opts.endpoints {
+		endpoint := endpoint
+		if endpoint.readAddress == "" {
+			continue
+		}
+
+		metrics := p.readEndpointMetrics[endpoint.name]
+		atLeastOneEndpointMatched = true
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			series, err := p.readSingle(ctx, metrics, endpoint.readAddress, bytes.NewReader(encoded))
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				return
+			}
+			results = append(results, endpointReadResult{
+				endpoint: endpoint,
+				series:   series,
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if !atLeastOneEndpointMatched {
+		return nil, errNoReadEndpoints
+	}
+	if err := multiErr.FinalError(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (p *promStorage) readSingle(
+	ctx context.Context,
+	metrics instrument.MethodMetrics,
+	address string,
+	encoded io.Reader,
+) ([]prompb.TimeSeries, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, encoded)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-encoding", "snappy")
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	req.Header.Set("X-Prometheus-Remote-Read-Version", remoteReadVersion)
+
+	start := time.Now()
+	resp, err := p.client.Do(req)
+	if err != nil {
+		metrics.ReportError(time.Since(start))
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		metrics.ReportError(time.Since(start))
+		return nil, p.responseError(resp, address)
+	}
+
+	var series []prompb.TimeSeries
+	if strings.HasPrefix(resp.Header.Get(xhttp.HeaderContentType), streamedProtobufContentType) {
+		series, err = decodeChunkedReadResponse(resp.Body)
+	} else {
+		series, err = decodeReadResponse(resp.Body)
+	}
+	if err != nil {
+		metrics.ReportError(time.Since(start))
+		return nil, fmt.Errorf("unable to decode remote read response: address=%v, err=%w", address, err)
+	}
+	metrics.ReportSuccess(time.Since(start))
+	return series, nil
+}
+
+func decodeReadResponse(r io.Reader) ([]prompb.TimeSeries, error) {
+	compressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, err
+	}
+	var resp prompb.ReadResponse
+	if err := resp.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	var series []prompb.TimeSeries
+	for _, result := range resp.Results {
+		for _, s := range result.Timeseries {
+			series = append(series, *s)
+		}
+	}
+	return series, nil
+}
+
+func decodeChunkedReadResponse(r io.Reader) ([]prompb.TimeSeries, error) {
+	var (
+		reader = remote.NewChunkedReader(r, remote.DefaultChunkedReadLimit, nil)
+		series []prompb.TimeSeries
+	)
+	for {
+		var resp prompb.ChunkedReadResponse
+		if err := reader.NextProto(&resp); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		for _, chunked := range resp.ChunkedSeries {
+			samples, err := decodeChunks(chunked.Chunks)
+			if err != nil {
+				return nil, err
+			}
+			// NB: large series are split into several frames that repeat
+			// the series labels.
+			if n := len(series); n > 0 && labelsEqual(series[n-1].Labels, chunked.Labels) {
+				series[n-1].Samples = append(series[n-1].Samples, samples...)
+				continue
+			}
+			series = append(series, prompb.TimeSeries{
+				Labels:  chunked.Labels,
+				Samples: samples,
+			})
+		}
+	}
+
+	for i := range series {
+		series[i].Samples = sortAndDedupeSamples(series[i].Samples)
+	}
+	return series, nil
+}
+
+func decodeChunks(chunks []prompb.Chunk) ([]prompb.Sample, error) {
+	var samples []prompb.Sample
+	for _, chunk := range chunks {
+		if chunk.Type != prompb.Chunk_XOR {
+			return nil, fmt.Errorf("unsupported chunk encoding: %v", chunk.Type)
+		}
+		decoded, err := chunkenc.FromData(chunkenc.EncXOR, chunk.Data)
+		if err != nil {
+			return nil, err
+		}
+		iter := decoded.Iterator(nil)
+		for iter.Next() {
+			t, v := iter.At()
+			samples = append(samples, prompb.Sample{Timestamp: t, Value: v})
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return samples, nil
+}
+
+// sortAndDedupeSamples orders samples by time, chunks of a series are
+// returned in start time order but are allowed to overlap.
+func sortAndDedupeSamples(samples []prompb.Sample) []prompb.Sample {
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].Timestamp < samples[j].Timestamp
+	})
+	deduped := samples[:0]
+	for _, sample := range samples {
+		if n := len(deduped); n > 0 && deduped[n-1].Timestamp == sample.Timestamp {
+			continue
+		}
+		deduped = append(deduped, sample)
+	}
+	return deduped
+}
+
+func labelsEqual(a, b []prompb.Label) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *promStorage) toSeriesIterators(
+	result endpointReadResult,
+	start, end xtime.UnixNano,
+) (encoding.SeriesIterators, error) {
+	var (
+		nsID         = ident.StringID(result.endpoint.name)
+		encodingOpts = encoding.NewOptions()
+		iterAlloc    = m3tsz.DefaultReaderIteratorAllocFn(encodingOpts)
+		iters        = make([]encoding.SeriesIterator, 0, len(result.series))
+	)
+	for _, series := range result.series {
+		encoder := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		for _, sample := range series.Samples {
+			timestamp := xtime.FromNormalizedTime(sample.Timestamp, time.Millisecond)
+			if timestamp.Before(start) || !timestamp.Before(end) {
+				continue
+			}
+			dp := ts.Datapoint{TimestampNanos: timestamp, Value: sample.Value}
+			if err := encoder.Encode(dp, xtime.Millisecond, nil); err != nil {
+				encoder.Close()
+				encoding.NewSeriesIterators(iters).Close()
+				return nil, err
+			}
+		}
+		if encoder.NumEncoded() == 0 {
+			encoder.Close()
+			continue
+		}
+
+		reader := xio.BlockReader{
+			SegmentReader: xio.NewSegmentReader(encoder.Discard()),
+			Start:         start,
+			BlockSize:     end.Sub(start),
+		}
+		replica := encoding.NewMultiReaderIterator(iterAlloc, nil)
+		replica.ResetSliceOfSlices(
+			xio.NewReaderSliceOfSlicesFromBlockReadersIterator([][]xio.BlockReader{{reader}}), nil)
+
+		tags := p.toTags(series.Labels)
+		iters = append(iters, encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+			ID:             ident.BytesID(tags.ID()),
+			Namespace:      nsID,
+			Tags:           storage.TagsToIdentTagIterator(tags),
+			StartInclusive: start,
+			EndExclusive:   end,
+			Replicas:       []encoding.MultiReaderIterator{replica},
+		}, nil))
+	}
+	return encoding.NewSeriesIterators(iters), nil
+}
+
+func (p *promStorage) toTags(labels []prompb.Label) models.Tags {
+	tags := models.NewTags(len(labels), p.tagOptions)
+	for _, label := range labels {
+		tags = tags.AddTag(models.Tag{
+			Name:  []byte(label.Name),
+			Value: []byte(label.Value),
+		})
+	}
+	return tags
+}
+
+func seriesReadHints(query *storage.FetchQuery) *prompb.ReadHints {
+	return &prompb.ReadHints{
+		Func:    seriesHintFunc,
+		StartMs: storage.TimeToPromTimestamp(xtime.ToUnixNano(query.Start)),
+		EndMs:   storage.TimeToPromTimestamp(xtime.ToUnixNano(query.End)),
+	}
+}
+
+func toCompleteTagsResult(
+	series []prompb.TimeSeries,
+	query *storage.CompleteTagsQuery,
+) *consolidators.CompleteTagsResult {
+	filter := make(map[string]struct{}, len(query.FilterNameTags))
+	for _, name := range query.FilterNameTags {
+		filter[string(name)] = struct{}{}
+	}
+
+	values := make(map[string][][]byte)
+	for _, s := range series {
+		for _, label := range s.Labels {
+			if _, ok := filter[label.Name]; len(filter) > 0 && !ok {
+				continue
+			}
+			values[label.Name] = append(values[label.Name], []byte(label.Value))
+		}
+	}
+
+	tags := make([]consolidators.CompletedTag, 0, len(values))
+	for name, tagValues := range values {
+		tag := consolidators.CompletedTag{Name: []byte(name)}
+		if !query.CompleteNameOnly {
+			tag.Values = tagValues
+		}
+		tags = append(tags, tag)
+	}
+
+	return &consolidators.CompleteTagsResult{
+		CompleteNameOnly: query.CompleteNameOnly,
+		CompletedTags:    tags,
+		Metadata:         block.NewResultMetadata(),
+	}
+}
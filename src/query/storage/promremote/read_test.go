@@ -0,0 +1,326 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promremote
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/promremote/promremotetest"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/tallytest"
+)
+
+func newReadTestStorage(t *testing.T, endpoints ...EndpointOptions) storage.Storage {
+	promStorage, err := NewStorage(Options{
+		endpoints: endpoints,
+		scope:     scope,
+		logger:    logger,
+	})
+	require.NoError(t, err)
+	return promStorage
+}
+
+func newReadTestQuery(start, end time.Time) *storage.FetchQuery {
+	return &storage.FetchQuery{
+		TagMatchers: models.Matchers{{
+			Type:  models.MatchEqual,
+			Name:  []byte("__name__"),
+			Value: []byte("up"),
+		}},
+		Start: start,
+		End:   end,
+	}
+}
+
+func newReadTestFetchOptions() *storage.FetchOptions {
+	opts := storage.NewFetchOptions()
+	opts.SeriesLimit = 100
+	return opts
+}
+
+func newReadTestSeries(job string, start time.Time, values ...float64) prompb.TimeSeries {
+	series := prompb.TimeSeries{
+		Labels: []prompb.Label{
+			{Name: "__name__", Value: "up"},
+			{Name: "job", Value: job},
+		},
+	}
+	for i, v := range values {
+		series.Samples = append(series.Samples, prompb.Sample{
+			Timestamp: start.Add(time.Duration(i)*time.Second).UnixNano() / int64(time.Millisecond),
+			Value:     v,
+		})
+	}
+	return series
+}
+
+func TestFetchProm(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+
+	promStorage := newReadTestStorage(t, EndpointOptions{
+		name:        "testEndpoint",
+		address:     fakeProm.WriteAddr(),
+		readAddress: fakeProm.ReadAddr(),
+	})
+	defer closeWithCheck(t, promStorage)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(time.Minute)
+	fakeProm.SetReadSeries([]prompb.TimeSeries{
+		newReadTestSeries("a", start, 1, 2, 3),
+		newReadTestSeries("b", start, 4),
+	})
+
+	result, err := promStorage.FetchProm(context.TODO(), newReadTestQuery(start, end),
+		newReadTestFetchOptions())
+	require.NoError(t, err)
+
+	readReq := fakeProm.GetLastReadRequest()
+	require.Len(t, readReq.Queries, 1)
+	assert.Equal(t, start.UnixNano()/int64(time.Millisecond), readReq.Queries[0].StartTimestampMs)
+	assert.Equal(t, end.UnixNano()/int64(time.Millisecond), readReq.Queries[0].EndTimestampMs)
+	assert.Equal(t, []*prompb.LabelMatcher{
+		{Type: prompb.LabelMatcher_EQ, Name: "__name__", Value: "up"},
+	}, readReq.Queries[0].Matchers)
+	assert.Equal(t, prompb.ReadRequest_STREAMED_XOR_CHUNKS, readReq.AcceptedResponseTypes[0])
+
+	series := result.PromResult.Timeseries
+	require.Len(t, series, 2)
+	values := make(map[string][]float64, len(series))
+	for _, s := range series {
+		var job string
+		for _, l := range s.Labels {
+			if string(l.Name) == "job" {
+				job = string(l.Value)
+			}
+		}
+		for _, sample := range s.Samples {
+			values[job] = append(values[job], sample.Value)
+		}
+	}
+	assert.Equal(t, map[string][]float64{
+		"a": {1, 2, 3},
+		"b": {4},
+	}, values)
+
+	tallytest.AssertCounterValue(
+		t, 1, scope.Snapshot(), "test_scope.prom_remote_storage.readSingle.success",
+		map[string]string{"endpoint_name": "testEndpoint"},
+	)
+}
+
+func TestFetchCompressedFiltersSamplesOutsideRange(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+
+	promStorage := newReadTestStorage(t, EndpointOptions{
+		name:        "testEndpoint",
+		readAddress: fakeProm.ReadAddr(),
+	})
+	defer closeWithCheck(t, promStorage)
+
+	start := time.Now().Truncate(time.Hour)
+	fakeProm.SetReadSeries([]prompb.TimeSeries{
+		newReadTestSeries("a", start.Add(-time.Second), 1, 2, 3),
+		newReadTestSeries("b", start.Add(time.Hour), 4),
+	})
+
+	result, err := promStorage.FetchCompressed(context.TODO(),
+		newReadTestQuery(start, start.Add(time.Minute)), newReadTestFetchOptions())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, result.Close()) }()
+
+	fetchResult, err := result.FinalResult()
+	require.NoError(t, err)
+
+	iters := fetchResult.SeriesIterators()
+	require.Len(t, iters, 1)
+	var values []float64
+	for iters[0].Next() {
+		dp, _, _ := iters[0].Current()
+		values = append(values, dp.Value)
+	}
+	require.NoError(t, iters[0].Err())
+	assert.Equal(t, []float64{2, 3}, values)
+}
+
+func TestFetchBlocks(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+
+	promStorage := newReadTestStorage(t, EndpointOptions{
+		name:        "testEndpoint",
+		readAddress: fakeProm.ReadAddr(),
+	})
+	defer closeWithCheck(t, promStorage)
+
+	start := time.Now().Truncate(time.Hour)
+	fakeProm.SetReadSeries([]prompb.TimeSeries{
+		newReadTestSeries("a", start, 1, 2, 3),
+	})
+
+	query := newReadTestQuery(start, start.Add(3*time.Second))
+	query.Interval = time.Second
+	result, err := promStorage.FetchBlocks(context.TODO(), query,
+		newReadTestFetchOptions())
+	require.NoError(t, err)
+	require.Len(t, result.Blocks, 1)
+
+	bl := result.Blocks[0]
+	defer func() { require.NoError(t, bl.Close()) }()
+	iter, err := bl.SeriesIter()
+	require.NoError(t, err)
+	require.True(t, iter.Next())
+	assert.Equal(t, []float64{1, 2, 3}, iter.Current().Datapoints().Values())
+	assert.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestSearchSeries(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+	fakeProm2 := promremotetest.NewServer(t)
+	defer fakeProm2.Close()
+
+	promStorage := newReadTestStorage(t,
+		EndpointOptions{name: "first", readAddress: fakeProm.ReadAddr()},
+		EndpointOptions{name: "second", readAddress: fakeProm2.ReadAddr()},
+		EndpointOptions{name: "writeOnly", address: fakeProm.WriteAddr()},
+	)
+	defer closeWithCheck(t, promStorage)
+
+	start := time.Now().Truncate(time.Hour)
+	fakeProm.SetReadSeries([]prompb.TimeSeries{newReadTestSeries("a", start)})
+	fakeProm2.SetReadSeries([]prompb.TimeSeries{
+		newReadTestSeries("a", start),
+		newReadTestSeries("b", start),
+	})
+
+	result, err := promStorage.SearchSeries(context.TODO(),
+		newReadTestQuery(start, start.Add(time.Minute)), newReadTestFetchOptions())
+	require.NoError(t, err)
+
+	jobs := make([]string, 0, len(result.Metrics))
+	for _, metric := range result.Metrics {
+		job, ok := metric.Tags.Get([]byte("job"))
+		require.True(t, ok)
+		jobs = append(jobs, string(job))
+	}
+	sort.Strings(jobs)
+	assert.Equal(t, []string{"a", "b"}, jobs)
+
+	hints := fakeProm.GetLastReadRequest().Queries[0].Hints
+	require.NotNil(t, hints)
+	assert.Equal(t, "series", hints.Func)
+}
+
+func TestCompleteTags(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+
+	promStorage := newReadTestStorage(t, EndpointOptions{
+		name:        "testEndpoint",
+		readAddress: fakeProm.ReadAddr(),
+	})
+	defer closeWithCheck(t, promStorage)
+
+	start := time.Now().Truncate(time.Hour)
+	fakeProm.SetReadSeries([]prompb.TimeSeries{
+		newReadTestSeries("a", start),
+		newReadTestSeries("b", start),
+	})
+
+	query := &storage.CompleteTagsQuery{
+		TagMatchers:    newReadTestQuery(start, start).TagMatchers,
+		FilterNameTags: [][]byte{[]byte("job")},
+	}
+	result, err := promStorage.CompleteTags(context.TODO(), query, newReadTestFetchOptions())
+	require.NoError(t, err)
+	require.Len(t, result.CompletedTags, 1)
+	assert.Equal(t, "job", string(result.CompletedTags[0].Name))
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, result.CompletedTags[0].Values)
+
+	query.CompleteNameOnly = true
+	query.FilterNameTags = nil
+	result, err = promStorage.CompleteTags(context.TODO(), query, newReadTestFetchOptions())
+	require.NoError(t, err)
+	require.Len(t, result.CompletedTags, 2)
+	assert.Equal(t, "__name__", string(result.CompletedTags[0].Name))
+	assert.Equal(t, "job", string(result.CompletedTags[1].Name))
+}
+
+func TestReadErrorHandling(t *testing.T) {
+	fakeProm := promremotetest.NewServer(t)
+	defer fakeProm.Close()
+
+	start := time.Now().Truncate(time.Hour)
+	query := newReadTestQuery(start, start.Add(time.Minute))
+
+	t.Run("no read endpoints", func(t *testing.T) {
+		promStorage := newReadTestStorage(t, EndpointOptions{
+			name:    "testEndpoint",
+			address: fakeProm.WriteAddr(),
+		})
+		defer closeWithCheck(t, promStorage)
+
+		_, err := promStorage.FetchProm(context.TODO(), query, newReadTestFetchOptions())
+		assert.Equal(t, errNoReadEndpoints, err)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		defer fakeProm.Reset()
+		fakeProm.SetError("bad request", http.StatusBadRequest)
+		promStorage := newReadTestStorage(t, EndpointOptions{
+			name:        "testEndpoint",
+			readAddress: fakeProm.ReadAddr(),
+		})
+		defer closeWithCheck(t, promStorage)
+
+		_, err := promStorage.FetchProm(context.TODO(), query, newReadTestFetchOptions())
+		require.Error(t, err)
+		assert.True(t, xerrors.IsInvalidParams(err))
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		defer fakeProm.Reset()
+		fakeProm.SetError("internal error", http.StatusInternalServerError)
+		promStorage := newReadTestStorage(t, EndpointOptions{
+			name:        "testEndpoint",
+			readAddress: fakeProm.ReadAddr(),
+		})
+		defer closeWithCheck(t, promStorage)
+
+		_, err := promStorage.SearchSeries(context.TODO(), query, newReadTestFetchOptions())
+		require.Error(t, err)
+		assert.False(t, xerrors.IsInvalidParams(err))
+	})
+}
@@ -34,9 +34,10 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 
-	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
-	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/instrument"
@@ -49,27 +50,36 @@ var errorReadingBody = []byte("error reading body")
 
 var errNoEndpoints = errors.New("write did not match any of known endpoints")
 
-// NewStorage returns new Prometheus remote write compatible storage
+// NewStorage returns new Prometheus remote write and remote read compatible storage
 func NewStorage(opts Options) (storage.Storage, error) {
 	client := xhttp.NewHTTPClient(opts.httpOptions)
 	scope := opts.scope.SubScope(metricsScope)
+	tagOptions := opts.tagOptions
+	if tagOptions == nil {
+		tagOptions = models.NewTagOptions()
+	}
 	s := &promStorage{
-		opts:            opts,
-		client:          client,
-		endpointMetrics: initEndpointMetrics(opts.endpoints, scope),
-		droppedWrites:   scope.Counter("dropped_writes"),
-		logger:          opts.logger,
+		opts:                opts,
+		client:              client,
+		endpointMetrics:     initEndpointMetrics(opts.endpoints, scope, "writeSingle"),
+		readEndpointMetrics: initEndpointMetrics(opts.endpoints, scope, "readSingle"),
+		droppedWrites:       scope.Counter("dropped_writes"),
+		tagOptions:          tagOptions,
+		blockOpts:           m3.NewOptions(encoding.NewOptions()).SetTagOptions(tagOptions),
+		logger:              opts.logger,
 	}
 	return s, nil
 }
 
 type promStorage struct {
-	unimplementedPromStorageMethods
-	opts            Options
-	client          *http.Client
-	endpointMetrics map[string]instrument.MethodMetrics
-	droppedWrites   tally.Counter
-	logger          *zap.Logger
+	opts                Options
+	client              *http.Client
+	endpointMetrics     map[string]instrument.MethodMetrics
+	readEndpointMetrics map[string]instrument.MethodMetrics
+	droppedWrites       tally.Counter
+	tagOptions          models.TagOptions
+	blockOpts           m3.Options
+	logger              *zap.Logger
 }
 
 func (p *promStorage) Write(ctx context.Context, query *storage.WriteQuery) error {
@@ -84,6 +94,10 @@ func (p *promStorage) Write(ctx context.Context, query *storage.WriteQuery) erro
 	atLeastOneEndpointMatched := false
 	for _, endpoint := range p.opts.endpoints {
 		endpoint := endpoint
+		if endpoint.address == "" {
+			// NB: the endpoint is only queried for reads.
+			continue
+		}
 		if endpoint.attributes.Resolution != query.Attributes().Resolution ||
 			endpoint.attributes.Retention != query.Attributes().Retention {
 			continue
@@ -119,6 +133,23 @@ func (p *promStorage) Write(ctx context.Context, query *storage.WriteQuery) erro
 	return multiErr.FinalError()
 }
 
+// QueryStorageMetadataAttributes returns the attributes of the endpoints
+// queried for reads.
+func (p *promStorage) QueryStorageMetadataAttributes(
+	_ context.Context,
+	_, _ time.Time,
+	_ *storage.FetchOptions,
+) ([]storagemetadata.Attributes, error) {
+	attrs := make([]storagemetadata.Attributes, 0, len(p.opts.endpoints))
+	for _, endpoint := range p.opts.endpoints {
+		if endpoint.readAddress == "" {
+			continue
+		}
+		attrs = append(attrs, endpoint.attributes)
+	}
+	return attrs, nil
+}
+
 func (p *promStorage) Type() storage.Type {
 	return storage.TypeRemoteDC
 }
@@ -159,29 +190,37 @@ func (p *promStorage) writeSingle(
 	defer func() { _ = resp.Body.Close() }()
 	if resp.StatusCode/100 != 2 {
 		metrics.ReportError(methodDuration)
-		response, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			p.logger.Error("error reading body", zap.Error(err))
-			response = errorReadingBody
-		}
-		genericError := fmt.Errorf(
-			"expected status code 2XX: actual=%v, address=%v, resp=%s",
-			resp.StatusCode, address, response,
-		)
-		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
-			return xerrors.NewInvalidParamsError(genericError)
-		}
-		return genericError
+		return p.responseError(resp, address)
 	}
 	metrics.ReportSuccess(methodDuration)
 	return nil
 }
 
-func initEndpointMetrics(endpoints []EndpointOptions, scope tally.Scope) map[string]instrument.MethodMetrics {
+func (p *promStorage) responseError(resp *http.Response, address string) error {
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		p.logger.Error("error reading body", zap.Error(err))
+		response = errorReadingBody
+	}
+	genericError := fmt.Errorf(
+		"expected status code 2XX: actual=%v, address=%v, resp=%s",
+		resp.StatusCode, address, response,
+	)
+	if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return xerrors.NewInvalidParamsError(genericError)
+	}
+	return genericError
+}
+
+func initEndpointMetrics(
+	endpoints []EndpointOptions,
+	scope tally.Scope,
+	method string,
+) map[string]instrument.MethodMetrics {
 	metrics := make(map[string]instrument.MethodMetrics, len(endpoints))
 	for _, endpoint := range endpoints {
 		endpointScope := scope.Tagged(map[string]string{"endpoint_name": endpoint.name})
-		methodMetrics := instrument.NewMethodMetrics(endpointScope, "writeSingle", instrument.TimerOptions{
+		methodMetrics := instrument.NewMethodMetrics(endpointScope, method, instrument.TimerOptions{
 			Type:             instrument.HistogramTimerType,
 			HistogramBuckets: tally.DefaultBuckets,
 		})
@@ -191,57 +230,3 @@ func initEndpointMetrics(endpoints []EndpointOptions, scope tally.Scope) map[str
 }
 
 var _ storage.Storage = &promStorage{}
-
-type unimplementedPromStorageMethods struct{}
-
-func (p *unimplementedPromStorageMethods) FetchProm(
-	_ context.Context,
-	_ *storage.FetchQuery,
-	_ *storage.FetchOptions,
-) (storage.PromResult, error) {
-	return storage.PromResult{}, unimplementedError("FetchProm")
-}
-
-func (p *unimplementedPromStorageMethods) FetchBlocks(
-	_ context.Context,
-	_ *storage.FetchQuery,
-	_ *storage.FetchOptions,
-) (block.Result, error) {
-	return block.Result{}, unimplementedError("FetchBlocks")
-}
-
-func (p *unimplementedPromStorageMethods) FetchCompressed(
-	_ context.Context,
-	_ *storage.FetchQuery,
-	_ *storage.FetchOptions,
-) (consolidators.MultiFetchResult, error) {
-	return nil, unimplementedError("FetchCompressed")
-}
-
-func (p *unimplementedPromStorageMethods) SearchSeries(
-	_ context.Context,
-	_ *storage.FetchQuery,
-	_ *storage.FetchOptions,
-) (*storage.SearchResults, error) {
-	return nil, unimplementedError("SearchSeries")
-}
-
-func (p *unimplementedPromStorageMethods) CompleteTags(
-	_ context.Context,
-	_ *storage.CompleteTagsQuery,
-	_ *storage.FetchOptions,
-) (*consolidators.CompleteTagsResult, error) {
-	return nil, unimplementedError("CompleteTags")
-}
-
-func (p *unimplementedPromStorageMethods) QueryStorageMetadataAttributes(
-	_ context.Context,
-	_, _ time.Time,
-	_ *storage.FetchOptions,
-) ([]storagemetadata.Attributes, error) {
-	return nil, unimplementedError("QueryStorageMetadataAttributes")
-}
-
-func unimplementedError(name string) error {
-	return fmt.Errorf("promStorage: %s method is not supported", name)
-}
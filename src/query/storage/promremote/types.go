@@ -18,7 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-// Package promremote implements storage interface backed by Prometheus remote write capable endpoints.
+// Package promremote implements storage interface backed by Prometheus remote write
+// and remote read capable endpoints.
 package promremote
 
 import (
@@ -26,6 +27,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/x/ident"
@@ -36,6 +38,7 @@ import (
 type Options struct {
 	endpoints   []EndpointOptions
 	httpOptions xhttp.HTTPClientOptions
+	tagOptions  models.TagOptions
 	scope       tally.Scope
 	logger      *zap.Logger
 }
@@ -53,6 +56,7 @@ func (o Options) Namespaces() m3.ClusterNamespaces {
 type EndpointOptions struct {
 	name              string
 	address           string
+	readAddress       string
 	attributes        storagemetadata.Attributes
 	downsampleOptions *m3.ClusterNamespaceDownsampleOptions
 }
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber-go/tally"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/metrics/policy"
@@ -37,6 +38,7 @@ import (
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/histogram"
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
@@ -151,10 +153,79 @@ type FetchOptions struct {
 	IterateEqualTimestampStrategy *encoding.IterateEqualTimestampStrategy
 	// Source is the source for the query.
 	Source []byte
+	// NativeHistogramProjection projects native histogram samples to
+	// float values when fetching.
+	NativeHistogramProjection NativeHistogramProjection
 
 	RelatedQueryOptions *RelatedQueryOptions
 }
 
+// NativeHistogramProjectionType is a type of native histogram projection.
+type NativeHistogramProjectionType uint
+
+const (
+	// NoNativeHistogramProjection leaves native histogram samples as is,
+	// their value is the count of observations.
+	NoNativeHistogramProjection NativeHistogramProjectionType = iota
+	// NativeHistogramCountProjection projects native histogram samples to
+	// their count of observations.
+	NativeHistogramCountProjection
+	// NativeHistogramSumProjection projects native histogram samples to
+	// their sum of observations.
+	NativeHistogramSumProjection
+	// NativeHistogramQuantileProjection projects native histogram samples to
+	// the estimated value at a quantile.
+	NativeHistogramQuantileProjection
+	// NativeHistogramRejectProjection fails fetches that return native
+	// histogram samples, it is used for the series of native histogram
+	// functions that are not applied directly to a vector selector since
+	// those cannot be projected and would be evaluated over the counts.
+	NativeHistogramRejectProjection
+)
+
+// NativeHistogramProjection describes how native histogram samples, and
+// samples of aggregated timers carrying a sketch, are projected to float
+// values when fetching. Other samples are projected to NaN.
+type NativeHistogramProjection struct {
+	// Type is the type of the projection.
+	Type NativeHistogramProjectionType
+	// Quantile is the quantile used by quantile projections.
+	Quantile float64
+}
+
+// Enabled returns true if native histogram samples should be projected.
+func (p NativeHistogramProjection) Enabled() bool {
+	return p.Type != NoNativeHistogramProjection
+}
+
+// Project returns the value of the given histogram.
+func (p NativeHistogramProjection) Project(h *histogram.Histogram) float64 {
+	switch p.Type {
+	case NativeHistogramCountProjection:
+		return h.Count
+	case NativeHistogramSumProjection:
+		return h.Sum
+	case NativeHistogramQuantileProjection:
+		return h.Quantile(p.Quantile)
+	default:
+		return h.Count
+	}
+}
+
+// ProjectTimerSketch returns the value of the given timer sketch.
+func (p NativeHistogramProjection) ProjectTimerSketch(s *ddsketch.Sketch) float64 {
+	switch p.Type {
+	case NativeHistogramCountProjection:
+		return s.Count()
+	case NativeHistogramSumProjection:
+		return s.Sum()
+	case NativeHistogramQuantileProjection:
+		return s.Quantile(p.Quantile)
+	default:
+		return s.Count()
+	}
+}
+
 // QueryTimespan represents the start and end time of a query
 type QueryTimespan struct {
 	Start xtime.UnixNano
@@ -310,6 +381,9 @@ type CompleteTagsQuery struct {
 	Start xtime.UnixNano
 	// End is the exclusive end for the query.
 	End xtime.UnixNano
+	// IncludeDocsCount indicates if the query should also count the series
+	// matching each of the completed tag values.
+	IncludeDocsCount bool
 }
 
 // SeriesMatchQuery represents a query that returns a set of series
@@ -34,6 +34,10 @@ const (
 
 	// M3MetricTypeTimer is the timer metric type.
 	M3MetricTypeTimer
+
+	// M3MetricTypeHistogram is the native histogram metric type, the
+	// histogram of each sample is carried in the annotation.
+	M3MetricTypeHistogram
 )
 
 // PromMetricType is the enum for Prometheus metric types.
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import "errors"
+
+var errKeyProviderNotConfigured = errors.New("encryption must set a key provider")
+
+// Configuration is the configuration of the encryption of files at rest.
+type Configuration struct {
+	// KeyFile configures key encryption keys read from local files.
+	KeyFile *KeyFileConfiguration `yaml:"keyFile"`
+}
+
+// NewKeyProvider returns a new key provider from the configuration.
+func (c Configuration) NewKeyProvider() (KeyProvider, error) {
+	if c.KeyFile == nil {
+		return nil, errKeyProviderNotConfigured
+	}
+	return NewKeyFileProvider(c.KeyFile.Path, c.KeyFile.PreviousPaths...)
+}
+
+// KeyFileConfiguration is the configuration of key encryption keys read
+// from local files.
+type KeyFileConfiguration struct {
+	// Path is the path of the file holding the key new files are
+	// encrypted with.
+	Path string `yaml:"path" validate:"nonzero"`
+
+	// PreviousPaths are the paths of the files holding keys that were
+	// rotated out, they are only used to decrypt existing files.
+	PreviousPaths []string `yaml:"previousPaths"`
+}
@@ -0,0 +1,513 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package encryption implements the envelope encryption of files at rest.
+//
+// Each file is encrypted with AES-256-GCM using a data key of its own, the
+// data key is wrapped by a KeyProvider and stored in the header of the file.
+// The contents of a file are split into chunks that are sealed separately so
+// that files can be both written and read as streams, and read at any offset.
+//
+// An encrypted file is laid out as follows, integers are big endian:
+//
+//	magic (8 bytes) | version (1 byte) | chunk size (4 bytes) |
+//	key ID length (2 bytes) | key ID | wrapped key length (2 bytes) | wrapped key |
+//	chunks...
+//
+// Each chunk holds its plaintext length (4 bytes) followed by its sealed
+// contents. The nonce of a chunk is its index in the file, and the plaintext
+// length is authenticated along with the contents.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+)
+
+const (
+	formatVersion = 1
+
+	// defaultChunkSize is small so that reading a few bytes at an
+	// offset, as the seeker does, only requires decrypting a few chunks.
+	defaultChunkSize = 4096
+	maxChunkSize     = 16 << 20
+
+	dataKeyLen     = 32
+	chunkLenSize   = 4
+	fixedHeaderLen = 8 + 1 + 4 + 2
+
+	// readAheadChunks is the number of chunks read at once when
+	// reading at an offset.
+	readAheadChunks = 16
+)
+
+var (
+	magic = []byte("\x89M3ENC\r\n")
+
+	endianness = binary.BigEndian
+
+	errNotEncrypted      = errors.New("file is not encrypted")
+	errCorruptHeader     = errors.New("encrypted file header is corrupt")
+	errCorruptChunk      = errors.New("encrypted file chunk is corrupt")
+	errNegativeOffset    = errors.New("negative offset")
+	errKeyIDTooLong      = errors.New("key ID is too long")
+	errWrappedKeyTooLong = errors.New("wrapped key is too long")
+)
+
+// IsEncrypted returns whether a file was written by a Writer.
+func IsEncrypted(r io.ReaderAt) (bool, error) {
+	buf := make([]byte, len(magic))
+	n, err := r.ReadAt(buf, 0)
+	if n < len(buf) {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+	return string(buf) == string(magic), nil
+}
+
+// Writer encrypts the contents written to it with a new data key and writes
+// them to an underlying writer.
+type Writer struct {
+	w         io.Writer
+	aead      cipher.AEAD
+	chunkSize int
+	chunk     uint64
+	nonce     []byte
+	lenBuf    [chunkLenSize]byte
+	buf       []byte
+	sealed    []byte
+}
+
+// NewWriter returns a new writer that encrypts with a new data key wrapped by
+// the key provider, the header of the file is written to w immediately.
+func NewWriter(w io.Writer, keyProvider KeyProvider) (*Writer, error) {
+	dataKey := make([]byte, dataKeyLen)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("could not generate data key: %v", err)
+	}
+	keyID, wrappedKey, err := keyProvider.WrapKey(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not wrap data key: %v", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	h := header{
+		chunkSize:  defaultChunkSize,
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+	}
+	headerBytes, err := h.encode()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(headerBytes); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		w:         w,
+		aead:      aead,
+		chunkSize: h.chunkSize,
+		nonce:     make([]byte, aead.NonceSize()),
+		buf:       make([]byte, 0, h.chunkSize),
+		sealed:    make([]byte, 0, chunkLenSize+h.chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Write encrypts and writes the bytes, buffering them until a full chunk
+// can be written.
+func (w *Writer) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buf[len(w.buf):w.chunkSize], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+		if len(w.buf) == w.chunkSize {
+			if err := w.writeChunk(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Flush writes the buffered bytes as a chunk shorter than the chunk size.
+// Files that were flushed before being closed can only be read with a
+// Reader, not a ReaderAt.
+func (w *Writer) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	return w.writeChunk()
+}
+
+// Close writes the buffered bytes, it does not close the underlying writer.
+func (w *Writer) Close() error {
+	return w.Flush()
+}
+
+func (w *Writer) writeChunk() error {
+	endianness.PutUint32(w.lenBuf[:], uint32(len(w.buf)))
+	setChunkNonce(w.nonce, w.chunk)
+	w.sealed = append(w.sealed[:0], w.lenBuf[:]...)
+	w.sealed = w.aead.Seal(w.sealed, w.nonce, w.buf, w.lenBuf[:])
+	if _, err := w.w.Write(w.sealed); err != nil {
+		return err
+	}
+	w.chunk++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// Reader decrypts a file written by a Writer as a stream.
+type Reader struct {
+	r         io.Reader
+	aead      cipher.AEAD
+	chunkSize int
+	chunk     uint64
+	nonce     []byte
+	lenBuf    [chunkLenSize]byte
+	sealed    []byte
+	plain     []byte
+	pos       int
+}
+
+// NewReader returns a new reader that decrypts the file read from r, which
+// must be positioned at the start of the file.
+func NewReader(r io.Reader, keyProvider KeyProvider) (*Reader, error) {
+	h, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := h.aead(keyProvider)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		r:         r,
+		aead:      aead,
+		chunkSize: h.chunkSize,
+		nonce:     make([]byte, aead.NonceSize()),
+		sealed:    make([]byte, h.chunkSize+aead.Overhead()),
+		plain:     make([]byte, 0, h.chunkSize),
+	}, nil
+}
+
+// Read reads decrypted bytes, it returns io.EOF at the end of the file and
+// io.ErrUnexpectedEOF if the file ends within a chunk.
+func (r *Reader) Read(p []byte) (int, error) {
+	for r.pos == len(r.plain) {
+		if err := r.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plain[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+func (r *Reader) readChunk() error {
+	if _, err := io.ReadFull(r.r, r.lenBuf[:]); err != nil {
+		return err
+	}
+	plainLen := int(endianness.Uint32(r.lenBuf[:]))
+	if plainLen > r.chunkSize {
+		return errCorruptChunk
+	}
+	sealed := r.sealed[:plainLen+r.aead.Overhead()]
+	if _, err := io.ReadFull(r.r, sealed); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	setChunkNonce(r.nonce, r.chunk)
+	plain, err := r.aead.Open(r.plain[:0], r.nonce, sealed, r.lenBuf[:])
+	if err != nil {
+		return fmt.Errorf("could not decrypt chunk %d: %v", r.chunk, err)
+	}
+	r.plain = plain
+	r.pos = 0
+	r.chunk++
+	return nil
+}
+
+// ReaderAt decrypts a file written by a Writer at any offset, the file must
+// not have been flushed before it was closed. It is safe for concurrent use.
+type ReaderAt struct {
+	r               io.ReaderAt
+	aead            cipher.AEAD
+	headerLen       int64
+	chunkSize       int64
+	sealedChunkSize int64
+	numChunks       int64
+	size            int64
+	buffers         sync.Pool
+}
+
+type readerAtBuffers struct {
+	nonce  []byte
+	sealed []byte
+	plain  []byte
+}
+
+// NewReaderAt returns a new reader that decrypts the file of the given size
+// read from r.
+func NewReaderAt(r io.ReaderAt, size int64, keyProvider KeyProvider) (*ReaderAt, error) {
+	sectionReader := io.NewSectionReader(r, 0, size)
+	h, err := readHeader(sectionReader)
+	if err != nil {
+		return nil, err
+	}
+	headerLen, err := sectionReader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := h.aead(keyProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		chunkSize       = int64(h.chunkSize)
+		sealedChunkSize = chunkLenSize + chunkSize + int64(aead.Overhead())
+		bodySize        = size - headerLen
+		numChunks       = (bodySize + sealedChunkSize - 1) / sealedChunkSize
+		plainSize       int64
+	)
+	if numChunks > 0 {
+		lastChunkLen := bodySize - (numChunks-1)*sealedChunkSize -
+			chunkLenSize - int64(aead.Overhead())
+		if lastChunkLen <= 0 {
+			return nil, errCorruptChunk
+		}
+		plainSize = (numChunks-1)*chunkSize + lastChunkLen
+	}
+
+	readerAt := &ReaderAt{
+		r:               r,
+		aead:            aead,
+		headerLen:       headerLen,
+		chunkSize:       chunkSize,
+		sealedChunkSize: sealedChunkSize,
+		numChunks:       numChunks,
+		size:            plainSize,
+	}
+	readerAt.buffers.New = func() interface{} {
+		return &readerAtBuffers{
+			nonce:  make([]byte, aead.NonceSize()),
+			sealed: make([]byte, readAheadChunks*sealedChunkSize),
+			plain:  make([]byte, 0, chunkSize),
+		}
+	}
+	return readerAt, nil
+}
+
+// Size returns the size of the decrypted file.
+func (r *ReaderAt) Size() int64 {
+	return r.size
+}
+
+// ReadAt reads decrypted bytes at the offset.
+func (r *ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errNegativeOffset
+	}
+
+	buffers := r.buffers.Get().(*readerAtBuffers)
+	defer r.buffers.Put(buffers)
+
+	read := 0
+	for read < len(p) && off < r.size {
+		var (
+			first = off / r.chunkSize
+			last  = (off + int64(len(p)-read) - 1) / r.chunkSize
+		)
+		if last >= r.numChunks {
+			last = r.numChunks - 1
+		}
+		if last-first >= readAheadChunks {
+			last = first + readAheadChunks - 1
+		}
+
+		var (
+			start  = r.headerLen + first*r.sealedChunkSize
+			end    = r.headerLen + last*r.sealedChunkSize + r.sealedChunkLen(last)
+			sealed = buffers.sealed[:end-start]
+		)
+		if n, err := r.r.ReadAt(sealed, start); n < len(sealed) {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return read, err
+		}
+
+		for chunk := first; chunk <= last; chunk++ {
+			sealedChunk := sealed[(chunk-first)*r.sealedChunkSize:][:r.sealedChunkLen(chunk)]
+			plain, err := r.openChunk(chunk, sealedChunk, buffers)
+			if err != nil {
+				return read, err
+			}
+			n := copy(p[read:], plain[off-chunk*r.chunkSize:])
+			read += n
+			off += int64(n)
+		}
+	}
+
+	if read < len(p) {
+		return read, io.EOF
+	}
+	return read, nil
+}
+
+func (r *ReaderAt) sealedChunkLen(chunk int64) int64 {
+	plainLen := r.chunkSize
+	if chunk == r.numChunks-1 {
+		plainLen = r.size - chunk*r.chunkSize
+	}
+	return chunkLenSize + plainLen + int64(r.aead.Overhead())
+}
+
+func (r *ReaderAt) openChunk(
+	chunk int64,
+	sealedChunk []byte,
+	buffers *readerAtBuffers,
+) ([]byte, error) {
+	lenBuf := sealedChunk[:chunkLenSize]
+	if int64(endianness.Uint32(lenBuf)) !=
+		int64(len(sealedChunk))-chunkLenSize-int64(r.aead.Overhead()) {
+		return nil, errCorruptChunk
+	}
+	setChunkNonce(buffers.nonce, uint64(chunk))
+	plain, err := r.aead.Open(buffers.plain[:0], buffers.nonce,
+		sealedChunk[chunkLenSize:], lenBuf)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt chunk %d: %v", chunk, err)
+	}
+	return plain, nil
+}
+
+type header struct {
+	chunkSize  int
+	keyID      string
+	wrappedKey []byte
+}
+
+func (h header) encode() ([]byte, error) {
+	if len(h.keyID) > math.MaxUint16 {
+		return nil, errKeyIDTooLong
+	}
+	if len(h.wrappedKey) > math.MaxUint16 {
+		return nil, errWrappedKeyTooLong
+	}
+
+	b := make([]byte, 0, fixedHeaderLen+len(h.keyID)+2+len(h.wrappedKey))
+	b = append(b, magic...)
+	b = append(b, formatVersion)
+	b = appendUint32(b, uint32(h.chunkSize))
+	b = appendUint16(b, uint16(len(h.keyID)))
+	b = append(b, h.keyID...)
+	b = appendUint16(b, uint16(len(h.wrappedKey)))
+	b = append(b, h.wrappedKey...)
+	return b, nil
+}
+
+func readHeader(r io.Reader) (header, error) {
+	fixed := make([]byte, fixedHeaderLen)
+	if _, err := io.ReadFull(r, fixed); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return header{}, errNotEncrypted
+		}
+		return header{}, err
+	}
+	if string(fixed[:len(magic)]) != string(magic) {
+		return header{}, errNotEncrypted
+	}
+	if version := fixed[len(magic)]; version != formatVersion {
+		return header{}, fmt.Errorf("unsupported encrypted file version: %d", version)
+	}
+
+	var (
+		chunkSize = endianness.Uint32(fixed[len(magic)+1:])
+		keyIDLen  = endianness.Uint16(fixed[len(magic)+5:])
+	)
+	if chunkSize == 0 || chunkSize > maxChunkSize {
+		return header{}, errCorruptHeader
+	}
+
+	keyID := make([]byte, keyIDLen+2)
+	if _, err := io.ReadFull(r, keyID); err != nil {
+		return header{}, errCorruptHeader
+	}
+	wrappedKey := make([]byte, endianness.Uint16(keyID[keyIDLen:]))
+	if _, err := io.ReadFull(r, wrappedKey); err != nil {
+		return header{}, errCorruptHeader
+	}
+
+	return header{
+		chunkSize:  int(chunkSize),
+		keyID:      string(keyID[:keyIDLen]),
+		wrappedKey: wrappedKey,
+	}, nil
+}
+
+func (h header) aead(keyProvider KeyProvider) (cipher.AEAD, error) {
+	dataKey, err := keyProvider.UnwrapKey(h.keyID, h.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not unwrap data key: %v", err)
+	}
+	return newAEAD(dataKey)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func setChunkNonce(nonce []byte, chunk uint64) {
+	for i := range nonce[:len(nonce)-8] {
+		nonce[i] = 0
+	}
+	endianness.PutUint64(nonce[len(nonce)-8:], chunk)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
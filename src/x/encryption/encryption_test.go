@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyProvider(t *testing.T) KeyProvider {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "key")
+	writeTestKeyFile(t, path)
+	keyProvider, err := NewKeyFileProvider(path)
+	require.NoError(t, err)
+	return keyProvider
+}
+
+func encryptTestData(t *testing.T, keyProvider KeyProvider, data []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, keyProvider)
+	require.NoError(t, err)
+
+	// Write in uneven pieces to cross chunk boundaries.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		written, err := w.Write(data[:n])
+		require.NoError(t, err)
+		require.Equal(t, n, written)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+	rng := rand.New(rand.NewSource(0))
+
+	for _, size := range []int{
+		0, 1, defaultChunkSize - 1, defaultChunkSize, defaultChunkSize + 1,
+		readAheadChunks*defaultChunkSize + 17,
+	} {
+		data := make([]byte, size)
+		rng.Read(data)
+		encrypted := encryptTestData(t, keyProvider, data)
+
+		encryptedFile := bytes.NewReader(encrypted)
+		ok, err := IsEncrypted(encryptedFile)
+		require.NoError(t, err)
+		require.True(t, ok)
+		if size > 16 {
+			require.False(t, bytes.Contains(encrypted, data))
+		}
+
+		// Read as a stream.
+		r, err := NewReader(bytes.NewReader(encrypted), keyProvider)
+		require.NoError(t, err)
+		decrypted, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, append([]byte{}, decrypted...))
+
+		// Read at offsets.
+		readerAt, err := NewReaderAt(encryptedFile, int64(len(encrypted)), keyProvider)
+		require.NoError(t, err)
+		require.Equal(t, int64(size), readerAt.Size())
+
+		all := make([]byte, size)
+		n, err := readerAt.ReadAt(all, 0)
+		require.NoError(t, err)
+		require.Equal(t, size, n)
+		require.Equal(t, data, all)
+
+		for i := 0; i < 100 && size > 0; i++ {
+			off := rng.Intn(size)
+			buf := make([]byte, rng.Intn(2*defaultChunkSize))
+			n, err := readerAt.ReadAt(buf, int64(off))
+			if off+len(buf) > size {
+				require.Equal(t, io.EOF, err)
+				require.Equal(t, size-off, n)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, len(buf), n)
+			}
+			require.Equal(t, data[off:off+n], buf[:n])
+		}
+
+		_, err = readerAt.ReadAt(make([]byte, 1), int64(size))
+		require.Equal(t, io.EOF, err)
+	}
+}
+
+func TestEncryptionFlush(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, keyProvider)
+	require.NoError(t, err)
+	for _, s := range []string{"foo", "bar", "baz"} {
+		_, err := w.Write([]byte(s))
+		require.NoError(t, err)
+		require.NoError(t, w.Flush())
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), keyProvider)
+	require.NoError(t, err)
+	decrypted, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "foobarbaz", string(decrypted))
+
+	// A file truncated within a chunk is detected.
+	r, err = NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), keyProvider)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+
+	// Flushed files can not be read at offsets.
+	readerAt, err := NewReaderAt(bytes.NewReader(buf.Bytes()), int64(buf.Len()), keyProvider)
+	if err == nil {
+		_, err = readerAt.ReadAt(make([]byte, 9), 0)
+	}
+	require.Error(t, err)
+}
+
+func TestEncryptionTampered(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+	encrypted := encryptTestData(t, keyProvider, make([]byte, 3*defaultChunkSize))
+	encrypted[len(encrypted)-defaultChunkSize] ^= 1
+
+	r, err := NewReader(bytes.NewReader(encrypted), keyProvider)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+
+	readerAt, err := NewReaderAt(bytes.NewReader(encrypted), int64(len(encrypted)), keyProvider)
+	require.NoError(t, err)
+	_, err = readerAt.ReadAt(make([]byte, 3*defaultChunkSize), 0)
+	require.Error(t, err)
+}
+
+func TestIsEncrypted(t *testing.T) {
+	for _, data := range []string{"", "foo", "plaintext file contents"} {
+		ok, err := IsEncrypted(bytes.NewReader([]byte(data)))
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		_, err = NewReader(bytes.NewReader([]byte(data)), newTestKeyProvider(t))
+		require.Equal(t, errNotEncrypted, err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const keyFileKeyLen = 32
+
+var errWrappedKeyTooShort = errors.New("wrapped key is too short")
+
+// KeyProvider wraps the data keys that files are encrypted with using key
+// encryption keys it manages. It is the extension point for key management
+// services, implementations must be safe for concurrent use.
+type KeyProvider interface {
+	// WrapKey encrypts a data key with the current key encryption key,
+	// returning the ID of the key encryption key and the wrapped data key.
+	WrapKey(dataKey []byte) (keyID string, wrappedKey []byte, err error)
+
+	// UnwrapKey decrypts a data key wrapped with the key encryption key
+	// of the given ID.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+type keyFileProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewKeyFileProvider returns a key provider of key encryption keys read from
+// local files, each file holds a base64 encoded 256 bit key. Data keys are
+// wrapped with the key of the first file, the keys of the previous files are
+// only used to unwrap data keys which allows keys to be rotated.
+func NewKeyFileProvider(path string, previousPaths ...string) (KeyProvider, error) {
+	p := &keyFileProvider{
+		keys: make(map[string]cipher.AEAD, 1+len(previousPaths)),
+	}
+	for i, path := range append([]string{path}, previousPaths...) {
+		key, err := readKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		keyID := keyFileKeyID(key)
+		if i == 0 {
+			p.currentKeyID = keyID
+		}
+		p.keys[keyID] = aead
+	}
+	return p, nil
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key file %s: %v", path, err)
+	}
+	if len(key) != keyFileKeyLen {
+		return nil, fmt.Errorf("key file %s holds a %d byte key, expected %d bytes",
+			path, len(key), keyFileKeyLen)
+	}
+	return key, nil
+}
+
+// keyFileKeyID identifies a key by a prefix of its hash, so that the ID
+// stored in file headers does not depend on where the key file is.
+func keyFileKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+func (p *keyFileProvider) WrapKey(dataKey []byte) (string, []byte, error) {
+	aead := p.keys[p.currentKeyID]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return p.currentKeyID, aead.Seal(nonce, nonce, dataKey, []byte(p.currentKeyID)), nil
+}
+
+func (p *keyFileProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("no key file holds the key with ID %s", keyID)
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errWrappedKeyTooShort
+	}
+	nonce, sealed := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(keyID))
+}
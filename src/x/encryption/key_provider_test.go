@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestKeyFile(t *testing.T, path string) {
+	key := make([]byte, keyFileKeyLen)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(key) + "\n"
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+}
+
+func TestKeyFileProviderRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		oldPath = filepath.Join(dir, "old")
+		newPath = filepath.Join(dir, "new")
+	)
+	writeTestKeyFile(t, oldPath)
+	writeTestKeyFile(t, newPath)
+
+	oldProvider, err := NewKeyFileProvider(oldPath)
+	require.NoError(t, err)
+	encrypted := encryptTestData(t, oldProvider, []byte("foo"))
+
+	// Files encrypted with a rotated out key can be read while its key
+	// file is still configured.
+	rotatedProvider, err := NewKeyFileProvider(newPath, oldPath)
+	require.NoError(t, err)
+	r, err := NewReader(bytes.NewReader(encrypted), rotatedProvider)
+	require.NoError(t, err)
+	decrypted, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(decrypted))
+
+	// New files are encrypted with the new key.
+	keyID, _, err := rotatedProvider.WrapKey(make([]byte, dataKeyLen))
+	require.NoError(t, err)
+	newProvider, err := NewKeyFileProvider(newPath)
+	require.NoError(t, err)
+	newKeyID, _, err := newProvider.WrapKey(make([]byte, dataKeyLen))
+	require.NoError(t, err)
+	require.Equal(t, newKeyID, keyID)
+
+	_, err = NewReader(bytes.NewReader(encrypted), newProvider)
+	require.Error(t, err)
+}
+
+func TestKeyFileProviderInvalidKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "key")
+	for _, data := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+		_, err := NewKeyFileProvider(path)
+		require.Error(t, err)
+	}
+
+	_, err = NewKeyFileProvider(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
+
+func TestKeyFileProviderUnwrapInvalidKey(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+	keyID, wrappedKey, err := keyProvider.WrapKey(make([]byte, dataKeyLen))
+	require.NoError(t, err)
+
+	_, err = keyProvider.UnwrapKey("unknown", wrappedKey)
+	require.Error(t, err)
+	_, err = keyProvider.UnwrapKey(keyID, wrappedKey[:4])
+	require.Equal(t, errWrappedKeyTooShort, err)
+
+	wrappedKey[len(wrappedKey)-1] ^= 1
+	_, err = keyProvider.UnwrapKey(keyID, wrappedKey)
+	require.Error(t, err)
+}
+
+func TestConfigurationNewKeyProvider(t *testing.T) {
+	_, err := Configuration{}.NewKeyProvider()
+	require.Equal(t, errKeyProviderNotConfigured, err)
+}
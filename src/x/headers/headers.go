@@ -62,6 +62,10 @@ const (
 	// SourceHeader tracks bytes and docs read for the given source, if provided.
 	SourceHeader = M3HeaderPrefix + "Source"
 
+	// TenantHeader identifies the tenant a request belongs to when enforcing
+	// per-tenant quotas.
+	TenantHeader = M3HeaderPrefix + "Tenant"
+
 	// DefaultWriteType is the default write type.
 	DefaultWriteType = "default"
 
@@ -0,0 +1,401 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package histogram implements native histograms, histograms with sparse
+// exponential buckets as introduced by Prometheus.
+package histogram
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// MinSchema is the lowest supported schema, each bucket spans a factor
+	// of 2^16.
+	MinSchema int32 = -4
+	// MaxSchema is the highest supported schema, each bucket spans a factor
+	// of 2^(2^-8).
+	MaxSchema int32 = 8
+)
+
+var (
+	errSpansBucketsMismatch = errors.New("histogram spans do not match the number of buckets")
+	errNegativeCount        = errors.New("histogram has a negative count")
+)
+
+// Span is a run of consecutive buckets. The offset is the gap between the
+// first bucket of the span and the last bucket of the previous span, or the
+// index of the first bucket for the first span.
+type Span struct {
+	Offset int32
+	Length uint32
+}
+
+// Histogram is a native histogram. The bucket counts are absolute rather
+// than delta encoded, a bucket with index i covers the range
+// (base^(i-1), base^i] where base is 2^(2^-schema), negative buckets mirror
+// the positive ones.
+type Histogram struct {
+	Schema          int32
+	ZeroThreshold   float64
+	ZeroCount       float64
+	Count           float64
+	Sum             float64
+	PositiveSpans   []Span
+	PositiveBuckets []float64
+	NegativeSpans   []Span
+	NegativeBuckets []float64
+}
+
+// Validate validates the histogram.
+func (h *Histogram) Validate() error {
+	if h.Schema < MinSchema || h.Schema > MaxSchema {
+		return fmt.Errorf("histogram schema %d out of range [%d, %d]",
+			h.Schema, MinSchema, MaxSchema)
+	}
+	if err := validateBuckets(h.PositiveSpans, h.PositiveBuckets); err != nil {
+		return err
+	}
+	if err := validateBuckets(h.NegativeSpans, h.NegativeBuckets); err != nil {
+		return err
+	}
+	if h.ZeroCount < 0 || h.Count < 0 {
+		return errNegativeCount
+	}
+	return nil
+}
+
+func validateBuckets(spans []Span, buckets []float64) error {
+	var n uint32
+	for _, span := range spans {
+		n += span.Length
+	}
+	if int(n) != len(buckets) {
+		return errSpansBucketsMismatch
+	}
+	for _, count := range buckets {
+		if count < 0 {
+			return errNegativeCount
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the histogram.
+func (h *Histogram) Copy() *Histogram {
+	c := *h
+	c.PositiveSpans = append([]Span(nil), h.PositiveSpans...)
+	c.PositiveBuckets = append([]float64(nil), h.PositiveBuckets...)
+	c.NegativeSpans = append([]Span(nil), h.NegativeSpans...)
+	c.NegativeBuckets = append([]float64(nil), h.NegativeBuckets...)
+	return &c
+}
+
+// Equal returns true if both histograms have the same schema, counts
+// and buckets.
+func (h *Histogram) Equal(other *Histogram) bool {
+	if h.Schema != other.Schema ||
+		h.ZeroThreshold != other.ZeroThreshold ||
+		h.ZeroCount != other.ZeroCount ||
+		h.Count != other.Count ||
+		!floatEqual(h.Sum, other.Sum) {
+		return false
+	}
+	return spansEqual(h.PositiveSpans, other.PositiveSpans) &&
+		floatsEqual(h.PositiveBuckets, other.PositiveBuckets) &&
+		spansEqual(h.NegativeSpans, other.NegativeSpans) &&
+		floatsEqual(h.NegativeBuckets, other.NegativeBuckets)
+}
+
+// Add merges the other histogram into this one. The merged histogram uses
+// the lower of both schemas and the wider of both zero buckets, buckets
+// are merged to match.
+func (h *Histogram) Add(other *Histogram) {
+	schema := h.Schema
+	if other.Schema < schema {
+		schema = other.Schema
+	}
+	zeroThreshold := math.Max(h.ZeroThreshold, other.ZeroThreshold)
+
+	positive := make(map[int32]float64, len(h.PositiveBuckets)+len(other.PositiveBuckets))
+	negative := make(map[int32]float64, len(h.NegativeBuckets)+len(other.NegativeBuckets))
+	zeroCount := h.ZeroCount + other.ZeroCount
+	for _, src := range []*Histogram{h, other} {
+		zeroCount += collectBuckets(positive, src.Schema, schema, zeroThreshold,
+			src.PositiveSpans, src.PositiveBuckets)
+		zeroCount += collectBuckets(negative, src.Schema, schema, zeroThreshold,
+			src.NegativeSpans, src.NegativeBuckets)
+	}
+
+	h.Schema = schema
+	h.ZeroThreshold = zeroThreshold
+	h.ZeroCount = zeroCount
+	h.Count += other.Count
+	h.Sum += other.Sum
+	h.PositiveSpans, h.PositiveBuckets = fromBucketMap(positive, h.PositiveSpans[:0], h.PositiveBuckets[:0])
+	h.NegativeSpans, h.NegativeBuckets = fromBucketMap(negative, h.NegativeSpans[:0], h.NegativeBuckets[:0])
+}
+
+// ReduceResolution lowers the schema of the histogram to the target schema
+// by merging adjacent buckets, it is a no-op if the schema is already lower.
+func (h *Histogram) ReduceResolution(schema int32) {
+	if schema >= h.Schema {
+		return
+	}
+	positive := make(map[int32]float64, len(h.PositiveBuckets))
+	negative := make(map[int32]float64, len(h.NegativeBuckets))
+	h.ZeroCount += collectBuckets(positive, h.Schema, schema, h.ZeroThreshold,
+		h.PositiveSpans, h.PositiveBuckets)
+	h.ZeroCount += collectBuckets(negative, h.Schema, schema, h.ZeroThreshold,
+		h.NegativeSpans, h.NegativeBuckets)
+	h.Schema = schema
+	h.PositiveSpans, h.PositiveBuckets = fromBucketMap(positive, h.PositiveSpans[:0], h.PositiveBuckets[:0])
+	h.NegativeSpans, h.NegativeBuckets = fromBucketMap(negative, h.NegativeSpans[:0], h.NegativeBuckets[:0])
+}
+
+// collectBuckets adds the buckets to the given map keyed by their index at
+// the target schema, returning the count of buckets that fall within the
+// zero bucket.
+func collectBuckets(
+	into map[int32]float64,
+	schema, targetSchema int32,
+	zeroThreshold float64,
+	spans []Span,
+	buckets []float64,
+) float64 {
+	var zeroCount float64
+	iter := newBucketIter(spans, buckets)
+	for iter.next() {
+		idx, count := iter.index, iter.count
+		if UpperBound(idx, schema) <= zeroThreshold {
+			zeroCount += count
+			continue
+		}
+		into[targetIndex(idx, schema, targetSchema)] += count
+	}
+	return zeroCount
+}
+
+// targetIndex returns the index of the bucket at the target schema that
+// contains the bucket at the origin schema.
+func targetIndex(idx, schema, targetSchema int32) int32 {
+	return ((idx - 1) >> uint32(schema-targetSchema)) + 1
+}
+
+func fromBucketMap(
+	buckets map[int32]float64,
+	spans []Span,
+	counts []float64,
+) ([]Span, []float64) {
+	indexes := make([]int32, 0, len(buckets))
+	for idx := range buckets {
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	var prev int32
+	for i, idx := range indexes {
+		if i > 0 && idx == prev+1 {
+			spans[len(spans)-1].Length++
+		} else {
+			offset := idx
+			if i > 0 {
+				offset = idx - prev - 1
+			}
+			spans = append(spans, Span{Offset: offset, Length: 1})
+		}
+		counts = append(counts, buckets[idx])
+		prev = idx
+	}
+	return spans, counts
+}
+
+// UpperBound returns the upper bound of the bucket with the given index at
+// the given schema.
+func UpperBound(idx, schema int32) float64 {
+	return math.Exp2(float64(idx) * math.Exp2(-float64(schema)))
+}
+
+// Quantile returns the estimated value at the given quantile, interpolating
+// linearly within the bucket the quantile falls into.
+func (h *Histogram) Quantile(q float64) float64 {
+	if h.Count == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q < 0 {
+		return math.Inf(-1)
+	}
+	if q > 1 {
+		return math.Inf(+1)
+	}
+
+	var (
+		rank       = q * h.Count
+		cumulative float64
+	)
+	for _, b := range h.ascendingBuckets() {
+		if b.count == 0 {
+			continue
+		}
+		if cumulative+b.count >= rank {
+			return b.lower + (b.upper-b.lower)*((rank-cumulative)/b.count)
+		}
+		cumulative += b.count
+	}
+
+	// NB: the count can exceed the sum of the buckets due to float
+	// precision, in which case the quantile is the highest bound.
+	buckets := h.ascendingBuckets()
+	if len(buckets) == 0 {
+		return math.NaN()
+	}
+	return buckets[len(buckets)-1].upper
+}
+
+type bucket struct {
+	lower, upper float64
+	count        float64
+}
+
+// ascendingBuckets returns the negative, zero and positive buckets ordered
+// by their bounds.
+func (h *Histogram) ascendingBuckets() []bucket {
+	buckets := make([]bucket, 0, len(h.NegativeBuckets)+len(h.PositiveBuckets)+1)
+	iter := newBucketIter(h.NegativeSpans, h.NegativeBuckets)
+	for iter.next() {
+		buckets = append(buckets, bucket{
+			lower: -UpperBound(iter.index, h.Schema),
+			upper: -UpperBound(iter.index-1, h.Schema),
+			count: iter.count,
+		})
+	}
+	// NB: negative buckets are iterated by increasing absolute value.
+	for i, j := 0, len(buckets)-1; i < j; i, j = i+1, j-1 {
+		buckets[i], buckets[j] = buckets[j], buckets[i]
+	}
+
+	if h.ZeroCount > 0 {
+		lower := -h.ZeroThreshold
+		if len(h.NegativeBuckets) == 0 {
+			lower = 0
+		}
+		upper := h.ZeroThreshold
+		if len(h.PositiveBuckets) == 0 {
+			upper = 0
+		}
+		buckets = append(buckets, bucket{lower: lower, upper: upper, count: h.ZeroCount})
+	}
+
+	iter = newBucketIter(h.PositiveSpans, h.PositiveBuckets)
+	for iter.next() {
+		lower := UpperBound(iter.index-1, h.Schema)
+		if lower < h.ZeroThreshold {
+			lower = h.ZeroThreshold
+		}
+		buckets = append(buckets, bucket{
+			lower: lower,
+			upper: UpperBound(iter.index, h.Schema),
+			count: iter.count,
+		})
+	}
+	return buckets
+}
+
+// ForEachPositiveBucket calls the given function with the upper bound and
+// count of every positive bucket in increasing order.
+func (h *Histogram) ForEachPositiveBucket(fn func(upper, count float64)) {
+	iter := newBucketIter(h.PositiveSpans, h.PositiveBuckets)
+	for iter.next() {
+		fn(UpperBound(iter.index, h.Schema), iter.count)
+	}
+}
+
+type bucketIter struct {
+	spans   []Span
+	buckets []float64
+
+	spanIdx   int
+	inSpan    uint32
+	bucketIdx int
+
+	index int32
+	count float64
+}
+
+func newBucketIter(spans []Span, buckets []float64) bucketIter {
+	return bucketIter{spans: spans, buckets: buckets, spanIdx: -1}
+}
+
+func (it *bucketIter) next() bool {
+	for it.spanIdx < 0 || it.inSpan >= it.spans[it.spanIdx].Length {
+		it.spanIdx++
+		if it.spanIdx >= len(it.spans) {
+			return false
+		}
+		span := it.spans[it.spanIdx]
+		if it.spanIdx == 0 {
+			it.index = span.Offset - 1
+		} else {
+			it.index += span.Offset
+		}
+		it.inSpan = 0
+	}
+	if it.bucketIdx >= len(it.buckets) {
+		return false
+	}
+	it.index++
+	it.inSpan++
+	it.count = it.buckets[it.bucketIdx]
+	it.bucketIdx++
+	return true
+}
+
+func spansEqual(a, b []Span) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func floatsEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !floatEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func floatEqual(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHistogram() *Histogram {
+	// Buckets at schema 0 with indexes 1, 2 and 4, that is (1, 2], (2, 4]
+	// and (8, 16].
+	return &Histogram{
+		Schema:          0,
+		ZeroThreshold:   0.001,
+		ZeroCount:       2,
+		Count:           12,
+		Sum:             50,
+		PositiveSpans:   []Span{{Offset: 1, Length: 2}, {Offset: 1, Length: 1}},
+		PositiveBuckets: []float64{4, 4, 2},
+	}
+}
+
+func TestHistogramValidate(t *testing.T) {
+	h := testHistogram()
+	require.NoError(t, h.Validate())
+
+	h.Schema = MaxSchema + 1
+	require.Error(t, h.Validate())
+
+	h = testHistogram()
+	h.PositiveBuckets = h.PositiveBuckets[:2]
+	require.Error(t, h.Validate())
+
+	h = testHistogram()
+	h.PositiveBuckets[0] = -1
+	require.Error(t, h.Validate())
+}
+
+func TestHistogramMarshalRoundTrip(t *testing.T) {
+	h := testHistogram()
+	h.NegativeSpans = []Span{{Offset: -3, Length: 1}}
+	h.NegativeBuckets = []float64{1}
+
+	data := h.Marshal(nil)
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.True(t, h.Equal(decoded))
+
+	for i := 0; i < len(data); i++ {
+		_, err := Unmarshal(data[:i])
+		require.Error(t, err)
+	}
+}
+
+func TestHistogramAdd(t *testing.T) {
+	h := testHistogram()
+	other := &Histogram{
+		Schema:          0,
+		ZeroThreshold:   0.001,
+		ZeroCount:       1,
+		Count:           4,
+		Sum:             10,
+		PositiveSpans:   []Span{{Offset: 2, Length: 2}},
+		PositiveBuckets: []float64{1, 2},
+	}
+
+	h.Add(other)
+	require.NoError(t, h.Validate())
+	assert.Equal(t, float64(3), h.ZeroCount)
+	assert.Equal(t, float64(16), h.Count)
+	assert.Equal(t, float64(60), h.Sum)
+	assert.Equal(t, []Span{{Offset: 1, Length: 4}}, h.PositiveSpans)
+	assert.Equal(t, []float64{4, 5, 2, 2}, h.PositiveBuckets)
+}
+
+func TestHistogramAddDifferentSchemas(t *testing.T) {
+	h := testHistogram()
+	// Buckets at schema 1 with indexes 1 to 4, that is (1, 1.41], (1.41, 2],
+	// (2, 2.83] and (2.83, 4].
+	other := &Histogram{
+		Schema:          1,
+		Count:           4,
+		PositiveSpans:   []Span{{Offset: 1, Length: 4}},
+		PositiveBuckets: []float64{1, 1, 1, 1},
+	}
+
+	h.Add(other)
+	require.NoError(t, h.Validate())
+	assert.Equal(t, int32(0), h.Schema)
+	assert.Equal(t, []Span{{Offset: 1, Length: 2}, {Offset: 1, Length: 1}}, h.PositiveSpans)
+	assert.Equal(t, []float64{6, 6, 2}, h.PositiveBuckets)
+}
+
+func TestHistogramReduceResolution(t *testing.T) {
+	h := testHistogram()
+	h.ReduceResolution(-1)
+	require.NoError(t, h.Validate())
+	assert.Equal(t, int32(-1), h.Schema)
+	// At schema -1 the buckets are (1, 4] and (4, 16].
+	assert.Equal(t, []Span{{Offset: 1, Length: 2}}, h.PositiveSpans)
+	assert.Equal(t, []float64{8, 2}, h.PositiveBuckets)
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	h := testHistogram()
+	assert.Equal(t, float64(0), h.Quantile(0))
+	// The first 2 observations are in the zero bucket, the next 4 are in
+	// (1, 2].
+	assert.InDelta(t, 1.5, h.Quantile(4.0/12), 1e-9)
+	assert.InDelta(t, 3, h.Quantile(8.0/12), 1e-9)
+	assert.InDelta(t, 16, h.Quantile(1), 1e-9)
+	assert.True(t, math.IsInf(h.Quantile(2), 1))
+	assert.True(t, math.IsNaN((&Histogram{}).Quantile(0.5)))
+}
+
+func TestHistogramCopy(t *testing.T) {
+	h := testHistogram()
+	c := h.Copy()
+	require.True(t, h.Equal(c))
+
+	c.PositiveBuckets[0]++
+	assert.False(t, h.Equal(c))
+}
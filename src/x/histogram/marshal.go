@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const marshalVersion = 1
+
+var (
+	errUnmarshalTruncated = errors.New("histogram: truncated data")
+	errUnmarshalVersion   = errors.New("histogram: unknown version")
+)
+
+// Marshal appends the binary representation of the histogram to the buffer
+// and returns the extended buffer.
+func (h *Histogram) Marshal(buf []byte) []byte {
+	buf = append(buf, marshalVersion)
+	buf = binary.AppendVarint(buf, int64(h.Schema))
+	buf = appendFloat(buf, h.ZeroThreshold)
+	buf = appendFloat(buf, h.ZeroCount)
+	buf = appendFloat(buf, h.Count)
+	buf = appendFloat(buf, h.Sum)
+	buf = appendBuckets(buf, h.PositiveSpans, h.PositiveBuckets)
+	buf = appendBuckets(buf, h.NegativeSpans, h.NegativeBuckets)
+	return buf
+}
+
+func appendFloat(buf []byte, v float64) []byte {
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+}
+
+func appendBuckets(buf []byte, spans []Span, buckets []float64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(spans)))
+	for _, span := range spans {
+		buf = binary.AppendVarint(buf, int64(span.Offset))
+		buf = binary.AppendUvarint(buf, uint64(span.Length))
+	}
+	for _, count := range buckets {
+		buf = appendFloat(buf, count)
+	}
+	return buf
+}
+
+// Unmarshal decodes a histogram from its binary representation.
+func Unmarshal(data []byte) (*Histogram, error) {
+	var h Histogram
+	if err := h.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// Unmarshal decodes the binary representation into the histogram, reusing
+// the existing slices where possible.
+func (h *Histogram) Unmarshal(data []byte) error {
+	d := decoder{data: data}
+	if version := d.byte(); d.err == nil && version != marshalVersion {
+		return errUnmarshalVersion
+	}
+	h.Schema = int32(d.varint())
+	h.ZeroThreshold = d.float()
+	h.ZeroCount = d.float()
+	h.Count = d.float()
+	h.Sum = d.float()
+	h.PositiveSpans, h.PositiveBuckets = d.buckets(h.PositiveSpans[:0], h.PositiveBuckets[:0])
+	h.NegativeSpans, h.NegativeBuckets = d.buckets(h.NegativeSpans[:0], h.NegativeBuckets[:0])
+	if d.err != nil {
+		return d.err
+	}
+	return h.Validate()
+}
+
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 1 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) float() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 8 {
+		d.err = errUnmarshalTruncated
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[8:]
+	return v
+}
+
+func (d *decoder) buckets(spans []Span, buckets []float64) ([]Span, []float64) {
+	numSpans := d.uvarint()
+	if numSpans > uint64(len(d.data)) {
+		// Each span takes at least two bytes, guard against allocating
+		// based on a corrupt length.
+		d.err = errUnmarshalTruncated
+		return spans, buckets
+	}
+	var numBuckets uint64
+	for i := uint64(0); i < numSpans && d.err == nil; i++ {
+		span := Span{
+			Offset: int32(d.varint()),
+			Length: uint32(d.uvarint()),
+		}
+		numBuckets += uint64(span.Length)
+		spans = append(spans, span)
+	}
+	if d.err != nil {
+		return spans, buckets
+	}
+	if numBuckets*8 > uint64(len(d.data)) {
+		d.err = errUnmarshalTruncated
+		return spans, buckets
+	}
+	for i := uint64(0); i < numBuckets; i++ {
+		buckets = append(buckets, d.float())
+	}
+	return spans, buckets
+}
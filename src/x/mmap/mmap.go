@@ -61,6 +61,8 @@ type Descriptor struct {
 	Bytes           []byte
 	Warning         error
 	ReporterOptions ReporterOptions
+	// Anonymous is whether the memory is not backed by a file.
+	Anonymous bool
 }
 
 // HugeTLBOptions contains all options related to huge TLB
@@ -43,7 +43,12 @@ func Bytes(length int64, opts Options) (Descriptor, error) {
 	// offset is 0 because we're not indexing into a file
 	// fd is -1 and MAP_ANON because we're asking for an anonymous region of memory not tied to a file
 	// MAP_PRIVATE because we don't plan on sharing this region of memory with other processes
-	return mmap(-1, 0, length, syscall.MAP_ANON|syscall.MAP_PRIVATE, opts)
+	desc, err := mmap(-1, 0, length, syscall.MAP_ANON|syscall.MAP_PRIVATE, opts)
+	if err != nil {
+		return Descriptor{}, err
+	}
+	desc.Anonymous = true
+	return desc, nil
 }
 
 func mmap(fd, offset, length int64, flags int, opts Options) (Descriptor, error) {
@@ -108,10 +113,10 @@ func Munmap(desc Descriptor) error {
 // MadviseDontNeed frees mmapped memory.
 // `MADV_DONTNEED` informs the kernel to free the mmapped pages right away instead of waiting for memory pressure.
 // NB(bodu): DO NOT FREE anonymously mapped memory or else it will null all of the underlying bytes as the
-// memory is not file backed.
+// memory is not file backed, this is a no-op for descriptors returned by Bytes.
 func MadviseDontNeed(desc Descriptor) error {
-	// Do nothing if there's no data.
-	if len(desc.Bytes) == 0 {
+	// Do nothing if there's no data or the memory is not file backed.
+	if len(desc.Bytes) == 0 || desc.Anonymous {
 		return nil
 	}
 	return madvise(desc.Bytes, syscall.MADV_DONTNEED)
@@ -137,6 +137,8 @@ func getStatusCode(err error) int {
 	case error:
 		if xerrors.IsInvalidParams(v) {
 			return http.StatusBadRequest
+		} else if xerrors.IsResourceExhausted(v) || client.IsResourceExhaustedError(v) {
+			return http.StatusTooManyRequests
 		} else if errors.Is(err, context.Canceled) {
 			// This status code was coined by Nginx for exactly the same use case.
 			// https://httpstatuses.com/499
@@ -54,6 +54,16 @@ func TestErrorStatus(t *testing.T) {
 			err:            xerrors.NewInvalidParamsError(errors.New("bad param")),
 			expectedStatus: 400,
 		},
+		{
+			name:           "resource exhausted",
+			err:            xerrors.NewResourceExhaustedError(errors.New("quota exceeded")),
+			expectedStatus: 429,
+		},
+		{
+			name:           "client resource exhausted",
+			err:            terrors.NewResourceExhaustedError(errors.New("limit exceeded")),
+			expectedStatus: 429,
+		},
 		{
 			name:           "deadline exceeded",
 			err:            context.DeadlineExceeded,
@@ -146,6 +156,7 @@ func TestIsClientError(t *testing.T) {
 		{xerrors.NewInvalidParamsError(fmt.Errorf("InvalidParamsError")), true},
 		{xerrors.NewRetryableError(xerrors.NewInvalidParamsError(
 			fmt.Errorf("InvalidParamsError insde RetyrableError"))), true},
+		{xerrors.NewResourceExhaustedError(fmt.Errorf("ResourceExhaustedError")), true},
 
 		{NewError(fmt.Errorf("xhttp.Error(399)"), 399), false},
 		{NewError(fmt.Errorf("xhttp.Error(500)"), 500), false},
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import "errors"
+
+var (
+	errStoreNotConfigured       = errors.New("object store must set either a directory or s3")
+	errMultipleStoresConfigured = errors.New("object store must not set both a directory and s3")
+)
+
+// Configuration is the configuration of an object store, which is either a
+// local directory or an S3 compatible store.
+type Configuration struct {
+	// Directory is the local directory the objects are stored under.
+	Directory string `yaml:"directory"`
+
+	// S3 is the S3 compatible store the objects are stored in.
+	S3 *S3Configuration `yaml:"s3"`
+}
+
+// NewStore returns a new object store from the configuration.
+func (c Configuration) NewStore() (Store, error) {
+	switch {
+	case c.Directory != "" && c.S3 != nil:
+		return nil, errMultipleStoresConfigured
+	case c.Directory != "":
+		return NewDirectoryStore(c.Directory), nil
+	case c.S3 != nil:
+		return c.S3.NewStore()
+	}
+	return nil, errStoreNotConfigured
+}
+
+// S3Configuration is the configuration of an S3 compatible object store.
+type S3Configuration struct {
+	// Bucket is the bucket the objects are stored in.
+	Bucket string `yaml:"bucket" validate:"nonzero"`
+
+	// Prefix is prepended to the keys of all objects.
+	Prefix string `yaml:"prefix"`
+
+	// Region is the region of the bucket.
+	Region string `yaml:"region"`
+
+	// Endpoint overrides the S3 endpoint, for S3 compatible stores such
+	// as MinIO.
+	Endpoint string `yaml:"endpoint"`
+
+	// ForcePathStyle addresses buckets with the path of requests instead
+	// of the host, which most S3 compatible stores require.
+	ForcePathStyle bool `yaml:"forcePathStyle"`
+
+	// AccessKeyID is the access key ID of static credentials, the default
+	// AWS credential chain is used if it is not set.
+	AccessKeyID string `yaml:"accessKeyID"`
+
+	// SecretAccessKey is the secret access key of static credentials.
+	SecretAccessKey string `yaml:"secretAccessKey"`
+}
+
+// NewStore returns a new S3 object store from the configuration.
+func (c S3Configuration) NewStore() (Store, error) {
+	return NewS3Store(S3Options{
+		Bucket:          c.Bucket,
+		Prefix:          c.Prefix,
+		Region:          c.Region,
+		Endpoint:        c.Endpoint,
+		ForcePathStyle:  c.ForcePathStyle,
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+	})
+}
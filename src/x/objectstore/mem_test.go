@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func testStore(t *testing.T, store Store) {
+	require.NoError(t, store.Put("a/1", strings.NewReader("foo")))
+	require.NoError(t, store.Put("a/2", strings.NewReader("bar")))
+	require.NoError(t, store.Put("b/1", strings.NewReader("baz")))
+
+	r, err := store.Get("a/2")
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "bar", string(b))
+
+	_, err = store.Get("a/3")
+	require.Equal(t, ErrNotFound, err)
+
+	keys, err := store.List("a/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/1", "a/2"}, keys)
+
+	require.NoError(t, store.Delete("a/1"))
+	require.NoError(t, store.Delete("a/3"))
+	keys, err = store.List("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/2", "b/1"}, keys)
+}
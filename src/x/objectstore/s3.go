@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// NB: S3 compatible stores such as MinIO require a region for signing
+// requests even though they ignore it.
+const defaultS3Region = "us-east-1"
+
+var errS3BucketNotSet = errors.New("s3 bucket is not set")
+
+// S3Options are the options of an S3 object store.
+type S3Options struct {
+	// Bucket is the bucket the objects are stored in.
+	Bucket string
+	// Prefix is prepended to the keys of all objects.
+	Prefix string
+	// Region is the region of the bucket, us-east-1 if not set.
+	Region string
+	// Endpoint overrides the S3 endpoint, for S3 compatible stores such
+	// as MinIO.
+	Endpoint string
+	// ForcePathStyle addresses buckets with the path of requests instead
+	// of the host, which most S3 compatible stores require.
+	ForcePathStyle bool
+	// AccessKeyID and SecretAccessKey are static credentials, the default
+	// credential chain is used if they are not set.
+	AccessKeyID     string
+	SecretAccessKey string
+	// HTTPClient is the HTTP client used for requests, if set.
+	HTTPClient *http.Client
+}
+
+type s3Store struct {
+	client   s3iface.S3API
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+// NewS3Store returns a new S3 object store.
+func NewS3Store(opts S3Options) (Store, error) {
+	if opts.Bucket == "" {
+		return nil, errS3BucketNotSet
+	}
+
+	region := opts.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	config := aws.NewConfig().
+		WithRegion(region).
+		WithS3ForcePathStyle(opts.ForcePathStyle)
+	if opts.Endpoint != "" {
+		config = config.WithEndpoint(opts.Endpoint)
+	}
+	if opts.AccessKeyID != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			opts.AccessKeyID, opts.SecretAccessKey, ""))
+	}
+	if opts.HTTPClient != nil {
+		config = config.WithHTTPClient(opts.HTTPClient)
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.New(sess)
+	return &s3Store{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   opts.Bucket,
+		prefix:   opts.Prefix,
+	}, nil
+}
+
+func (s *s3Store) Put(key string, r io.Reader) error {
+	_, err := s.uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+		Body:   r,
+	})
+	return err
+}
+
+func (s *s3Store) Get(key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if isS3NotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *s3Store) Delete(key string) error {
+	_, err := s.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if isS3NotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *s3Store) List(prefix string) ([]string, error) {
+	var keys []string
+	err := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.objectKey(prefix)),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+			keys = append(keys, strings.TrimPrefix(key, s.objectKey("")))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *s3Store) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	if key == "" {
+		return strings.TrimSuffix(s.prefix, "/") + "/"
+	}
+	return path.Join(s.prefix, key)
+}
+
+func isS3NotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	switch awsErr.Code() {
+	case s3.ErrCodeNoSuchKey, "NotFound":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testS3Server is a minimal S3 server that serves path style requests of a
+// single bucket.
+type testS3Server struct {
+	t       *testing.T
+	bucket  string
+	objects map[string][]byte
+}
+
+type testS3ListResult struct {
+	XMLName  xml.Name `xml:"ListBucketResult"`
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+}
+
+func (s *testS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucketPrefix := "/" + s.bucket
+	require.True(s.t, strings.HasPrefix(r.URL.Path, bucketPrefix))
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, bucketPrefix), "/")
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		var result testS3ListResult
+		prefix := r.URL.Query().Get("prefix")
+		for k := range s.objects {
+			if strings.HasPrefix(k, prefix) {
+				result.Contents = append(result.Contents, struct {
+					Key string `xml:"Key"`
+				}{Key: k})
+			}
+		}
+		b, err := xml.Marshal(result)
+		require.NoError(s.t, err)
+		_, _ = w.Write(b)
+	case r.Method == http.MethodPut:
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(s.t, err)
+		s.objects[key] = b
+	case r.Method == http.MethodGet:
+		b, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+			return
+		}
+		_, _ = w.Write(b)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	server := &testS3Server{t: t, bucket: "bucket", objects: make(map[string][]byte)}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	store, err := NewS3Store(S3Options{
+		Bucket:          "bucket",
+		Prefix:          "prefix",
+		Endpoint:        httpServer.URL,
+		ForcePathStyle:  true,
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	testStore(t, store)
+	_, ok := server.objects["prefix/b/1"]
+	require.True(t, ok)
+}
+
+func TestS3StoreRequiresBucket(t *testing.T) {
+	_, err := NewS3Store(S3Options{})
+	require.Equal(t, errS3BucketNotSet, err)
+}